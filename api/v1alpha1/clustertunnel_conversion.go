@@ -17,15 +17,7 @@ func (src *ClusterTunnel) ConvertTo(dstRaw conversion.Hub) error {
 	log.Printf("ConvertTo: Converting ClusterTunnel from Spoke version v1alpha1 to Hub version v1alpha2;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// Implement conversion logic from v1alpha1 to v1alpha2
-	dst.ObjectMeta = src.ObjectMeta
-	if err := src.Spec.ConvertTo(&dst.Spec); err != nil {
-		return err
-	}
-	if err := src.Status.ConvertTo(&dst.Status); err != nil {
-		return err
-	}
-	return nil
+	return convertTunnelToHub(&src.ObjectMeta, &src.Spec, &src.Status, &dst.ObjectMeta, &dst.Spec, &dst.Status)
 }
 
 // ConvertFrom converts the Hub version (v1alpha2) to this ClusterTunnel (v1alpha1).
@@ -34,13 +26,5 @@ func (dst *ClusterTunnel) ConvertFrom(srcRaw conversion.Hub) error {
 	log.Printf("ConvertFrom: Converting ClusterTunnel from Hub version v1alpha2 to Spoke version v1alpha1;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// Implement conversion logic from v1alpha2 to v1alpha1
-	dst.ObjectMeta = src.ObjectMeta
-	if err := dst.Spec.ConvertFrom(src.Spec); err != nil {
-		return err
-	}
-	if err := dst.Status.ConvertFrom(src.Status); err != nil {
-		return err
-	}
-	return nil
+	return convertTunnelFromHub(&src.ObjectMeta, &src.Spec, &src.Status, &dst.ObjectMeta, &dst.Spec, &dst.Status)
 }
@@ -8,15 +8,32 @@
 package v1alpha1
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"maps"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 	"sigs.k8s.io/yaml"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
 )
 
+// ConversionDataAnnotation holds the v1alpha2 spec and status of a Tunnel or
+// ClusterTunnel converted to v1alpha1 when v1alpha1 cannot represent them, so
+// that fields such as spec.enableWarpRouting survive an update made through
+// v1alpha1.
+const ConversionDataAnnotation = "cloudflare-operator.io/conversion-data"
+
+// tunnelConversionData is the content of ConversionDataAnnotation.
+type tunnelConversionData struct {
+	Spec   v1alpha2.TunnelSpec   `json:"spec"`
+	Status v1alpha2.TunnelStatus `json:"status"`
+}
+
 // Patch conversion type
 type V1alpha1Tov1alpha2PatchSpecTemplateSpecContainer struct {
 	Name   string         `json:"name,omitempty"`
@@ -53,17 +70,66 @@ func (src *Tunnel) ConvertTo(dstRaw conversion.Hub) error {
 	log.Printf("ConvertTo: Converting Tunnel from Spoke version v1alpha1 to Hub version v1alpha2;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// Implement conversion logic from v1alpha1 to v1alpha2
-	dst.ObjectMeta = src.ObjectMeta
-	if err := src.Spec.ConvertTo(&dst.Spec); err != nil {
+	return convertTunnelToHub(&src.ObjectMeta, &src.Spec, &src.Status, &dst.ObjectMeta, &dst.Spec, &dst.Status)
+}
+
+// convertTunnelToHub converts a v1alpha1 Tunnel or ClusterTunnel to v1alpha2,
+// restoring the fields kept in ConversionDataAnnotation.
+func convertTunnelToHub(
+	srcMeta *metav1.ObjectMeta, srcSpec *TunnelSpec, srcStatus *TunnelStatus,
+	dstMeta *metav1.ObjectMeta, dstSpec *v1alpha2.TunnelSpec, dstStatus *v1alpha2.TunnelStatus,
+) error {
+	*dstMeta = *srcMeta
+	if err := srcSpec.ConvertTo(dstSpec); err != nil {
 		return err
 	}
-	if err := src.Status.ConvertTo(&dst.Status); err != nil {
+	if err := srcStatus.ConvertTo(dstStatus); err != nil {
 		return err
 	}
+
+	raw, ok := srcMeta.Annotations[ConversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+	dstMeta.Annotations = maps.Clone(srcMeta.Annotations)
+	delete(dstMeta.Annotations, ConversionDataAnnotation)
+	if len(dstMeta.Annotations) == 0 {
+		dstMeta.Annotations = nil
+	}
+
+	data := &tunnelConversionData{}
+	if err := json.Unmarshal([]byte(raw), data); err != nil {
+		log.Printf("Ignoring invalid %s annotation on %s/%s: %v", ConversionDataAnnotation, srcMeta.Namespace, srcMeta.Name, err)
+		return nil
+	}
+
+	// Fields v1alpha1 does not have
+	dstSpec.EnableWarpRouting = data.Spec.EnableWarpRouting
+	dstSpec.Cloudflare.CredentialsRef = data.Spec.Cloudflare.CredentialsRef
+	dstSpec.Cloudflare.ZoneId = data.Spec.Cloudflare.ZoneId
+
+	// The deploy patch may hold more than v1alpha1 can express; keep it unless
+	// the fields taken from it were changed through v1alpha1
+	previous := TunnelSpec{}
+	if err := previous.ConvertFrom(data.Spec); err == nil && sameDeploymentFields(&previous, srcSpec) {
+		dstSpec.DeployPatch = data.Spec.DeployPatch
+	}
+
+	previousStatus := TunnelStatus{}
+	if err := previousStatus.ConvertFrom(data.Status); err == nil && previousStatus == *srcStatus {
+		*dstStatus = *data.Status.DeepCopy()
+	}
 	return nil
 }
 
+// sameDeploymentFields returns whether a and b set up the cloudflared
+// deployment the same way.
+func sameDeploymentFields(a, b *TunnelSpec) bool {
+	return a.Size == b.Size && a.Image == b.Image &&
+		equality.Semantic.DeepEqual(a.NodeSelectors, b.NodeSelectors) &&
+		equality.Semantic.DeepEqual(a.Tolerations, b.Tolerations)
+}
+
 func (src TunnelSpec) ConvertTo(dst *v1alpha2.TunnelSpec) error {
 
 	if (src.NewTunnel != NewTunnel{}) {
@@ -151,17 +217,82 @@ func (dst *Tunnel) ConvertFrom(srcRaw conversion.Hub) error {
 	log.Printf("ConvertFrom: Converting Tunnel from Hub version v1alpha2 to Spoke version v1alpha1;"+
 		"source: %s/%s, target: %s/%s", src.Namespace, src.Name, dst.Namespace, dst.Name)
 
-	// Implement conversion logic from v1alpha2 to v1alpha1
-	dst.ObjectMeta = src.ObjectMeta
-	if err := dst.Spec.ConvertFrom(src.Spec); err != nil {
+	return convertTunnelFromHub(&src.ObjectMeta, &src.Spec, &src.Status, &dst.ObjectMeta, &dst.Spec, &dst.Status)
+}
+
+// convertTunnelFromHub converts a v1alpha2 Tunnel or ClusterTunnel to
+// v1alpha1. When converting the result back would lose data, the v1alpha2
+// spec and status are kept in ConversionDataAnnotation.
+func convertTunnelFromHub(
+	srcMeta *metav1.ObjectMeta, srcSpec *v1alpha2.TunnelSpec, srcStatus *v1alpha2.TunnelStatus,
+	dstMeta *metav1.ObjectMeta, dstSpec *TunnelSpec, dstStatus *TunnelStatus,
+) error {
+	*dstMeta = *srcMeta
+	if err := dstSpec.ConvertFrom(*srcSpec); err != nil {
 		return err
 	}
-	if err := dst.Status.ConvertFrom(src.Status); err != nil {
+	if err := dstStatus.ConvertFrom(*srcStatus); err != nil {
 		return err
 	}
+
+	lossy, err := tunnelConversionLossy(dstSpec, dstStatus, srcSpec, srcStatus)
+	if err != nil || !lossy {
+		return err
+	}
+	data, err := json.Marshal(tunnelConversionData{Spec: *srcSpec, Status: *srcStatus})
+	if err != nil {
+		return fmt.Errorf("marshal conversion data: %w", err)
+	}
+	dstMeta.Annotations = maps.Clone(srcMeta.Annotations)
+	if dstMeta.Annotations == nil {
+		dstMeta.Annotations = map[string]string{}
+	}
+	dstMeta.Annotations[ConversionDataAnnotation] = string(data)
 	return nil
 }
 
+// tunnelConversionLossy returns whether converting the v1alpha1 spec and status
+// back to v1alpha2 differs from the v1alpha2 spec and status they came from.
+func tunnelConversionLossy(
+	spec *TunnelSpec, status *TunnelStatus, hubSpec *v1alpha2.TunnelSpec, hubStatus *v1alpha2.TunnelStatus,
+) (bool, error) {
+	convertedSpec := v1alpha2.TunnelSpec{}
+	if err := spec.ConvertTo(&convertedSpec); err != nil {
+		return false, err
+	}
+	convertedStatus := v1alpha2.TunnelStatus{}
+	if err := status.ConvertTo(&convertedStatus); err != nil {
+		return false, err
+	}
+	if !equality.Semantic.DeepEqual(convertedStatus, *hubStatus) {
+		return true, nil
+	}
+
+	// Deploy patches are compared by content, as formatting is not kept
+	samePatch, err := sameDeployPatch(convertedSpec.DeployPatch, hubSpec.DeployPatch)
+	if err != nil || !samePatch {
+		return true, nil
+	}
+	convertedSpec.DeployPatch = hubSpec.DeployPatch
+	return !equality.Semantic.DeepEqual(convertedSpec, *hubSpec), nil
+}
+
+// sameDeployPatch returns whether two deploy patches have the same content.
+// An empty patch is the same as an empty object.
+func sameDeployPatch(a, b string) (bool, error) {
+	var objA, objB map[string]any
+	if err := yaml.Unmarshal([]byte(a), &objA); err != nil {
+		return false, err
+	}
+	if err := yaml.Unmarshal([]byte(b), &objB); err != nil {
+		return false, err
+	}
+	if len(objA) == 0 && len(objB) == 0 {
+		return true, nil
+	}
+	return equality.Semantic.DeepEqual(objA, objB), nil
+}
+
 func (dst *TunnelSpec) ConvertFrom(src v1alpha2.TunnelSpec) error {
 	if src.NewTunnel != nil {
 		dst.NewTunnel = NewTunnel(*src.NewTunnel)
@@ -191,8 +322,12 @@ func (dst *TunnelSpec) ConvertFrom(src v1alpha2.TunnelSpec) error {
 		return err
 	}
 
+	// A patch without replicas runs the v1alpha1 default of one replica
+	dst.Size = 1
 	if spec := patch.Spec; spec != nil {
-		dst.Size = spec.Replicas
+		if spec.Replicas != 0 {
+			dst.Size = spec.Replicas
+		}
 
 		if template := spec.Template; template != nil {
 			if spec := template.Spec; spec != nil {
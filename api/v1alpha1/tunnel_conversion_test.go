@@ -119,7 +119,7 @@ func TestUpgradeTunnel(t *testing.T) {
 	}
 
 	convertedNewTunnel.Spec.DeployPatch = ""
-	if equality.Semantic.DeepEqualWithNilDifferentFromEmpty(convertedNewTunnel, sampleFilledNewTunnel) {
+	if !equality.Semantic.DeepEqualWithNilDifferentFromEmpty(*convertedNewTunnel, sampleFilledNewTunnel) {
 		converted, err := json.Marshal(convertedNewTunnel)
 		if err != nil {
 			t.Errorf("Failed to marshal converted tunnel")
@@ -146,7 +146,7 @@ func TestDowngradeTunnel(t *testing.T) {
 		t.Fatalf("ConvertFrom failed: %v", err)
 	}
 
-	if equality.Semantic.DeepEqualWithNilDifferentFromEmpty(convertedOldTunnel, sampleFilledOldTunnel) {
+	if !equality.Semantic.DeepEqualWithNilDifferentFromEmpty(*convertedOldTunnel, sampleFilledOldTunnel) {
 		converted, err := json.Marshal(convertedOldTunnel)
 		if err != nil {
 			t.Errorf("Failed to marshal converted tunnel")
@@ -158,3 +158,150 @@ func TestDowngradeTunnel(t *testing.T) {
 		t.Errorf("Failed to validate converted object.\nExpected: \n%+v\nConverted: \n%+v", string(expected), string(converted))
 	}
 }
+
+// assertEqualObjects fails the test when converted differs from expected.
+func assertEqualObjects(t *testing.T, expected, converted any) {
+	t.Helper()
+	if equality.Semantic.DeepEqual(expected, converted) {
+		return
+	}
+	expectedJSON, _ := json.Marshal(expected)
+	convertedJSON, _ := json.Marshal(converted)
+	t.Errorf("Round trip lost data.\nExpected: \n%s\nConverted: \n%s", expectedJSON, convertedJSON)
+}
+
+func TestTunnelRoundTrip(t *testing.T) {
+	existing := sampleFilledOldTunnel.DeepCopy()
+	existing.Spec.NewTunnel = v1alpha1.NewTunnel{}
+	existing.Spec.ExistingTunnel = v1alpha1.ExistingTunnel{Id: "tunnel-id", Name: "tunnel-name"}
+	defaults := sampleFilledOldTunnel.DeepCopy()
+	defaults.Spec.Size = 1
+	defaults.Spec.NodeSelectors = nil
+	defaults.Spec.Tolerations = nil
+	defaults.Annotations = map[string]string{"team": "platform"}
+
+	tests := map[string]*v1alpha1.Tunnel{
+		"all fields":      sampleFilledOldTunnel.DeepCopy(),
+		"existing tunnel": existing,
+		"one replica":     defaults,
+	}
+	for name, original := range tests {
+		t.Run(name, func(t *testing.T) {
+			hub := &v1alpha2.Tunnel{}
+			if err := original.ConvertTo(hub); err != nil {
+				t.Fatalf("ConvertTo failed: %v", err)
+			}
+			converted := &v1alpha1.Tunnel{}
+			if err := converted.ConvertFrom(hub); err != nil {
+				t.Fatalf("ConvertFrom failed: %v", err)
+			}
+			assertEqualObjects(t, original, converted)
+		})
+	}
+}
+
+func TestClusterTunnelRoundTrip(t *testing.T) {
+	original := &v1alpha1.ClusterTunnel{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-tunnel"},
+		Spec:       *sampleFilledOldTunnel.Spec.DeepCopy(),
+		Status:     sampleFilledOldTunnel.Status,
+	}
+
+	hub := &v1alpha2.ClusterTunnel{}
+	if err := original.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	converted := &v1alpha1.ClusterTunnel{}
+	if err := converted.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	assertEqualObjects(t, original, converted)
+}
+
+// newHubOnlyTunnel returns a v1alpha2 Tunnel using fields v1alpha1 cannot represent.
+func newHubOnlyTunnel() *v1alpha2.Tunnel {
+	hub := sampleFilledNewTunnel.DeepCopy()
+	hub.Spec.DeployPatch = `{"spec":{"replicas":2,"template":{"spec":{"containers":` +
+		`[{"name":"cloudflared","image":"cloudflare/cloudflared:latest","resources":{"limits":{"cpu":"1"}}}]}}}}`
+	hub.Spec.EnableWarpRouting = true
+	hub.Spec.Cloudflare.CredentialsRef = &v1alpha2.CloudflareCredentialsRef{Name: "production"}
+	hub.Spec.Cloudflare.ZoneId = "zone-id"
+	hub.Status.State = "active"
+	hub.Status.ObservedGeneration = 3
+	hub.Status.SyncedHostnames = []string{"app.example.test"}
+	hub.Status.Conditions = []metav1.Condition{{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Reconciled",
+		LastTransitionTime: metav1.NewTime(metav1.Now().Rfc3339Copy().Time),
+	}}
+	return hub
+}
+
+func TestHubRoundTripKeepsV1alpha2Fields(t *testing.T) {
+	hub := newHubOnlyTunnel()
+
+	spoke := &v1alpha1.Tunnel{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	if _, ok := spoke.Annotations[v1alpha1.ConversionDataAnnotation]; !ok {
+		t.Fatalf("Expected the %s annotation on the v1alpha1 object", v1alpha1.ConversionDataAnnotation)
+	}
+	if hub.Annotations != nil {
+		t.Errorf("ConvertFrom changed the annotations of the v1alpha2 object: %v", hub.Annotations)
+	}
+
+	converted := &v1alpha2.Tunnel{}
+	if err := spoke.ConvertTo(converted); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	assertEqualObjects(t, hub, converted)
+}
+
+func TestHubRoundTripAppliesV1alpha1Changes(t *testing.T) {
+	hub := newHubOnlyTunnel()
+
+	spoke := &v1alpha1.Tunnel{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	spoke.Spec.Size = 5
+	spoke.Spec.Protocol = "quic"
+
+	converted := &v1alpha2.Tunnel{}
+	if err := spoke.ConvertTo(converted); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	if converted.Spec.Protocol != "quic" {
+		t.Errorf("Expected protocol quic, got %q", converted.Spec.Protocol)
+	}
+	if !converted.Spec.EnableWarpRouting || converted.Spec.Cloudflare.CredentialsRef == nil ||
+		converted.Spec.Cloudflare.ZoneId != "zone-id" {
+		t.Errorf("v1alpha2 fields were not restored: %+v", converted.Spec)
+	}
+	patch := &v1alpha1.V1alpha1Tov1alpha2Patch{}
+	if err := yaml.Unmarshal([]byte(converted.Spec.DeployPatch), patch); err != nil {
+		t.Fatalf("Failed to parse deploy patch: %v", err)
+	}
+	if patch.Spec == nil || patch.Spec.Replicas != 5 {
+		t.Errorf("Expected the deploy patch to be regenerated with 5 replicas, got %s", converted.Spec.DeployPatch)
+	}
+	assertEqualObjects(t, hub.Status, converted.Status)
+}
+
+func TestLosslessConversionHasNoAnnotation(t *testing.T) {
+	hub := &v1alpha2.Tunnel{}
+	if err := sampleFilledOldTunnel.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+
+	spoke := &v1alpha1.Tunnel{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	if _, ok := spoke.Annotations[v1alpha1.ConversionDataAnnotation]; ok {
+		t.Errorf("Unexpected %s annotation: %s", v1alpha1.ConversionDataAnnotation,
+			spoke.Annotations[v1alpha1.ConversionDataAnnotation])
+	}
+}
@@ -5,6 +5,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // TunnelBindingSubject defines the subject TunnelBinding connects to the Tunnel
@@ -38,6 +39,13 @@ type TunnelBindingSubjectSpec struct {
 	// +kubebuilder:validation:Optional
 	Path string `json:"path,omitempty"`
 
+	// Port selects the Service port by name or number.
+	// Defaults to the first port of the Service.
+	// For a headless Service, the target port of the selected port is used, since cloudflared
+	// connects to the pods directly.
+	// +kubebuilder:validation:Optional
+	Port intstr.IntOrString `json:"port,omitempty"`
+
 	// Target specified where the tunnel should proxy to.
 	// Defaults to the form of <protocol>://<service.metadata.name>.<service.metadata.namespace>.svc:<port>
 	// +kubebuilder:validation:Optional
@@ -80,6 +88,74 @@ type TunnelBindingSubjectSpec struct {
 	// +kubebuilder:default:=""
 	// +kubebuilder:validation:Enum:="";"socks"
 	ProxyType string `json:"proxyType,omitempty"`
+
+	// OriginRequest tunes how cloudflared connects to the service.
+	// +kubebuilder:validation:Optional
+	OriginRequest *TunnelBindingOriginRequest `json:"originRequest,omitempty"`
+
+	// Access protects the hostname of the subject with Cloudflare Access.
+	// +kubebuilder:validation:Optional
+	Access *TunnelBindingAccess `json:"access,omitempty"`
+}
+
+// TunnelBindingAccess protects the hostname of a subject with Cloudflare Access, either
+// with an existing AccessApplication or with one the operator creates for the hostname.
+// The AccessApplication is attached once the hostname is routed through the tunnel.
+// +kubebuilder:validation:XValidation:rule="has(self.applicationRef) != has(self.policies)",message="exactly one of applicationRef or policies must be specified"
+type TunnelBindingAccess struct {
+	// ApplicationRef is the name of an existing AccessApplication in the TunnelBinding's
+	// namespace. It must protect the hostname of the subject.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	ApplicationRef string `json:"applicationRef,omitempty"`
+
+	// Policies are the names of the AccessPolicy resources attached to the self-hosted
+	// AccessApplication the operator creates for the hostname.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	Policies []string `json:"policies,omitempty"`
+
+	// DeletionPolicy of the created AccessApplication.
+	// Delete: The Access application is deleted from Cloudflare with the subject.
+	// Orphan: The Access application is left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// TunnelBindingOriginRequest holds the cloudflared connection settings of a subject.
+// Timeouts are durations such as "30s" and must be greater than 0 and at most 1h.
+type TunnelBindingOriginRequest struct {
+	// ConnectTimeout is the timeout for establishing a connection to the service.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	ConnectTimeout string `json:"connectTimeout,omitempty"`
+
+	// TLSTimeout is the timeout for completing the TLS handshake with the service.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	TLSTimeout string `json:"tlsTimeout,omitempty"`
+
+	// TCPKeepAlive is the interval of TCP keep-alive probes sent to the service.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	TCPKeepAlive string `json:"tcpKeepAlive,omitempty"`
+
+	// KeepAliveTimeout is how long an idle connection to the service is kept open.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|ms|s|m|h))+$`
+	KeepAliveTimeout string `json:"keepAliveTimeout,omitempty"`
+
+	// KeepAliveConnections is the maximum number of idle connections kept open to the service.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	KeepAliveConnections *int `json:"keepAliveConnections,omitempty"`
+
+	// NoHappyEyeballs disables the Happy Eyeballs algorithm for IPv4/IPv6 fallback.
+	// +kubebuilder:validation:Optional
+	NoHappyEyeballs bool `json:"noHappyEyeballs,omitempty"`
 }
 
 // TunnelRef defines the Tunnel TunnelBinding connects to
@@ -105,6 +181,26 @@ type ServiceInfo struct {
 	Target string `json:"target"`
 }
 
+// TunnelBindingSubjectStatus reports how a subject of the TunnelBinding was resolved
+type TunnelBindingSubjectStatus struct {
+	// Name of the subject
+	Name string `json:"name"`
+	// Hostname the subject is served on
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+	// Service is the resolved service URL cloudflared proxies to
+	// +optional
+	Service string `json:"service,omitempty"`
+	// Resolved is true when the service URL of the subject could be determined
+	Resolved bool `json:"resolved"`
+	// Configured is true when the subject was written to the tunnel configuration.
+	// Unresolved subjects are left out of the configuration.
+	Configured bool `json:"configured"`
+	// Message explains why the subject could not be resolved
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // TunnelBindingStatus defines the observed state of TunnelBinding
 type TunnelBindingStatus struct {
 	// To show on the kubectl cli
@@ -117,11 +213,48 @@ type TunnelBindingStatus struct {
 	// +kubebuilder:validation:Optional
 	SyncedHostnames []string `json:"syncedHostnames,omitempty"`
 
+	// Subjects reports the resolution result of each subject, in the order of the subjects
+	// +optional
+	Subjects []TunnelBindingSubjectStatus `json:"subjects,omitempty"`
+
 	// ConfigVersion is the tunnel configuration version after last sync
 	// +kubebuilder:validation:Optional
 	ConfigVersion int `json:"configVersion,omitempty"`
+
+	// Conditions represent the latest available observations of the TunnelBinding's state.
+	// The Ready condition is True when every subject is configured on the tunnel.
+	// The Conflict condition names DNS records that exist but are not managed by this tunnel.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+const (
+	// TunnelBindingConditionReady is True when every subject is resolved and written
+	// to the tunnel configuration.
+	TunnelBindingConditionReady = "Ready"
+
+	// TunnelBindingReasonSubjectsConfigured means every subject is configured on the tunnel.
+	TunnelBindingReasonSubjectsConfigured = "SubjectsConfigured"
+	// TunnelBindingReasonUnresolvedSubjects means some subjects could not be resolved
+	// and were left out of the tunnel configuration; the others are configured.
+	TunnelBindingReasonUnresolvedSubjects = "UnresolvedSubjects"
+	// TunnelBindingReasonConfigurationFailed means the tunnel configuration could not be written.
+	TunnelBindingReasonConfigurationFailed = "ConfigurationFailed"
+
+	// TunnelBindingConditionConflict is True when a hostname has a DNS record
+	// that the binding refuses to overwrite.
+	TunnelBindingConditionConflict = "Conflict"
+
+	// TunnelBindingReasonUnmanagedRecord means a record exists without a managed TXT marker.
+	TunnelBindingReasonUnmanagedRecord = "UnmanagedRecord"
+	// TunnelBindingReasonOwnedByOtherTunnel means the managed TXT marker points to another tunnel.
+	TunnelBindingReasonOwnedByOtherTunnel = "OwnedByOtherTunnel"
+	// TunnelBindingReasonNoConflict means every hostname is owned by the binding's tunnel.
+	TunnelBindingReasonNoConflict = "NoConflict"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="FQDNs",type=string,JSONPath=`.status.hostnames`
@@ -9,6 +9,7 @@ package v1alpha1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -291,7 +292,9 @@ func (in *TunnelBinding) DeepCopyInto(out *TunnelBinding) {
 	if in.Subjects != nil {
 		in, out := &in.Subjects, &out.Subjects
 		*out = make([]TunnelBindingSubject, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	out.TunnelRef = in.TunnelRef
 	in.Status.DeepCopyInto(&out.Status)
@@ -315,6 +318,26 @@ func (in *TunnelBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TunnelBindingAccess) DeepCopyInto(out *TunnelBindingAccess) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelBindingAccess.
+func (in *TunnelBindingAccess) DeepCopy() *TunnelBindingAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(TunnelBindingAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TunnelBindingList) DeepCopyInto(out *TunnelBindingList) {
 	*out = *in
@@ -347,6 +370,26 @@ func (in *TunnelBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TunnelBindingOriginRequest) DeepCopyInto(out *TunnelBindingOriginRequest) {
+	*out = *in
+	if in.KeepAliveConnections != nil {
+		in, out := &in.KeepAliveConnections, &out.KeepAliveConnections
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelBindingOriginRequest.
+func (in *TunnelBindingOriginRequest) DeepCopy() *TunnelBindingOriginRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TunnelBindingOriginRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TunnelBindingStatus) DeepCopyInto(out *TunnelBindingStatus) {
 	*out = *in
@@ -360,6 +403,18 @@ func (in *TunnelBindingStatus) DeepCopyInto(out *TunnelBindingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]TunnelBindingSubjectStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelBindingStatus.
@@ -375,7 +430,7 @@ func (in *TunnelBindingStatus) DeepCopy() *TunnelBindingStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TunnelBindingSubject) DeepCopyInto(out *TunnelBindingSubject) {
 	*out = *in
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelBindingSubject.
@@ -391,6 +446,17 @@ func (in *TunnelBindingSubject) DeepCopy() *TunnelBindingSubject {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TunnelBindingSubjectSpec) DeepCopyInto(out *TunnelBindingSubjectSpec) {
 	*out = *in
+	out.Port = in.Port
+	if in.OriginRequest != nil {
+		in, out := &in.OriginRequest, &out.OriginRequest
+		*out = new(TunnelBindingOriginRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(TunnelBindingAccess)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelBindingSubjectSpec.
@@ -403,6 +469,21 @@ func (in *TunnelBindingSubjectSpec) DeepCopy() *TunnelBindingSubjectSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TunnelBindingSubjectStatus) DeepCopyInto(out *TunnelBindingSubjectStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TunnelBindingSubjectStatus.
+func (in *TunnelBindingSubjectStatus) DeepCopy() *TunnelBindingSubjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TunnelBindingSubjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TunnelList) DeepCopyInto(out *TunnelList) {
 	*out = *in
@@ -8,6 +8,7 @@ import (
 )
 
 // AccessApplicationSpec defines the desired state of AccessApplication
+// +kubebuilder:validation:XValidation:rule="self.type in ['app_launcher','dash_sso'] || has(self.domain)",message="domain is required unless type is app_launcher or dash_sso"
 type AccessApplicationSpec struct {
 	// Name of the Access Application in Cloudflare.
 	// If not specified, the Kubernetes resource name will be used.
@@ -16,8 +17,10 @@ type AccessApplicationSpec struct {
 	Name string `json:"name,omitempty"`
 
 	// Domain is the primary domain/URL for the application.
-	// +kubebuilder:validation:Required
-	Domain string `json:"domain"`
+	// For bookmark applications it is the bookmark URL. It is required for all
+	// types except app_launcher and dash_sso, which do not accept a domain.
+	// +kubebuilder:validation:Optional
+	Domain string `json:"domain,omitempty"`
 
 	// SelfHostedDomains is a list of additional domains for the application.
 	// This allows protecting multiple domains with a single Access Application.
@@ -128,10 +131,23 @@ type AccessApplicationSpec struct {
 	// +kubebuilder:validation:Optional
 	Tags []string `json:"tags,omitempty"`
 
+	// AutoCreateTags creates tags that do not exist in the account yet before
+	// assigning them to the application.
+	// When disabled, unknown tags put the application into an error state with
+	// reason UnknownTags instead.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	AutoCreateTags *bool `json:"autoCreateTags,omitempty"`
+
 	// CustomPages is a list of custom page IDs to use for the application.
 	// +kubebuilder:validation:Optional
 	CustomPages []string `json:"customPages,omitempty"`
 
+	// CustomPageRefs references custom pages by AccessCustomPage resource or Cloudflare name.
+	// The resolved IDs are merged with customPages.
+	// +kubebuilder:validation:Optional
+	CustomPageRefs []AccessCustomPageRef `json:"customPageRefs,omitempty"`
+
 	// GatewayRules is a list of Gateway rule IDs associated with the application.
 	// +kubebuilder:validation:Optional
 	GatewayRules []string `json:"gatewayRules,omitempty"`
@@ -156,6 +172,12 @@ type AccessApplicationSpec struct {
 	// +kubebuilder:validation:Optional
 	TargetContexts []AccessInfrastructureTargetContext `json:"targetContexts,omitempty"`
 
+	// ClientCertificate requires clients to present a certificate issued by a specific CA (mTLS).
+	// The CA is associated with the application's hostnames, so the certificate and
+	// commonName rules of its policies only accept certificates issued by it.
+	// +kubebuilder:validation:Optional
+	ClientCertificate *AccessApplicationClientCertificate `json:"clientCertificate,omitempty"`
+
 	// Policies defines the inline access policies for this application (DEPRECATED).
 	// Use ReusablePolicyRefs to reference AccessPolicy CRDs instead.
 	// This field will be removed in a future version.
@@ -187,6 +209,20 @@ type AccessApplicationSpec struct {
 	// Cloudflare contains the Cloudflare API credentials and account information.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The Access application will be deleted from Cloudflare.
+	// Orphan: The Access application will be left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the Access application from Cloudflare is retried.
+	// After it, the finalizer is removed and the application, with the hostnames it protects, may stay in Cloudflare.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // ReusablePolicyRef references a reusable AccessPolicy resource.
@@ -216,8 +252,9 @@ type ReusablePolicyRef struct {
 	// +kubebuilder:validation:MaxLength=255
 	CloudflareName string `json:"cloudflareName,omitempty"`
 
-	// Precedence overrides the policy's default precedence when attached to this application.
-	// If not specified, the policy's own precedence value is used.
+	// Precedence overrides the position of this policy when ordering the references.
+	// References are sorted by this value, or by their 1-based position in the list
+	// when not specified; ties keep list order.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=1
 	Precedence *int `json:"precedence,omitempty"`
@@ -285,12 +322,14 @@ type AccessApplicationCorsHeaders struct {
 	AllowAllOrigins bool `json:"allowAllOrigins,omitempty"`
 
 	// AllowCredentials allows credentials.
+	// Cannot be combined with allowAllOrigins or a "*" origin, which browsers reject.
 	// +kubebuilder:validation:Optional
 	AllowCredentials bool `json:"allowCredentials,omitempty"`
 
 	// MaxAge is the maximum age for CORS preflight cache in seconds.
+	// Set to -1 to disable caching of preflight responses.
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Minimum=-1
 	// +kubebuilder:validation:Maximum=86400
 	MaxAge int `json:"maxAge,omitempty"`
 }
@@ -790,6 +829,92 @@ type ResolvedReusablePolicyStatus struct {
 	Decision string `json:"decision,omitempty"`
 }
 
+// AccessApplicationClientCertificate references the CA that issues client certificates.
+// Exactly one of caSecretRef or cloudflareId must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.caSecretRef) != has(self.cloudflareId)",message="exactly one of caSecretRef or cloudflareId must be specified"
+type AccessApplicationClientCertificate struct {
+	// CASecretRef references a Secret holding the PEM-encoded CA certificate.
+	// The namespace defaults to the namespace of the application. The operator
+	// uploads the CA as an Access mTLS certificate and deletes it with the application.
+	// +kubebuilder:validation:Optional
+	CASecretRef *SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// CloudflareID is the ID of an existing Access mTLS certificate.
+	// The operator only adds the application's hostnames to it, and removes them
+	// again when the application is deleted.
+	// +kubebuilder:validation:Optional
+	CloudflareID string `json:"cloudflareId,omitempty"`
+
+	// Name is the name of the uploaded mTLS certificate.
+	// Defaults to the application name with a "-client-ca" suffix.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+}
+
+// AccessApplicationClientCertificateStatus describes the mTLS certificate of the application.
+type AccessApplicationClientCertificateStatus struct {
+	// CertificateID is the ID of the Access mTLS certificate.
+	CertificateID string `json:"certificateId"`
+
+	// Managed is true when the operator uploaded the certificate from caSecretRef.
+	// +kubebuilder:validation:Optional
+	Managed bool `json:"managed,omitempty"`
+
+	// CertificateHash is the SHA-256 hash of the uploaded PEM, used to detect a rotated CA.
+	// +kubebuilder:validation:Optional
+	CertificateHash string `json:"certificateHash,omitempty"`
+
+	// Fingerprint is the fingerprint of the certificate reported by Cloudflare.
+	// +kubebuilder:validation:Optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// AssociatedHostnames are the application hostnames associated with the certificate.
+	// +kubebuilder:validation:Optional
+	AssociatedHostnames []string `json:"associatedHostnames,omitempty"`
+
+	// ExpiresOn is when the CA certificate expires.
+	// +kubebuilder:validation:Optional
+	ExpiresOn *metav1.Time `json:"expiresOn,omitempty"`
+
+	// PendingReleaseIDs are uploaded client CAs that were replaced by a rotated CA
+	// but could not be deleted yet. Their deletion is retried on every reconcile.
+	// +kubebuilder:validation:Optional
+	PendingReleaseIDs []string `json:"pendingReleaseIds,omitempty"`
+}
+
+// AccessApplicationSCIMStatus summarizes the recent SCIM updates of the
+// identity provider used for SCIM provisioning.
+type AccessApplicationSCIMStatus struct {
+	// LastSync is the time of the most recent successful SCIM update.
+	// +kubebuilder:validation:Optional
+	LastSync *metav1.Time `json:"lastSync,omitempty"`
+
+	// SyncedUsers is the number of successful user updates among the recent updates.
+	// +kubebuilder:validation:Optional
+	SyncedUsers int `json:"syncedUsers,omitempty"`
+
+	// SyncedGroups is the number of successful group updates among the recent updates.
+	// +kubebuilder:validation:Optional
+	SyncedGroups int `json:"syncedGroups,omitempty"`
+
+	// FailedUpdates is the number of failed updates among the recent updates.
+	// +kubebuilder:validation:Optional
+	FailedUpdates int `json:"failedUpdates,omitempty"`
+
+	// LastError is the error of the most recent failed update.
+	// +kubebuilder:validation:Optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastErrorTime is the time of the most recent failed update.
+	// +kubebuilder:validation:Optional
+	LastErrorTime *metav1.Time `json:"lastErrorTime,omitempty"`
+
+	// LastChecked is when the SCIM status was last fetched from Cloudflare.
+	// +kubebuilder:validation:Optional
+	LastChecked *metav1.Time `json:"lastChecked,omitempty"`
+}
+
 // AccessApplicationStatus defines the observed state of AccessApplication
 type AccessApplicationStatus struct {
 	// ApplicationID is the Cloudflare ID of the Access Application.
@@ -837,6 +962,15 @@ type AccessApplicationStatus struct {
 	// +kubebuilder:validation:Optional
 	ResolvedPolicyIDs []string `json:"resolvedPolicyIds,omitempty"`
 
+	// SCIM reports recent SCIM provisioning activity.
+	// Only set when SCIM provisioning is enabled with an identity provider.
+	// +kubebuilder:validation:Optional
+	SCIM *AccessApplicationSCIMStatus `json:"scim,omitempty"`
+
+	// ClientCertificate describes the mTLS certificate required by the application.
+	// +kubebuilder:validation:Optional
+	ClientCertificate *AccessApplicationClientCertificateStatus `json:"clientCertificate,omitempty"`
+
 	// Conditions represent the latest available observations.
 	// +kubebuilder:validation:Optional
 	// +listType=map
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessCustomPageSpec defines the desired state of AccessCustomPage.
+type AccessCustomPageSpec struct {
+	// Name of the custom page in Cloudflare.
+	// If not specified, the Kubernetes resource name will be used.
+	// An existing page with this name is adopted.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+
+	// Type is the Access page this custom page replaces.
+	// forbidden: shown when a user is blocked by a policy.
+	// identity_denied: shown when the identity provider denies the user.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=forbidden;identity_denied
+	Type string `json:"type"`
+
+	// CustomHTML is the HTML content of the page.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	CustomHTML string `json:"customHTML"`
+
+	// Cloudflare contains the Cloudflare API credentials and account information.
+	// +kubebuilder:validation:Required
+	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The custom page will be deleted from Cloudflare.
+	// Orphan: The custom page will be left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long a failed deletion from Cloudflare is retried.
+	// Once it has passed, the finalizer is removed and the Cloudflare resource may be orphaned.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
+}
+
+// AccessCustomPageStatus defines the observed state of AccessCustomPage.
+type AccessCustomPageStatus struct {
+	// PageID is the Cloudflare ID of the custom page.
+	// Use it in the customPages of an AccessApplication, or reference the
+	// page through customPageRefs.
+	// +kubebuilder:validation:Optional
+	PageID string `json:"pageId,omitempty"`
+
+	// AccountID is the Cloudflare Account ID.
+	// +kubebuilder:validation:Optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// State indicates the current state of the custom page.
+	// Possible values: Ready, Error
+	// +kubebuilder:validation:Optional
+	State string `json:"state,omitempty"`
+
+	// Conditions represent the latest available observations.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=accesspage
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="PageID",type=string,JSONPath=`.status.pageId`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AccessCustomPage is the Schema for the accesscustompages API.
+// An AccessCustomPage manages a Cloudflare Access custom page, an HTML template
+// that replaces the default forbidden or identity denied page of Access applications.
+type AccessCustomPage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessCustomPageSpec   `json:"spec,omitempty"`
+	Status AccessCustomPageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessCustomPageList contains a list of AccessCustomPage
+type AccessCustomPageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessCustomPage `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccessCustomPage{}, &AccessCustomPageList{})
+}
+
+// GetAccessCustomPageName returns the name to use in Cloudflare.
+func (a *AccessCustomPage) GetAccessCustomPageName() string {
+	if a.Spec.Name != "" {
+		return a.Spec.Name
+	}
+	return a.Name
+}
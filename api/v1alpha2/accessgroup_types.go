@@ -34,6 +34,20 @@ type AccessGroupSpec struct {
 	// Cloudflare contains the Cloudflare API credentials.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The Access group will be deleted from Cloudflare.
+	// Orphan: The Access group will be left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the Access group is retried, for example while
+	// a policy still includes it. After it, the finalizer is removed and the group may stay in Cloudflare.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // AccessGroupRule defines a single rule in an Access Group.
@@ -55,6 +69,8 @@ type AccessGroupRule struct {
 	Everyone bool `json:"everyone,omitempty"`
 
 	// IPRanges matches users from specific IP ranges.
+	// In include and exclude, each range becomes a separate Cloudflare rule and
+	// the ranges are ORed. A require rule accepts a single range.
 	// +kubebuilder:validation:Optional
 	IPRanges *AccessGroupIPRangesRule `json:"ipRanges,omitempty"`
 
@@ -63,6 +79,8 @@ type AccessGroupRule struct {
 	IPList *AccessGroupIPListRule `json:"ipList,omitempty"`
 
 	// Country matches users from specific countries.
+	// In include and exclude, each country becomes a separate Cloudflare rule and
+	// the countries are ORed. A require rule accepts a single country.
 	// +kubebuilder:validation:Optional
 	Country *AccessGroupCountryRule `json:"country,omitempty"`
 
@@ -78,7 +96,8 @@ type AccessGroupRule struct {
 	// +kubebuilder:validation:Optional
 	AnyValidServiceToken bool `json:"anyValidServiceToken,omitempty"`
 
-	// Certificate matches requests with a valid mTLS certificate.
+	// Certificate matches requests with a valid mTLS certificate issued by a CA
+	// associated with the requested hostname, such as the clientCertificate of an AccessApplication.
 	// +kubebuilder:validation:Optional
 	Certificate bool `json:"certificate,omitempty"`
 
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// AnnotationForceDelete allows deleting an AccessGroup that is still referenced
+// by other resources when set to "true".
+const AnnotationForceDelete = "cloudflare-operator.io/force-delete"
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (r *AccessGroup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&AccessGroupValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-networking-cloudflare-operator-io-v1alpha2-accessgroup,mutating=false,failurePolicy=ignore,sideEffects=None,groups=networking.cloudflare-operator.io,resources=accessgroups,verbs=delete,versions=v1alpha2,name=vaccessgroup.kb.io,admissionReviewVersions=v1
+
+// AccessGroupValidator refuses deletion of AccessGroups that are still
+// referenced by AccessApplications, AccessPolicies or other AccessGroups.
+type AccessGroupValidator struct {
+	Client client.Reader
+}
+
+var _ webhook.CustomValidator = &AccessGroupValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (*AccessGroupValidator) ValidateCreate(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (*AccessGroupValidator) ValidateUpdate(context.Context, runtime.Object, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *AccessGroupValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	group, ok := obj.(*AccessGroup)
+	if !ok {
+		return nil, fmt.Errorf("expected AccessGroup but got %T", obj)
+	}
+
+	refs, err := v.findReferences(ctx, group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check references to AccessGroup %s: %w", group.Name, err)
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	if group.Annotations[AnnotationForceDelete] == "true" {
+		return admission.Warnings{
+			fmt.Sprintf("AccessGroup %s is still referenced by %s", group.Name, strings.Join(refs, ", ")),
+		}, nil
+	}
+
+	return nil, apierrors.NewForbidden(
+		schema.GroupResource{Group: GroupVersion.Group, Resource: "accessgroups"},
+		group.Name,
+		fmt.Errorf("still referenced by %s; set annotation %s=true to delete anyway",
+			strings.Join(refs, ", "), AnnotationForceDelete))
+}
+
+// findReferences lists the resources that reference the group, formatted as
+// "Kind namespace/name" or "Kind name" for cluster-scoped kinds.
+func (v *AccessGroupValidator) findReferences(ctx context.Context, group *AccessGroup) ([]string, error) {
+	var refs []string
+
+	apps := &AccessApplicationList{}
+	if err := v.Client.List(ctx, apps); err != nil {
+		return nil, err
+	}
+	for i := range apps.Items {
+		if applicationReferencesGroup(&apps.Items[i], group) {
+			refs = append(refs, fmt.Sprintf("AccessApplication %s/%s", apps.Items[i].Namespace, apps.Items[i].Name))
+		}
+	}
+
+	policies := &AccessPolicyList{}
+	if err := v.Client.List(ctx, policies); err != nil {
+		return nil, err
+	}
+	for i := range policies.Items {
+		spec := &policies.Items[i].Spec
+		if rulesReferenceGroup(group, spec.Include, spec.Exclude, spec.Require) {
+			refs = append(refs, "AccessPolicy "+policies.Items[i].Name)
+		}
+	}
+
+	groups := &AccessGroupList{}
+	if err := v.Client.List(ctx, groups); err != nil {
+		return nil, err
+	}
+	for i := range groups.Items {
+		other := &groups.Items[i]
+		if other.Name == group.Name {
+			continue
+		}
+		if rulesReferenceGroup(group, other.Spec.Include, other.Spec.Exclude, other.Spec.Require) {
+			refs = append(refs, "AccessGroup "+other.Name)
+		}
+	}
+
+	return refs, nil
+}
+
+// applicationReferencesGroup reports whether any policy or reusable group
+// reference of the application points at the group.
+func applicationReferencesGroup(app *AccessApplication, group *AccessGroup) bool {
+	groupID := group.Status.GroupID
+	for _, policy := range app.Spec.Policies {
+		if policy.Name == group.Name ||
+			(groupID != "" && policy.GroupID == groupID) ||
+			policy.CloudflareGroupName == group.GetAccessGroupName() {
+			return true
+		}
+		if rulesReferenceGroup(group, policy.Include, policy.Exclude, policy.Require) {
+			return true
+		}
+	}
+	for _, ref := range app.Spec.ReusableGroupRefs {
+		if ref.Name == group.Name ||
+			(groupID != "" && ref.CloudflareID == groupID) ||
+			ref.CloudflareName == group.GetAccessGroupName() {
+			return true
+		}
+	}
+	return false
+}
+
+// rulesReferenceGroup reports whether any group rule matches the group's Cloudflare ID.
+func rulesReferenceGroup(group *AccessGroup, ruleSets ...[]AccessGroupRule) bool {
+	if group.Status.GroupID == "" {
+		return false
+	}
+	for _, rules := range ruleSets {
+		for _, rule := range rules {
+			if rule.Group != nil && rule.Group.ID == group.Status.GroupID {
+				return true
+			}
+		}
+	}
+	return false
+}
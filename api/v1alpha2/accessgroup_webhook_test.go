@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testGroupID = "0b2c7a3e-1f4d-4c5e-9a8b-7c6d5e4f3a2b"
+
+func newAccessGroupValidator(t *testing.T, objs ...client.Object) *AccessGroupValidator {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme: %v", err)
+	}
+	return &AccessGroupValidator{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+	}
+}
+
+func testAccessGroup() *AccessGroup {
+	return &AccessGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "engineering"},
+		Spec:       AccessGroupSpec{Name: "Engineering"},
+		Status:     AccessGroupStatus{GroupID: testGroupID},
+	}
+}
+
+func TestAccessGroupValidator_ValidateDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		objs     []client.Object
+		wantRefs []string
+	}{
+		{
+			name: "unreferenced",
+			objs: []client.Object{
+				&AccessApplication{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+					Spec: AccessApplicationSpec{
+						Policies: []AccessPolicyRef{{Name: "admins"}},
+					},
+				},
+			},
+		},
+		{
+			name: "referenced by application policy name",
+			objs: []client.Object{
+				&AccessApplication{
+					ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "apps"},
+					Spec: AccessApplicationSpec{
+						Policies: []AccessPolicyRef{{Name: "engineering"}},
+					},
+				},
+			},
+			wantRefs: []string{"AccessApplication apps/dashboard"},
+		},
+		{
+			name: "referenced by group id and cloudflare name",
+			objs: []client.Object{
+				&AccessApplication{
+					ObjectMeta: metav1.ObjectMeta{Name: "by-id", Namespace: "default"},
+					Spec: AccessApplicationSpec{
+						Policies: []AccessPolicyRef{{GroupID: testGroupID}},
+					},
+				},
+				&AccessApplication{
+					ObjectMeta: metav1.ObjectMeta{Name: "by-name", Namespace: "default"},
+					Spec: AccessApplicationSpec{
+						ReusableGroupRefs: []ReusableGroupRef{{CloudflareName: "Engineering"}},
+					},
+				},
+			},
+			wantRefs: []string{"AccessApplication default/by-id", "AccessApplication default/by-name"},
+		},
+		{
+			name: "referenced by policy and group rules",
+			objs: []client.Object{
+				&AccessPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "allow-engineering"},
+					Spec: AccessPolicySpec{
+						Include: []AccessGroupRule{{Group: &AccessGroupGroupRule{ID: testGroupID}}},
+					},
+				},
+				&AccessGroup{
+					ObjectMeta: metav1.ObjectMeta{Name: "contractors"},
+					Spec: AccessGroupSpec{
+						Exclude: []AccessGroupRule{{Group: &AccessGroupGroupRule{ID: testGroupID}}},
+					},
+				},
+			},
+			wantRefs: []string{"AccessPolicy allow-engineering", "AccessGroup contractors"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := testAccessGroup()
+			validator := newAccessGroupValidator(t, append(tt.objs, group)...)
+
+			_, err := validator.ValidateDelete(context.Background(), group)
+			if len(tt.wantRefs) == 0 {
+				if err != nil {
+					t.Fatalf("expected deletion to be allowed, got %v", err)
+				}
+				return
+			}
+			if !apierrors.IsForbidden(err) {
+				t.Fatalf("expected Forbidden error, got %v", err)
+			}
+			for _, ref := range tt.wantRefs {
+				if !strings.Contains(err.Error(), ref) {
+					t.Errorf("error %q does not mention %q", err.Error(), ref)
+				}
+			}
+		})
+	}
+}
+
+func TestAccessGroupValidator_ForceDelete(t *testing.T) {
+	group := testAccessGroup()
+	group.Annotations = map[string]string{AnnotationForceDelete: "true"}
+	validator := newAccessGroupValidator(t, group, &AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "dashboard", Namespace: "apps"},
+		Spec: AccessApplicationSpec{
+			Policies: []AccessPolicyRef{{Name: "engineering"}},
+		},
+	})
+
+	warnings, err := validator.ValidateDelete(context.Background(), group)
+	if err != nil {
+		t.Fatalf("expected forced deletion to be allowed, got %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "AccessApplication apps/dashboard") {
+		t.Errorf("expected warning listing the reference, got %v", warnings)
+	}
+}
@@ -34,6 +34,20 @@ type AccessIdentityProviderSpec struct {
 	// Cloudflare contains the Cloudflare API credentials.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The identity provider will be deleted from Cloudflare.
+	// Orphan: The identity provider will be left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the identity provider is retried, for example while
+	// an Access application still allows it. After it, the finalizer is removed and users may
+	// still sign in through the identity provider. If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // SecretKeySelector selects a key from a Secret.
@@ -192,6 +206,33 @@ type IdentityProviderScimConfig struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=automatic;reauth;no_action
 	IdentityUpdateBehavior string `json:"identityUpdateBehavior,omitempty"`
+
+	// SecretRef is the Secret the SCIM endpoint and the secret generated by
+	// Cloudflare are written to, for configuring provisioning on the IdP side.
+	// +kubebuilder:validation:Optional
+	SecretRef *ScimSecretRef `json:"secretRef,omitempty"`
+}
+
+// ScimSecretRef defines where to store the SCIM provisioning credentials.
+type ScimSecretRef struct {
+	// Name is the name of the Secret to create/update.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret.
+	// Defaults to the operator namespace.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// EndpointKey is the key for the SCIM endpoint URL.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="SCIM_ENDPOINT"
+	EndpointKey string `json:"endpointKey,omitempty"`
+
+	// SecretKey is the key for the SCIM secret.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="SCIM_SECRET"
+	SecretKey string `json:"secretKey,omitempty"`
 }
 
 // SAMLHeaderAttribute defines a SAML attribute to header mapping.
@@ -221,6 +262,14 @@ type AccessIdentityProviderStatus struct {
 	// +kubebuilder:validation:Optional
 	State string `json:"state,omitempty"`
 
+	// ScimEndpoint is the SCIM endpoint the IdP pushes provisioning updates to.
+	// +kubebuilder:validation:Optional
+	ScimEndpoint string `json:"scimEndpoint,omitempty"`
+
+	// ScimSecretName is the namespace/name of the Secret holding the SCIM credentials.
+	// +kubebuilder:validation:Optional
+	ScimSecretName string `json:"scimSecretName,omitempty"`
+
 	// Conditions represent the latest available observations.
 	// +kubebuilder:validation:Optional
 	// +listType=map
@@ -78,6 +78,20 @@ type AccessPolicySpec struct {
 	// Cloudflare contains the Cloudflare API credentials and account information.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The Access policy will be deleted from Cloudflare.
+	// Orphan: The Access policy will be left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the reusable policy is retried, for example while
+	// an Access application still references it. After it, the finalizer is removed and the policy
+	// may stay in Cloudflare. If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // ApprovalGroup defines an approval group configuration for access requests.
@@ -26,6 +26,20 @@ type AccessServiceTokenSpec struct {
 	// Cloudflare contains the Cloudflare API credentials.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The service token will be deleted from Cloudflare.
+	// Orphan: The service token will be left in Cloudflare.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the service token from Cloudflare is retried.
+	// After it, the finalizer is removed and the token may remain valid until it expires.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // ServiceTokenSecretRef defines where to store token credentials.
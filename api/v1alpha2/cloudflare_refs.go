@@ -64,6 +64,29 @@ type ReusableGroupRef struct {
 	CloudflareName string `json:"cloudflareName,omitempty"`
 }
 
+// AccessCustomPageRef references an AccessCustomPage.
+// Supports K8s name, Cloudflare UUID, or Cloudflare display name.
+// Exactly one of name, cloudflareId, or cloudflareName must be set.
+type AccessCustomPageRef struct {
+	// Name is the K8s AccessCustomPage resource name.
+	// The controller will look up the CRD and use its status.pageId.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name,omitempty"`
+
+	// CloudflareID is the Cloudflare custom page UUID.
+	// Use this to directly reference a custom page
+	// without creating a corresponding K8s AccessCustomPage resource.
+	// +kubebuilder:validation:Optional
+	CloudflareID string `json:"cloudflareId,omitempty"`
+
+	// CloudflareName is the display name of the custom page in Cloudflare.
+	// The controller will resolve this name to an ID via the Cloudflare API.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	CloudflareName string `json:"cloudflareName,omitempty"`
+}
+
 // VirtualNetworkRef references a VirtualNetwork.
 // Supports K8s name, Cloudflare UUID, or Cloudflare display name.
 // Exactly one of name, cloudflareId, or cloudflareName must be set.
@@ -24,9 +24,8 @@ type SecretReference struct {
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
-	// Namespace of the secret. Defaults to "cloudflare-operator-system"
+	// Namespace of the secret. Defaults to the operator namespace
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:default:="cloudflare-operator-system"
 	Namespace string `json:"namespace,omitempty"`
 
 	// Key in the secret for API Token (used when authType is apiToken)
@@ -47,9 +46,11 @@ type SecretReference struct {
 
 // CloudflareCredentialsSpec defines the desired state of CloudflareCredentials
 type CloudflareCredentialsSpec struct {
-	// AccountID is the Cloudflare Account ID
-	// +kubebuilder:validation:Required
-	AccountID string `json:"accountId"`
+	// AccountID pins the Cloudflare Account ID used with these credentials.
+	// When unset, the account is auto-detected if the credentials can access exactly one
+	// account; credentials with access to several accounts must set it.
+	// +kubebuilder:validation:Optional
+	AccountID string `json:"accountId,omitempty"`
 
 	// AccountName is an optional human-readable account name (for reference only)
 	// +kubebuilder:validation:Optional
@@ -100,12 +101,20 @@ type CloudflareCredentialsStatus struct {
 	// AccountName is the account name retrieved from Cloudflare API
 	// +optional
 	AccountName string `json:"accountName,omitempty"`
+
+	// AccountID is the account ID verified against the Cloudflare API
+	// +optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// TokenExpiresOn is the expiry time of the API token, if it has one
+	// +optional
+	TokenExpiresOn *metav1.Time `json:"tokenExpiresOn,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=cfcreds
-// +kubebuilder:printcolumn:name="Account ID",type=string,JSONPath=`.spec.accountId`
+// +kubebuilder:printcolumn:name="Account ID",type=string,JSONPath=`.status.accountId`
 // +kubebuilder:printcolumn:name="Auth Type",type=string,JSONPath=`.spec.authType`
 // +kubebuilder:printcolumn:name="Default",type=boolean,JSONPath=`.spec.isDefault`
 // +kubebuilder:printcolumn:name="Validated",type=boolean,JSONPath=`.status.validated`
@@ -130,6 +139,14 @@ type CloudflareCredentialsList struct {
 	Items           []CloudflareCredentials `json:"items"`
 }
 
+// GetAccountID returns the pinned account ID, or the auto-detected one when unpinned.
+func (c *CloudflareCredentials) GetAccountID() string {
+	if c.Spec.AccountID != "" {
+		return c.Spec.AccountID
+	}
+	return c.Status.AccountID
+}
+
 func init() {
 	SchemeBuilder.Register(&CloudflareCredentials{}, &CloudflareCredentialsList{})
 }
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// D1DatabaseState represents the state of the D1 database
+// +kubebuilder:validation:Enum=Pending;Ready;Error
+type D1DatabaseState string
+
+const (
+	// D1DatabaseStatePending means the database is waiting to be created
+	D1DatabaseStatePending D1DatabaseState = "Pending"
+	// D1DatabaseStateReady means the database is created and ready
+	D1DatabaseStateReady D1DatabaseState = "Ready"
+	// D1DatabaseStateError means there was an error with the database
+	D1DatabaseStateError D1DatabaseState = "Error"
+)
+
+// D1LocationHint specifies the preferred location of the primary database
+// +kubebuilder:validation:Enum=wnam;enam;weur;eeur;apac;oc
+type D1LocationHint string
+
+const (
+	// D1LocationWNAM is Western North America
+	D1LocationWNAM D1LocationHint = "wnam"
+	// D1LocationENAM is Eastern North America
+	D1LocationENAM D1LocationHint = "enam"
+	// D1LocationWEUR is Western Europe
+	D1LocationWEUR D1LocationHint = "weur"
+	// D1LocationEEUR is Eastern Europe
+	D1LocationEEUR D1LocationHint = "eeur"
+	// D1LocationAPAC is Asia-Pacific
+	D1LocationAPAC D1LocationHint = "apac"
+	// D1LocationOC is Oceania
+	D1LocationOC D1LocationHint = "oc"
+)
+
+// D1DatabaseSpec defines the desired state of D1Database
+type D1DatabaseSpec struct {
+	// Name is the name of the D1 database in Cloudflare
+	// If not specified, defaults to the Kubernetes resource name
+	// An existing database with this name is adopted only as allowed by AdoptionPolicy
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name,omitempty"`
+
+	// AdoptionPolicy defines how an existing D1 database with the name is handled
+	// IfExists: Adopt it, or create the database if there is none
+	// MustExist: Adopt it, and fail if there is none
+	// MustNotExist: Fail if it exists, and create the database otherwise
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=IfExists;MustExist;MustNotExist
+	// +kubebuilder:default=MustNotExist
+	AdoptionPolicy string `json:"adoptionPolicy,omitempty"`
+
+	// LocationHint specifies the preferred location of the primary database
+	// It only applies when the database is created
+	// +kubebuilder:validation:Optional
+	LocationHint D1LocationHint `json:"locationHint,omitempty"`
+
+	// CredentialsRef references a CloudflareCredentials resource
+	// If not specified, the default CloudflareCredentials will be used
+	// +kubebuilder:validation:Optional
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted
+	// Delete: The D1 database and its data will be deleted from Cloudflare
+	// Orphan: The D1 database will be left in Cloudflare
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long a failed deletion from Cloudflare is retried
+	// Once it has passed, the finalizer is removed and the Cloudflare resource may be orphaned
+	// If not specified, the operator's --deletion-timeout is used
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
+}
+
+// D1DatabaseStatus defines the observed state of D1Database
+type D1DatabaseStatus struct {
+	// Conditions represent the latest available observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// State represents the current state of the database
+	// +optional
+	State D1DatabaseState `json:"state,omitempty"`
+
+	// DatabaseID is the Cloudflare ID (UUID) of the database
+	// Use it as the databaseId of PagesProject D1 bindings
+	// +optional
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// DatabaseName is the actual name of the database in Cloudflare
+	// +optional
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// Version is the storage backend version of the database
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// CreatedAt is the time the database was created in Cloudflare
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// Message provides additional information about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfd1;d1db
+// +kubebuilder:printcolumn:name="Database",type=string,JSONPath=`.status.databaseName`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.databaseId`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// D1Database manages a Cloudflare D1 serverless SQL database.
+// PagesProject D1 bindings reference the database by status.databaseId.
+type D1Database struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   D1DatabaseSpec   `json:"spec,omitempty"`
+	Status D1DatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// D1DatabaseList contains a list of D1Database
+type D1DatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []D1Database `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&D1Database{}, &D1DatabaseList{})
+}
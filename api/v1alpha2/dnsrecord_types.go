@@ -220,6 +220,21 @@ type DNSRecordSpec struct {
 	// Cloudflare contains the Cloudflare API credentials.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted.
+	// Delete: The DNS record will be deleted from Cloudflare.
+	// Orphan: The DNS record will be left in Cloudflare.
+	// SourceDeletionPolicy instead applies when the source of the record is deleted.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the record from its zone is retried.
+	// After it, the finalizer is removed and the record may keep resolving in Cloudflare.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // DNSRecordData contains type-specific record data.
@@ -81,9 +81,9 @@ type RegistrantContact struct {
 // DomainConfiguration contains domain configuration settings
 type DomainConfiguration struct {
 	// AutoRenew enables automatic domain renewal
+	// If not specified, the current setting is preserved
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:default=true
-	AutoRenew bool `json:"autoRenew,omitempty"`
+	AutoRenew *bool `json:"autoRenew,omitempty"`
 
 	// Privacy enables WHOIS privacy protection
 	// +kubebuilder:validation:Optional
@@ -95,9 +95,14 @@ type DomainConfiguration struct {
 	// +kubebuilder:default=true
 	Locked bool `json:"locked,omitempty"`
 
-	// NameServers specifies custom nameservers (optional)
-	// If not specified, Cloudflare nameservers will be used
+	// NameServers specifies the nameservers of the domain (optional)
+	// If not specified, the current nameservers are preserved.
+	// At least two distinct hostnames are required. Replacing Cloudflare
+	// nameservers requires the cloudflare-operator.io/allow-nameserver-change
+	// annotation, since DNS served by Cloudflare stops resolving.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=13
 	NameServers []string `json:"nameServers,omitempty"`
 }
 
@@ -174,6 +179,10 @@ type DomainRegistrationStatus struct {
 	// +optional
 	Locked bool `json:"locked,omitempty"`
 
+	// NameServers are the current nameservers of the domain
+	// +optional
+	NameServers []string `json:"nameServers,omitempty"`
+
 	// TransferInStatus contains transfer status if applicable
 	// +optional
 	TransferInStatus string `json:"transferInStatus,omitempty"`
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Field namespaces accepted in rule expressions. An identifier that is neither a keyword,
+// a function call nor inside a set literal must be one of these namespaces or start with
+// one of them followed by a dot. Add a namespace here when Cloudflare introduces new fields.
+var (
+	// rulesetExpressionFields are the namespaces of the Rules language used by Transform and Redirect Rules.
+	rulesetExpressionFields = []string{"http", "raw.http", "ip", "ssl", "cf"}
+
+	// gatewayExpressionFields are the namespaces of Zero Trust Gateway rule expressions.
+	gatewayExpressionFields = []string{"http", "dns", "net", "identity", "device_posture", "app", "dlp"}
+)
+
+// expressionKeywords are the operators and literals shared by both expression languages.
+var expressionKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "xor": true,
+	"eq": true, "ne": true, "lt": true, "le": true, "gt": true, "ge": true,
+	"contains": true, "matches": true, "in": true, "wildcard": true, "strict": true,
+	"true": true, "false": true,
+}
+
+// ValidateRulesetExpression performs a lightweight syntactic check of a Rules language expression,
+// as used by Transform and Redirect Rules: quotes and brackets must be balanced and fields must
+// belong to a known namespace. Semantic validation is left to Cloudflare.
+func ValidateRulesetExpression(expr string) error {
+	return validateExpression(expr, rulesetExpressionFields)
+}
+
+// ValidateGatewayExpression performs the same lightweight syntactic check as ValidateRulesetExpression
+// for Zero Trust Gateway rule expressions.
+func ValidateGatewayExpression(expr string) error {
+	return validateExpression(expr, gatewayExpressionFields)
+}
+
+// skipExpressionUpdateValidation reports whether an update can skip expression validation:
+// the object is being deleted (e.g. its finalizer is removed) or its spec did not change,
+// so resources created before a validation rule existed can still be updated and deleted.
+func skipExpressionUpdateValidation(obj metav1.Object, oldSpec, newSpec any) bool {
+	return obj.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(oldSpec, newSpec)
+}
+
+//nolint:revive // cognitive complexity is acceptable for a tokenizer
+func validateExpression(expr string, fields []string) error {
+	runes := []rune(expr)
+	var open []int // positions of unclosed brackets
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '"':
+			end, err := skipString(runes, i)
+			if err != nil {
+				return err
+			}
+			i = end
+
+		case r == 'r' && isRawStringStart(runes, i+1):
+			end, err := skipRawString(runes, i)
+			if err != nil {
+				return err
+			}
+			i = end
+
+		case r == '(' || r == '[' || r == '{':
+			open = append(open, i)
+			i++
+
+		case r == ')' || r == ']' || r == '}':
+			if len(open) == 0 || runes[open[len(open)-1]] != matchingBracket(r) {
+				return fmt.Errorf("unexpected %q at position %d", r, i)
+			}
+			open = open[:len(open)-1]
+			i++
+
+		case r == '$':
+			// List reference by name or UUID
+			i++
+			for i < len(runes) && (isIdentPart(runes[i]) || runes[i] == '-') {
+				i++
+			}
+
+		case unicode.IsDigit(r):
+			// Number, IP or CIDR literals
+			i = skipToken(runes, i+1)
+
+		case isIdentStart(r):
+			end := skipToken(runes, i+1)
+			ident := string(runes[i:end])
+			i = end
+			inSet := len(open) > 0 && runes[open[len(open)-1]] == '{'
+			isLiteral := strings.ContainsRune(ident, ':') // IPv6 address such as fe80::1
+			if inSet || isLiteral || isFunctionCall(runes, end) || expressionKeywords[strings.ToLower(ident)] {
+				continue
+			}
+			if !hasFieldNamespace(ident, fields) {
+				return fmt.Errorf("unknown field %q, fields must start with one of: %s",
+					ident, strings.Join(fields, ", "))
+			}
+
+		default:
+			i++
+		}
+	}
+
+	if len(open) > 0 {
+		pos := open[len(open)-1]
+		return fmt.Errorf("unclosed %q at position %d", runes[pos], pos)
+	}
+	return nil
+}
+
+// skipString returns the position after the double-quoted string starting at start.
+func skipString(runes []rune, start int) (int, error) {
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated string starting at position %d", start)
+}
+
+// isRawStringStart reports whether a raw string (r"..." or r#"..."#) starts after the r at i-1.
+func isRawStringStart(runes []rune, i int) bool {
+	for i < len(runes) && runes[i] == '#' {
+		i++
+	}
+	return i < len(runes) && runes[i] == '"'
+}
+
+// skipRawString returns the position after the raw string starting at start.
+func skipRawString(runes []rune, start int) (int, error) {
+	i := start + 1
+	hashes := 0
+	for runes[i] == '#' {
+		hashes++
+		i++
+	}
+	terminator := "\"" + strings.Repeat("#", hashes)
+	rest := string(runes[i+1:])
+	end := strings.Index(rest, terminator)
+	if end < 0 {
+		return 0, fmt.Errorf("unterminated raw string starting at position %d", start)
+	}
+	return i + 1 + len([]rune(rest[:end])) + len(terminator), nil
+}
+
+// skipToken returns the position after the identifier or literal characters starting at i.
+func skipToken(runes []rune, i int) int {
+	for i < len(runes) && (isIdentPart(runes[i]) || runes[i] == ':' || runes[i] == '/') {
+		i++
+	}
+	return i
+}
+
+// isFunctionCall reports whether the identifier ending at i is followed by an opening parenthesis.
+func isFunctionCall(runes []rune, i int) bool {
+	for i < len(runes) && unicode.IsSpace(runes[i]) {
+		i++
+	}
+	return i < len(runes) && runes[i] == '('
+}
+
+func hasFieldNamespace(ident string, fields []string) bool {
+	for _, ns := range fields {
+		if ident == ns || strings.HasPrefix(ident, ns+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func matchingBracket(r rune) rune {
+	switch r {
+	case ')':
+		return '('
+	case ']':
+		return '['
+	default:
+		return '{'
+	}
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
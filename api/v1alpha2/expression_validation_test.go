@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateRulesetExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "simple comparison", expr: `http.request.uri.path contains "/api"`},
+		{name: "grouped conditions", expr: `(http.host eq "example.com" and not ssl) or ip.src in {192.0.2.0/24 2001:db8::/32}`},
+		{name: "functions", expr: `starts_with(lower(http.request.uri.path), "/blog") and len(http.request.uri.query) gt 0`},
+		{name: "header map", expr: `any(http.request.headers["x-api-key"][*] eq "secret")`},
+		{name: "raw string", expr: `http.request.uri.path matches r"^/(a|b)\.html$"`},
+		{name: "raw string with hashes", expr: `http.request.full_uri wildcard r#"https://example.com/"quoted"/*"#`},
+		{name: "escaped quote", expr: `http.user_agent eq "say \"hi\""`},
+		{name: "cf fields", expr: `cf.bot_management.score lt 30 and cf.edge.server_port eq 443`},
+		{name: "IPv6 literal", expr: `ip.src eq fe80::1`},
+		{name: "value expression", expr: `concat("/v2", http.request.uri.path)`},
+		{name: "unterminated string", expr: `http.host eq "example.com`, wantErr: "unterminated string"},
+		{name: "unterminated raw string", expr: `http.request.uri.path matches r"^/api`, wantErr: "unterminated raw string"},
+		{name: "unclosed parenthesis", expr: `(http.host eq "example.com"`, wantErr: `unclosed '('`},
+		{name: "unexpected parenthesis", expr: `http.host eq "example.com")`, wantErr: `unexpected ')'`},
+		{name: "mismatched brackets", expr: `ip.src in {192.0.2.1)`, wantErr: `unexpected ')'`},
+		{name: "unknown field", expr: `htp.host eq "example.com"`, wantErr: `unknown field "htp.host"`},
+		{name: "gateway field", expr: `dns.fqdn == "example.com"`, wantErr: `unknown field "dns.fqdn"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRulesetExpression(tt.expr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateRulesetExpression(%q) error = %v, want nil", tt.expr, err)
+				}
+				return
+			}
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateRulesetExpression(%q) error = %v, want error containing %q", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateGatewayExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "dns", expr: `any(dns.domains[*] == "example.com")`},
+		{name: "list reference", expr: `any(dns.domains[*] in $d1a2b3c4-5e6f-4a7b-8c9d-0e1f2a3b4c5d)`},
+		{name: "identity", expr: `identity.email matches ".*@example.com" and any(identity.groups.name[*] in {"admins" "devs"})`},
+		{name: "network", expr: `net.dst.ip in {10.0.0.0/8} and net.dst.port == 22`},
+		{name: "device posture", expr: `any(device_posture.checks.passed[*] in {"a1b2"})`},
+		{name: "dlp", expr: `any(dlp.profiles[*] in {"4a7b8c9d"})`},
+		{name: "unknown field", expr: `dns.fqdn == "example.com" or ip.src == 10.0.0.1`, wantErr: `unknown field "ip.src"`},
+		{name: "unbalanced", expr: `any(dns.domains[*] == "example.com"`, wantErr: `unclosed '('`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGatewayExpression(tt.expr)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateGatewayExpression(%q) error = %v, want nil", tt.expr, err)
+				}
+				return
+			}
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateGatewayExpression(%q) error = %v, want error containing %q", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransformRuleValidator_ValidateCreate(t *testing.T) {
+	validator := &TransformRuleValidator{}
+
+	valid := &TransformRule{
+		Spec: TransformRuleSpec{
+			Zone: "example.com",
+			Type: TransformRuleTypeURLRewrite,
+			Rules: []TransformRuleDefinition{{
+				Name:       "rewrite",
+				Expression: `http.request.uri.path contains "/api"`,
+				URLRewrite: &URLRewriteConfig{
+					Path: &RewriteValue{Expression: `regex_replace(http.request.uri.path, "^/api", "/v2")`},
+				},
+			}},
+		},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), valid); err != nil {
+		t.Errorf("ValidateCreate() error = %v, want nil", err)
+	}
+
+	invalid := valid.DeepCopy()
+	invalid.Spec.Rules = append(invalid.Spec.Rules, TransformRuleDefinition{
+		Name:       "headers",
+		Expression: `http.host eq "example.com"`,
+		Headers:    []HeaderModification{{Name: "X-Country", Operation: HeaderOperationSet, Expression: `ip.geoip.country)`}},
+	})
+	_, err := validator.ValidateCreate(context.Background(), invalid)
+	if err == nil || !contains(err.Error(), "spec.rules[1].headers[0].expression") {
+		t.Errorf("ValidateCreate() error = %v, want error for spec.rules[1].headers[0].expression", err)
+	}
+}
+
+func TestRedirectRuleValidator_ValidateCreate(t *testing.T) {
+	validator := &RedirectRuleValidator{}
+
+	rule := &RedirectRule{
+		Spec: RedirectRuleSpec{
+			Zone: "example.com",
+			Rules: []RedirectRuleDefinition{{
+				Name:       "old-path",
+				Expression: `(http.request.uri.path eq "/old")`,
+				Target:     RedirectTarget{Expression: `concat("https://", http.host, "/new")`},
+			}},
+		},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), rule); err != nil {
+		t.Errorf("ValidateCreate() error = %v, want nil", err)
+	}
+
+	rule.Spec.Rules[0].Expression = `(http.request.uri.path eq "/old"`
+	_, err := validator.ValidateCreate(context.Background(), rule)
+	if err == nil || !contains(err.Error(), "spec.rules[0].expression") {
+		t.Errorf("ValidateCreate() error = %v, want error for spec.rules[0].expression", err)
+	}
+
+	// Updates are validated like creates
+	_, err = validator.ValidateUpdate(context.Background(), &RedirectRule{}, rule)
+	if err == nil {
+		t.Error("ValidateUpdate() error = nil, want error")
+	}
+}
+
+func TestGatewayRuleValidator_ValidateCreate(t *testing.T) {
+	validator := &GatewayRuleValidator{}
+
+	rule := &GatewayRule{
+		Spec: GatewayRuleSpec{
+			Action:   "block",
+			Traffic:  `any(dns.domains[*] == "example.com")`,
+			Identity: `identity.email == "user@example.com"`,
+		},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), rule); err != nil {
+		t.Errorf("ValidateCreate() error = %v, want nil", err)
+	}
+
+	rule.Spec.Identity = `identiy.email == "user@example.com"`
+	_, err := validator.ValidateCreate(context.Background(), rule)
+	if err == nil || !contains(err.Error(), "spec.identity") {
+		t.Errorf("ValidateCreate() error = %v, want error for spec.identity", err)
+	}
+}
+
+func TestGatewayRuleValidator_ValidateUpdate(t *testing.T) {
+	validator := &GatewayRuleValidator{}
+
+	invalid := &GatewayRule{
+		Spec: GatewayRuleSpec{
+			Action:  "block",
+			Traffic: `any(dns.domains[*] == "example.com"`,
+		},
+	}
+
+	// An unchanged spec is not re-validated, e.g. for label or finalizer updates
+	if _, err := validator.ValidateUpdate(context.Background(), invalid, invalid.DeepCopy()); err != nil {
+		t.Errorf("ValidateUpdate() with unchanged spec error = %v, want nil", err)
+	}
+
+	// A resource being deleted can always have its finalizer removed
+	deleting := invalid.DeepCopy()
+	deleting.Spec.Identity = `identiy.email == "user@example.com"`
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+	if _, err := validator.ValidateUpdate(context.Background(), invalid, deleting); err != nil {
+		t.Errorf("ValidateUpdate() while deleting error = %v, want nil", err)
+	}
+
+	changed := invalid.DeepCopy()
+	changed.Spec.Action = "allow"
+	if _, err := validator.ValidateUpdate(context.Background(), invalid, changed); err == nil {
+		t.Error("ValidateUpdate() with changed spec error = nil, want error")
+	}
+}
@@ -63,6 +63,15 @@ type ConfigMapKeyRef struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// GatewayListRejectedItem is a list item that was not sent to Cloudflare.
+type GatewayListRejectedItem struct {
+	// Value is the rejected item value.
+	Value string `json:"value"`
+
+	// Reason explains why the item was rejected.
+	Reason string `json:"reason"`
+}
+
 // GatewayListStatus defines the observed state
 type GatewayListStatus struct {
 	// ListID is the Cloudflare Gateway List ID.
@@ -77,6 +86,15 @@ type GatewayListStatus struct {
 	// +kubebuilder:validation:Optional
 	ItemCount int `json:"itemCount,omitempty"`
 
+	// RejectedItemCount is the number of items that were not sent to Cloudflare
+	// because they are invalid for the list type.
+	// +kubebuilder:validation:Optional
+	RejectedItemCount int `json:"rejectedItemCount,omitempty"`
+
+	// RejectedItems lists the rejected items with the reason, capped at 100 entries.
+	// +kubebuilder:validation:Optional
+	RejectedItems []GatewayListRejectedItem `json:"rejectedItems,omitempty"`
+
 	// State indicates the current state.
 	// +kubebuilder:validation:Optional
 	State string `json:"state,omitempty"`
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GatewayLocationSpec defines the desired state of GatewayLocation
+type GatewayLocationSpec struct {
+	// Name of the Gateway Location in Cloudflare.
+	// Defaults to the resource name.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+
+	// Networks are the source IPv4 networks (CIDR) whose DNS queries to the
+	// location's IPv4 resolvers are attributed to this location.
+	// +kubebuilder:validation:Optional
+	Networks []string `json:"networks,omitempty"`
+
+	// ClientDefault makes this the default location for WARP clients.
+	// Cloudflare allows a single default location per account; setting it here
+	// moves the default from the current default location.
+	// +kubebuilder:validation:Optional
+	ClientDefault bool `json:"clientDefault,omitempty"`
+
+	// ECSSupport enables EDNS Client Subnet for queries from this location.
+	// +kubebuilder:validation:Optional
+	ECSSupport *bool `json:"ecsSupport,omitempty"`
+
+	// DNSDestinationIPsID is the ID of the dedicated DNS resolver IPs assigned to this location.
+	// +kubebuilder:validation:Optional
+	DNSDestinationIPsID string `json:"dnsDestinationIpsId,omitempty"`
+
+	// PolicyIDs are the IDs of the Gateway DNS policies applied to this location.
+	// +kubebuilder:validation:Optional
+	PolicyIDs []string `json:"policyIds,omitempty"`
+
+	// Endpoints configures the DNS endpoints of the location.
+	// Endpoints that are not set keep their current configuration in Cloudflare.
+	// +kubebuilder:validation:Optional
+	Endpoints *GatewayLocationEndpoints `json:"endpoints,omitempty"`
+
+	// Cloudflare contains the Cloudflare API credentials.
+	// +kubebuilder:validation:Required
+	Cloudflare CloudflareDetails `json:"cloudflare"`
+}
+
+// GatewayLocationEndpoints configures the DNS endpoints of a Gateway Location.
+type GatewayLocationEndpoints struct {
+	// IPv4 configures the IPv4 resolver endpoint. Networks and RequireToken are ignored.
+	// +kubebuilder:validation:Optional
+	IPv4 *GatewayLocationEndpoint `json:"ipv4,omitempty"`
+
+	// IPv6 configures the IPv6 resolver endpoint. RequireToken is ignored.
+	// +kubebuilder:validation:Optional
+	IPv6 *GatewayLocationEndpoint `json:"ipv6,omitempty"`
+
+	// DoT configures the DNS-over-TLS endpoint.
+	// +kubebuilder:validation:Optional
+	DoT *GatewayLocationEndpoint `json:"dot,omitempty"`
+
+	// DoH configures the DNS-over-HTTPS endpoint.
+	// +kubebuilder:validation:Optional
+	DoH *GatewayLocationEndpoint `json:"doh,omitempty"`
+}
+
+// GatewayLocationEndpoint configures a single DNS endpoint of a Gateway Location.
+type GatewayLocationEndpoint struct {
+	// Enabled turns the endpoint on.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// RequireToken requires a user-specific token in DoH/DoT requests.
+	// +kubebuilder:validation:Optional
+	RequireToken bool `json:"requireToken,omitempty"`
+
+	// Networks restricts the endpoint to the given source networks (CIDR).
+	// An empty list allows all networks.
+	// +kubebuilder:validation:Optional
+	Networks []string `json:"networks,omitempty"`
+}
+
+// GatewayLocationStatus defines the observed state
+type GatewayLocationStatus struct {
+	// LocationID is the Cloudflare Gateway Location ID.
+	// DNS policies select the location with a dns.location expression using this ID.
+	// +kubebuilder:validation:Optional
+	LocationID string `json:"locationId,omitempty"`
+
+	// AccountID is the Cloudflare Account ID.
+	// +kubebuilder:validation:Optional
+	AccountID string `json:"accountId,omitempty"`
+
+	// DoHSubdomain is the subdomain of the location's DoH endpoint
+	// (https://<subdomain>.cloudflare-gateway.com/dns-query).
+	// +kubebuilder:validation:Optional
+	DoHSubdomain string `json:"dohSubdomain,omitempty"`
+
+	// IPv6Address is the IPv6 resolver address of the location.
+	// +kubebuilder:validation:Optional
+	IPv6Address string `json:"ipv6Address,omitempty"`
+
+	// ClientDefault reports whether the location is the account's default location in Cloudflare.
+	// +kubebuilder:validation:Optional
+	ClientDefault bool `json:"clientDefault,omitempty"`
+
+	// State indicates the current state.
+	// +kubebuilder:validation:Optional
+	State string `json:"state,omitempty"`
+
+	// Conditions represent the latest available observations.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=gwlocation
+// +kubebuilder:printcolumn:name="Default",type=boolean,JSONPath=`.status.clientDefault`
+// +kubebuilder:printcolumn:name="DoHSubdomain",type=string,JSONPath=`.status.dohSubdomain`
+// +kubebuilder:printcolumn:name="LocationID",type=string,JSONPath=`.status.locationId`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// GatewayLocation is the Schema for the gatewaylocations API.
+// It manages a Gateway DNS location: the source networks and the DNS endpoints
+// (IPv4, IPv6, DoT and DoH) through which DNS queries are attributed to it.
+type GatewayLocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayLocationSpec   `json:"spec,omitempty"`
+	Status GatewayLocationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayLocationList contains a list of GatewayLocation
+type GatewayLocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayLocation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GatewayLocation{}, &GatewayLocationList{})
+}
+
+// GetGatewayLocationName returns the name to use in Cloudflare.
+func (g *GatewayLocation) GetGatewayLocationName() string {
+	if g.Spec.Name != "" {
+		return g.Spec.Name
+	}
+	return g.Name
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (r *GatewayRule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&GatewayRuleValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-networking-cloudflare-operator-io-v1alpha2-gatewayrule,mutating=false,failurePolicy=fail,sideEffects=None,groups=networking.cloudflare-operator.io,resources=gatewayrules,verbs=create;update,versions=v1alpha2,name=vgatewayrule.kb.io,admissionReviewVersions=v1
+
+// GatewayRuleValidator implements webhook validation for GatewayRule.
+type GatewayRuleValidator struct{}
+
+var _ webhook.CustomValidator = &GatewayRuleValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *GatewayRuleValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rule, ok := obj.(*GatewayRule)
+	if !ok {
+		return nil, fmt.Errorf("expected GatewayRule but got %T", obj)
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	for _, expr := range []struct {
+		name  string
+		value string
+	}{
+		{"traffic", rule.Spec.Traffic},
+		{"identity", rule.Spec.Identity},
+		{"devicePosture", rule.Spec.DevicePosture},
+	} {
+		if expr.value == "" {
+			continue
+		}
+		if err := ValidateGatewayExpression(expr.value); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child(expr.name), expr.value, err.Error()))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "GatewayRule"},
+			rule.Name, allErrs)
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *GatewayRuleValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRule, ok := oldObj.(*GatewayRule)
+	if !ok {
+		return nil, fmt.Errorf("expected GatewayRule but got %T", oldObj)
+	}
+	newRule, ok := newObj.(*GatewayRule)
+	if !ok {
+		return nil, fmt.Errorf("expected GatewayRule but got %T", newObj)
+	}
+	if skipExpressionUpdateValidation(newRule, oldRule.Spec, newRule.Spec) {
+		return nil, nil
+	}
+
+	// Same validation rules as create
+	return v.ValidateCreate(ctx, newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *GatewayRuleValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	// No validation needed for deletion
+	return nil, nil
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HyperdriveConfigState represents the state of the Hyperdrive configuration
+// +kubebuilder:validation:Enum=Pending;Ready;Error
+type HyperdriveConfigState string
+
+const (
+	// HyperdriveConfigStatePending means the configuration is waiting to be created
+	HyperdriveConfigStatePending HyperdriveConfigState = "Pending"
+	// HyperdriveConfigStateReady means the configuration is created and ready
+	HyperdriveConfigStateReady HyperdriveConfigState = "Ready"
+	// HyperdriveConfigStateError means there was an error with the configuration
+	HyperdriveConfigStateError HyperdriveConfigState = "Error"
+)
+
+// HyperdriveOrigin defines the origin database Hyperdrive connects to
+type HyperdriveOrigin struct {
+	// Scheme is the database protocol
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=postgres;postgresql;mysql
+	// +kubebuilder:default=postgres
+	Scheme string `json:"scheme,omitempty"`
+
+	// Host is the hostname or IP address of the database
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// Port is the port of the database
+	// If not specified, defaults to 5432 for PostgreSQL and 3306 for MySQL
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int `json:"port,omitempty"`
+
+	// Database is the name of the database
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Database string `json:"database"`
+
+	// User is the database user Hyperdrive connects as
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	User string `json:"user"`
+
+	// PasswordSecretRef references the Secret containing the password of the user
+	// The Secret must be in the same namespace as the HyperdriveConfig
+	// +kubebuilder:validation:Required
+	PasswordSecretRef HyperdrivePasswordSecretRef `json:"passwordSecretRef"`
+}
+
+// HyperdrivePasswordSecretRef references the database password in a Secret
+type HyperdrivePasswordSecretRef struct {
+	// Name is the name of the Secret
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key is the key of the password in the Secret data
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=password
+	Key string `json:"key,omitempty"`
+}
+
+// HyperdriveCaching defines the query caching settings of a Hyperdrive configuration
+type HyperdriveCaching struct {
+	// Disabled turns off query caching
+	// +kubebuilder:validation:Optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// MaxAge is how long a cached query result is served, in seconds
+	// If not specified, the Cloudflare default (60) is used
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxAge *int `json:"maxAge,omitempty"`
+
+	// StaleWhileRevalidate is how long a stale result is served while it is refreshed, in seconds
+	// If not specified, the Cloudflare default (15) is used
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	StaleWhileRevalidate *int `json:"staleWhileRevalidate,omitempty"`
+}
+
+// HyperdriveConfigSpec defines the desired state of HyperdriveConfig
+type HyperdriveConfigSpec struct {
+	// Name is the name of the Hyperdrive configuration in Cloudflare
+	// If not specified, defaults to the Kubernetes resource name
+	// An existing configuration with this name is adopted
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name,omitempty"`
+
+	// Origin is the database Hyperdrive connects to
+	// +kubebuilder:validation:Required
+	Origin HyperdriveOrigin `json:"origin"`
+
+	// Caching configures query caching
+	// +kubebuilder:validation:Optional
+	Caching *HyperdriveCaching `json:"caching,omitempty"`
+
+	// CredentialsRef references a CloudflareCredentials resource
+	// If not specified, the default CloudflareCredentials will be used
+	// +kubebuilder:validation:Optional
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted
+	// Delete: The Hyperdrive configuration will be deleted from Cloudflare
+	// Orphan: The Hyperdrive configuration will be left in Cloudflare
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long a failed deletion from Cloudflare is retried
+	// Once it has passed, the finalizer is removed and the Cloudflare resource may be orphaned
+	// If not specified, the operator's --deletion-timeout is used
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
+}
+
+// HyperdriveConfigStatus defines the observed state of HyperdriveConfig
+type HyperdriveConfigStatus struct {
+	// Conditions represent the latest available observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// State represents the current state of the configuration
+	// +optional
+	State HyperdriveConfigState `json:"state,omitempty"`
+
+	// HyperdriveID is the Cloudflare ID of the configuration
+	// Use it as the id of PagesProject Hyperdrive bindings
+	// +optional
+	HyperdriveID string `json:"hyperdriveId,omitempty"`
+
+	// HyperdriveName is the actual name of the configuration in Cloudflare
+	// +optional
+	HyperdriveName string `json:"hyperdriveName,omitempty"`
+
+	// PasswordSecretVersion is the resourceVersion of the password Secret last sent to Cloudflare
+	// The password itself is never stored in status
+	// +optional
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+
+	// Message provides additional information about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfhd;hyperdrive
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.status.hyperdriveName`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.hyperdriveId`
+// +kubebuilder:printcolumn:name="Host",type=string,JSONPath=`.spec.origin.host`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// HyperdriveConfig manages a Cloudflare Hyperdrive configuration.
+// PagesProject Hyperdrive bindings reference the configuration by status.hyperdriveId.
+type HyperdriveConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HyperdriveConfigSpec   `json:"spec,omitempty"`
+	Status HyperdriveConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HyperdriveConfigList contains a list of HyperdriveConfig
+type HyperdriveConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HyperdriveConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HyperdriveConfig{}, &HyperdriveConfigList{})
+}
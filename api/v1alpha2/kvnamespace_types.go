@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KVNamespaceState represents the state of the KV namespace
+// +kubebuilder:validation:Enum=Pending;Ready;Error
+type KVNamespaceState string
+
+const (
+	// KVNamespaceStatePending means the namespace is waiting to be created
+	KVNamespaceStatePending KVNamespaceState = "Pending"
+	// KVNamespaceStateReady means the namespace is created and ready
+	KVNamespaceStateReady KVNamespaceState = "Ready"
+	// KVNamespaceStateError means there was an error with the namespace
+	KVNamespaceStateError KVNamespaceState = "Error"
+)
+
+// KVNamespaceSpec defines the desired state of KVNamespace
+type KVNamespaceSpec struct {
+	// Title is the title of the KV namespace in Cloudflare
+	// If not specified, defaults to the Kubernetes resource name
+	// An existing namespace with this title is adopted only as allowed by AdoptionPolicy;
+	// changing it renames the namespace
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=512
+	Title string `json:"title,omitempty"`
+
+	// AdoptionPolicy defines how an existing KV namespace with the title is handled
+	// IfExists: Adopt it, or create the namespace if there is none
+	// MustExist: Adopt it, and fail if there is none
+	// MustNotExist: Fail if it exists, and create the namespace otherwise
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=IfExists;MustExist;MustNotExist
+	// +kubebuilder:default=MustNotExist
+	AdoptionPolicy string `json:"adoptionPolicy,omitempty"`
+
+	// CredentialsRef references a CloudflareCredentials resource
+	// If not specified, the default CloudflareCredentials will be used
+	// +kubebuilder:validation:Optional
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted
+	// Delete: The KV namespace and its keys will be deleted from Cloudflare
+	// Orphan: The KV namespace will be left in Cloudflare
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long a failed deletion from Cloudflare is retried
+	// Once it has passed, the finalizer is removed and the Cloudflare resource may be orphaned
+	// If not specified, the operator's --deletion-timeout is used
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
+}
+
+// KVNamespaceStatus defines the observed state of KVNamespace
+type KVNamespaceStatus struct {
+	// Conditions represent the latest available observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// State represents the current state of the namespace
+	// +optional
+	State KVNamespaceState `json:"state,omitempty"`
+
+	// NamespaceID is the Cloudflare ID of the namespace
+	// Use it as the namespaceId of PagesProject KV bindings
+	// +optional
+	NamespaceID string `json:"namespaceId,omitempty"`
+
+	// Title is the actual title of the namespace in Cloudflare
+	// +optional
+	Title string `json:"title,omitempty"`
+
+	// Message provides additional information about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfkv;kvns
+// +kubebuilder:printcolumn:name="Title",type=string,JSONPath=`.status.title`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.namespaceId`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// KVNamespace manages a Cloudflare Workers KV namespace.
+// PagesProject KV bindings reference the namespace by status.namespaceId.
+type KVNamespace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KVNamespaceSpec   `json:"spec,omitempty"`
+	Status KVNamespaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KVNamespaceList contains a list of KVNamespace
+type KVNamespaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KVNamespace `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KVNamespace{}, &KVNamespaceList{})
+}
@@ -69,6 +69,12 @@ type NetworkRouteStatus struct {
 	// +kubebuilder:validation:Optional
 	VirtualNetworkID string `json:"virtualNetworkId,omitempty"`
 
+	// TunnelStatus is the health of the target tunnel in Cloudflare
+	// (healthy, degraded, inactive or down). Empty if the tunnel was not found.
+	// The route only carries traffic while the tunnel is healthy or degraded.
+	// +kubebuilder:validation:Optional
+	TunnelStatus string `json:"tunnelStatus,omitempty"`
+
 	// AccountID is the Cloudflare Account ID.
 	// +kubebuilder:validation:Optional
 	AccountID string `json:"accountId,omitempty"`
@@ -94,6 +100,7 @@ type NetworkRouteStatus struct {
 // +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.network`
 // +kubebuilder:printcolumn:name="TunnelID",type=string,JSONPath=`.status.tunnelId`
 // +kubebuilder:printcolumn:name="VNetID",type=string,JSONPath=`.status.virtualNetworkId`
+// +kubebuilder:printcolumn:name="Tunnel",type=string,JSONPath=`.status.tunnelStatus`
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
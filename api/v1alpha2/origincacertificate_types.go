@@ -90,6 +90,17 @@ type SecretSyncConfig struct {
 	IncludeCA bool `json:"includeCA,omitempty"`
 }
 
+// PrivateKeyCurve represents the elliptic curve of an ECDSA private key
+// +kubebuilder:validation:Enum=P256;P384
+type PrivateKeyCurve string
+
+const (
+	// PrivateKeyCurveP256 is the NIST P-256 curve
+	PrivateKeyCurveP256 PrivateKeyCurve = "P256"
+	// PrivateKeyCurveP384 is the NIST P-384 curve
+	PrivateKeyCurveP384 PrivateKeyCurve = "P384"
+)
+
 // PrivateKeySpec configures how the private key is handled
 type PrivateKeySpec struct {
 	// Algorithm specifies the private key algorithm
@@ -99,11 +110,15 @@ type PrivateKeySpec struct {
 	Algorithm string `json:"algorithm,omitempty"`
 
 	// Size specifies the key size in bits (for RSA) or curve (for ECDSA)
-	// For RSA: 2048, 4096. For ECDSA: 256, 384
+	// For RSA: 2048, 3072, 4096 (default 2048). For ECDSA: 256, 384
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:default=2048
 	Size int `json:"size,omitempty"`
 
+	// Curve specifies the elliptic curve for ECDSA keys (default P256).
+	// Only valid with the ECDSA algorithm; when Size is also set, both must match.
+	// +kubebuilder:validation:Optional
+	Curve PrivateKeyCurve `json:"curve,omitempty"`
+
 	// SecretRef references an existing Secret containing the private key
 	// If specified, the controller will use this key instead of generating one
 	// The Secret must contain a "private-key" or "tls.key" key
@@ -151,8 +166,9 @@ type OriginCACertificateSpec struct {
 	Hostnames []string `json:"hostnames"`
 
 	// RequestType specifies the certificate type (RSA or ECC)
+	// If not specified, it follows the private key algorithm: origin-rsa for RSA
+	// keys and origin-ecc for ECDSA keys
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:default=origin-rsa
 	RequestType CertificateRequestType `json:"requestType,omitempty"`
 
 	// Validity specifies the certificate validity period in days
@@ -205,6 +221,10 @@ type OriginCACertificateStatus struct {
 	// +optional
 	Certificate string `json:"certificate,omitempty"`
 
+	// KeyAlgorithm describes the private key of the certificate (e.g. "RSA-2048", "ECDSA-P256")
+	// +optional
+	KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+
 	// ExpiresAt is the certificate expiration time
 	// +optional
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
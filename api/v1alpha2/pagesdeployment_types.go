@@ -274,6 +274,19 @@ type S3Source struct {
 	// +kubebuilder:validation:Optional
 	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 
+	// RoleARN is an IAM role to assume with STS AssumeRoleWithWebIdentity (e.g. EKS IRSA).
+	// Used only when CredentialsSecretRef is not set. The role must be allowed by the
+	// operator's --s3-allowed-role-arns flag.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// Anonymous sends unsigned requests, for public buckets.
+	// Without it, and without CredentialsSecretRef or RoleARN, the operator's default
+	// AWS credential chain is used (environment, shared config, IRSA, instance role).
+	// +kubebuilder:validation:Optional
+	Anonymous bool `json:"anonymous,omitempty"`
+
 	// UsePathStyle forces path-style addressing instead of virtual hosted-style.
 	// Required for some S3-compatible services like MinIO.
 	// +kubebuilder:default=false
@@ -290,8 +303,10 @@ type OCISource struct {
 
 	// CredentialsSecretRef references a Secret containing registry credentials.
 	// Supports two formats:
-	// - Docker config: .dockerconfigjson key (same as imagePullSecrets)
+	// - Docker config: .dockerconfigjson (or legacy .dockercfg) key, same as imagePullSecrets.
+	//   The entry matching the image registry host is used; other registries are pulled anonymously.
 	// - Basic auth: username and password keys
+	// When unset, the image is pulled anonymously.
 	// +kubebuilder:validation:Optional
 	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 
@@ -316,6 +331,25 @@ type ChecksumConfig struct {
 	Value string `json:"value"`
 }
 
+// ChecksumVerificationStatus reports the expected and actual checksum of a direct upload source.
+type ChecksumVerificationStatus struct {
+	// Algorithm is the checksum algorithm used.
+	// +kubebuilder:validation:Optional
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// Expected is the checksum configured in spec.
+	// +kubebuilder:validation:Optional
+	Expected string `json:"expected,omitempty"`
+
+	// Actual is the checksum computed from the downloaded source.
+	// +kubebuilder:validation:Optional
+	Actual string `json:"actual,omitempty"`
+
+	// Matched indicates whether the actual checksum matched the expected one.
+	// +kubebuilder:validation:Optional
+	Matched bool `json:"matched,omitempty"`
+}
+
 // ArchiveConfig defines archive extraction configuration.
 type ArchiveConfig struct {
 	// Type is the archive type.
@@ -410,6 +444,14 @@ type PagesDeploymentSpec struct {
 	// +kubebuilder:default=false
 	PurgeBuildCache bool `json:"purgeBuildCache,omitempty"`
 
+	// FailureLogLines is the number of trailing build log lines stored in
+	// status.failureLogs when the deployment fails. Set to 0 to disable.
+	// Defaults to 50.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=500
+	FailureLogLines *int32 `json:"failureLogLines,omitempty"`
+
 	// Cloudflare contains Cloudflare-specific configuration.
 	// +kubebuilder:validation:Required
 	Cloudflare CloudflareDetails `json:"cloudflare"`
@@ -526,6 +568,24 @@ type PagesDeploymentStatus struct {
 	// +kubebuilder:validation:Optional
 	SourceDescription string `json:"sourceDescription,omitempty"`
 
+	// SourceHash is the SHA-256 hash of the direct upload source package.
+	// +kubebuilder:validation:Optional
+	SourceHash string `json:"sourceHash,omitempty"`
+
+	// SourceURL is the URL the direct upload source was fetched from.
+	// +kubebuilder:validation:Optional
+	SourceURL string `json:"sourceUrl,omitempty"`
+
+	// ChecksumVerification is the result of the last direct upload checksum verification.
+	// Not set when no checksum is configured.
+	// +kubebuilder:validation:Optional
+	ChecksumVerification *ChecksumVerificationStatus `json:"checksumVerification,omitempty"`
+
+	// FailureLogs contains the last build log lines of a failed deployment.
+	// Long lines are truncated to keep the status small.
+	// +kubebuilder:validation:Optional
+	FailureLogs []string `json:"failureLogs,omitempty"`
+
 	// State is the current state of the deployment.
 	// +kubebuilder:validation:Optional
 	State PagesDeploymentState `json:"state,omitempty"`
@@ -85,6 +85,12 @@ type PagesDomainSpec struct {
 	// +kubebuilder:validation:Enum=Delete;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long a failed removal from Cloudflare is retried.
+	// Once it has passed, the finalizer is removed and the custom domain may be orphaned.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // PagesDomainStatus defines the observed state of PagesDomain
@@ -109,11 +115,15 @@ type PagesDomainStatus struct {
 	// +kubebuilder:validation:Optional
 	Status string `json:"status,omitempty"`
 
-	// ValidationMethod is the validation method (txt, http, cname).
+	// VerificationStatus is the status of the domain ownership verification.
+	// +kubebuilder:validation:Optional
+	VerificationStatus string `json:"verificationStatus,omitempty"`
+
+	// ValidationMethod is the SSL certificate validation method (txt, http).
 	// +kubebuilder:validation:Optional
 	ValidationMethod string `json:"validationMethod,omitempty"`
 
-	// ValidationStatus is the validation status.
+	// ValidationStatus is the status of the SSL certificate validation.
 	// +kubebuilder:validation:Optional
 	ValidationStatus string `json:"validationStatus,omitempty"`
 
@@ -121,7 +131,8 @@ type PagesDomainStatus struct {
 	// +kubebuilder:validation:Optional
 	CertificateAuthority string `json:"certificateAuthority,omitempty"`
 
-	// VerificationData contains DNS verification records if needed.
+	// VerificationData contains the DNS record that validates the SSL certificate,
+	// while the validation is pending and uses the txt method.
 	// +kubebuilder:validation:Optional
 	VerificationData *PagesDomainVerificationData `json:"verificationData,omitempty"`
 
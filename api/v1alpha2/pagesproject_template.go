@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Reserved SourceTemplate metadata keys mapped to deployment trigger metadata.
+const (
+	MetadataKeyCommitHash    = "commitHash"
+	MetadataKeyCommitMessage = "commitMessage"
+	MetadataKeyCommitDirty   = "commitDirty"
+	MetadataKeyBranch        = "branch"
+)
+
+const (
+	// defaultTemplateArchiveType is the archive type used when a template does not set one.
+	defaultTemplateArchiveType = "tar.gz"
+	// sampleTemplateVersion is rendered at admission time when no concrete version is known.
+	sampleTemplateVersion = "v0.0.0"
+)
+
+var (
+	// s3BucketPattern matches S3 bucket names (3-63 chars, lowercase, digits, dots, hyphens).
+	s3BucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	// ociRegistryPattern matches a registry host with an optional port.
+	ociRegistryPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*(:[0-9]+)?$`)
+	// ociPathComponentPattern matches a single OCI repository path component.
+	ociPathComponentPattern = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$`)
+	// ociTagPattern matches an OCI tag.
+	ociTagPattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+	// commitHashPattern matches abbreviated or full SHA-1/SHA-256 commit hashes.
+	commitHashPattern = regexp.MustCompile(`^[0-9a-fA-F]{7,64}$`)
+)
+
+// sourceTemplateData is the data available to SourceTemplate placeholders.
+type sourceTemplateData struct {
+	Version string
+}
+
+// RenderSource renders the template for the given version into a direct upload source.
+// It validates the rendered bucket/key, URL, or repository/tag, and maps the
+// reserved metadata keys into DeploymentMetadata.
+//
+//nolint:revive // cognitive complexity acceptable for template type dispatch
+func (st *SourceTemplate) RenderSource(version string) (*PagesDirectUploadSourceSpec, error) {
+	if version == "" {
+		return nil, errors.New("version must not be empty")
+	}
+
+	metadata, err := deploymentMetadataFromTemplate(st.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PagesDirectUploadSourceSpec{
+		Source:             &DirectUploadSource{},
+		DeploymentMetadata: metadata,
+	}
+	data := sourceTemplateData{Version: version}
+
+	switch st.Type {
+	case S3SourceTemplateType:
+		if st.S3 == nil {
+			return nil, errors.New("s3 template is nil")
+		}
+		if !s3BucketPattern.MatchString(st.S3.Bucket) {
+			return nil, fmt.Errorf("invalid s3 bucket name %q", st.S3.Bucket)
+		}
+		key, err := renderTemplateString("keyTemplate", st.S3.KeyTemplate, data)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" || strings.HasPrefix(key, "/") || len(key) > 1024 {
+			return nil, fmt.Errorf("rendered s3 key %q must be a non-empty relative key of at most 1024 bytes", key)
+		}
+		s3 := &S3Source{
+			Bucket:       st.S3.Bucket,
+			Key:          key,
+			Region:       st.S3.Region,
+			Endpoint:     st.S3.Endpoint,
+			UsePathStyle: st.S3.UsePathStyle,
+			RoleARN:      st.S3.RoleARN,
+			Anonymous:    st.S3.Anonymous,
+		}
+		if st.S3.CredentialsSecretRef != "" {
+			s3.CredentialsSecretRef = &corev1.LocalObjectReference{Name: st.S3.CredentialsSecretRef}
+		}
+		result.Source.S3 = s3
+		result.Archive = &ArchiveConfig{Type: archiveTypeOrDefault(st.S3.ArchiveType)}
+
+	case HTTPSourceTemplateType:
+		if st.HTTP == nil {
+			return nil, errors.New("http template is nil")
+		}
+		rendered, err := renderTemplateString("urlTemplate", st.HTTP.URLTemplate, data)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateRenderedURL(rendered); err != nil {
+			return nil, err
+		}
+		httpSource := &HTTPSource{URL: rendered}
+		if st.HTTP.HeadersSecretRef != "" {
+			httpSource.HeadersSecretRef = &corev1.LocalObjectReference{Name: st.HTTP.HeadersSecretRef}
+		}
+		result.Source.HTTP = httpSource
+		result.Archive = &ArchiveConfig{Type: archiveTypeOrDefault(st.HTTP.ArchiveType)}
+
+	case OCISourceTemplateType:
+		if st.OCI == nil {
+			return nil, errors.New("oci template is nil")
+		}
+		if err := validateOCIRepository(st.OCI.Repository); err != nil {
+			return nil, err
+		}
+		tag, err := renderTemplateString("tagTemplate", st.OCI.TagTemplate, data)
+		if err != nil {
+			return nil, err
+		}
+		if !ociTagPattern.MatchString(tag) {
+			return nil, fmt.Errorf("rendered oci tag %q is not a valid tag", tag)
+		}
+		oci := &OCISource{Image: fmt.Sprintf("%s:%s", st.OCI.Repository, tag)}
+		if st.OCI.CredentialsSecretRef != "" {
+			oci.CredentialsSecretRef = &corev1.LocalObjectReference{Name: st.OCI.CredentialsSecretRef}
+		}
+		result.Source.OCI = oci
+
+	default:
+		return nil, fmt.Errorf("unknown source template type: %s", st.Type)
+	}
+
+	return result, nil
+}
+
+// ValidateSourceMetadata validates the values of reserved metadata keys.
+// Non-reserved keys are accepted as-is.
+func ValidateSourceMetadata(metadata map[string]string) error {
+	_, err := deploymentMetadataFromTemplate(metadata)
+	return err
+}
+
+// deploymentMetadataFromTemplate converts reserved metadata keys into DeploymentTriggerMetadata.
+// Returns nil when no reserved key is set.
+func deploymentMetadataFromTemplate(metadata map[string]string) (*DeploymentTriggerMetadata, error) {
+	result := &DeploymentTriggerMetadata{}
+	found := false
+
+	if v, ok := metadata[MetadataKeyCommitHash]; ok && v != "" {
+		if !commitHashPattern.MatchString(v) {
+			return nil, fmt.Errorf("metadata %s %q must be a 7-64 character hex commit hash", MetadataKeyCommitHash, v)
+		}
+		result.CommitHash = v
+		found = true
+	}
+	if v, ok := metadata[MetadataKeyCommitMessage]; ok && v != "" {
+		if len(v) > 1024 {
+			return nil, fmt.Errorf("metadata %s must be at most 1024 characters", MetadataKeyCommitMessage)
+		}
+		result.CommitMessage = v
+		found = true
+	}
+	if v, ok := metadata[MetadataKeyCommitDirty]; ok {
+		if v != "true" && v != "false" {
+			return nil, fmt.Errorf("metadata %s %q must be \"true\" or \"false\"", MetadataKeyCommitDirty, v)
+		}
+		dirty := v == "true"
+		result.CommitDirty = &dirty
+		found = true
+	}
+	if v, ok := metadata[MetadataKeyBranch]; ok {
+		if v == "" || strings.ContainsAny(v, " \t\n~^:?*[\\") || len(v) > 255 {
+			return nil, fmt.Errorf("metadata %s %q is not a valid branch name", MetadataKeyBranch, v)
+		}
+		result.Branch = v
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// renderTemplateString executes a single template field with strict key checking.
+func renderTemplateString(name, text string, data sourceTemplateData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parse %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("execute %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// validateRenderedURL checks that a rendered URL is an absolute http(s) URL.
+func validateRenderedURL(rendered string) error {
+	if !strings.HasPrefix(rendered, "http://") && !strings.HasPrefix(rendered, "https://") {
+		return fmt.Errorf("rendered url %q must match ^https?://", rendered)
+	}
+	parsed, err := url.Parse(rendered)
+	if err != nil {
+		return fmt.Errorf("rendered url %q is invalid: %w", rendered, err)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("rendered url %q has no host", rendered)
+	}
+	return nil
+}
+
+// validateOCIRepository checks the shape of an OCI repository reference without tag or digest.
+func validateOCIRepository(repository string) error {
+	if repository == "" {
+		return errors.New("oci repository must not be empty")
+	}
+	if strings.Contains(repository, "@") {
+		return fmt.Errorf("oci repository %q must not contain a digest", repository)
+	}
+
+	components := strings.Split(repository, "/")
+	start := 0
+	if len(components) > 1 && (strings.ContainsAny(components[0], ".:") || components[0] == "localhost") {
+		if !ociRegistryPattern.MatchString(components[0]) {
+			return fmt.Errorf("oci repository %q has an invalid registry host", repository)
+		}
+		start = 1
+	}
+	for _, c := range components[start:] {
+		if !ociPathComponentPattern.MatchString(c) {
+			return fmt.Errorf("oci repository %q has an invalid path component %q", repository, c)
+		}
+	}
+	return nil
+}
+
+// archiveTypeOrDefault returns the archive type or the default when empty.
+func archiveTypeOrDefault(archiveType string) string {
+	if archiveType == "" {
+		return defaultTemplateArchiveType
+	}
+	return archiveType
+}
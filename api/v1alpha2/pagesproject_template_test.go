@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSourceTemplate_RenderSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		template SourceTemplate
+		version  string
+		errMsg   string
+		check    func(t *testing.T, spec *PagesDirectUploadSourceSpec)
+	}{
+		{
+			name: "s3 valid",
+			template: SourceTemplate{
+				Type: S3SourceTemplateType,
+				S3: &S3SourceTemplate{
+					Bucket:               "my-artifacts",
+					KeyTemplate:          "sites/{{.Version}}/dist.tar.gz",
+					Region:               "us-east-1",
+					CredentialsSecretRef: "s3-creds",
+					RoleARN:              "arn:aws:iam::123456789012:role/pages",
+				},
+			},
+			version: "v1.2.3",
+			check: func(t *testing.T, spec *PagesDirectUploadSourceSpec) {
+				if spec.Source.S3 == nil || spec.Source.S3.Key != "sites/v1.2.3/dist.tar.gz" {
+					t.Fatalf("unexpected s3 source: %+v", spec.Source.S3)
+				}
+				if spec.Source.S3.CredentialsSecretRef == nil || spec.Source.S3.CredentialsSecretRef.Name != "s3-creds" {
+					t.Errorf("expected credentials secret ref s3-creds, got %+v", spec.Source.S3.CredentialsSecretRef)
+				}
+				if spec.Source.S3.RoleARN != "arn:aws:iam::123456789012:role/pages" {
+					t.Errorf("expected roleArn to be copied, got %q", spec.Source.S3.RoleARN)
+				}
+				if spec.Archive == nil || spec.Archive.Type != "tar.gz" {
+					t.Errorf("expected default archive tar.gz, got %+v", spec.Archive)
+				}
+			},
+		},
+		{
+			name: "s3 invalid bucket",
+			template: SourceTemplate{
+				Type: S3SourceTemplateType,
+				S3:   &S3SourceTemplate{Bucket: "My_Bucket", KeyTemplate: "{{.Version}}.tar.gz"},
+			},
+			version: "v1",
+			errMsg:  "invalid s3 bucket name",
+		},
+		{
+			name: "s3 malformed key template",
+			template: SourceTemplate{
+				Type: S3SourceTemplateType,
+				S3:   &S3SourceTemplate{Bucket: "artifacts", KeyTemplate: "{{.Version}/dist.tar.gz"},
+			},
+			version: "v1",
+			errMsg:  "parse keyTemplate",
+		},
+		{
+			name: "s3 unknown placeholder",
+			template: SourceTemplate{
+				Type: S3SourceTemplateType,
+				S3:   &S3SourceTemplate{Bucket: "artifacts", KeyTemplate: "{{.Commit}}/dist.tar.gz"},
+			},
+			version: "v1",
+			errMsg:  "execute keyTemplate",
+		},
+		{
+			name: "http valid",
+			template: SourceTemplate{
+				Type: HTTPSourceTemplateType,
+				HTTP: &HTTPSourceTemplate{
+					URLTemplate: "https://cdn.example.com/{{.Version}}/dist.zip",
+					ArchiveType: "zip",
+				},
+			},
+			version: "sha-abc123",
+			check: func(t *testing.T, spec *PagesDirectUploadSourceSpec) {
+				if spec.Source.HTTP == nil || spec.Source.HTTP.URL != "https://cdn.example.com/sha-abc123/dist.zip" {
+					t.Fatalf("unexpected http source: %+v", spec.Source.HTTP)
+				}
+				if spec.Archive.Type != "zip" {
+					t.Errorf("expected archive zip, got %s", spec.Archive.Type)
+				}
+			},
+		},
+		{
+			name: "http scheme rendered from version",
+			template: SourceTemplate{
+				Type: HTTPSourceTemplateType,
+				HTTP: &HTTPSourceTemplate{URLTemplate: "https://{{.Version}}"},
+			},
+			version: "/dist.tar.gz",
+			errMsg:  "has no host",
+		},
+		{
+			name: "http does not match scheme pattern",
+			template: SourceTemplate{
+				Type: HTTPSourceTemplateType,
+				HTTP: &HTTPSourceTemplate{URLTemplate: "{{.Version}}/dist.tar.gz"},
+			},
+			version: "ftp://example.com",
+			errMsg:  "must match ^https?://",
+		},
+		{
+			name: "oci valid",
+			template: SourceTemplate{
+				Type: OCISourceTemplateType,
+				OCI: &OCISourceTemplate{
+					Repository:           "ghcr.io/org/site",
+					TagTemplate:          "{{.Version}}",
+					CredentialsSecretRef: "registry-creds",
+				},
+			},
+			version: "v1.0.0",
+			check: func(t *testing.T, spec *PagesDirectUploadSourceSpec) {
+				if spec.Source.OCI == nil || spec.Source.OCI.Image != "ghcr.io/org/site:v1.0.0" {
+					t.Fatalf("unexpected oci source: %+v", spec.Source.OCI)
+				}
+				if spec.Archive != nil {
+					t.Errorf("expected no archive for oci, got %+v", spec.Archive)
+				}
+			},
+		},
+		{
+			name: "oci invalid repository",
+			template: SourceTemplate{
+				Type: OCISourceTemplateType,
+				OCI:  &OCISourceTemplate{Repository: "ghcr.io/Org/Site", TagTemplate: "{{.Version}}"},
+			},
+			version: "v1",
+			errMsg:  "invalid path component",
+		},
+		{
+			name: "oci invalid rendered tag",
+			template: SourceTemplate{
+				Type: OCISourceTemplateType,
+				OCI:  &OCISourceTemplate{Repository: "registry:5000/site", TagTemplate: "release/{{.Version}}"},
+			},
+			version: "v1",
+			errMsg:  "not a valid tag",
+		},
+		{
+			name: "reserved metadata mapped",
+			template: SourceTemplate{
+				Type: HTTPSourceTemplateType,
+				HTTP: &HTTPSourceTemplate{URLTemplate: "https://example.com/{{.Version}}.tar.gz"},
+				Metadata: map[string]string{
+					MetadataKeyBranch:      "main",
+					MetadataKeyCommitHash:  "abc1234",
+					MetadataKeyCommitDirty: "false",
+					"team":                 "web",
+				},
+			},
+			version: "v1",
+			check: func(t *testing.T, spec *PagesDirectUploadSourceSpec) {
+				md := spec.DeploymentMetadata
+				if md == nil || md.Branch != "main" || md.CommitHash != "abc1234" {
+					t.Fatalf("unexpected deployment metadata: %+v", md)
+				}
+				if md.CommitDirty == nil || *md.CommitDirty {
+					t.Errorf("expected commitDirty false, got %v", md.CommitDirty)
+				}
+			},
+		},
+		{
+			name: "reserved metadata invalid commitDirty",
+			template: SourceTemplate{
+				Type:     HTTPSourceTemplateType,
+				HTTP:     &HTTPSourceTemplate{URLTemplate: "https://example.com/{{.Version}}.tar.gz"},
+				Metadata: map[string]string{MetadataKeyCommitDirty: "yes"},
+			},
+			version: "v1",
+			errMsg:  "commitDirty",
+		},
+		{
+			name: "empty version",
+			template: SourceTemplate{
+				Type: HTTPSourceTemplateType,
+				HTTP: &HTTPSourceTemplate{URLTemplate: "https://example.com/{{.Version}}.tar.gz"},
+			},
+			errMsg: "version must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := tt.template.RenderSource(tt.version)
+			if tt.errMsg != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got nil", tt.errMsg)
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Fatalf("expected error containing %q, got %q", tt.errMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, spec)
+		})
+	}
+}
+
+func TestPagesProjectValidator_RejectsBadTemplates(t *testing.T) {
+	validator := &PagesProjectValidator{}
+
+	tests := []struct {
+		name   string
+		vm     *VersionManagement
+		errMsg string
+	}{
+		{
+			name: "targetVersion renders with spec version",
+			vm: &VersionManagement{
+				Policy: VersionPolicyTargetVersion,
+				TargetVersion: &TargetVersionSpec{
+					Version: "ftp://mirror",
+					SourceTemplate: SourceTemplate{
+						Type: HTTPSourceTemplateType,
+						HTTP: &HTTPSourceTemplate{URLTemplate: "{{.Version}}/dist.tar.gz"},
+					},
+				},
+			},
+			errMsg: "template does not render for version",
+		},
+		{
+			name: "latestPreview renders with sample version",
+			vm: &VersionManagement{
+				Policy: VersionPolicyLatestPreview,
+				LatestPreview: &LatestPreviewConfig{
+					SourceTemplate: &SourceTemplate{
+						Type: S3SourceTemplateType,
+						S3:   &S3SourceTemplate{Bucket: "artifacts", KeyTemplate: "{{.Version"},
+					},
+				},
+			},
+			errMsg: "invalid template",
+		},
+		{
+			name: "gitopsLatest metadata",
+			vm: &VersionManagement{
+				Policy: VersionPolicyGitOpsLatest,
+				GitOpsLatest: &GitOpsLatestConfig{
+					Version: "v1",
+					SourceTemplate: SourceTemplate{
+						Type: OCISourceTemplateType,
+						OCI:  &OCISourceTemplate{Repository: "ghcr.io/org/site", TagTemplate: "{{.Version}}"},
+					},
+					Metadata: map[string]string{MetadataKeyCommitHash: "not-a-hash"},
+				},
+			},
+			errMsg: "commitHash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := &PagesProject{
+				Spec: PagesProjectSpec{ProductionBranch: "main", VersionManagement: tt.vm},
+			}
+			_, err := validator.ValidateCreate(context.Background(), project)
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tt.errMsg)
+			}
+			if !strings.Contains(err.Error(), tt.errMsg) {
+				t.Fatalf("expected error containing %q, got %q", tt.errMsg, err.Error())
+			}
+		})
+	}
+}
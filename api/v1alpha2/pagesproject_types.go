@@ -65,7 +65,9 @@ type GitOpsVersionConfig struct {
 	SourceTemplate *SourceTemplate `json:"sourceTemplate,omitempty"`
 
 	// RequirePreviewValidation requires productionVersion to have passed preview validation.
-	// When true (default), promotion fails if the version hasn't been successfully deployed as preview.
+	// When true (default), promotion is refused until status.validationHistory has a passed
+	// entry for the version, recorded once its preview deployment succeeded, and the
+	// PreviewValidated condition explains why.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=true
 	RequirePreviewValidation *bool `json:"requirePreviewValidation,omitempty"`
@@ -132,9 +134,19 @@ type AutoPromoteConfig struct {
 // ExternalVersionConfig defines configuration for external version control.
 type ExternalVersionConfig struct {
 	// WebhookURL is the URL to notify when version changes are needed.
+	// The operator POSTs a JSON payload describing the needed change, and
+	// polls it with GET every SyncInterval to pick up currentVersion and
+	// productionVersion from the external system.
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^https?://`
 	WebhookURL string `json:"webhookUrl,omitempty"`
 
+	// WebhookSecretRef references a key of a Secret in the PagesProject namespace
+	// holding the HMAC secret. When set, requests carry an
+	// X-Cloudflare-Operator-Signature header with the hex HMAC-SHA256 of the body.
+	// +kubebuilder:validation:Optional
+	WebhookSecretRef *SecretKeySelector `json:"webhookSecretRef,omitempty"`
+
 	// SyncInterval is the interval to sync version status from external system.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default="5m"
@@ -348,6 +360,19 @@ type S3SourceTemplate struct {
 	// +kubebuilder:validation:Optional
 	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
 
+	// RoleARN is an IAM role to assume with STS AssumeRoleWithWebIdentity (e.g. EKS IRSA).
+	// Used only when CredentialsSecretRef is not set. The role must be allowed by the
+	// operator's --s3-allowed-role-arns flag.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// Anonymous sends unsigned requests, for public buckets.
+	// Without it, and without CredentialsSecretRef or RoleARN, the operator's default
+	// AWS credential chain is used.
+	// +kubebuilder:validation:Optional
+	Anonymous bool `json:"anonymous,omitempty"`
+
 	// ArchiveType is the archive type (default: "tar.gz").
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=tar.gz;tar;zip;none
@@ -391,7 +416,8 @@ type OCISourceTemplate struct {
 	// +kubebuilder:validation:Required
 	TagTemplate string `json:"tagTemplate"`
 
-	// CredentialsSecretRef references a Secret containing registry credentials.
+	// CredentialsSecretRef references a Secret containing registry credentials,
+	// either a kubernetes.io/dockerconfigjson Secret or username/password keys.
 	// +kubebuilder:validation:Optional
 	CredentialsSecretRef string `json:"credentialsSecretRef,omitempty"`
 }
@@ -646,8 +672,22 @@ type PagesD1Binding struct {
 	Name string `json:"name"`
 
 	// DatabaseID is the D1 database ID.
+	// Either databaseId or databaseRef must be set; databaseId takes precedence.
+	// +kubebuilder:validation:Optional
+	DatabaseID string `json:"databaseId,omitempty"`
+
+	// DatabaseRef references the D1 database by name.
+	// It is resolved to the current database ID on every reconcile.
+	// +kubebuilder:validation:Optional
+	DatabaseRef *PagesD1DatabaseRef `json:"databaseRef,omitempty"`
+}
+
+// PagesD1DatabaseRef references a D1 database by name.
+type PagesD1DatabaseRef struct {
+	// Name is the name of the D1 database in Cloudflare.
 	// +kubebuilder:validation:Required
-	DatabaseID string `json:"databaseId"`
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 // PagesDurableObjectBinding defines a Durable Object binding.
@@ -676,8 +716,22 @@ type PagesKVBinding struct {
 	Name string `json:"name"`
 
 	// NamespaceID is the KV namespace ID.
+	// Either namespaceId or namespaceRef must be set; namespaceId takes precedence.
+	// +kubebuilder:validation:Optional
+	NamespaceID string `json:"namespaceId,omitempty"`
+
+	// NamespaceRef references the KV namespace by title.
+	// It is resolved to the current namespace ID on every reconcile.
+	// +kubebuilder:validation:Optional
+	NamespaceRef *PagesKVNamespaceRef `json:"namespaceRef,omitempty"`
+}
+
+// PagesKVNamespaceRef references a KV namespace by title.
+type PagesKVNamespaceRef struct {
+	// Title is the title of the KV namespace in Cloudflare.
 	// +kubebuilder:validation:Required
-	NamespaceID string `json:"namespaceId"`
+	// +kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
 }
 
 // PagesR2Binding defines an R2 bucket binding.
@@ -741,7 +795,7 @@ type PagesHyperdriveBinding struct {
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
 
-	// ID is the Hyperdrive configuration ID.
+	// ID is the Hyperdrive configuration ID, such as the status.hyperdriveId of a HyperdriveConfig.
 	// +kubebuilder:validation:Required
 	ID string `json:"id"`
 }
@@ -849,7 +903,7 @@ type VersionValidation struct {
 	ValidatedAt *metav1.Time `json:"validatedAt,omitempty"`
 
 	// ValidatedBy indicates how the version was validated.
-	// Values: "preview", "manual", "autoPromote"
+	// Values: "preview", "gitops", "latestPreview", "autoPromote", "healthCheck", "external", "manual"
 	// +kubebuilder:validation:Optional
 	ValidatedBy string `json:"validatedBy,omitempty"`
 
@@ -1052,7 +1106,7 @@ type PagesProjectSpec struct {
 	// DeploymentHistoryLimit is the number of deployment records to keep in history.
 	// Used for intelligent rollback feature.
 	// +kubebuilder:default=10
-	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Optional
 	DeploymentHistoryLimit *int `json:"deploymentHistoryLimit,omitempty"`
@@ -1081,12 +1135,20 @@ type PagesProjectSpec struct {
 
 	// RevisionHistoryLimit limits the number of managed PagesDeployment resources to keep.
 	// When exceeded, oldest non-production deployments are automatically deleted.
-	// Production deployments are never deleted by pruning.
+	// Production deployments are never deleted by pruning, so 0 keeps only production.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=10
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// RollbackTo triggers a rollback of the production deployment.
+	// Accepts a Cloudflare deployment ID, a version name, or a history
+	// version number (e.g. "v5"). The target must be present in
+	// status.deploymentHistory. The field is cleared once the rollback succeeds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=128
+	RollbackTo string `json:"rollbackTo,omitempty"`
 }
 
 // PagesProjectStatus defines the observed state of PagesProject
@@ -1120,6 +1182,7 @@ type PagesProjectStatus struct {
 	Subdomain string `json:"subdomain,omitempty"`
 
 	// Domains are the custom domains configured for this project.
+	// Custom domains are attached with PagesDomain resources.
 	// +kubebuilder:validation:Optional
 	Domains []string `json:"domains,omitempty"`
 
@@ -1206,6 +1269,52 @@ type PagesProjectStatus struct {
 	// ActivePolicy is the current active version management policy.
 	// +kubebuilder:validation:Optional
 	ActivePolicy VersionPolicy `json:"activePolicy,omitempty"`
+
+	// ExternalSync tracks webhook delivery and polling for the external policy.
+	// +kubebuilder:validation:Optional
+	ExternalSync *ExternalSyncStatus `json:"externalSync,omitempty"`
+
+	// HandledRollback records the last spec.rollbackTo action that was sent to Cloudflare,
+	// so the rollback is not repeated when clearing spec.rollbackTo fails.
+	// +kubebuilder:validation:Optional
+	HandledRollback *HandledRollback `json:"handledRollback,omitempty"`
+}
+
+// HandledRollback identifies a spec.rollbackTo action that has been handled.
+type HandledRollback struct {
+	// Target is the handled spec.rollbackTo value.
+	Target string `json:"target"`
+
+	// Generation is the spec generation that requested the rollback.
+	Generation int64 `json:"generation"`
+}
+
+// ExternalSyncStatus tracks the communication with the external version system.
+type ExternalSyncStatus struct {
+	// LastNotificationReason is the reason of the last webhook notification.
+	// +kubebuilder:validation:Optional
+	LastNotificationReason string `json:"lastNotificationReason,omitempty"`
+
+	// LastNotifiedVersion is the desired version of the last webhook notification.
+	// +kubebuilder:validation:Optional
+	LastNotifiedVersion string `json:"lastNotifiedVersion,omitempty"`
+
+	// LastNotifiedAt is when the last webhook notification was delivered.
+	// +kubebuilder:validation:Optional
+	LastNotifiedAt *metav1.Time `json:"lastNotifiedAt,omitempty"`
+
+	// DeliveryAttempts is the number of attempts made for the last notification.
+	// +kubebuilder:validation:Optional
+	DeliveryAttempts int32 `json:"deliveryAttempts,omitempty"`
+
+	// LastDeliveryError is the error of the last failed delivery.
+	// Empty when the last notification was delivered.
+	// +kubebuilder:validation:Optional
+	LastDeliveryError string `json:"lastDeliveryError,omitempty"`
+
+	// LastPolledAt is when the external state was last polled.
+	// +kubebuilder:validation:Optional
+	LastPolledAt *metav1.Time `json:"lastPolledAt,omitempty"`
 }
 
 // PagesProjectOriginalConfig stores the original Cloudflare configuration before adoption.
@@ -77,6 +77,49 @@ func getEffectivePolicy(vm *VersionManagement) VersionPolicy {
 	return VersionPolicyNone
 }
 
+// versionPolicyBlock maps a version policy to its configuration block in VersionManagement.
+type versionPolicyBlock struct {
+	policy VersionPolicy
+	field  string
+	isSet  func(vm *VersionManagement) bool
+}
+
+// versionPolicyBlocks lists every policy that owns a configuration block.
+var versionPolicyBlocks = []versionPolicyBlock{
+	{VersionPolicyTargetVersion, "targetVersion", func(vm *VersionManagement) bool { return vm.TargetVersion != nil }},
+	{VersionPolicyDeclarativeVersions, "declarativeVersions",
+		func(vm *VersionManagement) bool { return vm.DeclarativeVersions != nil }},
+	{VersionPolicyFullVersions, "fullVersions", func(vm *VersionManagement) bool { return vm.FullVersions != nil }},
+	{VersionPolicyGitOps, "gitops", func(vm *VersionManagement) bool { return vm.GitOps != nil }},
+	{VersionPolicyLatestPreview, "latestPreview", func(vm *VersionManagement) bool { return vm.LatestPreview != nil }},
+	{VersionPolicyAutoPromote, "autoPromote", func(vm *VersionManagement) bool { return vm.AutoPromote != nil }},
+	{VersionPolicyExternal, "external", func(vm *VersionManagement) bool { return vm.External != nil }},
+	{VersionPolicyGitOpsLatest, "gitopsLatest", func(vm *VersionManagement) bool { return vm.GitOpsLatest != nil }},
+}
+
+// validatePolicyBlocks enforces that exactly the block matching the policy is set.
+// Blocks belonging to other policies must be empty.
+func validatePolicyBlocks(path *field.Path, vm *VersionManagement, policy VersionPolicy) field.ErrorList {
+	var errs field.ErrorList
+
+	for _, block := range versionPolicyBlocks {
+		if block.policy == policy {
+			if !block.isSet(vm) {
+				errs = append(errs, field.Required(path.Child(block.field),
+					fmt.Sprintf("%s is required when policy is %s", block.field, policy)))
+			}
+			continue
+		}
+		if block.isSet(vm) {
+			errs = append(errs, field.Forbidden(path.Child(block.field),
+				fmt.Sprintf("%s must not be set when policy is %s; set policy to %s or remove %s",
+					block.field, policy, block.policy, block.field)))
+		}
+	}
+
+	return errs
+}
+
 // validateVersionManagement validates the versionManagement configuration.
 //
 //nolint:revive // cognitive complexity acceptable for validation
@@ -95,49 +138,44 @@ func (v *PagesProjectValidator) validateVersionManagement(project *PagesProject)
 	// Validate policy and corresponding configuration
 	switch policy {
 	case VersionPolicyNone, "":
-		// No validation needed for none policy
+		// No configuration block allowed for none policy
+		errs = append(errs, validatePolicyBlocks(path, vm, VersionPolicyNone)...)
 
 	case VersionPolicyTargetVersion:
-		if vm.TargetVersion == nil {
-			errs = append(errs, field.Required(path.Child("targetVersion"),
-				"targetVersion is required when policy is targetVersion"))
-		} else {
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
+		if vm.TargetVersion != nil {
 			errs = append(errs, v.validateSourceTemplate(
 				path.Child("targetVersion", "sourceTemplate"),
-				&vm.TargetVersion.SourceTemplate)...)
+				&vm.TargetVersion.SourceTemplate, vm.TargetVersion.Version)...)
+			errs = append(errs, validateVersionMetadata(
+				path.Child("targetVersion", "metadata"), vm.TargetVersion.Metadata)...)
 		}
 
 	case VersionPolicyDeclarativeVersions:
-		if vm.DeclarativeVersions == nil {
-			errs = append(errs, field.Required(path.Child("declarativeVersions"),
-				"declarativeVersions is required when policy is declarativeVersions"))
-		} else {
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
+		if vm.DeclarativeVersions != nil {
 			errs = append(errs, v.validateDeclarativeVersions(
 				path.Child("declarativeVersions"), vm.DeclarativeVersions)...)
 			errs = append(errs, v.validateSourceTemplate(
 				path.Child("declarativeVersions", "sourceTemplate"),
-				&vm.DeclarativeVersions.SourceTemplate)...)
+				&vm.DeclarativeVersions.SourceTemplate, vm.DeclarativeVersions.Versions...)...)
 		}
 
 	case VersionPolicyFullVersions:
-		if vm.FullVersions == nil {
-			errs = append(errs, field.Required(path.Child("fullVersions"),
-				"fullVersions is required when policy is fullVersions"))
-		} else {
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
+		if vm.FullVersions != nil {
 			errs = append(errs, v.validateFullVersions(
 				path.Child("fullVersions"), vm.FullVersions)...)
 		}
 
 	case VersionPolicyGitOps:
-		if vm.GitOps == nil {
-			errs = append(errs, field.Required(path.Child("gitops"),
-				"gitops is required when policy is gitops"))
-		} else {
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
+		if vm.GitOps != nil {
 			errs = append(errs, v.validateGitOps(path.Child("gitops"), vm.GitOps)...)
 		}
 
 	case VersionPolicyLatestPreview:
-		// latestPreview has no required fields, just validate if present
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
 		if vm.LatestPreview != nil && vm.LatestPreview.SourceTemplate != nil {
 			errs = append(errs, v.validateSourceTemplate(
 				path.Child("latestPreview", "sourceTemplate"),
@@ -145,7 +183,7 @@ func (v *PagesProjectValidator) validateVersionManagement(project *PagesProject)
 		}
 
 	case VersionPolicyAutoPromote:
-		// autoPromote has no required fields, just validate if present
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
 		if vm.AutoPromote != nil && vm.AutoPromote.SourceTemplate != nil {
 			errs = append(errs, v.validateSourceTemplate(
 				path.Child("autoPromote", "sourceTemplate"),
@@ -153,11 +191,27 @@ func (v *PagesProjectValidator) validateVersionManagement(project *PagesProject)
 		}
 
 	case VersionPolicyExternal:
-		// external has no required fields
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
+		if vm.External != nil && vm.External.SourceTemplate != nil {
+			errs = append(errs, v.validateSourceTemplate(
+				path.Child("external", "sourceTemplate"),
+				vm.External.SourceTemplate, vm.External.CurrentVersion, vm.External.ProductionVersion)...)
+		}
+
+	case VersionPolicyGitOpsLatest:
+		errs = append(errs, validatePolicyBlocks(path, vm, policy)...)
+		if vm.GitOpsLatest != nil {
+			errs = append(errs, v.validateSourceTemplate(
+				path.Child("gitopsLatest", "sourceTemplate"),
+				&vm.GitOpsLatest.SourceTemplate, vm.GitOpsLatest.Version)...)
+			errs = append(errs, validateVersionMetadata(
+				path.Child("gitopsLatest", "metadata"), vm.GitOpsLatest.Metadata)...)
+		}
 
 	default:
 		errs = append(errs, field.Invalid(path.Child("policy"), policy,
-			"must be one of: none, targetVersion, declarativeVersions, fullVersions, gitops, latestPreview, autoPromote, external"))
+			"must be one of: none, targetVersion, declarativeVersions, fullVersions, gitops, "+
+				"latestPreview, autoPromote, external, gitopsLatest"))
 	}
 
 	return errs, warnings
@@ -176,14 +230,18 @@ func (v *PagesProjectValidator) validateGitOps(path *field.Path, gitops *GitOpsV
 
 	// Validate source template if present
 	if gitops.SourceTemplate != nil {
-		errs = append(errs, v.validateSourceTemplate(path.Child("sourceTemplate"), gitops.SourceTemplate)...)
+		errs = append(errs, v.validateSourceTemplate(path.Child("sourceTemplate"), gitops.SourceTemplate,
+			gitops.PreviewVersion, gitops.ProductionVersion)...)
 	}
+	errs = append(errs, validateVersionMetadata(path.Child("previewMetadata"), gitops.PreviewMetadata)...)
 
 	return errs
 }
 
 // validateSourceTemplate validates a source template configuration.
-func (v *PagesProjectValidator) validateSourceTemplate(path *field.Path, st *SourceTemplate) field.ErrorList {
+// The template is rendered for each given version (or a sample version when none
+// is known yet) so that malformed templates are rejected at admission time.
+func (v *PagesProjectValidator) validateSourceTemplate(path *field.Path, st *SourceTemplate, versions ...string) field.ErrorList {
 	var errs field.ErrorList
 
 	switch st.Type {
@@ -234,9 +292,38 @@ func (v *PagesProjectValidator) validateSourceTemplate(path *field.Path, st *Sou
 			"must be one of: s3, http, oci"))
 	}
 
+	if len(errs) > 0 {
+		return errs
+	}
+
+	rendered := false
+	for _, version := range versions {
+		if version == "" {
+			continue
+		}
+		rendered = true
+		if _, err := st.RenderSource(version); err != nil {
+			return append(errs, field.Invalid(path, version,
+				fmt.Sprintf("template does not render for version %q: %v", version, err)))
+		}
+	}
+	if !rendered {
+		if _, err := st.RenderSource(sampleTemplateVersion); err != nil {
+			errs = append(errs, field.Invalid(path, st.Type, fmt.Sprintf("invalid template: %v", err)))
+		}
+	}
+
 	return errs
 }
 
+// validateVersionMetadata validates reserved keys in mode-specific version metadata.
+func validateVersionMetadata(path *field.Path, metadata map[string]string) field.ErrorList {
+	if err := ValidateSourceMetadata(metadata); err != nil {
+		return field.ErrorList{field.Invalid(path, metadata, err.Error())}
+	}
+	return nil
+}
+
 // validateDeclarativeVersions validates declarative versions configuration.
 func (v *PagesProjectValidator) validateDeclarativeVersions(path *field.Path, dv *DeclarativeVersionsSpec) field.ErrorList {
 	var errs field.ErrorList
@@ -320,6 +320,93 @@ func TestPagesProjectValidator_validateGitOps(t *testing.T) {
 	}
 }
 
+func TestPagesProjectValidator_PolicyBlocks(t *testing.T) {
+	validator := &PagesProjectValidator{}
+
+	httpTemplate := SourceTemplate{
+		Type: HTTPSourceTemplateType,
+		HTTP: &HTTPSourceTemplate{URLTemplate: "https://example.com/{{.Version}}/dist.tar.gz"},
+	}
+
+	// withBlock returns a VersionManagement with only the block for policy populated.
+	withBlock := func(policy VersionPolicy) *VersionManagement {
+		vm := &VersionManagement{Policy: policy}
+		switch policy {
+		case VersionPolicyTargetVersion:
+			vm.TargetVersion = &TargetVersionSpec{Version: "v1", SourceTemplate: httpTemplate}
+		case VersionPolicyDeclarativeVersions:
+			vm.DeclarativeVersions = &DeclarativeVersionsSpec{Versions: []string{"v1"}, SourceTemplate: httpTemplate}
+		case VersionPolicyFullVersions:
+			vm.FullVersions = &FullVersionsSpec{Versions: []ProjectVersion{{Name: "v1"}}}
+		case VersionPolicyGitOps:
+			vm.GitOps = &GitOpsVersionConfig{PreviewVersion: "v1"}
+		case VersionPolicyLatestPreview:
+			vm.LatestPreview = &LatestPreviewConfig{}
+		case VersionPolicyAutoPromote:
+			vm.AutoPromote = &AutoPromoteConfig{}
+		case VersionPolicyExternal:
+			vm.External = &ExternalVersionConfig{CurrentVersion: "v1"}
+		case VersionPolicyGitOpsLatest:
+			vm.GitOpsLatest = &GitOpsLatestConfig{Version: "v1", SourceTemplate: httpTemplate}
+		}
+		return vm
+	}
+
+	for _, block := range versionPolicyBlocks {
+		t.Run(string(block.policy)+" with matching block", func(t *testing.T) {
+			project := &PagesProject{Spec: PagesProjectSpec{VersionManagement: withBlock(block.policy)}}
+			if _, err := validator.ValidateCreate(context.Background(), project); err != nil {
+				t.Errorf("ValidateCreate() unexpected error: %v", err)
+			}
+		})
+
+		t.Run(string(block.policy)+" without block", func(t *testing.T) {
+			project := &PagesProject{Spec: PagesProjectSpec{
+				VersionManagement: &VersionManagement{Policy: block.policy},
+			}}
+			_, err := validator.ValidateCreate(context.Background(), project)
+			if err == nil {
+				t.Fatal("ValidateCreate() expected error for missing block")
+			}
+			want := "spec.versionManagement." + block.field + ": Required value"
+			if !contains(err.Error(), want) {
+				t.Errorf("ValidateCreate() error = %v, want error containing %q", err, want)
+			}
+		})
+
+		t.Run(string(block.policy)+" with conflicting block", func(t *testing.T) {
+			vm := withBlock(block.policy)
+			conflictField := "gitops"
+			if block.policy == VersionPolicyGitOps {
+				vm.AutoPromote = &AutoPromoteConfig{}
+				conflictField = "autoPromote"
+			} else {
+				vm.GitOps = &GitOpsVersionConfig{PreviewVersion: "v1"}
+			}
+
+			project := &PagesProject{Spec: PagesProjectSpec{VersionManagement: vm}}
+			_, err := validator.ValidateCreate(context.Background(), project)
+			if err == nil {
+				t.Fatal("ValidateCreate() expected error for conflicting block")
+			}
+			want := "spec.versionManagement." + conflictField + ": Forbidden"
+			if !contains(err.Error(), want) {
+				t.Errorf("ValidateCreate() error = %v, want error containing %q", err, want)
+			}
+		})
+	}
+
+	t.Run("none policy with block", func(t *testing.T) {
+		vm := withBlock(VersionPolicyExternal)
+		vm.Policy = VersionPolicyNone
+		project := &PagesProject{Spec: PagesProjectSpec{VersionManagement: vm}}
+		_, err := validator.ValidateCreate(context.Background(), project)
+		if err == nil || !contains(err.Error(), "spec.versionManagement.external: Forbidden") {
+			t.Errorf("ValidateCreate() error = %v, want forbidden external block", err)
+		}
+	})
+}
+
 // contains checks if a string contains a substring.
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 || findSubstring(s, substr))
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QueueState represents the state of the queue
+// +kubebuilder:validation:Enum=Pending;Ready;Error
+type QueueState string
+
+const (
+	// QueueStatePending means the queue is waiting to be created
+	QueueStatePending QueueState = "Pending"
+	// QueueStateReady means the queue and its consumers are synced
+	QueueStateReady QueueState = "Ready"
+	// QueueStateError means there was an error with the queue or a consumer
+	QueueStateError QueueState = "Error"
+)
+
+// QueueConsumerType is the type of a queue consumer
+// +kubebuilder:validation:Enum=worker;http_pull
+type QueueConsumerType string
+
+const (
+	// QueueConsumerTypeWorker delivers messages to a Worker script
+	QueueConsumerTypeWorker QueueConsumerType = "worker"
+	// QueueConsumerTypeHTTPPull lets clients pull messages over HTTP
+	QueueConsumerTypeHTTPPull QueueConsumerType = "http_pull"
+)
+
+// QueueSettings defines the settings of a queue
+type QueueSettings struct {
+	// DeliveryDelay is the number of seconds new messages are delayed before delivery
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=43200
+	DeliveryDelay *int `json:"deliveryDelay,omitempty"`
+
+	// MessageRetentionPeriod is the number of seconds messages are retained
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=1209600
+	MessageRetentionPeriod *int `json:"messageRetentionPeriod,omitempty"`
+}
+
+// QueueConsumerSettings defines how messages are delivered to a consumer
+type QueueConsumerSettings struct {
+	// BatchSize is the maximum number of messages per batch
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	BatchSize *int `json:"batchSize,omitempty"`
+
+	// MaxRetries is the number of times a message is retried before it is
+	// sent to the dead letter queue or dropped
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// MaxWaitTimeMs is how long a batch is filled before it is delivered (worker consumers)
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxWaitTimeMs *int `json:"maxWaitTimeMs,omitempty"`
+
+	// MaxConcurrency is the maximum number of concurrent consumer invocations (worker consumers)
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+
+	// VisibilityTimeoutMs is how long pulled messages are hidden from other pulls (http_pull consumers)
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	VisibilityTimeoutMs *int `json:"visibilityTimeoutMs,omitempty"`
+
+	// RetryDelay is the number of seconds a failed message is delayed before it is retried
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	RetryDelay *int `json:"retryDelay,omitempty"`
+}
+
+// QueueConsumer defines a consumer of the queue
+type QueueConsumer struct {
+	// Type is the consumer type
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=worker
+	Type QueueConsumerType `json:"type,omitempty"`
+
+	// ScriptName is the Worker script that consumes the queue
+	// Required for worker consumers
+	// +kubebuilder:validation:Optional
+	ScriptName string `json:"scriptName,omitempty"`
+
+	// DeadLetterQueue is the name of the queue messages are sent to once their retries are exhausted
+	// +kubebuilder:validation:Optional
+	DeadLetterQueue string `json:"deadLetterQueue,omitempty"`
+
+	// Settings defines how messages are delivered to the consumer
+	// +kubebuilder:validation:Optional
+	Settings *QueueConsumerSettings `json:"settings,omitempty"`
+}
+
+// QueueSpec defines the desired state of Queue
+type QueueSpec struct {
+	// Name is the name of the queue in Cloudflare
+	// If not specified, defaults to the Kubernetes resource name
+	// An existing queue with this name is adopted only as allowed by AdoptionPolicy
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-]{0,62}$`
+	Name string `json:"name,omitempty"`
+
+	// AdoptionPolicy defines how an existing queue with the name is handled
+	// IfExists: Adopt it, or create the queue if there is none
+	// MustExist: Adopt it, and fail if there is none
+	// MustNotExist: Fail if it exists, and create the queue otherwise
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=IfExists;MustExist;MustNotExist
+	// +kubebuilder:default=MustNotExist
+	AdoptionPolicy string `json:"adoptionPolicy,omitempty"`
+
+	// Settings defines the settings of the queue
+	// +kubebuilder:validation:Optional
+	Settings *QueueSettings `json:"settings,omitempty"`
+
+	// Consumers defines the consumers of the queue
+	// Consumers created by the operator that are removed from this list are deleted;
+	// consumers created outside the operator are left alone
+	// +kubebuilder:validation:Optional
+	Consumers []QueueConsumer `json:"consumers,omitempty"`
+
+	// CredentialsRef references a CloudflareCredentials resource
+	// If not specified, the default CloudflareCredentials will be used
+	// +kubebuilder:validation:Optional
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted
+	// Delete: The queue will be deleted from Cloudflare
+	// Orphan: The queue will be left in Cloudflare
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long a failed deletion from Cloudflare is retried
+	// Once it has passed, the finalizer is removed and the Cloudflare resource may be orphaned
+	// If not specified, the operator's --deletion-timeout is used
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
+}
+
+// QueueConsumerStatus is the observed state of a queue consumer
+type QueueConsumerStatus struct {
+	// ConsumerID is the Cloudflare ID of the consumer
+	ConsumerID string `json:"consumerId"`
+
+	// Type is the consumer type
+	Type QueueConsumerType `json:"type"`
+
+	// ScriptName is the Worker script of a worker consumer
+	// +optional
+	ScriptName string `json:"scriptName,omitempty"`
+
+	// Ready is true when the consumer matches the spec
+	Ready bool `json:"ready"`
+
+	// Message explains why the consumer is not ready
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// QueueStatus defines the observed state of Queue
+type QueueStatus struct {
+	// Conditions represent the latest available observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// State represents the current state of the queue
+	// +optional
+	State QueueState `json:"state,omitempty"`
+
+	// QueueID is the Cloudflare ID of the queue
+	// +optional
+	QueueID string `json:"queueId,omitempty"`
+
+	// QueueName is the actual name of the queue in Cloudflare
+	// +optional
+	QueueName string `json:"queueName,omitempty"`
+
+	// Consumers is the status of the consumers created by the operator
+	// +optional
+	Consumers []QueueConsumerStatus `json:"consumers,omitempty"`
+
+	// ConsumerCount is the number of ready consumers
+	// +optional
+	ConsumerCount int `json:"consumerCount,omitempty"`
+
+	// Message provides additional information about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfqueue;cfq
+// +kubebuilder:printcolumn:name="Queue",type=string,JSONPath=`.status.queueName`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.queueId`,priority=1
+// +kubebuilder:printcolumn:name="Consumers",type=integer,JSONPath=`.status.consumerCount`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Queue manages a Cloudflare Queue and its consumers.
+// R2BucketNotification sends events to a queue by its name.
+type Queue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QueueSpec   `json:"spec,omitempty"`
+	Status QueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueueList contains a list of Queue
+type QueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Queue `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Queue{}, &QueueList{})
+}
@@ -150,6 +150,12 @@ type R2BucketSpec struct {
 	// +kubebuilder:validation:Enum=Delete;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long deleting the bucket is retried; Cloudflare only deletes empty buckets.
+	// After it, the finalizer is removed and the bucket stays in Cloudflare with its objects.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // R2BucketStatus defines the observed state of R2Bucket
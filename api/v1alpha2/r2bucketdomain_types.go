@@ -70,6 +70,20 @@ type R2BucketDomainSpec struct {
 	// If not specified, the default CloudflareCredentials will be used
 	// +kubebuilder:validation:Optional
 	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted
+	// Delete: The custom domain will be deleted from Cloudflare
+	// Orphan: The custom domain will be left in Cloudflare
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long detaching the domain from the bucket is retried.
+	// After it, the finalizer is removed and the domain may still serve the bucket.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // R2BucketDomainStatus defines the observed state of R2BucketDomain
@@ -98,6 +112,21 @@ type R2BucketDomainStatus struct {
 	// +optional
 	ZoneID string `json:"zoneId,omitempty"`
 
+	// ZoneName is the name of the zone the domain belongs to
+	// Cloudflare adds the DNS record of the domain to this zone
+	// +optional
+	ZoneName string `json:"zoneName,omitempty"`
+
+	// OwnershipStatus is the ownership verification status reported by Cloudflare
+	// (pending, active, deactivated, blocked, error or unknown)
+	// +optional
+	OwnershipStatus string `json:"ownershipStatus,omitempty"`
+
+	// SSLStatus is the SSL certificate status reported by Cloudflare
+	// (initializing, pending, active, deactivated, error or unknown)
+	// +optional
+	SSLStatus string `json:"sslStatus,omitempty"`
+
 	// Enabled indicates if the domain is enabled
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
@@ -121,6 +150,8 @@ type R2BucketDomainStatus struct {
 // +kubebuilder:printcolumn:name="Bucket",type=string,JSONPath=`.spec.bucketName`
 // +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.spec.domain`
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Ownership",type=string,JSONPath=`.status.ownershipStatus`,priority=1
+// +kubebuilder:printcolumn:name="SSL",type=string,JSONPath=`.status.sslStatus`,priority=1
 // +kubebuilder:printcolumn:name="Public",type=boolean,JSONPath=`.status.publicAccessEnabled`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
@@ -61,15 +61,44 @@ type R2BucketNotificationSpec struct {
 	// +kubebuilder:validation:Required
 	QueueName string `json:"queueName"`
 
-	// Rules defines the notification rules
-	// +kubebuilder:validation:Required
+	// EventTypes is a shorthand for a single rule: the event types to notify on for
+	// objects matching Prefix and Suffix. It is used in addition to Rules.
+	// Either EventTypes or Rules must be set.
+	// +kubebuilder:validation:Optional
+	EventTypes []R2EventType `json:"eventTypes,omitempty"`
+
+	// Prefix filters the events of EventTypes to objects with keys starting with this prefix
+	// +kubebuilder:validation:Optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Suffix filters the events of EventTypes to objects with keys ending with this suffix
+	// +kubebuilder:validation:Optional
+	Suffix string `json:"suffix,omitempty"`
+
+	// Rules defines the notification rules, each with its own event types and filters
+	// Either EventTypes or Rules must be set.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinItems=1
-	Rules []R2NotificationRule `json:"rules"`
+	Rules []R2NotificationRule `json:"rules,omitempty"`
 
 	// CredentialsRef references a CloudflareCredentials resource
 	// If not specified, the default CloudflareCredentials will be used
 	// +kubebuilder:validation:Optional
 	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+
+	// DeletionPolicy specifies what happens when the Kubernetes resource is deleted
+	// Delete: The notification rule will be deleted from Cloudflare
+	// Orphan: The notification rule will be left in Cloudflare
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DeletionTimeout is how long removing the notification rule from the bucket is retried.
+	// After it, the finalizer is removed and the bucket may keep sending events to the queue.
+	// If not specified, the operator's --deletion-timeout is used.
+	// +kubebuilder:validation:Optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
 }
 
 // R2BucketNotificationStatus defines the observed state of R2BucketNotification
@@ -79,6 +79,13 @@ type RedirectRuleDefinition struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=false
 	PreserveQueryString bool `json:"preserveQueryString,omitempty"`
+
+	// PreservePathSuffix appends the request path to the static target URL,
+	// e.g. target https://example.com/docs and request /intro redirect to https://example.com/docs/intro.
+	// Requires target.url; with target.expression, build the path into the expression instead.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	PreservePathSuffix bool `json:"preservePathSuffix,omitempty"`
 }
 
 // WildcardRedirectRule defines a wildcard-based redirect rule
@@ -177,6 +184,10 @@ type RedirectRuleStatus struct {
 	// +optional
 	RuleCount int `json:"ruleCount,omitempty"`
 
+	// Rules reports the result of each rule, expression rules first, then wildcard rules
+	// +optional
+	Rules []RuleStatus `json:"rules,omitempty"`
+
 	// Message provides additional information about the current state
 	// +optional
 	Message string `json:"message,omitempty"`
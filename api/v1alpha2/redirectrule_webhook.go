@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (r *RedirectRule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&RedirectRuleValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-networking-cloudflare-operator-io-v1alpha2-redirectrule,mutating=false,failurePolicy=fail,sideEffects=None,groups=networking.cloudflare-operator.io,resources=redirectrules,verbs=create;update,versions=v1alpha2,name=vredirectrule.kb.io,admissionReviewVersions=v1
+
+// RedirectRuleValidator implements webhook validation for RedirectRule.
+type RedirectRuleValidator struct{}
+
+var _ webhook.CustomValidator = &RedirectRuleValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *RedirectRuleValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rule, ok := obj.(*RedirectRule)
+	if !ok {
+		return nil, fmt.Errorf("expected RedirectRule but got %T", obj)
+	}
+
+	var allErrs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+	for i, r := range rule.Spec.Rules {
+		path := rulesPath.Index(i)
+		allErrs = append(allErrs, validateRulesetExpressionField(path.Child("expression"), r.Expression)...)
+		allErrs = append(allErrs, validateRulesetExpressionField(
+			path.Child("target", "expression"), r.Target.Expression)...)
+		allErrs = append(allErrs, validateRedirectTarget(path, r)...)
+		allErrs = append(allErrs, validateRedirectStatusCode(path.Child("statusCode"), r.StatusCode)...)
+	}
+	wildcardPath := field.NewPath("spec", "wildcardRules")
+	for i, r := range rule.Spec.WildcardRules {
+		allErrs = append(allErrs, validateRedirectStatusCode(wildcardPath.Index(i).Child("statusCode"), r.StatusCode)...)
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "RedirectRule"},
+			rule.Name, allErrs)
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *RedirectRuleValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRule, ok := oldObj.(*RedirectRule)
+	if !ok {
+		return nil, fmt.Errorf("expected RedirectRule but got %T", oldObj)
+	}
+	newRule, ok := newObj.(*RedirectRule)
+	if !ok {
+		return nil, fmt.Errorf("expected RedirectRule but got %T", newObj)
+	}
+	if skipExpressionUpdateValidation(newRule, oldRule.Spec, newRule.Spec) {
+		return nil, nil
+	}
+
+	// Same validation rules as create
+	return v.ValidateCreate(ctx, newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *RedirectRuleValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	// No validation needed for deletion
+	return nil, nil
+}
+
+// validateRedirectTarget checks that a rule has exactly one target and that the redirect options fit it.
+func validateRedirectTarget(path *field.Path, r RedirectRuleDefinition) field.ErrorList {
+	var errs field.ErrorList
+	targetPath := path.Child("target")
+
+	switch {
+	case r.Target.URL == "" && r.Target.Expression == "":
+		return append(errs, field.Required(targetPath, "one of url or expression is required"))
+	case r.Target.URL != "" && r.Target.Expression != "":
+		return append(errs, field.Invalid(targetPath, r.Target.URL, "url and expression are mutually exclusive"))
+	case r.Target.Expression != "":
+		if r.PreservePathSuffix {
+			errs = append(errs, field.Forbidden(path.Child("preservePathSuffix"),
+				"preservePathSuffix requires target.url; append http.request.uri.path in target.expression instead"))
+		}
+		return errs
+	}
+
+	u, err := url.Parse(r.Target.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return append(errs, field.Invalid(targetPath.Child("url"), r.Target.URL,
+			"must be an absolute http or https URL"))
+	}
+	if r.PreservePathSuffix && (u.RawQuery != "" || u.Fragment != "" || strings.HasSuffix(r.Target.URL, "?")) {
+		errs = append(errs, field.Invalid(targetPath.Child("url"), r.Target.URL,
+			"must not contain a query string or fragment when preservePathSuffix is set"))
+	}
+	return errs
+}
+
+// validateRedirectStatusCode checks that the status code is a redirect supported by Cloudflare.
+// Zero is accepted as the CRD default applies.
+func validateRedirectStatusCode(path *field.Path, code RedirectStatusCode) field.ErrorList {
+	switch code {
+	case 0, RedirectStatusMovedPermanently, RedirectStatusFound,
+		RedirectStatusTemporaryRedirect, RedirectStatusPermanentRedirect:
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(path, code, []string{"301", "302", "307", "308"})}
+}
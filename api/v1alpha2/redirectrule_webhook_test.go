@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestRedirectRuleValidator_Targets(t *testing.T) {
+	validator := &RedirectRuleValidator{}
+
+	tests := []struct {
+		name    string
+		rule    RedirectRuleDefinition
+		wantErr string
+	}{
+		{
+			name: "static target preserving path and query",
+			rule: RedirectRuleDefinition{
+				Target:              RedirectTarget{URL: "https://example.com/docs"},
+				StatusCode:          RedirectStatusPermanentRedirect,
+				PreserveQueryString: true,
+				PreservePathSuffix:  true,
+			},
+		},
+		{
+			name: "expression target",
+			rule: RedirectRuleDefinition{
+				Target:     RedirectTarget{Expression: `concat("https://example.com", http.request.uri.path)`},
+				StatusCode: RedirectStatusTemporaryRedirect,
+			},
+		},
+		{
+			name:    "missing target",
+			rule:    RedirectRuleDefinition{StatusCode: RedirectStatusFound},
+			wantErr: "spec.rules[0].target: Required value",
+		},
+		{
+			name: "both targets",
+			rule: RedirectRuleDefinition{
+				Target: RedirectTarget{URL: "https://example.com", Expression: "http.host"},
+			},
+			wantErr: "mutually exclusive",
+		},
+		{
+			name:    "relative url",
+			rule:    RedirectRuleDefinition{Target: RedirectTarget{URL: "/new-path"}},
+			wantErr: "must be an absolute http or https URL",
+		},
+		{
+			name: "path suffix with expression target",
+			rule: RedirectRuleDefinition{
+				Target:             RedirectTarget{Expression: `"https://example.com"`},
+				PreservePathSuffix: true,
+			},
+			wantErr: "spec.rules[0].preservePathSuffix: Forbidden",
+		},
+		{
+			name: "path suffix with query in target",
+			rule: RedirectRuleDefinition{
+				Target:             RedirectTarget{URL: "https://example.com/search?q=1"},
+				PreservePathSuffix: true,
+			},
+			wantErr: "must not contain a query string",
+		},
+		{
+			name: "unsupported status code",
+			rule: RedirectRuleDefinition{
+				Target:     RedirectTarget{URL: "https://example.com"},
+				StatusCode: 303,
+			},
+			wantErr: "spec.rules[0].statusCode: Unsupported value",
+		},
+	}
+
+	for _, code := range []RedirectStatusCode{301, 302, 307, 308} {
+		tests = append(tests, struct {
+			name    string
+			rule    RedirectRuleDefinition
+			wantErr string
+		}{
+			name: fmt.Sprintf("status code %d", code),
+			rule: RedirectRuleDefinition{Target: RedirectTarget{URL: "https://example.com"}, StatusCode: code},
+		})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Name = "rule"
+			tt.rule.Expression = `http.request.uri.path eq "/old"`
+			redirect := &RedirectRule{
+				Spec: RedirectRuleSpec{Zone: "example.com", Rules: []RedirectRuleDefinition{tt.rule}},
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), redirect)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCreate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedirectRuleValidator_WildcardStatusCode(t *testing.T) {
+	validator := &RedirectRuleValidator{}
+
+	redirect := &RedirectRule{
+		Spec: RedirectRuleSpec{
+			Zone: "example.com",
+			WildcardRules: []WildcardRedirectRule{{
+				Name:       "blog",
+				SourceURL:  "https://example.com/blog/*",
+				TargetURL:  "https://blog.example.com/${1}",
+				StatusCode: 300,
+			}},
+		},
+	}
+
+	_, err := validator.ValidateCreate(context.Background(), redirect)
+	if err == nil || !contains(err.Error(), "spec.wildcardRules[0].statusCode") {
+		t.Errorf("ValidateCreate() error = %v, want error for spec.wildcardRules[0].statusCode", err)
+	}
+}
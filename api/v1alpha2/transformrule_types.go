@@ -169,6 +169,10 @@ type TransformRuleStatus struct {
 	// +optional
 	RuleCount int `json:"ruleCount,omitempty"`
 
+	// Rules reports the result of each rule, in spec order
+	// +optional
+	Rules []RuleStatus `json:"rules,omitempty"`
+
 	// Message provides additional information about the current state
 	// +optional
 	Message string `json:"message,omitempty"`
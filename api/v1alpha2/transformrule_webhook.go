@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (r *TransformRule) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithValidator(&TransformRuleValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-networking-cloudflare-operator-io-v1alpha2-transformrule,mutating=false,failurePolicy=fail,sideEffects=None,groups=networking.cloudflare-operator.io,resources=transformrules,verbs=create;update,versions=v1alpha2,name=vtransformrule.kb.io,admissionReviewVersions=v1
+
+// TransformRuleValidator implements webhook validation for TransformRule.
+type TransformRuleValidator struct{}
+
+var _ webhook.CustomValidator = &TransformRuleValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *TransformRuleValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rule, ok := obj.(*TransformRule)
+	if !ok {
+		return nil, fmt.Errorf("expected TransformRule but got %T", obj)
+	}
+
+	var allErrs field.ErrorList
+	rulesPath := field.NewPath("spec", "rules")
+	for i, r := range rule.Spec.Rules {
+		path := rulesPath.Index(i)
+		allErrs = append(allErrs, validateRulesetExpressionField(path.Child("expression"), r.Expression)...)
+		if r.URLRewrite != nil {
+			if r.URLRewrite.Path != nil {
+				allErrs = append(allErrs, validateRulesetExpressionField(
+					path.Child("urlRewrite", "path", "expression"), r.URLRewrite.Path.Expression)...)
+			}
+			if r.URLRewrite.Query != nil {
+				allErrs = append(allErrs, validateRulesetExpressionField(
+					path.Child("urlRewrite", "query", "expression"), r.URLRewrite.Query.Expression)...)
+			}
+		}
+		for j, h := range r.Headers {
+			allErrs = append(allErrs, validateRulesetExpressionField(
+				path.Child("headers").Index(j).Child("expression"), h.Expression)...)
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "TransformRule"},
+			rule.Name, allErrs)
+	}
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *TransformRuleValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRule, ok := oldObj.(*TransformRule)
+	if !ok {
+		return nil, fmt.Errorf("expected TransformRule but got %T", oldObj)
+	}
+	newRule, ok := newObj.(*TransformRule)
+	if !ok {
+		return nil, fmt.Errorf("expected TransformRule but got %T", newObj)
+	}
+	if skipExpressionUpdateValidation(newRule, oldRule.Spec, newRule.Spec) {
+		return nil, nil
+	}
+
+	// Same validation rules as create
+	return v.ValidateCreate(ctx, newObj)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *TransformRuleValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	// No validation needed for deletion
+	return nil, nil
+}
+
+// validateRulesetExpressionField validates an optional Rules language expression field.
+func validateRulesetExpressionField(path *field.Path, expr string) field.ErrorList {
+	if expr == "" {
+		return nil
+	}
+	if err := ValidateRulesetExpression(expr); err != nil {
+		return field.ErrorList{field.Invalid(path, expr, err.Error())}
+	}
+	return nil
+}
@@ -20,7 +20,8 @@ type VirtualNetworkSpec struct {
 	Comment string `json:"comment,omitempty"`
 
 	// IsDefaultNetwork marks this Virtual Network as the default for the account.
-	// Only one Virtual Network can be the default.
+	// Only one Virtual Network can be the default: the previous default is demoted,
+	// and when several resources claim it the oldest one wins.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=false
 	IsDefaultNetwork bool `json:"isDefaultNetwork,omitempty"`
@@ -121,6 +121,14 @@ type WARPConnectorStatus struct {
 	// +kubebuilder:validation:Optional
 	AccountID string `json:"accountId,omitempty"`
 
+	// TokenSecretName is the name of the Secret holding the connector token.
+	// +kubebuilder:validation:Optional
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
+
+	// TokenRotatedAt is the time the connector token was last rotated.
+	// +kubebuilder:validation:Optional
+	TokenRotatedAt *metav1.Time `json:"tokenRotatedAt,omitempty"`
+
 	// ReadyReplicas is the number of ready connector pods.
 	// +kubebuilder:validation:Optional
 	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
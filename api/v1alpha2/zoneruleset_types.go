@@ -500,6 +500,29 @@ type ZoneRulesetSpec struct {
 	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
 }
 
+// RuleStatus reports whether a single rule of a ruleset was applied in Cloudflare.
+// It is shared by ZoneRuleset, TransformRule and RedirectRule.
+type RuleStatus struct {
+	// Index is the position of the rule in the ruleset built from the spec
+	Index int `json:"index"`
+
+	// Name identifies the rule by its ref, name or description
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Applied is true when the rule is part of the Cloudflare ruleset
+	Applied bool `json:"applied"`
+
+	// Error is the reason Cloudflare rejected the rule
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// Hash identifies the content of a rejected rule. The rule is not sent
+	// to Cloudflare again until its spec changes.
+	// +optional
+	Hash string `json:"hash,omitempty"`
+}
+
 // ZoneRulesetStatus defines the observed state of ZoneRuleset
 type ZoneRulesetStatus struct {
 	// Conditions represent the latest available observations
@@ -530,6 +553,10 @@ type ZoneRulesetStatus struct {
 	// +optional
 	RuleCount int `json:"ruleCount,omitempty"`
 
+	// Rules reports the result of each rule, in spec order
+	// +optional
+	Rules []RuleStatus `json:"rules,omitempty"`
+
 	// LastUpdated is the last time the ruleset was updated
 	// +optional
 	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
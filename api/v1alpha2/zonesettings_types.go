@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ZoneSettingsState represents the state of the zone settings
+// +kubebuilder:validation:Enum=Pending;Syncing;Ready;Error
+type ZoneSettingsState string
+
+const (
+	// ZoneSettingsStatePending means the settings are waiting to be synced
+	ZoneSettingsStatePending ZoneSettingsState = "Pending"
+	// ZoneSettingsStateSyncing means the settings are being synced
+	ZoneSettingsStateSyncing ZoneSettingsState = "Syncing"
+	// ZoneSettingsStateReady means the settings are synced
+	ZoneSettingsStateReady ZoneSettingsState = "Ready"
+	// ZoneSettingsStateError means there was an error syncing the settings
+	ZoneSettingsStateError ZoneSettingsState = "Error"
+)
+
+// ZoneSSLMode is the value of the Cloudflare "ssl" zone setting.
+// Unlike SSLMode it has no full_strict alias, which the zone settings API rejects.
+// +kubebuilder:validation:Enum=off;flexible;full;strict
+type ZoneSSLMode string
+
+// ZoneSettingsSpec defines the desired state of ZoneSettings.
+// Only settings that are set are managed; unset settings are left as they are in Cloudflare.
+type ZoneSettingsSpec struct {
+	// Zone is the zone name (domain) to configure
+	// +kubebuilder:validation:Required
+	Zone string `json:"zone"`
+
+	// SSL is the SSL/TLS encryption mode
+	// +kubebuilder:validation:Optional
+	SSL ZoneSSLMode `json:"ssl,omitempty"`
+
+	// AlwaysUseHTTPS redirects all HTTP requests to HTTPS
+	// +kubebuilder:validation:Optional
+	AlwaysUseHTTPS *bool `json:"alwaysUseHttps,omitempty"`
+
+	// AutomaticHTTPSRewrites rewrites HTTP links in HTML to HTTPS
+	// +kubebuilder:validation:Optional
+	AutomaticHTTPSRewrites *bool `json:"automaticHttpsRewrites,omitempty"`
+
+	// MinTLSVersion is the minimum TLS version accepted by the edge
+	// +kubebuilder:validation:Optional
+	MinTLSVersion TLSVersion `json:"minTLSVersion,omitempty"`
+
+	// Brotli enables Brotli compression
+	// +kubebuilder:validation:Optional
+	Brotli *bool `json:"brotli,omitempty"`
+
+	// CredentialsRef references a CloudflareCredentials resource
+	// If not specified, the default CloudflareCredentials will be used
+	// +kubebuilder:validation:Optional
+	CredentialsRef *CredentialsReference `json:"credentialsRef,omitempty"`
+}
+
+// ZoneSettingsStatus defines the observed state of ZoneSettings
+type ZoneSettingsStatus struct {
+	// Conditions represent the latest available observations
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// State represents the current state of the settings
+	// +optional
+	State ZoneSettingsState `json:"state,omitempty"`
+
+	// ZoneID is the Cloudflare zone ID
+	// +optional
+	ZoneID string `json:"zoneId,omitempty"`
+
+	// ReadOnlySettings lists the managed settings that cannot be changed on the zone's plan
+	// and differ from the desired value
+	// +optional
+	ReadOnlySettings []string `json:"readOnlySettings,omitempty"`
+
+	// LastSyncTime is the last time settings were updated in Cloudflare,
+	// or the first time they were found in sync
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Message provides additional information about the current state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=cfzonesettings;zonesetting
+// +kubebuilder:printcolumn:name="Zone",type=string,JSONPath=`.spec.zone`
+// +kubebuilder:printcolumn:name="SSL",type=string,JSONPath=`.spec.ssl`
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ZoneSettings manages common Cloudflare zone settings:
+// SSL mode, Always Use HTTPS, Automatic HTTPS Rewrites, minimum TLS version and Brotli.
+//
+// The controller periodically compares the settings with Cloudflare and only updates
+// the ones that drifted. Deleting the resource leaves the settings unchanged.
+type ZoneSettings struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ZoneSettingsSpec   `json:"spec,omitempty"`
+	Status ZoneSettingsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ZoneSettingsList contains a list of ZoneSettings
+type ZoneSettingsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ZoneSettings `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ZoneSettings{}, &ZoneSettingsList{})
+}
@@ -70,6 +70,55 @@ func (in *AccessApplication) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApplicationClientCertificate) DeepCopyInto(out *AccessApplicationClientCertificate) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApplicationClientCertificate.
+func (in *AccessApplicationClientCertificate) DeepCopy() *AccessApplicationClientCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApplicationClientCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApplicationClientCertificateStatus) DeepCopyInto(out *AccessApplicationClientCertificateStatus) {
+	*out = *in
+	if in.AssociatedHostnames != nil {
+		in, out := &in.AssociatedHostnames, &out.AssociatedHostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresOn != nil {
+		in, out := &in.ExpiresOn, &out.ExpiresOn
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingReleaseIDs != nil {
+		in, out := &in.PendingReleaseIDs, &out.PendingReleaseIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApplicationClientCertificateStatus.
+func (in *AccessApplicationClientCertificateStatus) DeepCopy() *AccessApplicationClientCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApplicationClientCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessApplicationCorsHeaders) DeepCopyInto(out *AccessApplicationCorsHeaders) {
 	*out = *in
@@ -169,6 +218,33 @@ func (in *AccessApplicationSCIMConfig) DeepCopy() *AccessApplicationSCIMConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessApplicationSCIMStatus) DeepCopyInto(out *AccessApplicationSCIMStatus) {
+	*out = *in
+	if in.LastSync != nil {
+		in, out := &in.LastSync, &out.LastSync
+		*out = (*in).DeepCopy()
+	}
+	if in.LastErrorTime != nil {
+		in, out := &in.LastErrorTime, &out.LastErrorTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApplicationSCIMStatus.
+func (in *AccessApplicationSCIMStatus) DeepCopy() *AccessApplicationSCIMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessApplicationSCIMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessApplicationSpec) DeepCopyInto(out *AccessApplicationSpec) {
 	*out = *in
@@ -239,11 +315,21 @@ func (in *AccessApplicationSpec) DeepCopyInto(out *AccessApplicationSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AutoCreateTags != nil {
+		in, out := &in.AutoCreateTags, &out.AutoCreateTags
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CustomPages != nil {
 		in, out := &in.CustomPages, &out.CustomPages
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CustomPageRefs != nil {
+		in, out := &in.CustomPageRefs, &out.CustomPageRefs
+		*out = make([]AccessCustomPageRef, len(*in))
+		copy(*out, *in)
+	}
 	if in.GatewayRules != nil {
 		in, out := &in.GatewayRules, &out.GatewayRules
 		*out = make([]string, len(*in))
@@ -276,6 +362,11 @@ func (in *AccessApplicationSpec) DeepCopyInto(out *AccessApplicationSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(AccessApplicationClientCertificate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Policies != nil {
 		in, out := &in.Policies, &out.Policies
 		*out = make([]AccessPolicyRef, len(*in))
@@ -296,6 +387,11 @@ func (in *AccessApplicationSpec) DeepCopyInto(out *AccessApplicationSpec) {
 		copy(*out, *in)
 	}
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessApplicationSpec.
@@ -331,6 +427,16 @@ func (in *AccessApplicationStatus) DeepCopyInto(out *AccessApplicationStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SCIM != nil {
+		in, out := &in.SCIM, &out.SCIM
+		*out = new(AccessApplicationSCIMStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(AccessApplicationClientCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -350,6 +456,123 @@ func (in *AccessApplicationStatus) DeepCopy() *AccessApplicationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCustomPage) DeepCopyInto(out *AccessCustomPage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCustomPage.
+func (in *AccessCustomPage) DeepCopy() *AccessCustomPage {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCustomPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessCustomPage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCustomPageList) DeepCopyInto(out *AccessCustomPageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessCustomPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCustomPageList.
+func (in *AccessCustomPageList) DeepCopy() *AccessCustomPageList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCustomPageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessCustomPageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCustomPageRef) DeepCopyInto(out *AccessCustomPageRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCustomPageRef.
+func (in *AccessCustomPageRef) DeepCopy() *AccessCustomPageRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCustomPageRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCustomPageSpec) DeepCopyInto(out *AccessCustomPageSpec) {
+	*out = *in
+	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCustomPageSpec.
+func (in *AccessCustomPageSpec) DeepCopy() *AccessCustomPageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCustomPageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessCustomPageStatus) DeepCopyInto(out *AccessCustomPageStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessCustomPageStatus.
+func (in *AccessCustomPageStatus) DeepCopy() *AccessCustomPageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessCustomPageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessDestination) DeepCopyInto(out *AccessDestination) {
 	*out = *in
@@ -944,6 +1167,11 @@ func (in *AccessGroupSpec) DeepCopyInto(out *AccessGroupSpec) {
 		**out = **in
 	}
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessGroupSpec.
@@ -1071,6 +1299,11 @@ func (in *AccessIdentityProviderSpec) DeepCopyInto(out *AccessIdentityProviderSp
 		(*in).DeepCopyInto(*out)
 	}
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessIdentityProviderSpec.
@@ -1293,6 +1526,11 @@ func (in *AccessPolicySpec) DeepCopyInto(out *AccessPolicySpec) {
 		}
 	}
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicySpec.
@@ -1391,6 +1629,11 @@ func (in *AccessServiceTokenSpec) DeepCopyInto(out *AccessServiceTokenSpec) {
 	*out = *in
 	out.SecretRef = in.SecretRef
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessServiceTokenSpec.
@@ -1735,6 +1978,21 @@ func (in *ChecksumConfig) DeepCopy() *ChecksumConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChecksumVerificationStatus) DeepCopyInto(out *ChecksumVerificationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChecksumVerificationStatus.
+func (in *ChecksumVerificationStatus) DeepCopy() *ChecksumVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChecksumVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudflareCredentials) DeepCopyInto(out *CloudflareCredentials) {
 	*out = *in
@@ -1839,6 +2097,10 @@ func (in *CloudflareCredentialsStatus) DeepCopyInto(out *CloudflareCredentialsSt
 		in, out := &in.LastValidatedTime, &out.LastValidatedTime
 		*out = (*in).DeepCopy()
 	}
+	if in.TokenExpiresOn != nil {
+		in, out := &in.TokenExpiresOn, &out.TokenExpiresOn
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudflareCredentialsStatus.
@@ -2273,7 +2535,7 @@ func (in *CustomCertificateSettings) DeepCopy() *CustomCertificateSettings {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
+func (in *D1Database) DeepCopyInto(out *D1Database) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2281,18 +2543,18 @@ func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
-func (in *DNSRecord) DeepCopy() *DNSRecord {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new D1Database.
+func (in *D1Database) DeepCopy() *D1Database {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSRecord)
+	out := new(D1Database)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSRecord) DeepCopyObject() runtime.Object {
+func (in *D1Database) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2300,46 +2562,31 @@ func (in *DNSRecord) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSRecordData) DeepCopyInto(out *DNSRecordData) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordData.
-func (in *DNSRecordData) DeepCopy() *DNSRecordData {
-	if in == nil {
-		return nil
-	}
-	out := new(DNSRecordData)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSRecordList) DeepCopyInto(out *DNSRecordList) {
+func (in *D1DatabaseList) DeepCopyInto(out *D1DatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DNSRecord, len(*in))
+		*out = make([]D1Database, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordList.
-func (in *DNSRecordList) DeepCopy() *DNSRecordList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new D1DatabaseList.
+func (in *D1DatabaseList) DeepCopy() *D1DatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSRecordList)
+	out := new(D1DatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSRecordList) DeepCopyObject() runtime.Object {
+func (in *D1DatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2347,47 +2594,172 @@ func (in *DNSRecordList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSRecordSourceRef) DeepCopyInto(out *DNSRecordSourceRef) {
+func (in *D1DatabaseSpec) DeepCopyInto(out *D1DatabaseSpec) {
 	*out = *in
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(ServiceDNSSource)
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
 		**out = **in
 	}
-	if in.Ingress != nil {
-		in, out := &in.Ingress, &out.Ingress
-		*out = new(IngressDNSSource)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.HTTPRoute != nil {
-		in, out := &in.HTTPRoute, &out.HTTPRoute
-		*out = new(HTTPRouteDNSSource)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new D1DatabaseSpec.
+func (in *D1DatabaseSpec) DeepCopy() *D1DatabaseSpec {
+	if in == nil {
+		return nil
 	}
-	if in.Gateway != nil {
-		in, out := &in.Gateway, &out.Gateway
-		*out = new(GatewayDNSSource)
-		**out = **in
+	out := new(D1DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *D1DatabaseStatus) DeepCopyInto(out *D1DatabaseStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Node != nil {
-		in, out := &in.Node, &out.Node
-		*out = new(NodeDNSSource)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordSourceRef.
-func (in *DNSRecordSourceRef) DeepCopy() *DNSRecordSourceRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new D1DatabaseStatus.
+func (in *D1DatabaseStatus) DeepCopy() *D1DatabaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSRecordSourceRef)
+	out := new(D1DatabaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecord) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordData) DeepCopyInto(out *DNSRecordData) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordData.
+func (in *DNSRecordData) DeepCopy() *DNSRecordData {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordList) DeepCopyInto(out *DNSRecordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordList.
+func (in *DNSRecordList) DeepCopy() *DNSRecordList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSRecordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordSourceRef) DeepCopyInto(out *DNSRecordSourceRef) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceDNSSource)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(IngressDNSSource)
+		**out = **in
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(HTTPRouteDNSSource)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(GatewayDNSSource)
+		**out = **in
+	}
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = new(NodeDNSSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordSourceRef.
+func (in *DNSRecordSourceRef) DeepCopy() *DNSRecordSourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordSourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 	*out = *in
 	if in.SourceRef != nil {
 		in, out := &in.SourceRef, &out.SourceRef
@@ -2410,6 +2782,11 @@ func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 		**out = **in
 	}
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordSpec.
@@ -2968,6 +3345,11 @@ func (in *DirectUploadSource) DeepCopy() *DirectUploadSource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainConfiguration) DeepCopyInto(out *DomainConfiguration) {
 	*out = *in
+	if in.AutoRenew != nil {
+		in, out := &in.AutoRenew, &out.AutoRenew
+		*out = new(bool)
+		**out = **in
+	}
 	if in.NameServers != nil {
 		in, out := &in.NameServers, &out.NameServers
 		*out = make([]string, len(*in))
@@ -3092,6 +3474,11 @@ func (in *DomainRegistrationStatus) DeepCopyInto(out *DomainRegistrationStatus)
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainRegistrationStatus.
@@ -3134,9 +3521,37 @@ func (in *ExistingTunnel) DeepCopy() *ExistingTunnel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSyncStatus) DeepCopyInto(out *ExternalSyncStatus) {
+	*out = *in
+	if in.LastNotifiedAt != nil {
+		in, out := &in.LastNotifiedAt, &out.LastNotifiedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastPolledAt != nil {
+		in, out := &in.LastPolledAt, &out.LastPolledAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSyncStatus.
+func (in *ExternalSyncStatus) DeepCopy() *ExternalSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalVersionConfig) DeepCopyInto(out *ExternalVersionConfig) {
 	*out = *in
+	if in.WebhookSecretRef != nil {
+		in, out := &in.WebhookSecretRef, &out.WebhookSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
 	if in.SyncInterval != nil {
 		in, out := &in.SyncInterval, &out.SyncInterval
 		*out = new(v1.Duration)
@@ -3410,6 +3825,21 @@ func (in *GatewayListList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayListRejectedItem) DeepCopyInto(out *GatewayListRejectedItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayListRejectedItem.
+func (in *GatewayListRejectedItem) DeepCopy() *GatewayListRejectedItem {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayListRejectedItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayListSpec) DeepCopyInto(out *GatewayListSpec) {
 	*out = *in
@@ -3439,6 +3869,11 @@ func (in *GatewayListSpec) DeepCopy() *GatewayListSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayListStatus) DeepCopyInto(out *GatewayListStatus) {
 	*out = *in
+	if in.RejectedItems != nil {
+		in, out := &in.RejectedItems, &out.RejectedItems
+		*out = make([]GatewayListRejectedItem, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -3459,7 +3894,7 @@ func (in *GatewayListStatus) DeepCopy() *GatewayListStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayRule) DeepCopyInto(out *GatewayRule) {
+func (in *GatewayLocation) DeepCopyInto(out *GatewayLocation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3467,18 +3902,18 @@ func (in *GatewayRule) DeepCopyInto(out *GatewayRule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRule.
-func (in *GatewayRule) DeepCopy() *GatewayRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLocation.
+func (in *GatewayLocation) DeepCopy() *GatewayLocation {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayRule)
+	out := new(GatewayLocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GatewayRule) DeepCopyObject() runtime.Object {
+func (in *GatewayLocation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3486,46 +3921,86 @@ func (in *GatewayRule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayRuleExpiration) DeepCopyInto(out *GatewayRuleExpiration) {
+func (in *GatewayLocationEndpoint) DeepCopyInto(out *GatewayLocationEndpoint) {
 	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleExpiration.
-func (in *GatewayRuleExpiration) DeepCopy() *GatewayRuleExpiration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLocationEndpoint.
+func (in *GatewayLocationEndpoint) DeepCopy() *GatewayLocationEndpoint {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayRuleExpiration)
+	out := new(GatewayLocationEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayRuleList) DeepCopyInto(out *GatewayRuleList) {
+func (in *GatewayLocationEndpoints) DeepCopyInto(out *GatewayLocationEndpoints) {
+	*out = *in
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(GatewayLocationEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(GatewayLocationEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DoT != nil {
+		in, out := &in.DoT, &out.DoT
+		*out = new(GatewayLocationEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DoH != nil {
+		in, out := &in.DoH, &out.DoH
+		*out = new(GatewayLocationEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLocationEndpoints.
+func (in *GatewayLocationEndpoints) DeepCopy() *GatewayLocationEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayLocationEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayLocationList) DeepCopyInto(out *GatewayLocationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GatewayRule, len(*in))
+		*out = make([]GatewayLocation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleList.
-func (in *GatewayRuleList) DeepCopy() *GatewayRuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLocationList.
+func (in *GatewayLocationList) DeepCopy() *GatewayLocationList {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayRuleList)
+	out := new(GatewayLocationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GatewayRuleList) DeepCopyObject() runtime.Object {
+func (in *GatewayLocationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3533,41 +4008,173 @@ func (in *GatewayRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayRuleSchedule) DeepCopyInto(out *GatewayRuleSchedule) {
+func (in *GatewayLocationSpec) DeepCopyInto(out *GatewayLocationSpec) {
 	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ECSSupport != nil {
+		in, out := &in.ECSSupport, &out.ECSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PolicyIDs != nil {
+		in, out := &in.PolicyIDs, &out.PolicyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(GatewayLocationEndpoints)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleSchedule.
-func (in *GatewayRuleSchedule) DeepCopy() *GatewayRuleSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLocationSpec.
+func (in *GatewayLocationSpec) DeepCopy() *GatewayLocationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayRuleSchedule)
+	out := new(GatewayLocationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayRuleSettings) DeepCopyInto(out *GatewayRuleSettings) {
+func (in *GatewayLocationStatus) DeepCopyInto(out *GatewayLocationStatus) {
 	*out = *in
-	if in.BlockPageEnabled != nil {
-		in, out := &in.BlockPageEnabled, &out.BlockPageEnabled
-		*out = new(bool)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.OverrideIPs != nil {
-		in, out := &in.OverrideIPs, &out.OverrideIPs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLocationStatus.
+func (in *GatewayLocationStatus) DeepCopy() *GatewayLocationStatus {
+	if in == nil {
+		return nil
 	}
-	if in.L4Override != nil {
-		in, out := &in.L4Override, &out.L4Override
-		*out = new(L4OverrideSettings)
-		**out = **in
+	out := new(GatewayLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRule) DeepCopyInto(out *GatewayRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRule.
+func (in *GatewayRule) DeepCopy() *GatewayRule {
+	if in == nil {
+		return nil
 	}
-	if in.BISOAdminControls != nil {
-		in, out := &in.BISOAdminControls, &out.BISOAdminControls
-		*out = new(BISOAdminControls)
+	out := new(GatewayRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRuleExpiration) DeepCopyInto(out *GatewayRuleExpiration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleExpiration.
+func (in *GatewayRuleExpiration) DeepCopy() *GatewayRuleExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRuleExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRuleList) DeepCopyInto(out *GatewayRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GatewayRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleList.
+func (in *GatewayRuleList) DeepCopy() *GatewayRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GatewayRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRuleSchedule) DeepCopyInto(out *GatewayRuleSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleSchedule.
+func (in *GatewayRuleSchedule) DeepCopy() *GatewayRuleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRuleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRuleSettings) DeepCopyInto(out *GatewayRuleSettings) {
+	*out = *in
+	if in.BlockPageEnabled != nil {
+		in, out := &in.BlockPageEnabled, &out.BlockPageEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OverrideIPs != nil {
+		in, out := &in.OverrideIPs, &out.OverrideIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.L4Override != nil {
+		in, out := &in.L4Override, &out.L4Override
+		*out = new(L4OverrideSettings)
+		**out = **in
+	}
+	if in.BISOAdminControls != nil {
+		in, out := &in.BISOAdminControls, &out.BISOAdminControls
+		*out = new(BISOAdminControls)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CheckSession != nil {
@@ -3722,6 +4329,21 @@ func (in *GatewayRuleStatus) DeepCopy() *GatewayRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayRuleValidator) DeepCopyInto(out *GatewayRuleValidator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRuleValidator.
+func (in *GatewayRuleValidator) DeepCopy() *GatewayRuleValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayRuleValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewaySettings) DeepCopyInto(out *GatewaySettings) {
 	*out = *in
@@ -3918,6 +4540,21 @@ func (in *HTTPSourceTemplate) DeepCopy() *HTTPSourceTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HandledRollback) DeepCopyInto(out *HandledRollback) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandledRollback.
+func (in *HandledRollback) DeepCopy() *HandledRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(HandledRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeaderModification) DeepCopyInto(out *HeaderModification) {
 	*out = *in
@@ -3958,6 +4595,179 @@ func (in *HybridAndImplicitOptions) DeepCopy() *HybridAndImplicitOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdriveCaching) DeepCopyInto(out *HyperdriveCaching) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int)
+		**out = **in
+	}
+	if in.StaleWhileRevalidate != nil {
+		in, out := &in.StaleWhileRevalidate, &out.StaleWhileRevalidate
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdriveCaching.
+func (in *HyperdriveCaching) DeepCopy() *HyperdriveCaching {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdriveCaching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdriveConfig) DeepCopyInto(out *HyperdriveConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdriveConfig.
+func (in *HyperdriveConfig) DeepCopy() *HyperdriveConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdriveConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperdriveConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdriveConfigList) DeepCopyInto(out *HyperdriveConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HyperdriveConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdriveConfigList.
+func (in *HyperdriveConfigList) DeepCopy() *HyperdriveConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdriveConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HyperdriveConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdriveConfigSpec) DeepCopyInto(out *HyperdriveConfigSpec) {
+	*out = *in
+	out.Origin = in.Origin
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(HyperdriveCaching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
+		**out = **in
+	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdriveConfigSpec.
+func (in *HyperdriveConfigSpec) DeepCopy() *HyperdriveConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdriveConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdriveConfigStatus) DeepCopyInto(out *HyperdriveConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdriveConfigStatus.
+func (in *HyperdriveConfigStatus) DeepCopy() *HyperdriveConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdriveConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdriveOrigin) DeepCopyInto(out *HyperdriveOrigin) {
+	*out = *in
+	out.PasswordSecretRef = in.PasswordSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdriveOrigin.
+func (in *HyperdriveOrigin) DeepCopy() *HyperdriveOrigin {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdriveOrigin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HyperdrivePasswordSecretRef) DeepCopyInto(out *HyperdrivePasswordSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HyperdrivePasswordSecretRef.
+func (in *HyperdrivePasswordSecretRef) DeepCopy() *HyperdrivePasswordSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(HyperdrivePasswordSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProviderConfig) DeepCopyInto(out *IdentityProviderConfig) {
 	*out = *in
@@ -4014,51 +4824,162 @@ func (in *IdentityProviderConfig) DeepCopy() *IdentityProviderConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IdentityProviderScimConfig) DeepCopyInto(out *IdentityProviderScimConfig) {
+func (in *IdentityProviderScimConfig) DeepCopyInto(out *IdentityProviderScimConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserDeprovision != nil {
+		in, out := &in.UserDeprovision, &out.UserDeprovision
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SeatDeprovision != nil {
+		in, out := &in.SeatDeprovision, &out.SeatDeprovision
+		*out = new(bool)
+		**out = **in
+	}
+	if in.GroupMemberDeprovision != nil {
+		in, out := &in.GroupMemberDeprovision, &out.GroupMemberDeprovision
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ScimSecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderScimConfig.
+func (in *IdentityProviderScimConfig) DeepCopy() *IdentityProviderScimConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderScimConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressDNSSource) DeepCopyInto(out *IngressDNSSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressDNSSource.
+func (in *IngressDNSSource) DeepCopy() *IngressDNSSource {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressDNSSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVNamespace) DeepCopyInto(out *KVNamespace) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVNamespace.
+func (in *KVNamespace) DeepCopy() *KVNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(KVNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KVNamespace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVNamespaceList) DeepCopyInto(out *KVNamespaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KVNamespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVNamespaceList.
+func (in *KVNamespaceList) DeepCopy() *KVNamespaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(KVNamespaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KVNamespaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KVNamespaceSpec) DeepCopyInto(out *KVNamespaceSpec) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.UserDeprovision != nil {
-		in, out := &in.UserDeprovision, &out.UserDeprovision
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SeatDeprovision != nil {
-		in, out := &in.SeatDeprovision, &out.SeatDeprovision
-		*out = new(bool)
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
 		**out = **in
 	}
-	if in.GroupMemberDeprovision != nil {
-		in, out := &in.GroupMemberDeprovision, &out.GroupMemberDeprovision
-		*out = new(bool)
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderScimConfig.
-func (in *IdentityProviderScimConfig) DeepCopy() *IdentityProviderScimConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVNamespaceSpec.
+func (in *KVNamespaceSpec) DeepCopy() *KVNamespaceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IdentityProviderScimConfig)
+	out := new(KVNamespaceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressDNSSource) DeepCopyInto(out *IngressDNSSource) {
+func (in *KVNamespaceStatus) DeepCopyInto(out *KVNamespaceStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressDNSSource.
-func (in *IngressDNSSource) DeepCopy() *IngressDNSSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KVNamespaceStatus.
+func (in *KVNamespaceStatus) DeepCopy() *KVNamespaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressDNSSource)
+	out := new(KVNamespaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4613,6 +5534,11 @@ func (in *PagesBuildConfigStatus) DeepCopy() *PagesBuildConfigStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagesD1Binding) DeepCopyInto(out *PagesD1Binding) {
 	*out = *in
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(PagesD1DatabaseRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesD1Binding.
@@ -4625,6 +5551,21 @@ func (in *PagesD1Binding) DeepCopy() *PagesD1Binding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesD1DatabaseRef) DeepCopyInto(out *PagesD1DatabaseRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesD1DatabaseRef.
+func (in *PagesD1DatabaseRef) DeepCopy() *PagesD1DatabaseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PagesD1DatabaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagesDeployment) DeepCopyInto(out *PagesDeployment) {
 	*out = *in
@@ -4670,7 +5611,9 @@ func (in *PagesDeploymentConfig) DeepCopyInto(out *PagesDeploymentConfig) {
 	if in.D1Bindings != nil {
 		in, out := &in.D1Bindings, &out.D1Bindings
 		*out = make([]PagesD1Binding, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.DurableObjectBindings != nil {
 		in, out := &in.DurableObjectBindings, &out.DurableObjectBindings
@@ -4680,7 +5623,9 @@ func (in *PagesDeploymentConfig) DeepCopyInto(out *PagesDeploymentConfig) {
 	if in.KVBindings != nil {
 		in, out := &in.KVBindings, &out.KVBindings
 		*out = make([]PagesKVBinding, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.R2Bindings != nil {
 		in, out := &in.R2Bindings, &out.R2Bindings
@@ -4905,6 +5850,11 @@ func (in *PagesDeploymentSpec) DeepCopyInto(out *PagesDeploymentSpec) {
 		*out = new(PagesDeploymentSourceSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FailureLogLines != nil {
+		in, out := &in.FailureLogLines, &out.FailureLogLines
+		*out = new(int32)
+		**out = **in
+	}
 	in.Cloudflare.DeepCopyInto(&out.Cloudflare)
 	if in.DirectUpload != nil {
 		in, out := &in.DirectUpload, &out.DirectUpload
@@ -4946,6 +5896,16 @@ func (in *PagesDeploymentStatus) DeepCopyInto(out *PagesDeploymentStatus) {
 		*out = new(PagesDeploymentSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ChecksumVerification != nil {
+		in, out := &in.ChecksumVerification, &out.ChecksumVerification
+		*out = new(ChecksumVerificationStatus)
+		**out = **in
+	}
+	if in.FailureLogs != nil {
+		in, out := &in.FailureLogs, &out.FailureLogs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -5119,6 +6079,11 @@ func (in *PagesDomainSpec) DeepCopyInto(out *PagesDomainSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesDomainSpec.
@@ -5301,6 +6266,11 @@ func (in *PagesHyperdriveBinding) DeepCopy() *PagesHyperdriveBinding {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagesKVBinding) DeepCopyInto(out *PagesKVBinding) {
 	*out = *in
+	if in.NamespaceRef != nil {
+		in, out := &in.NamespaceRef, &out.NamespaceRef
+		*out = new(PagesKVNamespaceRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesKVBinding.
@@ -5313,6 +6283,21 @@ func (in *PagesKVBinding) DeepCopy() *PagesKVBinding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagesKVNamespaceRef) DeepCopyInto(out *PagesKVNamespaceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesKVNamespaceRef.
+func (in *PagesKVNamespaceRef) DeepCopy() *PagesKVNamespaceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PagesKVNamespaceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PagesMTLSCertificate) DeepCopyInto(out *PagesMTLSCertificate) {
 	*out = *in
@@ -5571,6 +6556,16 @@ func (in *PagesProjectStatus) DeepCopyInto(out *PagesProjectStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExternalSync != nil {
+		in, out := &in.ExternalSync, &out.ExternalSync
+		*out = new(ExternalSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HandledRollback != nil {
+		in, out := &in.HandledRollback, &out.HandledRollback
+		*out = new(HandledRollback)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagesProjectStatus.
@@ -6048,107 +7043,335 @@ func (in *PrivateServiceStatus) DeepCopy() *PrivateServiceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PrivateServiceStatus)
+	out := new(PrivateServiceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductionDeploymentInfo) DeepCopyInto(out *ProductionDeploymentInfo) {
+	*out = *in
+	if in.DeployedAt != nil {
+		in, out := &in.DeployedAt, &out.DeployedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductionDeploymentInfo.
+func (in *ProductionDeploymentInfo) DeepCopy() *ProductionDeploymentInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductionDeploymentInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectVersion) DeepCopyInto(out *ProjectVersion) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(PagesDirectUploadSourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVersion.
+func (in *ProjectVersion) DeepCopy() *ProjectVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotedDeploymentInfo) DeepCopyInto(out *PromotedDeploymentInfo) {
+	*out = *in
+	if in.PromotedAt != nil {
+		in, out := &in.PromotedAt, &out.PromotedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotedDeploymentInfo.
+func (in *PromotedDeploymentInfo) DeepCopy() *PromotedDeploymentInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotedDeploymentInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolDetectionSettings) DeepCopyInto(out *ProtocolDetectionSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolDetectionSettings.
+func (in *ProtocolDetectionSettings) DeepCopy() *ProtocolDetectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolDetectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuarantineSettings) DeepCopyInto(out *QuarantineSettings) {
+	*out = *in
+	if in.FileTypes != nil {
+		in, out := &in.FileTypes, &out.FileTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuarantineSettings.
+func (in *QuarantineSettings) DeepCopy() *QuarantineSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(QuarantineSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Queue) DeepCopyInto(out *Queue) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Queue.
+func (in *Queue) DeepCopy() *Queue {
+	if in == nil {
+		return nil
+	}
+	out := new(Queue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Queue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueConsumer) DeepCopyInto(out *QueueConsumer) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(QueueConsumerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConsumer.
+func (in *QueueConsumer) DeepCopy() *QueueConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueueConsumerSettings) DeepCopyInto(out *QueueConsumerSettings) {
+	*out = *in
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxWaitTimeMs != nil {
+		in, out := &in.MaxWaitTimeMs, &out.MaxWaitTimeMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
+	if in.VisibilityTimeoutMs != nil {
+		in, out := &in.VisibilityTimeoutMs, &out.VisibilityTimeoutMs
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryDelay != nil {
+		in, out := &in.RetryDelay, &out.RetryDelay
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConsumerSettings.
+func (in *QueueConsumerSettings) DeepCopy() *QueueConsumerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(QueueConsumerSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProductionDeploymentInfo) DeepCopyInto(out *ProductionDeploymentInfo) {
+func (in *QueueConsumerStatus) DeepCopyInto(out *QueueConsumerStatus) {
 	*out = *in
-	if in.DeployedAt != nil {
-		in, out := &in.DeployedAt, &out.DeployedAt
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductionDeploymentInfo.
-func (in *ProductionDeploymentInfo) DeepCopy() *ProductionDeploymentInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueConsumerStatus.
+func (in *QueueConsumerStatus) DeepCopy() *QueueConsumerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProductionDeploymentInfo)
+	out := new(QueueConsumerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProjectVersion) DeepCopyInto(out *ProjectVersion) {
+func (in *QueueList) DeepCopyInto(out *QueueList) {
 	*out = *in
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
-		*out = new(PagesDirectUploadSourceSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Queue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectVersion.
-func (in *ProjectVersion) DeepCopy() *ProjectVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueList.
+func (in *QueueList) DeepCopy() *QueueList {
 	if in == nil {
 		return nil
 	}
-	out := new(ProjectVersion)
+	out := new(QueueList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PromotedDeploymentInfo) DeepCopyInto(out *PromotedDeploymentInfo) {
+func (in *QueueSettings) DeepCopyInto(out *QueueSettings) {
 	*out = *in
-	if in.PromotedAt != nil {
-		in, out := &in.PromotedAt, &out.PromotedAt
-		*out = (*in).DeepCopy()
+	if in.DeliveryDelay != nil {
+		in, out := &in.DeliveryDelay, &out.DeliveryDelay
+		*out = new(int)
+		**out = **in
+	}
+	if in.MessageRetentionPeriod != nil {
+		in, out := &in.MessageRetentionPeriod, &out.MessageRetentionPeriod
+		*out = new(int)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotedDeploymentInfo.
-func (in *PromotedDeploymentInfo) DeepCopy() *PromotedDeploymentInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSettings.
+func (in *QueueSettings) DeepCopy() *QueueSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(PromotedDeploymentInfo)
+	out := new(QueueSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProtocolDetectionSettings) DeepCopyInto(out *ProtocolDetectionSettings) {
+func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(QueueSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]QueueConsumer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
+		**out = **in
+	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolDetectionSettings.
-func (in *ProtocolDetectionSettings) DeepCopy() *ProtocolDetectionSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueSpec.
+func (in *QueueSpec) DeepCopy() *QueueSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProtocolDetectionSettings)
+	out := new(QueueSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuarantineSettings) DeepCopyInto(out *QuarantineSettings) {
+func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 	*out = *in
-	if in.FileTypes != nil {
-		in, out := &in.FileTypes, &out.FileTypes
-		*out = make([]string, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]QueueConsumerStatus, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuarantineSettings.
-func (in *QuarantineSettings) DeepCopy() *QuarantineSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueStatus.
+func (in *QueueStatus) DeepCopy() *QueueStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(QuarantineSettings)
+	out := new(QueueStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6247,6 +7470,11 @@ func (in *R2BucketDomainSpec) DeepCopyInto(out *R2BucketDomainSpec) {
 		*out = new(CredentialsReference)
 		**out = **in
 	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2BucketDomainSpec.
@@ -6375,6 +7603,11 @@ func (in *R2BucketNotificationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *R2BucketNotificationSpec) DeepCopyInto(out *R2BucketNotificationSpec) {
 	*out = *in
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]R2EventType, len(*in))
+		copy(*out, *in)
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]R2NotificationRule, len(*in))
@@ -6387,6 +7620,11 @@ func (in *R2BucketNotificationSpec) DeepCopyInto(out *R2BucketNotificationSpec)
 		*out = new(CredentialsReference)
 		**out = **in
 	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2BucketNotificationSpec.
@@ -6443,6 +7681,11 @@ func (in *R2BucketSpec) DeepCopyInto(out *R2BucketSpec) {
 		*out = new(CredentialsReference)
 		**out = **in
 	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new R2BucketSpec.
@@ -6716,6 +7959,11 @@ func (in *RedirectRuleStatus) DeepCopyInto(out *RedirectRuleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RuleStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectRuleStatus.
@@ -6728,6 +7976,21 @@ func (in *RedirectRuleStatus) DeepCopy() *RedirectRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectRuleValidator) DeepCopyInto(out *RedirectRuleValidator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectRuleValidator.
+func (in *RedirectRuleValidator) DeepCopy() *RedirectRuleValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectRuleValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedirectTarget) DeepCopyInto(out *RedirectTarget) {
 	*out = *in
@@ -6937,6 +8200,21 @@ func (in *RollbackConfig) DeepCopy() *RollbackConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleStatus) DeepCopyInto(out *RuleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleStatus.
+func (in *RuleStatus) DeepCopy() *RuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesetCacheKey) DeepCopyInto(out *RulesetCacheKey) {
 	*out = *in
@@ -7759,6 +9037,21 @@ func (in *SaasApplicationConfig) DeepCopy() *SaasApplicationConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScimSecretRef) DeepCopyInto(out *ScimSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScimSecretRef.
+func (in *ScimSecretRef) DeepCopy() *ScimSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ScimSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
 	*out = *in
@@ -8180,6 +9473,11 @@ func (in *TransformRuleStatus) DeepCopyInto(out *TransformRuleStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RuleStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformRuleStatus.
@@ -8192,6 +9490,21 @@ func (in *TransformRuleStatus) DeepCopy() *TransformRuleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransformRuleValidator) DeepCopyInto(out *TransformRuleValidator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformRuleValidator.
+func (in *TransformRuleValidator) DeepCopy() *TransformRuleValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(TransformRuleValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tunnel) DeepCopyInto(out *Tunnel) {
 	*out = *in
@@ -8916,6 +10229,10 @@ func (in *WARPConnectorSpec) DeepCopy() *WARPConnectorSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WARPConnectorStatus) DeepCopyInto(out *WARPConnectorStatus) {
 	*out = *in
+	if in.TokenRotatedAt != nil {
+		in, out := &in.TokenRotatedAt, &out.TokenRotatedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -9085,6 +10402,11 @@ func (in *ZoneRulesetStatus) DeepCopyInto(out *ZoneRulesetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RuleStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.LastUpdated != nil {
 		in, out := &in.LastUpdated, &out.LastUpdated
 		*out = (*in).DeepCopy()
@@ -9100,3 +10422,128 @@ func (in *ZoneRulesetStatus) DeepCopy() *ZoneRulesetStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettings) DeepCopyInto(out *ZoneSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettings.
+func (in *ZoneSettings) DeepCopy() *ZoneSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsList) DeepCopyInto(out *ZoneSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZoneSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsList.
+func (in *ZoneSettingsList) DeepCopy() *ZoneSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZoneSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsSpec) DeepCopyInto(out *ZoneSettingsSpec) {
+	*out = *in
+	if in.AlwaysUseHTTPS != nil {
+		in, out := &in.AlwaysUseHTTPS, &out.AlwaysUseHTTPS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutomaticHTTPSRewrites != nil {
+		in, out := &in.AutomaticHTTPSRewrites, &out.AutomaticHTTPSRewrites
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Brotli != nil {
+		in, out := &in.Brotli, &out.Brotli
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(CredentialsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsSpec.
+func (in *ZoneSettingsSpec) DeepCopy() *ZoneSettingsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneSettingsStatus) DeepCopyInto(out *ZoneSettingsStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReadOnlySettings != nil {
+		in, out := &in.ReadOnlySettings, &out.ReadOnlySettings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneSettingsStatus.
+func (in *ZoneSettingsStatus) DeepCopy() *ZoneSettingsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneSettingsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Lease timings used by controller-runtime when none are configured.
+const (
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+// leaderElectionFlags holds the --leader-elect-* lease timing flags.
+// Clusters with slow API servers can raise them to stop leadership flapping.
+type leaderElectionFlags struct {
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
+// bindFlags registers the flags on fs.
+func (f *leaderElectionFlags) bindFlags(fs *flag.FlagSet) {
+	fs.DurationVar(&f.leaseDuration, "leader-elect-lease-duration", defaultLeaseDuration,
+		"How long non-leader candidates wait after observing a leadership renewal before trying to acquire leadership.")
+	fs.DurationVar(&f.renewDeadline, "leader-elect-renew-deadline", defaultRenewDeadline,
+		"How long the leader retries refreshing leadership before giving it up. Must be less than the lease duration.")
+	fs.DurationVar(&f.retryPeriod, "leader-elect-retry-period", defaultRetryPeriod,
+		"How long candidates wait between attempts to acquire or renew leadership. Must be less than the renew deadline.")
+}
+
+// apply sets the lease timings of opts from the flags.
+func (f *leaderElectionFlags) apply(opts *ctrl.Options) error {
+	if f.retryPeriod <= 0 {
+		return fmt.Errorf("invalid --leader-elect-retry-period %s: must be positive", f.retryPeriod)
+	}
+	if f.renewDeadline <= f.retryPeriod {
+		return fmt.Errorf("invalid --leader-elect-renew-deadline %s: must be greater than --leader-elect-retry-period %s",
+			f.renewDeadline, f.retryPeriod)
+	}
+	if f.leaseDuration <= f.renewDeadline {
+		return fmt.Errorf("invalid --leader-elect-lease-duration %s: must be greater than --leader-elect-renew-deadline %s",
+			f.leaseDuration, f.renewDeadline)
+	}
+
+	opts.LeaseDuration = &f.leaseDuration
+	opts.RenewDeadline = &f.renewDeadline
+	opts.RetryPeriod = &f.retryPeriod
+	return nil
+}
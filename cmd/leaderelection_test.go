@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package main
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// managerOptionsWithFlags parses args as main does and applies the leader
+// election flags to empty manager options.
+func managerOptionsWithFlags(t *testing.T, args ...string) (ctrl.Options, error) {
+	t.Helper()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var leaderElection leaderElectionFlags
+	leaderElection.bindFlags(fs)
+	require.NoError(t, fs.Parse(args))
+
+	var opts ctrl.Options
+	err := leaderElection.apply(&opts)
+	return opts, err
+}
+
+func TestLeaderElectionFlags_Defaults(t *testing.T) {
+	opts, err := managerOptionsWithFlags(t)
+	require.NoError(t, err)
+
+	require.NotNil(t, opts.LeaseDuration)
+	require.NotNil(t, opts.RenewDeadline)
+	require.NotNil(t, opts.RetryPeriod)
+	assert.Equal(t, 15*time.Second, *opts.LeaseDuration)
+	assert.Equal(t, 10*time.Second, *opts.RenewDeadline)
+	assert.Equal(t, 2*time.Second, *opts.RetryPeriod)
+}
+
+func TestLeaderElectionFlags_PopulateOptions(t *testing.T) {
+	opts, err := managerOptionsWithFlags(t,
+		"--leader-elect-lease-duration=60s",
+		"--leader-elect-renew-deadline=40s",
+		"--leader-elect-retry-period=5s",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Minute, *opts.LeaseDuration)
+	assert.Equal(t, 40*time.Second, *opts.RenewDeadline)
+	assert.Equal(t, 5*time.Second, *opts.RetryPeriod)
+}
+
+func TestLeaderElectionFlags_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "renew deadline not below lease duration", args: []string{"--leader-elect-renew-deadline=15s"}},
+		{name: "retry period not below renew deadline", args: []string{"--leader-elect-retry-period=10s"}},
+		{name: "zero retry period", args: []string{"--leader-elect-retry-period=0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := managerOptionsWithFlags(t, tt.args...)
+			assert.Error(t, err)
+			assert.Nil(t, opts.LeaseDuration)
+		})
+	}
+}
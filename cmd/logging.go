@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	uberzap "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+const (
+	logFormatConsole = "console"
+	logFormatJSON    = "json"
+)
+
+// logFlags holds the --log-format and --log-level flags. They are shorthands
+// for the --zap-* flags registered by zap.Options.BindFlags; flags left unset
+// keep the logger as configured by those.
+type logFlags struct {
+	format string
+	level  string
+}
+
+// bindFlags registers the flags on fs.
+func (f *logFlags) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.format, "log-format", "",
+		"Log format: console (human-readable, the default) or json (for log aggregation such as Loki or ELK).")
+	fs.StringVar(&f.level, "log-level", "",
+		"Minimum log level: debug, info, warn, error, or an integer > 0 for debug verbosity. "+
+			"Defaults to debug for console and info for json.")
+}
+
+// apply configures opts from the flags.
+func (f *logFlags) apply(opts *zap.Options) error {
+	switch f.format {
+	case "":
+	case logFormatConsole:
+		opts.Development = true
+		opts.NewEncoder = newConsoleEncoder
+	case logFormatJSON:
+		opts.Development = false
+		opts.NewEncoder = newJSONEncoder
+	default:
+		return fmt.Errorf("invalid --log-format %q: must be %s or %s", f.format, logFormatConsole, logFormatJSON)
+	}
+
+	if f.level != "" {
+		level, err := parseLogLevel(f.level)
+		if err != nil {
+			return err
+		}
+		atomicLevel := uberzap.NewAtomicLevelAt(level)
+		opts.Level = &atomicLevel
+	}
+
+	opts.ZapOpts = append(opts.ZapOpts, uberzap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return sanitizingCore{Core: core}
+	}))
+	return nil
+}
+
+// sanitizingCore passes logged errors through cf.RedactSensitiveValues, so Cloudflare
+// credentials and account IDs in API errors do not end up in the logs. Unlike status
+// messages, the rest of the error is logged in full.
+type sanitizingCore struct {
+	zapcore.Core
+}
+
+// With adds structured context to the core.
+func (c sanitizingCore) With(fields []zapcore.Field) zapcore.Core {
+	return sanitizingCore{Core: c.Core.With(sanitizeFields(fields))}
+}
+
+// Check adds the core to ce if the entry is logged, so that Write is called on
+// the sanitizing core rather than on the wrapped one.
+func (c sanitizingCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+// Write logs the entry with sanitized error fields.
+func (c sanitizingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, sanitizeFields(fields))
+}
+
+// sanitizeFields replaces error fields by their message with secret values redacted.
+// fields is not modified, as zap may reuse it.
+func sanitizeFields(fields []zapcore.Field) []zapcore.Field {
+	var sanitized []zapcore.Field
+	for i, field := range fields {
+		err, ok := field.Interface.(error)
+		if field.Type != zapcore.ErrorType || !ok {
+			continue
+		}
+		if sanitized == nil {
+			sanitized = slices.Clone(fields)
+		}
+		sanitized[i] = uberzap.String(field.Key, cf.RedactSensitiveValues(err.Error()))
+	}
+	if sanitized == nil {
+		return fields
+	}
+	return sanitized
+}
+
+// parseLogLevel parses a level name or a logr verbosity, like --zap-log-level.
+func parseLogLevel(s string) (zapcore.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info":
+		return zapcore.InfoLevel, nil
+	case "warn":
+		return zapcore.WarnLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	}
+	verbosity, err := strconv.Atoi(s)
+	if err != nil || verbosity <= 0 {
+		return 0, fmt.Errorf("invalid --log-level %q: must be debug, info, warn, error or an integer > 0", s)
+	}
+	return zapcore.Level(-verbosity), nil
+}
+
+// newConsoleEncoder returns the human-readable encoder of zap's development mode.
+func newConsoleEncoder(opts ...zap.EncoderConfigOption) zapcore.Encoder {
+	encoderConfig := uberzap.NewDevelopmentEncoderConfig()
+	for _, opt := range opts {
+		opt(&encoderConfig)
+	}
+	return zapcore.NewConsoleEncoder(encoderConfig)
+}
+
+// newJSONEncoder returns the JSON encoder of zap's production mode.
+func newJSONEncoder(opts ...zap.EncoderConfigOption) zapcore.Encoder {
+	encoderConfig := uberzap.NewProductionEncoderConfig()
+	for _, opt := range opts {
+		opt(&encoderConfig)
+	}
+	return zapcore.NewJSONEncoder(encoderConfig)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+// logWithFlags configures a logger from args as main does and logs one message
+// at each of the info and debug levels.
+func logWithFlags(t *testing.T, args ...string) string {
+	t.Helper()
+	opts := zap.Options{Development: true}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	opts.BindFlags(fs)
+	var logging logFlags
+	logging.bindFlags(fs)
+	require.NoError(t, fs.Parse(args))
+	require.NoError(t, logging.apply(&opts))
+
+	var buf bytes.Buffer
+	logger := zap.New(zap.UseFlagOptions(&opts), zap.WriteTo(&buf))
+	logger.Info("info message", "key", "value")
+	logger.V(1).Info("debug message")
+	return buf.String()
+}
+
+func TestLogFlags_DefaultIsConsole(t *testing.T) {
+	out := logWithFlags(t)
+
+	assert.Contains(t, out, "info message")
+	assert.Contains(t, out, "debug message")
+	assert.False(t, json.Valid([]byte(strings.Split(out, "\n")[0])))
+}
+
+func TestLogFlags_JSON(t *testing.T) {
+	out := logWithFlags(t, "--log-format=json")
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 1, "json defaults to the info level")
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "info message", entry["msg"])
+	assert.Equal(t, "value", entry["key"])
+}
+
+func TestLogFlags_Level(t *testing.T) {
+	assert.NotContains(t, logWithFlags(t, "--log-level=info"), "debug message")
+	assert.NotContains(t, logWithFlags(t, "--log-level=error"), "info message")
+	assert.Contains(t, logWithFlags(t, "--log-format=json", "--log-level=1"), "debug message")
+}
+
+func TestLogFlags_ZapFlagsStillApply(t *testing.T) {
+	out := logWithFlags(t, "--zap-encoder=json")
+
+	assert.True(t, json.Valid([]byte(strings.Split(out, "\n")[0])))
+}
+
+func TestLogFlags_SanitizesErrors(t *testing.T) {
+	opts := zap.Options{}
+	require.NoError(t, (&logFlags{format: logFormatJSON}).apply(&opts))
+	var buf bytes.Buffer
+	logger := zap.New(zap.UseFlagOptions(&opts), zap.WriteTo(&buf))
+
+	const token = "abcdefghij0123456789abcdefghij0123456789"
+	err := errors.New("request failed for token " + token)
+	logger.Error(err, "error message", "cause", err)
+	logger.WithValues("previous", err).Info("info message")
+
+	// Errors mentioning secrets are logged in full, only values are redacted
+	logger.Error(errors.New(`failed to get tunnel token: secrets "tunnel-creds" not found`), "lookup failed")
+
+	out := buf.String()
+	assert.Contains(t, out, "error message")
+	assert.Contains(t, out, "info message")
+	assert.Contains(t, out, "request failed for token [REDACTED]")
+	assert.NotContains(t, out, token)
+	assert.Contains(t, out, `failed to get tunnel token: secrets \"tunnel-creds\" not found`)
+}
+
+func TestLogFlags_Invalid(t *testing.T) {
+	for _, flags := range []logFlags{
+		{format: "xml"},
+		{level: "verbose"},
+		{level: "0"},
+	} {
+		assert.Error(t, flags.apply(&zap.Options{}), "%+v", flags)
+	}
+}
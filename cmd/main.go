@@ -6,11 +6,14 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/StringKe/cloudflare-operator/internal/controller/accessapplication"
+	"github.com/StringKe/cloudflare-operator/internal/controller/accesscustompage"
 	"github.com/StringKe/cloudflare-operator/internal/controller/accessgroup"
 	"github.com/StringKe/cloudflare-operator/internal/controller/accessidentityprovider"
 	"github.com/StringKe/cloudflare-operator/internal/controller/accesspolicy"
@@ -18,6 +21,8 @@ import (
 	"github.com/StringKe/cloudflare-operator/internal/controller/accesstunnel"
 	"github.com/StringKe/cloudflare-operator/internal/controller/cloudflarecredentials"
 	"github.com/StringKe/cloudflare-operator/internal/controller/cloudflaredomain"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/internal/controller/d1database"
 	"github.com/StringKe/cloudflare-operator/internal/controller/deviceposturerule"
 	"github.com/StringKe/cloudflare-operator/internal/controller/devicesettingspolicy"
 	"github.com/StringKe/cloudflare-operator/internal/controller/dnsrecord"
@@ -25,8 +30,11 @@ import (
 	"github.com/StringKe/cloudflare-operator/internal/controller/gateway"
 	"github.com/StringKe/cloudflare-operator/internal/controller/gatewayconfiguration"
 	"github.com/StringKe/cloudflare-operator/internal/controller/gatewaylist"
+	"github.com/StringKe/cloudflare-operator/internal/controller/gatewaylocation"
 	"github.com/StringKe/cloudflare-operator/internal/controller/gatewayrule"
+	"github.com/StringKe/cloudflare-operator/internal/controller/hyperdriveconfig"
 	"github.com/StringKe/cloudflare-operator/internal/controller/ingress"
+	"github.com/StringKe/cloudflare-operator/internal/controller/kvnamespace"
 	"github.com/StringKe/cloudflare-operator/internal/controller/networkroute"
 	"github.com/StringKe/cloudflare-operator/internal/controller/origincacertificate"
 	"github.com/StringKe/cloudflare-operator/internal/controller/pagesdeployment"
@@ -34,6 +42,7 @@ import (
 	"github.com/StringKe/cloudflare-operator/internal/controller/pagesproject"
 	"github.com/StringKe/cloudflare-operator/internal/controller/pagespromotion"
 	"github.com/StringKe/cloudflare-operator/internal/controller/privateservice"
+	"github.com/StringKe/cloudflare-operator/internal/controller/queue"
 	"github.com/StringKe/cloudflare-operator/internal/controller/r2bucket"
 	"github.com/StringKe/cloudflare-operator/internal/controller/r2bucketdomain"
 	"github.com/StringKe/cloudflare-operator/internal/controller/r2bucketnotification"
@@ -43,7 +52,10 @@ import (
 	"github.com/StringKe/cloudflare-operator/internal/controller/virtualnetwork"
 	"github.com/StringKe/cloudflare-operator/internal/controller/warpconnector"
 	"github.com/StringKe/cloudflare-operator/internal/controller/zoneruleset"
+	"github.com/StringKe/cloudflare-operator/internal/controller/zonesettings"
+	synccommon "github.com/StringKe/cloudflare-operator/internal/sync/common"
 	tunnelconfigsync "github.com/StringKe/cloudflare-operator/internal/sync/tunnel"
+	"github.com/StringKe/cloudflare-operator/internal/uploader"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -62,6 +74,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
@@ -89,6 +102,7 @@ func init() {
 	// Gateway API scheme registration
 	utilruntime.Must(gatewayv1.Install(scheme))
 	utilruntime.Must(gatewayv1alpha2.Install(scheme))
+	utilruntime.Must(gatewayv1beta1.Install(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -103,6 +117,11 @@ func main() {
 	var overwriteUnmanaged bool
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var deletionTimeout time.Duration
+	var driftResyncInterval time.Duration
+	var cloudflareReadinessCheck bool
+	var cloudflareReadinessInterval time.Duration
+	var s3AllowedRoleARNs string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -123,22 +142,55 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&deletionTimeout, "deletion-timeout", controller.DefaultDeletionTimeout,
+		"How long a failed Cloudflare deletion is retried before the finalizer is removed anyway. "+
+			"Resources can override it with spec.deletionTimeout.")
+	flag.DurationVar(&driftResyncInterval, "drift-resync-interval", 0,
+		"How often synced tunnel configuration is re-applied to Cloudflare to correct out-of-band drift. "+
+			"Only tunnel configuration is resynced. 0 disables periodic resync. Tunnels and ClusterTunnels can override it with the "+
+			synccommon.AnnotationDriftResyncInterval+" annotation.")
+	flag.BoolVar(&cloudflareReadinessCheck, "cloudflare-readiness-check", false,
+		"If set, the readiness probe fails while no CloudflareCredentials can reach the Cloudflare API.")
+	flag.DurationVar(&cloudflareReadinessInterval, "cloudflare-readiness-interval", common.DefaultConnectivityCheckInterval,
+		"How often the Cloudflare API is called for --cloudflare-readiness-check. Probes use the cached result.")
+	flag.StringVar(&s3AllowedRoleARNs, "s3-allowed-role-arns", "",
+		"Comma-separated IAM role ARNs that Pages S3 sources may assume with the operator's web identity token. "+
+			"Empty allows no role.")
 	opts := zap.Options{
 		Development: true,
 		TimeEncoder: zapcore.TimeEncoderOfLayout(time.RFC3339),
 	}
 	opts.BindFlags(flag.CommandLine)
+	var logging logFlags
+	logging.bindFlags(flag.CommandLine)
+	var leaderElection leaderElectionFlags
+	leaderElection.bindFlags(flag.CommandLine)
+	var shutdown shutdownFlags
+	shutdown.bindFlags(flag.CommandLine)
 	flag.Parse()
 
+	if err := logging.apply(&opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	controller.DefaultDeletionTimeout = deletionTimeout
+	synccommon.DriftResyncInterval = driftResyncInterval
+	for _, arn := range strings.Split(s3AllowedRoleARNs, ",") {
+		if arn = strings.TrimSpace(arn); arn != "" {
+			uploader.AllowedS3RoleARNs = append(uploader.AllowedS3RoleARNs, arn)
+		}
+	}
 
 	// Use POD_NAMESPACE env var if cluster-resource-namespace is not explicitly set
-	if clusterResourceNamespace == "" {
-		clusterResourceNamespace = os.Getenv("POD_NAMESPACE")
-		if clusterResourceNamespace == "" {
-			clusterResourceNamespace = "cloudflare-operator-system" // fallback default
-		}
+	operatorNamespace, err := common.ResolveOperatorNamespace(clusterResourceNamespace)
+	if err != nil {
+		setupLog.Error(err, "unable to determine the operator namespace")
+		os.Exit(1)
 	}
+	clusterResourceNamespace = operatorNamespace
+	common.SetOperatorNamespace(clusterResourceNamespace)
+	setupLog.Info("using operator namespace", "namespace", clusterResourceNamespace)
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -229,7 +281,7 @@ func main() {
 		})
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgrOptions := ctrl.Options{
 		Scheme:                  scheme,
 		Metrics:                 metricsServerOptions,
 		WebhookServer:           webhookServer,
@@ -237,18 +289,21 @@ func main() {
 		LeaderElection:          enableLeaderElection,
 		LeaderElectionID:        "9f193cf8.cloudflare-operator.io",
 		LeaderElectionNamespace: clusterResourceNamespace,
-		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
-		// when the Manager ends. This requires the binary to immediately end when the
-		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
-		// speeds up voluntary leader transitions as the new leader don't have to wait
-		// LeaseDuration time first.
-		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
-	})
+		// GracefulShutdownTimeout and LeaderElectionReleaseOnCancel are set from the
+		// shutdown flags. Releasing the lease is only safe because the manager waits
+		// for in-flight reconciles before releasing it and nothing runs after the
+		// manager stops, see shutdownFlags.
+	}
+	if err := leaderElection.apply(&mgrOptions); err != nil {
+		setupLog.Error(err, "invalid leader election configuration")
+		os.Exit(1)
+	}
+	if err := shutdown.apply(&mgrOptions); err != nil {
+		setupLog.Error(err, "invalid shutdown configuration")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOptions)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
@@ -348,6 +403,13 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "AccessServiceToken")
 		os.Exit(1)
 	}
+	if err = (&accesscustompage.Reconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AccessCustomPage")
+		os.Exit(1)
+	}
 	if err = (&deviceposturerule.Reconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -369,6 +431,13 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "GatewayList")
 		os.Exit(1)
 	}
+	if err = (&gatewaylocation.Reconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GatewayLocation")
+		os.Exit(1)
+	}
 	if err = (&dnsrecord.DNSRecordReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -437,6 +506,38 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "R2BucketNotification")
 		os.Exit(1)
 	}
+	if err = (&queue.Reconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("queue-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Queue")
+		os.Exit(1)
+	}
+	if err = (&d1database.Reconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("d1database-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "D1Database")
+		os.Exit(1)
+	}
+	if err = (&kvnamespace.Reconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("kvnamespace-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "KVNamespace")
+		os.Exit(1)
+	}
+	if err = (&hyperdriveconfig.Reconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("hyperdriveconfig-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "HyperdriveConfig")
+		os.Exit(1)
+	}
 	if err = (&zoneruleset.Reconciler{
 		Client:   mgr.GetClient(),
 		Scheme:   mgr.GetScheme(),
@@ -461,6 +562,14 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "RedirectRule")
 		os.Exit(1)
 	}
+	if err = (&zonesettings.Reconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("zonesettings-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ZoneSettings")
+		os.Exit(1)
+	}
 	// Pages Project controller (L2)
 	if err = (&pagesproject.PagesProjectReconciler{
 		Client: mgr.GetClient(),
@@ -525,7 +634,9 @@ func main() {
 			"gateway", gatewayAPIStatus.GatewayAvailable,
 			"httpRoute", gatewayAPIStatus.HTTPRouteAvailable,
 			"tcpRoute", gatewayAPIStatus.TCPRouteAvailable,
-			"udpRoute", gatewayAPIStatus.UDPRouteAvailable)
+			"tlsRoute", gatewayAPIStatus.TLSRouteAvailable,
+			"udpRoute", gatewayAPIStatus.UDPRouteAvailable,
+			"referenceGrant", gatewayAPIStatus.ReferenceGrantAvailable)
 
 		if err = (&gateway.GatewayClassReconciler{
 			Client:   mgr.GetClient(),
@@ -536,14 +647,40 @@ func main() {
 			os.Exit(1)
 		}
 		if err = (&gateway.GatewayReconciler{
-			Client:            mgr.GetClient(),
-			Scheme:            mgr.GetScheme(),
-			Recorder:          mgr.GetEventRecorderFor("gateway-controller"),
-			OperatorNamespace: clusterResourceNamespace,
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              mgr.GetEventRecorderFor("gateway-controller"),
+			OperatorNamespace:     clusterResourceNamespace,
+			TLSRouteEnabled:       gatewayAPIStatus.TLSRouteAvailable,
+			ReferenceGrantEnabled: gatewayAPIStatus.ReferenceGrantAvailable,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "Gateway")
 			os.Exit(1)
 		}
+		if gatewayAPIStatus.TCPRouteAvailable {
+			if err = (&gateway.TCPRouteReconciler{
+				Client:                mgr.GetClient(),
+				Scheme:                mgr.GetScheme(),
+				Recorder:              mgr.GetEventRecorderFor("tcproute-controller"),
+				OperatorNamespace:     clusterResourceNamespace,
+				ReferenceGrantEnabled: gatewayAPIStatus.ReferenceGrantAvailable,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "TCPRoute")
+				os.Exit(1)
+			}
+		}
+		if gatewayAPIStatus.TLSRouteAvailable {
+			if err = (&gateway.TLSRouteReconciler{
+				Client:                mgr.GetClient(),
+				Scheme:                mgr.GetScheme(),
+				Recorder:              mgr.GetEventRecorderFor("tlsroute-controller"),
+				OperatorNamespace:     clusterResourceNamespace,
+				ReferenceGrantEnabled: gatewayAPIStatus.ReferenceGrantAvailable,
+			}).SetupWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "TLSRoute")
+				os.Exit(1)
+			}
+		}
 	} else {
 		setupLog.Info("Gateway API CRDs not installed, Gateway API controllers disabled. "+
 			"Install Gateway API CRDs (https://gateway-api.sigs.k8s.io/) to enable Gateway support",
@@ -582,6 +719,22 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "PagesProject")
 			os.Exit(1)
 		}
+		if err = webhooknetworkingv1alpha2.SetupTransformRuleWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "TransformRule")
+			os.Exit(1)
+		}
+		if err = webhooknetworkingv1alpha2.SetupRedirectRuleWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "RedirectRule")
+			os.Exit(1)
+		}
+		if err = webhooknetworkingv1alpha2.SetupGatewayRuleWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "GatewayRule")
+			os.Exit(1)
+		}
+		if err = webhooknetworkingv1alpha2.SetupAccessGroupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AccessGroup")
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -593,6 +746,18 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if cloudflareReadinessCheck {
+		connectivity := common.NewConnectivityChecker(mgr.GetClient(), cloudflareReadinessInterval,
+			ctrl.Log.WithName("readiness"))
+		if err := mgr.Add(connectivity); err != nil {
+			setupLog.Error(err, "unable to set up Cloudflare connectivity check")
+			os.Exit(1)
+		}
+		if err := mgr.AddReadyzCheck("cloudflare", connectivity.Check); err != nil {
+			setupLog.Error(err, "unable to set up Cloudflare ready check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
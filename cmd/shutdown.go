@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// defaultGracefulShutdownTimeout matches the controller-runtime default.
+const defaultGracefulShutdownTimeout = 30 * time.Second
+
+// shutdownFlags holds the flags that control how the operator shuts down.
+//
+// On shutdown the manager stops watching for changes and waits for the in-flight
+// reconciles of every controller to return, for at most the graceful shutdown
+// timeout. Only then is the leader election lease released, and main exits as
+// soon as the manager has stopped, so no cleanup runs after the lease is given up.
+// This is what makes releasing the lease on shutdown safe: the next leader does
+// not start reconciling while this replica is still, for example, scaling down
+// a tunnel deployment.
+type shutdownFlags struct {
+	gracefulShutdownTimeout time.Duration
+	releaseLeaseOnShutdown  bool
+}
+
+// bindFlags registers the flags on fs.
+func (f *shutdownFlags) bindFlags(fs *flag.FlagSet) {
+	fs.DurationVar(&f.gracefulShutdownTimeout, "graceful-shutdown-timeout", defaultGracefulShutdownTimeout,
+		"How long the operator waits on shutdown for in-flight reconciles to finish before exiting. "+
+			"0 exits without waiting.")
+	fs.BoolVar(&f.releaseLeaseOnShutdown, "leader-elect-release-on-cancel", false,
+		"If set, the leader releases its lease once in-flight reconciles have finished on shutdown, "+
+			"so another replica takes over without waiting for the lease to expire. "+
+			"Requires a positive --graceful-shutdown-timeout.")
+}
+
+// apply sets the shutdown behavior of opts from the flags.
+func (f *shutdownFlags) apply(opts *ctrl.Options) error {
+	if f.gracefulShutdownTimeout < 0 {
+		return fmt.Errorf("invalid --graceful-shutdown-timeout %s: must not be negative", f.gracefulShutdownTimeout)
+	}
+	if f.releaseLeaseOnShutdown && f.gracefulShutdownTimeout == 0 {
+		// Without waiting, the lease could be released while reconciles are still
+		// running, letting two replicas reconcile the same resources.
+		return errors.New("--leader-elect-release-on-cancel requires a positive --graceful-shutdown-timeout")
+	}
+
+	opts.GracefulShutdownTimeout = &f.gracefulShutdownTimeout
+	opts.LeaderElectionReleaseOnCancel = f.releaseLeaseOnShutdown
+	return nil
+}
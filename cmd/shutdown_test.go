@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package main
+
+import (
+	"context"
+	"flag"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// shutdownOptionsWithFlags parses args as main does and applies the shutdown
+// flags to manager options that need no API server.
+func shutdownOptionsWithFlags(t *testing.T, args ...string) (ctrl.Options, error) {
+	t.Helper()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var shutdown shutdownFlags
+	shutdown.bindFlags(fs)
+	require.NoError(t, fs.Parse(args))
+
+	opts := ctrl.Options{
+		Metrics:                metricsserver.Options{BindAddress: "0"},
+		HealthProbeBindAddress: "0",
+	}
+	err := shutdown.apply(&opts)
+	return opts, err
+}
+
+// runShutdownWithInFlightReconcile starts a manager whose only controller
+// reconciles for reconcileTime, stops the manager while the reconcile is in
+// flight and returns whether the reconcile finished before the manager stopped
+// and the error of the manager.
+func runShutdownWithInFlightReconcile(t *testing.T, opts ctrl.Options, reconcileTime time.Duration) (bool, error) {
+	t.Helper()
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "http://127.0.0.1:1"}, opts)
+	require.NoError(t, err)
+
+	started := make(chan struct{})
+	var finished atomic.Bool
+	c, err := controller.New("inflight", mgr, controller.Options{
+		SkipNameValidation: ptr.To(true),
+		Reconciler: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+			close(started)
+			// Simulate cleanup work that does not stop when the manager shuts down
+			time.Sleep(reconcileTime)
+			finished.Store(true)
+			return reconcile.Result{}, nil
+		}),
+	})
+	require.NoError(t, err)
+
+	events := make(chan event.GenericEvent, 1)
+	require.NoError(t, c.Watch(source.Channel(events, &handler.EnqueueRequestForObject{})))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan error, 1)
+	go func() { stopped <- mgr.Start(ctx) }()
+
+	events <- event.GenericEvent{Object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"}}}
+	select {
+	case <-started:
+	case <-time.After(10 * time.Second):
+		t.Fatal("reconcile did not start")
+	}
+
+	cancel()
+	err = <-stopped
+	return finished.Load(), err
+}
+
+func TestShutdownFlags_WaitsForInFlightReconcile(t *testing.T) {
+	opts, err := shutdownOptionsWithFlags(t, "--graceful-shutdown-timeout=10s", "--leader-elect-release-on-cancel")
+	require.NoError(t, err)
+	assert.True(t, opts.LeaderElectionReleaseOnCancel)
+
+	finished, err := runShutdownWithInFlightReconcile(t, opts, 200*time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, finished, "the manager stops only after the in-flight reconcile finished")
+}
+
+func TestShutdownFlags_WaitIsBounded(t *testing.T) {
+	opts, err := shutdownOptionsWithFlags(t, "--graceful-shutdown-timeout=100ms")
+	require.NoError(t, err)
+
+	start := time.Now()
+	finished, err := runShutdownWithInFlightReconcile(t, opts, 3*time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "grace period")
+	assert.False(t, finished)
+	assert.Less(t, time.Since(start), 3*time.Second)
+}
+
+func TestShutdownFlags_Invalid(t *testing.T) {
+	_, err := shutdownOptionsWithFlags(t, "--graceful-shutdown-timeout=0", "--leader-elect-release-on-cancel")
+	assert.Error(t, err, "releasing the lease without waiting is unsafe")
+
+	_, err = shutdownOptionsWithFlags(t, "--graceful-shutdown-timeout=-1s")
+	assert.Error(t, err)
+
+	opts, err := shutdownOptionsWithFlags(t)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, *opts.GracefulShutdownTimeout)
+	assert.False(t, opts.LeaderElectionReleaseOnCancel)
+}
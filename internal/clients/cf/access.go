@@ -5,7 +5,13 @@ package cf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 )
@@ -206,6 +212,17 @@ type AccessInfrastructureTargetContextParams struct {
 	Protocol         string
 }
 
+// Access Application types whose required and accepted fields differ from self-hosted applications.
+const (
+	// AccessAppTypeBookmark is a link shown in the App Launcher. It requires the
+	// bookmark URL in Domain and is not protected by policies.
+	AccessAppTypeBookmark = "bookmark"
+	// AccessAppTypeAppLauncher configures the App Launcher portal of the account.
+	AccessAppTypeAppLauncher = "app_launcher"
+	// AccessAppTypeDashSSO configures single sign-on for the Cloudflare dashboard.
+	AccessAppTypeDashSSO = "dash_sso"
+)
+
 // AccessApplicationResult contains the result of an Access Application operation.
 type AccessApplicationResult struct {
 	ID                     string
@@ -218,6 +235,17 @@ type AccessApplicationResult struct {
 	AllowedIdps            []string
 	AutoRedirectToIdentity bool
 	SaasAppClientID        string
+	// Policies are the policies attached to the application, ordered by precedence.
+	Policies []AccessApplicationPolicyResult
+}
+
+// AccessApplicationPolicyResult describes a policy attached to an Access Application.
+type AccessApplicationPolicyResult struct {
+	ID         string
+	Name       string
+	Precedence int
+	// Reusable is false for legacy policies that exist only within the application.
+	Reusable bool
 }
 
 // CreateAccessApplication creates a new Access Application.
@@ -227,6 +255,14 @@ func (c *API) CreateAccessApplication(ctx context.Context, params AccessApplicat
 		return nil, err
 	}
 
+	params, err := applyAccessAppTypeRules(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateSessionDuration(params.SessionDuration); err != nil {
+		return nil, fmt.Errorf("access application %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	createParams := cloudflare.CreateAccessApplicationParams{
@@ -252,7 +288,9 @@ func (c *API) CreateAccessApplication(ctx context.Context, params AccessApplicat
 	}
 
 	// Set optional fields using helper functions
-	applyCreateAccessAppOptionalParams(&createParams, params)
+	if err := applyCreateAccessAppOptionalParams(&createParams, params); err != nil {
+		return nil, err
+	}
 
 	app, err := c.CloudflareClient.CreateAccessApplication(ctx, rc, createParams)
 	if err != nil {
@@ -290,6 +328,14 @@ func (c *API) UpdateAccessApplication(ctx context.Context, applicationID string,
 		return nil, err
 	}
 
+	params, err := applyAccessAppTypeRules(params)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateSessionDuration(params.SessionDuration); err != nil {
+		return nil, fmt.Errorf("access application %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	updateParams := cloudflare.UpdateAccessApplicationParams{
@@ -316,7 +362,9 @@ func (c *API) UpdateAccessApplication(ctx context.Context, applicationID string,
 	}
 
 	// Set optional fields using helper functions
-	applyUpdateAccessAppOptionalParams(&updateParams, params)
+	if err := applyUpdateAccessAppOptionalParams(&updateParams, params); err != nil {
+		return nil, err
+	}
 
 	app, err := c.CloudflareClient.UpdateAccessApplication(ctx, rc, updateParams)
 	if err != nil {
@@ -382,6 +430,13 @@ func (c *API) CreateAccessPolicy(ctx context.Context, params AccessPolicyParams)
 		return nil, err
 	}
 
+	if err := validateOptionalSessionDuration(params.SessionDuration); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+	if err := validateRequireRules(params.Require); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	createParams := cloudflare.CreateAccessPolicyParams{
@@ -450,6 +505,13 @@ func (c *API) UpdateAccessPolicy(ctx context.Context, policyID string, params Ac
 		return nil, err
 	}
 
+	if err := validateOptionalSessionDuration(params.SessionDuration); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+	if err := validateRequireRules(params.Require); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	updateParams := cloudflare.UpdateAccessPolicyParams{
@@ -578,6 +640,94 @@ type ReusableAccessPolicyResult struct {
 	Name       string
 	Decision   string
 	Precedence int
+	// Include, Exclude and Require are the rules as returned by Cloudflare.
+	Include                      []interface{}
+	Exclude                      []interface{}
+	Require                      []interface{}
+	SessionDuration              string
+	IsolationRequired            *bool
+	PurposeJustificationRequired *bool
+	PurposeJustificationPrompt   string
+	ApprovalRequired             *bool
+	ApprovalGroups               []AccessApprovalGroupParams
+}
+
+func convertReusableAccessPolicy(policy cloudflare.AccessPolicy) *ReusableAccessPolicyResult {
+	result := &ReusableAccessPolicyResult{
+		ID:                           policy.ID,
+		Name:                         policy.Name,
+		Decision:                     policy.Decision,
+		Precedence:                   policy.Precedence,
+		Include:                      policy.Include,
+		Exclude:                      policy.Exclude,
+		Require:                      policy.Require,
+		IsolationRequired:            policy.IsolationRequired,
+		PurposeJustificationRequired: policy.PurposeJustificationRequired,
+		ApprovalRequired:             policy.ApprovalRequired,
+	}
+	if policy.SessionDuration != nil {
+		result.SessionDuration = *policy.SessionDuration
+	}
+	if policy.PurposeJustificationPrompt != nil {
+		result.PurposeJustificationPrompt = *policy.PurposeJustificationPrompt
+	}
+	for _, group := range policy.ApprovalGroups {
+		result.ApprovalGroups = append(result.ApprovalGroups, AccessApprovalGroupParams{
+			EmailAddresses:  group.EmailAddresses,
+			EmailListUUID:   group.EmailListUuid,
+			ApprovalsNeeded: group.ApprovalsNeeded,
+		})
+	}
+	return result
+}
+
+// ReusableAccessPolicyInSync reports whether a reusable policy in Cloudflare matches
+// the desired params. Rules and approval groups are compared as unordered collections.
+// Settings that Cloudflare fills in when they are not sent, such as the session
+// duration, are only compared when set, and precedence is ignored since reusable
+// policies are ordered by each application that uses them.
+func ReusableAccessPolicyInSync(params ReusableAccessPolicyParams, current *ReusableAccessPolicyResult) bool {
+	if current.Name != params.Name || current.Decision != params.Decision {
+		return false
+	}
+	if !AccessGroupRulesMatch(params.Include, current.Include) ||
+		!AccessGroupRulesMatch(params.Exclude, current.Exclude) ||
+		!AccessGroupRulesMatch(params.Require, current.Require) {
+		return false
+	}
+	if params.SessionDuration != nil && *params.SessionDuration != current.SessionDuration {
+		return false
+	}
+	if params.PurposeJustificationPrompt != "" && params.PurposeJustificationPrompt != current.PurposeJustificationPrompt {
+		return false
+	}
+	if !optionalBoolMatches(params.IsolationRequired, current.IsolationRequired) ||
+		!optionalBoolMatches(params.PurposeJustificationRequired, current.PurposeJustificationRequired) ||
+		!optionalBoolMatches(params.ApprovalRequired, current.ApprovalRequired) {
+		return false
+	}
+	return slices.Equal(normalizeApprovalGroups(params.ApprovalGroups), normalizeApprovalGroups(current.ApprovalGroups))
+}
+
+// optionalBoolMatches reports whether a remote setting matches a desired one that may be
+// unset. An unset remote value is false.
+func optionalBoolMatches(desired, current *bool) bool {
+	return desired == nil || *desired == (current != nil && *current)
+}
+
+// normalizeApprovalGroups returns the canonical JSON of each approval group, sorted.
+func normalizeApprovalGroups(groups []AccessApprovalGroupParams) []string {
+	rules := make([]interface{}, 0, len(groups))
+	for _, group := range groups {
+		emails := slices.Clone(group.EmailAddresses)
+		slices.Sort(emails)
+		rules = append(rules, AccessApprovalGroupParams{
+			EmailAddresses:  emails,
+			EmailListUUID:   group.EmailListUUID,
+			ApprovalsNeeded: group.ApprovalsNeeded,
+		})
+	}
+	return normalizeAccessRules(rules)
 }
 
 // buildReusablePolicyParams converts ReusableAccessPolicyParams to cloudflare.CreateAccessPolicyParams.
@@ -622,6 +772,13 @@ func (c *API) CreateReusableAccessPolicy(ctx context.Context, params ReusableAcc
 		return nil, err
 	}
 
+	if err := validateOptionalSessionDuration(params.SessionDuration); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+	if err := validateRequireRules(params.Require); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 	createParams := buildReusablePolicyParams(params)
 
@@ -633,12 +790,7 @@ func (c *API) CreateReusableAccessPolicy(ctx context.Context, params ReusableAcc
 
 	c.Log.Info("Reusable Access Policy created", "id", policy.ID, "name", policy.Name)
 
-	return &ReusableAccessPolicyResult{
-		ID:         policy.ID,
-		Name:       policy.Name,
-		Decision:   policy.Decision,
-		Precedence: policy.Precedence,
-	}, nil
+	return convertReusableAccessPolicy(policy), nil
 }
 
 // GetReusableAccessPolicy retrieves a reusable Access Policy by ID.
@@ -660,12 +812,7 @@ func (c *API) GetReusableAccessPolicy(ctx context.Context, policyID string) (*Re
 		return nil, err
 	}
 
-	return &ReusableAccessPolicyResult{
-		ID:         policy.ID,
-		Name:       policy.Name,
-		Decision:   policy.Decision,
-		Precedence: policy.Precedence,
-	}, nil
+	return convertReusableAccessPolicy(policy), nil
 }
 
 // GetReusableAccessPolicyByName finds a reusable Access Policy by name.
@@ -689,12 +836,7 @@ func (c *API) GetReusableAccessPolicyByName(ctx context.Context, name string) (*
 
 	for _, policy := range policies {
 		if policy.Name == name {
-			return &ReusableAccessPolicyResult{
-				ID:         policy.ID,
-				Name:       policy.Name,
-				Decision:   policy.Decision,
-				Precedence: policy.Precedence,
-			}, nil
+			return convertReusableAccessPolicy(policy), nil
 		}
 	}
 
@@ -708,6 +850,13 @@ func (c *API) UpdateReusableAccessPolicy(ctx context.Context, policyID string, p
 		return nil, err
 	}
 
+	if err := validateOptionalSessionDuration(params.SessionDuration); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+	if err := validateRequireRules(params.Require); err != nil {
+		return nil, fmt.Errorf("access policy %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	updateParams := cloudflare.UpdateAccessPolicyParams{
@@ -761,12 +910,7 @@ func (c *API) UpdateReusableAccessPolicy(ctx context.Context, policyID string, p
 
 	c.Log.Info("Reusable Access Policy updated", "id", policy.ID, "name", policy.Name)
 
-	return &ReusableAccessPolicyResult{
-		ID:         policy.ID,
-		Name:       policy.Name,
-		Decision:   policy.Decision,
-		Precedence: policy.Precedence,
-	}, nil
+	return convertReusableAccessPolicy(policy), nil
 }
 
 // DeleteReusableAccessPolicy deletes a reusable Access Policy.
@@ -816,12 +960,7 @@ func (c *API) ListReusableAccessPolicies(ctx context.Context) ([]ReusableAccessP
 
 	results := make([]ReusableAccessPolicyResult, 0, len(policies))
 	for _, p := range policies {
-		results = append(results, ReusableAccessPolicyResult{
-			ID:         p.ID,
-			Name:       p.Name,
-			Decision:   p.Decision,
-			Precedence: p.Precedence,
-		})
+		results = append(results, *convertReusableAccessPolicy(p))
 	}
 
 	return results, nil
@@ -1016,6 +1155,12 @@ func convertRuleToSDK(rule AccessGroupRuleParams) map[string]interface{} {
 }
 
 // ConvertRulesToSDK converts typed rules to SDK-compatible format.
+//
+// Cloudflare's ip and geo rules hold a single value, so convertRuleToSDK only
+// carries the first IP range and country of a rule. The remaining values are
+// emitted here as additional single-value rules, which Cloudflare ORs in
+// include and exclude. Require rules are ANDed, so validateRequireRules rejects
+// rules with several values there.
 func ConvertRulesToSDK(rules []AccessGroupRuleParams) []interface{} {
 	if len(rules) == 0 {
 		return nil
@@ -1026,10 +1171,46 @@ func ConvertRulesToSDK(rules []AccessGroupRuleParams) []interface{} {
 		if len(ruleMap) > 0 {
 			result = append(result, ruleMap)
 		}
+		result = append(result, additionalValueRules(rule)...)
+	}
+	return result
+}
+
+// additionalValueRules returns one rule per IP range and country after the first.
+func additionalValueRules(rule AccessGroupRuleParams) []interface{} {
+	var result []interface{}
+	if rule.IPRanges != nil && len(rule.IPRanges.IP) > 1 {
+		for _, ip := range rule.IPRanges.IP[1:] {
+			result = append(result, map[string]interface{}{"ip": map[string]string{"ip": ip}})
+		}
+	}
+	if rule.Country != nil && len(rule.Country.Country) > 1 {
+		for _, country := range rule.Country.Country[1:] {
+			result = append(result, map[string]interface{}{"geo": map[string]string{"country_code": country}})
+		}
 	}
 	return result
 }
 
+// validateRequireRules rejects require rules with more than one IP range or country.
+// They would become several single-value rules that must all match, which no
+// request can satisfy.
+func validateRequireRules(rules []AccessGroupRuleParams) error {
+	for _, rule := range rules {
+		if rule.IPRanges != nil && len(rule.IPRanges.IP) > 1 {
+			return fmt.Errorf("%w: a require rule accepts a single IP range, got %d; "+
+				"use an ipList or require an AccessGroup that includes the ranges",
+				ErrInvalidConfiguration, len(rule.IPRanges.IP))
+		}
+		if rule.Country != nil && len(rule.Country.Country) > 1 {
+			return fmt.Errorf("%w: a require rule accepts a single country, got %d; "+
+				"require an AccessGroup that includes the countries",
+				ErrInvalidConfiguration, len(rule.Country.Country))
+		}
+	}
+	return nil
+}
+
 // BuildGroupIncludeRule constructs an include rule that references an Access Group.
 // This uses the "group" rule type with the group's UUID.
 func BuildGroupIncludeRule(groupID string) AccessGroupRuleParams {
@@ -1051,6 +1232,54 @@ type AccessGroupParams struct {
 type AccessGroupResult struct {
 	ID   string
 	Name string
+	// Include, Exclude and Require are the rules as returned by Cloudflare.
+	Include []interface{}
+	Exclude []interface{}
+	Require []interface{}
+}
+
+func convertAccessGroup(group cloudflare.AccessGroup) *AccessGroupResult {
+	return &AccessGroupResult{
+		ID:      group.ID,
+		Name:    group.Name,
+		Include: group.Include,
+		Exclude: group.Exclude,
+		Require: group.Require,
+	}
+}
+
+// AccessGroupInSync reports whether an existing Access Group already matches params,
+// so that updating it would be a no-op.
+func AccessGroupInSync(params AccessGroupParams, current *AccessGroupResult) bool {
+	return current.Name == params.Name &&
+		AccessGroupRulesMatch(params.Include, current.Include) &&
+		AccessGroupRulesMatch(params.Exclude, current.Exclude) &&
+		AccessGroupRulesMatch(params.Require, current.Require)
+}
+
+// AccessGroupRulesMatch reports whether the desired rules and the rules returned by
+// Cloudflare contain the same rules. Rules are compared as an unordered collection,
+// since the order of include, exclude and require rules has no effect.
+func AccessGroupRulesMatch(desired []AccessGroupRuleParams, current []interface{}) bool {
+	want := normalizeAccessRules(ConvertRulesToSDK(desired))
+	got := normalizeAccessRules(current)
+	return slices.Equal(want, got)
+}
+
+// normalizeAccessRules returns the canonical JSON of each rule, sorted.
+// JSON encoding sorts map keys, so equal rules encode identically whether they
+// were built by convertRuleToSDK or decoded from an API response.
+func normalizeAccessRules(rules []interface{}) []string {
+	result := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		encoded, err := json.Marshal(rule)
+		if err != nil {
+			encoded = []byte(fmt.Sprintf("%v", rule))
+		}
+		result = append(result, string(encoded))
+	}
+	sort.Strings(result)
+	return result
 }
 
 // CreateAccessGroup creates a new Access Group.
@@ -1060,6 +1289,10 @@ func (c *API) CreateAccessGroup(ctx context.Context, params AccessGroupParams) (
 		return nil, err
 	}
 
+	if err := validateRequireRules(params.Require); err != nil {
+		return nil, fmt.Errorf("access group %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	createParams := cloudflare.CreateAccessGroupParams{
@@ -1075,12 +1308,10 @@ func (c *API) CreateAccessGroup(ctx context.Context, params AccessGroupParams) (
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindAccessGroup)
 	c.Log.Info("Access Group created", "id", group.ID, "name", group.Name)
 
-	return &AccessGroupResult{
-		ID:   group.ID,
-		Name: group.Name,
-	}, nil
+	return convertAccessGroup(group), nil
 }
 
 // GetAccessGroup retrieves an Access Group by ID.
@@ -1098,10 +1329,7 @@ func (c *API) GetAccessGroup(ctx context.Context, groupID string) (*AccessGroupR
 		return nil, err
 	}
 
-	return &AccessGroupResult{
-		ID:   group.ID,
-		Name: group.Name,
-	}, nil
+	return convertAccessGroup(group), nil
 }
 
 // UpdateAccessGroup updates an existing Access Group.
@@ -1111,6 +1339,10 @@ func (c *API) UpdateAccessGroup(ctx context.Context, groupID string, params Acce
 		return nil, err
 	}
 
+	if err := validateRequireRules(params.Require); err != nil {
+		return nil, fmt.Errorf("access group %q: %w", params.Name, err)
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	updateParams := cloudflare.UpdateAccessGroupParams{
@@ -1127,12 +1359,10 @@ func (c *API) UpdateAccessGroup(ctx context.Context, groupID string, params Acce
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindAccessGroup)
 	c.Log.Info("Access Group updated", "id", group.ID, "name", group.Name)
 
-	return &AccessGroupResult{
-		ID:   group.ID,
-		Name: group.Name,
-	}, nil
+	return convertAccessGroup(group), nil
 }
 
 // DeleteAccessGroup deletes an Access Group.
@@ -1155,6 +1385,7 @@ func (c *API) DeleteAccessGroup(ctx context.Context, groupID string) error {
 		return err
 	}
 
+	c.invalidateNameIDs(idKindAccessGroup)
 	c.Log.Info("Access Group deleted", "id", groupID)
 	return nil
 }
@@ -1172,6 +1403,120 @@ type AccessIdentityProviderResult struct {
 	ID   string
 	Name string
 	Type string
+	// Config and ScimConfig are the settings as returned by Cloudflare,
+	// with secrets redacted.
+	Config     cloudflare.AccessIdentityProviderConfiguration
+	ScimConfig cloudflare.AccessIdentityProviderScimConfiguration
+}
+
+func convertAccessIdentityProvider(idp cloudflare.AccessIdentityProvider) *AccessIdentityProviderResult {
+	return &AccessIdentityProviderResult{
+		ID:         idp.ID,
+		Name:       idp.Name,
+		Type:       idp.Type,
+		Config:     idp.Config,
+		ScimConfig: idp.ScimConfig,
+	}
+}
+
+// identityProviderSecretFields are config fields that Cloudflare returns redacted.
+var identityProviderSecretFields = []string{"client_secret", "api_token"}
+
+// AccessIdentityProviderInSync reports whether an existing identity provider already
+// matches params, so that updating it would be a no-op.
+//
+// Only config fields set in params are compared, since Cloudflare fills in defaults
+// such as the redirect URL and the OIDC scopes. Secrets are never compared because
+// Cloudflare returns them redacted. The SCIM config is compared the same way when
+// compareSCIM is set: only the settings set in params, ignoring the generated secret.
+func AccessIdentityProviderInSync(params AccessIdentityProviderParams, current *AccessIdentityProviderResult, compareSCIM bool) bool {
+	if current.Name != params.Name || current.Type != params.Type {
+		return false
+	}
+	if !identityProviderConfigMatches(params.Config, current.Config) {
+		return false
+	}
+	if compareSCIM {
+		return scimConfigMatches(params.ScimConfig, current.ScimConfig)
+	}
+	return true
+}
+
+// scimConfigMatches compares the SCIM settings set in desired with current.
+// Unset settings are left to Cloudflare's defaults, and the secret is skipped
+// because Cloudflare never returns it.
+func scimConfigMatches(desired, current cloudflare.AccessIdentityProviderScimConfiguration) bool {
+	if desired.Enabled && !current.Enabled ||
+		desired.UserDeprovision && !current.UserDeprovision ||
+		desired.SeatDeprovision && !current.SeatDeprovision ||
+		desired.GroupMemberDeprovision && !current.GroupMemberDeprovision {
+		return false
+	}
+	return desired.IdentityUpdateBehavior == "" || desired.IdentityUpdateBehavior == current.IdentityUpdateBehavior
+}
+
+// identityProviderConfigMatches compares the fields set in desired with current.
+// Lists are compared as unordered sets and strings ignore surrounding whitespace.
+func identityProviderConfigMatches(desired, current cloudflare.AccessIdentityProviderConfiguration) bool {
+	want, err := configFields(desired)
+	if err != nil {
+		return false
+	}
+	got, err := configFields(current)
+	if err != nil {
+		return false
+	}
+
+	for field, value := range want {
+		if slices.Contains(identityProviderSecretFields, field) {
+			continue
+		}
+		if !configValueEqual(value, got[field]) {
+			return false
+		}
+	}
+	return true
+}
+
+// configFields returns the non-empty fields of a config keyed by their API name.
+func configFields(config cloudflare.AccessIdentityProviderConfiguration) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func configValueEqual(desired, current interface{}) bool {
+	switch want := desired.(type) {
+	case string:
+		got, ok := current.(string)
+		return ok && strings.TrimSpace(want) == strings.TrimSpace(got)
+	case []interface{}:
+		got, ok := current.([]interface{})
+		if !ok || len(want) != len(got) {
+			return false
+		}
+		wantSet := make([]string, 0, len(want))
+		gotSet := make([]string, 0, len(got))
+		for i := range want {
+			wantSet = append(wantSet, fmt.Sprint(want[i]))
+			gotSet = append(gotSet, fmt.Sprint(got[i]))
+		}
+		sort.Strings(wantSet)
+		sort.Strings(gotSet)
+		return slices.Equal(wantSet, gotSet)
+	case bool:
+		// Cloudflare omits false flags
+		got, _ := current.(bool)
+		return want == got
+	default:
+		return desired == current
+	}
 }
 
 // CreateAccessIdentityProvider creates a new Access Identity Provider.
@@ -1196,13 +1541,10 @@ func (c *API) CreateAccessIdentityProvider(ctx context.Context, params AccessIde
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindAccessIdentityProvider)
 	c.Log.Info("Access Identity Provider created", "id", idp.ID, "name", idp.Name)
 
-	return &AccessIdentityProviderResult{
-		ID:   idp.ID,
-		Name: idp.Name,
-		Type: idp.Type,
-	}, nil
+	return convertAccessIdentityProvider(idp), nil
 }
 
 // GetAccessIdentityProvider retrieves an Access Identity Provider by ID.
@@ -1220,11 +1562,7 @@ func (c *API) GetAccessIdentityProvider(ctx context.Context, idpID string) (*Acc
 		return nil, err
 	}
 
-	return &AccessIdentityProviderResult{
-		ID:   idp.ID,
-		Name: idp.Name,
-		Type: idp.Type,
-	}, nil
+	return convertAccessIdentityProvider(idp), nil
 }
 
 // UpdateAccessIdentityProvider updates an existing Access Identity Provider.
@@ -1254,13 +1592,10 @@ func (c *API) UpdateAccessIdentityProvider(
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindAccessIdentityProvider)
 	c.Log.Info("Access Identity Provider updated", "id", idp.ID, "name", idp.Name)
 
-	return &AccessIdentityProviderResult{
-		ID:   idp.ID,
-		Name: idp.Name,
-		Type: idp.Type,
-	}, nil
+	return convertAccessIdentityProvider(idp), nil
 }
 
 // DeleteAccessIdentityProvider deletes an Access Identity Provider.
@@ -1283,6 +1618,7 @@ func (c *API) DeleteAccessIdentityProvider(ctx context.Context, idpID string) er
 		return err
 	}
 
+	c.invalidateNameIDs(idKindAccessIdentityProvider)
 	c.Log.Info("Access Identity Provider deleted", "id", idpID)
 	return nil
 }
@@ -1342,9 +1678,16 @@ func (c *API) GetAccessServiceTokenByName(ctx context.Context, name string) (*Ac
 		return nil, err
 	}
 
+	if token := lookupCachedName(ctx, c, idKindAccessServiceToken, name, c.getAccessServiceToken,
+		func(t *AccessServiceTokenResult) string { return t.Name }); token != nil {
+		return token, nil
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
-	tokens, _, err := c.CloudflareClient.ListAccessServiceTokens(ctx, rc, cloudflare.ListAccessServiceTokensParams{})
+	// The cloudflare-go method returns a single page, so the pages are requested directly
+	tokens, err := listAllPages(ctx, rawListPage[cloudflare.AccessServiceToken](c.CloudflareClient,
+		fmt.Sprintf("/%s/%s/access/service_tokens", rc.Level, rc.Identifier)))
 	if err != nil {
 		c.Log.Error(err, "error listing access service tokens")
 		return nil, err
@@ -1352,6 +1695,7 @@ func (c *API) GetAccessServiceTokenByName(ctx context.Context, name string) (*Ac
 
 	for _, token := range tokens {
 		if token.Name == name {
+			c.cacheNameID(idKindAccessServiceToken, name, token.ID)
 			return c.convertServiceToken(token), nil
 		}
 	}
@@ -1359,6 +1703,22 @@ func (c *API) GetAccessServiceTokenByName(ctx context.Context, name string) (*Ac
 	return nil, nil
 }
 
+// getAccessServiceToken retrieves an Access Service Token by ID.
+// cloudflare-go has no method for it, so the endpoint is requested directly.
+func (c *API) getAccessServiceToken(ctx context.Context, tokenID string) (*AccessServiceTokenResult, error) {
+	endpoint := fmt.Sprintf("/accounts/%s/access/service_tokens/%s", c.ValidAccountId, tokenID)
+	resp, err := c.CloudflareClient.Raw(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var token cloudflare.AccessServiceToken
+	if err := json.Unmarshal(resp.Result, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse access service token: %w", err)
+	}
+	return c.convertServiceToken(token), nil
+}
+
 // CreateAccessServiceToken creates a new Access Service Token.
 func (c *API) CreateAccessServiceToken(ctx context.Context, name string, duration string) (*AccessServiceTokenResult, error) {
 	if _, err := c.GetAccountId(ctx); err != nil {
@@ -1380,6 +1740,7 @@ func (c *API) CreateAccessServiceToken(ctx context.Context, name string, duratio
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindAccessServiceToken)
 	c.Log.Info("Access Service Token created", "id", token.ID, "name", token.Name)
 
 	expiresAt := ""
@@ -1419,6 +1780,7 @@ func (c *API) UpdateAccessServiceToken(ctx context.Context, tokenID string, name
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindAccessServiceToken)
 	c.Log.Info("Access Service Token updated", "id", token.ID, "name", token.Name)
 
 	expiresAt := ""
@@ -1490,6 +1852,7 @@ func (c *API) DeleteAccessServiceToken(ctx context.Context, tokenID string) erro
 		return err
 	}
 
+	c.invalidateNameIDs(idKindAccessServiceToken)
 	c.Log.Info("Access Service Token deleted", "id", tokenID)
 	return nil
 }
@@ -1690,6 +2053,7 @@ func (c *API) CreateDevicePostureRule(ctx context.Context, params DevicePostureR
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindDevicePostureRule)
 	c.Log.Info("Device Posture Rule created", "id", result.ID, "name", result.Name)
 
 	return &DevicePostureRuleResult{
@@ -1758,6 +2122,7 @@ func (c *API) UpdateDevicePostureRule(ctx context.Context, ruleID string, params
 		return nil, err
 	}
 
+	c.invalidateNameIDs(idKindDevicePostureRule)
 	c.Log.Info("Device Posture Rule updated", "id", result.ID, "name", result.Name)
 
 	return &DevicePostureRuleResult{
@@ -1787,6 +2152,7 @@ func (c *API) DeleteDevicePostureRule(ctx context.Context, ruleID string) error
 		return err
 	}
 
+	c.invalidateNameIDs(idKindDevicePostureRule)
 	c.Log.Info("Device Posture Rule deleted", "id", ruleID)
 	return nil
 }
@@ -1807,9 +2173,18 @@ func (c *API) ListAccessGroupsByName(ctx context.Context, name string) (*AccessG
 		return nil, err
 	}
 
+	if group := lookupCachedName(ctx, c, idKindAccessGroup, name, c.GetAccessGroup,
+		func(g *AccessGroupResult) string { return g.Name }); group != nil {
+		return group, nil
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
-	groups, _, err := c.CloudflareClient.ListAccessGroups(ctx, rc, cloudflare.ListAccessGroupsParams{})
+	groups, err := listAllPages(ctx, func(
+		ctx context.Context, page cloudflare.ResultInfo,
+	) ([]cloudflare.AccessGroup, *cloudflare.ResultInfo, error) {
+		return c.CloudflareClient.ListAccessGroups(ctx, rc, cloudflare.ListAccessGroupsParams{ResultInfo: page})
+	})
 	if err != nil {
 		c.Log.Error(err, "error listing access groups")
 		return nil, err
@@ -1817,10 +2192,8 @@ func (c *API) ListAccessGroupsByName(ctx context.Context, name string) (*AccessG
 
 	for _, group := range groups {
 		if group.Name == name {
-			return &AccessGroupResult{
-				ID:   group.ID,
-				Name: group.Name,
-			}, nil
+			c.cacheNameID(idKindAccessGroup, name, group.ID)
+			return convertAccessGroup(group), nil
 		}
 	}
 
@@ -1843,9 +2216,19 @@ func (c *API) ListAccessIdentityProvidersByName(ctx context.Context, name string
 		return nil, err
 	}
 
+	if provider := lookupCachedName(ctx, c, idKindAccessIdentityProvider, name, c.GetAccessIdentityProvider,
+		func(p *AccessIdentityProviderResult) string { return p.Name }); provider != nil {
+		return provider, nil
+	}
+
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
-	providers, _, err := c.CloudflareClient.ListAccessIdentityProviders(ctx, rc, cloudflare.ListAccessIdentityProvidersParams{})
+	providers, err := listAllPages(ctx, func(
+		ctx context.Context, page cloudflare.ResultInfo,
+	) ([]cloudflare.AccessIdentityProvider, *cloudflare.ResultInfo, error) {
+		return c.CloudflareClient.ListAccessIdentityProviders(ctx, rc,
+			cloudflare.ListAccessIdentityProvidersParams{ResultInfo: page})
+	})
 	if err != nil {
 		c.Log.Error(err, "error listing access identity providers")
 		return nil, err
@@ -1853,11 +2236,8 @@ func (c *API) ListAccessIdentityProvidersByName(ctx context.Context, name string
 
 	for _, provider := range providers {
 		if provider.Name == name {
-			return &AccessIdentityProviderResult{
-				ID:   provider.ID,
-				Name: provider.Name,
-				Type: provider.Type,
-			}, nil
+			c.cacheNameID(idKindAccessIdentityProvider, name, provider.ID)
+			return convertAccessIdentityProvider(provider), nil
 		}
 	}
 
@@ -1872,7 +2252,14 @@ func (c *API) ListDevicePostureRulesByName(ctx context.Context, name string) (*D
 		return nil, err
 	}
 
-	rules, _, err := c.CloudflareClient.DevicePostureRules(ctx, c.ValidAccountId)
+	if rule := lookupCachedName(ctx, c, idKindDevicePostureRule, name, c.GetDevicePostureRule,
+		func(r *DevicePostureRuleResult) string { return r.Name }); rule != nil {
+		return rule, nil
+	}
+
+	// The cloudflare-go method returns a single page, so the pages are requested directly
+	rules, err := listAllPages(ctx, rawListPage[cloudflare.DevicePostureRule](c.CloudflareClient,
+		fmt.Sprintf("/accounts/%s/devices/posture", c.ValidAccountId)))
 	if err != nil {
 		c.Log.Error(err, "error listing device posture rules")
 		return nil, err
@@ -1880,6 +2267,7 @@ func (c *API) ListDevicePostureRulesByName(ctx context.Context, name string) (*D
 
 	for _, rule := range rules {
 		if rule.Name == name {
+			c.cacheNameID(idKindDevicePostureRule, name, rule.ID)
 			return &DevicePostureRuleResult{
 				ID:          rule.ID,
 				Name:        rule.Name,
@@ -1917,6 +2305,32 @@ func (c *API) ListAccessApplicationsByName(ctx context.Context, name string) (*A
 	return nil, fmt.Errorf("access application not found: %s", name)
 }
 
+// GetAccessApplicationByAUD finds an Access Application by its audience (AUD) tag,
+// for integrations that only know the AUD of the tokens Access issues.
+// Returns nil without an error if no application has the AUD.
+func (c *API) GetAccessApplicationByAUD(ctx context.Context, aud string) (*AccessApplicationResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	apps, _, err := c.CloudflareClient.ListAccessApplications(ctx, rc, cloudflare.ListAccessApplicationsParams{})
+	if err != nil {
+		c.Log.Error(err, "error listing access applications")
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.AUD == aud {
+			return convertAccessApplicationToResult(app, c.ValidAccountId), nil
+		}
+	}
+
+	return nil, nil
+}
+
 // ============================================================================
 // Conversion helper functions for AccessApplication
 // ============================================================================
@@ -1953,9 +2367,31 @@ func convertAccessApplicationToResult(app cloudflare.AccessApplication, _ string
 		AllowedIdps:            app.AllowedIdps,
 		AutoRedirectToIdentity: autoRedirect,
 		SaasAppClientID:        saasAppClientID,
+		Policies:               convertApplicationPolicies(app.Policies),
 	}
 }
 
+// convertApplicationPolicies converts the policies embedded in an application,
+// sorted by precedence.
+func convertApplicationPolicies(policies []cloudflare.AccessPolicy) []AccessApplicationPolicyResult {
+	if len(policies) == 0 {
+		return nil
+	}
+	result := make([]AccessApplicationPolicyResult, 0, len(policies))
+	for _, p := range policies {
+		result = append(result, AccessApplicationPolicyResult{
+			ID:         p.ID,
+			Name:       p.Name,
+			Precedence: p.Precedence,
+			Reusable:   p.Reusable != nil && *p.Reusable,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Precedence < result[j].Precedence
+	})
+	return result
+}
+
 // convertDestinationsToCloudflare converts destination params to Cloudflare format.
 func convertDestinationsToCloudflare(destinations []AccessDestinationParams) []cloudflare.AccessDestination {
 	result := make([]cloudflare.AccessDestination, 0, len(destinations))
@@ -1979,14 +2415,18 @@ func convertDestinationsToCloudflare(destinations []AccessDestinationParams) []c
 func applyCreateAccessAppOptionalParams(
 	createParams *cloudflare.CreateAccessApplicationParams,
 	params AccessApplicationParams,
-) {
+) error {
+	corsHeaders, err := convertCorsHeadersToCloudflare(params.CorsHeaders)
+	if err != nil {
+		return err
+	}
+	createParams.CorsHeaders = corsHeaders
 	if params.DomainType != "" {
 		createParams.DomainType = cloudflare.AccessDestinationType(params.DomainType)
 	}
 	if destinations := buildAccessAppDestinations(params); len(destinations) > 0 {
 		createParams.Destinations = destinations
 	}
-	createParams.CorsHeaders = convertCorsHeadersToCloudflare(params.CorsHeaders)
 	createParams.SaasApplication = convertSaasAppToCloudflare(params.SaasApp)
 	createParams.SCIMConfig = convertSCIMConfigToCloudflare(params.SCIMConfig)
 	if params.AppLauncherCustomization != nil {
@@ -2005,6 +2445,7 @@ func applyCreateAccessAppOptionalParams(
 	if len(params.Policies) > 0 {
 		createParams.Policies = params.Policies
 	}
+	return nil
 }
 
 // applyUpdateAccessAppOptionalParams applies optional parameters to update params.
@@ -2013,14 +2454,18 @@ func applyCreateAccessAppOptionalParams(
 func applyUpdateAccessAppOptionalParams(
 	updateParams *cloudflare.UpdateAccessApplicationParams,
 	params AccessApplicationParams,
-) {
+) error {
+	corsHeaders, err := convertCorsHeadersToCloudflare(params.CorsHeaders)
+	if err != nil {
+		return err
+	}
+	updateParams.CorsHeaders = corsHeaders
 	if params.DomainType != "" {
 		updateParams.DomainType = cloudflare.AccessDestinationType(params.DomainType)
 	}
 	if destinations := buildAccessAppDestinations(params); len(destinations) > 0 {
 		updateParams.Destinations = destinations
 	}
-	updateParams.CorsHeaders = convertCorsHeadersToCloudflare(params.CorsHeaders)
 	updateParams.SaasApplication = convertSaasAppToCloudflare(params.SaasApp)
 	updateParams.SCIMConfig = convertSCIMConfigToCloudflare(params.SCIMConfig)
 	if params.AppLauncherCustomization != nil {
@@ -2040,10 +2485,90 @@ func applyUpdateAccessAppOptionalParams(
 	if len(params.Policies) > 0 {
 		updateParams.Policies = &params.Policies
 	}
+	return nil
+}
+
+// ValidateAccessApplicationType checks the fields that the application type requires
+// or does not accept. Bookmarks need a URL in Domain and take no policies, destinations
+// or SaaS settings; app_launcher and dash_sso applications have no domain of their own.
+func ValidateAccessApplicationType(params AccessApplicationParams) error {
+	switch params.Type {
+	case AccessAppTypeBookmark:
+		if params.Domain == "" {
+			return fmt.Errorf("%w: bookmark application %q requires a domain with the bookmark URL",
+				ErrInvalidConfiguration, params.Name)
+		}
+		if len(params.Policies) > 0 {
+			return fmt.Errorf("%w: bookmark application %q cannot have policies: bookmarks are not protected by Access",
+				ErrInvalidConfiguration, params.Name)
+		}
+		return rejectAccessAppFields(params, "domains or destinations",
+			len(params.SelfHostedDomains) > 0 || len(params.Destinations) > 0)
+	case AccessAppTypeAppLauncher, AccessAppTypeDashSSO:
+		return rejectAccessAppFields(params, "a domain or destinations",
+			params.Domain != "" || len(params.SelfHostedDomains) > 0 || len(params.Destinations) > 0)
+	default:
+		return nil
+	}
+}
+
+// rejectAccessAppFields returns an error naming the fields when the type does not
+// accept them. SaaS and SCIM settings are rejected for every type handled here.
+func rejectAccessAppFields(params AccessApplicationParams, fields string, set bool) error {
+	if set {
+		return fmt.Errorf("%w: %s application %q cannot have %s", ErrInvalidConfiguration, params.Type, params.Name, fields)
+	}
+	if params.SaasApp != nil || params.SCIMConfig != nil {
+		return fmt.Errorf("%w: %s application %q cannot have SaaS or SCIM settings",
+			ErrInvalidConfiguration, params.Type, params.Name)
+	}
+	return nil
+}
+
+// applyAccessAppTypeRules validates params for the application type and clears
+// optional settings the type does not use, such as the defaulted session duration
+// of a bookmark, so they are not sent to Cloudflare.
+func applyAccessAppTypeRules(params AccessApplicationParams) (AccessApplicationParams, error) {
+	if err := ValidateAccessApplicationType(params); err != nil {
+		return params, err
+	}
+
+	switch params.Type {
+	case AccessAppTypeBookmark:
+		// Bookmarks only keep their name, URL, logo, tags and App Launcher visibility
+		params = AccessApplicationParams{
+			Name:               params.Name,
+			Domain:             params.Domain,
+			Type:               params.Type,
+			LogoURL:            params.LogoURL,
+			AppLauncherVisible: params.AppLauncherVisible,
+			Tags:               params.Tags,
+		}
+	case AccessAppTypeAppLauncher, AccessAppTypeDashSSO:
+		// Login settings apply, settings of the protected origin do not
+		launcher := AccessApplicationParams{
+			Name:                   params.Name,
+			Type:                   params.Type,
+			SessionDuration:        params.SessionDuration,
+			AllowedIdps:            params.AllowedIdps,
+			AutoRedirectToIdentity: params.AutoRedirectToIdentity,
+			Policies:               params.Policies,
+		}
+		if params.Type == AccessAppTypeAppLauncher {
+			launcher.AppLauncherCustomization = params.AppLauncherCustomization
+			launcher.AllowAuthenticateViaWarp = params.AllowAuthenticateViaWarp
+		}
+		params = launcher
+	}
+	return params, nil
 }
 
 // buildAccessAppDestinations builds the full destinations list including Domain and SelfHostedDomains.
 func buildAccessAppDestinations(params AccessApplicationParams) []cloudflare.AccessDestination {
+	// Bookmarks link to Domain directly and take no destinations
+	if params.Type == AccessAppTypeBookmark {
+		return nil
+	}
 	destinations := convertDestinationsToCloudflare(params.Destinations)
 	// Add main domain as public destination if not already included
 	if params.Domain != "" {
@@ -2074,11 +2599,77 @@ func buildGatewayRules(gatewayRules []string) []cloudflare.AccessApplicationGate
 	return result
 }
 
-// convertCorsHeadersToCloudflare converts CORS headers params to Cloudflare format.
-func convertCorsHeadersToCloudflare(cors *AccessApplicationCorsHeadersParams) *cloudflare.AccessApplicationCorsHeaders {
+// MaxSessionDuration is the longest session duration Cloudflare accepts (one month).
+const MaxSessionDuration = 730 * time.Hour
+
+// ValidateSessionDuration checks a session duration of an Access application or policy.
+// Cloudflare accepts Go duration strings such as "30m" or "2h45m" with the units ns, us,
+// ms, s, m and h, up to MaxSessionDuration. "0s" makes Access prompt on every request.
+// An empty value leaves the duration unset.
+func ValidateSessionDuration(value string) error {
+	if value == "" {
+		return nil
+	}
+	duration, err := time.ParseDuration(value)
+	// ParseDuration accepts a bare "0", Cloudflare requires a unit
+	if err != nil || value == "0" {
+		return fmt.Errorf("%w: session duration %q is not a duration such as \"30m\" or \"24h\" (units: ns, us, ms, s, m, h)",
+			ErrInvalidConfiguration, value)
+	}
+	if duration < 0 {
+		return fmt.Errorf("%w: session duration %q must not be negative", ErrInvalidConfiguration, value)
+	}
+	if duration > MaxSessionDuration {
+		return fmt.Errorf("%w: session duration %q exceeds the maximum of %s", ErrInvalidConfiguration, value, MaxSessionDuration)
+	}
+	return nil
+}
+
+// validateOptionalSessionDuration validates a session duration override that may be unset.
+func validateOptionalSessionDuration(value *string) error {
+	if value == nil {
+		return nil
+	}
+	return ValidateSessionDuration(*value)
+}
+
+const (
+	// CorsMaxAgeDisabled is the CORS max age that disables caching of preflight responses.
+	CorsMaxAgeDisabled = -1
+	// CorsMaxAgeLimit is the largest CORS max age in seconds accepted by Cloudflare.
+	CorsMaxAgeLimit = 86400
+)
+
+// ValidateCorsHeaders checks CORS settings for values Cloudflare or browsers reject.
+// MaxAge may be -1 (no preflight caching), 0 (unset) or up to CorsMaxAgeLimit seconds.
+func ValidateCorsHeaders(cors *AccessApplicationCorsHeadersParams) error {
 	if cors == nil {
 		return nil
 	}
+	if cors.MaxAge < CorsMaxAgeDisabled || cors.MaxAge > CorsMaxAgeLimit {
+		return fmt.Errorf("%w: corsHeaders.maxAge %d is out of range: must be %d to disable preflight caching or between 0 and %d seconds",
+			ErrInvalidConfiguration, cors.MaxAge, CorsMaxAgeDisabled, CorsMaxAgeLimit)
+	}
+	if cors.AllowCredentials && cors.AllowAllOrigins {
+		return fmt.Errorf("%w: corsHeaders.allowCredentials cannot be combined with allowAllOrigins: "+
+			"browsers reject credentialed requests to a wildcard origin, list the origins in allowedOrigins instead",
+			ErrInvalidConfiguration)
+	}
+	if cors.AllowCredentials && slices.Contains(cors.AllowedOrigins, "*") {
+		return fmt.Errorf("%w: corsHeaders.allowCredentials cannot be combined with the \"*\" origin in allowedOrigins: "+
+			"browsers reject credentialed requests to a wildcard origin", ErrInvalidConfiguration)
+	}
+	return nil
+}
+
+// convertCorsHeadersToCloudflare validates and converts CORS headers params to Cloudflare format.
+func convertCorsHeadersToCloudflare(cors *AccessApplicationCorsHeadersParams) (*cloudflare.AccessApplicationCorsHeaders, error) {
+	if cors == nil {
+		return nil, nil
+	}
+	if err := ValidateCorsHeaders(cors); err != nil {
+		return nil, err
+	}
 	return &cloudflare.AccessApplicationCorsHeaders{
 		AllowedMethods:   cors.AllowedMethods,
 		AllowedOrigins:   cors.AllowedOrigins,
@@ -2088,7 +2679,7 @@ func convertCorsHeadersToCloudflare(cors *AccessApplicationCorsHeadersParams) *c
 		AllowAllOrigins:  cors.AllowAllOrigins,
 		AllowCredentials: cors.AllowCredentials,
 		MaxAge:           cors.MaxAge,
-	}
+	}, nil
 }
 
 // convertSaasAppToCloudflare converts SaaS app params to Cloudflare format.
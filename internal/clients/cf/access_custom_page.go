@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Access custom page types.
+const (
+	AccessCustomPageTypeForbidden      = "forbidden"
+	AccessCustomPageTypeIdentityDenied = "identity_denied"
+)
+
+// AccessCustomPageParams contains parameters for creating or updating an Access custom page.
+type AccessCustomPageParams struct {
+	Name string
+	// Type is the page the HTML replaces: "forbidden" or "identity_denied".
+	Type       string
+	CustomHTML string
+}
+
+// AccessCustomPageResult contains the result of an Access custom page operation.
+type AccessCustomPageResult struct {
+	ID         string
+	Name       string
+	Type       string
+	CustomHTML string
+	// AppCount is the number of Access applications using the page.
+	AppCount int
+}
+
+// validateAccessCustomPageParams rejects pages Cloudflare would refuse.
+func validateAccessCustomPageParams(params AccessCustomPageParams) error {
+	if params.Name == "" {
+		return fmt.Errorf("%w: access custom page name is required", ErrInvalidConfiguration)
+	}
+	if params.CustomHTML == "" {
+		return fmt.Errorf("%w: access custom page %q has no HTML content", ErrInvalidConfiguration, params.Name)
+	}
+	switch params.Type {
+	case AccessCustomPageTypeForbidden, AccessCustomPageTypeIdentityDenied:
+		return nil
+	default:
+		return fmt.Errorf("%w: access custom page type %q must be %q or %q",
+			ErrInvalidConfiguration, params.Type, AccessCustomPageTypeForbidden, AccessCustomPageTypeIdentityDenied)
+	}
+}
+
+// CreateAccessCustomPage creates an Access custom page.
+func (c *API) CreateAccessCustomPage(ctx context.Context, params AccessCustomPageParams) (*AccessCustomPageResult, error) {
+	if err := validateAccessCustomPageParams(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	page, err := c.CloudflareClient.CreateAccessCustomPage(ctx, rc, cloudflare.CreateAccessCustomPageParams{
+		Name:       params.Name,
+		Type:       cloudflare.AccessCustomPageType(params.Type),
+		CustomHTML: params.CustomHTML,
+	})
+	if err != nil {
+		c.Log.Error(err, "error creating access custom page", "name", params.Name)
+		return nil, err
+	}
+
+	c.Log.Info("Access custom page created", "id", page.UID, "name", page.Name)
+
+	return convertAccessCustomPageToResult(page), nil
+}
+
+// GetAccessCustomPage retrieves an Access custom page by ID.
+func (c *API) GetAccessCustomPage(ctx context.Context, pageID string) (*AccessCustomPageResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	page, err := c.CloudflareClient.GetAccessCustomPage(ctx, rc, pageID)
+	if err != nil {
+		c.Log.Error(err, "error getting access custom page", "id", pageID)
+		return nil, err
+	}
+
+	return convertAccessCustomPageToResult(page), nil
+}
+
+// ListAccessCustomPages lists the Access custom pages of the account.
+func (c *API) ListAccessCustomPages(ctx context.Context) ([]AccessCustomPageResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	pages, err := c.CloudflareClient.ListAccessCustomPages(ctx, rc, cloudflare.ListAccessCustomPagesParams{})
+	if err != nil {
+		c.Log.Error(err, "error listing access custom pages")
+		return nil, err
+	}
+
+	results := make([]AccessCustomPageResult, 0, len(pages))
+	for _, page := range pages {
+		results = append(results, *convertAccessCustomPageToResult(page))
+	}
+	return results, nil
+}
+
+// GetAccessCustomPageByName retrieves an Access custom page by name.
+// Returns nil if no page with the name exists.
+func (c *API) GetAccessCustomPageByName(ctx context.Context, name string) (*AccessCustomPageResult, error) {
+	pages, err := c.ListAccessCustomPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pages {
+		if pages[i].Name == name {
+			return &pages[i], nil
+		}
+	}
+
+	return nil, nil // Not found, return nil without error
+}
+
+// UpdateAccessCustomPage updates an existing Access custom page.
+func (c *API) UpdateAccessCustomPage(
+	ctx context.Context,
+	pageID string,
+	params AccessCustomPageParams,
+) (*AccessCustomPageResult, error) {
+	if err := validateAccessCustomPageParams(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	page, err := c.CloudflareClient.UpdateAccessCustomPage(ctx, rc, cloudflare.UpdateAccessCustomPageParams{
+		UID:        pageID,
+		Name:       params.Name,
+		Type:       cloudflare.AccessCustomPageType(params.Type),
+		CustomHTML: params.CustomHTML,
+	})
+	if err != nil {
+		c.Log.Error(err, "error updating access custom page", "id", pageID)
+		return nil, err
+	}
+
+	c.Log.Info("Access custom page updated", "id", page.UID, "name", page.Name)
+
+	return convertAccessCustomPageToResult(page), nil
+}
+
+// DeleteAccessCustomPage deletes an Access custom page.
+// This method is idempotent - returns nil if the page is already deleted.
+func (c *API) DeleteAccessCustomPage(ctx context.Context, pageID string) error {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	if err := c.CloudflareClient.DeleteAccessCustomPage(ctx, rc, pageID); err != nil {
+		if IsNotFoundError(err) {
+			c.Log.Info("Access custom page already deleted", "id", pageID)
+			return nil
+		}
+		c.Log.Error(err, "error deleting access custom page", "id", pageID)
+		return err
+	}
+
+	c.Log.Info("Access custom page deleted", "id", pageID)
+	return nil
+}
+
+// AccessCustomPageInSync reports whether the page in Cloudflare matches the params.
+func AccessCustomPageInSync(params AccessCustomPageParams, current *AccessCustomPageResult) bool {
+	return current.Name == params.Name &&
+		current.Type == params.Type &&
+		current.CustomHTML == params.CustomHTML
+}
+
+// convertAccessCustomPageToResult converts a Cloudflare custom page to our result type.
+func convertAccessCustomPageToResult(page cloudflare.AccessCustomPage) *AccessCustomPageResult {
+	return &AccessCustomPageResult{
+		ID:         page.UID,
+		Name:       page.Name,
+		Type:       string(page.Type),
+		CustomHTML: page.CustomHTML,
+		AppCount:   page.AppCount,
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessCustomPageCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateAccessCustomPage(ctx, AccessCustomPageParams{
+		Name:       "blocked",
+		Type:       AccessCustomPageTypeForbidden,
+		CustomHTML: "<html><body>Blocked</body></html>",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, AccessCustomPageTypeForbidden, created.Type)
+
+	updated, err := api.UpdateAccessCustomPage(ctx, created.ID, AccessCustomPageParams{
+		Name:       "blocked",
+		Type:       AccessCustomPageTypeIdentityDenied,
+		CustomHTML: "<html><body>Denied</body></html>",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, AccessCustomPageTypeIdentityDenied, updated.Type)
+	assert.Equal(t, "<html><body>Denied</body></html>", updated.CustomHTML)
+
+	byName, err := api.GetAccessCustomPageByName(ctx, "blocked")
+	require.NoError(t, err)
+	require.NotNil(t, byName)
+	assert.Equal(t, created.ID, byName.ID)
+
+	missing, err := api.GetAccessCustomPageByName(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, api.DeleteAccessCustomPage(ctx, created.ID))
+	_, ok := mock.Store().GetAccessCustomPage(created.ID)
+	assert.False(t, ok)
+	_, err = api.GetAccessCustomPage(ctx, created.ID)
+	assert.True(t, IsNotFoundError(err))
+
+	assert.NoError(t, api.DeleteAccessCustomPage(ctx, created.ID), "deletion is idempotent")
+}
+
+func TestAccessCustomPageValidation(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params AccessCustomPageParams
+	}{
+		{name: "missing name", params: AccessCustomPageParams{Type: AccessCustomPageTypeForbidden, CustomHTML: "<p>x</p>"}},
+		{name: "missing html", params: AccessCustomPageParams{Name: "page", Type: AccessCustomPageTypeForbidden}},
+		{name: "unknown type", params: AccessCustomPageParams{Name: "page", Type: "login", CustomHTML: "<p>x</p>"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := api.CreateAccessCustomPage(ctx, tt.params)
+			assert.True(t, errors.Is(err, ErrInvalidConfiguration), "got %v", err)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// AccessMutualTLSCertificateParams contains parameters for uploading or updating
+// an Access mTLS certificate, the CA that issues client certificates.
+type AccessMutualTLSCertificateParams struct {
+	Name string
+	// Certificate is the PEM-encoded CA certificate. It is only used on creation:
+	// Cloudflare does not replace the certificate of an existing entry.
+	Certificate string
+	// AssociatedHostnames are the hostnames whose certificate rules accept client
+	// certificates issued by this CA.
+	AssociatedHostnames []string
+}
+
+// AccessMutualTLSCertificateResult contains the result of an Access mTLS certificate operation.
+type AccessMutualTLSCertificateResult struct {
+	ID                  string
+	Name                string
+	Fingerprint         string
+	AssociatedHostnames []string
+	ExpiresOn           time.Time
+}
+
+// CreateAccessMutualTLSCertificate uploads a CA certificate for mTLS client authentication.
+func (c *API) CreateAccessMutualTLSCertificate(
+	ctx context.Context,
+	params AccessMutualTLSCertificateParams,
+) (*AccessMutualTLSCertificateResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	cert, err := c.CloudflareClient.CreateAccessMutualTLSCertificate(ctx, rc, cloudflare.CreateAccessMutualTLSCertificateParams{
+		Name:                params.Name,
+		Certificate:         params.Certificate,
+		AssociatedHostnames: params.AssociatedHostnames,
+	})
+	if err != nil {
+		c.Log.Error(err, "error creating access mTLS certificate", "name", params.Name)
+		return nil, err
+	}
+
+	c.Log.Info("Access mTLS certificate created", "id", cert.ID, "name", cert.Name)
+
+	return convertMutualTLSCertificateToResult(cert), nil
+}
+
+// GetAccessMutualTLSCertificate retrieves an Access mTLS certificate by ID.
+func (c *API) GetAccessMutualTLSCertificate(ctx context.Context, certificateID string) (*AccessMutualTLSCertificateResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	cert, err := c.CloudflareClient.GetAccessMutualTLSCertificate(ctx, rc, certificateID)
+	if err != nil {
+		c.Log.Error(err, "error getting access mTLS certificate", "id", certificateID)
+		return nil, err
+	}
+
+	return convertMutualTLSCertificateToResult(cert), nil
+}
+
+// ListAccessMutualTLSCertificates lists the Access mTLS certificates of the account.
+func (c *API) ListAccessMutualTLSCertificates(ctx context.Context) ([]AccessMutualTLSCertificateResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	certs, _, err := c.CloudflareClient.ListAccessMutualTLSCertificates(ctx, rc, cloudflare.ListAccessMutualTLSCertificatesParams{})
+	if err != nil {
+		c.Log.Error(err, "error listing access mTLS certificates")
+		return nil, err
+	}
+
+	results := make([]AccessMutualTLSCertificateResult, 0, len(certs))
+	for _, cert := range certs {
+		results = append(results, *convertMutualTLSCertificateToResult(cert))
+	}
+	return results, nil
+}
+
+// UpdateAccessMutualTLSCertificate updates the name and associated hostnames of an
+// Access mTLS certificate. The SDK drops an empty hostname list, so the request is
+// sent directly to allow removing all hostnames, which Cloudflare requires before deletion.
+func (c *API) UpdateAccessMutualTLSCertificate(
+	ctx context.Context,
+	certificateID string,
+	params AccessMutualTLSCertificateParams,
+) (*AccessMutualTLSCertificateResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	hostnames := params.AssociatedHostnames
+	if hostnames == nil {
+		hostnames = []string{}
+	}
+	body := struct {
+		Name                string   `json:"name,omitempty"`
+		AssociatedHostnames []string `json:"associated_hostnames"`
+	}{Name: params.Name, AssociatedHostnames: hostnames}
+
+	endpoint := fmt.Sprintf("/accounts/%s/access/certificates/%s", c.ValidAccountId, certificateID)
+	resp, err := c.CloudflareClient.Raw(ctx, http.MethodPut, endpoint, body, nil)
+	if err != nil {
+		c.Log.Error(err, "error updating access mTLS certificate", "id", certificateID)
+		return nil, err
+	}
+
+	var cert cloudflare.AccessMutualTLSCertificate
+	if err := json.Unmarshal(resp.Result, &cert); err != nil {
+		return nil, fmt.Errorf("failed to parse access mTLS certificate: %w", err)
+	}
+
+	c.Log.Info("Access mTLS certificate updated", "id", cert.ID, "hostnames", cert.AssociatedHostnames)
+
+	return convertMutualTLSCertificateToResult(cert), nil
+}
+
+// DeleteAccessMutualTLSCertificate deletes an Access mTLS certificate.
+// Its associated hostnames are removed first, as Cloudflare rejects deleting a
+// certificate that is still in use. This method is idempotent - returns nil if
+// the certificate is already deleted.
+func (c *API) DeleteAccessMutualTLSCertificate(ctx context.Context, certificateID string) error {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return err
+	}
+
+	if _, err := c.UpdateAccessMutualTLSCertificate(ctx, certificateID, AccessMutualTLSCertificateParams{}); err != nil {
+		if IsNotFoundError(err) {
+			c.Log.Info("Access mTLS certificate already deleted", "id", certificateID)
+			return nil
+		}
+		return err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	if err := c.CloudflareClient.DeleteAccessMutualTLSCertificate(ctx, rc, certificateID); err != nil {
+		if IsNotFoundError(err) {
+			c.Log.Info("Access mTLS certificate already deleted", "id", certificateID)
+			return nil
+		}
+		c.Log.Error(err, "error deleting access mTLS certificate", "id", certificateID)
+		return err
+	}
+
+	c.Log.Info("Access mTLS certificate deleted", "id", certificateID)
+	return nil
+}
+
+// convertMutualTLSCertificateToResult converts a Cloudflare mTLS certificate to our result type.
+func convertMutualTLSCertificateToResult(cert cloudflare.AccessMutualTLSCertificate) *AccessMutualTLSCertificateResult {
+	return &AccessMutualTLSCertificateResult{
+		ID:                  cert.ID,
+		Name:                cert.Name,
+		Fingerprint:         cert.Fingerprint,
+		AssociatedHostnames: cert.AssociatedHostnames,
+		ExpiresOn:           cert.ExpiresOn,
+	}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMutualTLSCA = "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIU\n-----END CERTIFICATE-----\n"
+
+func TestAccessMutualTLSCertificateCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateAccessMutualTLSCertificate(ctx, AccessMutualTLSCertificateParams{
+		Name:                "client-ca",
+		Certificate:         testMutualTLSCA,
+		AssociatedHostnames: []string{"app.example.com"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.NotEmpty(t, created.Fingerprint)
+	assert.Equal(t, []string{"app.example.com"}, created.AssociatedHostnames)
+
+	updated, err := api.UpdateAccessMutualTLSCertificate(ctx, created.ID, AccessMutualTLSCertificateParams{
+		Name:                "client-ca",
+		AssociatedHostnames: []string{"app.example.com", "api.example.com"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app.example.com", "api.example.com"}, updated.AssociatedHostnames)
+
+	certs, err := api.ListAccessMutualTLSCertificates(ctx)
+	require.NoError(t, err)
+	assert.Len(t, certs, 1)
+
+	// Hostnames are cleared before deletion, which the API requires
+	require.NoError(t, api.DeleteAccessMutualTLSCertificate(ctx, created.ID))
+	_, ok := mock.Store().GetAccessCertificate(created.ID)
+	assert.False(t, ok)
+	_, err = api.GetAccessMutualTLSCertificate(ctx, created.ID)
+	assert.True(t, IsNotFoundError(err))
+
+	assert.NoError(t, api.DeleteAccessMutualTLSCertificate(ctx, created.ID), "deletion is idempotent")
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// AccessTagResult contains the result of an Access tag operation.
+type AccessTagResult struct {
+	Name string
+	// AppCount is the number of Access applications with the tag.
+	AppCount int
+}
+
+// ListAccessTags lists the Access tags of the account.
+func (c *API) ListAccessTags(ctx context.Context) ([]AccessTagResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	// The cloudflare-go method returns a single page, so the pages are requested directly
+	tags, err := listAllPages(ctx, rawListPage[cloudflare.AccessTag](c.CloudflareClient,
+		fmt.Sprintf("/%s/%s/access/tags", rc.Level, rc.Identifier)))
+	if err != nil {
+		c.Log.Error(err, "error listing access tags")
+		return nil, err
+	}
+
+	results := make([]AccessTagResult, 0, len(tags))
+	for _, tag := range tags {
+		results = append(results, AccessTagResult{Name: tag.Name, AppCount: tag.AppCount})
+	}
+	return results, nil
+}
+
+// CreateAccessTag creates an Access tag.
+func (c *API) CreateAccessTag(ctx context.Context, name string) (*AccessTagResult, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: access tag name is required", ErrInvalidConfiguration)
+	}
+
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	tag, err := c.CloudflareClient.CreateAccessTag(ctx, rc, cloudflare.CreateAccessTagParams{Name: name})
+	if err != nil {
+		c.Log.Error(err, "error creating access tag", "name", name)
+		return nil, err
+	}
+
+	c.Log.Info("Access tag created", "name", tag.Name)
+
+	return &AccessTagResult{Name: tag.Name, AppCount: tag.AppCount}, nil
+}
+
+// DeleteAccessTag deletes an Access tag.
+// This method is idempotent - returns nil if the tag is already deleted.
+func (c *API) DeleteAccessTag(ctx context.Context, name string) error {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	if err := c.CloudflareClient.DeleteAccessTag(ctx, rc, name); err != nil {
+		if IsNotFoundError(err) {
+			c.Log.Info("Access tag already deleted", "name", name)
+			return nil
+		}
+		c.Log.Error(err, "error deleting access tag", "name", name)
+		return err
+	}
+
+	c.Log.Info("Access tag deleted", "name", name)
+	return nil
+}
+
+// MissingAccessTags returns the names that have no Access tag in the account,
+// in the order they are given. Duplicates are reported once.
+func (c *API) MissingAccessTags(ctx context.Context, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags, err := c.ListAccessTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		existing[tag.Name] = true
+	}
+
+	var missing []string
+	for _, name := range names {
+		if existing[name] {
+			continue
+		}
+		existing[name] = true
+		missing = append(missing, name)
+	}
+	return missing, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTagLifecycle(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateAccessTag(ctx, "engineering")
+	require.NoError(t, err)
+	assert.Equal(t, "engineering", created.Name)
+
+	tags, err := api.ListAccessTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "engineering", tags[0].Name)
+
+	missing, err := api.MissingAccessTags(ctx, []string{"engineering", "finance", "finance", "ops"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"finance", "ops"}, missing)
+
+	require.NoError(t, api.DeleteAccessTag(ctx, "engineering"))
+	_, ok := mock.Store().GetAccessTag("engineering")
+	assert.False(t, ok)
+	assert.NoError(t, api.DeleteAccessTag(ctx, "engineering"), "deletion is idempotent")
+}
+
+func TestCreateAccessTagRequiresName(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+
+	_, err := api.CreateAccessTag(context.Background(), "")
+	assert.True(t, errors.Is(err, ErrInvalidConfiguration), "got %v", err)
+}
+
+func TestMissingAccessTagsWithoutNames(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+
+	missing, err := api.MissingAccessTags(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	assert.Zero(t, mock.CountRequests("GET", "/access/tags"), "no tags means no lookup")
+}
@@ -4,8 +4,10 @@
 package cf
 
 import (
+	"context"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -598,3 +600,556 @@ func TestAccessGroupParamsConversion(t *testing.T) {
 	assert.Len(t, params.Exclude, 1)
 	assert.Len(t, params.Require, 1)
 }
+
+func TestValidateCorsHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		cors    *AccessApplicationCorsHeadersParams
+		wantErr string
+	}{
+		{name: "nil", cors: nil},
+		{name: "max age unset", cors: &AccessApplicationCorsHeadersParams{AllowAllOrigins: true}},
+		{name: "max age disables caching", cors: &AccessApplicationCorsHeadersParams{MaxAge: -1}},
+		{name: "max age at limit", cors: &AccessApplicationCorsHeadersParams{MaxAge: 86400}},
+		{
+			name:    "max age above limit",
+			cors:    &AccessApplicationCorsHeadersParams{MaxAge: 86401},
+			wantErr: "corsHeaders.maxAge 86401 is out of range",
+		},
+		{
+			name:    "max age below -1",
+			cors:    &AccessApplicationCorsHeadersParams{MaxAge: -2},
+			wantErr: "corsHeaders.maxAge -2 is out of range",
+		},
+		{
+			name: "credentials with listed origins",
+			cors: &AccessApplicationCorsHeadersParams{
+				AllowedOrigins:   []string{"https://app.example.com"},
+				AllowCredentials: true,
+			},
+		},
+		{
+			name:    "credentials with all origins",
+			cors:    &AccessApplicationCorsHeadersParams{AllowAllOrigins: true, AllowCredentials: true},
+			wantErr: "allowCredentials cannot be combined with allowAllOrigins",
+		},
+		{
+			name: "credentials with wildcard origin",
+			cors: &AccessApplicationCorsHeadersParams{
+				AllowedOrigins:   []string{"https://app.example.com", "*"},
+				AllowCredentials: true,
+			},
+			wantErr: `allowCredentials cannot be combined with the "*" origin`,
+		},
+		{name: "all origins without credentials", cors: &AccessApplicationCorsHeadersParams{AllowAllOrigins: true, MaxAge: 600}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCorsHeaders(tt.cors)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidConfiguration)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestConvertCorsHeadersToCloudflare(t *testing.T) {
+	result, err := convertCorsHeadersToCloudflare(&AccessApplicationCorsHeadersParams{
+		AllowedOrigins: []string{"https://app.example.com"},
+		MaxAge:         -1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, -1, result.MaxAge)
+
+	_, err = convertCorsHeadersToCloudflare(&AccessApplicationCorsHeadersParams{AllowAllOrigins: true, AllowCredentials: true})
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+}
+
+func TestAccessGroupRulesMatch(t *testing.T) {
+	alice := AccessGroupRuleParams{Email: &AccessGroupEmailRuleParams{Email: "alice@example.com"}}
+	bob := AccessGroupRuleParams{Email: &AccessGroupEmailRuleParams{Email: "bob@example.com"}}
+	github := AccessGroupRuleParams{GitHub: &AccessGroupGitHubRuleParams{
+		Name: "org", Teams: []string{"ops"}, IdentityProviderID: "idp-1",
+	}}
+
+	// Rules as decoded from a Cloudflare response
+	current := []interface{}{
+		map[string]interface{}{"email": map[string]interface{}{"email": "alice@example.com"}},
+		map[string]interface{}{"github_organization": map[string]interface{}{
+			"identity_provider_id": "idp-1", "name": "org", "teams": []interface{}{"ops"},
+		}},
+		map[string]interface{}{"email": map[string]interface{}{"email": "bob@example.com"}},
+	}
+
+	tests := []struct {
+		name    string
+		desired []AccessGroupRuleParams
+		current []interface{}
+		want    bool
+	}{
+		{name: "same order", desired: []AccessGroupRuleParams{alice, github, bob}, current: current, want: true},
+		{name: "reordered", desired: []AccessGroupRuleParams{bob, alice, github}, current: current, want: true},
+		{name: "rule removed", desired: []AccessGroupRuleParams{alice, github}, current: current, want: false},
+		{
+			name:    "rule changed",
+			desired: []AccessGroupRuleParams{alice, github, {Email: &AccessGroupEmailRuleParams{Email: "carol@example.com"}}},
+			current: current,
+			want:    false,
+		},
+		{name: "duplicate is not a reorder", desired: []AccessGroupRuleParams{alice, alice, bob}, current: current, want: false},
+		{name: "both empty", desired: nil, current: []interface{}{}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AccessGroupRulesMatch(tt.desired, tt.current))
+		})
+	}
+}
+
+func TestAccessGroupInSync(t *testing.T) {
+	params := AccessGroupParams{
+		Name:    "employees",
+		Include: []AccessGroupRuleParams{{EmailDomain: &AccessGroupEmailDomainRuleParams{Domain: "example.com"}}},
+		Require: []AccessGroupRuleParams{{Everyone: true}},
+	}
+	current := &AccessGroupResult{
+		Name:    "employees",
+		Include: []interface{}{map[string]interface{}{"email_domain": map[string]interface{}{"domain": "example.com"}}},
+		Require: []interface{}{map[string]interface{}{"everyone": map[string]interface{}{}}},
+	}
+	assert.True(t, AccessGroupInSync(params, current))
+
+	renamed := *current
+	renamed.Name = "staff"
+	assert.False(t, AccessGroupInSync(params, &renamed))
+
+	excluded := *current
+	excluded.Exclude = current.Require
+	assert.False(t, AccessGroupInSync(params, &excluded))
+}
+
+func TestReusableAccessPolicyInSync(t *testing.T) {
+	params := ReusableAccessPolicyParams{
+		Name:     "approvers",
+		Decision: "allow",
+		Include:  []AccessGroupRuleParams{{EmailDomain: &AccessGroupEmailDomainRuleParams{Domain: "example.com"}}},
+		ApprovalGroups: []AccessApprovalGroupParams{
+			{EmailAddresses: []string{"a@example.com", "b@example.com"}, ApprovalsNeeded: 1},
+			{EmailListUUID: "list-1", ApprovalsNeeded: 2},
+		},
+	}
+	// Cloudflare fills in the session duration, precedence and unset flags
+	current := &ReusableAccessPolicyResult{
+		Name:              "approvers",
+		Decision:          "allow",
+		Precedence:        3,
+		Include:           []interface{}{map[string]interface{}{"email_domain": map[string]interface{}{"domain": "example.com"}}},
+		SessionDuration:   "24h",
+		IsolationRequired: boolPtrAccess(false),
+		ApprovalGroups: []AccessApprovalGroupParams{
+			{EmailListUUID: "list-1", ApprovalsNeeded: 2},
+			{EmailAddresses: []string{"b@example.com", "a@example.com"}, ApprovalsNeeded: 1},
+		},
+	}
+	assert.True(t, ReusableAccessPolicyInSync(params, current), "order and server defaults are not drift")
+
+	withDuration := params
+	withDuration.SessionDuration = strPtr("30m")
+	assert.False(t, ReusableAccessPolicyInSync(withDuration, current))
+
+	isolated := params
+	isolated.IsolationRequired = boolPtrAccess(true)
+	assert.False(t, ReusableAccessPolicyInSync(isolated, current))
+
+	denied := params
+	denied.Decision = "deny"
+	assert.False(t, ReusableAccessPolicyInSync(denied, current))
+
+	fewerApprovers := params
+	fewerApprovers.ApprovalGroups = params.ApprovalGroups[:1]
+	assert.False(t, ReusableAccessPolicyInSync(fewerApprovers, current))
+}
+
+func TestConvertRulesToSDK_MultipleValues(t *testing.T) {
+	rules := []AccessGroupRuleParams{
+		{IPRanges: &AccessGroupIPRangesRuleParams{IP: []string{"192.168.1.0/24", "10.0.0.0/8", "2001:db8::/32"}}},
+		{Country: &AccessGroupCountryRuleParams{Country: []string{"US", "CA"}}},
+		{Email: &AccessGroupEmailRuleParams{Email: "admin@example.com"}},
+	}
+
+	result := ConvertRulesToSDK(rules)
+
+	var ips, countries []string
+	for _, entry := range result {
+		rule := entry.(map[string]interface{})
+		if ip, ok := rule["ip"]; ok {
+			ips = append(ips, ip.(map[string]string)["ip"])
+		}
+		if geo, ok := rule["geo"]; ok {
+			countries = append(countries, geo.(map[string]string)["country_code"])
+		}
+	}
+	assert.Len(t, result, 6)
+	assert.Equal(t, []string{"192.168.1.0/24", "10.0.0.0/8", "2001:db8::/32"}, ips)
+	assert.Equal(t, []string{"US", "CA"}, countries)
+}
+
+func TestAccessGroupRulesMatch_MultipleValues(t *testing.T) {
+	desired := []AccessGroupRuleParams{
+		{IPRanges: &AccessGroupIPRangesRuleParams{IP: []string{"10.0.0.0/8", "192.168.0.0/16"}}},
+	}
+	// Cloudflare returns the expanded single-value rules
+	current := []interface{}{
+		map[string]interface{}{"ip": map[string]interface{}{"ip": "192.168.0.0/16"}},
+		map[string]interface{}{"ip": map[string]interface{}{"ip": "10.0.0.0/8"}},
+	}
+
+	assert.True(t, AccessGroupRulesMatch(desired, current))
+	assert.False(t, AccessGroupRulesMatch(desired, current[:1]))
+}
+
+func TestAccessIdentityProviderInSync(t *testing.T) {
+	oidc := AccessIdentityProviderParams{
+		Name: "corp-oidc",
+		Type: "oidc",
+		Config: cloudflare.AccessIdentityProviderConfiguration{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			AuthURL:      "https://idp.example.com/authorize",
+			TokenURL:     "https://idp.example.com/token",
+			CertsURL:     "https://idp.example.com/jwks",
+			Scopes:       []string{"openid", "email"},
+		},
+	}
+	// Cloudflare redacts the secret and fills in the redirect URL
+	oidcRemote := oidc.Config
+	oidcRemote.ClientSecret = "**********"
+	oidcRemote.RedirectURL = "https://team.cloudflareaccess.com/cdn-cgi/access/callback"
+	oidcRemote.Scopes = []string{"email", "openid"}
+
+	saml := AccessIdentityProviderParams{
+		Name: "corp-saml",
+		Type: "saml",
+		Config: cloudflare.AccessIdentityProviderConfiguration{
+			IssuerURL:     "https://idp.example.com/saml",
+			SsoTargetURL:  "https://idp.example.com/sso",
+			IdpPublicCert: "MIIC...",
+			Attributes:    []string{"group"},
+			SignRequest:   true,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		params  AccessIdentityProviderParams
+		current cloudflare.AccessIdentityProviderConfiguration
+		want    bool
+	}{
+		{name: "oidc in sync", params: oidc, current: oidcRemote, want: true},
+		{
+			name:   "oidc token url changed",
+			params: oidc,
+			current: func() cloudflare.AccessIdentityProviderConfiguration {
+				c := oidcRemote
+				c.TokenURL = "https://other.example.com/token"
+				return c
+			}(),
+		},
+		{
+			name:   "oidc scope removed",
+			params: oidc,
+			current: func() cloudflare.AccessIdentityProviderConfiguration {
+				c := oidcRemote
+				c.Scopes = []string{"openid"}
+				return c
+			}(),
+		},
+		{
+			name:   "saml certificate whitespace",
+			params: saml,
+			current: func() cloudflare.AccessIdentityProviderConfiguration {
+				c := saml.Config
+				c.IdpPublicCert = "MIIC...\n"
+				return c
+			}(),
+			want: true,
+		},
+		{
+			name:   "saml sign request disabled",
+			params: saml,
+			current: func() cloudflare.AccessIdentityProviderConfiguration {
+				c := saml.Config
+				c.SignRequest = false
+				return c
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := &AccessIdentityProviderResult{Name: tt.params.Name, Type: tt.params.Type, Config: tt.current}
+			assert.Equal(t, tt.want, AccessIdentityProviderInSync(tt.params, current, false))
+		})
+	}
+}
+
+func TestAccessIdentityProviderInSync_SCIM(t *testing.T) {
+	params := AccessIdentityProviderParams{
+		Name:       "corp-saml",
+		Type:       "saml",
+		ScimConfig: cloudflare.AccessIdentityProviderScimConfiguration{Enabled: true, UserDeprovision: true},
+	}
+	current := &AccessIdentityProviderResult{
+		Name:       "corp-saml",
+		Type:       "saml",
+		ScimConfig: cloudflare.AccessIdentityProviderScimConfiguration{Enabled: true, UserDeprovision: true, Secret: "*****"},
+	}
+	assert.True(t, AccessIdentityProviderInSync(params, current, true))
+
+	// Defaults filled in by Cloudflare and the missing secret are not drift
+	params.ScimConfig.Secret = "generated"
+	current.ScimConfig.Secret = ""
+	current.ScimConfig.SeatDeprovision = true
+	current.ScimConfig.IdentityUpdateBehavior = "no_action"
+	assert.True(t, AccessIdentityProviderInSync(params, current, true))
+
+	params.ScimConfig.IdentityUpdateBehavior = "reauth"
+	assert.False(t, AccessIdentityProviderInSync(params, current, true))
+	params.ScimConfig.IdentityUpdateBehavior = ""
+
+	current.ScimConfig.UserDeprovision = false
+	assert.False(t, AccessIdentityProviderInSync(params, current, true))
+	assert.True(t, AccessIdentityProviderInSync(params, current, false))
+}
+
+func TestCreateAccessApplication_Bookmark(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+
+	app, err := api.CreateAccessApplication(context.Background(), AccessApplicationParams{
+		Name:                   "wiki",
+		Domain:                 "https://wiki.example.com",
+		Type:                   AccessAppTypeBookmark,
+		SessionDuration:        "24h",
+		AutoRedirectToIdentity: boolPtrAccess(false),
+		AppLauncherVisible:     boolPtrAccess(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, AccessAppTypeBookmark, app.Type)
+	assert.Equal(t, "https://wiki.example.com", app.Domain)
+
+	stored, ok := mock.Store().GetAccessApplication(app.ID)
+	require.True(t, ok)
+	assert.True(t, stored.AppLauncherVisible)
+	assert.Empty(t, stored.SessionDuration, "session duration does not apply to bookmarks")
+	assert.Empty(t, stored.Destinations, "bookmarks link to the domain without destinations")
+}
+
+func TestCreateAccessApplication_AppLauncher(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+
+	app, err := api.CreateAccessApplication(context.Background(), AccessApplicationParams{
+		Name:                    "App Launcher",
+		Type:                    AccessAppTypeAppLauncher,
+		SessionDuration:         "12h",
+		AllowedIdps:             []string{"idp-1"},
+		AutoRedirectToIdentity:  boolPtrAccess(true),
+		SameSiteCookieAttribute: "lax",
+		CustomDenyURL:           "https://example.com/denied",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, AccessAppTypeAppLauncher, app.Type)
+	assert.Equal(t, "12h", app.SessionDuration)
+	assert.Equal(t, []string{"idp-1"}, app.AllowedIdps)
+	assert.True(t, app.AutoRedirectToIdentity)
+
+	stored, ok := mock.Store().GetAccessApplication(app.ID)
+	require.True(t, ok)
+	assert.Empty(t, stored.SameSiteCookieAttribute, "cookie settings do not apply to the App Launcher")
+	assert.Empty(t, stored.CustomDenyURL)
+}
+
+func TestValidateAccessApplicationType(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  AccessApplicationParams
+		wantErr string
+	}{
+		{
+			name:   "bookmark with URL",
+			params: AccessApplicationParams{Name: "wiki", Type: AccessAppTypeBookmark, Domain: "https://wiki.example.com"},
+		},
+		{
+			name:    "bookmark without URL",
+			params:  AccessApplicationParams{Name: "wiki", Type: AccessAppTypeBookmark},
+			wantErr: "requires a domain",
+		},
+		{
+			name: "bookmark with policies",
+			params: AccessApplicationParams{
+				Name: "wiki", Type: AccessAppTypeBookmark, Domain: "https://wiki.example.com", Policies: []string{"policy-1"},
+			},
+			wantErr: "cannot have policies",
+		},
+		{
+			name: "bookmark with destinations",
+			params: AccessApplicationParams{
+				Name: "wiki", Type: AccessAppTypeBookmark, Domain: "https://wiki.example.com",
+				SelfHostedDomains: []string{"wiki.example.com"},
+			},
+			wantErr: "cannot have domains or destinations",
+		},
+		{
+			name: "bookmark with SaaS settings",
+			params: AccessApplicationParams{
+				Name: "wiki", Type: AccessAppTypeBookmark, Domain: "https://wiki.example.com",
+				SaasApp: &SaasApplicationParams{AuthType: "oidc"},
+			},
+			wantErr: "cannot have SaaS or SCIM settings",
+		},
+		{
+			name:   "app launcher with policies",
+			params: AccessApplicationParams{Name: "launcher", Type: AccessAppTypeAppLauncher, Policies: []string{"policy-1"}},
+		},
+		{
+			name:    "app launcher with domain",
+			params:  AccessApplicationParams{Name: "launcher", Type: AccessAppTypeAppLauncher, Domain: "launcher.example.com"},
+			wantErr: "cannot have a domain or destinations",
+		},
+		{
+			name: "dash sso with destinations",
+			params: AccessApplicationParams{
+				Name: "dashboard", Type: AccessAppTypeDashSSO,
+				Destinations: []AccessDestinationParams{{Type: "public", URI: "dash.example.com"}},
+			},
+			wantErr: "cannot have a domain or destinations",
+		},
+		{
+			name:   "self hosted is not restricted",
+			params: AccessApplicationParams{Name: "app", Type: "self_hosted", Domain: "app.example.com", Policies: []string{"p"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAccessApplicationType(tt.params)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidConfiguration)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCreateAccessApplication_RejectsInvalidBookmark(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+
+	_, err := api.CreateAccessApplication(context.Background(), AccessApplicationParams{
+		Name: "wiki", Type: AccessAppTypeBookmark, Domain: "https://wiki.example.com", Policies: []string{"policy-1"},
+	})
+	require.Error(t, err)
+	assert.True(t, IsValidationError(err))
+	assert.Empty(t, mock.Store().ListAccessApplications(), "invalid applications are not sent to Cloudflare")
+}
+
+func TestGetAccessApplicationByAUD(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"app-a", "app-b"} {
+		_, err := api.CreateAccessApplication(ctx, AccessApplicationParams{
+			Name: name, Domain: name + ".example.com", Type: "self_hosted",
+		})
+		require.NoError(t, err)
+	}
+	appB, err := api.ListAccessApplicationsByName(ctx, "app-b")
+	require.NoError(t, err)
+	require.NotEmpty(t, appB.AUD)
+
+	found, err := api.GetAccessApplicationByAUD(ctx, appB.AUD)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, appB.ID, found.ID)
+	assert.Equal(t, "app-b", found.Name)
+
+	missing, err := api.GetAccessApplicationByAUD(ctx, "unknown-aud")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestValidateSessionDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "unset", value: ""},
+		{name: "hours", value: "24h"},
+		{name: "minutes", value: "30m"},
+		{name: "compound", value: "2h45m"},
+		{name: "always prompt", value: "0s"},
+		{name: "maximum", value: "730h"},
+		{name: "sub-second units", value: "1500ms"},
+		{name: "word", value: "forever", wantErr: "not a duration"},
+		{name: "no unit", value: "30", wantErr: "not a duration"},
+		{name: "bare zero", value: "0", wantErr: "not a duration"},
+		{name: "days", value: "7d", wantErr: "not a duration"},
+		{name: "negative", value: "-1h", wantErr: "must not be negative"},
+		{name: "too long", value: "731h", wantErr: "exceeds the maximum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSessionDuration(tt.value)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidConfiguration)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Contains(t, err.Error(), tt.value)
+		})
+	}
+}
+
+func TestCreateAccessApplication_RejectsInvalidSessionDuration(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+
+	_, err := api.CreateAccessApplication(context.Background(), AccessApplicationParams{
+		Name: "app", Domain: "app.example.com", Type: "self_hosted", SessionDuration: "forever",
+	})
+	require.Error(t, err)
+	assert.True(t, IsValidationError(err))
+	assert.Empty(t, mock.Store().ListAccessApplications(), "invalid applications are not sent to Cloudflare")
+
+	_, err = api.CreateReusableAccessPolicy(context.Background(), ReusableAccessPolicyParams{
+		Name: "policy", Decision: "allow", SessionDuration: strPtr("1y"),
+	})
+	assert.ErrorIs(t, err, ErrInvalidConfiguration)
+}
+
+func TestValidateRequireRules(t *testing.T) {
+	assert.NoError(t, validateRequireRules([]AccessGroupRuleParams{
+		{IPRanges: &AccessGroupIPRangesRuleParams{IP: []string{"10.0.0.0/8"}}},
+		{Country: &AccessGroupCountryRuleParams{Country: []string{"US"}}},
+	}))
+
+	err := validateRequireRules([]AccessGroupRuleParams{
+		{IPRanges: &AccessGroupIPRangesRuleParams{IP: []string{"10.0.0.0/8", "192.168.0.0/16"}}},
+	})
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+
+	err = validateRequireRules([]AccessGroupRuleParams{
+		{Country: &AccessGroupCountryRuleParams{Country: []string{"US", "CA"}}},
+	})
+	require.ErrorIs(t, err, ErrInvalidConfiguration)
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAccountTestAPI serves the account list and lookups for the given account IDs.
+func newAccountTestAPI(t *testing.T, accountIDs ...string) *API {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts", func(rw http.ResponseWriter, _ *http.Request) {
+		items := make([]string, 0, len(accountIDs))
+		for _, id := range accountIDs {
+			items = append(items, fmt.Sprintf(`{"id":%q,"name":"Account %s"}`, id, id))
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(rw, `{"success":true,"errors":[],"messages":[],"result":[%s],`+
+			`"result_info":{"page":1,"per_page":20,"count":%d,"total_count":%d}}`,
+			strings.Join(items, ","), len(accountIDs), len(accountIDs))
+	})
+	mux.HandleFunc("/accounts/", func(rw http.ResponseWriter, req *http.Request) {
+		id := strings.TrimPrefix(req.URL.Path, "/accounts/")
+		rw.Header().Set("Content-Type", "application/json")
+		for _, accountID := range accountIDs {
+			if accountID == id {
+				_, _ = fmt.Fprintf(rw, `{"success":true,"errors":[],"messages":[],"result":{"id":%q,"name":"Account %s"}}`,
+					id, id)
+				return
+			}
+		}
+		rw.WriteHeader(http.StatusForbidden)
+		_, _ = rw.Write([]byte(`{"success":false,"errors":[{"code":9109,"message":"Unauthorized to access requested resource"}],"messages":[],"result":null}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+	return &API{Log: logr.Discard(), CloudflareClient: client}
+}
+
+func TestGetAccountId(t *testing.T) {
+	tests := []struct {
+		name        string
+		accounts    []string
+		accountID   string
+		accountName string
+		want        string
+		wantErr     error
+	}{
+		{
+			name:      "pinned account",
+			accounts:  []string{"account-a", "account-b"},
+			accountID: "account-b",
+			want:      "account-b",
+		},
+		{
+			name:      "pinned account not accessible",
+			accounts:  []string{"account-a"},
+			accountID: "account-b",
+			wantErr:   ErrAccountNotAccessible,
+		},
+		{
+			name:        "pinned account falls back to name",
+			accounts:    []string{"account-a"},
+			accountID:   "account-b",
+			accountName: "Account account-a",
+			want:        "account-a",
+		},
+		{
+			name:     "single account detected",
+			accounts: []string{"account-a"},
+			want:     "account-a",
+		},
+		{
+			name:     "multiple accounts",
+			accounts: []string{"account-a", "account-b"},
+			wantErr:  ErrMultipleAccounts,
+		},
+		{
+			name:    "no accounts",
+			wantErr: ErrAccountNotAccessible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newAccountTestAPI(t, tt.accounts...)
+			api.AccountId = tt.accountID
+			api.AccountName = tt.accountName
+
+			got, err := api.GetAccountId(context.Background())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, api.ValidAccountId)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, api.ValidAccountId)
+		})
+	}
+}
+
+func TestDetectAccountId_ListsAccessibleAccounts(t *testing.T) {
+	api := newAccountTestAPI(t, "account-a", "account-b")
+
+	_, err := api.DetectAccountId(context.Background())
+	require.ErrorIs(t, err, ErrMultipleAccounts)
+	assert.Contains(t, err.Error(), "account-a, account-b")
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -161,29 +162,108 @@ func (c *API) ValidateAll(ctx context.Context) error {
 	return nil
 }
 
-// GetAccountId gets AccountId from Account Name
+// GetAccountId returns the account to use.
+// A configured account ID pins the account and must be accessible with the credentials;
+// the account name is only used as a fallback when it is set explicitly. Without an
+// account ID or name, the account is auto-detected when the credentials can access
+// exactly one account.
+// The resolved ID is cached for DefaultIDCacheTTL and shared by clients with the
+// same credentials; RefreshIDs forces a new lookup.
 func (c *API) GetAccountId(ctx context.Context) (string, error) {
 	if c.ValidAccountId != "" {
 		return c.ValidAccountId, nil
 	}
 
-	if c.AccountId == "" && c.AccountName == "" {
-		err := fmt.Errorf("both account ID and Name cannot be empty")
-		c.Log.Error(err, "Both accountId and accountName cannot be empty")
+	key := c.idCacheKey(idKindAccount, c.AccountId, c.AccountName)
+	if id, ok := resolvedIDs.get(key); ok {
+		c.ValidAccountId = id
+		return c.ValidAccountId, nil
+	}
+
+	id, err := c.resolveAccountId(ctx)
+	if err != nil {
+		c.refreshIDsOnAuthError(err)
 		return "", err
 	}
+	resolvedIDs.set(key, id)
+	c.ValidAccountId = id
+	return c.ValidAccountId, nil
+}
 
-	if c.validateAccountId(ctx) {
-		c.ValidAccountId = c.AccountId
-	} else {
+// resolveAccountId looks up the account to use, as described on GetAccountId.
+func (c *API) resolveAccountId(ctx context.Context) (string, error) {
+	if c.AccountId != "" {
+		if c.validateAccountId(ctx) {
+			return c.AccountId, nil
+		}
+		if c.AccountName == "" {
+			return "", fmt.Errorf("%w: account %s", ErrAccountNotAccessible, c.AccountId)
+		}
 		c.Log.Info("Account ID failed, falling back to Account Name")
+	}
+
+	if c.AccountName != "" {
 		accountIdFromName, err := c.getAccountIdByName(ctx)
 		if err != nil {
-			return "", fmt.Errorf("error fetching Account ID by Account Name")
+			return "", fmt.Errorf("error fetching Account ID by Account Name %q: %w", c.AccountName, err)
 		}
-		c.ValidAccountId = accountIdFromName
+		return accountIdFromName, nil
 	}
-	return c.ValidAccountId, nil
+
+	return c.DetectAccountId(ctx)
+}
+
+// DetectAccountId returns the only account the credentials can access.
+// It fails with ErrMultipleAccounts when the credentials can access several accounts,
+// since picking one of them could act on the wrong account.
+func (c *API) DetectAccountId(ctx context.Context) (string, error) {
+	accounts, _, err := c.CloudflareClient.Accounts(ctx, cloudflare.AccountsListParams{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	switch len(accounts) {
+	case 0:
+		return "", fmt.Errorf("%w: the credentials cannot access any account", ErrAccountNotAccessible)
+	case 1:
+		return accounts[0].ID, nil
+	default:
+		ids := make([]string, 0, len(accounts))
+		for _, account := range accounts {
+			ids = append(ids, account.ID)
+		}
+		return "", fmt.Errorf("%w: the credentials can access %d accounts (%s), set accountId to select one",
+			ErrMultipleAccounts, len(accounts), strings.Join(ids, ", "))
+	}
+}
+
+// VerifyToken verifies the API token and returns its status and validity window.
+// Only applicable when the client authenticates with an API token.
+func (c *API) VerifyToken(ctx context.Context) (cloudflare.APITokenVerifyBody, error) {
+	return c.CloudflareClient.VerifyAPIToken(ctx)
+}
+
+// GetAccount returns the details of the configured account.
+// It fails when the account does not exist or the credentials have no access to it.
+func (c *API) GetAccount(ctx context.Context) (cloudflare.Account, error) {
+	if c.AccountId == "" {
+		return cloudflare.Account{}, fmt.Errorf("account ID is empty")
+	}
+	account, _, err := c.CloudflareClient.Account(ctx, c.AccountId)
+	return account, err
+}
+
+// Ping checks that the Cloudflare API can be reached with the client's credentials.
+// Unlike GetAccountId, it always calls the API and never uses cached IDs.
+func (c *API) Ping(ctx context.Context) error {
+	if c.AccountId != "" {
+		_, err := c.GetAccount(ctx)
+		return err
+	}
+	_, _, err := c.CloudflareClient.Accounts(ctx, cloudflare.AccountsListParams{
+		PaginationOptions: cloudflare.PaginationOptions{PerPage: 1},
+	})
+	return err
 }
 
 func (c *API) validateAccountId(ctx context.Context) bool {
@@ -210,6 +290,7 @@ func (c *API) getAccountIdByName(ctx context.Context) (string, error) {
 
 	if err != nil {
 		c.Log.Error(err, "error listing accounts", "accountName", c.AccountName)
+		return "", err
 	}
 
 	switch len(accounts) {
@@ -330,7 +411,8 @@ func (c *API) GetTunnelCreds(ctx context.Context, tunnelSecret string) (string,
 	return string(creds), err
 }
 
-// GetZoneId gets Zone Id from DNS domain
+// GetZoneId gets Zone Id from DNS domain.
+// Like account IDs, zone IDs are cached per credentials for DefaultIDCacheTTL.
 func (c *API) GetZoneId(ctx context.Context) (string, error) {
 	if c.ValidZoneId != "" {
 		return c.ValidZoneId, nil
@@ -342,10 +424,18 @@ func (c *API) GetZoneId(ctx context.Context) (string, error) {
 		return "", err
 	}
 
+	key := c.idCacheKey(idKindZone, c.Domain)
+	if id, ok := resolvedIDs.get(key); ok {
+		c.ValidZoneId = id
+		return c.ValidZoneId, nil
+	}
+
 	zoneIdFromName, err := c.getZoneIdByName(ctx)
 	if err != nil {
+		c.refreshIDsOnAuthError(err)
 		return "", fmt.Errorf("error fetching Zone ID by Zone Name")
 	}
+	resolvedIDs.set(key, zoneIdFromName)
 	c.ValidZoneId = zoneIdFromName
 	return c.ValidZoneId, nil
 }
@@ -435,7 +525,7 @@ func (c *API) InsertOrUpdateCName(ctx context.Context, fqdn, dnsId string) (stri
 			ID:      dnsId,
 			Type:    "CNAME",
 			Name:    fqdn,
-			Content: fmt.Sprintf("%s.cfargotunnel.com", c.ValidTunnelId),
+			Content: c.TunnelCNameTarget(),
 			Comment: ptr.To("Managed by cloudflare-operator"),
 			TTL:     1,            // Automatic TTL
 			Proxied: ptr.To(true), // For Cloudflare tunnels
@@ -452,7 +542,7 @@ func (c *API) InsertOrUpdateCName(ctx context.Context, fqdn, dnsId string) (stri
 		createParams := cloudflare.CreateDNSRecordParams{
 			Type:    "CNAME",
 			Name:    fqdn,
-			Content: fmt.Sprintf("%s.cfargotunnel.com", c.ValidTunnelId),
+			Content: c.TunnelCNameTarget(),
 			Comment: "Managed by cloudflare-operator",
 			TTL:     1,            // Automatic TTL
 			Proxied: ptr.To(true), // For Cloudflare tunnels
@@ -569,7 +659,15 @@ func (c *API) GetManagedDnsTxt(ctx context.Context, fqdn string) (string, DnsMan
 // GetTunnelToken retrieves the token for a tunnel from Cloudflare API.
 // The token is used to start cloudflared in remotely-managed mode with --token flag.
 // This allows cloudflared to automatically pull configuration from Cloudflare cloud.
+//
+// Unlike the tunnel credentials (the credentials.json secret), which Cloudflare returns
+// only once when the tunnel is created, the token can be fetched again at any time.
+// It is therefore safe to use for recovering a lost token Secret, but it can never be
+// used to recover lost credentials.
 func (c *API) GetTunnelToken(ctx context.Context, tunnelID string) (string, error) {
+	if tunnelID == "" {
+		return "", errors.New("tunnel ID is required to fetch the tunnel token")
+	}
 	if _, err := c.GetAccountId(ctx); err != nil {
 		c.Log.Error(err, "error in getting account ID")
 		return "", err
@@ -787,3 +885,51 @@ func (c *API) GetTunnelCredsByID(ctx context.Context, tunnelID string) (*TunnelC
 		// Note: TunnelSecret is not available for existing tunnels
 	}, nil
 }
+
+// Tunnel health states reported by Cloudflare.
+const (
+	TunnelStatusHealthy  = "healthy"
+	TunnelStatusDegraded = "degraded"
+	TunnelStatusInactive = "inactive"
+	TunnelStatusDown     = "down"
+)
+
+// TunnelHealthResult contains the connection health of a tunnel.
+type TunnelHealthResult struct {
+	ID     string
+	Name   string
+	Status string
+	// Connections is the number of active connections to the Cloudflare edge.
+	Connections int
+	// Deleted is true when the tunnel has been deleted.
+	Deleted bool
+}
+
+// Connected reports whether the tunnel has at least one active connection.
+func (t *TunnelHealthResult) Connected() bool {
+	return !t.Deleted && (t.Status == TunnelStatusHealthy || t.Status == TunnelStatusDegraded)
+}
+
+// GetTunnelHealth retrieves the connection health of a tunnel by ID.
+func (c *API) GetTunnelHealth(ctx context.Context, tunnelID string) (*TunnelHealthResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
+
+	tunnel, err := c.CloudflareClient.GetTunnel(ctx, rc, tunnelID)
+	if err != nil {
+		c.Log.Error(err, "error getting tunnel health", "tunnelId", tunnelID)
+		return nil, err
+	}
+
+	return &TunnelHealthResult{
+		ID:          tunnel.ID,
+		Name:        tunnel.Name,
+		Status:      tunnel.Status,
+		Connections: len(tunnel.Connections),
+		Deleted:     tunnel.DeletedAt != nil,
+	}, nil
+}
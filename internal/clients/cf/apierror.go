@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Cloudflare API error codes the operator inspects.
+const (
+	// CodeAuthenticationError is returned for missing or invalid credentials.
+	CodeAuthenticationError = 10000
+	// CodeUnauthorized is returned when the credentials cannot access the resource.
+	CodeUnauthorized = 9109
+	// CodeInvalidObjectIdentifier is returned when a resource ID in the path does not exist.
+	CodeInvalidObjectIdentifier = 7003
+)
+
+// CloudflareErrorDetail is one entry of the errors array of a Cloudflare API response.
+type CloudflareErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// CloudflareAPIError is an error response of the Cloudflare API with the structured
+// codes and messages of its errors array. Use AsCloudflareAPIError to get it from an
+// error returned by any cf method.
+type CloudflareAPIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	Errors     []CloudflareErrorDetail
+	// RayID identifies the request for Cloudflare support.
+	RayID string
+}
+
+func (e *CloudflareAPIError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for _, detail := range e.Errors {
+		if detail.Code != 0 {
+			parts = append(parts, fmt.Sprintf("%s (%d)", detail.Message, detail.Code))
+		} else {
+			parts = append(parts, detail.Message)
+		}
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("Cloudflare API error: status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Cloudflare API error: status %d: %s", e.StatusCode, strings.Join(parts, ", "))
+}
+
+// Code returns the first non-zero error code, or 0 if the response had none.
+func (e *CloudflareAPIError) Code() int {
+	for _, detail := range e.Errors {
+		if detail.Code != 0 {
+			return detail.Code
+		}
+	}
+	return 0
+}
+
+// HasCode reports whether the response contained the error code.
+func (e *CloudflareAPIError) HasCode(code int) bool {
+	return slices.ContainsFunc(e.Errors, func(detail CloudflareErrorDetail) bool { return detail.Code == code })
+}
+
+// AsCloudflareAPIError returns the Cloudflare API error response in err's chain.
+// It recognizes both the errors returned by cloudflare-go and the responses of
+// endpoints the operator calls directly.
+func AsCloudflareAPIError(err error) (*CloudflareAPIError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var apiErr *CloudflareAPIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+
+	var sdkErr *cloudflare.Error
+	if errors.As(err, &sdkErr) {
+		apiErr = &CloudflareAPIError{StatusCode: sdkErr.StatusCode, RayID: sdkErr.RayID}
+		for _, info := range sdkErr.Errors {
+			apiErr.Errors = append(apiErr.Errors, CloudflareErrorDetail{Code: info.Code, Message: info.Message})
+		}
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// ErrorCode returns the Cloudflare error code of err, or 0 if err is not a
+// Cloudflare API error response or carries no code.
+func ErrorCode(err error) int {
+	if apiErr, ok := AsCloudflareAPIError(err); ok {
+		return apiErr.Code()
+	}
+	return 0
+}
+
+// parseAPIErrorResponse builds the error of a failed response from an endpoint
+// called without cloudflare-go. The errors array is used when the body has one;
+// otherwise the body is kept as the message.
+func parseAPIErrorResponse(resp *http.Response, body []byte) error {
+	apiErr := &CloudflareAPIError{StatusCode: resp.StatusCode, RayID: resp.Header.Get("cf-ray")}
+
+	var envelope struct {
+		Errors []CloudflareErrorDetail `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && len(envelope.Errors) > 0 {
+		apiErr.Errors = envelope.Errors
+	} else if msg := strings.TrimSpace(string(body)); msg != "" {
+		apiErr.Errors = []CloudflareErrorDetail{{Message: msg}}
+	}
+	return apiErr
+}
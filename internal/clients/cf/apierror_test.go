@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newErrorResponseAPI returns a client for a server that answers every request
+// with status and body.
+func newErrorResponseAPI(t *testing.T, status int, body string) *API {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("cf-ray", "ray-123")
+		rw.WriteHeader(status)
+		_, _ = fmt.Fprint(rw, body)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+	return &API{Log: logr.Discard(), CloudflareClient: client, ValidAccountId: "test-account-id"}
+}
+
+func TestAsCloudflareAPIError_FromSDKResponse(t *testing.T) {
+	api := newErrorResponseAPI(t, http.StatusForbidden,
+		`{"success":false,"errors":[{"code":10000,"message":"Authentication error"}],"messages":[],"result":null}`)
+
+	_, err := api.GetAccessGroup(context.Background(), "group-1")
+	require.Error(t, err)
+
+	apiErr, ok := AsCloudflareAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, CodeAuthenticationError, apiErr.Code())
+	assert.True(t, apiErr.HasCode(CodeAuthenticationError))
+	assert.Equal(t, "Authentication error", apiErr.Errors[0].Message)
+	assert.Equal(t, CodeAuthenticationError, ErrorCode(fmt.Errorf("get group: %w", err)), "found through wrapping")
+	assert.True(t, IsAuthError(err))
+}
+
+func TestAsCloudflareAPIError_FromRawResponse(t *testing.T) {
+	api := newErrorResponseAPI(t, http.StatusNotFound,
+		`{"success":false,"errors":[{"code":7003,"message":"Could not route to /accounts/x/access/service_tokens/t"}],`+
+			`"messages":[],"result":null}`)
+
+	_, err := api.getAccessServiceToken(context.Background(), "token-1")
+	require.Error(t, err)
+
+	assert.Equal(t, CodeInvalidObjectIdentifier, ErrorCode(err))
+	assert.True(t, IsNotFoundError(err))
+}
+
+func TestParseAPIErrorResponse(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Cf-Ray": []string{"ray-1"}}}
+
+	err := parseAPIErrorResponse(resp, []byte(`{"success":false,"errors":[{"code":971,"message":"Please wait"}]}`))
+	apiErr, ok := AsCloudflareAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, 971, apiErr.Code())
+	assert.Equal(t, "ray-1", apiErr.RayID)
+	assert.Equal(t, "Cloudflare API error: status 429: Please wait (971)", err.Error())
+	assert.True(t, IsRateLimitError(err))
+
+	err = parseAPIErrorResponse(&http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}, []byte("bad gateway"))
+	assert.Zero(t, ErrorCode(err))
+	assert.Equal(t, "Cloudflare API error: status 502: bad gateway", err.Error())
+	assert.True(t, IsTemporaryError(err))
+}
+
+func TestAsCloudflareAPIError_OtherErrors(t *testing.T) {
+	_, ok := AsCloudflareAPIError(errors.New("connection refused"))
+	assert.False(t, ok)
+	_, ok = AsCloudflareAPIError(nil)
+	assert.False(t, ok)
+	assert.Zero(t, ErrorCode(ErrInvalidConfiguration))
+}
+
+func TestSanitizeErrorMessage_KeepsErrorCode(t *testing.T) {
+	err := &CloudflareAPIError{
+		StatusCode: http.StatusBadRequest,
+		Errors:     []CloudflareErrorDetail{{Code: 1000, Message: "Invalid API Token"}},
+	}
+
+	assert.Equal(t, "operation failed - check operator logs for details (Cloudflare error code 1000)", SanitizeErrorMessage(err))
+}
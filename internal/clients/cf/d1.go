@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// D1DatabaseParams contains parameters for creating a D1 database
+type D1DatabaseParams struct {
+	Name                string `json:"name"`
+	PrimaryLocationHint string `json:"primary_location_hint,omitempty"`
+}
+
+// D1DatabaseResult contains the result of a D1 database operation
+type D1DatabaseResult struct {
+	ID        string
+	Name      string
+	Version   string
+	NumTables int
+	FileSize  int64
+	CreatedAt time.Time
+}
+
+func d1DatabaseResult(db cloudflare.D1Database) *D1DatabaseResult {
+	result := &D1DatabaseResult{
+		ID:        db.UUID,
+		Name:      db.Name,
+		Version:   db.Version,
+		NumTables: db.NumTables,
+		FileSize:  db.FileSize,
+	}
+	if db.CreatedAt != nil {
+		result.CreatedAt = *db.CreatedAt
+	}
+	return result
+}
+
+// CreateD1Database creates a new D1 database
+func (api *API) CreateD1Database(ctx context.Context, params D1DatabaseParams) (*D1DatabaseResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	// The create parameters of cloudflare-go have no primary location hint
+	endpoint := fmt.Sprintf("/accounts/%s/d1/database", accountID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodPost, endpoint, params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create D1 database: %w", err)
+	}
+
+	var db cloudflare.D1Database
+	if err := json.Unmarshal(resp.Result, &db); err != nil {
+		return nil, fmt.Errorf("failed to parse D1 database response: %w", err)
+	}
+
+	return d1DatabaseResult(db), nil
+}
+
+// GetD1Database retrieves a D1 database by ID
+func (api *API) GetD1Database(ctx context.Context, databaseID string) (*D1DatabaseResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	db, err := api.CloudflareClient.GetD1Database(ctx, rc, databaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get D1 database: %w", err)
+	}
+
+	return d1DatabaseResult(db), nil
+}
+
+// ListD1Databases lists all D1 databases
+func (api *API) ListD1Databases(ctx context.Context) ([]D1DatabaseResult, error) {
+	return api.listD1Databases(ctx, "")
+}
+
+// GetD1DatabaseByName retrieves a D1 database by name
+func (api *API) GetD1DatabaseByName(ctx context.Context, name string) (*D1DatabaseResult, error) {
+	// The name filter of the API also matches other names containing name
+	databases, err := api.listD1Databases(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range databases {
+		if databases[i].Name == name {
+			return &databases[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: D1 database %s", ErrResourceNotFound, name)
+}
+
+func (api *API) listD1Databases(ctx context.Context, name string) ([]D1DatabaseResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	databases, _, err := api.CloudflareClient.ListD1Databases(ctx, rc, cloudflare.ListD1DatabasesParams{Name: name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list D1 databases: %w", err)
+	}
+
+	results := make([]D1DatabaseResult, len(databases))
+	for i, db := range databases {
+		results[i] = *d1DatabaseResult(db)
+	}
+
+	return results, nil
+}
+
+// DeleteD1Database deletes a D1 database.
+// This method is idempotent - returns nil if the database is already deleted.
+func (api *API) DeleteD1Database(ctx context.Context, databaseID string) error {
+	if api.CloudflareClient == nil {
+		return errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	if err := api.CloudflareClient.DeleteD1Database(ctx, rc, databaseID); err != nil {
+		if IsNotFoundError(err) {
+			api.Log.Info("D1 database already deleted (not found)", "databaseId", databaseID)
+			return nil
+		}
+		return fmt.Errorf("failed to delete D1 database: %w", err)
+	}
+
+	api.Log.Info("D1 database deleted", "databaseId", databaseID)
+	return nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+)
+
+func newStorageTestAPI(t *testing.T) (*API, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	return &API{Log: logr.Discard(), CloudflareClient: client, ValidAccountId: "test-account-id"}, mock
+}
+
+func TestD1DatabaseCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateD1Database(ctx, D1DatabaseParams{Name: "app", PrimaryLocationHint: "weur"})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, "app", created.Name)
+	assert.False(t, created.CreatedAt.IsZero())
+	stored, ok := mock.Store().GetD1Database(created.ID)
+	require.True(t, ok)
+	assert.Equal(t, "weur", stored.PrimaryLocationHint)
+
+	got, err := api.GetD1Database(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, "app", got.Name)
+
+	databases, err := api.ListD1Databases(ctx)
+	require.NoError(t, err)
+	assert.Len(t, databases, 1)
+
+	require.NoError(t, api.DeleteD1Database(ctx, created.ID))
+	_, err = api.GetD1Database(ctx, created.ID)
+	assert.True(t, IsNotFoundError(err))
+
+	// Deletion is idempotent
+	assert.NoError(t, api.DeleteD1Database(ctx, created.ID))
+}
+
+func TestGetD1DatabaseByName(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	staging, err := api.CreateD1Database(ctx, D1DatabaseParams{Name: "app-staging"})
+	require.NoError(t, err)
+
+	// The API name filter also matches "app-staging", which must not be returned for "app"
+	_, err = api.GetD1DatabaseByName(ctx, "app")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+
+	app, err := api.CreateD1Database(ctx, D1DatabaseParams{Name: "app"})
+	require.NoError(t, err)
+
+	got, err := api.GetD1DatabaseByName(ctx, "app")
+	require.NoError(t, err)
+	assert.Equal(t, app.ID, got.ID)
+	got, err = api.GetD1DatabaseByName(ctx, "app-staging")
+	require.NoError(t, err)
+	assert.Equal(t, staging.ID, got.ID)
+}
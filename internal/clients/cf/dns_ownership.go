@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// DNSOwnership describes who manages the DNS record of a hostname.
+type DNSOwnership string
+
+const (
+	// DNSOwnershipNone means neither a record nor a managed TXT marker exists.
+	DNSOwnershipNone DNSOwnership = "None"
+	// DNSOwnershipOwned means the managed TXT marker points to our tunnel.
+	DNSOwnershipOwned DNSOwnership = "Owned"
+	// DNSOwnershipOtherTunnel means the managed TXT marker points to another tunnel.
+	DNSOwnershipOtherTunnel DNSOwnership = "OtherTunnel"
+	// DNSOwnershipUnmanaged means a record exists without a managed TXT marker.
+	DNSOwnershipUnmanaged DNSOwnership = "Unmanaged"
+)
+
+// ExistingDNSRecord is a summary of a DNS record found for a hostname.
+type ExistingDNSRecord struct {
+	ID      string
+	Type    string
+	Name    string
+	Content string
+	Proxied bool
+}
+
+// String describes the record, e.g. "CNAME app.example.com -> origin.example.net (proxied)".
+func (r *ExistingDNSRecord) String() string {
+	s := fmt.Sprintf("%s %s -> %s", r.Type, r.Name, r.Content)
+	if r.Proxied {
+		s += " (proxied)"
+	}
+	return s
+}
+
+// DNSOwnershipResult is the outcome of CheckDNSOwnership.
+type DNSOwnershipResult struct {
+	Ownership DNSOwnership
+	// TxtId is the ID of the managed TXT marker, empty if there is none.
+	TxtId string
+	// Marker is the content of the managed TXT marker.
+	Marker DnsManagedRecordTxt
+	// Existing is the CNAME record of the hostname, nil if there is none.
+	Existing *ExistingDNSRecord
+	// Conflicting are the records of other types at the hostname, such as A, AAAA
+	// or TXT. A CNAME cannot coexist with any of them, and the operator never
+	// creates them, so they are never managed by a tunnel.
+	Conflicting []*ExistingDNSRecord
+}
+
+// UnmanagedRecords returns the records at the hostname that are not managed by a
+// tunnel and would be overwritten by the tunnel CNAME: the CNAME record without a
+// managed TXT marker and all conflicting records.
+func (r *DNSOwnershipResult) UnmanagedRecords() []*ExistingDNSRecord {
+	var records []*ExistingDNSRecord
+	if r.Ownership == DNSOwnershipUnmanaged && r.Existing != nil {
+		records = append(records, r.Existing)
+	}
+	return append(records, r.Conflicting...)
+}
+
+// TunnelCNameTarget returns the CNAME target of the tunnel.
+func (c *API) TunnelCNameTarget() string {
+	return fmt.Sprintf("%s.cfargotunnel.com", c.ValidTunnelId)
+}
+
+// CheckDNSOwnership fetches the managed TXT marker and the records of the fqdn and
+// compares the marker with the tunnel of the client. Records of every type are
+// checked, since a CNAME conflicts with any other record of the same name.
+func (c *API) CheckDNSOwnership(ctx context.Context, fqdn string) (*DNSOwnershipResult, error) {
+	if _, err := c.GetZoneId(ctx); err != nil {
+		c.Log.Error(err, "error in getting Zone ID")
+		return nil, err
+	}
+
+	rc := cloudflare.ZoneIdentifier(c.ValidZoneId)
+	txtRecords, _, err := c.CloudflareClient.ListDNSRecords(ctx, rc, cloudflare.ListDNSRecordsParams{
+		Type: "TXT",
+		Name: fmt.Sprintf("%s%s", TXT_PREFIX, fqdn),
+	})
+	if err != nil {
+		c.Log.Error(err, "error listing DNS records, check fqdn", "fqdn", fqdn)
+		return nil, err
+	}
+	if len(txtRecords) > 1 {
+		err := fmt.Errorf("multiple TXT records found for %s: %w", fqdn, ErrMultipleResourcesFound)
+		c.Log.Error(err, "multiple TXT records returned for fqdn", "fqdn", fqdn, "count", len(txtRecords))
+		return nil, err
+	}
+
+	result := &DNSOwnershipResult{Ownership: DNSOwnershipNone}
+	if len(txtRecords) == 1 {
+		if err := json.Unmarshal([]byte(txtRecords[0].Content), &result.Marker); err != nil {
+			// TXT record exists, but not in JSON
+			c.Log.Error(err, "could not read managed TXT content", "fqdn", fqdn)
+			return nil, fmt.Errorf("managed TXT record for %s is not readable: %w", fqdn, err)
+		}
+		result.TxtId = txtRecords[0].ID
+		result.Ownership = DNSOwnershipOtherTunnel
+		if result.Marker.TunnelId == c.ValidTunnelId {
+			result.Ownership = DNSOwnershipOwned
+		}
+	}
+
+	records, _, err := c.CloudflareClient.ListDNSRecords(ctx, rc, cloudflare.ListDNSRecordsParams{
+		Name: fqdn,
+	})
+	if err != nil {
+		c.Log.Error(err, "error listing DNS records, check fqdn", "fqdn", fqdn)
+		return nil, err
+	}
+	for _, record := range records {
+		existing := &ExistingDNSRecord{
+			ID:      record.ID,
+			Type:    record.Type,
+			Name:    record.Name,
+			Content: record.Content,
+			Proxied: record.Proxied != nil && *record.Proxied,
+		}
+		if record.Type != "CNAME" {
+			result.Conflicting = append(result.Conflicting, existing)
+			continue
+		}
+		if result.Existing != nil {
+			err := fmt.Errorf("multiple CNAME records found for %s: %w", fqdn, ErrMultipleResourcesFound)
+			c.Log.Error(err, "multiple records returned for fqdn", "fqdn", fqdn)
+			return nil, err
+		}
+		result.Existing = existing
+	}
+	if result.Ownership == DNSOwnershipNone && len(records) > 0 {
+		result.Ownership = DNSOwnershipUnmanaged
+	}
+
+	return result, nil
+}
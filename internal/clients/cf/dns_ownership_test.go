@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newDNSOwnershipTestAPI(t *testing.T) (*API, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	return &API{
+		Log:              logr.Discard(),
+		CloudflareClient: client,
+		Domain:           "example.com",
+		ValidTunnelId:    "tunnel-a",
+	}, mock
+}
+
+func addDNSRecord(mock *mockserver.Server, id, recordType, name, content string) {
+	mock.Store().CreateDNSRecord(&models.DNSRecord{
+		ID: id, ZoneID: "test-zone-id", ZoneName: "example.com",
+		Type: recordType, Name: name, Content: content, Proxied: ptr.To(recordType == "CNAME"),
+	})
+}
+
+func TestCheckDNSOwnership(t *testing.T) {
+	tests := []struct {
+		name            string
+		records         [][4]string
+		wantOwnership   DNSOwnership
+		wantTxtID       string
+		wantExisting    string
+		wantConflicting []string
+	}{
+		{
+			name:          "no records",
+			wantOwnership: DNSOwnershipNone,
+		},
+		{
+			name: "owned",
+			records: [][4]string{
+				{"cname-1", "CNAME", "app.example.com", "tunnel-a.cfargotunnel.com"},
+				{"txt-1", "TXT", "_managed.app.example.com", `{"DnsId":"cname-1","TunnelName":"a","TunnelId":"tunnel-a"}`},
+			},
+			wantOwnership: DNSOwnershipOwned,
+			wantTxtID:     "txt-1",
+			wantExisting:  "CNAME app.example.com -> tunnel-a.cfargotunnel.com (proxied)",
+		},
+		{
+			name: "owned by another tunnel",
+			records: [][4]string{
+				{"cname-1", "CNAME", "app.example.com", "tunnel-b.cfargotunnel.com"},
+				{"txt-1", "TXT", "_managed.app.example.com", `{"DnsId":"cname-1","TunnelName":"b","TunnelId":"tunnel-b"}`},
+			},
+			wantOwnership: DNSOwnershipOtherTunnel,
+			wantTxtID:     "txt-1",
+			wantExisting:  "CNAME app.example.com -> tunnel-b.cfargotunnel.com (proxied)",
+		},
+		{
+			name: "unmanaged",
+			records: [][4]string{
+				{"cname-1", "CNAME", "app.example.com", "origin.example.net"},
+			},
+			wantOwnership: DNSOwnershipUnmanaged,
+			wantExisting:  "CNAME app.example.com -> origin.example.net (proxied)",
+		},
+		{
+			name: "unmanaged records of other types",
+			records: [][4]string{
+				{"a-1", "A", "app.example.com", "192.0.2.1"},
+				{"aaaa-1", "AAAA", "app.example.com", "2001:db8::1"},
+				{"txt-2", "TXT", "app.example.com", "v=spf1 -all"},
+			},
+			wantOwnership: DNSOwnershipUnmanaged,
+			wantConflicting: []string{
+				"A app.example.com -> 192.0.2.1",
+				"AAAA app.example.com -> 2001:db8::1",
+				"TXT app.example.com -> v=spf1 -all",
+			},
+		},
+		{
+			name: "owned marker with a record of another type",
+			records: [][4]string{
+				{"a-1", "A", "app.example.com", "192.0.2.1"},
+				{"txt-1", "TXT", "_managed.app.example.com", `{"DnsId":"cname-1","TunnelName":"a","TunnelId":"tunnel-a"}`},
+			},
+			wantOwnership:   DNSOwnershipOwned,
+			wantTxtID:       "txt-1",
+			wantConflicting: []string{"A app.example.com -> 192.0.2.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api, mock := newDNSOwnershipTestAPI(t)
+			for _, rec := range tt.records {
+				addDNSRecord(mock, rec[0], rec[1], rec[2], rec[3])
+			}
+
+			result, err := api.CheckDNSOwnership(context.Background(), "app.example.com")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOwnership, result.Ownership)
+			assert.Equal(t, tt.wantTxtID, result.TxtId)
+			conflicting := make([]string, 0, len(result.Conflicting))
+			for _, record := range result.Conflicting {
+				conflicting = append(conflicting, record.String())
+			}
+			assert.ElementsMatch(t, tt.wantConflicting, conflicting)
+			if tt.wantExisting == "" {
+				assert.Nil(t, result.Existing)
+				return
+			}
+			require.NotNil(t, result.Existing)
+			assert.Equal(t, "cname-1", result.Existing.ID)
+			assert.Equal(t, tt.wantExisting, result.Existing.String())
+		})
+	}
+}
+
+func TestCheckDNSOwnershipUnreadableMarker(t *testing.T) {
+	api, mock := newDNSOwnershipTestAPI(t)
+	addDNSRecord(mock, "txt-1", "TXT", "_managed.app.example.com", "not json")
+
+	_, err := api.CheckDNSOwnership(context.Background(), "app.example.com")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not readable")
+}
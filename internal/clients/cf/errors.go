@@ -6,6 +6,8 @@ package cf
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -78,6 +80,13 @@ var (
 
 	// ErrInvalidZoneID indicates zone ID is missing or invalid
 	ErrInvalidZoneID = errors.New("invalid or missing zone ID")
+
+	// ErrAccountNotAccessible indicates the credentials cannot access the account
+	ErrAccountNotAccessible = errors.New("account not accessible with the configured credentials")
+
+	// ErrMultipleAccounts indicates the account cannot be auto-detected because the
+	// credentials can access more than one account
+	ErrMultipleAccounts = errors.New("multiple accounts accessible")
 )
 
 // APIError wraps a Cloudflare API error with additional context
@@ -115,6 +124,9 @@ func IsNotFoundError(err error) bool {
 	if errors.Is(err, ErrResourceNotFound) {
 		return true
 	}
+	if apiErr, ok := AsCloudflareAPIError(err); ok && apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
 	// Check for common "not found" patterns in error messages
 	errStr := strings.ToLower(err.Error())
 	return strings.Contains(errStr, "not found") ||
@@ -169,6 +181,9 @@ func IsRateLimitError(err error) bool {
 	if errors.Is(err, ErrAPIRateLimited) {
 		return true
 	}
+	if apiErr, ok := AsCloudflareAPIError(err); ok && apiErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
 	errStr := strings.ToLower(err.Error())
 	return strings.Contains(errStr, "rate limit") ||
 		strings.Contains(errStr, "too many requests") ||
@@ -186,6 +201,9 @@ func IsTemporaryError(err error) bool {
 	if IsRateLimitError(err) {
 		return true
 	}
+	if apiErr, ok := AsCloudflareAPIError(err); ok && apiErr.StatusCode >= http.StatusInternalServerError {
+		return true
+	}
 	errStr := strings.ToLower(err.Error())
 	return strings.Contains(errStr, "timeout") ||
 		strings.Contains(errStr, "connection refused") ||
@@ -203,6 +221,11 @@ func IsAuthError(err error) bool {
 	if errors.Is(err, ErrAuthenticationFailed) || errors.Is(err, ErrPermissionDenied) {
 		return true
 	}
+	if apiErr, ok := AsCloudflareAPIError(err); ok &&
+		(apiErr.HasCode(CodeAuthenticationError) || apiErr.HasCode(CodeUnauthorized) ||
+			apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden) {
+		return true
+	}
 	errStr := strings.ToLower(err.Error())
 	return strings.Contains(errStr, "unauthorized") ||
 		strings.Contains(errStr, "authentication") ||
@@ -461,13 +484,59 @@ func IsAliasedDeploymentError(err error) bool {
 		strings.Contains(errStr, "deployment has aliases")
 }
 
+// redactedValue replaces credential values and account IDs in error messages.
+const redactedValue = "[REDACTED]"
+
+var (
+	// accountIDPattern matches the account ID in Cloudflare API paths.
+	accountIDPattern = regexp.MustCompile(`(/accounts/)[0-9a-fA-F]{32}`)
+	// jwtPattern matches JWTs and base64-encoded JSON such as tunnel tokens.
+	jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_=+/-]{16,}(\.[A-Za-z0-9_=+/-]+)*`)
+	// tokenCandidatePattern matches runs of characters an API token is made of.
+	tokenCandidatePattern = regexp.MustCompile(`[A-Za-z0-9_-]{32,}`)
+	// globalAPIKeyPattern matches a Cloudflare Global API Key.
+	globalAPIKeyPattern = regexp.MustCompile(`^[0-9a-f]{37}$`)
+	// hexDigestPattern matches lowercase hexadecimal digests such as git commit SHAs.
+	hexDigestPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// RedactSensitiveValues replaces values that look like credentials or account
+// IDs in msg, whether or not the message names them: Cloudflare API tokens
+// (40 characters), Global API Keys (37 hexadecimal characters), JWTs, tunnel
+// tokens and the account ID of API paths. The rest of the message is kept as is.
+func RedactSensitiveValues(msg string) string {
+	msg = accountIDPattern.ReplaceAllString(msg, "${1}"+redactedValue)
+	msg = jwtPattern.ReplaceAllString(msg, redactedValue)
+	return tokenCandidatePattern.ReplaceAllStringFunc(msg, func(s string) string {
+		if isAPITokenLike(s) || globalAPIKeyPattern.MatchString(s) {
+			return redactedValue
+		}
+		return s
+	})
+}
+
+// isAPITokenLike reports whether s has the shape of a Cloudflare API token:
+// 40 characters mixing letters and digits. Requiring both keeps long resource
+// names from being redacted, and lowercase hexadecimal digests such as git
+// commit SHAs are not tokens either.
+func isAPITokenLike(s string) bool {
+	if len(s) != 40 || hexDigestPattern.MatchString(s) {
+		return false
+	}
+	return strings.ContainsAny(s, "0123456789") &&
+		strings.ContainsAny(strings.ToLower(s), "abcdefghijklmnopqrstuvwxyz")
+}
+
 // SanitizeErrorMessage removes potentially sensitive information from error messages
-// before storing them in Status conditions
+// before storing them in Status conditions or Kubernetes events. Values that look
+// like credentials or account IDs are redacted, and messages mentioning tokens,
+// secrets or other credentials are replaced by a generic message that keeps the
+// Cloudflare error code.
 func SanitizeErrorMessage(err error) string {
 	if err == nil {
 		return ""
 	}
-	msg := err.Error()
+	msg := RedactSensitiveValues(err.Error())
 
 	// Truncate long error messages
 	const maxLen = 512
@@ -475,8 +544,12 @@ func SanitizeErrorMessage(err error) string {
 		msg = msg[:maxLen-3] + "..."
 	}
 
-	// Check for sensitive patterns and return generic message if found
+	// Check for sensitive patterns and return generic message if found,
+	// keeping the Cloudflare error code so the failure can still be looked up
 	if containsSensitivePattern(msg) {
+		if code := ErrorCode(err); code != 0 {
+			return fmt.Sprintf("%s (Cloudflare error code %d)", getGenericErrorMessage(err), code)
+		}
 		return getGenericErrorMessage(err)
 	}
 
@@ -686,6 +686,82 @@ func TestSanitizeErrorMessage(t *testing.T) {
 	}
 }
 
+func TestSanitizeErrorMessageRedactsValues(t *testing.T) {
+	const (
+		apiToken  = "Ab3dEfGh1jKlMn0pQrStUvWxYz0123456789_-Ab"
+		globalKey = "0123456789abcdef0123456789abcdef01234"
+		accountID = "0123456789abcdef0123456789abcdef"
+		tunnelTok = "eyJhIjoiYWNjb3VudCIsInQiOiJ0dW5uZWwiLCJzIjoic2VjcmV0In0="
+	)
+
+	tests := []struct {
+		name    string
+		err     error
+		want    string
+		wantNot []string
+	}{
+		{
+			name:    "API token without keyword",
+			err:     fmt.Errorf("request with %s rejected (10000)", apiToken),
+			want:    "request with [REDACTED] rejected (10000)",
+			wantNot: []string{apiToken},
+		},
+		{
+			name:    "Global API Key",
+			err:     fmt.Errorf("X-Auth-Key %s invalid", globalKey),
+			want:    "X-Auth-Key [REDACTED] invalid",
+			wantNot: []string{globalKey},
+		},
+		{
+			name: "account ID in API path",
+			err: fmt.Errorf(`Get "https://api.cloudflare.com/client/v4/accounts/%s/cfd_tunnel": EOF`,
+				accountID),
+			want:    `Get "https://api.cloudflare.com/client/v4/accounts/[REDACTED]/cfd_tunnel": EOF`,
+			wantNot: []string{accountID},
+		},
+		{
+			name:    "tunnel token",
+			err:     fmt.Errorf("cloudflared exited: %s", tunnelTok),
+			want:    "cloudflared exited: [REDACTED]",
+			wantNot: []string{tunnelTok},
+		},
+		{
+			name:    "wrapped Cloudflare error with token",
+			err:     fmt.Errorf("create DNS record: %w", fmt.Errorf("HTTP 400 for %s", apiToken)),
+			want:    "create DNS record: HTTP 400 for [REDACTED]",
+			wantNot: []string{apiToken},
+		},
+		{
+			name:    "keyword and value",
+			err:     fmt.Errorf("invalid api token %s", apiToken),
+			want:    "operation failed - check operator logs for details",
+			wantNot: []string{apiToken, "token"},
+		},
+		{
+			name: "git commit SHA is kept",
+			err:  errors.New("deployment of commit 3f786850e387550fdab836ed7e6dc881de23001b failed"),
+			want: "deployment of commit 3f786850e387550fdab836ed7e6dc881de23001b failed",
+		},
+		{
+			name: "IDs and names are kept",
+			err: errors.New("zone 023e105f4ecef8ad9ca31a8372d0c353 record " +
+				"372e6795-2fd1-4ba5-a4b2-c5d0f3b3f4f2 my-very-long-kubernetes-service-name-abcd"),
+			want: "zone 023e105f4ecef8ad9ca31a8372d0c353 record " +
+				"372e6795-2fd1-4ba5-a4b2-c5d0f3b3f4f2 my-very-long-kubernetes-service-name-abcd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SanitizeErrorMessage(tt.err)
+			assert.Equal(t, tt.want, got)
+			for _, notWant := range tt.wantNot {
+				assert.NotContains(t, strings.ToLower(got), strings.ToLower(notWant))
+			}
+		})
+	}
+}
+
 func TestSanitizeErrorMessageMaxLength(t *testing.T) {
 	// Create an error message longer than 512 characters
 	longMsg := strings.Repeat("x", 600)
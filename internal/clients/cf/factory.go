@@ -42,11 +42,7 @@ func (*DefaultClientFactory) NewClient(config ClientConfig) (CloudflareClient, e
 	var cfClient *cloudflare.API
 	var err error
 
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
+	opts := ClientOptions()
 
 	switch {
 	case config.APIToken != "":
@@ -5,6 +5,7 @@ package cf
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -476,6 +477,10 @@ func (c *API) DeleteGatewayRule(ctx context.Context, ruleID string) error {
 	return nil
 }
 
+// GatewayListMaxItemsPerRequest is the maximum number of items sent to Cloudflare
+// in a single create or append request.
+const GatewayListMaxItemsPerRequest = 1000
+
 // GatewayListParams contains parameters for a Gateway List.
 type GatewayListParams struct {
 	Name        string
@@ -507,20 +512,19 @@ func (c *API) CreateGatewayList(ctx context.Context, params GatewayListParams) (
 		return nil, err
 	}
 
-	// Convert items to TeamsListItems with descriptions
-	items := make([]cloudflare.TeamsListItem, len(params.Items))
-	for i, item := range params.Items {
-		items[i] = cloudflare.TeamsListItem{
-			Value:       item.Value,
-			Description: item.Description,
-		}
+	// The list is created with the first chunk of items and the rest is appended,
+	// so that no request exceeds the per-request item cap.
+	chunks := chunkGatewayListItems(params.Items, GatewayListMaxItemsPerRequest)
+	var first []GatewayListItem
+	if len(chunks) > 0 {
+		first = chunks[0]
 	}
 
 	createParams := cloudflare.CreateTeamsListParams{
 		Name:        params.Name,
 		Description: params.Description,
 		Type:        params.Type,
-		Items:       items,
+		Items:       toTeamsListItems(first),
 	}
 
 	result, err := c.CloudflareClient.CreateTeamsList(ctx, cloudflare.AccountIdentifier(c.ValidAccountId), createParams)
@@ -531,16 +535,118 @@ func (c *API) CreateGatewayList(ctx context.Context, params GatewayListParams) (
 
 	c.Log.Info("Gateway List created", "id", result.ID, "name", result.Name)
 
-	return &GatewayListResult{
+	created := &GatewayListResult{
 		ID:          result.ID,
 		Name:        result.Name,
 		Description: result.Description,
 		Type:        result.Type,
 		Count:       int(result.Count),
 		AccountID:   c.ValidAccountId,
+	}
+
+	if len(chunks) > 1 {
+		appended, err := c.PatchGatewayListItems(ctx, result.ID, params.Items[len(first):], nil)
+		if err != nil {
+			return nil, fmt.Errorf("gateway list %s created but appending items failed: %w", result.ID, err)
+		}
+		created.Count = appended.Count
+	}
+
+	return created, nil
+}
+
+// GetGatewayListItems returns all items of a Gateway List.
+func (c *API) GetGatewayListItems(ctx context.Context, listID string) ([]GatewayListItem, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	items, _, err := c.CloudflareClient.ListTeamsListItems(ctx, cloudflare.AccountIdentifier(c.ValidAccountId),
+		cloudflare.ListTeamsListItemsParams{ListID: listID})
+	if err != nil {
+		c.Log.Error(err, "error listing gateway list items", "id", listID)
+		return nil, err
+	}
+
+	result := make([]GatewayListItem, len(items))
+	for i, item := range items {
+		result[i] = GatewayListItem{
+			Value:       item.Value,
+			Description: item.Description,
+		}
+	}
+	return result, nil
+}
+
+// PatchGatewayListItems appends and removes items of an existing Gateway List without
+// replacing the whole list. Each request carries at most GatewayListMaxItemsPerRequest
+// items to append and as many to remove, so removals never run ahead of the appends.
+func (c *API) PatchGatewayListItems(
+	ctx context.Context, listID string, appendItems []GatewayListItem, removeValues []string,
+) (*GatewayListResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	var result cloudflare.TeamsList
+	for len(appendItems) > 0 || len(removeValues) > 0 {
+		appendChunk := appendItems[:min(len(appendItems), GatewayListMaxItemsPerRequest)]
+		removeChunk := removeValues[:min(len(removeValues), GatewayListMaxItemsPerRequest)]
+		appendItems = appendItems[len(appendChunk):]
+		removeValues = removeValues[len(removeChunk):]
+
+		var err error
+		result, err = c.CloudflareClient.PatchTeamsList(ctx, cloudflare.AccountIdentifier(c.ValidAccountId),
+			cloudflare.PatchTeamsListParams{
+				ID:     listID,
+				Append: toTeamsListItems(appendChunk),
+				Remove: append([]string{}, removeChunk...),
+			})
+		if err != nil {
+			c.Log.Error(err, "error patching gateway list items", "id", listID,
+				"append", len(appendChunk), "remove", len(removeChunk))
+			return nil, err
+		}
+	}
+
+	c.Log.Info("Gateway List items patched", "id", listID)
+
+	return &GatewayListResult{
+		ID:          listID,
+		Name:        result.Name,
+		Description: result.Description,
+		Type:        result.Type,
+		Count:       int(result.Count),
+		AccountID:   c.ValidAccountId,
 	}, nil
 }
 
+// chunkGatewayListItems splits items into consecutive chunks of at most size items.
+func chunkGatewayListItems(items []GatewayListItem, size int) [][]GatewayListItem {
+	var chunks [][]GatewayListItem
+	for len(items) > size {
+		chunks = append(chunks, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		chunks = append(chunks, items)
+	}
+	return chunks
+}
+
+func toTeamsListItems(items []GatewayListItem) []cloudflare.TeamsListItem {
+	result := make([]cloudflare.TeamsListItem, len(items))
+	for i, item := range items {
+		result[i] = cloudflare.TeamsListItem{
+			Value:       item.Value,
+			Description: item.Description,
+		}
+	}
+	return result
+}
+
 // GetGatewayList retrieves a Gateway List by ID.
 func (c *API) GetGatewayList(ctx context.Context, listID string) (*GatewayListResult, error) {
 	if _, err := c.GetAccountId(ctx); err != nil {
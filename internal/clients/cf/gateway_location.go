@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// GatewayLocationParams contains parameters for a Gateway Location.
+type GatewayLocationParams struct {
+	Name                string
+	Networks            []string
+	ClientDefault       bool
+	ECSSupport          *bool
+	DNSDestinationIPsID string
+	PolicyIDs           []string
+	// Endpoints is sent as a whole; nil leaves the endpoints unchanged.
+	Endpoints *cloudflare.TeamsLocationEndpoints
+}
+
+// GatewayLocationResult contains the result of a Gateway Location operation.
+type GatewayLocationResult struct {
+	ID            string
+	Name          string
+	Networks      []string
+	ClientDefault bool
+	ECSSupport    *bool
+	// DNSDestinationIPsID is empty when the location uses the shared resolver IPs.
+	DNSDestinationIPsID string
+	PolicyIDs           []string
+	DoHSubdomain        string
+	IPv6Address         string
+	Endpoints           *cloudflare.TeamsLocationEndpoints
+	AccountID           string
+}
+
+// gatewayLocation extends the SDK location with the policy IDs, which cloudflare-go
+// does not model. Locations are therefore sent and read directly.
+type gatewayLocation struct {
+	cloudflare.TeamsLocation
+	PolicyIDs []string `json:"policy_ids"`
+}
+
+// CreateGatewayLocation creates a new Gateway Location.
+func (c *API) CreateGatewayLocation(ctx context.Context, params GatewayLocationParams) (*GatewayLocationResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/gateway/locations", c.ValidAccountId)
+	result, err := c.doGatewayLocationRequest(ctx, http.MethodPost, endpoint, toGatewayLocation("", params))
+	if err != nil {
+		c.Log.Error(err, "error creating gateway location", "name", params.Name)
+		return nil, err
+	}
+
+	c.Log.Info("Gateway Location created", "id", result.ID, "name", result.Name)
+
+	return c.convertGatewayLocation(result), nil
+}
+
+// GetGatewayLocation retrieves a Gateway Location by ID.
+func (c *API) GetGatewayLocation(ctx context.Context, locationID string) (*GatewayLocationResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/gateway/locations/%s", c.ValidAccountId, locationID)
+	location, err := c.doGatewayLocationRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, WrapNotFound(fmt.Sprintf("gateway location %s", locationID), err)
+		}
+		c.Log.Error(err, "error getting gateway location", "id", locationID)
+		return nil, err
+	}
+
+	return c.convertGatewayLocation(location), nil
+}
+
+// UpdateGatewayLocation updates an existing Gateway Location.
+func (c *API) UpdateGatewayLocation(
+	ctx context.Context, locationID string, params GatewayLocationParams,
+) (*GatewayLocationResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/gateway/locations/%s", c.ValidAccountId, locationID)
+	result, err := c.doGatewayLocationRequest(ctx, http.MethodPut, endpoint, toGatewayLocation(locationID, params))
+	if err != nil {
+		c.Log.Error(err, "error updating gateway location", "id", locationID)
+		return nil, err
+	}
+
+	c.Log.Info("Gateway Location updated", "id", result.ID, "name", result.Name)
+
+	return c.convertGatewayLocation(result), nil
+}
+
+// DeleteGatewayLocation deletes a Gateway Location.
+// This method is idempotent - returns nil if the location is already deleted.
+func (c *API) DeleteGatewayLocation(ctx context.Context, locationID string) error {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return err
+	}
+
+	err := c.CloudflareClient.DeleteTeamsLocation(ctx, c.ValidAccountId, locationID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			c.Log.Info("Gateway Location already deleted (not found)", "id", locationID)
+			return nil
+		}
+		c.Log.Error(err, "error deleting gateway location", "id", locationID)
+		return err
+	}
+
+	c.Log.Info("Gateway Location deleted", "id", locationID)
+	return nil
+}
+
+// ListGatewayLocationsByName finds a Gateway Location by name.
+// Returns nil if no location with the given name is found.
+func (c *API) ListGatewayLocationsByName(ctx context.Context, name string) (*GatewayLocationResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	locations, err := listAllPages(ctx, rawListPage[gatewayLocation](c.CloudflareClient,
+		fmt.Sprintf("/accounts/%s/gateway/locations", c.ValidAccountId)))
+	if err != nil {
+		c.Log.Error(err, "error listing gateway locations")
+		return nil, err
+	}
+
+	for _, location := range locations {
+		if location.Name == name {
+			return c.convertGatewayLocation(location), nil
+		}
+	}
+
+	return nil, nil // Not found, return nil without error
+}
+
+// doGatewayLocationRequest sends a Gateway Location request and parses the returned location.
+func (c *API) doGatewayLocationRequest(
+	ctx context.Context, method, endpoint string, body interface{},
+) (gatewayLocation, error) {
+	var location gatewayLocation
+	resp, err := c.CloudflareClient.Raw(ctx, method, endpoint, body, nil)
+	if err != nil {
+		return location, err
+	}
+	if err := json.Unmarshal(resp.Result, &location); err != nil {
+		return location, fmt.Errorf("failed to parse gateway location: %w", err)
+	}
+	return location, nil
+}
+
+func toGatewayLocation(id string, params GatewayLocationParams) gatewayLocation {
+	location := gatewayLocation{
+		TeamsLocation: cloudflare.TeamsLocation{
+			ID:            id,
+			Name:          params.Name,
+			Networks:      make([]cloudflare.TeamsLocationNetwork, 0, len(params.Networks)),
+			ClientDefault: params.ClientDefault,
+			ECSSupport:    params.ECSSupport,
+			Endpoints:     params.Endpoints,
+		},
+		PolicyIDs: params.PolicyIDs,
+	}
+	if location.PolicyIDs == nil {
+		location.PolicyIDs = []string{}
+	}
+	for _, network := range params.Networks {
+		location.Networks = append(location.Networks, cloudflare.TeamsLocationNetwork{Network: network})
+	}
+	if params.DNSDestinationIPsID != "" {
+		location.DNSDestinationIPsID = &params.DNSDestinationIPsID
+	}
+	return location
+}
+
+func (c *API) convertGatewayLocation(location gatewayLocation) *GatewayLocationResult {
+	result := &GatewayLocationResult{
+		ID:            location.ID,
+		Name:          location.Name,
+		ClientDefault: location.ClientDefault,
+		ECSSupport:    location.ECSSupport,
+		PolicyIDs:     location.PolicyIDs,
+		DoHSubdomain:  location.Subdomain,
+		IPv6Address:   location.Ip,
+		Endpoints:     location.Endpoints,
+		AccountID:     c.ValidAccountId,
+	}
+	if location.DNSDestinationIPsID != nil {
+		result.DNSDestinationIPsID = *location.DNSDestinationIPsID
+	}
+	for _, network := range location.Networks {
+		result.Networks = append(result.Networks, network.Network)
+	}
+	return result
+}
@@ -471,6 +471,22 @@ func TestGatewayDNSResolversParams(t *testing.T) {
 	assert.Equal(t, "vnet-1", params.IPv4[1].VNetID)
 }
 
+func TestChunkGatewayListItems(t *testing.T) {
+	items := make([]GatewayListItem, 5)
+	for i := range items {
+		items[i] = GatewayListItem{Value: string(rune('a' + i))}
+	}
+
+	chunks := chunkGatewayListItems(items, 2)
+	require.Len(t, chunks, 3)
+	assert.Len(t, chunks[0], 2)
+	assert.Len(t, chunks[1], 2)
+	assert.Equal(t, []GatewayListItem{{Value: "e"}}, chunks[2])
+
+	assert.Len(t, chunkGatewayListItems(items, 5), 1)
+	assert.Empty(t, chunkGatewayListItems(nil, 5))
+}
+
 // Helper function for gateway tests
 func boolPtrGateway(b bool) *bool {
 	return &b
@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// HyperdriveOrigin is the origin database of a Hyperdrive configuration.
+// The password is not part of it, as Cloudflare never returns it.
+type HyperdriveOrigin struct {
+	Scheme   string
+	Host     string
+	Port     int
+	Database string
+	User     string
+}
+
+// HyperdriveCaching contains the caching settings of a Hyperdrive configuration
+type HyperdriveCaching struct {
+	Disabled             *bool
+	MaxAge               int
+	StaleWhileRevalidate int
+}
+
+// HyperdriveConfigParams contains parameters for creating or updating a Hyperdrive configuration
+type HyperdriveConfigParams struct {
+	Name     string
+	Origin   HyperdriveOrigin
+	Password string
+	Caching  HyperdriveCaching
+}
+
+// HyperdriveConfigResult contains the result of a Hyperdrive configuration operation
+type HyperdriveConfigResult struct {
+	ID      string
+	Name    string
+	Origin  HyperdriveOrigin
+	Caching HyperdriveCaching
+}
+
+func hyperdriveConfigResult(config cloudflare.HyperdriveConfig) *HyperdriveConfigResult {
+	return &HyperdriveConfigResult{
+		ID:   config.ID,
+		Name: config.Name,
+		Origin: HyperdriveOrigin{
+			Scheme:   config.Origin.Scheme,
+			Host:     config.Origin.Host,
+			Port:     config.Origin.Port,
+			Database: config.Origin.Database,
+			User:     config.Origin.User,
+		},
+		Caching: HyperdriveCaching{
+			Disabled:             config.Caching.Disabled,
+			MaxAge:               config.Caching.MaxAge,
+			StaleWhileRevalidate: config.Caching.StaleWhileRevalidate,
+		},
+	}
+}
+
+func (p HyperdriveConfigParams) originWithSecrets() cloudflare.HyperdriveConfigOriginWithSecrets {
+	return cloudflare.HyperdriveConfigOriginWithSecrets{
+		HyperdriveConfigOrigin: cloudflare.HyperdriveConfigOrigin{
+			Scheme:   p.Origin.Scheme,
+			Host:     p.Origin.Host,
+			Port:     p.Origin.Port,
+			Database: p.Origin.Database,
+			User:     p.Origin.User,
+		},
+		Password: p.Password,
+	}
+}
+
+func (p HyperdriveConfigParams) caching() cloudflare.HyperdriveConfigCaching {
+	return cloudflare.HyperdriveConfigCaching{
+		Disabled:             p.Caching.Disabled,
+		MaxAge:               p.Caching.MaxAge,
+		StaleWhileRevalidate: p.Caching.StaleWhileRevalidate,
+	}
+}
+
+// CreateHyperdriveConfig creates a new Hyperdrive configuration
+func (api *API) CreateHyperdriveConfig(ctx context.Context, params HyperdriveConfigParams) (*HyperdriveConfigResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	config, err := api.CloudflareClient.CreateHyperdriveConfig(ctx, rc, cloudflare.CreateHyperdriveConfigParams{
+		Name:    params.Name,
+		Origin:  params.originWithSecrets(),
+		Caching: params.caching(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Hyperdrive config: %w", err)
+	}
+
+	api.Log.Info("Hyperdrive config created", "hyperdriveId", config.ID, "name", config.Name)
+	return hyperdriveConfigResult(config), nil
+}
+
+// GetHyperdriveConfig retrieves a Hyperdrive configuration by ID
+func (api *API) GetHyperdriveConfig(ctx context.Context, hyperdriveID string) (*HyperdriveConfigResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	config, err := api.CloudflareClient.GetHyperdriveConfig(ctx, rc, hyperdriveID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Hyperdrive config: %w", err)
+	}
+
+	return hyperdriveConfigResult(config), nil
+}
+
+// ListHyperdriveConfigs lists all Hyperdrive configurations
+func (api *API) ListHyperdriveConfigs(ctx context.Context) ([]HyperdriveConfigResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	configs, err := api.CloudflareClient.ListHyperdriveConfigs(ctx, rc, cloudflare.ListHyperdriveConfigParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Hyperdrive configs: %w", err)
+	}
+
+	results := make([]HyperdriveConfigResult, len(configs))
+	for i, config := range configs {
+		results[i] = *hyperdriveConfigResult(config)
+	}
+
+	return results, nil
+}
+
+// GetHyperdriveConfigByName retrieves a Hyperdrive configuration by name
+func (api *API) GetHyperdriveConfigByName(ctx context.Context, name string) (*HyperdriveConfigResult, error) {
+	configs, err := api.ListHyperdriveConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range configs {
+		if configs[i].Name == name {
+			return &configs[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: Hyperdrive config %s", ErrResourceNotFound, name)
+}
+
+// UpdateHyperdriveConfig replaces a Hyperdrive configuration.
+// Cloudflare requires the origin password on every update.
+func (api *API) UpdateHyperdriveConfig(
+	ctx context.Context,
+	hyperdriveID string,
+	params HyperdriveConfigParams,
+) (*HyperdriveConfigResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	config, err := api.CloudflareClient.UpdateHyperdriveConfig(ctx, rc, cloudflare.UpdateHyperdriveConfigParams{
+		HyperdriveID: hyperdriveID,
+		Name:         params.Name,
+		Origin:       params.originWithSecrets(),
+		Caching:      params.caching(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update Hyperdrive config: %w", err)
+	}
+
+	api.Log.Info("Hyperdrive config updated", "hyperdriveId", hyperdriveID)
+	return hyperdriveConfigResult(config), nil
+}
+
+// DeleteHyperdriveConfig deletes a Hyperdrive configuration.
+// This method is idempotent - returns nil if the configuration is already deleted.
+func (api *API) DeleteHyperdriveConfig(ctx context.Context, hyperdriveID string) error {
+	if api.CloudflareClient == nil {
+		return errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	if err := api.CloudflareClient.DeleteHyperdriveConfig(ctx, rc, hyperdriveID); err != nil {
+		if IsNotFoundError(err) {
+			api.Log.Info("Hyperdrive config already deleted (not found)", "hyperdriveId", hyperdriveID)
+			return nil
+		}
+		return fmt.Errorf("failed to delete Hyperdrive config: %w", err)
+	}
+
+	api.Log.Info("Hyperdrive config deleted", "hyperdriveId", hyperdriveID)
+	return nil
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHyperdriveParams(password string) HyperdriveConfigParams {
+	return HyperdriveConfigParams{
+		Name: "orders-db",
+		Origin: HyperdriveOrigin{
+			Scheme:   "postgres",
+			Host:     "db.example.com",
+			Port:     5432,
+			Database: "orders",
+			User:     "app",
+		},
+		Password: password,
+	}
+}
+
+func TestHyperdriveConfigCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateHyperdriveConfig(ctx, testHyperdriveParams("s3cret"))
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, "orders-db", created.Name)
+	assert.Equal(t, "db.example.com", created.Origin.Host)
+	assert.Equal(t, 5432, created.Origin.Port)
+
+	got, err := api.GetHyperdriveConfig(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, "orders", got.Origin.Database)
+
+	byName, err := api.GetHyperdriveConfigByName(ctx, "orders-db")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+
+	configs, err := api.ListHyperdriveConfigs(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 1)
+
+	params := testHyperdriveParams("rotated")
+	disabled := true
+	params.Caching = HyperdriveCaching{Disabled: &disabled}
+	updated, err := api.UpdateHyperdriveConfig(ctx, created.ID, params)
+	require.NoError(t, err)
+	require.NotNil(t, updated.Caching.Disabled)
+	assert.True(t, *updated.Caching.Disabled)
+
+	stored, ok := mock.Store().GetHyperdriveConfig(created.ID)
+	require.True(t, ok)
+	assert.Equal(t, "rotated", stored.Password)
+
+	require.NoError(t, api.DeleteHyperdriveConfig(ctx, created.ID))
+	_, err = api.GetHyperdriveConfig(ctx, created.ID)
+	assert.True(t, IsNotFoundError(err))
+
+	// Deletion is idempotent
+	assert.NoError(t, api.DeleteHyperdriveConfig(ctx, created.ID))
+}
+
+func TestHyperdriveConfigPasswordNotReturned(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateHyperdriveConfig(ctx, testHyperdriveParams("s3cret"))
+	require.NoError(t, err)
+
+	// The password is sent to Cloudflare on create...
+	requests := mock.FindRequests(http.MethodPost, "/hyperdrive/configs$")
+	require.Len(t, requests, 1)
+	assert.Contains(t, requests[0].Body, `"password":"s3cret"`)
+
+	// ...but never part of a response.
+	raw, err := api.CloudflareClient.Raw(ctx, http.MethodGet,
+		"/accounts/"+api.ValidAccountId+"/hyperdrive/configs/"+created.ID, nil, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw.Result), "s3cret")
+	assert.NotContains(t, string(raw.Result), "password")
+}
+
+func TestGetHyperdriveConfigByNameNotFound(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	_, err := api.CreateHyperdriveConfig(ctx, testHyperdriveParams("s3cret"))
+	require.NoError(t, err)
+
+	_, err = api.GetHyperdriveConfigByName(ctx, "orders")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestUpdateHyperdriveConfigRequiresPassword(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateHyperdriveConfig(ctx, testHyperdriveParams("s3cret"))
+	require.NoError(t, err)
+
+	_, err = api.UpdateHyperdriveConfig(ctx, created.ID, testHyperdriveParams(""))
+	assert.Error(t, err)
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// DefaultIDCacheTTL is how long resolved account and zone IDs are reused by
+// API clients with the same credentials before they are looked up again.
+var DefaultIDCacheTTL = 10 * time.Minute
+
+type idKind string
+
+const (
+	idKindAccount idKind = "account"
+	idKindZone    idKind = "zone"
+)
+
+// idCacheKey identifies a resolved ID. credential is a hash of the credentials
+// and API host, so clients with different credentials never share IDs.
+type idCacheKey struct {
+	credential string
+	kind       idKind
+	lookup     string
+}
+
+type idCacheEntry struct {
+	id      string
+	expires time.Time
+}
+
+// idCache holds account and zone IDs resolved by API clients. API clients are
+// created for every reconcile, so the IDs are cached for the process rather
+// than on the API struct.
+type idCache struct {
+	mu      sync.Mutex
+	entries map[idCacheKey]idCacheEntry
+	now     func() time.Time
+}
+
+func newIDCache() *idCache {
+	return &idCache{
+		entries: make(map[idCacheKey]idCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// resolvedIDs is the process-wide ID cache.
+var resolvedIDs = newIDCache()
+
+func (c *idCache) get(key idCacheKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return "", false
+	}
+	return entry.id, true
+}
+
+func (c *idCache) set(key idCacheKey, id string) {
+	c.setFor(key, id, DefaultIDCacheTTL)
+}
+
+// setFor caches id for ttl.
+func (c *idCache) setFor(key idCacheKey, id string, ttl time.Duration) {
+	if id == "" || ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = idCacheEntry{id: id, expires: c.now().Add(ttl)}
+}
+
+// remove drops a single cached ID.
+func (c *idCache) remove(key idCacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// invalidateKind removes the IDs of one kind cached for credential.
+func (c *idCache) invalidateKind(credential string, kind idKind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.credential == credential && key.kind == kind {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// invalidate removes the IDs cached for credential.
+func (c *idCache) invalidate(credential string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if key.credential == credential {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *idCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
+
+// ClearIDCache removes all cached account and zone IDs and the IDs found by name lookups.
+func ClearIDCache() {
+	resolvedIDs.clear()
+}
+
+// credentialKey hashes the credentials together with the API host, so the key
+// can be stored without exposing the credentials.
+func credentialKey(host, apiToken, apiKey, apiEmail string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{host, apiToken, apiKey, apiEmail}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// credentialKey returns the ID cache key of the client's credentials.
+func (c *API) credentialKey() string {
+	apiToken, apiKey, apiEmail, host := c.APIToken, c.APIKey, c.APIEmail, ""
+	if c.CloudflareClient != nil {
+		apiToken, apiKey, apiEmail = c.CloudflareClient.APIToken, c.CloudflareClient.APIKey, c.CloudflareClient.APIEmail
+		if u, err := url.Parse(c.CloudflareClient.BaseURL); err == nil {
+			host = u.Host
+		}
+	}
+	return credentialKey(host, apiToken, apiKey, apiEmail)
+}
+
+func (c *API) idCacheKey(kind idKind, lookup ...string) idCacheKey {
+	return idCacheKey{credential: c.credentialKey(), kind: kind, lookup: strings.Join(lookup, "\x00")}
+}
+
+// RefreshIDs forgets the account and zone IDs resolved for the client's
+// credentials, so the next GetAccountId and GetZoneId look them up again.
+func (c *API) RefreshIDs() {
+	c.ValidAccountId = ""
+	c.ValidZoneId = ""
+	c.ValidDomainName = ""
+	resolvedIDs.invalidate(c.credentialKey())
+}
+
+// refreshIDsOnAuthError calls RefreshIDs when err is an authentication or
+// permission error, as the credentials may no longer reach the cached IDs.
+func (c *API) refreshIDsOnAuthError(err error) {
+	if IsAuthError(err) {
+		c.RefreshIDs()
+	}
+}
+
+// authErrorTransport invalidates the IDs cached for the credentials of a
+// request that fails with 401 Unauthorized or 403 Forbidden.
+type authErrorTransport struct {
+	base http.RoundTripper
+}
+
+func (t authErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+		resolvedIDs.invalidate(credentialKey(req.URL.Host,
+			strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "),
+			req.Header.Get("X-Auth-Key"), req.Header.Get("X-Auth-Email")))
+	}
+	return resp, err
+}
+
+// ClientOptions returns the options for creating a cloudflare.API. They set
+// the API base URL from CLOUDFLARE_API_BASE_URL and invalidate cached IDs when
+// a request fails with an authentication error.
+func ClientOptions() []cloudflare.Option {
+	opts := []cloudflare.Option{
+		cloudflare.HTTPClient(&http.Client{Transport: authErrorTransport{base: http.DefaultTransport}}),
+	}
+	if baseURL := GetAPIBaseURL(); baseURL != "" {
+		opts = append(opts, cloudflare.BaseURL(baseURL))
+	}
+	return opts
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validTestToken = "valid-token"
+
+// idLookupServer serves account and zone lookups, counting them. Requests
+// with a token other than validTestToken fail with 401, as does every request
+// while unauthorized is set.
+type idLookupServer struct {
+	*httptest.Server
+	accountLookups atomic.Int32
+	zoneLookups    atomic.Int32
+	unauthorized   atomic.Bool
+}
+
+func newIDLookupServer(t *testing.T) *idLookupServer {
+	t.Helper()
+	s := &idLookupServer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts", func(rw http.ResponseWriter, _ *http.Request) {
+		s.accountLookups.Add(1)
+		_, _ = fmt.Fprint(rw, `{"success":true,"errors":[],"messages":[],"result":[{"id":"acc-1","name":"Account"}],`+
+			`"result_info":{"page":1,"per_page":20,"count":1,"total_count":1}}`)
+	})
+	mux.HandleFunc("/zones", func(rw http.ResponseWriter, _ *http.Request) {
+		s.zoneLookups.Add(1)
+		_, _ = fmt.Fprint(rw, `{"success":true,"errors":[],"messages":[],"result":[{"id":"zone-1","name":"example.com"}],`+
+			`"result_info":{"page":1,"per_page":50,"count":1,"total_count":1,"total_pages":1}}`)
+	})
+	s.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if s.unauthorized.Load() || req.Header.Get("Authorization") != "Bearer "+validTestToken {
+			rw.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(rw, `{"success":false,"errors":[{"code":10000,"message":"Authentication error"}],`+
+				`"messages":[],"result":null}`)
+			return
+		}
+		mux.ServeHTTP(rw, req)
+	}))
+	t.Cleanup(s.Close)
+	t.Cleanup(ClearIDCache)
+	return s
+}
+
+// newClient returns a fresh API client for the server, as a reconcile would.
+func (s *idLookupServer) newClient(t *testing.T, token string) *API {
+	t.Helper()
+	client, err := cloudflare.NewWithAPIToken(token, append(ClientOptions(), cloudflare.BaseURL(s.URL))...)
+	require.NoError(t, err)
+	return &API{Log: logr.Discard(), CloudflareClient: client, Domain: "example.com"}
+}
+
+func TestIDCache_OneLookupAcrossClients(t *testing.T) {
+	server := newIDLookupServer(t)
+	ctx := context.Background()
+
+	for range 10 {
+		api := server.newClient(t, validTestToken)
+		accountID, err := api.GetAccountId(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "acc-1", accountID)
+		zoneID, err := api.GetZoneId(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "zone-1", zoneID)
+	}
+
+	assert.Equal(t, int32(1), server.accountLookups.Load())
+	assert.Equal(t, int32(1), server.zoneLookups.Load())
+}
+
+func TestIDCache_PerCredential(t *testing.T) {
+	server := newIDLookupServer(t)
+	ctx := context.Background()
+
+	_, err := server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.NoError(t, err)
+
+	_, err = server.newClient(t, "other-token").GetAccountId(ctx)
+	assert.Error(t, err, "another credential must not reuse the cached account")
+}
+
+func TestIDCache_Expires(t *testing.T) {
+	server := newIDLookupServer(t)
+	ctx := context.Background()
+	now := time.Now()
+	resolvedIDs.now = func() time.Time { return now }
+	t.Cleanup(func() { resolvedIDs.now = time.Now })
+
+	_, err := server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.NoError(t, err)
+	now = now.Add(DefaultIDCacheTTL)
+	_, err = server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), server.accountLookups.Load())
+}
+
+func TestIDCache_RefreshIDs(t *testing.T) {
+	server := newIDLookupServer(t)
+	ctx := context.Background()
+
+	api := server.newClient(t, validTestToken)
+	_, err := api.GetAccountId(ctx)
+	require.NoError(t, err)
+	api.RefreshIDs()
+	assert.Empty(t, api.ValidAccountId)
+	_, err = server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), server.accountLookups.Load())
+}
+
+func TestIDCache_AuthErrorClearsCache(t *testing.T) {
+	server := newIDLookupServer(t)
+	ctx := context.Background()
+
+	api := server.newClient(t, validTestToken)
+	_, err := api.GetAccountId(ctx)
+	require.NoError(t, err)
+	_, err = api.GetZoneId(ctx)
+	require.NoError(t, err)
+
+	server.unauthorized.Store(true)
+	_, err = api.ListVirtualNetworks(ctx)
+	require.Error(t, err)
+	server.unauthorized.Store(false)
+
+	_, err = server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.NoError(t, err)
+	_, err = server.newClient(t, validTestToken).GetZoneId(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), server.accountLookups.Load())
+	assert.Equal(t, int32(2), server.zoneLookups.Load())
+}
+
+func TestIDCache_FailedLookupIsNotCached(t *testing.T) {
+	server := newIDLookupServer(t)
+	ctx := context.Background()
+
+	server.unauthorized.Store(true)
+	_, err := server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.Error(t, err)
+	server.unauthorized.Store(false)
+
+	accountID, err := server.newClient(t, validTestToken).GetAccountId(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "acc-1", accountID)
+}
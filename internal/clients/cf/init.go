@@ -56,6 +56,7 @@ func NewAPIClientFromDetails(ctx context.Context, k8sClient client.Client, names
 		Log:              logger,
 		CloudflareClient: cfClient,
 		AccountId:        creds.AccountID,
+		AccountName:      details.AccountName,
 		Domain:           creds.Domain,
 		APIToken:         creds.APIToken,
 		APIKey:           creds.APIKey,
@@ -149,11 +150,7 @@ func NewAPIClientFromSecret(ctx context.Context, k8sClient client.Client, secret
 	var cfClient *cloudflare.API
 	var err error
 
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
+	opts := ClientOptions()
 
 	if apiToken != "" {
 		cfClient, err = cloudflare.NewWithAPIToken(apiToken, opts...)
@@ -183,11 +180,7 @@ func createCloudflareClient(creds *credentials.Credentials) (*cloudflare.API, er
 	var cfClient *cloudflare.API
 	var err error
 
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
+	opts := ClientOptions()
 
 	switch creds.AuthType {
 	case networkingv1alpha2.AuthTypeAPIToken:
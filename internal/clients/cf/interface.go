@@ -157,11 +157,16 @@ type CloudflareClient interface {
 	RollbackPagesDeployment(ctx context.Context, projectName, deploymentID string) (*PagesDeploymentResult, error)
 	GetPagesDeploymentLogs(ctx context.Context, projectName, deploymentID string) (*PagesDeploymentLogsResult, error)
 
+	// Pages binding target lookups
+	GetD1DatabaseByName(ctx context.Context, name string) (*D1DatabaseResult, error)
+	GetKVNamespaceByTitle(ctx context.Context, title string) (*KVNamespaceResult, error)
+
 	// Web Analytics (RUM) operations
-	EnableWebAnalytics(ctx context.Context, hostname string) (*RUMSite, error)
+	CreateWebAnalyticsSite(ctx context.Context, hostname string, autoInstall bool) (*RUMSite, error)
+	ListWebAnalyticsSites(ctx context.Context) ([]RUMSite, error)
 	GetWebAnalyticsSite(ctx context.Context, hostname string) (*RUMSite, error)
 	UpdateWebAnalyticsSite(ctx context.Context, siteTag string, autoInstall bool) (*RUMSite, error)
-	DisableWebAnalytics(ctx context.Context, siteTag string) error
+	DeleteWebAnalyticsSite(ctx context.Context, siteTag string) error
 }
 
 // Ensure API implements CloudflareClient
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// KVNamespaceResult contains the result of a Workers KV namespace operation
+type KVNamespaceResult struct {
+	ID    string
+	Title string
+}
+
+// CreateKVNamespace creates a new Workers KV namespace
+func (api *API) CreateKVNamespace(ctx context.Context, title string) (*KVNamespaceResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	resp, err := api.CloudflareClient.CreateWorkersKVNamespace(ctx, rc, cloudflare.CreateWorkersKVNamespaceParams{
+		Title: title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create KV namespace: %w", err)
+	}
+
+	api.Log.Info("KV namespace created", "namespaceId", resp.Result.ID, "title", title)
+	return &KVNamespaceResult{ID: resp.Result.ID, Title: resp.Result.Title}, nil
+}
+
+// GetKVNamespace retrieves a Workers KV namespace by ID
+func (api *API) GetKVNamespace(ctx context.Context, namespaceID string) (*KVNamespaceResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	// cloudflare-go has no method to get a single namespace
+	endpoint := fmt.Sprintf("/accounts/%s/storage/kv/namespaces/%s", accountID, namespaceID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KV namespace: %w", err)
+	}
+
+	var namespace cloudflare.WorkersKVNamespace
+	if err := json.Unmarshal(resp.Result, &namespace); err != nil {
+		return nil, fmt.Errorf("failed to parse KV namespace response: %w", err)
+	}
+
+	return &KVNamespaceResult{ID: namespace.ID, Title: namespace.Title}, nil
+}
+
+// ListKVNamespaces lists all Workers KV namespaces
+func (api *API) ListKVNamespaces(ctx context.Context) ([]KVNamespaceResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	namespaces, _, err := api.CloudflareClient.ListWorkersKVNamespaces(ctx, rc, cloudflare.ListWorkersKVNamespacesParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KV namespaces: %w", err)
+	}
+
+	results := make([]KVNamespaceResult, len(namespaces))
+	for i, namespace := range namespaces {
+		results[i] = KVNamespaceResult{ID: namespace.ID, Title: namespace.Title}
+	}
+
+	return results, nil
+}
+
+// GetKVNamespaceByTitle retrieves a Workers KV namespace by title
+func (api *API) GetKVNamespaceByTitle(ctx context.Context, title string) (*KVNamespaceResult, error) {
+	namespaces, err := api.ListKVNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range namespaces {
+		if namespaces[i].Title == title {
+			return &namespaces[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: KV namespace %s", ErrResourceNotFound, title)
+}
+
+// RenameKVNamespace changes the title of a Workers KV namespace
+func (api *API) RenameKVNamespace(ctx context.Context, namespaceID, title string) error {
+	if api.CloudflareClient == nil {
+		return errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	if _, err := api.CloudflareClient.UpdateWorkersKVNamespace(ctx, rc, cloudflare.UpdateWorkersKVNamespaceParams{
+		NamespaceID: namespaceID,
+		Title:       title,
+	}); err != nil {
+		return fmt.Errorf("failed to rename KV namespace: %w", err)
+	}
+
+	api.Log.Info("KV namespace renamed", "namespaceId", namespaceID, "title", title)
+	return nil
+}
+
+// DeleteKVNamespace deletes a Workers KV namespace.
+// This method is idempotent - returns nil if the namespace is already deleted.
+func (api *API) DeleteKVNamespace(ctx context.Context, namespaceID string) error {
+	if api.CloudflareClient == nil {
+		return errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	rc := cloudflare.AccountIdentifier(accountID)
+	if _, err := api.CloudflareClient.DeleteWorkersKVNamespace(ctx, rc, namespaceID); err != nil {
+		if IsNotFoundError(err) {
+			api.Log.Info("KV namespace already deleted (not found)", "namespaceId", namespaceID)
+			return nil
+		}
+		return fmt.Errorf("failed to delete KV namespace: %w", err)
+	}
+
+	api.Log.Info("KV namespace deleted", "namespaceId", namespaceID)
+	return nil
+}
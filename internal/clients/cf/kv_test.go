@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVNamespaceCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateKVNamespace(ctx, "sessions")
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, "sessions", created.Title)
+
+	got, err := api.GetKVNamespace(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+	assert.Equal(t, "sessions", got.Title)
+
+	byTitle, err := api.GetKVNamespaceByTitle(ctx, "sessions")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byTitle.ID)
+
+	namespaces, err := api.ListKVNamespaces(ctx)
+	require.NoError(t, err)
+	assert.Len(t, namespaces, 1)
+
+	require.NoError(t, api.RenameKVNamespace(ctx, created.ID, "user-sessions"))
+	stored, ok := mock.Store().GetKVNamespace(created.ID)
+	require.True(t, ok)
+	assert.Equal(t, "user-sessions", stored.Title)
+
+	require.NoError(t, api.DeleteKVNamespace(ctx, created.ID))
+	_, err = api.GetKVNamespace(ctx, created.ID)
+	assert.True(t, IsNotFoundError(err))
+
+	// Deletion is idempotent
+	assert.NoError(t, api.DeleteKVNamespace(ctx, created.ID))
+}
+
+func TestGetKVNamespaceByTitleNotFound(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	_, err := api.CreateKVNamespace(ctx, "sessions-staging")
+	require.NoError(t, err)
+
+	_, err = api.GetKVNamespaceByTitle(ctx, "sessions")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestCreateKVNamespaceDuplicateTitle(t *testing.T) {
+	api, _ := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	_, err := api.CreateKVNamespace(ctx, "sessions")
+	require.NoError(t, err)
+
+	_, err = api.CreateKVNamespace(ctx, "sessions")
+	assert.Error(t, err)
+}
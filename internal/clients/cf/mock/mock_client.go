@@ -282,6 +282,21 @@ func (mr *MockCloudflareClientMockRecorder) CreateWARPConnector(ctx, name any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWARPConnector", reflect.TypeOf((*MockCloudflareClient)(nil).CreateWARPConnector), ctx, name)
 }
 
+// CreateWebAnalyticsSite mocks base method.
+func (m *MockCloudflareClient) CreateWebAnalyticsSite(ctx context.Context, hostname string, autoInstall bool) (*cf.RUMSite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebAnalyticsSite", ctx, hostname, autoInstall)
+	ret0, _ := ret[0].(*cf.RUMSite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebAnalyticsSite indicates an expected call of CreateWebAnalyticsSite.
+func (mr *MockCloudflareClientMockRecorder) CreateWebAnalyticsSite(ctx, hostname, autoInstall any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebAnalyticsSite", reflect.TypeOf((*MockCloudflareClient)(nil).CreateWebAnalyticsSite), ctx, hostname, autoInstall)
+}
+
 // DeleteAccessApplication mocks base method.
 func (m *MockCloudflareClient) DeleteAccessApplication(ctx context.Context, applicationID string) error {
 	m.ctrl.T.Helper()
@@ -520,33 +535,33 @@ func (mr *MockCloudflareClientMockRecorder) DeleteWARPConnector(ctx, connectorID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWARPConnector", reflect.TypeOf((*MockCloudflareClient)(nil).DeleteWARPConnector), ctx, connectorID)
 }
 
-// DisableWebAnalytics mocks base method.
-func (m *MockCloudflareClient) DisableWebAnalytics(ctx context.Context, siteTag string) error {
+// DeleteWebAnalyticsSite mocks base method.
+func (m *MockCloudflareClient) DeleteWebAnalyticsSite(ctx context.Context, siteTag string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DisableWebAnalytics", ctx, siteTag)
+	ret := m.ctrl.Call(m, "DeleteWebAnalyticsSite", ctx, siteTag)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DisableWebAnalytics indicates an expected call of DisableWebAnalytics.
-func (mr *MockCloudflareClientMockRecorder) DisableWebAnalytics(ctx, siteTag any) *gomock.Call {
+// DeleteWebAnalyticsSite indicates an expected call of DeleteWebAnalyticsSite.
+func (mr *MockCloudflareClientMockRecorder) DeleteWebAnalyticsSite(ctx, siteTag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableWebAnalytics", reflect.TypeOf((*MockCloudflareClient)(nil).DisableWebAnalytics), ctx, siteTag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebAnalyticsSite", reflect.TypeOf((*MockCloudflareClient)(nil).DeleteWebAnalyticsSite), ctx, siteTag)
 }
 
-// EnableWebAnalytics mocks base method.
-func (m *MockCloudflareClient) EnableWebAnalytics(ctx context.Context, hostname string) (*cf.RUMSite, error) {
+// FindPagesDeploymentByCommitHash mocks base method.
+func (m *MockCloudflareClient) FindPagesDeploymentByCommitHash(ctx context.Context, projectName, commitHash string) (*cf.PagesDeploymentResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnableWebAnalytics", ctx, hostname)
-	ret0, _ := ret[0].(*cf.RUMSite)
+	ret := m.ctrl.Call(m, "FindPagesDeploymentByCommitHash", ctx, projectName, commitHash)
+	ret0, _ := ret[0].(*cf.PagesDeploymentResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EnableWebAnalytics indicates an expected call of EnableWebAnalytics.
-func (mr *MockCloudflareClientMockRecorder) EnableWebAnalytics(ctx, hostname any) *gomock.Call {
+// FindPagesDeploymentByCommitHash indicates an expected call of FindPagesDeploymentByCommitHash.
+func (mr *MockCloudflareClientMockRecorder) FindPagesDeploymentByCommitHash(ctx, projectName, commitHash any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableWebAnalytics", reflect.TypeOf((*MockCloudflareClient)(nil).EnableWebAnalytics), ctx, hostname)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPagesDeploymentByCommitHash", reflect.TypeOf((*MockCloudflareClient)(nil).FindPagesDeploymentByCommitHash), ctx, projectName, commitHash)
 }
 
 // GetAccessApplication mocks base method.
@@ -639,6 +654,21 @@ func (mr *MockCloudflareClientMockRecorder) GetAccountId(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountId", reflect.TypeOf((*MockCloudflareClient)(nil).GetAccountId), ctx)
 }
 
+// GetD1DatabaseByName mocks base method.
+func (m *MockCloudflareClient) GetD1DatabaseByName(ctx context.Context, name string) (*cf.D1DatabaseResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetD1DatabaseByName", ctx, name)
+	ret0, _ := ret[0].(*cf.D1DatabaseResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetD1DatabaseByName indicates an expected call of GetD1DatabaseByName.
+func (mr *MockCloudflareClientMockRecorder) GetD1DatabaseByName(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetD1DatabaseByName", reflect.TypeOf((*MockCloudflareClient)(nil).GetD1DatabaseByName), ctx, name)
+}
+
 // GetDNSCNameId mocks base method.
 func (m *MockCloudflareClient) GetDNSCNameId(ctx context.Context, fqdn string) (string, error) {
 	m.ctrl.T.Helper()
@@ -744,6 +774,21 @@ func (mr *MockCloudflareClientMockRecorder) GetGatewayRule(ctx, ruleID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGatewayRule", reflect.TypeOf((*MockCloudflareClient)(nil).GetGatewayRule), ctx, ruleID)
 }
 
+// GetKVNamespaceByTitle mocks base method.
+func (m *MockCloudflareClient) GetKVNamespaceByTitle(ctx context.Context, title string) (*cf.KVNamespaceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKVNamespaceByTitle", ctx, title)
+	ret0, _ := ret[0].(*cf.KVNamespaceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKVNamespaceByTitle indicates an expected call of GetKVNamespaceByTitle.
+func (mr *MockCloudflareClientMockRecorder) GetKVNamespaceByTitle(ctx, title any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKVNamespaceByTitle", reflect.TypeOf((*MockCloudflareClient)(nil).GetKVNamespaceByTitle), ctx, title)
+}
+
 // GetManagedDnsTxt mocks base method.
 func (m *MockCloudflareClient) GetManagedDnsTxt(ctx context.Context, fqdn string) (string, cf.DnsManagedRecordTxt, bool, error) {
 	m.ctrl.T.Helper()
@@ -1135,21 +1180,6 @@ func (mr *MockCloudflareClientMockRecorder) ListPagesDeployments(ctx, projectNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPagesDeployments", reflect.TypeOf((*MockCloudflareClient)(nil).ListPagesDeployments), ctx, projectName)
 }
 
-// FindPagesDeploymentByCommitHash mocks base method.
-func (m *MockCloudflareClient) FindPagesDeploymentByCommitHash(ctx context.Context, projectName, commitHash string) (*cf.PagesDeploymentResult, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPagesDeploymentByCommitHash", ctx, projectName, commitHash)
-	ret0, _ := ret[0].(*cf.PagesDeploymentResult)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FindPagesDeploymentByCommitHash indicates an expected call of FindPagesDeploymentByCommitHash.
-func (mr *MockCloudflareClientMockRecorder) FindPagesDeploymentByCommitHash(ctx, projectName, commitHash any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPagesDeploymentByCommitHash", reflect.TypeOf((*MockCloudflareClient)(nil).FindPagesDeploymentByCommitHash), ctx, projectName, commitHash)
-}
-
 // ListPagesDomains mocks base method.
 func (m *MockCloudflareClient) ListPagesDomains(ctx context.Context, projectName string) ([]cf.PagesDomainResult, error) {
 	m.ctrl.T.Helper()
@@ -1210,6 +1240,21 @@ func (mr *MockCloudflareClientMockRecorder) ListVirtualNetworks(ctx any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualNetworks", reflect.TypeOf((*MockCloudflareClient)(nil).ListVirtualNetworks), ctx)
 }
 
+// ListWebAnalyticsSites mocks base method.
+func (m *MockCloudflareClient) ListWebAnalyticsSites(ctx context.Context) ([]cf.RUMSite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebAnalyticsSites", ctx)
+	ret0, _ := ret[0].([]cf.RUMSite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebAnalyticsSites indicates an expected call of ListWebAnalyticsSites.
+func (mr *MockCloudflareClientMockRecorder) ListWebAnalyticsSites(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebAnalyticsSites", reflect.TypeOf((*MockCloudflareClient)(nil).ListWebAnalyticsSites), ctx)
+}
+
 // PatchPagesDomain mocks base method.
 func (m *MockCloudflareClient) PatchPagesDomain(ctx context.Context, projectName, domain string) (*cf.PagesDomainResult, error) {
 	m.ctrl.T.Helper()
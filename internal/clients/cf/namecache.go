@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultNameCacheTTL is how long the ID found by a ...ByName lookup is reused before
+// the resources are listed again. It is short because other tools may rename or
+// recreate resources; every hit is still checked with a get by ID.
+var DefaultNameCacheTTL = time.Minute
+
+// Kinds of the IDs cached by name lookups.
+const (
+	idKindAccessGroup            idKind = "access-group"
+	idKindAccessIdentityProvider idKind = "access-identity-provider"
+	idKindAccessServiceToken     idKind = "access-service-token"
+	idKindDevicePostureRule      idKind = "device-posture-rule"
+)
+
+// nameCacheKey returns the cache key of a resource name in the client's account.
+func (c *API) nameCacheKey(kind idKind, name string) idCacheKey {
+	return c.idCacheKey(kind, c.ValidAccountId, name)
+}
+
+// cacheNameID remembers the ID found for a resource name.
+func (c *API) cacheNameID(kind idKind, name, id string) {
+	resolvedIDs.setFor(c.nameCacheKey(kind, name), id, DefaultNameCacheTTL)
+}
+
+// invalidateNameIDs forgets the IDs found by name for one kind of resource. It is
+// called after a resource of the kind is created, updated or deleted, since a
+// name may now belong to another resource or to none.
+func (c *API) invalidateNameIDs(kind idKind) {
+	resolvedIDs.invalidateKind(c.credentialKey(), kind)
+}
+
+// lookupCachedName returns the resource whose ID is cached for name, or nil when no ID
+// is cached. The resource is fetched by ID, so a resource deleted or renamed outside
+// the operator is never returned; its cached ID is dropped instead.
+func lookupCachedName[T any](
+	ctx context.Context,
+	c *API,
+	kind idKind,
+	name string,
+	get func(ctx context.Context, id string) (*T, error),
+	nameOf func(*T) string,
+) *T {
+	key := c.nameCacheKey(kind, name)
+	id, ok := resolvedIDs.get(key)
+	if !ok {
+		return nil
+	}
+
+	result, err := get(ctx, id)
+	if err != nil || nameOf(result) != name {
+		c.Log.V(1).Info("Dropping cached ID of renamed or deleted resource", "kind", kind, "name", name, "id", id)
+		resolvedIDs.remove(key)
+		return nil
+	}
+	return result
+}
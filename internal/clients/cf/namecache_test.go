@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func TestNameCache_RepeatedLookupSkipsList(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	t.Cleanup(ClearIDCache)
+	mock.Store().CreateAccessGroup(&models.AccessGroup{ID: "group-1", Name: "engineers"})
+	ctx := context.Background()
+
+	for range 3 {
+		group, err := api.GetAccessGroupByName(ctx, "engineers")
+		require.NoError(t, err)
+		require.NotNil(t, group)
+		assert.Equal(t, "group-1", group.ID)
+	}
+
+	assert.Equal(t, 1, mock.CountRequests(http.MethodGet, "/access/groups$"), "only the first lookup lists groups")
+	assert.Equal(t, 2, mock.CountRequests(http.MethodGet, "/access/groups/group-1$"), "cached IDs are checked by ID")
+}
+
+func TestNameCache_DeleteInvalidates(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	t.Cleanup(ClearIDCache)
+	mock.Store().CreateAccessServiceToken(&models.AccessServiceToken{ID: "token-1", Name: "ci", ClientID: "client"})
+	ctx := context.Background()
+
+	token, err := api.GetAccessServiceTokenByName(ctx, "ci")
+	require.NoError(t, err)
+	require.NotNil(t, token)
+
+	require.NoError(t, api.DeleteAccessServiceToken(ctx, "token-1"))
+
+	token, err = api.GetAccessServiceTokenByName(ctx, "ci")
+	require.NoError(t, err)
+	assert.Nil(t, token)
+	assert.Zero(t, mock.CountRequests(http.MethodGet, "/access/service_tokens/token-1$"),
+		"the deleted token's cached ID is not used")
+	assert.Equal(t, 2, mock.CountRequests(http.MethodGet, "/access/service_tokens$"))
+}
+
+func TestNameCache_ExternalChangesAreNotReturned(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	t.Cleanup(ClearIDCache)
+	mock.Store().CreateDevicePostureRule(&models.DevicePostureRule{ID: "rule-1", Name: "firewall", Type: "firewall"})
+	mock.Store().CreateAccessGroup(&models.AccessGroup{ID: "group-1", Name: "engineers"})
+	ctx := context.Background()
+
+	rule, err := api.ListDevicePostureRulesByName(ctx, "firewall")
+	require.NoError(t, err)
+	require.NotNil(t, rule)
+	group, err := api.GetAccessGroupByName(ctx, "engineers")
+	require.NoError(t, err)
+	require.NotNil(t, group)
+
+	// Deleted and renamed outside the operator
+	mock.Store().DeleteDevicePostureRule("rule-1")
+	mock.Store().UpdateAccessGroup("group-1", func(g *models.AccessGroup) { g.Name = "contractors" })
+
+	rule, err = api.ListDevicePostureRulesByName(ctx, "firewall")
+	require.NoError(t, err)
+	assert.Nil(t, rule)
+	group, err = api.GetAccessGroupByName(ctx, "engineers")
+	require.NoError(t, err)
+	assert.Nil(t, group)
+}
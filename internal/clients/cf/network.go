@@ -6,6 +6,8 @@ package cf
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"net/url"
 
 	"github.com/cloudflare/cloudflare-go"
 )
@@ -450,9 +452,20 @@ func (c *API) DeleteTunnelRoute(ctx context.Context, network, virtualNetworkID s
 	return nil
 }
 
-// ListTunnelRoutesByTunnelID lists all Tunnel Routes associated with a specific Tunnel.
+// ListTunnelRoutesByTunnelID lists all live Tunnel Routes associated with a specific Tunnel.
 // This is used to clean up routes before deleting a tunnel.
 func (c *API) ListTunnelRoutesByTunnelID(ctx context.Context, tunnelID string) ([]TunnelRouteResult, error) {
+	routes, err := c.listLiveTunnelRoutes(ctx, url.Values{"tunnel_id": {tunnelID}})
+	if err != nil {
+		c.Log.Error(err, "error listing tunnel routes by tunnel ID", "tunnelId", tunnelID)
+		return nil, err
+	}
+	return routes, nil
+}
+
+// ListTunnelRoutesByVirtualNetworkID lists all Tunnel Routes associated with a specific Virtual Network.
+// This is used to clean up routes before deleting a virtual network.
+func (c *API) ListTunnelRoutesByVirtualNetworkID(ctx context.Context, virtualNetworkID string) ([]TunnelRouteResult, error) {
 	if _, err := c.GetAccountId(ctx); err != nil {
 		c.Log.Error(err, "error getting account ID")
 		return nil, err
@@ -461,12 +474,12 @@ func (c *API) ListTunnelRoutesByTunnelID(ctx context.Context, tunnelID string) (
 	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
 
 	params := cloudflare.TunnelRoutesListParams{
-		TunnelID: tunnelID,
+		VirtualNetworkID: virtualNetworkID,
 	}
 
 	routes, err := c.CloudflareClient.ListTunnelRoutes(ctx, rc, params)
 	if err != nil {
-		c.Log.Error(err, "error listing tunnel routes by tunnel ID", "tunnelId", tunnelID)
+		c.Log.Error(err, "error listing tunnel routes by virtual network ID", "virtualNetworkId", virtualNetworkID)
 		return nil, err
 	}
 
@@ -484,23 +497,25 @@ func (c *API) ListTunnelRoutesByTunnelID(ctx context.Context, tunnelID string) (
 	return results, nil
 }
 
-// ListTunnelRoutesByVirtualNetworkID lists all Tunnel Routes associated with a specific Virtual Network.
-// This is used to clean up routes before deleting a virtual network.
-func (c *API) ListTunnelRoutesByVirtualNetworkID(ctx context.Context, virtualNetworkID string) ([]TunnelRouteResult, error) {
+// ListTunnelRoutes lists all live Tunnel Routes of the account across tunnels and
+// virtual networks. Deleted routes are left out and every page is fetched.
+func (c *API) ListTunnelRoutes(ctx context.Context) ([]TunnelRouteResult, error) {
+	return c.listLiveTunnelRoutes(ctx, url.Values{})
+}
+
+// listLiveTunnelRoutes lists the Tunnel Routes matching the filter across all pages,
+// leaving out deleted routes, which Cloudflare otherwise includes.
+func (c *API) listLiveTunnelRoutes(ctx context.Context, filter url.Values) ([]TunnelRouteResult, error) {
 	if _, err := c.GetAccountId(ctx); err != nil {
 		c.Log.Error(err, "error getting account ID")
 		return nil, err
 	}
 
-	rc := cloudflare.AccountIdentifier(c.ValidAccountId)
-
-	params := cloudflare.TunnelRoutesListParams{
-		VirtualNetworkID: virtualNetworkID,
-	}
-
-	routes, err := c.CloudflareClient.ListTunnelRoutes(ctx, rc, params)
+	filter.Set("is_deleted", "false")
+	endpoint := fmt.Sprintf("/accounts/%s/teamnet/routes?%s", c.ValidAccountId, filter.Encode())
+	routes, err := listAllPages(ctx, rawListPage[cloudflare.TunnelRoute](c.CloudflareClient, endpoint))
 	if err != nil {
-		c.Log.Error(err, "error listing tunnel routes by virtual network ID", "virtualNetworkId", virtualNetworkID)
+		c.Log.Error(err, "error listing tunnel routes")
 		return nil, err
 	}
 
@@ -518,6 +533,39 @@ func (c *API) ListTunnelRoutesByVirtualNetworkID(ctx context.Context, virtualNet
 	return results, nil
 }
 
+// FindOverlappingTunnelRoutes returns the routes of other tunnels whose CIDR overlaps
+// network in the given virtual network, including routes with exactly the same CIDR.
+// Routes of tunnelID are not reported. An empty virtualNetworkID compares against
+// routes in every virtual network.
+func FindOverlappingTunnelRoutes(
+	routes []TunnelRouteResult, network, virtualNetworkID, tunnelID string,
+) ([]TunnelRouteResult, error) {
+	desired, err := netip.ParsePrefix(network)
+	if err != nil {
+		return nil, fmt.Errorf("invalid network %q: %w", network, err)
+	}
+	desired = desired.Masked()
+
+	var overlapping []TunnelRouteResult
+	for _, route := range routes {
+		if route.TunnelID == tunnelID {
+			continue
+		}
+		if virtualNetworkID != "" && route.VirtualNetworkID != virtualNetworkID {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(route.Network)
+		if err != nil {
+			continue
+		}
+		if !prefix.Masked().Overlaps(desired) {
+			continue
+		}
+		overlapping = append(overlapping, route)
+	}
+	return overlapping, nil
+}
+
 // DeleteTunnelRoutesByTunnelID deletes all routes associated with a tunnel.
 // Returns the number of routes deleted and any error encountered.
 //
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVirtualNetworkParams(t *testing.T) {
@@ -354,6 +355,47 @@ func TestEmptyTunnelRouteResult(t *testing.T) {
 	assert.Empty(t, result.Comment)
 }
 
+func TestFindOverlappingTunnelRoutes(t *testing.T) {
+	routes := []TunnelRouteResult{
+		{Network: "10.0.0.0/16", TunnelID: "tunnel-1", VirtualNetworkID: "vnet-1"},
+		{Network: "10.1.0.0/16", TunnelID: "tunnel-2", VirtualNetworkID: "vnet-1"},
+		{Network: "10.0.5.0/24", TunnelID: "tunnel-3", VirtualNetworkID: "vnet-2"},
+		{Network: "192.168.1.0/24", TunnelID: "tunnel-1", VirtualNetworkID: "vnet-1"},
+	}
+
+	tests := []struct {
+		name    string
+		network string
+		vnet    string
+		tunnel  string
+		want    []string
+	}{
+		{name: "subnet of existing route", network: "10.0.4.0/24", vnet: "vnet-1", want: []string{"10.0.0.0/16"}},
+		{name: "supernet of existing routes", network: "10.0.0.0/8", vnet: "vnet-1", want: []string{"10.0.0.0/16", "10.1.0.0/16"}},
+		{name: "same CIDR on another tunnel", network: "10.0.0.0/16", vnet: "vnet-1", want: []string{"10.0.0.0/16"}},
+		{name: "routes of the same tunnel ignored", network: "192.168.0.0/16", vnet: "vnet-1", tunnel: "tunnel-1"},
+		{name: "disjoint CIDR", network: "172.16.0.0/12", vnet: "vnet-1"},
+		{name: "other virtual network ignored", network: "10.0.5.128/25", vnet: "vnet-1", want: []string{"10.0.0.0/16"}},
+		{name: "all virtual networks", network: "10.0.5.128/25", want: []string{"10.0.0.0/16", "10.0.5.0/24"}},
+		{name: "host bits ignored", network: "192.168.1.10/16", vnet: "vnet-1", want: []string{"192.168.1.0/24"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overlapping, err := FindOverlappingTunnelRoutes(routes, tt.network, tt.vnet, tt.tunnel)
+			require.NoError(t, err)
+			var got []string
+			for _, route := range overlapping {
+				got = append(got, route.Network)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := FindOverlappingTunnelRoutes(routes, "10.0.0.0", "vnet-1", "")
+	assert.Error(t, err)
+}
+
 // Helper function
 func strPtr(s string) *string {
 	return &s
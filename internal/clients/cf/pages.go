@@ -11,6 +11,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -186,13 +187,45 @@ type PagesDeploymentStage struct {
 
 // PagesDomainResult contains the result of a Pages domain operation
 type PagesDomainResult struct {
-	ID               string
-	Name             string
-	Status           string
-	ZoneTag          string
+	ID                   string
+	Name                 string
+	Status               string
+	ZoneTag              string
+	CertificateAuthority string
+	// ValidationMethod and ValidationStatus describe the validation of the SSL certificate.
 	ValidationMethod string
 	ValidationStatus string
-	CreatedOn        time.Time
+	// ValidationTXTName and ValidationTXTValue are the TXT record that validates
+	// the certificate when the validation method is txt.
+	ValidationTXTName  string
+	ValidationTXTValue string
+	// VerificationStatus is the status of the domain ownership verification.
+	VerificationStatus string
+	// ErrorMessage is the error of a failed validation or verification.
+	ErrorMessage string
+	CreatedOn    time.Time
+}
+
+// pagesDomain is a Pages domain as returned by the API. cloudflare.PagesDomain
+// omits the certificate authority, the validation record and error messages.
+type pagesDomain struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Status               string `json:"status"`
+	ZoneTag              string `json:"zone_tag"`
+	CertificateAuthority string `json:"certificate_authority"`
+	ValidationData       struct {
+		Status       string `json:"status"`
+		Method       string `json:"method"`
+		TXTName      string `json:"txt_name"`
+		TXTValue     string `json:"txt_value"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"validation_data"`
+	VerificationData struct {
+		Status       string `json:"status"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"verification_data"`
+	CreatedOn *time.Time `json:"created_on"`
 }
 
 // PagesDeploymentLogsResult contains deployment logs
@@ -350,11 +383,16 @@ func (api *API) ListPagesProjects(ctx context.Context) ([]PagesProjectResult, er
 	return results, nil
 }
 
-// PurgePagesProjectBuildCache purges the build cache for a Pages project
+// PurgePagesProjectBuildCache purges the build cache for a Pages project.
+// Purging is idempotent on the Cloudflare side. A missing project returns an
+// error wrapping ErrResourceNotFound.
 func (api *API) PurgePagesProjectBuildCache(ctx context.Context, projectName string) error {
 	if api.CloudflareClient == nil {
 		return errClientNotInitialized
 	}
+	if projectName == "" {
+		return errors.New("failed to purge build cache: project name is required")
+	}
 
 	accountID, err := api.GetAccountId(ctx)
 	if err != nil {
@@ -363,6 +401,10 @@ func (api *API) PurgePagesProjectBuildCache(ctx context.Context, projectName str
 
 	endpoint := fmt.Sprintf("/accounts/%s/pages/projects/%s/purge_build_cache", accountID, projectName)
 	if _, err := api.CloudflareClient.Raw(ctx, "POST", endpoint, nil, nil); err != nil {
+		if IsNotFoundError(err) {
+			return fmt.Errorf("failed to purge build cache: %w",
+				WrapNotFound(fmt.Sprintf("pages project %q", projectName), err))
+		}
 		return fmt.Errorf("failed to purge build cache: %w", err)
 	}
 
@@ -370,54 +412,64 @@ func (api *API) PurgePagesProjectBuildCache(ctx context.Context, projectName str
 	return nil
 }
 
-// AddPagesDomain adds a custom domain to a Pages project
-func (api *API) AddPagesDomain(ctx context.Context, projectName, domain string) (*PagesDomainResult, error) {
+// pagesDomainsEndpoint returns the endpoint of the custom domains of a Pages project.
+func (api *API) pagesDomainsEndpoint(ctx context.Context, projectName string) (string, error) {
 	if api.CloudflareClient == nil {
-		return nil, errClientNotInitialized
+		return "", errClientNotInitialized
 	}
 
 	accountID, err := api.GetAccountId(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account ID: %w", err)
+		return "", fmt.Errorf("failed to get account ID: %w", err)
 	}
 
-	params := cloudflare.PagesDomainParameters{
-		AccountID:   accountID,
-		ProjectName: projectName,
-		DomainName:  domain,
-	}
+	return fmt.Sprintf("/accounts/%s/pages/projects/%s/domains", accountID, projectName), nil
+}
 
-	domainResult, err := api.CloudflareClient.PagesAddDomain(ctx, params)
+// pagesDomainRequest sends a request for a single Pages domain and parses the response.
+func (api *API) pagesDomainRequest(ctx context.Context, method, endpoint string, data any) (*PagesDomainResult, error) {
+	resp, err := api.CloudflareClient.Raw(ctx, method, endpoint, data, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to add Pages domain: %w", err)
+		return nil, err
+	}
+
+	var domain pagesDomain
+	if err := json.Unmarshal(resp.Result, &domain); err != nil {
+		return nil, fmt.Errorf("failed to parse Pages domain response: %w", err)
 	}
 
-	return convertFromPagesDomain(domainResult), nil
+	return convertFromPagesDomain(domain), nil
 }
 
-// GetPagesDomain gets a custom domain from a Pages project
-func (api *API) GetPagesDomain(ctx context.Context, projectName, domain string) (*PagesDomainResult, error) {
-	if api.CloudflareClient == nil {
-		return nil, errClientNotInitialized
+// AddPagesDomain adds a custom domain to a Pages project
+func (api *API) AddPagesDomain(ctx context.Context, projectName, domain string) (*PagesDomainResult, error) {
+	endpoint, err := api.pagesDomainsEndpoint(ctx, projectName)
+	if err != nil {
+		return nil, err
 	}
 
-	accountID, err := api.GetAccountId(ctx)
+	result, err := api.pagesDomainRequest(ctx, http.MethodPost, endpoint, map[string]string{"name": domain})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account ID: %w", err)
+		return nil, fmt.Errorf("failed to add Pages domain: %w", err)
 	}
 
-	params := cloudflare.PagesDomainParameters{
-		AccountID:   accountID,
-		ProjectName: projectName,
-		DomainName:  domain,
+	api.Log.Info("Pages domain added", "project", projectName, "domain", domain, "status", result.Status)
+	return result, nil
+}
+
+// GetPagesDomain gets a custom domain from a Pages project
+func (api *API) GetPagesDomain(ctx context.Context, projectName, domain string) (*PagesDomainResult, error) {
+	endpoint, err := api.pagesDomainsEndpoint(ctx, projectName)
+	if err != nil {
+		return nil, err
 	}
 
-	domainResult, err := api.CloudflareClient.GetPagesDomain(ctx, params)
+	result, err := api.pagesDomainRequest(ctx, http.MethodGet, endpoint+"/"+domain, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Pages domain: %w", err)
 	}
 
-	return convertFromPagesDomain(domainResult), nil
+	return result, nil
 }
 
 // DeletePagesDomain removes a custom domain from a Pages project
@@ -449,52 +501,38 @@ func (api *API) DeletePagesDomain(ctx context.Context, projectName, domain strin
 	return nil
 }
 
-// PatchPagesDomain updates a custom domain on a Pages project
+// PatchPagesDomain retries the validation of a custom domain on a Pages project
 func (api *API) PatchPagesDomain(ctx context.Context, projectName, domain string) (*PagesDomainResult, error) {
-	if api.CloudflareClient == nil {
-		return nil, errClientNotInitialized
-	}
-
-	accountID, err := api.GetAccountId(ctx)
+	endpoint, err := api.pagesDomainsEndpoint(ctx, projectName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account ID: %w", err)
-	}
-
-	params := cloudflare.PagesDomainParameters{
-		AccountID:   accountID,
-		ProjectName: projectName,
-		DomainName:  domain,
+		return nil, err
 	}
 
-	domainResult, err := api.CloudflareClient.PagesPatchDomain(ctx, params)
+	result, err := api.pagesDomainRequest(ctx, http.MethodPatch, endpoint+"/"+domain, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to patch Pages domain: %w", err)
 	}
 
-	return convertFromPagesDomain(domainResult), nil
+	return result, nil
 }
 
 // ListPagesDomains lists all custom domains for a Pages project
 func (api *API) ListPagesDomains(ctx context.Context, projectName string) ([]PagesDomainResult, error) {
-	if api.CloudflareClient == nil {
-		return nil, errClientNotInitialized
-	}
-
-	accountID, err := api.GetAccountId(ctx)
+	endpoint, err := api.pagesDomainsEndpoint(ctx, projectName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get account ID: %w", err)
-	}
-
-	params := cloudflare.PagesDomainsParameters{
-		AccountID:   accountID,
-		ProjectName: projectName,
+		return nil, err
 	}
 
-	domains, err := api.CloudflareClient.GetPagesDomains(ctx, params)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodGet, endpoint, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list Pages domains: %w", err)
 	}
 
+	var domains []pagesDomain
+	if err := json.Unmarshal(resp.Result, &domains); err != nil {
+		return nil, fmt.Errorf("failed to parse Pages domains response: %w", err)
+	}
+
 	results := make([]PagesDomainResult, len(domains))
 	for i, d := range domains {
 		results[i] = *convertFromPagesDomain(d)
@@ -984,14 +1022,22 @@ func convertFromPagesProject(project cloudflare.PagesProject) *PagesProjectResul
 	return result
 }
 
-func convertFromPagesDomain(domain cloudflare.PagesDomain) *PagesDomainResult {
+func convertFromPagesDomain(domain pagesDomain) *PagesDomainResult {
 	result := &PagesDomainResult{
-		ID:               domain.ID,
-		Name:             domain.Name,
-		Status:           domain.Status,
-		ZoneTag:          domain.ZoneTag,
-		ValidationMethod: domain.ValidationData.Method,
-		ValidationStatus: domain.ValidationData.Status,
+		ID:                   domain.ID,
+		Name:                 domain.Name,
+		Status:               domain.Status,
+		ZoneTag:              domain.ZoneTag,
+		CertificateAuthority: domain.CertificateAuthority,
+		ValidationMethod:     domain.ValidationData.Method,
+		ValidationStatus:     domain.ValidationData.Status,
+		ValidationTXTName:    domain.ValidationData.TXTName,
+		ValidationTXTValue:   domain.ValidationData.TXTValue,
+		VerificationStatus:   domain.VerificationData.Status,
+		ErrorMessage:         domain.VerificationData.ErrorMessage,
+	}
+	if result.ErrorMessage == "" {
+		result.ErrorMessage = domain.ValidationData.ErrorMessage
 	}
 
 	if domain.CreatedOn != nil {
@@ -1022,6 +1068,17 @@ func convertFromPagesDeployment(deployment cloudflare.PagesProjectDeployment) *P
 		result.ModifiedOn = *deployment.ModifiedOn
 	}
 
+	if trigger := deployment.DeploymentTrigger; trigger.Type != "" || trigger.Metadata != nil {
+		result.DeploymentTrigger = &PagesDeploymentTrigger{Type: trigger.Type}
+		if trigger.Metadata != nil {
+			result.DeploymentTrigger.Metadata = &PagesDeploymentTriggerMetadata{
+				Branch:        trigger.Metadata.Branch,
+				CommitHash:    trigger.Metadata.CommitHash,
+				CommitMessage: trigger.Metadata.CommitMessage,
+			}
+		}
+	}
+
 	// Convert stages
 	for _, stage := range deployment.Stages {
 		s := PagesDeploymentStage{
@@ -1280,7 +1337,7 @@ func (api *API) pagesGetUploadToken(ctx context.Context, accountID, projectName
 
 	body, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
+		return "", parseAPIErrorResponse(resp, body)
 	}
 
 	var result struct {
@@ -1315,7 +1372,7 @@ func (api *API) pagesCheckMissing(ctx context.Context, jwt string, hashes []stri
 
 	respBody, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody))
+		return nil, parseAPIErrorResponse(resp, respBody)
 	}
 
 	var result struct {
@@ -1348,7 +1405,7 @@ func (api *API) pagesUploadFiles(ctx context.Context, jwt string, payloads []pag
 
 	respBody, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody))
+		return parseAPIErrorResponse(resp, respBody)
 	}
 
 	return nil
@@ -1374,7 +1431,7 @@ func (api *API) pagesUpsertHashes(ctx context.Context, jwt string, hashes []stri
 
 	respBody, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody))
+		return parseAPIErrorResponse(resp, respBody)
 	}
 
 	return nil
@@ -1508,7 +1565,7 @@ func (api *API) pagesCreateDeployment(
 
 	respBody, _ := io.ReadAll(resp.Body)
 	if resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(respBody))
+		return nil, parseAPIErrorResponse(resp, respBody)
 	}
 
 	var response struct {
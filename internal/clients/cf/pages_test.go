@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newPurgeBuildCacheTestAPI(t *testing.T, status int, body string) (*API, *string) {
+	t.Helper()
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		path = req.Method + " " + req.URL.Path
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(status)
+		_, _ = rw.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+	return &API{Log: logr.Discard(), CloudflareClient: client, ValidAccountId: "account-id"}, &path
+}
+
+func TestPurgePagesProjectBuildCache(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		api, path := newPurgeBuildCacheTestAPI(t, http.StatusOK,
+			`{"success":true,"errors":[],"messages":[],"result":null}`)
+
+		require.NoError(t, api.PurgePagesProjectBuildCache(context.Background(), "my-site"))
+		assert.Equal(t, "POST /accounts/account-id/pages/projects/my-site/purge_build_cache", *path)
+	})
+
+	t.Run("missing project", func(t *testing.T) {
+		api, _ := newPurgeBuildCacheTestAPI(t, http.StatusNotFound,
+			`{"success":false,"errors":[{"code":8000007,"message":"Project not found."}],"messages":[],"result":null}`)
+
+		err := api.PurgePagesProjectBuildCache(context.Background(), "missing")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrResourceNotFound))
+		assert.Contains(t, err.Error(), `pages project "missing"`)
+	})
+
+	t.Run("empty project name", func(t *testing.T) {
+		api, path := newPurgeBuildCacheTestAPI(t, http.StatusOK, `{}`)
+
+		require.Error(t, api.PurgePagesProjectBuildCache(context.Background(), ""))
+		assert.Empty(t, *path)
+	})
+}
+
+func TestPagesDomainCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	added, err := api.AddPagesDomain(ctx, "my-app", "www.example.com")
+	require.NoError(t, err)
+	require.NotEmpty(t, added.ID)
+	assert.Equal(t, "www.example.com", added.Name)
+	assert.Equal(t, "pending", added.Status)
+	assert.Equal(t, "pending", added.VerificationStatus)
+	assert.Equal(t, "txt", added.ValidationMethod)
+	assert.Equal(t, "_cf-custom-hostname.www.example.com", added.ValidationTXTName)
+	assert.NotEmpty(t, added.ValidationTXTValue)
+	assert.Equal(t, "google", added.CertificateAuthority)
+
+	require.True(t, mock.Store().SetPagesDomainStatus("my-app", "www.example.com", "active", "active", "active"))
+	got, err := api.GetPagesDomain(ctx, "my-app", "www.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, added.ID, got.ID)
+	assert.Equal(t, "active", got.Status)
+	assert.Equal(t, "active", got.VerificationStatus)
+	assert.Equal(t, "active", got.ValidationStatus)
+
+	patched, err := api.PatchPagesDomain(ctx, "my-app", "www.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, added.ID, patched.ID)
+
+	domains, err := api.ListPagesDomains(ctx, "my-app")
+	require.NoError(t, err)
+	require.Len(t, domains, 1)
+	assert.Equal(t, "www.example.com", domains[0].Name)
+
+	require.NoError(t, api.DeletePagesDomain(ctx, "my-app", "www.example.com"))
+	_, err = api.GetPagesDomain(ctx, "my-app", "www.example.com")
+	assert.True(t, IsNotFoundError(err))
+
+	// Deletion is idempotent
+	assert.NoError(t, api.DeletePagesDomain(ctx, "my-app", "www.example.com"))
+}
+
+func TestGetPagesDomainErrorMessage(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	_, err := api.AddPagesDomain(ctx, "my-app", "www.example.com")
+	require.NoError(t, err)
+	require.True(t, mock.Store().UpdatePagesDomain("my-app", "www.example.com", func(d *models.PagesDomain) {
+		d.Status = "error"
+		d.ValidationData.Status = "error"
+		d.ValidationData.ErrorMessage = "CAA records block issuance"
+	}))
+
+	got, err := api.GetPagesDomain(ctx, "my-app", "www.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "error", got.Status)
+	assert.Equal(t, "CAA records block issuance", got.ErrorMessage)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+const (
+	// listPageSize is the number of items requested per page by listAllPages.
+	listPageSize = 50
+	// maxListPages bounds listAllPages so an API that keeps reporting more pages
+	// cannot make it loop forever.
+	maxListPages = 1000
+)
+
+// listPageFunc fetches one page of a paginated list. It returns the items of the page
+// and the pagination info of the response, which may be nil.
+type listPageFunc[T any] func(ctx context.Context, page cloudflare.ResultInfo) ([]T, *cloudflare.ResultInfo, error)
+
+// listAllPages fetches every page of a paginated list and returns all items.
+// It stops when the pagination info reports no more pages or a page is empty.
+func listAllPages[T any](ctx context.Context, fetch listPageFunc[T]) ([]T, error) {
+	var all []T
+	for page := 1; page <= maxListPages; page++ {
+		items, info, err := fetch(ctx, cloudflare.ResultInfo{Page: page, PerPage: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if len(items) == 0 || info == nil || !info.HasMorePages() {
+			return all, nil
+		}
+	}
+	return nil, fmt.Errorf("list did not end after %d pages", maxListPages)
+}
+
+// rawListPage returns a listPageFunc for list endpoints whose cloudflare-go method
+// does not accept pagination parameters. The endpoint may carry filter parameters
+// in its query string; the pagination parameters are appended to them.
+func rawListPage[T any](client *cloudflare.API, endpoint string) listPageFunc[T] {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return func(ctx context.Context, page cloudflare.ResultInfo) ([]T, *cloudflare.ResultInfo, error) {
+		uri := fmt.Sprintf("%s%spage=%d&per_page=%d", endpoint, separator, page.Page, page.PerPage)
+		resp, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var items []T
+		if err := json.Unmarshal(resp.Result, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %s: %w", endpoint, err)
+		}
+		return items, resp.ResultInfo, nil
+	}
+}
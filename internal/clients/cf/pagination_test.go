@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// paginatedItemCount spans three pages of listPageSize.
+const paginatedItemCount = 2*listPageSize + 10
+
+func TestListAllPages(t *testing.T) {
+	t.Run("follows pages until the last", func(t *testing.T) {
+		var pages []int
+		items, err := listAllPages(context.Background(), func(
+			_ context.Context, page cloudflare.ResultInfo,
+		) ([]int, *cloudflare.ResultInfo, error) {
+			pages = append(pages, page.Page)
+			return []int{page.Page}, &cloudflare.ResultInfo{Page: page.Page, PerPage: page.PerPage, TotalPages: 3}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, items)
+		assert.Equal(t, []int{1, 2, 3}, pages)
+	})
+
+	t.Run("stops without pagination info", func(t *testing.T) {
+		calls := 0
+		items, err := listAllPages(context.Background(), func(
+			context.Context, cloudflare.ResultInfo,
+		) ([]string, *cloudflare.ResultInfo, error) {
+			calls++
+			return []string{"a", "b"}, nil, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, items)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops on an empty page", func(t *testing.T) {
+		calls := 0
+		_, err := listAllPages(context.Background(), func(
+			context.Context, cloudflare.ResultInfo,
+		) ([]string, *cloudflare.ResultInfo, error) {
+			calls++
+			return nil, &cloudflare.ResultInfo{Page: 1, TotalPages: 5}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns fetch errors", func(t *testing.T) {
+		fetchErr := errors.New("boom")
+		_, err := listAllPages(context.Background(), func(
+			context.Context, cloudflare.ResultInfo,
+		) ([]string, *cloudflare.ResultInfo, error) {
+			return nil, nil, fetchErr
+		})
+		assert.ErrorIs(t, err, fetchErr)
+	})
+}
+
+func TestGetAccessGroupByNameSearchesAllPages(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	for i := range paginatedItemCount {
+		mock.Store().CreateAccessGroup(&models.AccessGroup{ID: fmt.Sprintf("group-%03d", i), Name: fmt.Sprintf("group-%03d", i)})
+	}
+
+	group, err := api.GetAccessGroupByName(context.Background(), "group-105")
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	assert.Equal(t, "group-105", group.ID)
+	assert.Equal(t, 3, mock.CountRequests("GET", "/access/groups"))
+}
+
+func TestGetAccessServiceTokenByNameSearchesAllPages(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	for i := range paginatedItemCount {
+		mock.Store().CreateAccessServiceToken(&models.AccessServiceToken{
+			ID: fmt.Sprintf("token-%03d", i), Name: fmt.Sprintf("token-%03d", i), ClientID: "client",
+		})
+	}
+
+	token, err := api.GetAccessServiceTokenByName(context.Background(), "token-109")
+	require.NoError(t, err)
+	require.NotNil(t, token)
+	assert.Equal(t, "token-109", token.ID)
+
+	missing, err := api.GetAccessServiceTokenByName(context.Background(), "token-999")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestListDevicePostureRulesByNameSearchesAllPages(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	for i := range paginatedItemCount {
+		mock.Store().CreateDevicePostureRule(&models.DevicePostureRule{
+			ID: fmt.Sprintf("rule-%03d", i), Name: fmt.Sprintf("rule-%03d", i), Type: "firewall",
+		})
+	}
+
+	rule, err := api.ListDevicePostureRulesByName(context.Background(), "rule-070")
+	require.NoError(t, err)
+	require.NotNil(t, rule)
+	assert.Equal(t, "rule-070", rule.ID)
+	assert.Equal(t, 3, mock.CountRequests("GET", "/devices/posture"))
+}
+
+func TestMissingAccessTagsSearchesAllPages(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	for i := range paginatedItemCount {
+		mock.Store().CreateAccessTag(&models.AccessTag{Name: fmt.Sprintf("tag-%03d", i)})
+	}
+
+	missing, err := api.MissingAccessTags(context.Background(), []string{"tag-001", "tag-105", "tag-999"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tag-999"}, missing)
+	assert.Equal(t, 3, mock.CountRequests("GET", "/access/tags"))
+}
+
+func TestListTunnelRoutesSearchesAllPagesAndSkipsDeleted(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	for i := range paginatedItemCount {
+		mock.Store().CreateTunnelRoute(&models.TunnelRoute{
+			Network: fmt.Sprintf("10.%d.%d.0/24", i/256, i%256), TunnelID: "tunnel-1",
+		})
+	}
+	deletedAt := time.Now()
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{Network: "192.168.0.0/16", TunnelID: "tunnel-2", DeletedAt: &deletedAt})
+
+	routes, err := api.ListTunnelRoutes(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, routes, paginatedItemCount)
+	for _, route := range routes {
+		assert.NotEqual(t, "192.168.0.0/16", route.Network)
+	}
+	assert.Equal(t, 3, mock.CountRequests("GET", "/teamnet/routes"))
+}
@@ -12,41 +12,139 @@ import (
 
 // Queue represents a Cloudflare Queue
 type Queue struct {
-	ID         string `json:"queue_id"`
-	Name       string `json:"queue_name"`
-	CreatedOn  string `json:"created_on,omitempty"`
-	ModifiedOn string `json:"modified_on,omitempty"`
+	ID                  string          `json:"queue_id"`
+	Name                string          `json:"queue_name"`
+	CreatedOn           string          `json:"created_on,omitempty"`
+	ModifiedOn          string          `json:"modified_on,omitempty"`
+	Settings            *QueueSettings  `json:"settings,omitempty"`
+	ConsumersTotalCount int             `json:"consumers_total_count,omitempty"`
+	Consumers           []QueueConsumer `json:"consumers,omitempty"`
 }
 
-// GetQueueID retrieves the queue ID for a given queue name
-func (api *API) GetQueueID(ctx context.Context, queueName string) (string, error) {
+// QueueSettings contains the settings of a queue
+type QueueSettings struct {
+	// DeliveryDelay is the number of seconds messages are delayed before delivery
+	DeliveryDelay *int `json:"delivery_delay,omitempty"`
+	// MessageRetentionPeriod is the number of seconds messages are retained
+	MessageRetentionPeriod *int `json:"message_retention_period,omitempty"`
+}
+
+// QueueParams contains parameters for creating or updating a queue
+type QueueParams struct {
+	Name     string         `json:"queue_name"`
+	Settings *QueueSettings `json:"settings,omitempty"`
+}
+
+// Queue consumer types
+const (
+	QueueConsumerTypeWorker   = "worker"
+	QueueConsumerTypeHTTPPull = "http_pull"
+)
+
+// QueueConsumer represents a consumer of a Cloudflare Queue.
+// Requests name the Worker of a consumer in ScriptName, responses in Script;
+// the methods of API copy Script to ScriptName.
+type QueueConsumer struct {
+	ID              string                 `json:"consumer_id,omitempty"`
+	Type            string                 `json:"type"`
+	ScriptName      string                 `json:"script_name,omitempty"`
+	Script          string                 `json:"script,omitempty"`
+	DeadLetterQueue string                 `json:"dead_letter_queue,omitempty"`
+	Settings        *QueueConsumerSettings `json:"settings,omitempty"`
+	CreatedOn       string                 `json:"created_on,omitempty"`
+}
+
+// QueueConsumerSettings contains the delivery settings of a queue consumer
+type QueueConsumerSettings struct {
+	BatchSize           *int `json:"batch_size,omitempty"`
+	MaxRetries          *int `json:"max_retries,omitempty"`
+	MaxWaitTimeMs       *int `json:"max_wait_time_ms,omitempty"`
+	MaxConcurrency      *int `json:"max_concurrency,omitempty"`
+	VisibilityTimeoutMs *int `json:"visibility_timeout_ms,omitempty"`
+	RetryDelay          *int `json:"retry_delay,omitempty"`
+}
+
+// normalize copies the Worker name of a consumer response to ScriptName.
+func (c *QueueConsumer) normalize() {
+	if c.ScriptName == "" {
+		c.ScriptName = c.Script
+	}
+	c.Script = ""
+}
+
+// CreateQueue creates a new queue
+func (api *API) CreateQueue(ctx context.Context, params QueueParams) (*Queue, error) {
 	if api.CloudflareClient == nil {
-		return "", errClientNotInitialized
+		return nil, errClientNotInitialized
 	}
 
 	accountID, err := api.GetAccountId(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get account ID: %w", err)
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
 	}
 
 	endpoint := fmt.Sprintf("/accounts/%s/queues", accountID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodPost, endpoint, params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue: %w", err)
+	}
+
+	var queue Queue
+	if err := json.Unmarshal(resp.Result, &queue); err != nil {
+		return nil, fmt.Errorf("failed to parse queue response: %w", err)
+	}
+
+	return &queue, nil
+}
+
+// GetQueue retrieves a queue by ID
+func (api *API) GetQueue(ctx context.Context, queueID string) (*Queue, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s", accountID, queueID)
 	resp, err := api.CloudflareClient.Raw(ctx, http.MethodGet, endpoint, nil, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to list queues: %w", err)
+		return nil, fmt.Errorf("failed to get queue: %w", err)
 	}
 
-	var queues []Queue
-	if err := json.Unmarshal(resp.Result, &queues); err != nil {
-		return "", fmt.Errorf("failed to parse queues response: %w", err)
+	var queue Queue
+	if err := json.Unmarshal(resp.Result, &queue); err != nil {
+		return nil, fmt.Errorf("failed to parse queue response: %w", err)
 	}
 
-	for _, q := range queues {
-		if q.Name == queueName {
-			return q.ID, nil
+	return &queue, nil
+}
+
+// GetQueueByName retrieves a queue by name
+func (api *API) GetQueueByName(ctx context.Context, queueName string) (*Queue, error) {
+	queues, err := api.ListQueues(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range queues {
+		if queues[i].Name == queueName {
+			return &queues[i], nil
 		}
 	}
 
-	return "", fmt.Errorf("queue not found: %s", queueName)
+	return nil, fmt.Errorf("%w: queue %s", ErrResourceNotFound, queueName)
+}
+
+// GetQueueID retrieves the queue ID for a given queue name
+func (api *API) GetQueueID(ctx context.Context, queueName string) (string, error) {
+	queue, err := api.GetQueueByName(ctx, queueName)
+	if err != nil {
+		return "", err
+	}
+	return queue.ID, nil
 }
 
 // ListQueues lists all Cloudflare Queues
@@ -73,3 +171,160 @@ func (api *API) ListQueues(ctx context.Context) ([]Queue, error) {
 
 	return queues, nil
 }
+
+// UpdateQueue updates the settings of a queue
+func (api *API) UpdateQueue(ctx context.Context, queueID string, params QueueParams) (*Queue, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s", accountID, queueID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodPut, endpoint, params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update queue: %w", err)
+	}
+
+	var queue Queue
+	if err := json.Unmarshal(resp.Result, &queue); err != nil {
+		return nil, fmt.Errorf("failed to parse queue response: %w", err)
+	}
+
+	return &queue, nil
+}
+
+// DeleteQueue deletes a queue.
+// This method is idempotent - returns nil if the queue is already deleted.
+func (api *API) DeleteQueue(ctx context.Context, queueID string) error {
+	if api.CloudflareClient == nil {
+		return errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s", accountID, queueID)
+	if _, err := api.CloudflareClient.Raw(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		if IsNotFoundError(err) {
+			api.Log.Info("Queue already deleted (not found)", "queueId", queueID)
+			return nil
+		}
+		return fmt.Errorf("failed to delete queue: %w", err)
+	}
+
+	api.Log.Info("Queue deleted", "queueId", queueID)
+	return nil
+}
+
+// ListQueueConsumers lists the consumers of a queue
+func (api *API) ListQueueConsumers(ctx context.Context, queueID string) ([]QueueConsumer, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s/consumers", accountID, queueID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queue consumers: %w", err)
+	}
+
+	var consumers []QueueConsumer
+	if err := json.Unmarshal(resp.Result, &consumers); err != nil {
+		return nil, fmt.Errorf("failed to parse queue consumers response: %w", err)
+	}
+	for i := range consumers {
+		consumers[i].normalize()
+	}
+
+	return consumers, nil
+}
+
+// CreateQueueConsumer adds a consumer to a queue
+func (api *API) CreateQueueConsumer(ctx context.Context, queueID string, consumer QueueConsumer) (*QueueConsumer, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s/consumers", accountID, queueID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodPost, endpoint, consumer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create queue consumer: %w", err)
+	}
+
+	var created QueueConsumer
+	if err := json.Unmarshal(resp.Result, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse queue consumer response: %w", err)
+	}
+	created.normalize()
+
+	return &created, nil
+}
+
+// UpdateQueueConsumer updates a consumer of a queue
+func (api *API) UpdateQueueConsumer(
+	ctx context.Context, queueID string, consumer QueueConsumer,
+) (*QueueConsumer, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s/consumers/%s", accountID, queueID, consumer.ID)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodPut, endpoint, consumer, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update queue consumer: %w", err)
+	}
+
+	var updated QueueConsumer
+	if err := json.Unmarshal(resp.Result, &updated); err != nil {
+		return nil, fmt.Errorf("failed to parse queue consumer response: %w", err)
+	}
+	updated.normalize()
+
+	return &updated, nil
+}
+
+// DeleteQueueConsumer removes a consumer from a queue.
+// This method is idempotent - returns nil if the consumer is already deleted.
+func (api *API) DeleteQueueConsumer(ctx context.Context, queueID, consumerID string) error {
+	if api.CloudflareClient == nil {
+		return errClientNotInitialized
+	}
+
+	accountID, err := api.GetAccountId(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/accounts/%s/queues/%s/consumers/%s", accountID, queueID, consumerID)
+	if _, err := api.CloudflareClient.Raw(ctx, http.MethodDelete, endpoint, nil, nil); err != nil {
+		if IsNotFoundError(err) {
+			api.Log.Info("Queue consumer already deleted (not found)", "queueId", queueID, "consumerId", consumerID)
+			return nil
+		}
+		return fmt.Errorf("failed to delete queue consumer: %w", err)
+	}
+
+	api.Log.Info("Queue consumer deleted", "queueId", queueID, "consumerId", consumerID)
+	return nil
+}
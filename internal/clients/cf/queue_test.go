@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+)
+
+func newQueueTestAPI(t *testing.T) *API {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	return &API{Log: logr.Discard(), CloudflareClient: client, ValidAccountId: "test-account-id"}
+}
+
+func TestQueueCRUD(t *testing.T) {
+	api := newQueueTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateQueue(ctx, QueueParams{
+		Name:     "events",
+		Settings: &QueueSettings{DeliveryDelay: ptr.To(30)},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, "events", created.Name)
+	assert.Equal(t, ptr.To(30), created.Settings.DeliveryDelay)
+
+	got, err := api.GetQueue(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, got.ID)
+
+	byName, err := api.GetQueueByName(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+	id, err := api.GetQueueID(ctx, "events")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, id)
+
+	queues, err := api.ListQueues(ctx)
+	require.NoError(t, err)
+	assert.Len(t, queues, 1)
+
+	updated, err := api.UpdateQueue(ctx, created.ID, QueueParams{
+		Name:     "events",
+		Settings: &QueueSettings{MessageRetentionPeriod: ptr.To(3600)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(3600), updated.Settings.MessageRetentionPeriod)
+
+	require.NoError(t, api.DeleteQueue(ctx, created.ID))
+	_, err = api.GetQueue(ctx, created.ID)
+	assert.True(t, IsNotFoundError(err))
+
+	// Deletion is idempotent
+	assert.NoError(t, api.DeleteQueue(ctx, created.ID))
+}
+
+func TestGetQueueByNameNotFound(t *testing.T) {
+	api := newQueueTestAPI(t)
+
+	_, err := api.GetQueueByName(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestQueueConsumerCRUD(t *testing.T) {
+	api := newQueueTestAPI(t)
+	ctx := context.Background()
+	queue, err := api.CreateQueue(ctx, QueueParams{Name: "events"})
+	require.NoError(t, err)
+
+	created, err := api.CreateQueueConsumer(ctx, queue.ID, QueueConsumer{
+		Type:       QueueConsumerTypeWorker,
+		ScriptName: "processor",
+		Settings:   &QueueConsumerSettings{BatchSize: ptr.To(10)},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+	assert.Equal(t, "processor", created.ScriptName, "the script of the response is normalized")
+	assert.Empty(t, created.Script)
+
+	pull, err := api.CreateQueueConsumer(ctx, queue.ID, QueueConsumer{Type: QueueConsumerTypeHTTPPull})
+	require.NoError(t, err)
+
+	consumers, err := api.ListQueueConsumers(ctx, queue.ID)
+	require.NoError(t, err)
+	require.Len(t, consumers, 2)
+	assert.Equal(t, "processor", consumers[0].ScriptName)
+
+	created.DeadLetterQueue = "events-dlq"
+	updated, err := api.UpdateQueueConsumer(ctx, queue.ID, *created)
+	require.NoError(t, err)
+	assert.Equal(t, "events-dlq", updated.DeadLetterQueue)
+	assert.Equal(t, "processor", updated.ScriptName)
+
+	require.NoError(t, api.DeleteQueueConsumer(ctx, queue.ID, pull.ID))
+	consumers, err = api.ListQueueConsumers(ctx, queue.ID)
+	require.NoError(t, err)
+	assert.Len(t, consumers, 1)
+
+	// Deletion is idempotent
+	assert.NoError(t, api.DeleteQueueConsumer(ctx, queue.ID, pull.ID))
+}
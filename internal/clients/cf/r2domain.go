@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // R2CustomDomain represents a custom domain attached to an R2 bucket
@@ -26,6 +27,58 @@ type R2DomainStatus struct {
 	SSL       string `json:"ssl,omitempty"`
 }
 
+// R2 custom domain ownership and SSL statuses, as returned by the API.
+// Ownership additionally reports R2DomainStatusBlocked, SSL additionally
+// reports R2DomainStatusInitializing.
+const (
+	R2DomainStatusPending      = "pending"
+	R2DomainStatusInitializing = "initializing"
+	R2DomainStatusActive       = "active"
+	R2DomainStatusDeactivated  = "deactivated"
+	R2DomainStatusBlocked      = "blocked"
+	R2DomainStatusError        = "error"
+	R2DomainStatusUnknown      = "unknown"
+)
+
+// normalizeR2DomainStatus lowercases a status and maps an empty one to
+// R2DomainStatusUnknown.
+func normalizeR2DomainStatus(status string) string {
+	status = strings.ToLower(strings.TrimSpace(status))
+	if status == "" {
+		return R2DomainStatusUnknown
+	}
+	return status
+}
+
+// normalize normalizes the ownership and SSL statuses of the domain.
+func (d *R2CustomDomain) normalize() *R2CustomDomain {
+	d.Status.Ownership = normalizeR2DomainStatus(d.Status.Ownership)
+	d.Status.SSL = normalizeR2DomainStatus(d.Status.SSL)
+	return d
+}
+
+// OwnershipVerified reports whether Cloudflare verified ownership of the domain.
+func (s R2DomainStatus) OwnershipVerified() bool {
+	return s.Ownership == R2DomainStatusActive
+}
+
+// CertificateIssued reports whether the SSL certificate of the domain is active.
+func (s R2DomainStatus) CertificateIssued() bool {
+	return s.SSL == R2DomainStatusActive
+}
+
+// Failed reports whether ownership or SSL reached a status that does not
+// resolve by waiting.
+func (s R2DomainStatus) Failed() bool {
+	for _, status := range []string{s.Ownership, s.SSL} {
+		switch status {
+		case R2DomainStatusBlocked, R2DomainStatusDeactivated, R2DomainStatusError:
+			return true
+		}
+	}
+	return false
+}
+
 // R2CustomDomainParams contains parameters for attaching a custom domain
 type R2CustomDomainParams struct {
 	Domain  string `json:"domain"`
@@ -36,15 +89,10 @@ type R2CustomDomainParams struct {
 
 // r2CustomDomainResponse is the API response for custom domain operations
 type r2CustomDomainResponse struct {
-	Result   R2CustomDomain `json:"result"`
-	Success  bool           `json:"success"`
-	Errors   []apiError     `json:"errors"`
-	Messages []string       `json:"messages"`
-}
-
-type apiError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Result   R2CustomDomain          `json:"result"`
+	Success  bool                    `json:"success"`
+	Errors   []CloudflareErrorDetail `json:"errors"`
+	Messages []string                `json:"messages"`
 }
 
 // AttachR2CustomDomain attaches a custom domain to an R2 bucket
@@ -72,7 +120,7 @@ func (api *API) AttachR2CustomDomain(
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	return &result.Result, nil
+	return result.Result.normalize(), nil
 }
 
 // GetR2CustomDomain retrieves a custom domain configuration for an R2 bucket
@@ -103,7 +151,7 @@ func (api *API) GetR2CustomDomain(
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	return &result, nil
+	return result.normalize(), nil
 }
 
 // ListR2CustomDomains lists all custom domains for an R2 bucket
@@ -130,6 +178,9 @@ func (api *API) ListR2CustomDomains(
 	if err := json.Unmarshal(resp.Result, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	for i := range result {
+		result[i].normalize()
+	}
 
 	return result, nil
 }
@@ -162,7 +213,7 @@ func (api *API) UpdateR2CustomDomain(
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
-	return &result, nil
+	return result.normalize(), nil
 }
 
 // DeleteR2CustomDomain removes a custom domain from an R2 bucket.
@@ -5,7 +5,9 @@ package cf
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -25,6 +27,9 @@ type RegistrarDomainInfo struct {
 	Locked            bool
 	TransferInStatus  string // Combined transfer status
 	CanCancelTransfer bool
+	AutoRenew         bool
+	Privacy           bool
+	NameServers       []string
 	RegistrantContact *RegistrantContactInfo
 }
 
@@ -45,12 +50,76 @@ type RegistrantContactInfo struct {
 	Fax          string
 }
 
-// RegistrarDomainConfig contains domain configuration
+// RegistrarDomainConfig contains domain configuration.
+// Nil fields are left unchanged.
 type RegistrarDomainConfig struct {
-	NameServers []string
-	Privacy     bool
-	Locked      bool
-	AutoRenew   bool
+	NameServers []string `json:"name_servers,omitempty"`
+	Privacy     *bool    `json:"privacy,omitempty"`
+	Locked      *bool    `json:"locked,omitempty"`
+	AutoRenew   *bool    `json:"auto_renew,omitempty"`
+}
+
+// IsEmpty reports whether the configuration changes nothing.
+func (c *RegistrarDomainConfig) IsEmpty() bool {
+	return c.NameServers == nil && c.Privacy == nil && c.Locked == nil && c.AutoRenew == nil
+}
+
+// registrarDomain is a registrar domain with the settings the SDK does not decode.
+type registrarDomain struct {
+	cloudflare.RegistrarDomain
+	AutoRenew   bool     `json:"auto_renew"`
+	Privacy     bool     `json:"privacy"`
+	NameServers []string `json:"name_servers"`
+}
+
+// toRegistrarDomainInfo converts a registrar domain API result.
+func toRegistrarDomainInfo(domain *registrarDomain) *RegistrarDomainInfo {
+	info := &RegistrarDomainInfo{
+		ID:                domain.ID,
+		Available:         domain.Available,
+		SupportedTLD:      domain.SupportedTLD,
+		CanRegister:       domain.CanRegister,
+		CurrentRegistrar:  domain.CurrentRegistrar,
+		ExpiresAt:         domain.ExpiresAt,
+		CreatedAt:         domain.CreatedAt,
+		UpdatedAt:         domain.UpdatedAt,
+		RegistryStatuses:  domain.RegistryStatuses,
+		Locked:            domain.Locked,
+		TransferInStatus:  getTransferStatus(domain.TransferIn),
+		CanCancelTransfer: domain.TransferIn.CanCancelTransfer,
+		AutoRenew:         domain.AutoRenew,
+		Privacy:           domain.Privacy,
+		NameServers:       domain.NameServers,
+	}
+
+	if domain.RegistrantContact.ID != "" {
+		info.RegistrantContact = &RegistrantContactInfo{
+			ID:           domain.RegistrantContact.ID,
+			FirstName:    domain.RegistrantContact.FirstName,
+			LastName:     domain.RegistrantContact.LastName,
+			Organization: domain.RegistrantContact.Organization,
+			Address:      domain.RegistrantContact.Address,
+			Address2:     domain.RegistrantContact.Address2,
+			City:         domain.RegistrantContact.City,
+			State:        domain.RegistrantContact.State,
+			Zip:          domain.RegistrantContact.Zip,
+			Country:      domain.RegistrantContact.Country,
+			Phone:        domain.RegistrantContact.Phone,
+			Email:        domain.RegistrantContact.Email,
+			Fax:          domain.RegistrantContact.Fax,
+		}
+	}
+
+	return info
+}
+
+// parseRegistrarDomain parses a registrar domain API result.
+func parseRegistrarDomain(result json.RawMessage) (*RegistrarDomainInfo, error) {
+	var domain registrarDomain
+	if err := json.Unmarshal(result, &domain); err != nil {
+		return nil, fmt.Errorf("failed to parse registrar domain: %w", err)
+	}
+	return toRegistrarDomainInfo(&domain), nil
 }
 
 const transferStepComplete = "complete"
@@ -90,7 +159,8 @@ func checkTransferStep(status, stepName string) (string, bool) {
 	return "", false
 }
 
-// GetRegistrarDomain retrieves information about a registered domain
+// GetRegistrarDomain retrieves information about a registered domain.
+// The SDK does not decode the auto-renew and nameserver settings, so the domain is read directly.
 func (api *API) GetRegistrarDomain(ctx context.Context, domainName string) (*RegistrarDomainInfo, error) {
 	if api.CloudflareClient == nil {
 		return nil, errClientNotInitialized
@@ -101,45 +171,13 @@ func (api *API) GetRegistrarDomain(ctx context.Context, domainName string) (*Reg
 		return nil, fmt.Errorf("failed to get account ID: %w", err)
 	}
 
-	domain, err := api.CloudflareClient.RegistrarDomain(ctx, accountID, domainName)
+	endpoint := fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domainName)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodGet, endpoint, nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get registrar domain: %w", err)
 	}
 
-	info := &RegistrarDomainInfo{
-		ID:                domain.ID,
-		Available:         domain.Available,
-		SupportedTLD:      domain.SupportedTLD,
-		CanRegister:       domain.CanRegister,
-		CurrentRegistrar:  domain.CurrentRegistrar,
-		ExpiresAt:         domain.ExpiresAt,
-		CreatedAt:         domain.CreatedAt,
-		UpdatedAt:         domain.UpdatedAt,
-		RegistryStatuses:  domain.RegistryStatuses,
-		Locked:            domain.Locked,
-		TransferInStatus:  getTransferStatus(domain.TransferIn),
-		CanCancelTransfer: domain.TransferIn.CanCancelTransfer,
-	}
-
-	if domain.RegistrantContact.ID != "" {
-		info.RegistrantContact = &RegistrantContactInfo{
-			ID:           domain.RegistrantContact.ID,
-			FirstName:    domain.RegistrantContact.FirstName,
-			LastName:     domain.RegistrantContact.LastName,
-			Organization: domain.RegistrantContact.Organization,
-			Address:      domain.RegistrantContact.Address,
-			Address2:     domain.RegistrantContact.Address2,
-			City:         domain.RegistrantContact.City,
-			State:        domain.RegistrantContact.State,
-			Zip:          domain.RegistrantContact.Zip,
-			Country:      domain.RegistrantContact.Country,
-			Phone:        domain.RegistrantContact.Phone,
-			Email:        domain.RegistrantContact.Email,
-			Fax:          domain.RegistrantContact.Fax,
-		}
-	}
-
-	return info, nil
+	return parseRegistrarDomain(resp.Result)
 }
 
 // ListRegistrarDomains lists all domains registered with Cloudflare Registrar
@@ -179,7 +217,8 @@ func (api *API) ListRegistrarDomains(ctx context.Context) ([]RegistrarDomainInfo
 	return results, nil
 }
 
-// UpdateRegistrarDomain updates domain configuration
+// UpdateRegistrarDomain updates domain configuration.
+// Only the fields set in config are sent, so unset settings keep their current value.
 func (api *API) UpdateRegistrarDomain(
 	ctx context.Context, domainName string, config RegistrarDomainConfig,
 ) (*RegistrarDomainInfo, error) {
@@ -192,34 +231,13 @@ func (api *API) UpdateRegistrarDomain(
 		return nil, fmt.Errorf("failed to get account ID: %w", err)
 	}
 
-	cfConfig := cloudflare.RegistrarDomainConfiguration{
-		NameServers: config.NameServers,
-		Privacy:     config.Privacy,
-		Locked:      config.Locked,
-		AutoRenew:   config.AutoRenew,
-	}
-
-	domain, err := api.CloudflareClient.UpdateRegistrarDomain(ctx, accountID, domainName, cfConfig)
+	endpoint := fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domainName)
+	resp, err := api.CloudflareClient.Raw(ctx, http.MethodPut, endpoint, config, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update registrar domain: %w", err)
 	}
 
-	info := &RegistrarDomainInfo{
-		ID:                domain.ID,
-		Available:         domain.Available,
-		SupportedTLD:      domain.SupportedTLD,
-		CanRegister:       domain.CanRegister,
-		CurrentRegistrar:  domain.CurrentRegistrar,
-		ExpiresAt:         domain.ExpiresAt,
-		CreatedAt:         domain.CreatedAt,
-		UpdatedAt:         domain.UpdatedAt,
-		RegistryStatuses:  domain.RegistryStatuses,
-		Locked:            domain.Locked,
-		TransferInStatus:  getTransferStatus(domain.TransferIn),
-		CanCancelTransfer: domain.TransferIn.CanCancelTransfer,
-	}
-
-	return info, nil
+	return parseRegistrarDomain(resp.Result)
 }
 
 // InitiateRegistrarTransfer initiates a domain transfer to Cloudflare
@@ -5,8 +5,14 @@ package cf
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/cloudflare/cloudflare-go"
 )
@@ -89,6 +95,55 @@ func (api *API) UpdateEntrypointRuleset(
 	return result, nil
 }
 
+// UpdateEntrypointRulesetSkippingRejected updates the entrypoint ruleset like UpdateEntrypointRuleset,
+// but when Cloudflare rejects individual rules it removes them and retries with the remaining rules.
+// It returns the rejection message of each rejected rule, keyed by its index in rules.
+// An error is returned when a failure cannot be attributed to specific rules or when every rule is rejected.
+func (api *API) UpdateEntrypointRulesetSkippingRejected(
+	ctx context.Context, zoneID, phase, description string, rules []cloudflare.RulesetRule,
+) (*RulesetResult, map[int]string, error) {
+	// indexes maps the position of each remaining rule to its index in rules
+	indexes := make([]int, len(rules))
+	for i := range rules {
+		indexes[i] = i
+	}
+	rejected := map[int]string{}
+
+	for {
+		remaining := make([]cloudflare.RulesetRule, len(indexes))
+		for i, idx := range indexes {
+			remaining[i] = rules[idx]
+		}
+
+		result, err := api.UpdateEntrypointRuleset(ctx, zoneID, phase, description, remaining)
+		if err == nil {
+			return result, rejected, nil
+		}
+
+		ruleErrs := RulesetRuleErrors(err, remaining)
+		if len(ruleErrs) == 0 {
+			return nil, rejected, err
+		}
+
+		var kept []int
+		for i, idx := range indexes {
+			if msg, ok := ruleErrs[i]; ok {
+				rejected[idx] = msg
+				continue
+			}
+			kept = append(kept, idx)
+		}
+		indexes = kept
+
+		api.Log.Info("Cloudflare rejected ruleset rules, retrying without them",
+			"zoneId", zoneID, "phase", phase, "rejected", len(ruleErrs), "remaining", len(indexes))
+
+		if len(indexes) == 0 {
+			return nil, rejected, fmt.Errorf("all rules were rejected: %w", err)
+		}
+	}
+}
+
 // GetRuleset gets a ruleset by ID
 func (api *API) GetRuleset(ctx context.Context, zoneID, rulesetID string) (*RulesetResult, error) {
 	if api.CloudflareClient == nil {
@@ -171,3 +226,180 @@ func (api *API) DeleteRuleset(ctx context.Context, zoneID, rulesetID string) err
 	api.Log.Info("Ruleset deleted", "zoneId", zoneID, "rulesetId", rulesetID)
 	return nil
 }
+
+// RedirectAction describes the dynamic redirect performed by a rule in the
+// http_request_dynamic_redirect phase. TargetURL takes precedence over TargetExpression.
+type RedirectAction struct {
+	TargetURL           string
+	TargetExpression    string
+	StatusCode          int
+	PreserveQueryString bool
+	// PreservePathSuffix appends the request path to TargetURL
+	PreservePathSuffix bool
+}
+
+// ActionParameters returns the ruleset action parameters of the redirect.
+// A static target with PreservePathSuffix is turned into a target expression.
+func (a RedirectAction) ActionParameters() *cloudflare.RulesetRuleActionParameters {
+	preserveQueryString := a.PreserveQueryString
+	fromValue := &cloudflare.RulesetRuleActionParametersFromValue{
+		StatusCode:          uint16(a.StatusCode),
+		PreserveQueryString: &preserveQueryString,
+	}
+
+	switch {
+	case a.TargetURL != "" && a.PreservePathSuffix:
+		fromValue.TargetURL.Expression = fmt.Sprintf("concat(%s, http.request.uri.path)",
+			strconv.Quote(strings.TrimSuffix(a.TargetURL, "/")))
+	case a.TargetURL != "":
+		fromValue.TargetURL.Value = a.TargetURL
+	default:
+		fromValue.TargetURL.Expression = a.TargetExpression
+	}
+
+	return &cloudflare.RulesetRuleActionParameters{FromValue: fromValue}
+}
+
+// DiffRulesetRules compares the desired rules with the current rules of a ruleset, in order,
+// and returns a description of each difference. It returns nil when the rules are equivalent.
+// Only the fields set by the operator are compared: rule IDs, versions and timestamps assigned
+// by Cloudflare are ignored, and expressions are compared with NormalizeExpression.
+func DiffRulesetRules(desired, current []cloudflare.RulesetRule) []string {
+	var diffs []string
+	if len(desired) != len(current) {
+		diffs = append(diffs, fmt.Sprintf("rule count changed from %d to %d", len(current), len(desired)))
+	}
+
+	for i := 0; i < len(desired) && i < len(current); i++ {
+		for _, field := range diffRulesetRule(desired[i], current[i]) {
+			diffs = append(diffs, fmt.Sprintf("rule %d: %s changed", i, field))
+		}
+	}
+
+	return diffs
+}
+
+// diffRulesetRule returns the names of the fields that differ between two rules.
+func diffRulesetRule(desired, current cloudflare.RulesetRule) []string {
+	var fields []string
+	if desired.Action != current.Action {
+		fields = append(fields, "action")
+	}
+	if NormalizeExpression(desired.Expression) != NormalizeExpression(current.Expression) {
+		fields = append(fields, "expression")
+	}
+	if desired.Description != current.Description {
+		fields = append(fields, "description")
+	}
+	// Rules are enabled unless explicitly disabled
+	if (desired.Enabled == nil || *desired.Enabled) != (current.Enabled == nil || *current.Enabled) {
+		fields = append(fields, "enabled")
+	}
+	// Cloudflare assigns a ref when none is set, so only a desired ref is compared
+	if desired.Ref != "" && desired.Ref != current.Ref {
+		fields = append(fields, "ref")
+	}
+	if !jsonEqual(desired.ActionParameters, current.ActionParameters) {
+		fields = append(fields, "action parameters")
+	}
+	if !jsonEqual(desired.RateLimit, current.RateLimit) {
+		fields = append(fields, "rate limit")
+	}
+	return fields
+}
+
+// NormalizeExpression collapses runs of whitespace outside of string literals into a single
+// space and trims the expression, so that formatting differences are not reported as drift.
+func NormalizeExpression(expr string) string {
+	var b strings.Builder
+	b.Grow(len(expr))
+
+	inString, escaped, pendingSpace := false, false, false
+	for _, r := range strings.TrimSpace(expr) {
+		if inString {
+			b.WriteRune(r)
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			}
+			continue
+		}
+
+		if unicode.IsSpace(r) {
+			pendingSpace = true
+			continue
+		}
+		if pendingSpace {
+			b.WriteByte(' ')
+			pendingSpace = false
+		}
+		if r == '"' {
+			inString = true
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// jsonEqual reports whether a and b have the same JSON encoding.
+func jsonEqual(a, b any) bool {
+	aj, aErr := json.Marshal(a)
+	bj, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aj) == string(bj)
+}
+
+// rulePointerPattern matches the rule index in Cloudflare validation errors, e.g. "/rules/2/expression".
+var rulePointerPattern = regexp.MustCompile(`rules(?:/|\[|\.)(\d+)`)
+
+// RulesetRuleErrors maps the error of a failed ruleset update to the rules it rejected.
+// The key is the index of the rejected rule in rules and the value is Cloudflare's message.
+// A message is attributed to a rule by the rule index it references or, failing that, by the
+// quoted rule expression it contains. Messages that cannot be attributed are ignored.
+func RulesetRuleErrors(err error, rules []cloudflare.RulesetRule) map[int]string {
+	if err == nil {
+		return nil
+	}
+
+	var messages []string
+	var cfErr interface{ ErrorMessages() []string }
+	if errors.As(err, &cfErr) {
+		messages = cfErr.ErrorMessages()
+	}
+	if len(messages) == 0 {
+		messages = []string{err.Error()}
+	}
+
+	ruleErrs := map[int]string{}
+	for _, msg := range messages {
+		idx, ok := ruleIndexForMessage(msg, rules)
+		if !ok {
+			continue
+		}
+		if existing, seen := ruleErrs[idx]; seen {
+			msg = existing + "; " + msg
+		}
+		ruleErrs[idx] = msg
+	}
+	return ruleErrs
+}
+
+func ruleIndexForMessage(msg string, rules []cloudflare.RulesetRule) (int, bool) {
+	if m := rulePointerPattern.FindStringSubmatch(msg); m != nil {
+		if idx, err := strconv.Atoi(m[1]); err == nil && idx < len(rules) {
+			return idx, true
+		}
+	}
+
+	// Cloudflare quotes the offending value: "'<expression>' is not a valid value for expression ..."
+	for i, rule := range rules {
+		if rule.Expression != "" && strings.Contains(msg, "'"+rule.Expression+"'") {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -4,11 +4,13 @@
 package cf
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
 )
 
 func TestRulesetResult(t *testing.T) {
@@ -346,3 +348,239 @@ func TestEmptyRulesetResult(t *testing.T) {
 	assert.True(t, result.LastUpdated.IsZero())
 	assert.Nil(t, result.Rules)
 }
+
+func TestNormalizeExpression(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{
+			name: "already normalized",
+			expr: `http.host eq "example.com"`,
+			want: `http.host eq "example.com"`,
+		},
+		{
+			name: "collapses whitespace",
+			expr: "  (http.host eq \"example.com\")\n\tand\n  (http.request.uri.path eq \"/\")  ",
+			want: `(http.host eq "example.com") and (http.request.uri.path eq "/")`,
+		},
+		{
+			name: "keeps whitespace in string literals",
+			expr: `http.user_agent  contains  "Mozilla  5.0"`,
+			want: `http.user_agent contains "Mozilla  5.0"`,
+		},
+		{
+			name: "handles escaped quotes",
+			expr: `http.request.uri.query  eq  "a=\"b  c\""   and true`,
+			want: `http.request.uri.query eq "a=\"b  c\"" and true`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeExpression(tt.expr))
+		})
+	}
+}
+
+func TestDiffRulesetRules(t *testing.T) {
+	enabled, disabled := true, false
+	desired := []cloudflare.RulesetRule{
+		{
+			Action:      "block",
+			Expression:  `(ip.src eq 192.0.2.1)`,
+			Description: "Block bad IP",
+			Enabled:     &enabled,
+		},
+		{
+			Action:     "rewrite",
+			Expression: `http.request.uri.path eq "/old"`,
+			Enabled:    &enabled,
+			Ref:        "rewrite-old",
+			ActionParameters: &cloudflare.RulesetRuleActionParameters{
+				URI: &cloudflare.RulesetRuleActionParametersURI{
+					Path: &cloudflare.RulesetRuleActionParametersURIPath{Value: "/new"},
+				},
+			},
+		},
+	}
+
+	// current returns the rules as Cloudflare reports them, with server-assigned fields set
+	current := func() []cloudflare.RulesetRule {
+		rules := make([]cloudflare.RulesetRule, len(desired))
+		for i, rule := range desired {
+			rule.ID = "rule-" + rule.Action
+			rule.Version = &[]string{"3"}[0]
+			if rule.Ref == "" {
+				rule.Ref = rule.ID
+			}
+			rule.Enabled = nil
+			rules[i] = rule
+		}
+		return rules
+	}
+
+	t.Run("in sync", func(t *testing.T) {
+		assert.Nil(t, DiffRulesetRules(desired, current()))
+	})
+
+	t.Run("expression formatting is ignored", func(t *testing.T) {
+		rules := current()
+		rules[0].Expression = "(ip.src eq 192.0.2.1)\n"
+		assert.Nil(t, DiffRulesetRules(desired, rules))
+	})
+
+	t.Run("changed expression", func(t *testing.T) {
+		rules := current()
+		rules[0].Expression = `(ip.src eq 192.0.2.2)`
+		assert.Equal(t, []string{"rule 0: expression changed"}, DiffRulesetRules(desired, rules))
+	})
+
+	t.Run("changed action and disabled rule", func(t *testing.T) {
+		rules := current()
+		rules[0].Action = "log"
+		rules[1].Enabled = &disabled
+		assert.Equal(t, []string{
+			"rule 0: action changed",
+			"rule 1: enabled changed",
+		}, DiffRulesetRules(desired, rules))
+	})
+
+	t.Run("changed action parameters", func(t *testing.T) {
+		rules := current()
+		rules[1].ActionParameters = &cloudflare.RulesetRuleActionParameters{
+			URI: &cloudflare.RulesetRuleActionParametersURI{
+				Path: &cloudflare.RulesetRuleActionParametersURIPath{Value: "/other"},
+			},
+		}
+		assert.Equal(t, []string{"rule 1: action parameters changed"}, DiffRulesetRules(desired, rules))
+	})
+
+	t.Run("reordered rules", func(t *testing.T) {
+		rules := current()
+		rules[0], rules[1] = rules[1], rules[0]
+		assert.NotEmpty(t, DiffRulesetRules(desired, rules))
+	})
+
+	t.Run("removed rule", func(t *testing.T) {
+		assert.Equal(t, []string{"rule count changed from 1 to 2"}, DiffRulesetRules(desired, current()[:1]))
+	})
+}
+
+func TestRulesetRuleErrors(t *testing.T) {
+	rules := []cloudflare.RulesetRule{
+		{Expression: `(ip.src eq 192.0.2.1)`},
+		{Expression: `(http.hots eq "example.com")`},
+		{Expression: `(http.request.uri.path eq "/api")`},
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want map[int]string
+	}{
+		{
+			name: "quoted expression",
+			err: cloudflare.NewRequestError(&cloudflare.Error{ErrorMessages: []string{
+				`'(http.hots eq "example.com")' is not a valid value for expression because the expression is invalid`,
+			}}),
+			want: map[int]string{
+				1: `'(http.hots eq "example.com")' is not a valid value for expression because the expression is invalid`,
+			},
+		},
+		{
+			name: "rule pointer",
+			err:  errors.New("failed to update entrypoint ruleset: invalid value at /rules/2/action_parameters"),
+			want: map[int]string{
+				2: "failed to update entrypoint ruleset: invalid value at /rules/2/action_parameters",
+			},
+		},
+		{
+			name: "pointer out of range",
+			err:  errors.New("invalid value at /rules/7/expression"),
+			want: map[int]string{},
+		},
+		{
+			name: "unrelated error",
+			err:  errors.New("authentication error (10000)"),
+			want: map[int]string{},
+		},
+		{
+			name: "nil error",
+			err:  nil,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RulesetRuleErrors(tt.err, rules))
+		})
+	}
+}
+
+func TestRedirectActionParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		action RedirectAction
+		want   cloudflare.RulesetRuleActionParametersFromValue
+	}{
+		{
+			name:   "301 static target",
+			action: RedirectAction{TargetURL: "https://example.com/new", StatusCode: 301},
+			want: cloudflare.RulesetRuleActionParametersFromValue{
+				StatusCode:          301,
+				TargetURL:           cloudflare.RulesetRuleActionParametersTargetURL{Value: "https://example.com/new"},
+				PreserveQueryString: ptr.To(false),
+			},
+		},
+		{
+			name:   "302 preserving query string",
+			action: RedirectAction{TargetURL: "https://example.com/new", StatusCode: 302, PreserveQueryString: true},
+			want: cloudflare.RulesetRuleActionParametersFromValue{
+				StatusCode:          302,
+				TargetURL:           cloudflare.RulesetRuleActionParametersTargetURL{Value: "https://example.com/new"},
+				PreserveQueryString: ptr.To(true),
+			},
+		},
+		{
+			name:   "307 target expression",
+			action: RedirectAction{TargetExpression: `concat("https://", http.host, "/v2")`, StatusCode: 307},
+			want: cloudflare.RulesetRuleActionParametersFromValue{
+				StatusCode:          307,
+				TargetURL:           cloudflare.RulesetRuleActionParametersTargetURL{Expression: `concat("https://", http.host, "/v2")`},
+				PreserveQueryString: ptr.To(false),
+			},
+		},
+		{
+			name: "308 preserving path suffix",
+			action: RedirectAction{
+				TargetURL: "https://example.com/docs/", StatusCode: 308, PreservePathSuffix: true, PreserveQueryString: true,
+			},
+			want: cloudflare.RulesetRuleActionParametersFromValue{
+				StatusCode: 308,
+				TargetURL: cloudflare.RulesetRuleActionParametersTargetURL{
+					Expression: `concat("https://example.com/docs", http.request.uri.path)`,
+				},
+				PreserveQueryString: ptr.To(true),
+			},
+		},
+		{
+			name:   "static target takes precedence",
+			action: RedirectAction{TargetURL: "https://example.com", TargetExpression: "http.host", StatusCode: 301},
+			want: cloudflare.RulesetRuleActionParametersFromValue{
+				StatusCode:          301,
+				TargetURL:           cloudflare.RulesetRuleActionParametersTargetURL{Value: "https://example.com"},
+				PreserveQueryString: ptr.To(false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.action.ActionParameters()
+			assert.Equal(t, &tt.want, params.FromValue)
+		})
+	}
+}
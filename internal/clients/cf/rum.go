@@ -47,12 +47,20 @@ type RUMRule struct {
 	CreatedAt   string   `json:"created,omitempty"`
 }
 
-// EnableWebAnalytics enables Web Analytics for a hostname.
-// For Pages projects, use the *.pages.dev hostname or custom domain.
-//
-// Note: auto_install is only supported for custom domains proxied through Cloudflare.
-// For *.pages.dev domains, auto_install must be false as Pages has built-in injection.
-func (api *API) EnableWebAnalytics(ctx context.Context, hostname string) (*RUMSite, error) {
+// rumSiteListPageSize is the number of Web Analytics sites requested per page.
+const rumSiteListPageSize = 100
+
+// WebAnalyticsAutoInstall returns whether the Web Analytics script is injected
+// automatically for hostname. auto_install is only valid for custom domains
+// proxied through Cloudflare; *.pages.dev hostnames must use false (error 10022
+// otherwise), as Pages has built-in Web Analytics injection.
+func WebAnalyticsAutoInstall(hostname string) bool {
+	return !strings.HasSuffix(hostname, ".pages.dev")
+}
+
+// CreateWebAnalyticsSite creates a Web Analytics site for a hostname.
+// For Pages projects, use the *.pages.dev hostname or a custom domain.
+func (api *API) CreateWebAnalyticsSite(ctx context.Context, hostname string, autoInstall bool) (*RUMSite, error) {
 	if api.CloudflareClient == nil {
 		return nil, errClientNotInitialized
 	}
@@ -64,11 +72,6 @@ func (api *API) EnableWebAnalytics(ctx context.Context, hostname string) (*RUMSi
 
 	endpoint := fmt.Sprintf("/accounts/%s/rum/site_info", accountID)
 
-	// auto_install is only valid for custom domains with Cloudflare proxy.
-	// For *.pages.dev domains, auto_install must be false (error 10022 otherwise).
-	// Pages projects have built-in Web Analytics injection, so auto_install is not needed.
-	autoInstall := !strings.HasSuffix(hostname, ".pages.dev")
-
 	params := map[string]interface{}{
 		"host":         hostname,
 		"auto_install": autoInstall,
@@ -84,12 +87,12 @@ func (api *API) EnableWebAnalytics(ctx context.Context, hostname string) (*RUMSi
 		return nil, fmt.Errorf("failed to parse site: %w", err)
 	}
 
-	api.Log.Info("Web Analytics enabled", "hostname", hostname, "siteTag", site.SiteTag, "autoInstall", autoInstall)
+	api.Log.Info("Web Analytics site created", "hostname", hostname, "siteTag", site.SiteTag, "autoInstall", autoInstall)
 	return &site, nil
 }
 
-// GetWebAnalyticsSite gets a Web Analytics site by hostname.
-func (api *API) GetWebAnalyticsSite(ctx context.Context, hostname string) (*RUMSite, error) {
+// ListWebAnalyticsSites lists all Web Analytics sites of the account.
+func (api *API) ListWebAnalyticsSites(ctx context.Context) ([]RUMSite, error) {
 	if api.CloudflareClient == nil {
 		return nil, errClientNotInitialized
 	}
@@ -99,22 +102,40 @@ func (api *API) GetWebAnalyticsSite(ctx context.Context, hostname string) (*RUMS
 		return nil, fmt.Errorf("failed to get account ID: %w", err)
 	}
 
-	// List all sites and find by hostname
-	endpoint := fmt.Sprintf("/accounts/%s/rum/site_info/list", accountID)
+	var sites []RUMSite
+	for page := 1; ; page++ {
+		endpoint := fmt.Sprintf("/accounts/%s/rum/site_info/list?page=%d&per_page=%d",
+			accountID, page, rumSiteListPageSize)
 
-	resp, err := api.CloudflareClient.Raw(ctx, "GET", endpoint, nil, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list Web Analytics sites: %w", err)
+		resp, err := api.CloudflareClient.Raw(ctx, "GET", endpoint, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list Web Analytics sites: %w", err)
+		}
+
+		var pageSites []RUMSite
+		if err := json.Unmarshal(resp.Result, &pageSites); err != nil {
+			return nil, fmt.Errorf("failed to parse sites: %w", err)
+		}
+		sites = append(sites, pageSites...)
+
+		if len(pageSites) < rumSiteListPageSize ||
+			(resp.ResultInfo != nil && resp.ResultInfo.Total > 0 && len(sites) >= resp.ResultInfo.Total) {
+			return sites, nil
+		}
 	}
+}
 
-	var sites []RUMSite
-	if err := json.Unmarshal(resp.Result, &sites); err != nil {
-		return nil, fmt.Errorf("failed to parse sites: %w", err)
+// GetWebAnalyticsSite gets a Web Analytics site by hostname.
+// It returns nil if there is no site for the hostname.
+func (api *API) GetWebAnalyticsSite(ctx context.Context, hostname string) (*RUMSite, error) {
+	sites, err := api.ListWebAnalyticsSites(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, site := range sites {
-		if site.Host == hostname {
-			return &site, nil
+	for i := range sites {
+		if sites[i].Host == hostname {
+			return &sites[i], nil
 		}
 	}
 
@@ -152,8 +173,9 @@ func (api *API) UpdateWebAnalyticsSite(ctx context.Context, siteTag string, auto
 	return &site, nil
 }
 
-// DisableWebAnalytics disables Web Analytics for a site.
-func (api *API) DisableWebAnalytics(ctx context.Context, siteTag string) error {
+// DeleteWebAnalyticsSite deletes a Web Analytics site. Deleting a site that
+// does not exist is not an error.
+func (api *API) DeleteWebAnalyticsSite(ctx context.Context, siteTag string) error {
 	if api.CloudflareClient == nil {
 		return errClientNotInitialized
 	}
@@ -174,6 +196,6 @@ func (api *API) DisableWebAnalytics(ctx context.Context, siteTag string) error {
 		return fmt.Errorf("failed to delete Web Analytics site: %w", err)
 	}
 
-	api.Log.Info("Web Analytics disabled", "siteTag", siteTag)
+	api.Log.Info("Web Analytics site deleted", "siteTag", siteTag)
 	return nil
 }
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func TestWebAnalyticsSiteCRUD(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	created, err := api.CreateWebAnalyticsSite(ctx, "my-site.pages.dev", false)
+	require.NoError(t, err)
+	require.NotEmpty(t, created.SiteTag)
+	assert.NotEmpty(t, created.SiteToken)
+	assert.Equal(t, "my-site.pages.dev", created.Host)
+	assert.False(t, created.AutoInstall)
+
+	custom, err := api.CreateWebAnalyticsSite(ctx, "www.example.com", true)
+	require.NoError(t, err)
+	assert.True(t, custom.AutoInstall)
+
+	sites, err := api.ListWebAnalyticsSites(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sites, 2)
+
+	got, err := api.GetWebAnalyticsSite(ctx, "www.example.com")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, custom.SiteTag, got.SiteTag)
+
+	updated, err := api.UpdateWebAnalyticsSite(ctx, custom.SiteTag, false)
+	require.NoError(t, err)
+	assert.False(t, updated.AutoInstall)
+
+	require.NoError(t, api.DeleteWebAnalyticsSite(ctx, created.SiteTag))
+	_, ok := mock.Store().GetRUMSite(created.SiteTag)
+	assert.False(t, ok)
+	require.NoError(t, api.DeleteWebAnalyticsSite(ctx, created.SiteTag), "deleting a missing site must be idempotent")
+
+	got, err = api.GetWebAnalyticsSite(ctx, "my-site.pages.dev")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestListWebAnalyticsSitesPaginates(t *testing.T) {
+	api, mock := newStorageTestAPI(t)
+	ctx := context.Background()
+
+	total := rumSiteListPageSize + 5
+	for i := range total {
+		mock.Store().CreateRUMSite(&models.RUMSite{
+			SiteTag: fmt.Sprintf("tag-%03d", i),
+			Host:    fmt.Sprintf("site-%03d.example.com", i),
+			Created: time.Now(),
+		})
+	}
+
+	sites, err := api.ListWebAnalyticsSites(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sites, total)
+	assert.Equal(t, 2, mock.CountRequests(http.MethodGet, "/rum/site_info/list$"))
+}
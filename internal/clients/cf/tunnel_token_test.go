@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func TestGetTunnelToken(t *testing.T) {
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	mock.Store().CreateTunnel(&models.Tunnel{ID: "tunnel-a", Name: "a", AccountTag: "test-account-id", TunnelSecret: "secret"})
+
+	client, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	api := &API{Log: logr.Discard(), CloudflareClient: client, ValidAccountId: "test-account-id"}
+
+	// The token can be fetched repeatedly
+	for range 2 {
+		token, err := api.GetTunnelToken(context.Background(), "tunnel-a")
+		require.NoError(t, err)
+		decoded, err := base64.StdEncoding.DecodeString(token)
+		require.NoError(t, err)
+		assert.Contains(t, string(decoded), `"t":"tunnel-a"`)
+	}
+
+	_, err = api.GetTunnelToken(context.Background(), "")
+	assert.ErrorContains(t, err, "tunnel ID is required")
+
+	_, err = api.GetTunnelToken(context.Background(), "missing")
+	assert.Error(t, err)
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"fmt"
+	"net/http"
 
 	"github.com/cloudflare/cloudflare-go"
 )
@@ -89,6 +91,33 @@ func (c *API) GetWARPConnectorToken(ctx context.Context, connectorID string) (*W
 	}, nil
 }
 
+// RotateWARPConnectorToken replaces the tunnel secret of a WARP connector and returns
+// the new token. Connectors running with the previous token are disconnected.
+func (c *API) RotateWARPConnectorToken(ctx context.Context, connectorID string) (*WARPConnectorTokenResult, error) {
+	if _, err := c.GetAccountId(ctx); err != nil {
+		c.Log.Error(err, "error getting account ID")
+		return nil, err
+	}
+
+	randSecret := make([]byte, 32)
+	if _, err := rand.Read(randSecret); err != nil {
+		return nil, err
+	}
+	body := map[string]string{
+		"tunnel_secret": base64.StdEncoding.EncodeToString(randSecret),
+	}
+
+	// The SDK's UpdateTunnel omits the tunnel ID from the path, so the tunnel is patched directly
+	endpoint := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", c.ValidAccountId, connectorID)
+	if _, err := c.CloudflareClient.Raw(ctx, http.MethodPatch, endpoint, body, nil); err != nil {
+		c.Log.Error(err, "error rotating WARP connector secret", "id", connectorID)
+		return nil, fmt.Errorf("failed to rotate WARP connector secret: %w", err)
+	}
+
+	c.Log.Info("WARP Connector secret rotated", "id", connectorID)
+	return c.GetWARPConnectorToken(ctx, connectorID)
+}
+
 // DeleteWARPConnector deletes a WARP Connector.
 // This method is idempotent - returns nil if the connector is already deleted.
 func (c *API) DeleteWARPConnector(ctx context.Context, connectorID string) error {
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// Zone statuses reported by the Cloudflare API
+const (
+	ZoneStatusActive       = "active"
+	ZoneStatusPending      = "pending"
+	ZoneStatusInitializing = "initializing"
+)
+
+// ZoneResult contains information about a Cloudflare zone
+type ZoneResult struct {
+	ID                  string
+	Name                string
+	AccountID           string
+	Status              string
+	Paused              bool
+	NameServers         []string
+	OriginalNameServers []string
+}
+
+// IsActive reports whether the zone is active, i.e. its name servers point to Cloudflare.
+func (z *ZoneResult) IsActive() bool {
+	return z.Status == ZoneStatusActive
+}
+
+// IsPending reports whether the zone is waiting for its name servers to be switched to Cloudflare.
+func (z *ZoneResult) IsPending() bool {
+	return z.Status == ZoneStatusPending || z.Status == ZoneStatusInitializing
+}
+
+// GetZoneByName looks up the zone with the given name.
+// The lookup is restricted to the configured account when one is set.
+func (api *API) GetZoneByName(ctx context.Context, name string) (*ZoneResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+	if name == "" {
+		return nil, errors.New("failed to get zone: zone name is required")
+	}
+
+	accountID := api.ValidAccountId
+	if accountID == "" {
+		accountID = api.AccountId
+	}
+
+	zones, err := api.CloudflareClient.ListZonesContext(ctx, cloudflare.WithZoneFilters(name, accountID, ""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list zones: %w", err)
+	}
+
+	switch len(zones.Result) {
+	case 0:
+		if accountID != "" {
+			return nil, WrapNotFound(fmt.Sprintf("zone %q in account %s", name, accountID), nil)
+		}
+		return nil, WrapNotFound(fmt.Sprintf("zone %q", name), nil)
+	case 1:
+		return convertZone(zones.Result[0]), nil
+	default:
+		return nil, fmt.Errorf("%w: %d zones named %q, set the account to select one",
+			ErrMultipleResourcesFound, len(zones.Result), name)
+	}
+}
+
+// GetZone returns the zone with the given ID.
+func (api *API) GetZone(ctx context.Context, zoneID string) (*ZoneResult, error) {
+	if api.CloudflareClient == nil {
+		return nil, errClientNotInitialized
+	}
+
+	zone, err := api.CloudflareClient.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return nil, WrapNotFound(fmt.Sprintf("zone %s", zoneID), err)
+		}
+		return nil, fmt.Errorf("failed to get zone %s: %w", zoneID, err)
+	}
+	return convertZone(zone), nil
+}
+
+func convertZone(zone cloudflare.Zone) *ZoneResult {
+	return &ZoneResult{
+		ID:                  zone.ID,
+		Name:                zone.Name,
+		AccountID:           zone.Account.ID,
+		Status:              zone.Status,
+		Paused:              zone.Paused,
+		NameServers:         zone.NameServers,
+		OriginalNameServers: zone.OriginalNS,
+	}
+}
@@ -187,6 +187,21 @@ func parseZoneSetting(result *ZoneSettings, setting cloudflare.ZoneSetting) {
 	}
 }
 
+// GetZoneSetting retrieves a single zone setting, including whether it is editable on the zone's plan
+func (api *API) GetZoneSetting(ctx context.Context, zoneID, settingName string) (cloudflare.ZoneSetting, error) {
+	if api.CloudflareClient == nil {
+		return cloudflare.ZoneSetting{}, errClientNotInitialized
+	}
+
+	rc := cloudflare.ZoneIdentifier(zoneID)
+	setting, err := api.CloudflareClient.GetZoneSetting(ctx, rc, cloudflare.GetZoneSettingParams{Name: settingName})
+	if err != nil {
+		return cloudflare.ZoneSetting{}, fmt.Errorf("failed to get zone setting %s: %w", settingName, err)
+	}
+
+	return setting, nil
+}
+
 // UpdateZoneSetting updates a single zone setting
 func (api *API) UpdateZoneSetting(ctx context.Context, zoneID, settingName string, value any) error {
 	if api.CloudflareClient == nil {
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ErrRefNotPermitted is returned when a cross-namespace reference is not allowed by any ReferenceGrant.
+var ErrRefNotPermitted = errors.New("reference not permitted")
+
+// ReferenceFrom identifies the object holding a reference.
+// Group is empty for the core API group.
+type ReferenceFrom struct {
+	Group     string
+	Kind      string
+	Namespace string
+}
+
+// ReferenceTo identifies the referenced object.
+// An empty Namespace refers to the namespace of the referencing object.
+type ReferenceTo struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// CheckReferenceGrant verifies that from may reference to.
+// Same-namespace references are always allowed. Cross-namespace references must be
+// allowed by a Gateway API ReferenceGrant in the target namespace, otherwise an error
+// wrapping ErrRefNotPermitted is returned. A cluster without the ReferenceGrant CRD
+// permits no cross-namespace references.
+func CheckReferenceGrant(ctx context.Context, c client.Reader, from ReferenceFrom, to ReferenceTo) error {
+	if to.Namespace == "" || to.Namespace == from.Namespace {
+		return nil
+	}
+
+	grants := &gatewayv1beta1.ReferenceGrantList{}
+	if err := c.List(ctx, grants, client.InNamespace(to.Namespace)); err != nil {
+		if !meta.IsNoMatchError(err) && !runtime.IsNotRegisteredError(err) {
+			return fmt.Errorf("failed to list ReferenceGrants in namespace %s: %w", to.Namespace, err)
+		}
+		grants.Items = nil
+	}
+
+	for i := range grants.Items {
+		if referenceGrantAllows(&grants.Items[i], from, to) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s in namespace %s may not reference %s %s/%s, no ReferenceGrant in namespace %s allows it",
+		ErrRefNotPermitted, from.Kind, from.Namespace, to.Kind, to.Namespace, to.Name, to.Namespace)
+}
+
+// referenceGrantAllows reports whether the grant allows the reference.
+func referenceGrantAllows(grant *gatewayv1beta1.ReferenceGrant, from ReferenceFrom, to ReferenceTo) bool {
+	fromMatched := false
+	for _, f := range grant.Spec.From {
+		if string(f.Group) == from.Group && string(f.Kind) == from.Kind && string(f.Namespace) == from.Namespace {
+			fromMatched = true
+			break
+		}
+	}
+	if !fromMatched {
+		return false
+	}
+
+	for _, t := range grant.Spec.To {
+		if string(t.Group) != to.Group || string(t.Kind) != to.Kind {
+			continue
+		}
+		if t.Name == nil || *t.Name == "" || string(*t.Name) == to.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveSecretRef returns the Secret referenced by from.
+// An empty namespace refers to from.Namespace. Cross-namespace references are checked
+// against ReferenceGrants, see CheckReferenceGrant.
+func ResolveSecretRef(ctx context.Context, c client.Reader, from ReferenceFrom, namespace, name string) (*corev1.Secret, error) {
+	if namespace == "" {
+		namespace = from.Namespace
+	}
+	if err := CheckReferenceGrant(ctx, c, from, ReferenceTo{
+		Kind:      "Secret",
+		Namespace: namespace,
+		Name:      name,
+	}); err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, apitypes.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("secret %s/%s not found: %w", namespace, name, err)
+		}
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", namespace, name, err)
+	}
+	return secret, nil
+}
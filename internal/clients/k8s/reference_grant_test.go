@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package k8s_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/k8s"
+)
+
+var gatewayFrom = k8s.ReferenceFrom{Group: "gateway.networking.k8s.io", Kind: "Gateway", Namespace: "apps"}
+
+func newReferenceGrantClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, gatewayv1beta1.Install(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func secretGrant(name *gatewayv1beta1.ObjectName) *gatewayv1beta1.ReferenceGrant {
+	return &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-apps", Namespace: "certs"},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group: "gateway.networking.k8s.io", Kind: "Gateway", Namespace: "apps",
+			}},
+			To: []gatewayv1beta1.ReferenceGrantTo{{Group: "", Kind: "Secret", Name: name}},
+		},
+	}
+}
+
+func TestCheckReferenceGrant(t *testing.T) {
+	ctx := context.Background()
+	to := k8s.ReferenceTo{Kind: "Secret", Namespace: "certs", Name: "app-cert"}
+
+	tests := []struct {
+		name    string
+		objs    []client.Object
+		from    k8s.ReferenceFrom
+		to      k8s.ReferenceTo
+		allowed bool
+	}{
+		{
+			name:    "same namespace",
+			from:    gatewayFrom,
+			to:      k8s.ReferenceTo{Kind: "Secret", Name: "app-cert"},
+			allowed: true,
+		},
+		{
+			name: "cross-namespace without grant",
+			from: gatewayFrom,
+			to:   to,
+		},
+		{
+			name:    "cross-namespace with grant for all Secrets",
+			objs:    []client.Object{secretGrant(nil)},
+			from:    gatewayFrom,
+			to:      to,
+			allowed: true,
+		},
+		{
+			name:    "cross-namespace with grant for the named Secret",
+			objs:    []client.Object{secretGrant(ptr.To(gatewayv1beta1.ObjectName("app-cert")))},
+			from:    gatewayFrom,
+			to:      to,
+			allowed: true,
+		},
+		{
+			name: "grant for another Secret",
+			objs: []client.Object{secretGrant(ptr.To(gatewayv1beta1.ObjectName("other-cert")))},
+			from: gatewayFrom,
+			to:   to,
+		},
+		{
+			name: "grant for another source kind",
+			objs: []client.Object{secretGrant(nil)},
+			from: k8s.ReferenceFrom{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute", Namespace: "apps"},
+			to:   to,
+		},
+		{
+			name: "grant for another source namespace",
+			objs: []client.Object{secretGrant(nil)},
+			from: k8s.ReferenceFrom{Group: "gateway.networking.k8s.io", Kind: "Gateway", Namespace: "other"},
+			to:   to,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := k8s.CheckReferenceGrant(ctx, newReferenceGrantClient(t, tt.objs...), tt.from, tt.to)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, k8s.ErrRefNotPermitted)
+			}
+		})
+	}
+}
+
+func TestCheckReferenceGrant_WithoutReferenceGrantType(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	err := k8s.CheckReferenceGrant(context.Background(), c, gatewayFrom,
+		k8s.ReferenceTo{Kind: "Secret", Namespace: "certs", Name: "app-cert"})
+	assert.ErrorIs(t, err, k8s.ErrRefNotPermitted)
+}
+
+func TestResolveSecretRef(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-cert", Namespace: "certs"},
+		Data:       map[string][]byte{"tls.crt": []byte("cert")},
+	}
+
+	denied := newReferenceGrantClient(t, secret)
+	_, err := k8s.ResolveSecretRef(ctx, denied, gatewayFrom, "certs", "app-cert")
+	require.ErrorIs(t, err, k8s.ErrRefNotPermitted)
+
+	allowed := newReferenceGrantClient(t, secret, secretGrant(nil))
+	got, err := k8s.ResolveSecretRef(ctx, allowed, gatewayFrom, "certs", "app-cert")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("cert"), got.Data["tls.crt"])
+
+	_, err = k8s.ResolveSecretRef(ctx, allowed, gatewayFrom, "certs", "missing")
+	assert.True(t, apierrors.IsNotFound(err))
+
+	_, err = k8s.ResolveSecretRef(ctx, allowed, gatewayFrom, "", "app-cert")
+	assert.True(t, apierrors.IsNotFound(err), "empty namespace resolves in the referencing namespace")
+}
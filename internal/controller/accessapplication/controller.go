@@ -9,7 +9,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -38,8 +40,21 @@ const (
 	FinalizerName = "cloudflare.com/accessapplication-finalizer"
 	// StateActive indicates the resource is actively synced with Cloudflare.
 	StateActive = "active"
+	// ReasonPolicyNotFound is the Ready condition reason when a referenced reusable policy does not exist.
+	ReasonPolicyNotFound = "PolicyNotFound"
+	// ReasonUnknownTags is the Ready condition reason when tags do not exist and auto-creation is disabled.
+	ReasonUnknownTags = "UnknownTags"
+	// ReasonCustomPagesUnresolved is the Ready condition reason when a custom page reference cannot be resolved.
+	ReasonCustomPagesUnresolved = "CustomPagesUnresolved"
+
+	// policyNameResolveInterval is how often policies referenced by cloudflareName are
+	// resolved again, so a policy recreated with a new ID is picked up.
+	policyNameResolveInterval = 10 * time.Minute
 )
 
+// errPolicyNotFound is returned when a referenced reusable policy does not exist.
+var errPolicyNotFound = errors.New("reusable policy not found")
+
 // Reconciler reconciles an AccessApplication object.
 // It directly calls Cloudflare API and writes status back to the CRD.
 type Reconciler struct {
@@ -104,32 +119,47 @@ func (r *Reconciler) handleDeletion(
 		return common.NoRequeue(), nil
 	}
 
-	// Get API client for deletion - use resource namespace for credentials resolution
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CloudflareDetails: &app.Spec.Cloudflare,
-		Namespace:         app.Namespace, // AccessApplication is now namespaced
-		StatusAccountID:   app.Status.AccountID,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if app.Status.ApplicationID != "" {
-		// Delete from Cloudflare
-		logger.Info("Deleting AccessApplication from Cloudflare",
-			"applicationID", app.Status.ApplicationID)
-
-		if err := apiResult.API.DeleteAccessApplication(ctx, app.Status.ApplicationID); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete AccessApplication from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(app, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, app, app.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client for deletion - use resource namespace for credentials resolution
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CloudflareDetails: &app.Spec.Cloudflare,
+			Namespace:         app.Namespace, // AccessApplication is now namespaced
+			StatusAccountID:   app.Status.AccountID,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if app.Status.ApplicationID != "" {
+			// Delete from Cloudflare
+			logger.Info("Deleting AccessApplication from Cloudflare",
+				"applicationID", app.Status.ApplicationID)
+
+			if err := apiResult.API.DeleteAccessApplication(ctx, app.Status.ApplicationID); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete AccessApplication from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, app, app.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("AccessApplication not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("AccessApplication not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(app, corev1.EventTypeNormal, "Deleted",
+					"AccessApplication deleted from Cloudflare")
+			}
+		}
+
+		// Release the client CA once the application no longer uses it
+		if err == nil && app.Status.ClientCertificate != nil {
+			if err := r.releaseClientCertificate(ctx, logger, app, apiResult.API, app.Status.ClientCertificate); err != nil {
+				logger.Error(err, "Failed to release client certificate")
+				if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, app, app.Spec.DeletionTimeout, err); retry {
+					return result, nil
+				}
 			}
-		} else {
-			r.Recorder.Event(app, corev1.EventTypeNormal, "Deleted",
-				"AccessApplication deleted from Cloudflare")
 		}
 	}
 
@@ -158,16 +188,41 @@ func (r *Reconciler) reconcileApplication(
 	allowedIdps := r.resolveAllowedIdps(ctx, logger, app, apiResult.API)
 
 	// Resolve reusable policies
-	policyIDs, err := r.resolvePolicies(ctx, logger, app, apiResult.API)
+	resolvedPolicies, err := r.resolvePolicies(ctx, logger, app, apiResult.API)
 	if err != nil {
 		logger.Error(err, "Failed to resolve policies")
 		r.Recorder.Event(app, corev1.EventTypeWarning, "PolicyResolutionFailed",
 			fmt.Sprintf("Failed to resolve policies: %s", cf.SanitizeErrorMessage(err)))
+		if errors.Is(err, errPolicyNotFound) {
+			return r.setErrorStatusWithReason(ctx, app, ReasonPolicyNotFound, err)
+		}
+		return r.setErrorStatus(ctx, app, err)
+	}
+	policyIDs := policyIDsOf(resolvedPolicies)
+
+	// Make sure the tags exist before assigning them
+	if err := r.ensureTags(ctx, logger, app, apiResult.API); err != nil {
+		logger.Error(err, "Failed to ensure Access tags")
+		r.Recorder.Event(app, corev1.EventTypeWarning, "TagsFailed",
+			fmt.Sprintf("Failed to ensure Access tags: %s", cf.SanitizeErrorMessage(err)))
+		if errors.Is(err, errUnknownTags) {
+			return r.setErrorStatusWithReason(ctx, app, ReasonUnknownTags, err)
+		}
 		return r.setErrorStatus(ctx, app, err)
 	}
 
+	// Resolve custom pages; syncing without an unresolved page would remove it from the application
+	customPages, err := r.resolveCustomPages(ctx, app, refs.NewResolver(r.Client, apiResult.API))
+	if err != nil {
+		logger.Error(err, "Failed to resolve custom page references")
+		r.Recorder.Event(app, corev1.EventTypeWarning, ReasonCustomPagesUnresolved,
+			fmt.Sprintf("Failed to resolve custom pages: %s", cf.SanitizeErrorMessage(err)))
+		return r.setErrorStatusWithReason(ctx, app, ReasonCustomPagesUnresolved, err)
+	}
+
 	// Build API parameters
-	params := r.buildAPIParams(ctx, app, appName, allowedIdps, policyIDs, apiResult.API)
+	params := r.buildAPIParams(ctx, app, appName, allowedIdps, policyIDs, customPages, apiResult.API)
+	policyOrder := policyIDs
 
 	// Check if application exists
 	var result *cf.AccessApplicationResult
@@ -190,7 +245,8 @@ func (r *Reconciler) reconcileApplication(
 				return r.setErrorStatus(ctx, app, err)
 			}
 		} else {
-			// Update existing application
+			// Update existing application, sending the policies only when their order changes
+			policyOrder = r.reconcilePolicyOrder(logger, app, &params, existing.Policies)
 			result, err = apiResult.API.UpdateAccessApplication(ctx, app.Status.ApplicationID, params)
 			if err != nil {
 				logger.Error(err, "Failed to update AccessApplication")
@@ -206,6 +262,7 @@ func (r *Reconciler) reconcileApplication(
 			// Found existing, adopt it
 			logger.Info("Found existing AccessApplication in Cloudflare, adopting",
 				"applicationID", existing.ID, "name", appName)
+			policyOrder = r.reconcilePolicyOrder(logger, app, &params, existing.Policies)
 			result, err = apiResult.API.UpdateAccessApplication(ctx, existing.ID, params)
 			if err != nil {
 				logger.Error(err, "Failed to update adopted AccessApplication")
@@ -225,34 +282,151 @@ func (r *Reconciler) reconcileApplication(
 		}
 	}
 
+	// Associate the client CA with the application hostnames
+	clientCertStatus, err := r.reconcileClientCertificate(ctx, logger, app, apiResult.API)
+	if err != nil {
+		logger.Error(err, "Failed to reconcile client certificate")
+		r.Recorder.Event(app, corev1.EventTypeWarning, "ClientCertificateFailed",
+			fmt.Sprintf("Failed to reconcile client certificate: %s", cf.SanitizeErrorMessage(err)))
+		return r.setErrorStatus(ctx, app, err)
+	}
+
 	// Update status with success
-	return r.setSuccessStatus(ctx, app, apiResult.AccountID, result, policyIDs)
+	assignPolicyPrecedence(resolvedPolicies, policyOrder)
+	scimStatus := r.refreshSCIMStatus(ctx, logger, app, apiResult.API)
+	return r.setSuccessStatus(ctx, app, apiResult.AccountID, result, policyIDs, resolvedPolicies, scimStatus, clientCertStatus)
 }
 
 // resolvePolicies resolves ReusablePolicyRefs to Cloudflare policy IDs.
+// The result is in evaluation order; a policy referenced more than once keeps its first position.
 func (r *Reconciler) resolvePolicies(
 	ctx context.Context,
 	logger logr.Logger,
 	app *networkingv1alpha2.AccessApplication,
 	api *cf.API,
-) ([]string, error) {
+) ([]networkingv1alpha2.ResolvedReusablePolicyStatus, error) {
 	if len(app.Spec.ReusablePolicyRefs) == 0 {
 		return nil, nil
 	}
 
-	policyIDs := make([]string, 0, len(app.Spec.ReusablePolicyRefs))
+	resolved := make([]networkingv1alpha2.ResolvedReusablePolicyStatus, 0, len(app.Spec.ReusablePolicyRefs))
+	seen := make(map[string]bool, len(app.Spec.ReusablePolicyRefs))
 
-	for i, ref := range app.Spec.ReusablePolicyRefs {
+	for _, i := range orderPolicyRefs(app.Spec.ReusablePolicyRefs) {
+		ref := app.Spec.ReusablePolicyRefs[i]
 		policyID, err := r.resolvePolicyRef(ctx, logger, ref, api)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve policy ref at index %d: %w", i, err)
 		}
-		if policyID != "" {
-			policyIDs = append(policyIDs, policyID)
+		if policyID == "" || seen[policyID] {
+			continue
+		}
+		seen[policyID] = true
+		status := resolvedPolicyStatus(ref, policyID)
+		r.recordPolicyReResolved(logger, app, status)
+		resolved = append(resolved, status)
+	}
+
+	return resolved, nil
+}
+
+// recordPolicyReResolved records an event when a policy referenced by cloudflareName
+// resolves to a different ID than before, e.g. because it was recreated.
+func (r *Reconciler) recordPolicyReResolved(
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	status networkingv1alpha2.ResolvedReusablePolicyStatus,
+) {
+	if status.Source != "cloudflareName" {
+		return
+	}
+	for _, previous := range app.Status.ResolvedReusablePolicies {
+		if previous.Source != status.Source || previous.PolicyName != status.PolicyName {
+			continue
+		}
+		if previous.PolicyID != status.PolicyID {
+			logger.Info("Reusable policy resolved to a new ID",
+				"policyName", status.PolicyName, "previousId", previous.PolicyID, "policyId", status.PolicyID)
+			r.Recorder.Event(app, corev1.EventTypeNormal, "PolicyReResolved",
+				fmt.Sprintf("Policy %q now resolves to %s (was %s)", status.PolicyName, status.PolicyID, previous.PolicyID))
 		}
+		return
 	}
+}
 
-	return policyIDs, nil
+// referencesPolicyByName reports whether the application references a reusable policy by cloudflareName.
+func referencesPolicyByName(app *networkingv1alpha2.AccessApplication) bool {
+	for _, ref := range app.Spec.ReusablePolicyRefs {
+		if ref.CloudflareID == "" && ref.Name == "" && ref.CloudflareName != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvedPolicyStatus describes how a ReusablePolicyRef was resolved.
+func resolvedPolicyStatus(
+	ref networkingv1alpha2.ReusablePolicyRef,
+	policyID string,
+) networkingv1alpha2.ResolvedReusablePolicyStatus {
+	status := networkingv1alpha2.ResolvedReusablePolicyStatus{PolicyID: policyID}
+	switch {
+	case ref.CloudflareID != "":
+		status.Source = "cloudflareId"
+	case ref.Name != "":
+		status.Source = "k8s"
+		status.PolicyName = ref.Name
+	default:
+		status.Source = "cloudflareName"
+		status.PolicyName = ref.CloudflareName
+	}
+	return status
+}
+
+// policyIDsOf returns the policy IDs of the resolved policies in order.
+func policyIDsOf(resolved []networkingv1alpha2.ResolvedReusablePolicyStatus) []string {
+	if len(resolved) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(resolved))
+	for _, policy := range resolved {
+		ids = append(ids, policy.PolicyID)
+	}
+	return ids
+}
+
+// reconcilePolicyOrder sets the policies of an update to an existing application.
+// The policies are only sent when the remote order differs from the spec, so an
+// update does not touch policies that are already in place. It returns the order
+// the policies are evaluated in after the update.
+func (r *Reconciler) reconcilePolicyOrder(
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	params *cf.AccessApplicationParams,
+	remote []cf.AccessApplicationPolicyResult,
+) []string {
+	current := make([]string, 0, len(remote))
+	for _, policy := range remote {
+		current = append(current, policy.ID)
+	}
+
+	// Without reusablePolicyRefs the spec does not manage the attached policies
+	if len(params.Policies) == 0 {
+		return current
+	}
+
+	order := desiredPolicyOrder(params.Policies, remote)
+	if policyOrderMatches(remote, order) {
+		params.Policies = nil
+		return order
+	}
+
+	logger.Info("AccessApplication policy order differs from spec, updating",
+		"current", current, "desired", order)
+	r.Recorder.Event(app, corev1.EventTypeNormal, "PolicyOrderUpdated",
+		fmt.Sprintf("Policy order updated to [%s]", strings.Join(order, ", ")))
+	params.Policies = order
+	return order
 }
 
 // resolvePolicyRef resolves a single ReusablePolicyRef to a Cloudflare policy ID.
@@ -275,7 +449,7 @@ func (r *Reconciler) resolvePolicyRef(
 		policy := &networkingv1alpha2.AccessPolicy{}
 		if err := r.Get(ctx, apitypes.NamespacedName{Name: ref.Name}, policy); err != nil {
 			if apierrors.IsNotFound(err) {
-				return "", fmt.Errorf("AccessPolicy %q not found", ref.Name)
+				return "", fmt.Errorf("%w: AccessPolicy %q not found", errPolicyNotFound, ref.Name)
 			}
 			logger.Error(err, "Failed to get AccessPolicy", "name", ref.Name)
 			return "", fmt.Errorf("failed to get AccessPolicy %q: %w", ref.Name, err)
@@ -295,7 +469,7 @@ func (r *Reconciler) resolvePolicyRef(
 			return "", fmt.Errorf("failed to find policy by name %q: %w", ref.CloudflareName, err)
 		}
 		if cfPolicy == nil {
-			return "", fmt.Errorf("policy %q not found in Cloudflare", ref.CloudflareName)
+			return "", fmt.Errorf("%w: policy %q not found in Cloudflare", errPolicyNotFound, ref.CloudflareName)
 		}
 		logger.V(1).Info("Resolved Cloudflare policy name to ID",
 			"policyName", ref.CloudflareName, "policyId", cfPolicy.ID)
@@ -330,6 +504,17 @@ func (r *Reconciler) resolveAllowedIdps(
 	return result
 }
 
+// resolveCustomPages resolves the custom page IDs from direct IDs and refs.
+// All resolution errors are returned together.
+func (*Reconciler) resolveCustomPages(
+	ctx context.Context,
+	app *networkingv1alpha2.AccessApplication,
+	resolver *refs.Resolver,
+) ([]string, error) {
+	result, errs := resolver.ResolveAllCustomPages(ctx, app.Spec.CustomPages, app.Spec.CustomPageRefs)
+	return result, errors.Join(errs...)
+}
+
 // buildAPIParams builds the Cloudflare API parameters from the spec.
 // It resolves VnetRef references in destinations using the provided API client.
 func (r *Reconciler) buildAPIParams(
@@ -338,6 +523,7 @@ func (r *Reconciler) buildAPIParams(
 	appName string,
 	allowedIdps []string,
 	policyIDs []string,
+	customPages []string,
 	api *cf.API,
 ) cf.AccessApplicationParams {
 	logger := ctrllog.FromContext(ctx)
@@ -366,7 +552,7 @@ func (r *Reconciler) buildAPIParams(
 		CustomNonIdentityDenyURL: app.Spec.CustomNonIdentityDenyURL,
 		AllowAuthenticateViaWarp: app.Spec.AllowAuthenticateViaWarp,
 		Tags:                     app.Spec.Tags,
-		CustomPages:              app.Spec.CustomPages,
+		CustomPages:              customPages,
 		GatewayRules:             app.Spec.GatewayRules,
 		Policies:                 policyIDs,
 	}
@@ -604,6 +790,9 @@ func (r *Reconciler) setSuccessStatus(
 	accountID string,
 	result *cf.AccessApplicationResult,
 	policyIDs []string,
+	resolvedPolicies []networkingv1alpha2.ResolvedReusablePolicyStatus,
+	scimStatus *networkingv1alpha2.AccessApplicationSCIMStatus,
+	clientCertStatus *networkingv1alpha2.AccessApplicationClientCertificateStatus,
 ) (ctrl.Result, error) {
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, app, func() {
 		app.Status.AccountID = accountID
@@ -615,6 +804,9 @@ func (r *Reconciler) setSuccessStatus(
 		app.Status.SaasAppClientID = result.SaasAppClientID
 		app.Status.ObservedGeneration = app.Generation
 		app.Status.ResolvedPolicyIDs = policyIDs
+		app.Status.ResolvedReusablePolicies = resolvedPolicies
+		app.Status.SCIM = scimStatus
+		app.Status.ClientCertificate = clientCertStatus
 
 		meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
@@ -630,6 +822,26 @@ func (r *Reconciler) setSuccessStatus(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
+	// Keep the SCIM provisioning status fresh and policy names resolved
+	var requeueAfter time.Duration
+	if scimIdentityProvider(app) != "" {
+		requeueAfter = scimStatusRefreshInterval
+		if next := scimNextCheck(app, time.Now()); next > 0 {
+			requeueAfter = next
+		}
+	}
+	if referencesPolicyByName(app) && (requeueAfter == 0 || policyNameResolveInterval < requeueAfter) {
+		requeueAfter = policyNameResolveInterval
+	}
+	// Retry deleting replaced client CAs
+	if clientCertStatus != nil && len(clientCertStatus.PendingReleaseIDs) > 0 &&
+		(requeueAfter == 0 || common.RequeueIntervalLong < requeueAfter) {
+		requeueAfter = common.RequeueIntervalLong
+	}
+	if requeueAfter > 0 {
+		return common.RequeueResult(requeueAfter), nil
+	}
+
 	return common.NoRequeue(), nil
 }
 
@@ -638,6 +850,16 @@ func (r *Reconciler) setErrorStatus(
 	ctx context.Context,
 	app *networkingv1alpha2.AccessApplication,
 	err error,
+) (ctrl.Result, error) {
+	return r.setErrorStatusWithReason(ctx, app, "Error", err)
+}
+
+// setErrorStatusWithReason updates the application status with an error and a specific condition reason.
+func (r *Reconciler) setErrorStatusWithReason(
+	ctx context.Context,
+	app *networkingv1alpha2.AccessApplication,
+	reason string,
+	err error,
 ) (ctrl.Result, error) {
 	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, app, func() {
 		app.Status.State = "error"
@@ -646,7 +868,7 @@ func (r *Reconciler) setErrorStatus(
 			Type:               "Ready",
 			Status:             metav1.ConditionFalse,
 			ObservedGeneration: app.Generation,
-			Reason:             "Error",
+			Reason:             reason,
 			Message:            cf.SanitizeErrorMessage(err),
 			LastTransitionTime: metav1.Now(),
 		})
@@ -753,6 +975,36 @@ func (r *Reconciler) findAccessApplicationsForAccessGroup(ctx context.Context, o
 	return requests
 }
 
+// findAccessApplicationsForCustomPage returns reconcile requests for AccessApplications
+// that reference the given AccessCustomPage, so they are synced once its page ID is known.
+func (r *Reconciler) findAccessApplicationsForCustomPage(ctx context.Context, obj client.Object) []reconcile.Request {
+	page, ok := obj.(*networkingv1alpha2.AccessCustomPage)
+	if !ok {
+		return nil
+	}
+	logger := ctrllog.FromContext(ctx)
+
+	appList := &networkingv1alpha2.AccessApplicationList{}
+	if err := r.List(ctx, appList); err != nil {
+		logger.Error(err, "Failed to list AccessApplications for AccessCustomPage watch")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range appList.Items {
+		app := &appList.Items[i]
+		if slices.ContainsFunc(app.Spec.CustomPageRefs, func(ref networkingv1alpha2.AccessCustomPageRef) bool {
+			return ref.Name == page.Name
+		}) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: app.Name, Namespace: app.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
 // findAccessApplicationsForAccessPolicy returns reconcile requests for AccessApplications
 // that reference the given AccessPolicy.
 func (r *Reconciler) findAccessApplicationsForAccessPolicy(ctx context.Context, obj client.Object) []reconcile.Request {
@@ -916,5 +1168,13 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&networkingv1alpha2.Tunnel{},
 			handler.EnqueueRequestsFromMapFunc(r.findAccessApplicationsForTunnel),
 		).
-		Complete(r)
+		Watches(
+			&networkingv1alpha2.AccessCustomPage{},
+			handler.EnqueueRequestsFromMapFunc(r.findAccessApplicationsForCustomPage),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findAccessApplicationsForClientCASecret),
+		).
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.AccessApplication{}, r))
 }
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func TestReconcile_ResolvesCustomPageRefs(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateAccessCustomPage(&models.AccessCustomPage{UID: "page-by-name", Name: "Identity denied", Type: "identity_denied"})
+	app := &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dashboard", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:        "self_hosted",
+			Domain:      "dashboard.example.com",
+			CustomPages: []string{"page-by-id"},
+			CustomPageRefs: []networkingv1alpha2.AccessCustomPageRef{
+				{Name: "blocked"},
+				{CloudflareName: "Identity denied"},
+				{CloudflareID: "page-by-id"},
+			},
+		},
+	}
+	r, c := newTestReconciler(t, app)
+	require.NoError(t, c.Create(context.Background(), &networkingv1alpha2.AccessCustomPage{
+		ObjectMeta: metav1.ObjectMeta{Name: "blocked"},
+		Spec:       networkingv1alpha2.AccessCustomPageSpec{Type: "forbidden", CustomHTML: "<p>Blocked</p>"},
+		Status:     networkingv1alpha2.AccessCustomPageStatus{PageID: "page-by-resource"},
+	}))
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+
+	apps := mock.Store().ListAccessApplications()
+	require.Len(t, apps, 1)
+	assert.Equal(t, []string{"page-by-id", "page-by-resource", "page-by-name"}, apps[0].CustomPages)
+}
+
+func TestReconcile_UnresolvedCustomPageRefBlocksSync(t *testing.T) {
+	mock := newMockServer(t)
+	app := &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dashboard", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:           "self_hosted",
+			Domain:         "dashboard.example.com",
+			CustomPageRefs: []networkingv1alpha2.AccessCustomPageRef{{Name: "blocked"}},
+		},
+	}
+	r, c := newTestReconciler(t, app)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)}
+
+	// The referenced AccessCustomPage has no page ID yet
+	page := &networkingv1alpha2.AccessCustomPage{
+		ObjectMeta: metav1.ObjectMeta{Name: "blocked"},
+		Spec:       networkingv1alpha2.AccessCustomPageSpec{Type: "forbidden", CustomHTML: "<p>Blocked</p>"},
+	}
+	require.NoError(t, c.Create(context.Background(), page))
+
+	result, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+	assert.Empty(t, mock.Store().ListAccessApplications())
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, ReasonCustomPagesUnresolved, ready.Reason)
+
+	// The page becoming ready enqueues the application
+	requests := r.findAccessApplicationsForCustomPage(context.Background(), page)
+	require.Len(t, requests, 1)
+	assert.Equal(t, req.NamespacedName, requests[0].NamespacedName)
+
+	page.Status.PageID = "page-by-resource"
+	require.NoError(t, c.Update(context.Background(), page))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	apps := mock.Store().ListAccessApplications()
+	require.Len(t, apps, 1)
+	assert.Equal(t, []string{"page-by-resource"}, apps[0].CustomPages)
+}
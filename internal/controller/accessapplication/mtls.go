@@ -0,0 +1,376 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// clientCANameSuffix is appended to the application name to name an uploaded client CA.
+const clientCANameSuffix = "-client-ca"
+
+// clientCertificateHostnames returns the hostnames of the application that its client
+// CA is associated with: the domain, the self-hosted domains and the public destinations,
+// without paths. The result is sorted and has no duplicates.
+func clientCertificateHostnames(app *networkingv1alpha2.AccessApplication) []string {
+	domains := make([]string, 0, 1+len(app.Spec.SelfHostedDomains)+len(app.Spec.Destinations))
+	domains = append(domains, app.Spec.Domain)
+	domains = append(domains, app.Spec.SelfHostedDomains...)
+	for _, dest := range app.Spec.Destinations {
+		if dest.Type == "public" {
+			domains = append(domains, dest.URI)
+		}
+	}
+
+	hostnames := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		host, _, _ := strings.Cut(domain, "/")
+		if host != "" {
+			hostnames = append(hostnames, host)
+		}
+	}
+	slices.Sort(hostnames)
+	return slices.Compact(hostnames)
+}
+
+// desiredAssociatedHostnames returns the hostnames of a certificate that is shared
+// with other applications: the remote hostnames without the ones this application
+// associated before but no longer uses, plus the current hostnames of the application.
+func desiredAssociatedHostnames(remote, previous, current []string) []string {
+	result := make([]string, 0, len(remote)+len(current))
+	for _, hostname := range remote {
+		if !slices.Contains(previous, hostname) || slices.Contains(current, hostname) {
+			result = append(result, hostname)
+		}
+	}
+	result = append(result, current...)
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// sameHostnames reports whether two hostname lists contain the same hostnames.
+func sameHostnames(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// reconcileClientCertificate associates the client CA of the application with its
+// hostnames, uploading the CA from caSecretRef when needed. A CA that is no longer
+// referenced is released. It returns the status to record, or nil when the
+// application does not require a client certificate.
+func (r *Reconciler) reconcileClientCertificate(
+	ctx context.Context,
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	api *cf.API,
+) (*networkingv1alpha2.AccessApplicationClientCertificateStatus, error) {
+	spec := app.Spec.ClientCertificate
+	previous := app.Status.ClientCertificate
+
+	if spec == nil {
+		if previous != nil {
+			if err := r.releaseClientCertificate(ctx, logger, app, api, previous); err != nil {
+				return previous, err
+			}
+		}
+		return nil, nil
+	}
+
+	hostnames := clientCertificateHostnames(app)
+	if spec.CloudflareID != "" {
+		if previous != nil && previous.CertificateID != spec.CloudflareID {
+			if err := r.releaseClientCertificate(ctx, logger, app, api, previous); err != nil {
+				return previous, err
+			}
+			previous = nil
+		}
+		return r.associateExistingClientCertificate(ctx, api, spec.CloudflareID, previous, hostnames)
+	}
+	return r.reconcileManagedClientCertificate(ctx, logger, app, api, previous, hostnames)
+}
+
+// associateExistingClientCertificate adds the application hostnames to an existing
+// mTLS certificate, keeping hostnames other applications associated with it.
+func (*Reconciler) associateExistingClientCertificate(
+	ctx context.Context,
+	api *cf.API,
+	certificateID string,
+	previous *networkingv1alpha2.AccessApplicationClientCertificateStatus,
+	hostnames []string,
+) (*networkingv1alpha2.AccessApplicationClientCertificateStatus, error) {
+	cert, err := api.GetAccessMutualTLSCertificate(ctx, certificateID)
+	if err != nil {
+		return previous, fmt.Errorf("get client CA %s: %w", certificateID, err)
+	}
+
+	var previousHostnames []string
+	if previous != nil {
+		previousHostnames = previous.AssociatedHostnames
+	}
+	desired := desiredAssociatedHostnames(cert.AssociatedHostnames, previousHostnames, hostnames)
+	if !sameHostnames(cert.AssociatedHostnames, desired) {
+		cert, err = api.UpdateAccessMutualTLSCertificate(ctx, certificateID, cf.AccessMutualTLSCertificateParams{
+			Name:                cert.Name,
+			AssociatedHostnames: desired,
+		})
+		if err != nil {
+			return previous, fmt.Errorf("associate client CA %s with application hostnames: %w", certificateID, err)
+		}
+	}
+
+	return clientCertificateStatus(cert, false, "", hostnames), nil
+}
+
+// reconcileManagedClientCertificate uploads the CA from caSecretRef and associates it
+// with the application hostnames. A changed CA is uploaded as a new certificate before
+// the previous one is deleted; a previous CA that cannot be deleted is kept in
+// pendingReleaseIds and deleted on a later reconcile.
+//
+//nolint:revive // cognitive complexity acceptable for CA rotation
+func (r *Reconciler) reconcileManagedClientCertificate(
+	ctx context.Context,
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	api *cf.API,
+	previous *networkingv1alpha2.AccessApplicationClientCertificateStatus,
+	hostnames []string,
+) (*networkingv1alpha2.AccessApplicationClientCertificateStatus, error) {
+	caPEM, err := r.readClientCA(ctx, app)
+	if err != nil {
+		return previous, err
+	}
+
+	// An existing CA the application used before only loses the application hostnames
+	if previous != nil && !previous.Managed {
+		if err := r.releaseClientCertificate(ctx, logger, app, api, previous); err != nil {
+			return previous, err
+		}
+		previous = nil
+	}
+	var pending []string
+	if previous != nil {
+		pending = previous.PendingReleaseIDs
+	}
+	sum := sha256.Sum256([]byte(caPEM))
+	hash := hex.EncodeToString(sum[:])
+	params := cf.AccessMutualTLSCertificateParams{
+		Name:                clientCertificateName(app),
+		Certificate:         caPEM,
+		AssociatedHostnames: hostnames,
+	}
+
+	if previous != nil && previous.Managed && previous.CertificateHash == hash {
+		cert, err := api.GetAccessMutualTLSCertificate(ctx, previous.CertificateID)
+		switch {
+		case err == nil:
+			if cert.Name != params.Name || !sameHostnames(cert.AssociatedHostnames, hostnames) {
+				if cert, err = api.UpdateAccessMutualTLSCertificate(ctx, cert.ID, params); err != nil {
+					return previous, fmt.Errorf("update client CA %s: %w", previous.CertificateID, err)
+				}
+			}
+			status := clientCertificateStatus(cert, true, hash, hostnames)
+			status.PendingReleaseIDs = r.releasePendingClientCAs(ctx, logger, app, api, pending)
+			return status, nil
+		case !cf.IsNotFoundError(err):
+			return previous, fmt.Errorf("get client CA %s: %w", previous.CertificateID, err)
+		}
+		logger.Info("Client CA not found in Cloudflare, uploading it again", "certificateId", previous.CertificateID)
+		previous = nil
+	}
+
+	cert, err := api.CreateAccessMutualTLSCertificate(ctx, params)
+	if err != nil {
+		return previous, fmt.Errorf("upload client CA: %w", err)
+	}
+	r.Recorder.Event(app, corev1.EventTypeNormal, "ClientCAUploaded",
+		fmt.Sprintf("Client CA uploaded as mTLS certificate %s", cert.ID))
+	status := clientCertificateStatus(cert, true, hash, hostnames)
+
+	status.PendingReleaseIDs = r.releasePendingClientCAs(ctx, logger, app, api, pending)
+	if previous != nil {
+		if err := api.DeleteAccessMutualTLSCertificate(ctx, previous.CertificateID); err != nil && !cf.IsNotFoundError(err) {
+			// The new CA is in place; the previous one is retried on the next reconcile
+			logger.Error(err, "Failed to release previous client CA", "certificateId", previous.CertificateID)
+			r.Recorder.Event(app, corev1.EventTypeWarning, "ClientCAReleaseFailed",
+				fmt.Sprintf("Failed to delete replaced client CA %s, will retry: %s",
+					previous.CertificateID, cf.SanitizeErrorMessage(err)))
+			status.PendingReleaseIDs = append(status.PendingReleaseIDs, previous.CertificateID)
+		} else {
+			r.Recorder.Event(app, corev1.EventTypeNormal, "ClientCADeleted",
+				fmt.Sprintf("Client CA %s deleted from Cloudflare", previous.CertificateID))
+		}
+	}
+	return status, nil
+}
+
+// releasePendingClientCAs deletes replaced client CAs whose deletion failed before.
+// It returns the IDs that still could not be deleted.
+func (r *Reconciler) releasePendingClientCAs(
+	ctx context.Context,
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	api *cf.API,
+	ids []string,
+) []string {
+	var remaining []string
+	for _, id := range ids {
+		if err := api.DeleteAccessMutualTLSCertificate(ctx, id); err != nil && !cf.IsNotFoundError(err) {
+			logger.Error(err, "Failed to release previous client CA", "certificateId", id)
+			remaining = append(remaining, id)
+			continue
+		}
+		r.Recorder.Event(app, corev1.EventTypeNormal, "ClientCADeleted",
+			fmt.Sprintf("Client CA %s deleted from Cloudflare", id))
+	}
+	return remaining
+}
+
+// releaseClientCertificate undoes the association of a client CA with the application:
+// an uploaded CA is deleted together with the replaced CAs pending release, and the
+// application hostnames are removed from an existing one.
+func (r *Reconciler) releaseClientCertificate(
+	ctx context.Context,
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	api *cf.API,
+	status *networkingv1alpha2.AccessApplicationClientCertificateStatus,
+) error {
+	if status.Managed {
+		if remaining := r.releasePendingClientCAs(ctx, logger, app, api, status.PendingReleaseIDs); len(remaining) > 0 {
+			return fmt.Errorf("delete replaced client CAs %s", strings.Join(remaining, ", "))
+		}
+		if err := api.DeleteAccessMutualTLSCertificate(ctx, status.CertificateID); err != nil {
+			return fmt.Errorf("delete client CA %s: %w", status.CertificateID, err)
+		}
+		r.Recorder.Event(app, corev1.EventTypeNormal, "ClientCADeleted",
+			fmt.Sprintf("Client CA %s deleted from Cloudflare", status.CertificateID))
+		return nil
+	}
+
+	cert, err := api.GetAccessMutualTLSCertificate(ctx, status.CertificateID)
+	if err != nil {
+		if cf.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("get client CA %s: %w", status.CertificateID, err)
+	}
+	desired := desiredAssociatedHostnames(cert.AssociatedHostnames, status.AssociatedHostnames, nil)
+	if sameHostnames(cert.AssociatedHostnames, desired) {
+		return nil
+	}
+	if _, err := api.UpdateAccessMutualTLSCertificate(ctx, cert.ID, cf.AccessMutualTLSCertificateParams{
+		Name:                cert.Name,
+		AssociatedHostnames: desired,
+	}); err != nil {
+		return fmt.Errorf("remove application hostnames from client CA %s: %w", status.CertificateID, err)
+	}
+	logger.Info("Removed application hostnames from client CA", "certificateId", status.CertificateID)
+	return nil
+}
+
+// readClientCA reads the PEM-encoded CA certificate referenced by caSecretRef.
+func (r *Reconciler) readClientCA(ctx context.Context, app *networkingv1alpha2.AccessApplication) (string, error) {
+	ref := app.Spec.ClientCertificate.CASecretRef
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = app.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, apitypes.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return "", fmt.Errorf("get client CA secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	data := secret.Data[ref.Key]
+	if len(data) == 0 {
+		return "", fmt.Errorf("%w: client CA secret %s/%s has no key %q",
+			cf.ErrInvalidConfiguration, namespace, ref.Name, ref.Key)
+	}
+	if block, _ := pem.Decode(data); block == nil || block.Type != "CERTIFICATE" {
+		return "", fmt.Errorf("%w: key %q of client CA secret %s/%s is not a PEM-encoded certificate",
+			cf.ErrInvalidConfiguration, ref.Key, namespace, ref.Name)
+	}
+	return string(data), nil
+}
+
+// findAccessApplicationsForClientCASecret returns reconcile requests for AccessApplications
+// whose clientCertificate.caSecretRef references the given Secret, so a rotated CA is
+// uploaded without waiting for another change to the application.
+func (r *Reconciler) findAccessApplicationsForClientCASecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	appList := &networkingv1alpha2.AccessApplicationList{}
+	if err := r.List(ctx, appList); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "Failed to list AccessApplications for Secret watch")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range appList.Items {
+		app := &appList.Items[i]
+		if app.Spec.ClientCertificate == nil || app.Spec.ClientCertificate.CASecretRef == nil {
+			continue
+		}
+		ref := app.Spec.ClientCertificate.CASecretRef
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = app.Namespace
+		}
+		if ref.Name == secret.Name && namespace == secret.Namespace {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: app.Name, Namespace: app.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// clientCertificateName returns the name of the uploaded client CA.
+func clientCertificateName(app *networkingv1alpha2.AccessApplication) string {
+	if app.Spec.ClientCertificate.Name != "" {
+		return app.Spec.ClientCertificate.Name
+	}
+	return app.GetAccessApplicationName() + clientCANameSuffix
+}
+
+// clientCertificateStatus builds the status of the client CA of an application.
+func clientCertificateStatus(
+	cert *cf.AccessMutualTLSCertificateResult,
+	managed bool,
+	hash string,
+	hostnames []string,
+) *networkingv1alpha2.AccessApplicationClientCertificateStatus {
+	status := &networkingv1alpha2.AccessApplicationClientCertificateStatus{
+		CertificateID:       cert.ID,
+		Managed:             managed,
+		CertificateHash:     hash,
+		Fingerprint:         cert.Fingerprint,
+		AssociatedHostnames: hostnames,
+	}
+	if !cert.ExpiresOn.IsZero() {
+		status.ExpiresOn = &metav1.Time{Time: cert.ExpiresOn}
+	}
+	return status
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/injection"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+const testClientCA = "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIU\n-----END CERTIFICATE-----\n"
+
+func newClientCertApp(cert *networkingv1alpha2.AccessApplicationClientCertificate) *networkingv1alpha2.AccessApplication {
+	return &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internal-api", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:              "self_hosted",
+			Domain:            "api.example.com/v1",
+			SelfHostedDomains: []string{"api.example.com", "api2.example.com"},
+			ClientCertificate: cert,
+		},
+	}
+}
+
+func TestClientCertificateHostnames(t *testing.T) {
+	app := newClientCertApp(nil)
+	app.Spec.Destinations = []networkingv1alpha2.AccessDestination{
+		{Type: "public", URI: "web.example.com/admin"},
+		{Type: "private", Hostname: "db.internal"},
+	}
+
+	assert.Equal(t, []string{"api.example.com", "api2.example.com", "web.example.com"},
+		clientCertificateHostnames(app))
+}
+
+func TestDesiredAssociatedHostnames(t *testing.T) {
+	remote := []string{"other.example.com", "old.example.com", "api.example.com"}
+	previous := []string{"old.example.com", "api.example.com"}
+
+	assert.Equal(t, []string{"api.example.com", "new.example.com", "other.example.com"},
+		desiredAssociatedHostnames(remote, previous, []string{"api.example.com", "new.example.com"}))
+	assert.Equal(t, []string{"other.example.com"},
+		desiredAssociatedHostnames(remote, previous, nil), "releasing keeps hostnames of other applications")
+}
+
+func TestReconcile_UploadsClientCAAndDeletesItWithApplication(t *testing.T) {
+	mock := newMockServer(t)
+	app := newClientCertApp(&networkingv1alpha2.AccessApplicationClientCertificate{
+		CASecretRef: &networkingv1alpha2.SecretKeySelector{Name: "client-ca", Key: "ca.crt"},
+	})
+	r, c := newTestReconciler(t, app)
+	require.NoError(t, c.Create(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-ca", Namespace: "default"},
+		Data:       map[string][]byte{"ca.crt": []byte(testClientCA)},
+	}))
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	certs := mock.Store().ListAccessCertificates()
+	require.Len(t, certs, 1)
+	assert.Equal(t, "internal-api-client-ca", certs[0].Name)
+	assert.ElementsMatch(t, []string{"api.example.com", "api2.example.com"}, certs[0].AssociatedHostnames)
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	require.Equal(t, StateActive, updated.Status.State)
+	status := updated.Status.ClientCertificate
+	require.NotNil(t, status)
+	assert.Equal(t, certs[0].ID, status.CertificateID)
+	assert.True(t, status.Managed)
+	assert.NotEmpty(t, status.CertificateHash)
+	assert.Equal(t, certs[0].Fingerprint, status.Fingerprint)
+
+	// An unchanged CA is not uploaded again
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, mock.Store().ListAccessCertificates(), 1)
+
+	require.NoError(t, c.Delete(context.Background(), updated))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, mock.Store().ListAccessCertificates(), "the uploaded CA is deleted with the application")
+	assert.True(t, apierrors.IsNotFound(c.Get(context.Background(), req.NamespacedName, updated)))
+}
+
+func TestReconcile_AssociatesExistingClientCA(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateAccessCertificate(&models.AccessMutualTLSCertificate{
+		ID: "shared-ca", Name: "shared", AssociatedHostnames: []string{"other.example.com"},
+	})
+	app := newClientCertApp(&networkingv1alpha2.AccessApplicationClientCertificate{CloudflareID: "shared-ca"})
+	r, c := newTestReconciler(t, app)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	cert, ok := mock.Store().GetAccessCertificate("shared-ca")
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{"api.example.com", "api2.example.com", "other.example.com"}, cert.AssociatedHostnames)
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	require.NotNil(t, updated.Status.ClientCertificate)
+	assert.False(t, updated.Status.ClientCertificate.Managed)
+
+	// Removing the client certificate only removes the application hostnames
+	updated.Spec.ClientCertificate = nil
+	require.NoError(t, c.Update(context.Background(), updated))
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	cert, ok = mock.Store().GetAccessCertificate("shared-ca")
+	require.True(t, ok)
+	assert.Equal(t, []string{"other.example.com"}, cert.AssociatedHostnames)
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	assert.Nil(t, updated.Status.ClientCertificate)
+}
+
+func TestReconcile_RetriesReleaseOfRotatedClientCA(t *testing.T) {
+	mock := newMockServer(t)
+	app := newClientCertApp(&networkingv1alpha2.AccessApplicationClientCertificate{
+		CASecretRef: &networkingv1alpha2.SecretKeySelector{Name: "client-ca", Key: "ca.crt"},
+	})
+	r, c := newTestReconciler(t, app)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-ca", Namespace: "default"},
+		Data:       map[string][]byte{"ca.crt": []byte(testClientCA)},
+	}
+	require.NoError(t, c.Create(context.Background(), secret))
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	certs := mock.Store().ListAccessCertificates()
+	require.Len(t, certs, 1)
+	oldID := certs[0].ID
+
+	// Rotate the CA while deleting the previous one fails
+	secret.Data["ca.crt"] = []byte(testClientCA + testClientCA)
+	require.NoError(t, c.Update(context.Background(), secret))
+	require.NoError(t, mock.ErrorInjector().Add(injection.ErrorInjection{
+		PathPattern:   "/access/certificates/" + oldID + "$",
+		MethodPattern: http.MethodDelete,
+		ErrorType:     injection.ErrorTypeStatus,
+		StatusCode:    http.StatusBadRequest,
+		TriggerMode:   injection.TriggerModeAlways,
+	}))
+
+	result, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	status := updated.Status.ClientCertificate
+	require.NotNil(t, status)
+	assert.NotEqual(t, oldID, status.CertificateID)
+	assert.Equal(t, []string{oldID}, status.PendingReleaseIDs)
+	assert.Len(t, mock.Store().ListAccessCertificates(), 2)
+
+	// The previous CA is deleted once Cloudflare accepts the deletion
+	mock.ErrorInjector().Clear()
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	assert.Empty(t, updated.Status.ClientCertificate.PendingReleaseIDs)
+	certs = mock.Store().ListAccessCertificates()
+	require.Len(t, certs, 1)
+	assert.Equal(t, updated.Status.ClientCertificate.CertificateID, certs[0].ID)
+}
+
+func TestFindAccessApplicationsForClientCASecret(t *testing.T) {
+	local := newClientCertApp(&networkingv1alpha2.AccessApplicationClientCertificate{
+		CASecretRef: &networkingv1alpha2.SecretKeySelector{Name: "client-ca", Key: "ca.crt"},
+	})
+	remote := newClientCertApp(&networkingv1alpha2.AccessApplicationClientCertificate{
+		CASecretRef: &networkingv1alpha2.SecretKeySelector{Name: "client-ca", Namespace: "pki", Key: "ca.crt"},
+	})
+	remote.Name = "remote-ca"
+	existing := newClientCertApp(&networkingv1alpha2.AccessApplicationClientCertificate{CloudflareID: "shared-ca"})
+	existing.Name = "existing-ca"
+	r, c := newTestReconciler(t, local)
+	require.NoError(t, c.Create(context.Background(), remote))
+	require.NoError(t, c.Create(context.Background(), existing))
+
+	requests := r.findAccessApplicationsForClientCASecret(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-ca", Namespace: "default"},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, "internal-api", requests[0].Name)
+
+	requests = r.findAccessApplicationsForClientCASecret(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-ca", Namespace: "pki"},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, "remote-ca", requests[0].Name)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"slices"
+	"sort"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// orderPolicyRefs returns the indices of refs in evaluation order.
+// A ref's sort key is its precedence override, or its 1-based position in the
+// spec when no override is set; ties keep spec order.
+func orderPolicyRefs(refs []networkingv1alpha2.ReusablePolicyRef) []int {
+	order := make([]int, len(refs))
+	for i := range refs {
+		order[i] = i
+	}
+
+	key := func(i int) int {
+		if refs[i].Precedence != nil {
+			return *refs[i].Precedence
+		}
+		return i + 1
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return key(order[a]) < key(order[b])
+	})
+
+	return order
+}
+
+// desiredPolicyOrder merges the reusable policy IDs from the spec with the
+// policies currently attached to the application.
+//
+// Legacy application-scoped policies are not managed by the spec, so they keep
+// their position. The slots held by reusable policies are refilled with the
+// desired reusable policies in spec order, and any remaining desired policies
+// are appended. Reusable policies no longer in the spec are dropped.
+func desiredPolicyOrder(reusableIDs []string, remote []cf.AccessApplicationPolicyResult) []string {
+	order := make([]string, 0, len(reusableIDs)+len(remote))
+	next := 0
+	for _, policy := range remote {
+		if !policy.Reusable {
+			order = append(order, policy.ID)
+			continue
+		}
+		if next < len(reusableIDs) {
+			order = append(order, reusableIDs[next])
+			next++
+		}
+	}
+	return append(order, reusableIDs[next:]...)
+}
+
+// policyOrderMatches reports whether the remote policies are attached in the given order.
+func policyOrderMatches(remote []cf.AccessApplicationPolicyResult, order []string) bool {
+	if len(remote) != len(order) {
+		return false
+	}
+	for i, policy := range remote {
+		if policy.ID != order[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// assignPolicyPrecedence sets the precedence of each resolved policy to its
+// 1-based position in the final policy order.
+func assignPolicyPrecedence(resolved []networkingv1alpha2.ResolvedReusablePolicyStatus, order []string) {
+	for i := range resolved {
+		resolved[i].Precedence = slices.Index(order, resolved[i].PolicyID) + 1
+	}
+}
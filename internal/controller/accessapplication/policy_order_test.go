@@ -0,0 +1,373 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	policyA = "aaaaaaaa-0000-0000-0000-000000000001"
+	policyB = "bbbbbbbb-0000-0000-0000-000000000002"
+	policyC = "cccccccc-0000-0000-0000-000000000003"
+	legacy  = "legacy-app-policy"
+)
+
+func TestOrderPolicyRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		refs []networkingv1alpha2.ReusablePolicyRef
+		want []int
+	}{
+		{
+			name: "spec order",
+			refs: []networkingv1alpha2.ReusablePolicyRef{{CloudflareID: policyA}, {CloudflareID: policyB}},
+			want: []int{0, 1},
+		},
+		{
+			name: "precedence override moves ref first",
+			refs: []networkingv1alpha2.ReusablePolicyRef{
+				{CloudflareID: policyA},
+				{CloudflareID: policyB},
+				{CloudflareID: policyC, Precedence: ptr.To(1)},
+			},
+			want: []int{0, 2, 1},
+		},
+		{
+			name: "overrides sort before later positions",
+			refs: []networkingv1alpha2.ReusablePolicyRef{
+				{CloudflareID: policyA, Precedence: ptr.To(10)},
+				{CloudflareID: policyB, Precedence: ptr.To(5)},
+			},
+			want: []int{1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, orderPolicyRefs(tt.refs))
+		})
+	}
+}
+
+func TestDesiredPolicyOrder(t *testing.T) {
+	remote := []cf.AccessApplicationPolicyResult{
+		{ID: policyA, Precedence: 1, Reusable: true},
+		{ID: legacy, Precedence: 2},
+		{ID: policyB, Precedence: 3, Reusable: true},
+	}
+
+	tests := []struct {
+		name     string
+		desired  []string
+		want     []string
+		wantSame bool
+	}{
+		{name: "in sync", desired: []string{policyA, policyB}, want: []string{policyA, legacy, policyB}, wantSame: true},
+		{name: "reordered", desired: []string{policyB, policyA}, want: []string{policyB, legacy, policyA}},
+		{name: "added", desired: []string{policyC, policyA, policyB}, want: []string{policyC, legacy, policyA, policyB}},
+		{name: "removed", desired: []string{policyB}, want: []string{policyB, legacy}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := desiredPolicyOrder(tt.desired, remote)
+			assert.Equal(t, tt.want, order)
+			assert.Equal(t, tt.wantSame, policyOrderMatches(remote, order))
+		})
+	}
+
+	assert.Equal(t, []string{policyA}, desiredPolicyOrder([]string{policyA}, nil))
+}
+
+// accessAppStub serves a single Access Application and applies the policy
+// order of updates the way Cloudflare does: precedence follows array order.
+type accessAppStub struct {
+	mu       sync.Mutex
+	policies []cf.AccessApplicationPolicyResult
+	// updates records the policies sent with each update; nil when omitted.
+	updates [][]string
+	// reusable maps the names of the account's reusable policies to their IDs.
+	reusable map[string]string
+}
+
+func newAccessAppStub(t *testing.T, policies []cf.AccessApplicationPolicyResult) *accessAppStub {
+	t.Helper()
+	stub := &accessAppStub{policies: policies}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/test-account-id", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"test-account-id","name":"test"}}`))
+	})
+	mux.HandleFunc("/accounts/test-account-id/access/apps/app-1", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		if req.Method == http.MethodPut {
+			var body struct {
+				Policies []string `json:"policies"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			stub.updates = append(stub.updates, body.Policies)
+			if body.Policies != nil {
+				stub.reorder(body.Policies)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, stub.appJSON())
+	})
+	mux.HandleFunc("/accounts/test-account-id/access/policies", func(w http.ResponseWriter, _ *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		policies := make([]map[string]any, 0, len(stub.reusable))
+		for name, id := range stub.reusable {
+			policies = append(policies, map[string]any{"id": id, "name": name, "decision": "allow", "reusable": true})
+		}
+		result, _ := json.Marshal(policies)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, result)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL)
+	return stub
+}
+
+func (s *accessAppStub) reorder(ids []string) {
+	reusable := make(map[string]bool, len(s.policies))
+	for _, p := range s.policies {
+		reusable[p.ID] = p.Reusable
+	}
+	s.policies = s.policies[:0]
+	for i, id := range ids {
+		isReusable, known := reusable[id]
+		s.policies = append(s.policies, cf.AccessApplicationPolicyResult{
+			ID: id, Precedence: i + 1, Reusable: !known || isReusable,
+		})
+	}
+}
+
+func (s *accessAppStub) appJSON() string {
+	policies := make([]map[string]any, 0, len(s.policies))
+	for _, p := range s.policies {
+		policies = append(policies, map[string]any{"id": p.ID, "precedence": p.Precedence, "reusable": p.Reusable})
+	}
+	app, _ := json.Marshal(map[string]any{
+		"id": "app-1", "aud": "aud-1", "name": "app", "type": "self_hosted", "policies": policies,
+	})
+	return string(app)
+}
+
+func newTestReconciler(t *testing.T, app *networkingv1alpha2.AccessApplication) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "test-account-id",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			app,
+		).
+		WithStatusSubresource(app).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(10),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func TestReconcile_ReorderedPoliciesUpdatePrecedence(t *testing.T) {
+	stub := newAccessAppStub(t, []cf.AccessApplicationPolicyResult{
+		{ID: policyA, Precedence: 1, Reusable: true},
+		{ID: legacy, Precedence: 2},
+		{ID: policyB, Precedence: 3, Reusable: true},
+	})
+	app := &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app", Namespace: "default", Generation: 2, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type: "self_hosted",
+			// The user swapped the two policies
+			ReusablePolicyRefs: []networkingv1alpha2.ReusablePolicyRef{
+				{CloudflareID: policyB},
+				{CloudflareID: policyA},
+			},
+		},
+		Status: networkingv1alpha2.AccessApplicationStatus{ApplicationID: "app-1"},
+	}
+	r, c := newTestReconciler(t, app)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+
+	// The reusable policies swap slots while the legacy policy keeps its position
+	require.Len(t, stub.updates, 1)
+	assert.Equal(t, []string{policyB, legacy, policyA}, stub.updates[0])
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(app), updated))
+	assert.Equal(t, []string{policyB, policyA}, updated.Status.ResolvedPolicyIDs)
+	require.Len(t, updated.Status.ResolvedReusablePolicies, 2)
+	assert.Equal(t, policyB, updated.Status.ResolvedReusablePolicies[0].PolicyID)
+	assert.Equal(t, 1, updated.Status.ResolvedReusablePolicies[0].Precedence)
+	assert.Equal(t, policyA, updated.Status.ResolvedReusablePolicies[1].PolicyID)
+	assert.Equal(t, 3, updated.Status.ResolvedReusablePolicies[1].Precedence)
+
+	// Once the order matches, updates leave the policies alone
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+	require.Len(t, stub.updates, 2)
+	assert.Nil(t, stub.updates[1])
+}
+
+func TestReconcile_PrecedenceOverrideReordersPolicies(t *testing.T) {
+	stub := newAccessAppStub(t, []cf.AccessApplicationPolicyResult{
+		{ID: policyA, Precedence: 1, Reusable: true},
+		{ID: policyB, Precedence: 2, Reusable: true},
+	})
+	app := &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type: "self_hosted",
+			ReusablePolicyRefs: []networkingv1alpha2.ReusablePolicyRef{
+				{CloudflareID: policyA, Precedence: ptr.To(5)},
+				{CloudflareID: policyB},
+			},
+		},
+		Status: networkingv1alpha2.AccessApplicationStatus{ApplicationID: "app-1"},
+	}
+	r, _ := newTestReconciler(t, app)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+
+	require.Len(t, stub.updates, 1)
+	assert.Equal(t, []string{policyB, policyA}, stub.updates[0])
+	assert.Equal(t, policyB, stub.policies[0].ID)
+	assert.Equal(t, 1, stub.policies[0].Precedence)
+}
+
+func TestReconcile_ResolvesPolicyByCloudflareName(t *testing.T) {
+	stub := newAccessAppStub(t, []cf.AccessApplicationPolicyResult{{ID: policyA, Precedence: 1, Reusable: true}})
+	stub.reusable = map[string]string{"Employees": policyA}
+	app := &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:               "self_hosted",
+			ReusablePolicyRefs: []networkingv1alpha2.ReusablePolicyRef{{CloudflareName: "Employees"}},
+		},
+		Status: networkingv1alpha2.AccessApplicationStatus{ApplicationID: "app-1"},
+	}
+	r, c := newTestReconciler(t, app)
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)}
+
+	result, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, policyNameResolveInterval, result.RequeueAfter, "policy names are resolved again periodically")
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	assert.Equal(t, []string{policyA}, updated.Status.ResolvedPolicyIDs)
+	require.Len(t, updated.Status.ResolvedReusablePolicies, 1)
+	assert.Equal(t, "cloudflareName", updated.Status.ResolvedReusablePolicies[0].Source)
+	assert.Equal(t, "Employees", updated.Status.ResolvedReusablePolicies[0].PolicyName)
+
+	// The policy is recreated with a new ID
+	stub.reusable["Employees"] = policyB
+	_, err = r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Get(context.Background(), req.NamespacedName, updated))
+	assert.Equal(t, []string{policyB}, updated.Status.ResolvedPolicyIDs)
+	assert.Equal(t, []string{policyB}, stub.updates[len(stub.updates)-1])
+	assert.Contains(t, drainEvents(r), "PolicyReResolved")
+}
+
+func TestReconcile_MissingNamedPolicySetsCondition(t *testing.T) {
+	stub := newAccessAppStub(t, nil)
+	stub.reusable = map[string]string{"Employees": policyA}
+	app := &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:               "self_hosted",
+			ReusablePolicyRefs: []networkingv1alpha2.ReusablePolicyRef{{CloudflareName: "Contractors"}},
+		},
+		Status: networkingv1alpha2.AccessApplicationStatus{ApplicationID: "app-1"},
+	}
+	r, c := newTestReconciler(t, app)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+	assert.Empty(t, stub.updates, "the application is not updated without its policies")
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(app), updated))
+	ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, ReasonPolicyNotFound, ready.Reason)
+	assert.Contains(t, ready.Message, "Contractors")
+}
+
+// drainEvents returns the events recorded by the reconciler's fake recorder so far.
+func drainEvents(r *Reconciler) string {
+	recorder := r.Recorder.(*record.FakeRecorder)
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return strings.Join(events, "\n")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// scimStatusRefreshInterval is how often the SCIM provisioning status is refreshed.
+const scimStatusRefreshInterval = 10 * time.Minute
+
+// scimIdentityProvider returns the identity provider whose SCIM updates feed the
+// application, or "" when SCIM provisioning is disabled.
+func scimIdentityProvider(app *networkingv1alpha2.AccessApplication) string {
+	scim := app.Spec.SCIMConfig
+	if scim == nil || scim.Enabled == nil || !*scim.Enabled {
+		return ""
+	}
+	return scim.IDPUID
+}
+
+// scimNextCheck returns how long until the SCIM provisioning status of the application
+// is due to be checked again. It is zero or negative when a check is due.
+func scimNextCheck(app *networkingv1alpha2.AccessApplication, now time.Time) time.Duration {
+	if app.Status.SCIM == nil || app.Status.SCIM.LastChecked == nil {
+		return 0
+	}
+	return app.Status.SCIM.LastChecked.Add(scimStatusRefreshInterval).Sub(now)
+}
+
+// refreshSCIMStatus fetches the SCIM provisioning status of the application once
+// every scimStatusRefreshInterval, so the status is not rewritten on every reconcile.
+// It returns nil when SCIM provisioning is disabled, and the previous status when no
+// check is due or the status cannot be fetched.
+func (r *Reconciler) refreshSCIMStatus(
+	ctx context.Context,
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	api *cf.API,
+) *networkingv1alpha2.AccessApplicationSCIMStatus {
+	idpID := scimIdentityProvider(app)
+	if idpID == "" {
+		return nil
+	}
+	if scimNextCheck(app, time.Now()) > 0 {
+		return app.Status.SCIM
+	}
+
+	status, err := api.GetSCIMProvisioningStatus(ctx, idpID)
+	if err != nil {
+		logger.Error(err, "Failed to get SCIM provisioning status", "idpId", idpID)
+		r.Recorder.Event(app, corev1.EventTypeWarning, "SCIMStatusFailed",
+			fmt.Sprintf("Failed to get SCIM provisioning status: %s", cf.SanitizeErrorMessage(err)))
+		return app.Status.SCIM
+	}
+
+	now := metav1.Now()
+	result := &networkingv1alpha2.AccessApplicationSCIMStatus{
+		SyncedUsers:   status.SyncedUsers,
+		SyncedGroups:  status.SyncedGroups,
+		FailedUpdates: status.FailedUpdates,
+		LastError:     status.LastError,
+		LastChecked:   &now,
+	}
+	if status.LastSync != nil {
+		result.LastSync = &metav1.Time{Time: *status.LastSync}
+	}
+	if status.LastErrorTime != nil {
+		result.LastErrorTime = &metav1.Time{Time: *status.LastErrorTime}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newSCIMApp(enabled bool) *networkingv1alpha2.AccessApplication {
+	return &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "saas-app", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type: "saas",
+			SCIMConfig: &networkingv1alpha2.AccessApplicationSCIMConfig{
+				Enabled:   ptr.To(enabled),
+				RemoteURI: "https://scim.example.com/v2",
+				IDPUID:    "idp-1",
+			},
+		},
+	}
+}
+
+func TestReconcile_ReportsSCIMProvisioningStatus(t *testing.T) {
+	mock := newMockServer(t)
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, entry := range []models.AccessSCIMUpdateLog{
+		{IdPID: "idp-1", ResourceType: "USER", RequestMethod: "POST", Status: "SUCCESS", LoggedAt: base},
+		{IdPID: "idp-1", ResourceType: "GROUP", RequestMethod: "PATCH", Status: "SUCCESS", LoggedAt: base.Add(time.Minute)},
+		{IdPID: "idp-1", ResourceType: "USER", RequestMethod: "PUT", Status: "FAILURE",
+			ErrorDescription: "remote SCIM endpoint returned 500", LoggedAt: base.Add(2 * time.Minute)},
+		{IdPID: "idp-other", ResourceType: "USER", RequestMethod: "POST", Status: "FAILURE",
+			ErrorDescription: "other identity provider", LoggedAt: base.Add(3 * time.Minute)},
+	} {
+		mock.Store().AddSCIMUpdateLog(entry)
+	}
+	app := newSCIMApp(true)
+	r, c := newTestReconciler(t, app)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+	assert.InDelta(t, scimStatusRefreshInterval, result.RequeueAfter, float64(time.Second))
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(app), updated))
+	require.Equal(t, StateActive, updated.Status.State)
+	scim := updated.Status.SCIM
+	require.NotNil(t, scim)
+	assert.Equal(t, 1, scim.SyncedUsers)
+	assert.Equal(t, 1, scim.SyncedGroups)
+	assert.Equal(t, 1, scim.FailedUpdates)
+	assert.Equal(t, "remote SCIM endpoint returned 500", scim.LastError)
+	require.NotNil(t, scim.LastSync)
+	assert.True(t, base.Add(time.Minute).Equal(scim.LastSync.Time))
+	require.NotNil(t, scim.LastErrorTime)
+	assert.True(t, base.Add(2*time.Minute).Equal(scim.LastErrorTime.Time))
+	assert.NotNil(t, scim.LastChecked)
+}
+
+func TestReconcile_SCIMStatusCheckedOncePerInterval(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().AddSCIMUpdateLog(models.AccessSCIMUpdateLog{
+		IdPID: "idp-1", ResourceType: "USER", RequestMethod: "POST", Status: "SUCCESS", LoggedAt: time.Now(),
+	})
+	app := newSCIMApp(true)
+	r, c := newTestReconciler(t, app)
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	checked := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(app), checked))
+	require.NotNil(t, checked.Status.SCIM)
+	assert.Equal(t, 1, checked.Status.SCIM.SyncedUsers)
+
+	// A reconcile triggered by the status write leaves the SCIM status unchanged
+	mock.Store().AddSCIMUpdateLog(models.AccessSCIMUpdateLog{
+		IdPID: "idp-1", ResourceType: "USER", RequestMethod: "POST", Status: "SUCCESS", LoggedAt: time.Now(),
+	})
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+	assert.LessOrEqual(t, result.RequeueAfter, scimStatusRefreshInterval)
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(app), updated))
+	assert.Equal(t, checked.Status.SCIM, updated.Status.SCIM)
+}
+
+func TestReconcile_SkipsSCIMStatusWhenDisabled(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().AddSCIMUpdateLog(models.AccessSCIMUpdateLog{
+		IdPID: "idp-1", ResourceType: "USER", Status: "SUCCESS", LoggedAt: time.Now(),
+	})
+	app := newSCIMApp(false)
+	app.Status.SCIM = &networkingv1alpha2.AccessApplicationSCIMStatus{SyncedUsers: 3}
+	r, c := newTestReconciler(t, app)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(app), updated))
+	require.Equal(t, StateActive, updated.Status.State)
+	assert.Nil(t, updated.Status.SCIM, "stale SCIM status is cleared once SCIM is disabled")
+}
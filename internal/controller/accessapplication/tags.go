@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// errUnknownTags is returned when the application references tags that do not exist
+// and auto-creation is disabled.
+var errUnknownTags = errors.New("unknown access tags")
+
+// ensureTags makes sure every tag of the application exists in the account before it
+// is assigned, creating missing tags unless autoCreateTags is disabled.
+func (r *Reconciler) ensureTags(
+	ctx context.Context,
+	logger logr.Logger,
+	app *networkingv1alpha2.AccessApplication,
+	api *cf.API,
+) error {
+	missing, err := api.MissingAccessTags(ctx, app.Spec.Tags)
+	if err != nil {
+		return fmt.Errorf("list access tags: %w", err)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if app.Spec.AutoCreateTags != nil && !*app.Spec.AutoCreateTags {
+		return fmt.Errorf("%w: %s (create them in Cloudflare or enable autoCreateTags)",
+			errUnknownTags, strings.Join(missing, ", "))
+	}
+
+	for _, name := range missing {
+		if _, err := api.CreateAccessTag(ctx, name); err != nil {
+			return fmt.Errorf("create access tag %q: %w", name, err)
+		}
+		logger.Info("Created Access tag", "tag", name)
+		r.Recorder.Event(app, corev1.EventTypeNormal, "TagCreated",
+			fmt.Sprintf("Access tag '%s' created in Cloudflare", name))
+	}
+	return nil
+}
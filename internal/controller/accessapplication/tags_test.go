@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newTaggedApp(tags []string, autoCreate *bool) *networkingv1alpha2.AccessApplication {
+	return &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dashboard", Namespace: "default", Generation: 1, Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:           "self_hosted",
+			Domain:         "dashboard.example.com",
+			Tags:           tags,
+			AutoCreateTags: autoCreate,
+		},
+	}
+}
+
+func TestReconcile_CreatesMissingTags(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateAccessTag(&models.AccessTag{Name: "engineering"})
+	app := newTaggedApp([]string{"engineering", "internal"}, nil)
+	r, c := newTestReconciler(t, app)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+
+	_, ok := mock.Store().GetAccessTag("internal")
+	assert.True(t, ok, "missing tag is created")
+	assert.Equal(t, 1, mock.CountRequests("POST", "/access/tags$"), "existing tag is not created again")
+
+	apps := mock.Store().ListAccessApplications()
+	require.Len(t, apps, 1)
+	assert.Equal(t, []string{"engineering", "internal"}, apps[0].Tags)
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(app), updated))
+	assert.Equal(t, StateActive, updated.Status.State)
+}
+
+func TestReconcile_UnknownTagsWithoutAutoCreate(t *testing.T) {
+	mock := newMockServer(t)
+	autoCreate := false
+	app := newTaggedApp([]string{"internal"}, &autoCreate)
+	r, c := newTestReconciler(t, app)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(app)})
+	require.NoError(t, err)
+
+	_, ok := mock.Store().GetAccessTag("internal")
+	assert.False(t, ok, "tag is not created")
+	assert.Empty(t, mock.Store().ListAccessApplications(), "application is not created with unknown tags")
+
+	updated := &networkingv1alpha2.AccessApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(app), updated))
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonUnknownTags, cond.Reason)
+	assert.Contains(t, cond.Message, "internal")
+}
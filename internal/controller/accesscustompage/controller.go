@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package accesscustompage provides a controller for managing Cloudflare Access custom pages.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package accesscustompage
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	finalizerName = "accesscustompage.networking.cloudflare-operator.io/finalizer"
+
+	// Reasons for the Ready condition and events
+	ReasonSynced        = "Synced"
+	ReasonInSync        = "InSync"
+	ReasonDriftDetected = "DriftDetected"
+)
+
+// Reconciler reconciles an AccessCustomPage object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accesscustompages,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accesscustompages/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accesscustompages/finalizers,verbs=update
+
+// Reconcile handles AccessCustomPage reconciliation
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Get the AccessCustomPage resource
+	page := &networkingv1alpha2.AccessCustomPage{}
+	if err := r.Get(ctx, req.NamespacedName, page); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch AccessCustomPage")
+		return common.NoRequeue(), err
+	}
+
+	// Handle deletion
+	if !page.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, page)
+	}
+
+	// Ensure finalizer
+	if added, err := controller.EnsureFinalizer(ctx, r.Client, page, finalizerName); err != nil {
+		return common.NoRequeue(), err
+	} else if added {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Get API client
+	// AccessCustomPage is cluster-scoped, use operator namespace for legacy inline secrets
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CloudflareDetails: &page.Spec.Cloudflare,
+		Namespace:         common.OperatorNamespace,
+		StatusAccountID:   page.Status.AccountID,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, page, err)
+	}
+
+	// Sync page to Cloudflare
+	return r.syncPage(ctx, page, apiResult)
+}
+
+// handleDeletion handles the deletion of AccessCustomPage.
+func (r *Reconciler) handleDeletion(
+	ctx context.Context,
+	page *networkingv1alpha2.AccessCustomPage,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(page, finalizerName) {
+		return common.NoRequeue(), nil
+	}
+
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, page, page.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CloudflareDetails: &page.Spec.Cloudflare,
+			Namespace:         common.OperatorNamespace,
+			StatusAccountID:   page.Status.AccountID,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if page.Status.PageID != "" {
+			logger.Info("Deleting Access custom page from Cloudflare",
+				"pageId", page.Status.PageID)
+
+			if err := apiResult.API.DeleteAccessCustomPage(ctx, page.Status.PageID); err != nil {
+				logger.Error(err, "Failed to delete Access custom page from Cloudflare")
+				if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, page, page.Spec.DeletionTimeout, err); retry {
+					return result, nil
+				}
+			} else {
+				r.Recorder.Event(page, corev1.EventTypeNormal, "Deleted",
+					"Access custom page deleted from Cloudflare")
+			}
+		}
+	}
+
+	// Remove finalizer
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, page, func() {
+		controllerutil.RemoveFinalizer(page, finalizerName)
+	}); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return common.NoRequeue(), err
+	}
+	r.Recorder.Event(page, corev1.EventTypeNormal, controller.EventReasonFinalizerRemoved, "Finalizer removed")
+
+	return common.NoRequeue(), nil
+}
+
+// syncPage syncs the Access custom page to Cloudflare.
+func (r *Reconciler) syncPage(
+	ctx context.Context,
+	page *networkingv1alpha2.AccessCustomPage,
+	apiResult *common.APIClientResult,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	pageName := page.GetAccessCustomPageName()
+	params := cf.AccessCustomPageParams{
+		Name:       pageName,
+		Type:       page.Spec.Type,
+		CustomHTML: page.Spec.CustomHTML,
+	}
+
+	// Check if page already exists by ID
+	if page.Status.PageID != "" {
+		existing, err := apiResult.API.GetAccessCustomPage(ctx, page.Status.PageID)
+		if err != nil {
+			if !cf.IsNotFoundError(err) {
+				logger.Error(err, "Failed to get Access custom page from Cloudflare")
+				return r.updateStatusError(ctx, page, err)
+			}
+			// Page doesn't exist, will create
+			logger.Info("Access custom page not found in Cloudflare, will recreate",
+				"pageId", page.Status.PageID)
+		} else {
+			if cf.AccessCustomPageInSync(params, existing) {
+				logger.V(1).Info("Access custom page is in sync with Cloudflare", "pageId", existing.ID)
+				return r.updateStatusReady(ctx, page, apiResult.AccountID, existing.ID, ReasonInSync)
+			}
+
+			// The spec has not changed since the last successful sync, so the page was
+			// modified outside the operator
+			if page.Status.State == "Ready" && page.Status.ObservedGeneration == page.Generation {
+				r.Recorder.Event(page, corev1.EventTypeWarning, ReasonDriftDetected,
+					fmt.Sprintf("Access custom page '%s' was modified outside the operator, restoring desired content", pageName))
+			}
+
+			result, err := apiResult.API.UpdateAccessCustomPage(ctx, existing.ID, params)
+			if err != nil {
+				logger.Error(err, "Failed to update Access custom page")
+				return r.updateStatusError(ctx, page, err)
+			}
+
+			r.Recorder.Event(page, corev1.EventTypeNormal, "Updated",
+				fmt.Sprintf("Access custom page '%s' updated in Cloudflare", pageName))
+
+			return r.updateStatusReady(ctx, page, apiResult.AccountID, result.ID, ReasonSynced)
+		}
+	}
+
+	// Try to find existing page by name
+	existingByName, err := apiResult.API.GetAccessCustomPageByName(ctx, pageName)
+	if err != nil {
+		logger.Error(err, "Failed to search for existing Access custom page")
+		return r.updateStatusError(ctx, page, err)
+	}
+
+	if existingByName != nil {
+		// Page already exists with this name, adopt it
+		logger.Info("Access custom page already exists with same name, adopting it",
+			"pageId", existingByName.ID,
+			"name", pageName)
+
+		result, err := apiResult.API.UpdateAccessCustomPage(ctx, existingByName.ID, params)
+		if err != nil {
+			logger.Error(err, "Failed to update existing Access custom page")
+			return r.updateStatusError(ctx, page, err)
+		}
+
+		r.Recorder.Event(page, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing Access custom page '%s'", pageName))
+
+		return r.updateStatusReady(ctx, page, apiResult.AccountID, result.ID, ReasonSynced)
+	}
+
+	// Create new page
+	logger.Info("Creating Access custom page in Cloudflare", "name", pageName)
+
+	result, err := apiResult.API.CreateAccessCustomPage(ctx, params)
+	if err != nil {
+		logger.Error(err, "Failed to create Access custom page")
+		return r.updateStatusError(ctx, page, err)
+	}
+
+	r.Recorder.Event(page, corev1.EventTypeNormal, "Created",
+		fmt.Sprintf("Access custom page '%s' created in Cloudflare", pageName))
+
+	return r.updateStatusReady(ctx, page, apiResult.AccountID, result.ID, ReasonSynced)
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	page *networkingv1alpha2.AccessCustomPage,
+	err error,
+) (ctrl.Result, error) {
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, page, func() {
+		page.Status.State = "Error"
+		meta.SetStatusCondition(&page.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: page.Generation,
+			Reason:             "Error",
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		page.Status.ObservedGeneration = page.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+func (r *Reconciler) updateStatusReady(
+	ctx context.Context,
+	page *networkingv1alpha2.AccessCustomPage,
+	accountID, pageID, reason string,
+) (ctrl.Result, error) {
+	message := "Access custom page synced to Cloudflare"
+	if reason == ReasonInSync {
+		message = "Access custom page is in sync with Cloudflare"
+	}
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, page, func() {
+		page.Status.AccountID = accountID
+		page.Status.PageID = pageID
+		page.Status.State = "Ready"
+		meta.SetStatusCondition(&page.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: page.Generation,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		page.Status.ObservedGeneration = page.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return common.NoRequeue(), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("accesscustompage-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("accesscustompage"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.AccessCustomPage{}).
+		Named("accesscustompage").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.AccessCustomPage{}, r))
+}
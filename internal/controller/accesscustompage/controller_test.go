@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accesscustompage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.AccessCustomPage{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newPage() *networkingv1alpha2.AccessCustomPage {
+	return &networkingv1alpha2.AccessCustomPage{
+		ObjectMeta: metav1.ObjectMeta{Name: "blocked", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec: networkingv1alpha2.AccessCustomPageSpec{
+			Type:       "forbidden",
+			CustomHTML: "<html><body>Blocked</body></html>",
+		},
+	}
+}
+
+func reconcilePage(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.AccessCustomPage {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "blocked"}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.AccessCustomPage{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "blocked"}, updated))
+	return updated
+}
+
+func drainEvents(recorder *record.FakeRecorder) string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return strings.Join(events, "\n")
+		}
+	}
+}
+
+func TestReconcile_CreatesPage(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPage())
+
+	updated := reconcilePage(t, r, c)
+
+	require.NotEmpty(t, updated.Status.PageID)
+	assert.Equal(t, "Ready", updated.Status.State)
+	assert.Equal(t, "test-account-id", updated.Status.AccountID)
+	remote, ok := mock.Store().GetAccessCustomPage(updated.Status.PageID)
+	require.True(t, ok)
+	assert.Equal(t, "blocked", remote.Name)
+	assert.Equal(t, "forbidden", remote.Type)
+	assert.Equal(t, "<html><body>Blocked</body></html>", remote.CustomHTML)
+
+	// A second reconcile finds the page in sync
+	updated = reconcilePage(t, r, c)
+	assert.Equal(t, ReasonInSync, meta.FindStatusCondition(updated.Status.Conditions, "Ready").Reason)
+	assert.Zero(t, mock.CountRequests(http.MethodPut, "/access/custom_pages/"))
+}
+
+func TestReconcile_AdoptsPageByName(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateAccessCustomPage(&models.AccessCustomPage{
+		UID: "page-1", Name: "blocked", Type: "forbidden", CustomHTML: "<p>old</p>",
+	})
+	r, c := newTestReconciler(t, newPage())
+
+	updated := reconcilePage(t, r, c)
+
+	assert.Equal(t, "page-1", updated.Status.PageID)
+	remote, _ := mock.Store().GetAccessCustomPage("page-1")
+	assert.Equal(t, "<html><body>Blocked</body></html>", remote.CustomHTML)
+	assert.Contains(t, drainEvents(r.Recorder.(*record.FakeRecorder)), "Adopted")
+}
+
+func TestReconcile_OutOfBandEditIsReverted(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateAccessCustomPage(&models.AccessCustomPage{
+		UID: "page-1", Name: "blocked", Type: "forbidden", CustomHTML: "<p>edited</p>",
+	})
+	page := newPage()
+	page.Status = networkingv1alpha2.AccessCustomPageStatus{PageID: "page-1", State: "Ready", ObservedGeneration: 1}
+	r, c := newTestReconciler(t, page)
+
+	reconcilePage(t, r, c)
+
+	remote, _ := mock.Store().GetAccessCustomPage("page-1")
+	assert.Equal(t, "<html><body>Blocked</body></html>", remote.CustomHTML)
+	assert.Contains(t, drainEvents(r.Recorder.(*record.FakeRecorder)), ReasonDriftDetected)
+}
+
+func TestReconcile_DeletesPage(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateAccessCustomPage(&models.AccessCustomPage{
+		UID: "page-1", Name: "blocked", Type: "forbidden", CustomHTML: "<p>x</p>",
+	})
+	page := newPage()
+	page.Status.PageID = "page-1"
+	now := metav1.Now()
+	page.DeletionTimestamp = &now
+	r, c := newTestReconciler(t, page)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "blocked"}})
+	require.NoError(t, err)
+
+	_, ok := mock.Store().GetAccessCustomPage("page-1")
+	assert.False(t, ok)
+	err = c.Get(context.Background(), types.NamespacedName{Name: "blocked"}, &networkingv1alpha2.AccessCustomPage{})
+	assert.True(t, client.IgnoreNotFound(err) == nil)
+}
@@ -30,6 +30,11 @@ import (
 
 const (
 	finalizerName = "accessgroup.networking.cloudflare-operator.io/finalizer"
+
+	// Reasons for the Ready condition and events
+	ReasonSynced        = "Synced"
+	ReasonInSync        = "InSync"
+	ReasonDriftDetected = "DriftDetected"
 )
 
 // Reconciler reconciles an AccessGroup object.
@@ -98,32 +103,37 @@ func (r *Reconciler) handleDeletion(
 		return common.NoRequeue(), nil
 	}
 
-	// Get API client
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CloudflareDetails: &accessGroup.Spec.Cloudflare,
-		Namespace:         common.OperatorNamespace,
-		StatusAccountID:   accessGroup.Status.AccountID,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if accessGroup.Status.GroupID != "" {
-		// Delete group from Cloudflare
-		logger.Info("Deleting Access Group from Cloudflare",
-			"groupId", accessGroup.Status.GroupID)
-
-		if err := apiResult.API.DeleteAccessGroup(ctx, accessGroup.Status.GroupID); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete Access Group from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(accessGroup, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, accessGroup, accessGroup.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CloudflareDetails: &accessGroup.Spec.Cloudflare,
+			Namespace:         common.OperatorNamespace,
+			StatusAccountID:   accessGroup.Status.AccountID,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if accessGroup.Status.GroupID != "" {
+			// Delete group from Cloudflare
+			logger.Info("Deleting Access Group from Cloudflare",
+				"groupId", accessGroup.Status.GroupID)
+
+			if err := apiResult.API.DeleteAccessGroup(ctx, accessGroup.Status.GroupID); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete Access Group from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, accessGroup, accessGroup.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("Access Group not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("Access Group not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Deleted",
+					"Access Group deleted from Cloudflare")
 			}
-		} else {
-			r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Deleted",
-				"Access Group deleted from Cloudflare")
 		}
 	}
 
@@ -175,21 +185,7 @@ func (r *Reconciler) syncAccessGroup(
 			logger.Info("Access Group not found in Cloudflare, will recreate",
 				"groupId", accessGroup.Status.GroupID)
 		} else {
-			// Group exists, update it
-			logger.V(1).Info("Updating Access Group in Cloudflare",
-				"groupId", existing.ID,
-				"name", groupName)
-
-			result, err := apiResult.API.UpdateAccessGroup(ctx, existing.ID, params)
-			if err != nil {
-				logger.Error(err, "Failed to update Access Group")
-				return r.updateStatusError(ctx, accessGroup, err)
-			}
-
-			r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Updated",
-				fmt.Sprintf("Access Group '%s' updated in Cloudflare", groupName))
-
-			return r.updateStatusReady(ctx, accessGroup, apiResult.AccountID, result.ID)
+			return r.syncExistingGroup(ctx, accessGroup, apiResult, existing, params, false)
 		}
 	}
 
@@ -206,17 +202,7 @@ func (r *Reconciler) syncAccessGroup(
 			"groupId", existingByName.ID,
 			"name", groupName)
 
-		// Update the existing group
-		result, err := apiResult.API.UpdateAccessGroup(ctx, existingByName.ID, params)
-		if err != nil {
-			logger.Error(err, "Failed to update existing Access Group")
-			return r.updateStatusError(ctx, accessGroup, err)
-		}
-
-		r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Adopted",
-			fmt.Sprintf("Adopted existing Access Group '%s'", groupName))
-
-		return r.updateStatusReady(ctx, accessGroup, apiResult.AccountID, result.ID)
+		return r.syncExistingGroup(ctx, accessGroup, apiResult, existingByName, params, true)
 	}
 
 	// Create new group
@@ -232,7 +218,53 @@ func (r *Reconciler) syncAccessGroup(
 	r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("Access Group '%s' created in Cloudflare", groupName))
 
-	return r.updateStatusReady(ctx, accessGroup, apiResult.AccountID, result.ID)
+	return r.updateStatusReady(ctx, accessGroup, apiResult.AccountID, result.ID, ReasonSynced)
+}
+
+// syncExistingGroup updates an existing Access Group when its rules differ from the
+// desired rules, and leaves it untouched when it is already in sync.
+func (r *Reconciler) syncExistingGroup(
+	ctx context.Context,
+	accessGroup *networkingv1alpha2.AccessGroup,
+	apiResult *common.APIClientResult,
+	existing *cf.AccessGroupResult,
+	params cf.AccessGroupParams,
+	adopted bool,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if adopted {
+		r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing Access Group '%s'", params.Name))
+	}
+
+	if cf.AccessGroupInSync(params, existing) {
+		logger.V(1).Info("Access Group is in sync with Cloudflare", "groupId", existing.ID)
+		return r.updateStatusReady(ctx, accessGroup, apiResult.AccountID, existing.ID, ReasonInSync)
+	}
+
+	// The spec has not changed since the last successful sync, so the group was
+	// modified outside the operator
+	if !adopted && accessGroup.Status.State == "Ready" &&
+		accessGroup.Status.ObservedGeneration == accessGroup.Generation {
+		r.Recorder.Event(accessGroup, corev1.EventTypeWarning, ReasonDriftDetected,
+			fmt.Sprintf("Access Group '%s' was modified outside the operator, restoring desired rules", params.Name))
+	}
+
+	logger.V(1).Info("Updating Access Group in Cloudflare",
+		"groupId", existing.ID,
+		"name", params.Name)
+
+	result, err := apiResult.API.UpdateAccessGroup(ctx, existing.ID, params)
+	if err != nil {
+		logger.Error(err, "Failed to update Access Group")
+		return r.updateStatusError(ctx, accessGroup, err)
+	}
+
+	r.Recorder.Event(accessGroup, corev1.EventTypeNormal, "Updated",
+		fmt.Sprintf("Access Group '%s' updated in Cloudflare", params.Name))
+
+	return r.updateStatusReady(ctx, accessGroup, apiResult.AccountID, result.ID, ReasonSynced)
 }
 
 // convertRulesToCF converts AccessGroupRule slice to cf.AccessGroupRuleParams slice.
@@ -412,8 +444,13 @@ func (r *Reconciler) updateStatusError(
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	accessGroup *networkingv1alpha2.AccessGroup,
-	accountID, groupID string,
+	accountID, groupID, reason string,
 ) (ctrl.Result, error) {
+	message := "Access Group synced to Cloudflare"
+	if reason == ReasonInSync {
+		message = "Access Group is in sync with Cloudflare"
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, accessGroup, func() {
 		accessGroup.Status.AccountID = accountID
 		accessGroup.Status.GroupID = groupID
@@ -422,8 +459,8 @@ func (r *Reconciler) updateStatusReady(
 			Type:               "Ready",
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: accessGroup.Generation,
-			Reason:             "Synced",
-			Message:            "Access Group synced to Cloudflare",
+			Reason:             reason,
+			Message:            message,
 			LastTransitionTime: metav1.Now(),
 		})
 		accessGroup.Status.ObservedGeneration = accessGroup.Generation
@@ -446,5 +483,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.AccessGroup{}).
 		Named("accessgroup").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.AccessGroup{}, r))
 }
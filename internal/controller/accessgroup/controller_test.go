@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessgroup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/injection"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.AccessGroup{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func emailRule(email string) networkingv1alpha2.AccessGroupRule {
+	return networkingv1alpha2.AccessGroupRule{Email: &networkingv1alpha2.AccessGroupEmailRule{Email: email}}
+}
+
+// newSyncedGroup returns an AccessGroup that was last synced to group-1, whose
+// include rules are alice and bob in that order.
+func newSyncedGroup(mock *mockserver.Server, include ...networkingv1alpha2.AccessGroupRule) *networkingv1alpha2.AccessGroup {
+	mock.Store().CreateAccessGroup(&models.AccessGroup{
+		ID:   "group-1",
+		Name: "employees",
+		Include: []models.AccessRule{
+			{Email: &models.EmailRule{Email: "alice@example.com"}},
+			{Email: &models.EmailRule{Email: "bob@example.com"}},
+		},
+	})
+	return &networkingv1alpha2.AccessGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "employees", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec:       networkingv1alpha2.AccessGroupSpec{Include: include},
+		Status: networkingv1alpha2.AccessGroupStatus{
+			GroupID:            "group-1",
+			State:              "Ready",
+			ObservedGeneration: 1,
+		},
+	}
+}
+
+func reconcileGroup(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.AccessGroup {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "employees"}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.AccessGroup{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "employees"}, updated))
+	return updated
+}
+
+func countGroupUpdates(mock *mockserver.Server) int {
+	return mock.CountRequests(http.MethodPut, "/access/groups/group-1$")
+}
+
+func TestReconcile_ReorderedRulesDoNotUpdate(t *testing.T) {
+	mock := newMockServer(t)
+	group := newSyncedGroup(mock, emailRule("bob@example.com"), emailRule("alice@example.com"))
+	r, c := newTestReconciler(t, group)
+
+	updated := reconcileGroup(t, r, c)
+
+	assert.Zero(t, countGroupUpdates(mock))
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, ReasonInSync, cond.Reason)
+}
+
+func TestReconcile_ChangedRulesUpdate(t *testing.T) {
+	mock := newMockServer(t)
+	group := newSyncedGroup(mock, emailRule("alice@example.com"), emailRule("carol@example.com"))
+	group.Generation = 2
+	r, c := newTestReconciler(t, group)
+
+	updated := reconcileGroup(t, r, c)
+
+	assert.Equal(t, 1, countGroupUpdates(mock))
+	assert.Equal(t, ReasonSynced, meta.FindStatusCondition(updated.Status.Conditions, "Ready").Reason)
+	remote, _ := mock.Store().GetAccessGroup("group-1")
+	require.Len(t, remote.Include, 2)
+	assert.Equal(t, "carol@example.com", remote.Include[1].Email.Email)
+
+	// The spec changed, so the update is not reported as drift
+	recorder := r.Recorder.(*record.FakeRecorder)
+	assert.NotContains(t, strings.Join(drainEvents(recorder), "\n"), ReasonDriftDetected)
+
+	// Once updated, the group is in sync
+	reconcileGroup(t, r, c)
+	assert.Equal(t, 1, countGroupUpdates(mock))
+}
+
+func TestReconcile_OutOfBandEditIsReverted(t *testing.T) {
+	mock := newMockServer(t)
+	group := newSyncedGroup(mock, emailRule("alice@example.com"), emailRule("bob@example.com"))
+	r, c := newTestReconciler(t, group)
+	mock.Store().UpdateAccessGroup("group-1", func(g *models.AccessGroup) {
+		g.Include = append(g.Include, models.AccessRule{Email: &models.EmailRule{Email: "mallory@example.com"}})
+	})
+
+	reconcileGroup(t, r, c)
+
+	assert.Equal(t, 1, countGroupUpdates(mock))
+	remote, _ := mock.Store().GetAccessGroup("group-1")
+	assert.Len(t, remote.Include, 2)
+	recorder := r.Recorder.(*record.FakeRecorder)
+	assert.Contains(t, strings.Join(drainEvents(recorder), "\n"), ReasonDriftDetected)
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+// newDeletingGroup returns a synced AccessGroup whose deletion started at deletedAt
+// and whose deletion from Cloudflare fails.
+func newDeletingGroup(t *testing.T, mock *mockserver.Server, deletedAt time.Time) *networkingv1alpha2.AccessGroup {
+	t.Helper()
+	group := newSyncedGroup(mock, emailRule("alice@example.com"), emailRule("bob@example.com"))
+	group.DeletionTimestamp = &metav1.Time{Time: deletedAt}
+	group.Spec.DeletionTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	require.NoError(t, mock.ErrorInjector().Add(injection.ErrorInjection{
+		PathPattern:   "/access/groups/group-1$",
+		MethodPattern: http.MethodDelete,
+		ErrorType:     injection.ErrorTypeConflict,
+		TriggerMode:   injection.TriggerModeAlways,
+	}))
+	return group
+}
+
+func TestReconcile_FailedDeletionIsRetriedWithinTimeout(t *testing.T) {
+	mock := newMockServer(t)
+	group := newDeletingGroup(t, mock, time.Now().Add(-time.Minute))
+	r, c := newTestReconciler(t, group)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "employees"}})
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+
+	updated := &networkingv1alpha2.AccessGroup{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "employees"}, updated))
+	assert.Contains(t, updated.Finalizers, finalizerName)
+	events := strings.Join(drainEvents(r.Recorder.(*record.FakeRecorder)), "\n")
+	assert.Contains(t, events, controller.EventReasonDeleteFailed)
+	assert.NotContains(t, events, controller.EventReasonForcedDeletion)
+}
+
+func TestReconcile_DeletionTimeoutRemovesFinalizer(t *testing.T) {
+	mock := newMockServer(t)
+	group := newDeletingGroup(t, mock, time.Now().Add(-time.Hour))
+	r, c := newTestReconciler(t, group)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "employees"}})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	err = c.Get(context.Background(), types.NamespacedName{Name: "employees"}, &networkingv1alpha2.AccessGroup{})
+	assert.True(t, apierrors.IsNotFound(err), "finalizer should be removed, got %v", err)
+	_, stillExists := mock.Store().GetAccessGroup("group-1")
+	assert.True(t, stillExists)
+	events := strings.Join(drainEvents(r.Recorder.(*record.FakeRecorder)), "\n")
+	assert.Contains(t, events, controller.EventReasonForcedDeletion)
+}
+
+func TestReconcile_DeletionPolicy(t *testing.T) {
+	tests := []struct {
+		policy      string
+		wantDeleted bool
+	}{
+		{policy: "", wantDeleted: true},
+		{policy: common.DeletionPolicyDelete, wantDeleted: true},
+		{policy: common.DeletionPolicyOrphan, wantDeleted: false},
+	}
+
+	for _, tt := range tests {
+		t.Run("policy "+tt.policy, func(t *testing.T) {
+			mock := newMockServer(t)
+			group := newSyncedGroup(mock, emailRule("alice@example.com"), emailRule("bob@example.com"))
+			group.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			group.Spec.DeletionPolicy = tt.policy
+			r, c := newTestReconciler(t, group)
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "employees"}})
+			require.NoError(t, err)
+
+			err = c.Get(context.Background(), types.NamespacedName{Name: "employees"}, &networkingv1alpha2.AccessGroup{})
+			assert.True(t, apierrors.IsNotFound(err), "finalizer should be removed, got %v", err)
+			_, exists := mock.Store().GetAccessGroup("group-1")
+			assert.Equal(t, !tt.wantDeleted, exists)
+			events := strings.Join(drainEvents(r.Recorder.(*record.FakeRecorder)), "\n")
+			assert.Contains(t, events, common.EventReasonDeletionPolicy)
+		})
+	}
+}
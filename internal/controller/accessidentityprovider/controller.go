@@ -29,6 +29,9 @@ import (
 
 const (
 	finalizerName = "accessidentityprovider.networking.cloudflare-operator.io/finalizer"
+
+	// ReasonDriftDetected is the event reason for changes made outside the operator.
+	ReasonDriftDetected = "DriftDetected"
 )
 
 // Reconciler reconciles an AccessIdentityProvider object.
@@ -43,6 +46,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accessidentityproviders,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accessidentityproviders/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accessidentityproviders/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
 
 // Reconcile handles AccessIdentityProvider reconciliation
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -97,32 +101,37 @@ func (r *Reconciler) handleDeletion(
 		return common.NoRequeue(), nil
 	}
 
-	// Get API client
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CloudflareDetails: &idp.Spec.Cloudflare,
-		Namespace:         common.OperatorNamespace,
-		StatusAccountID:   idp.Status.AccountID,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if idp.Status.ProviderID != "" {
-		// Delete identity provider from Cloudflare
-		logger.Info("Deleting Access Identity Provider from Cloudflare",
-			"providerId", idp.Status.ProviderID)
-
-		if err := apiResult.API.DeleteAccessIdentityProvider(ctx, idp.Status.ProviderID); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete Access Identity Provider from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(idp, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, idp, idp.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CloudflareDetails: &idp.Spec.Cloudflare,
+			Namespace:         common.OperatorNamespace,
+			StatusAccountID:   idp.Status.AccountID,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if idp.Status.ProviderID != "" {
+			// Delete identity provider from Cloudflare
+			logger.Info("Deleting Access Identity Provider from Cloudflare",
+				"providerId", idp.Status.ProviderID)
+
+			if err := apiResult.API.DeleteAccessIdentityProvider(ctx, idp.Status.ProviderID); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete Access Identity Provider from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, idp, idp.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("Access Identity Provider not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("Access Identity Provider not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(idp, corev1.EventTypeNormal, "Deleted",
+					"Access Identity Provider deleted from Cloudflare")
 			}
-		} else {
-			r.Recorder.Event(idp, corev1.EventTypeNormal, "Deleted",
-				"Access Identity Provider deleted from Cloudflare")
 		}
 	}
 
@@ -164,21 +173,7 @@ func (r *Reconciler) syncIdentityProvider(
 			logger.Info("Access Identity Provider not found in Cloudflare, will recreate",
 				"providerId", idp.Status.ProviderID)
 		} else {
-			// Provider exists, update it
-			logger.V(1).Info("Updating Access Identity Provider in Cloudflare",
-				"providerId", existing.ID,
-				"name", providerName)
-
-			result, err := apiResult.API.UpdateAccessIdentityProvider(ctx, existing.ID, params)
-			if err != nil {
-				logger.Error(err, "Failed to update Access Identity Provider")
-				return r.updateStatusError(ctx, idp, err)
-			}
-
-			r.Recorder.Event(idp, corev1.EventTypeNormal, "Updated",
-				fmt.Sprintf("Access Identity Provider '%s' updated in Cloudflare", providerName))
-
-			return r.updateStatusReady(ctx, idp, apiResult.AccountID, result.ID)
+			return r.syncExistingProvider(ctx, idp, apiResult, existing, params, false)
 		}
 	}
 
@@ -195,17 +190,7 @@ func (r *Reconciler) syncIdentityProvider(
 			"providerId", existingByName.ID,
 			"name", providerName)
 
-		// Update the existing provider
-		result, err := apiResult.API.UpdateAccessIdentityProvider(ctx, existingByName.ID, params)
-		if err != nil {
-			logger.Error(err, "Failed to update existing Access Identity Provider")
-			return r.updateStatusError(ctx, idp, err)
-		}
-
-		r.Recorder.Event(idp, corev1.EventTypeNormal, "Adopted",
-			fmt.Sprintf("Adopted existing Access Identity Provider '%s'", providerName))
-
-		return r.updateStatusReady(ctx, idp, apiResult.AccountID, result.ID)
+		return r.syncExistingProvider(ctx, idp, apiResult, existingByName, params, true)
 	}
 
 	// Create new provider
@@ -221,7 +206,72 @@ func (r *Reconciler) syncIdentityProvider(
 	r.Recorder.Event(idp, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("Access Identity Provider '%s' created in Cloudflare", providerName))
 
-	return r.updateStatusReady(ctx, idp, apiResult.AccountID, result.ID)
+	return r.completeSync(ctx, idp, apiResult, result.ID)
+}
+
+// syncExistingProvider updates an existing Access Identity Provider when its settings
+// differ from the spec, and leaves it untouched when it is already in sync.
+func (r *Reconciler) syncExistingProvider(
+	ctx context.Context,
+	idp *networkingv1alpha2.AccessIdentityProvider,
+	apiResult *common.APIClientResult,
+	existing *cf.AccessIdentityProviderResult,
+	params cf.AccessIdentityProviderParams,
+	adopted bool,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if adopted {
+		r.Recorder.Event(idp, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing Access Identity Provider '%s'", params.Name))
+	}
+
+	// Secrets cannot be compared, so a changed spec is always applied
+	specSynced := idp.Status.State == "Ready" && idp.Status.ObservedGeneration == idp.Generation
+	if specSynced && cf.AccessIdentityProviderInSync(params, existing, scimSupportedTypes[idp.Spec.Type]) {
+		logger.V(1).Info("Access Identity Provider is in sync with Cloudflare", "providerId", existing.ID)
+		return r.completeSync(ctx, idp, apiResult, existing.ID)
+	}
+
+	// The spec has not changed since the last successful sync, so the provider was
+	// modified outside the operator
+	if !adopted && specSynced {
+		r.Recorder.Event(idp, corev1.EventTypeWarning, ReasonDriftDetected,
+			fmt.Sprintf("Access Identity Provider '%s' was modified outside the operator, re-applying spec", params.Name))
+	}
+
+	logger.V(1).Info("Updating Access Identity Provider in Cloudflare",
+		"providerId", existing.ID,
+		"name", params.Name)
+
+	result, err := apiResult.API.UpdateAccessIdentityProvider(ctx, existing.ID, params)
+	if err != nil {
+		logger.Error(err, "Failed to update Access Identity Provider")
+		return r.updateStatusError(ctx, idp, err)
+	}
+
+	r.Recorder.Event(idp, corev1.EventTypeNormal, "Updated",
+		fmt.Sprintf("Access Identity Provider '%s' updated in Cloudflare", params.Name))
+
+	return r.completeSync(ctx, idp, apiResult, result.ID)
+}
+
+// completeSync syncs SCIM provisioning of the synced provider and marks it ready.
+func (r *Reconciler) completeSync(
+	ctx context.Context,
+	idp *networkingv1alpha2.AccessIdentityProvider,
+	apiResult *common.APIClientResult,
+	providerID string,
+) (ctrl.Result, error) {
+	scim, err := r.syncSCIM(ctx, idp, apiResult.API, providerID)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to sync SCIM provisioning")
+		r.Recorder.Event(idp, corev1.EventTypeWarning, "SCIMSyncFailed",
+			fmt.Sprintf("Failed to sync SCIM provisioning: %s", cf.SanitizeErrorMessage(err)))
+		return r.updateStatusError(ctx, idp, err)
+	}
+
+	return r.updateStatusReady(ctx, idp, apiResult.AccountID, providerID, scim)
 }
 
 // buildParams builds the AccessIdentityProviderParams from the AccessIdentityProvider spec.
@@ -236,8 +286,8 @@ func (r *Reconciler) buildParams(idp *networkingv1alpha2.AccessIdentityProvider,
 		params.Config = convertConfigToCF(idp.Spec.Config)
 	}
 
-	// Convert SCIM config
-	if idp.Spec.ScimConfig != nil {
+	// Convert SCIM config; Cloudflare rejects it for types without SCIM support
+	if idp.Spec.ScimConfig != nil && scimSupportedTypes[idp.Spec.Type] {
 		params.ScimConfig = convertScimConfigToCF(idp.Spec.ScimConfig)
 	}
 
@@ -340,10 +390,18 @@ func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	idp *networkingv1alpha2.AccessIdentityProvider,
 	accountID, providerID string,
+	scim *scimResult,
 ) (ctrl.Result, error) {
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, idp, func() {
 		idp.Status.AccountID = accountID
 		idp.Status.ProviderID = providerID
+		idp.Status.ScimEndpoint = scim.endpoint
+		idp.Status.ScimSecretName = scim.secretName
+		if scim.condition != nil {
+			meta.SetStatusCondition(&idp.Status.Conditions, *scim.condition)
+		} else {
+			meta.RemoveStatusCondition(&idp.Status.Conditions, ConditionTypeSCIM)
+		}
 		idp.Status.State = "Ready"
 		meta.SetStatusCondition(&idp.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
@@ -373,5 +431,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.AccessIdentityProvider{}).
 		Named("accessidentityprovider").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.AccessIdentityProvider{}, r))
 }
@@ -0,0 +1,319 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessidentityprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.AccessIdentityProvider{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newSCIMProvider(idpType string) *networkingv1alpha2.AccessIdentityProvider {
+	return &networkingv1alpha2.AccessIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp-sso", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec: networkingv1alpha2.AccessIdentityProviderSpec{
+			Type: idpType,
+			ScimConfig: &networkingv1alpha2.IdentityProviderScimConfig{
+				Enabled:   ptr.To(true),
+				SecretRef: &networkingv1alpha2.ScimSecretRef{Name: "corp-sso-scim", Namespace: "identity"},
+			},
+		},
+	}
+}
+
+func reconcileProvider(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.AccessIdentityProvider {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "corp-sso"}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.AccessIdentityProvider{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "corp-sso"}, updated))
+	return updated
+}
+
+func getSCIMSecret(t *testing.T, c client.Client) *corev1.Secret {
+	t.Helper()
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "corp-sso-scim", Namespace: "identity"}, secret))
+	return secret
+}
+
+func TestReconcile_WritesSCIMSecret(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newSCIMProvider("okta"))
+
+	updated := reconcileProvider(t, r, c)
+
+	require.Equal(t, "Ready", updated.Status.State)
+	remote, ok := mock.Store().GetAccessIdentityProvider(updated.Status.ProviderID)
+	require.True(t, ok)
+	require.NotNil(t, remote.ScimConfig)
+	assert.Equal(t, remote.ScimConfig.ScimBaseURL, updated.Status.ScimEndpoint)
+	assert.NotEmpty(t, updated.Status.ScimEndpoint)
+	assert.Equal(t, "identity/corp-sso-scim", updated.Status.ScimSecretName)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeSCIM)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+
+	secret := getSCIMSecret(t, c)
+	assert.Equal(t, remote.ScimConfig.Secret, string(secret.Data[defaultSCIMSecretKey]))
+	assert.Equal(t, updated.Status.ScimEndpoint, string(secret.Data[defaultSCIMEndpointKey]))
+
+	// The secret is only generated once
+	reconcileProvider(t, r, c)
+	assert.Equal(t, remote.ScimConfig.Secret, string(getSCIMSecret(t, c).Data[defaultSCIMSecretKey]))
+}
+
+func TestReconcile_RegeneratesSCIMSecretOnAnnotation(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newSCIMProvider("azureAD"))
+
+	created := reconcileProvider(t, r, c)
+	previous := string(getSCIMSecret(t, c).Data[defaultSCIMSecretKey])
+
+	created.Annotations = map[string]string{AnnotationRegenerateSCIMSecret: "1"}
+	require.NoError(t, c.Update(context.Background(), created))
+	updated := reconcileProvider(t, r, c)
+
+	current := string(getSCIMSecret(t, c).Data[defaultSCIMSecretKey])
+	assert.NotEqual(t, previous, current)
+	remote, _ := mock.Store().GetAccessIdentityProvider(updated.Status.ProviderID)
+	assert.Equal(t, remote.ScimConfig.Secret, current)
+	assert.Equal(t, "1", updated.Annotations[AnnotationLastRegenerateSCIMSecret])
+
+	// The processed annotation does not regenerate again
+	reconcileProvider(t, r, c)
+	assert.Equal(t, current, string(getSCIMSecret(t, c).Data[defaultSCIMSecretKey]))
+}
+
+func TestReconcile_LeavesUnmanagedSCIMSecretAlone(t *testing.T) {
+	newMockServer(t)
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp-sso-scim", Namespace: "identity"},
+		Data:       map[string][]byte{"password": []byte("keep-me")},
+	}
+	r, c := newTestReconciler(t, newSCIMProvider("okta"), foreign)
+
+	updated := reconcileProvider(t, r, c)
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeSCIM)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonSCIMSecretConflict, cond.Reason)
+	assert.Empty(t, updated.Status.ScimSecretName)
+	assert.Equal(t, map[string][]byte{"password": []byte("keep-me")}, getSCIMSecret(t, c).Data)
+}
+
+func TestReconcile_WarnsWhenSCIMSecretIsRegenerated(t *testing.T) {
+	newMockServer(t)
+	r, c := newTestReconciler(t, newSCIMProvider("okta"))
+	recorder := r.Recorder.(*record.FakeRecorder)
+
+	reconcileProvider(t, r, c)
+	secret := getSCIMSecret(t, c)
+	previous := string(secret.Data[defaultSCIMSecretKey])
+	delete(secret.Data, defaultSCIMSecretKey)
+	require.NoError(t, c.Update(context.Background(), secret))
+	for len(recorder.Events) > 0 {
+		<-recorder.Events
+	}
+
+	reconcileProvider(t, r, c)
+
+	assert.NotEqual(t, previous, string(getSCIMSecret(t, c).Data[defaultSCIMSecretKey]))
+	var warnings []string
+	for len(recorder.Events) > 0 {
+		if event := <-recorder.Events; strings.HasPrefix(event, corev1.EventTypeWarning) {
+			warnings = append(warnings, event)
+		}
+	}
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "SCIMSecretRegenerated")
+}
+
+func TestReconcile_SCIMNotSupported(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newSCIMProvider("github"))
+
+	updated := reconcileProvider(t, r, c)
+
+	require.Equal(t, "Ready", updated.Status.State)
+	assert.Empty(t, updated.Status.ScimEndpoint)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeSCIM)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonSCIMNotSupported, cond.Reason)
+
+	remote, _ := mock.Store().GetAccessIdentityProvider(updated.Status.ProviderID)
+	// SCIM is not enabled on a provider type that does not support it
+	assert.False(t, remote.ScimConfig != nil && remote.ScimConfig.Enabled)
+	err := c.Get(context.Background(), types.NamespacedName{Name: "corp-sso-scim", Namespace: "identity"}, &corev1.Secret{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func countProviderUpdates(mock *mockserver.Server) int {
+	return mock.CountRequests(http.MethodPut, "/access/identity_providers/")
+}
+
+func drainEvents(recorder *record.FakeRecorder) string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return strings.Join(events, "\n")
+		}
+	}
+}
+
+func newOIDCProvider() *networkingv1alpha2.AccessIdentityProvider {
+	return &networkingv1alpha2.AccessIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp-sso", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec: networkingv1alpha2.AccessIdentityProviderSpec{
+			Type: "oidc",
+			Config: &networkingv1alpha2.IdentityProviderConfig{
+				ClientID:     "client-id",
+				ClientSecret: "client-secret",
+				AuthURL:      "https://idp.example.com/authorize",
+				TokenURL:     "https://idp.example.com/token",
+				CertsURL:     "https://idp.example.com/jwks",
+				Scopes:       []string{"openid", "email"},
+			},
+		},
+	}
+}
+
+func TestReconcile_OIDCDriftDetectedOnlyOnRealChange(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newOIDCProvider())
+	recorder := r.Recorder.(*record.FakeRecorder)
+
+	created := reconcileProvider(t, r, c)
+	require.Equal(t, "Ready", created.Status.State)
+
+	// Cloudflare redacts the secret, fills in defaults and may reorder scopes
+	mock.Store().UpdateAccessIdentityProvider(created.Status.ProviderID, func(idp *models.AccessIdentityProvider) {
+		idp.Config["client_secret"] = "**********"
+		idp.Config["redirect_url"] = "https://team.cloudflareaccess.com/cdn-cgi/access/callback"
+		idp.Config["scopes"] = []interface{}{"email", "openid"}
+	})
+	drainEvents(recorder)
+	reconcileProvider(t, r, c)
+	assert.Zero(t, countProviderUpdates(mock))
+	assert.NotContains(t, drainEvents(recorder), ReasonDriftDetected)
+
+	// An out-of-band edit is reverted
+	mock.Store().UpdateAccessIdentityProvider(created.Status.ProviderID, func(idp *models.AccessIdentityProvider) {
+		idp.Config["token_url"] = "https://attacker.example.com/token"
+	})
+	reconcileProvider(t, r, c)
+	assert.Equal(t, 1, countProviderUpdates(mock))
+	assert.Contains(t, drainEvents(recorder), ReasonDriftDetected)
+	remote, _ := mock.Store().GetAccessIdentityProvider(created.Status.ProviderID)
+	assert.Equal(t, "https://idp.example.com/token", remote.Config["token_url"])
+}
+
+func TestReconcile_SAMLSpecChangeUpdatesProvider(t *testing.T) {
+	mock := newMockServer(t)
+	provider := &networkingv1alpha2.AccessIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp-sso", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec: networkingv1alpha2.AccessIdentityProviderSpec{
+			Type: "saml",
+			Config: &networkingv1alpha2.IdentityProviderConfig{
+				IssuerURL:     "https://idp.example.com/saml",
+				SSOTargetURL:  "https://idp.example.com/sso",
+				IdPPublicCert: "MIIC...",
+				Attributes:    []string{"group"},
+			},
+		},
+	}
+	r, c := newTestReconciler(t, provider)
+	recorder := r.Recorder.(*record.FakeRecorder)
+
+	reconcileProvider(t, r, c)
+	created := reconcileProvider(t, r, c)
+	assert.Zero(t, countProviderUpdates(mock))
+
+	created.Spec.Config.Attributes = []string{"group", "department"}
+	created.Generation = 2
+	require.NoError(t, c.Update(context.Background(), created))
+	drainEvents(recorder)
+	reconcileProvider(t, r, c)
+
+	assert.Equal(t, 1, countProviderUpdates(mock))
+	// A spec change is not reported as drift
+	assert.NotContains(t, drainEvents(recorder), ReasonDriftDetected)
+	remote, _ := mock.Store().GetAccessIdentityProvider(created.Status.ProviderID)
+	assert.Equal(t, []interface{}{"group", "department"}, remote.Config["attributes"])
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accessidentityprovider
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	// AnnotationRegenerateSCIMSecret is the annotation key to regenerate the SCIM secret.
+	// When this annotation value changes, Cloudflare generates a new secret and the
+	// referenced Secret is updated. The previous secret stops working immediately.
+	AnnotationRegenerateSCIMSecret = "cloudflare-operator.io/regenerate-scim-secret"
+
+	// AnnotationLastRegenerateSCIMSecret stores the last processed regenerate-scim-secret value
+	AnnotationLastRegenerateSCIMSecret = "cloudflare-operator.io/last-regenerate-scim-secret"
+
+	// ConditionTypeSCIM reports the state of SCIM provisioning.
+	ConditionTypeSCIM = "SCIMProvisioning"
+
+	// Reasons for the SCIMProvisioning condition
+	ReasonSCIMEnabled        = "Enabled"
+	ReasonSCIMNotSupported   = "NotSupported"
+	ReasonSCIMSecretConflict = "SecretConflict"
+
+	defaultSCIMEndpointKey = "SCIM_ENDPOINT"
+	defaultSCIMSecretKey   = "SCIM_SECRET"
+
+	scimSecretManagedByLabel = "cloudflare-operator.io/managed-by"
+	scimSecretIDPNameLabel   = "cloudflare-operator.io/idp-name"
+	scimSecretManagedBy      = "accessidentityprovider"
+)
+
+// scimSupportedTypes are the identity provider types that support SCIM provisioning.
+var scimSupportedTypes = map[string]bool{
+	"azureAD":  true,
+	"okta":     true,
+	"onelogin": true,
+	"pingone":  true,
+	"saml":     true,
+	"oidc":     true,
+}
+
+// scimEnabled reports whether SCIM provisioning is enabled in the spec.
+func scimEnabled(idp *networkingv1alpha2.AccessIdentityProvider) bool {
+	return idp.Spec.ScimConfig != nil &&
+		idp.Spec.ScimConfig.Enabled != nil && *idp.Spec.ScimConfig.Enabled
+}
+
+// scimRegenerateRequested reports whether the regenerate-scim-secret annotation
+// has a value that was not processed yet.
+func scimRegenerateRequested(idp *networkingv1alpha2.AccessIdentityProvider) bool {
+	requested := idp.Annotations[AnnotationRegenerateSCIMSecret]
+	return requested != "" && requested != idp.Annotations[AnnotationLastRegenerateSCIMSecret]
+}
+
+// scimSecretOwnedBy reports whether the Secret was created for the identity provider.
+// Secrets created by anything else are never written to.
+func scimSecretOwnedBy(secret *corev1.Secret, idp *networkingv1alpha2.AccessIdentityProvider) bool {
+	return secret.Labels[scimSecretManagedByLabel] == scimSecretManagedBy &&
+		secret.Labels[scimSecretIDPNameLabel] == idp.Name
+}
+
+// scimResult is the outcome of syncing SCIM provisioning.
+type scimResult struct {
+	endpoint   string
+	secretName string
+	// condition is nil when SCIM provisioning is disabled.
+	condition *metav1.Condition
+}
+
+// syncSCIM publishes the SCIM endpoint of the provider and writes the SCIM
+// credentials to the referenced Secret. A new secret is generated when the Secret
+// has none yet, or when regeneration is requested with an annotation. An existing
+// Secret that was not created for this provider is left untouched.
+func (r *Reconciler) syncSCIM(
+	ctx context.Context,
+	idp *networkingv1alpha2.AccessIdentityProvider,
+	api *cf.API,
+	providerID string,
+) (*scimResult, error) {
+	logger := log.FromContext(ctx)
+
+	if !scimEnabled(idp) {
+		return &scimResult{}, nil
+	}
+
+	if !scimSupportedTypes[idp.Spec.Type] {
+		message := fmt.Sprintf("Identity provider type '%s' does not support SCIM provisioning", idp.Spec.Type)
+		r.Recorder.Event(idp, corev1.EventTypeWarning, "SCIMNotSupported", message)
+		return &scimResult{condition: &metav1.Condition{
+			Type:               ConditionTypeSCIM,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: idp.Generation,
+			Reason:             ReasonSCIMNotSupported,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		}}, nil
+	}
+
+	scim, err := api.GetIdentityProviderSCIM(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &scimResult{
+		endpoint: scim.BaseURL,
+		condition: &metav1.Condition{
+			Type:               ConditionTypeSCIM,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: idp.Generation,
+			Reason:             ReasonSCIMEnabled,
+			Message:            "SCIM provisioning is enabled",
+			LastTransitionTime: metav1.Now(),
+		},
+	}
+
+	ref := idp.Spec.ScimConfig.SecretRef
+	if ref == nil {
+		return result, nil
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = common.OperatorNamespace
+	}
+	endpointKey := ref.EndpointKey
+	if endpointKey == "" {
+		endpointKey = defaultSCIMEndpointKey
+	}
+	secretKey := ref.SecretKey
+	if secretKey == "" {
+		secretKey = defaultSCIMSecretKey
+	}
+	secretName := fmt.Sprintf("%s/%s", namespace, ref.Name)
+
+	secret := &corev1.Secret{}
+	err = r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get SCIM secret: %w", err)
+	}
+	exists := err == nil
+
+	if exists && !scimSecretOwnedBy(secret, idp) {
+		message := fmt.Sprintf("Secret '%s' exists and is not managed by this identity provider", secretName)
+		r.Recorder.Event(idp, corev1.EventTypeWarning, ReasonSCIMSecretConflict, message)
+		result.condition.Status = metav1.ConditionFalse
+		result.condition.Reason = ReasonSCIMSecretConflict
+		result.condition.Message = message
+		return result, nil
+	}
+	result.secretName = secretName
+
+	regenerate := scimRegenerateRequested(idp)
+	if !exists || len(secret.Data[secretKey]) == 0 || regenerate {
+		// Cloudflare only returns the secret when it is generated
+		refreshed, err := api.RefreshIdentityProviderSCIMSecret(ctx, providerID)
+		if err != nil {
+			return nil, err
+		}
+		if refreshed.BaseURL != "" {
+			result.endpoint = refreshed.BaseURL
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[secretKey] = []byte(refreshed.Secret)
+		secret.Data[endpointKey] = []byte(result.endpoint)
+
+		if err := r.writeSCIMSecret(ctx, idp, secret, ref.Name, namespace, exists); err != nil {
+			return nil, err
+		}
+		logger.Info("SCIM secret generated", "secret", result.secretName)
+		switch {
+		case regenerate:
+			r.Recorder.Event(idp, corev1.EventTypeNormal, "SCIMSecretRegenerated",
+				fmt.Sprintf("SCIM secret regenerated and written to Secret '%s'", result.secretName))
+		case idp.Status.ScimSecretName == result.secretName:
+			// The secret was written before, so the one configured in the identity
+			// provider stopped working when Cloudflare generated a new one
+			r.Recorder.Event(idp, corev1.EventTypeWarning, "SCIMSecretRegenerated",
+				fmt.Sprintf("SCIM secret missing from Secret '%s', a new secret was generated; "+
+					"update the identity provider with it", result.secretName))
+		default:
+			r.Recorder.Event(idp, corev1.EventTypeNormal, "SCIMSecretGenerated",
+				fmt.Sprintf("SCIM secret written to Secret '%s'", result.secretName))
+		}
+
+		if regenerate {
+			if err := controller.UpdateWithConflictRetry(ctx, r.Client, idp, func() {
+				idp.Annotations[AnnotationLastRegenerateSCIMSecret] = idp.Annotations[AnnotationRegenerateSCIMSecret]
+			}); err != nil {
+				return nil, fmt.Errorf("failed to record SCIM secret regeneration: %w", err)
+			}
+		}
+		return result, nil
+	}
+
+	// Keep the endpoint current without touching the secret
+	if string(secret.Data[endpointKey]) != result.endpoint {
+		secret.Data[endpointKey] = []byte(result.endpoint)
+		if err := r.Update(ctx, secret); err != nil {
+			return nil, fmt.Errorf("failed to update SCIM secret: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// writeSCIMSecret creates or updates the Secret holding the SCIM credentials.
+func (r *Reconciler) writeSCIMSecret(
+	ctx context.Context,
+	idp *networkingv1alpha2.AccessIdentityProvider,
+	secret *corev1.Secret,
+	name, namespace string,
+	exists bool,
+) error {
+	if exists {
+		if err := r.Update(ctx, secret); err != nil {
+			return fmt.Errorf("failed to update SCIM secret: %w", err)
+		}
+		return nil
+	}
+
+	secret.ObjectMeta = metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels: map[string]string{
+			scimSecretManagedByLabel: scimSecretManagedBy,
+			scimSecretIDPNameLabel:   idp.Name,
+		},
+	}
+	secret.Type = corev1.SecretTypeOpaque
+	if err := r.Create(ctx, secret); err != nil {
+		return fmt.Errorf("failed to create SCIM secret: %w", err)
+	}
+	return nil
+}
@@ -30,6 +30,11 @@ import (
 
 const (
 	finalizerName = "accesspolicy.networking.cloudflare-operator.io/finalizer"
+
+	// Reasons for the Ready condition and events
+	ReasonSynced        = "Synced"
+	ReasonInSync        = "InSync"
+	ReasonDriftDetected = "DriftDetected"
 )
 
 // Reconciler reconciles an AccessPolicy object.
@@ -98,32 +103,37 @@ func (r *Reconciler) handleDeletion(
 		return common.NoRequeue(), nil
 	}
 
-	// Get API client
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CloudflareDetails: &policy.Spec.Cloudflare,
-		Namespace:         common.OperatorNamespace,
-		StatusAccountID:   policy.Status.AccountID,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if policy.Status.PolicyID != "" {
-		// Delete policy from Cloudflare
-		logger.Info("Deleting Access Policy from Cloudflare",
-			"policyId", policy.Status.PolicyID)
-
-		if err := apiResult.API.DeleteReusableAccessPolicy(ctx, policy.Status.PolicyID); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete Access Policy from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(policy, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, policy, policy.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CloudflareDetails: &policy.Spec.Cloudflare,
+			Namespace:         common.OperatorNamespace,
+			StatusAccountID:   policy.Status.AccountID,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if policy.Status.PolicyID != "" {
+			// Delete policy from Cloudflare
+			logger.Info("Deleting Access Policy from Cloudflare",
+				"policyId", policy.Status.PolicyID)
+
+			if err := apiResult.API.DeleteReusableAccessPolicy(ctx, policy.Status.PolicyID); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete Access Policy from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, policy, policy.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("Access Policy not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("Access Policy not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(policy, corev1.EventTypeNormal, "Deleted",
+					"Access Policy deleted from Cloudflare")
 			}
-		} else {
-			r.Recorder.Event(policy, corev1.EventTypeNormal, "Deleted",
-				"Access Policy deleted from Cloudflare")
 		}
 	}
 
@@ -168,7 +178,18 @@ func (r *Reconciler) syncPolicy(
 			logger.Info("Access Policy not found in Cloudflare, will recreate",
 				"policyId", policy.Status.PolicyID)
 		} else {
-			// Policy exists, update it
+			if cf.ReusableAccessPolicyInSync(params, existing) {
+				logger.V(1).Info("Access Policy is in sync with Cloudflare", "policyId", existing.ID)
+				return r.updateStatusReady(ctx, policy, apiResult.AccountID, existing.ID, ReasonInSync)
+			}
+
+			// The spec has not changed since the last successful sync, so the policy was
+			// modified outside the operator
+			if policy.Status.State == "Ready" && policy.Status.ObservedGeneration == policy.Generation {
+				r.Recorder.Event(policy, corev1.EventTypeWarning, ReasonDriftDetected,
+					fmt.Sprintf("Access Policy '%s' was modified outside the operator, restoring desired rules", policyName))
+			}
+
 			logger.V(1).Info("Updating Access Policy in Cloudflare",
 				"policyId", existing.ID,
 				"name", policyName)
@@ -182,7 +203,7 @@ func (r *Reconciler) syncPolicy(
 			r.Recorder.Event(policy, corev1.EventTypeNormal, "Updated",
 				fmt.Sprintf("Access Policy '%s' updated in Cloudflare", policyName))
 
-			return r.updateStatusReady(ctx, policy, apiResult.AccountID, result.ID)
+			return r.updateStatusReady(ctx, policy, apiResult.AccountID, result.ID, ReasonSynced)
 		}
 	}
 
@@ -209,7 +230,7 @@ func (r *Reconciler) syncPolicy(
 		r.Recorder.Event(policy, corev1.EventTypeNormal, "Adopted",
 			fmt.Sprintf("Adopted existing Access Policy '%s'", policyName))
 
-		return r.updateStatusReady(ctx, policy, apiResult.AccountID, result.ID)
+		return r.updateStatusReady(ctx, policy, apiResult.AccountID, result.ID, ReasonSynced)
 	}
 
 	// Create new policy
@@ -225,7 +246,7 @@ func (r *Reconciler) syncPolicy(
 	r.Recorder.Event(policy, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("Access Policy '%s' created in Cloudflare", policyName))
 
-	return r.updateStatusReady(ctx, policy, apiResult.AccountID, result.ID)
+	return r.updateStatusReady(ctx, policy, apiResult.AccountID, result.ID, ReasonSynced)
 }
 
 // buildParams builds the ReusableAccessPolicyParams from the AccessPolicy spec.
@@ -444,8 +465,13 @@ func (r *Reconciler) updateStatusError(
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	policy *networkingv1alpha2.AccessPolicy,
-	accountID, policyID string,
+	accountID, policyID, reason string,
 ) (ctrl.Result, error) {
+	message := "Access Policy synced to Cloudflare"
+	if reason == ReasonInSync {
+		message = "Access Policy is in sync with Cloudflare"
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, policy, func() {
 		policy.Status.AccountID = accountID
 		policy.Status.PolicyID = policyID
@@ -454,8 +480,8 @@ func (r *Reconciler) updateStatusReady(
 			Type:               "Ready",
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: policy.Generation,
-			Reason:             "Synced",
-			Message:            "Access Policy synced to Cloudflare",
+			Reason:             reason,
+			Message:            message,
 			LastTransitionTime: metav1.Now(),
 		})
 		policy.Status.ObservedGeneration = policy.Generation
@@ -478,5 +504,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.AccessPolicy{}).
 		Named("accesspolicy").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.AccessPolicy{}, r))
 }
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package accesspolicy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.AccessPolicy{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func emailRule(email string) networkingv1alpha2.AccessGroupRule {
+	return networkingv1alpha2.AccessGroupRule{Email: &networkingv1alpha2.AccessGroupEmailRule{Email: email}}
+}
+
+// newSyncedPolicy returns an AccessPolicy that was last synced to policy-1, whose
+// include rules are alice and bob in that order. Cloudflare filled in the default
+// session duration.
+func newSyncedPolicy(mock *mockserver.Server, include ...networkingv1alpha2.AccessGroupRule) *networkingv1alpha2.AccessPolicy {
+	mock.Store().CreateAccessPolicy("", &models.AccessPolicy{
+		ID:       "policy-1",
+		Name:     "employees",
+		Decision: "allow",
+		Include: []models.AccessRule{
+			{Email: &models.EmailRule{Email: "alice@example.com"}},
+			{Email: &models.EmailRule{Email: "bob@example.com"}},
+		},
+		SessionDuration: "24h",
+	})
+	return &networkingv1alpha2.AccessPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "employees", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec:       networkingv1alpha2.AccessPolicySpec{Decision: "allow", Include: include},
+		Status: networkingv1alpha2.AccessPolicyStatus{
+			PolicyID:           "policy-1",
+			State:              "Ready",
+			ObservedGeneration: 1,
+		},
+	}
+}
+
+func reconcilePolicy(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.AccessPolicy {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "employees"}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.AccessPolicy{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "employees"}, updated))
+	return updated
+}
+
+func countPolicyUpdates(mock *mockserver.Server) int {
+	return mock.CountRequests(http.MethodPut, "/access/policies/policy-1$")
+}
+
+func drainEvents(recorder *record.FakeRecorder) string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return strings.Join(events, "\n")
+		}
+	}
+}
+
+func TestReconcile_ReorderedRulesDoNotUpdate(t *testing.T) {
+	mock := newMockServer(t)
+	policy := newSyncedPolicy(mock, emailRule("bob@example.com"), emailRule("alice@example.com"))
+	r, c := newTestReconciler(t, policy)
+
+	updated := reconcilePolicy(t, r, c)
+
+	assert.Zero(t, countPolicyUpdates(mock))
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, ReasonInSync, cond.Reason)
+}
+
+func TestReconcile_ChangedRulesUpdate(t *testing.T) {
+	mock := newMockServer(t)
+	policy := newSyncedPolicy(mock, emailRule("alice@example.com"), emailRule("carol@example.com"))
+	policy.Generation = 2
+	r, c := newTestReconciler(t, policy)
+
+	updated := reconcilePolicy(t, r, c)
+
+	assert.Equal(t, 1, countPolicyUpdates(mock))
+	assert.Equal(t, ReasonSynced, meta.FindStatusCondition(updated.Status.Conditions, "Ready").Reason)
+	remote, _ := mock.Store().GetAccessPolicy("", "policy-1")
+	require.Len(t, remote.Include, 2)
+	assert.Equal(t, "carol@example.com", remote.Include[1].Email.Email)
+	assert.NotContains(t, drainEvents(r.Recorder.(*record.FakeRecorder)), ReasonDriftDetected)
+
+	// Once updated, the policy is in sync
+	reconcilePolicy(t, r, c)
+	assert.Equal(t, 1, countPolicyUpdates(mock))
+}
+
+func TestReconcile_ChangedSessionDurationUpdates(t *testing.T) {
+	mock := newMockServer(t)
+	policy := newSyncedPolicy(mock, emailRule("alice@example.com"), emailRule("bob@example.com"))
+	policy.Spec.SessionDuration = "30m"
+	policy.Generation = 2
+	r, c := newTestReconciler(t, policy)
+
+	reconcilePolicy(t, r, c)
+
+	assert.Equal(t, 1, countPolicyUpdates(mock))
+	remote, _ := mock.Store().GetAccessPolicy("", "policy-1")
+	assert.Equal(t, "30m", remote.SessionDuration)
+}
+
+func TestReconcile_OutOfBandRuleEditIsReverted(t *testing.T) {
+	mock := newMockServer(t)
+	policy := newSyncedPolicy(mock, emailRule("alice@example.com"), emailRule("bob@example.com"))
+	r, c := newTestReconciler(t, policy)
+	mock.Store().UpdateAccessPolicy("", "policy-1", func(p *models.AccessPolicy) {
+		p.Include = append(p.Include, models.AccessRule{Email: &models.EmailRule{Email: "mallory@example.com"}})
+	})
+
+	reconcilePolicy(t, r, c)
+
+	assert.Equal(t, 1, countPolicyUpdates(mock))
+	remote, _ := mock.Store().GetAccessPolicy("", "policy-1")
+	assert.Len(t, remote.Include, 2)
+	assert.Contains(t, drainEvents(r.Recorder.(*record.FakeRecorder)), ReasonDriftDetected)
+}
@@ -102,32 +102,37 @@ func (r *Reconciler) handleDeletion(
 		logger.Error(err, "Failed to remove secret finalizer, continuing with deletion")
 	}
 
-	// Get API client - use resource namespace for credentials resolution
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CloudflareDetails: &token.Spec.Cloudflare,
-		Namespace:         token.Namespace, // Use resource namespace
-		StatusAccountID:   token.Status.AccountID,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if token.Status.TokenID != "" {
-		// Delete token from Cloudflare
-		logger.Info("Deleting Access Service Token from Cloudflare",
-			"tokenId", token.Status.TokenID)
-
-		if err := apiResult.API.DeleteAccessServiceToken(ctx, token.Status.TokenID); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete Access Service Token from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(token, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, token, token.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client - use resource namespace for credentials resolution
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CloudflareDetails: &token.Spec.Cloudflare,
+			Namespace:         token.Namespace, // Use resource namespace
+			StatusAccountID:   token.Status.AccountID,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if token.Status.TokenID != "" {
+			// Delete token from Cloudflare
+			logger.Info("Deleting Access Service Token from Cloudflare",
+				"tokenId", token.Status.TokenID)
+
+			if err := apiResult.API.DeleteAccessServiceToken(ctx, token.Status.TokenID); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete Access Service Token from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, token, token.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("Access Service Token not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("Access Service Token not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(token, corev1.EventTypeNormal, "Deleted",
+					"Access Service Token deleted from Cloudflare")
 			}
-		} else {
-			r.Recorder.Event(token, corev1.EventTypeNormal, "Deleted",
-				"Access Service Token deleted from Cloudflare")
 		}
 	}
 
@@ -228,7 +233,7 @@ func (r *Reconciler) syncToken(
 	if err := r.createOrUpdateSecret(ctx, token, result); err != nil {
 		logger.Error(err, "Failed to create/update secret with token credentials")
 		r.Recorder.Event(token, corev1.EventTypeWarning, "SecretFailed",
-			fmt.Sprintf("Failed to create/update secret: %s", err.Error()))
+			fmt.Sprintf("Failed to create/update secret: %s", cf.SanitizeErrorMessage(err)))
 		// Continue - token was created successfully
 	}
 
@@ -419,5 +424,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.AccessServiceToken{}).
 		Named("accessservicetoken").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.AccessServiceToken{}, r))
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/go-logr/logr"
 
 	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
 )
 
 const containerPort int32 = 8000
@@ -278,5 +279,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&networkingv1alpha1.AccessTunnel{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Secret{}).
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha1.AccessTunnel{}, r))
 }
@@ -5,13 +5,16 @@ package cloudflarecredentials
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -19,16 +22,53 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
 	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/internal/credentials"
 )
 
 const (
 	finalizerName = "cloudflare.com/credentials-finalizer"
+
+	// ConditionTypeValid reports whether the credentials are usable against the Cloudflare API.
+	ConditionTypeValid = "Valid"
+
+	// Reasons for the Valid condition
+	ReasonVerified             = "Verified"
+	ReasonSecretNotFound       = "SecretNotFound"
+	ReasonSecretKeyMissing     = "SecretKeyMissing"
+	ReasonInvalidCredentials   = "InvalidCredentials"
+	ReasonTokenExpired         = "TokenExpired"
+	ReasonTokenNotActive       = "TokenNotActive"
+	ReasonAccountNotAccessible = "AccountNotAccessible"
+	ReasonMultipleAccounts     = "MultipleAccounts"
+	ReasonVerificationFailed   = "VerificationFailed"
+
+	// revalidateInterval is how often valid credentials are verified again.
+	revalidateInterval = time.Hour
+	// retryInterval is how soon invalid credentials are verified again.
+	retryInterval = 5 * time.Minute
 )
 
+// validationError is a credentials validation failure with the reason for the Valid condition.
+type validationError struct {
+	reason string
+	err    error
+}
+
+func (e *validationError) Error() string { return e.err.Error() }
+
+func (e *validationError) Unwrap() error { return e.err }
+
+func invalidCredentials(reason string, err error) error {
+	return &validationError{reason: reason, err: err}
+}
+
 // Reconciler reconciles a CloudflareCredentials object
 type Reconciler struct {
 	client.Client
@@ -75,10 +115,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// Validate credentials
 	if err := r.validateCredentials(); err != nil {
-		r.updateStatus("Error", false, err.Error())
-		r.Recorder.Event(r.creds, corev1.EventTypeWarning, controller.EventReasonAPIError, err.Error())
-		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+		reason := ReasonVerificationFailed
+		var verr *validationError
+		if stderrors.As(err, &verr) {
+			reason = verr.reason
+		}
+		message := cfclient.SanitizeErrorMessage(err)
+		r.creds.Status.AccountID = ""
+		r.setValidCondition(metav1.ConditionFalse, reason, message)
+		r.updateStatus("Error", false, message)
+		r.Recorder.Event(r.creds, corev1.EventTypeWarning, reason, message)
+		return ctrl.Result{RequeueAfter: retryInterval}, nil
 	}
+	r.setValidCondition(metav1.ConditionTrue, ReasonVerified,
+		fmt.Sprintf("Credentials have access to account %s", r.creds.Status.AccountID))
 
 	// Check for duplicate default
 	if r.creds.Spec.IsDefault {
@@ -94,7 +144,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	r.Recorder.Event(r.creds, corev1.EventTypeNormal, controller.EventReasonReconciled, "Credentials validated successfully")
 
 	// Requeue periodically to re-validate
-	return ctrl.Result{RequeueAfter: time.Hour}, nil
+	return ctrl.Result{RequeueAfter: revalidateInterval}, nil
 }
 
 // handleDeletion handles the deletion of CloudflareCredentials
@@ -111,85 +161,145 @@ func (r *Reconciler) handleDeletion() (ctrl.Result, error) {
 	return ctrl.Result{}, nil
 }
 
-// validateCredentials validates the credentials against Cloudflare API
+// validateCredentials validates the credentials against Cloudflare API.
+// API tokens are verified first so that a bad or expired token is reported as such
+// rather than as an inaccessible account. Failures are returned as validationError.
+//
+//nolint:revive // cyclomatic complexity acceptable for auth type handling
 func (r *Reconciler) validateCredentials() error {
 	// Get the secret
 	secret := &corev1.Secret{}
 	secretNamespace := r.creds.Spec.SecretRef.Namespace
 	if secretNamespace == "" {
-		secretNamespace = "cloudflare-operator-system"
+		secretNamespace = common.OperatorNamespace
 	}
 
 	if err := r.Get(r.ctx, types.NamespacedName{
 		Name:      r.creds.Spec.SecretRef.Name,
 		Namespace: secretNamespace,
 	}, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return invalidCredentials(ReasonSecretNotFound, fmt.Errorf("failed to get secret: %w", err))
+		}
 		return fmt.Errorf("failed to get secret: %w", err)
 	}
 
-	// Create Cloudflare client based on auth type
-	var cfClient *cloudflare.API
-	var err error
-
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := cfclient.GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
+	creds, err := credentials.ExtractFromSecret(r.log, secret, r.creds.Spec.SecretRef, r.creds.Spec.AuthType)
+	switch {
+	case stderrors.Is(err, credentials.ErrSecretKeyMissing):
+		return invalidCredentials(ReasonSecretKeyMissing, err)
+	case err != nil:
+		return err
 	}
 
-	switch r.creds.Spec.AuthType {
-	case networkingv1alpha2.AuthTypeAPIToken:
-		tokenKey := r.creds.Spec.SecretRef.APITokenKey
-		if tokenKey == "" {
-			tokenKey = "CLOUDFLARE_API_TOKEN"
-		}
-		token := string(secret.Data[tokenKey])
-		if token == "" {
-			return fmt.Errorf("API token not found in secret (key: %s)", tokenKey)
-		}
-		cfClient, err = cloudflare.NewWithAPIToken(token, opts...)
+	opts := cfclient.ClientOptions()
 
-	case networkingv1alpha2.AuthTypeGlobalAPIKey:
-		keyKey := r.creds.Spec.SecretRef.APIKeyKey
-		if keyKey == "" {
-			keyKey = "CLOUDFLARE_API_KEY"
-		}
-		emailKey := r.creds.Spec.SecretRef.EmailKey
-		if emailKey == "" {
-			emailKey = "CLOUDFLARE_EMAIL"
-		}
+	// Create Cloudflare client based on auth type
+	var cfClient *cloudflare.API
+	if creds.AuthType == networkingv1alpha2.AuthTypeAPIToken {
+		cfClient, err = cloudflare.NewWithAPIToken(creds.APIToken, opts...)
+	} else {
+		cfClient, err = cloudflare.New(creds.APIKey, creds.Email, opts...)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create Cloudflare client: %w", err)
+	}
 
-		apiKey := string(secret.Data[keyKey])
-		email := string(secret.Data[emailKey])
+	api := &cfclient.API{
+		Log:              r.log,
+		CloudflareClient: cfClient,
+		AccountId:        r.creds.Spec.AccountID,
+	}
 
-		if apiKey == "" {
-			return fmt.Errorf("API key not found in secret (key: %s)", keyKey)
-		}
-		if email == "" {
-			return fmt.Errorf("email not found in secret (key: %s)", emailKey)
+	r.creds.Status.TokenExpiresOn = nil
+	if r.creds.Spec.AuthType == networkingv1alpha2.AuthTypeAPIToken {
+		if err := r.verifyToken(api); err != nil {
+			return err
 		}
-		cfClient, err = cloudflare.New(apiKey, email, opts...)
-
-	default:
-		return fmt.Errorf("unknown auth type: %s", r.creds.Spec.AuthType)
 	}
 
-	if err != nil {
-		return fmt.Errorf("failed to create Cloudflare client: %w", err)
+	// Without a pinned account, use the only account the credentials can access
+	if api.AccountId == "" {
+		accountID, err := api.DetectAccountId(r.ctx)
+		switch {
+		case stderrors.Is(err, cfclient.ErrMultipleAccounts):
+			return invalidCredentials(ReasonMultipleAccounts, err)
+		case stderrors.Is(err, cfclient.ErrAccountNotAccessible):
+			return invalidCredentials(ReasonAccountNotAccessible, err)
+		case err != nil && isAuthError(err):
+			return invalidCredentials(ReasonInvalidCredentials, err)
+		case err != nil:
+			return err
+		}
+		api.AccountId = accountID
 	}
 
 	// Validate by fetching account details
-	account, _, err := cfClient.Account(r.ctx, r.creds.Spec.AccountID)
+	account, err := api.GetAccount(r.ctx)
 	if err != nil {
+		if isAuthError(err) {
+			if r.creds.Spec.AuthType == networkingv1alpha2.AuthTypeGlobalAPIKey {
+				return invalidCredentials(ReasonInvalidCredentials, fmt.Errorf("failed to validate account: %w", err))
+			}
+			return invalidCredentials(ReasonAccountNotAccessible, fmt.Errorf(
+				"credentials have no access to account %s: %w", api.AccountId, err))
+		}
 		return fmt.Errorf("failed to validate account: %w", err)
 	}
+	if account.ID != "" && account.ID != api.AccountId {
+		return invalidCredentials(ReasonAccountNotAccessible, fmt.Errorf(
+			"account %s resolved to a different account %s", api.AccountId, account.ID))
+	}
 
-	// Store account name in status
+	// Store account details in status
 	r.creds.Status.AccountName = account.Name
+	r.creds.Status.AccountID = api.AccountId
+
+	return nil
+}
+
+// verifyToken checks that the API token is active and within its validity window.
+func (r *Reconciler) verifyToken(api *cfclient.API) error {
+	token, err := api.VerifyToken(r.ctx)
+	if err != nil {
+		if isAuthError(err) {
+			return invalidCredentials(ReasonInvalidCredentials, fmt.Errorf("API token is invalid: %w", err))
+		}
+		return fmt.Errorf("failed to verify API token: %w", err)
+	}
 
+	now := time.Now()
+	if !token.ExpiresOn.IsZero() {
+		expiresOn := metav1.NewTime(token.ExpiresOn)
+		r.creds.Status.TokenExpiresOn = &expiresOn
+	}
+	switch {
+	case token.Status == "expired" || (!token.ExpiresOn.IsZero() && token.ExpiresOn.Before(now)):
+		return invalidCredentials(ReasonTokenExpired,
+			fmt.Errorf("API token expired on %s", token.ExpiresOn.UTC().Format(time.RFC3339)))
+	case !token.NotBefore.IsZero() && token.NotBefore.After(now):
+		return invalidCredentials(ReasonTokenNotActive,
+			fmt.Errorf("API token is not valid before %s", token.NotBefore.UTC().Format(time.RFC3339)))
+	case token.Status != "" && token.Status != "active":
+		return invalidCredentials(ReasonTokenNotActive, fmt.Errorf("API token status is %s", token.Status))
+	}
 	return nil
 }
 
+// isAuthError reports whether the Cloudflare API rejected the credentials: a 401 or 403
+// response, or the authentication (10000) or unauthorized (9109) error code. Other
+// request errors, such as a malformed request or a missing resource, are not auth errors.
+func isAuthError(err error) bool {
+	var authnErr *cloudflare.AuthenticationError
+	var authzErr *cloudflare.AuthorizationError
+	if stderrors.As(err, &authnErr) || stderrors.As(err, &authzErr) {
+		return true
+	}
+	apiErr, ok := cfclient.AsCloudflareAPIError(err)
+	return ok && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden ||
+		apiErr.HasCode(cfclient.CodeAuthenticationError) || apiErr.HasCode(cfclient.CodeUnauthorized))
+}
+
 // ensureSingleDefault ensures only one CloudflareCredentials is marked as default
 func (r *Reconciler) ensureSingleDefault() error {
 	credsList := &networkingv1alpha2.CloudflareCredentialsList{}
@@ -250,10 +360,48 @@ func (r *Reconciler) updateStatus(state string, validated bool, message string)
 	}
 }
 
+// setValidCondition sets the Valid condition. It is persisted by updateStatus.
+func (r *Reconciler) setValidCondition(status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&r.creds.Status.Conditions, metav1.Condition{
+		Type:               ConditionTypeValid,
+		Status:             status,
+		ObservedGeneration: r.creds.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.CloudflareCredentials{}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findCredentialsForSecret),
+		).
 		Named("cloudflarecredentials").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.CloudflareCredentials{}, r))
+}
+
+// findCredentialsForSecret returns the CloudflareCredentials that reference the Secret,
+// so that credentials are verified again as soon as the Secret changes.
+func (r *Reconciler) findCredentialsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	credsList := &networkingv1alpha2.CloudflareCredentialsList{}
+	if err := r.List(ctx, credsList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, creds := range credsList.Items {
+		namespace := creds.Spec.SecretRef.Namespace
+		if namespace == "" {
+			namespace = common.OperatorNamespace
+		}
+		if creds.Spec.SecretRef.Name == obj.GetName() && namespace == obj.GetNamespace() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: creds.Name},
+			})
+		}
+	}
+	return requests
 }
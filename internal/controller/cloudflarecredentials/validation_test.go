@@ -0,0 +1,355 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cloudflarecredentials
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	invalidTokenResponse = `{"success":false,"errors":[{"code":1000,"message":"Invalid API Token"}],"messages":[],"result":null}`
+	forbiddenResponse    = `{"success":false,"errors":[{"code":9109,"message":"Unauthorized to access requested resource"}],"messages":[],"result":null}`
+)
+
+// newCloudflareStub serves token verification, account lookups and the account list.
+// The status and body arguments are returned by the respective endpoint.
+func newCloudflareStub(
+	t *testing.T,
+	verifyStatus int, verifyBody string,
+	accountStatus int, accountBody string,
+	accountsBody string,
+) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(accountsBody))
+	})
+	mux.HandleFunc("/user/tokens/verify", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(verifyStatus)
+		_, _ = w.Write([]byte(verifyBody))
+	})
+	mux.HandleFunc("/accounts/test-account-123", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(accountStatus)
+		_, _ = w.Write([]byte(accountBody))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL)
+}
+
+func tokenVerifyResponse(status string, expiresOn time.Time) string {
+	expires := ""
+	if !expiresOn.IsZero() {
+		expires = fmt.Sprintf(`,"expires_on":%q`, expiresOn.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf(`{"success":true,"errors":[],"messages":[],"result":{"id":"token-id","status":%q%s}}`,
+		status, expires)
+}
+
+const accountResponse = `{"success":true,"errors":[],"messages":[],"result":{"id":"test-account-123","name":"Test Account"}}`
+
+func reconcileCredentials(t *testing.T, objs ...client.Object) *networkingv1alpha2.CloudflareCredentials {
+	t.Helper()
+	return reconcileGivenCredentials(t, createTestCredentials("test-creds", false), objs...)
+}
+
+func reconcileGivenCredentials(
+	t *testing.T,
+	creds *networkingv1alpha2.CloudflareCredentials,
+	objs ...client.Object,
+) *networkingv1alpha2.CloudflareCredentials {
+	t.Helper()
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(append(objs, creds)...).
+		WithStatusSubresource(creds).
+		Build()
+	r := &Reconciler{Client: fakeClient, Scheme: scheme.Scheme, Recorder: record.NewFakeRecorder(10)}
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: creds.Name}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.CloudflareCredentials{}
+	require.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: creds.Name}, updated))
+	return updated
+}
+
+func tokenSecret(token string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "default"},
+		Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte(token)},
+	}
+}
+
+func TestReconcile_ValidCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		secret        *corev1.Secret
+		verifyStatus  int
+		verifyBody    string
+		accountStatus int
+		accountBody   string
+		wantStatus    metav1.ConditionStatus
+		wantReason    string
+	}{
+		{
+			name:          "valid token",
+			secret:        tokenSecret("good-token"),
+			verifyStatus:  http.StatusOK,
+			verifyBody:    tokenVerifyResponse("active", time.Time{}),
+			accountStatus: http.StatusOK,
+			accountBody:   accountResponse,
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    ReasonVerified,
+		},
+		{
+			name:         "invalid token",
+			secret:       tokenSecret("bad-token"),
+			verifyStatus: http.StatusUnauthorized,
+			verifyBody:   invalidTokenResponse,
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   ReasonInvalidCredentials,
+		},
+		{
+			name:         "expired token",
+			secret:       tokenSecret("old-token"),
+			verifyStatus: http.StatusOK,
+			verifyBody:   tokenVerifyResponse("expired", time.Now().Add(-24*time.Hour)),
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   ReasonTokenExpired,
+		},
+		{
+			name:         "disabled token",
+			secret:       tokenSecret("disabled-token"),
+			verifyStatus: http.StatusOK,
+			verifyBody:   tokenVerifyResponse("disabled", time.Time{}),
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   ReasonTokenNotActive,
+		},
+		{
+			name:          "token without access to the account",
+			secret:        tokenSecret("other-account-token"),
+			verifyStatus:  http.StatusOK,
+			verifyBody:    tokenVerifyResponse("active", time.Time{}),
+			accountStatus: http.StatusForbidden,
+			accountBody:   forbiddenResponse,
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    ReasonAccountNotAccessible,
+		},
+		{
+			name: "secret with keys of both auth methods uses the token",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "default"},
+				Data: map[string][]byte{
+					"CLOUDFLARE_API_TOKEN": []byte("token"),
+					"CLOUDFLARE_API_KEY":   []byte("key"),
+				},
+			},
+			verifyStatus:  http.StatusOK,
+			verifyBody:    tokenVerifyResponse("active", time.Time{}),
+			accountStatus: http.StatusOK,
+			accountBody:   accountResponse,
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    ReasonVerified,
+		},
+		{
+			name:       "missing secret key",
+			secret:     tokenSecret(""),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: ReasonSecretKeyMissing,
+		},
+		{
+			name:       "missing secret",
+			wantStatus: metav1.ConditionFalse,
+			wantReason: ReasonSecretNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newCloudflareStub(t, tt.verifyStatus, tt.verifyBody, tt.accountStatus, tt.accountBody, "")
+			var objs []client.Object
+			if tt.secret != nil {
+				objs = append(objs, tt.secret)
+			}
+
+			updated := reconcileCredentials(t, objs...)
+
+			valid := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeValid)
+			require.NotNil(t, valid)
+			assert.Equal(t, tt.wantStatus, valid.Status)
+			assert.Equal(t, tt.wantReason, valid.Reason)
+			assert.Equal(t, tt.wantStatus == metav1.ConditionTrue, updated.Status.Validated)
+			if tt.wantStatus == metav1.ConditionTrue {
+				assert.Equal(t, "test-account-123", updated.Status.AccountID)
+				assert.Equal(t, "Test Account", updated.Status.AccountName)
+			} else {
+				assert.Empty(t, updated.Status.AccountID)
+			}
+		})
+	}
+}
+
+func TestReconcile_ExpiredTokenRecordsExpiry(t *testing.T) {
+	expiresOn := time.Now().Add(-time.Hour).Truncate(time.Second)
+	newCloudflareStub(t, http.StatusOK, tokenVerifyResponse("active", expiresOn), http.StatusOK, accountResponse, "")
+
+	updated := reconcileCredentials(t, tokenSecret("token"))
+
+	valid := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeValid)
+	require.NotNil(t, valid)
+	assert.Equal(t, ReasonTokenExpired, valid.Reason)
+	require.NotNil(t, updated.Status.TokenExpiresOn)
+	assert.True(t, expiresOn.Equal(updated.Status.TokenExpiresOn.Time))
+}
+
+func accountsResponse(ids ...string) string {
+	items := make([]string, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, fmt.Sprintf(`{"id":%q,"name":"Account %s"}`, id, id))
+	}
+	return fmt.Sprintf(`{"success":true,"errors":[],"messages":[],"result":[%s],`+
+		`"result_info":{"page":1,"per_page":20,"count":%d,"total_count":%d}}`,
+		strings.Join(items, ","), len(ids), len(ids))
+}
+
+func TestReconcile_AccountSelection(t *testing.T) {
+	tests := []struct {
+		name          string
+		pinned        string
+		accounts      []string
+		accountStatus int
+		accountBody   string
+		wantReason    string
+		wantAccountID string
+	}{
+		{
+			name:          "pinned account",
+			pinned:        "test-account-123",
+			accounts:      []string{"test-account-123", "other-account"},
+			accountStatus: http.StatusOK,
+			accountBody:   accountResponse,
+			wantReason:    ReasonVerified,
+			wantAccountID: "test-account-123",
+		},
+		{
+			name:          "pinned account not accessible",
+			pinned:        "test-account-123",
+			accounts:      []string{"other-account"},
+			accountStatus: http.StatusForbidden,
+			accountBody:   forbiddenResponse,
+			wantReason:    ReasonAccountNotAccessible,
+		},
+		{
+			name:          "single account auto-detected",
+			accounts:      []string{"test-account-123"},
+			accountStatus: http.StatusOK,
+			accountBody:   accountResponse,
+			wantReason:    ReasonVerified,
+			wantAccountID: "test-account-123",
+		},
+		{
+			name:       "multiple accounts without pin",
+			accounts:   []string{"test-account-123", "other-account"},
+			wantReason: ReasonMultipleAccounts,
+		},
+		{
+			name:       "no accessible account",
+			wantReason: ReasonAccountNotAccessible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newCloudflareStub(t, http.StatusOK, tokenVerifyResponse("active", time.Time{}),
+				tt.accountStatus, tt.accountBody, accountsResponse(tt.accounts...))
+			creds := createTestCredentials("test-creds", false)
+			creds.Spec.AccountID = tt.pinned
+
+			updated := reconcileGivenCredentials(t, creds, tokenSecret("token"))
+
+			valid := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeValid)
+			require.NotNil(t, valid)
+			assert.Equal(t, tt.wantReason, valid.Reason, valid.Message)
+			assert.Equal(t, tt.wantAccountID, updated.Status.AccountID)
+			if tt.wantAccountID != "" {
+				assert.Equal(t, tt.wantAccountID, updated.GetAccountID())
+			}
+		})
+	}
+}
+
+func TestFindCredentialsForSecret(t *testing.T) {
+	matching := createTestCredentials("matching", false)
+	defaultNamespace := createTestCredentials("default-namespace", false)
+	defaultNamespace.Spec.SecretRef.Namespace = ""
+	other := createTestCredentials("other", false)
+	other.Spec.SecretRef.Name = "other-secret"
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(matching, defaultNamespace, other).
+		Build()
+	r := &Reconciler{Client: fakeClient}
+
+	requests := r.findCredentialsForSecret(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "default"},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, "matching", requests[0].Name)
+
+	requests = r.findCredentialsForSecret(context.Background(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: common.OperatorNamespace},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, "default-namespace", requests[0].Name)
+}
+
+func TestIsAuthError(t *testing.T) {
+	requestErr := func(status, code int) error {
+		err := cloudflare.NewRequestError(&cloudflare.Error{
+			StatusCode: status,
+			Errors:     []cloudflare.ResponseInfo{{Code: code, Message: "error"}},
+		})
+		return fmt.Errorf("failed to verify API token: %w", &err)
+	}
+	authnErr := cloudflare.NewAuthenticationError(&cloudflare.Error{StatusCode: http.StatusUnauthorized})
+	notFoundErr := cloudflare.NewNotFoundError(&cloudflare.Error{StatusCode: http.StatusNotFound})
+
+	assert.True(t, isAuthError(&authnErr))
+	assert.True(t, isAuthError(requestErr(http.StatusBadRequest, cfclient.CodeAuthenticationError)))
+	assert.True(t, isAuthError(requestErr(http.StatusBadRequest, cfclient.CodeUnauthorized)))
+	assert.True(t, isAuthError(&cfclient.CloudflareAPIError{StatusCode: http.StatusForbidden}))
+
+	// Malformed requests and missing resources do not mean the credentials were rejected
+	assert.False(t, isAuthError(requestErr(http.StatusBadRequest, 1001)))
+	assert.False(t, isAuthError(&notFoundErr))
+	assert.False(t, isAuthError(fmt.Errorf("connection refused")))
+}
@@ -9,9 +9,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/cloudflare/cloudflare-go"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +32,14 @@ import (
 
 const (
 	finalizerName = "cloudflare.com/domain-finalizer"
+
+	// Reasons for the Ready condition
+	ReasonVerified      = "Verified"
+	ReasonZonePending   = "ZonePending"
+	ReasonZoneNotActive = "ZoneNotActive"
+
+	// pendingZoneRequeueInterval is how often zones that are not active yet are resolved again.
+	pendingZoneRequeueInterval = 5 * time.Minute
 )
 
 // Reconciler reconciles a CloudflareDomain object.
@@ -78,7 +86,6 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Only set Verifying state if this is a new domain or was in error state
 	needsFullVerification := domain.Status.ZoneID == "" ||
 		domain.Status.State == networkingv1alpha2.CloudflareDomainStateError ||
-		domain.Status.State == networkingv1alpha2.CloudflareDomainStatePending ||
 		domain.Status.State == ""
 
 	if needsFullVerification {
@@ -88,15 +95,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// Get credentials
 	creds, err := r.getCredentials(ctx, domain)
 	if err != nil {
-		r.updateState(ctx, domain, networkingv1alpha2.CloudflareDomainStateError, fmt.Sprintf("Failed to get credentials: %v", err))
-		r.Recorder.Event(domain, corev1.EventTypeWarning, controller.EventReasonAPIError, err.Error())
+		r.updateState(ctx, domain, networkingv1alpha2.CloudflareDomainStateError, fmt.Sprintf("Failed to get credentials: %s", cfclient.SanitizeErrorMessage(err)))
+		r.Recorder.Event(domain, corev1.EventTypeWarning, controller.EventReasonAPIError, cfclient.SanitizeErrorMessage(err))
 		return ctrl.Result{RequeueAfter: time.Minute}, nil
 	}
 
 	// Verify domain and get zone info
-	if err := r.verifyDomain(ctx, domain, creds); err != nil {
-		r.updateState(ctx, domain, networkingv1alpha2.CloudflareDomainStateError, fmt.Sprintf("Failed to verify domain: %v", err))
-		r.Recorder.Event(domain, corev1.EventTypeWarning, controller.EventReasonAPIError, err.Error())
+	zone, err := r.verifyDomain(ctx, domain, creds)
+	if err != nil {
+		r.updateState(ctx, domain, networkingv1alpha2.CloudflareDomainStateError, fmt.Sprintf("Failed to verify domain: %s", cfclient.SanitizeErrorMessage(err)))
+		r.Recorder.Event(domain, corev1.EventTypeWarning, controller.EventReasonAPIError, cfclient.SanitizeErrorMessage(err))
 		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
 	}
 
@@ -109,12 +117,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	// Update status to Ready
-	r.updateState(ctx, domain, networkingv1alpha2.CloudflareDomainStateReady, "Domain verified successfully")
-	r.Recorder.Event(domain, corev1.EventTypeNormal, controller.EventReasonReconciled, "Domain verified successfully")
+	// A zone only serves traffic once its name servers point to Cloudflare.
+	// Pending zones are re-resolved frequently to pick up the activation.
+	switch {
+	case zone.IsActive():
+		r.updateStatus(ctx, domain, networkingv1alpha2.CloudflareDomainStateReady, ReasonVerified, "Domain verified successfully", zone)
+		r.Recorder.Event(domain, corev1.EventTypeNormal, controller.EventReasonReconciled, "Domain verified successfully")
+		// Requeue periodically to re-verify
+		return ctrl.Result{RequeueAfter: time.Hour}, nil
+
+	case zone.IsPending():
+		message := fmt.Sprintf("Zone %s is %s, waiting for the name servers of %s to be set to %s",
+			zone.ID, zone.Status, domain.Spec.Domain, strings.Join(zone.NameServers, ", "))
+		r.updateStatus(ctx, domain, networkingv1alpha2.CloudflareDomainStatePending, ReasonZonePending, message, zone)
+		r.Recorder.Event(domain, corev1.EventTypeNormal, ReasonZonePending, message)
+		return ctrl.Result{RequeueAfter: pendingZoneRequeueInterval}, nil
 
-	// Requeue periodically to re-verify
-	return ctrl.Result{RequeueAfter: time.Hour}, nil
+	default:
+		message := fmt.Sprintf("Zone %s is %s", zone.ID, zone.Status)
+		r.updateStatus(ctx, domain, networkingv1alpha2.CloudflareDomainStateError, ReasonZoneNotActive, message, zone)
+		r.Recorder.Event(domain, corev1.EventTypeWarning, ReasonZoneNotActive, message)
+		return ctrl.Result{RequeueAfter: pendingZoneRequeueInterval}, nil
+	}
 }
 
 // handleDeletion handles the deletion of CloudflareDomain
@@ -167,142 +191,34 @@ func (r *Reconciler) getCredentials(ctx context.Context, domain *networkingv1alp
 }
 
 // verifyDomain verifies the domain exists in Cloudflare and retrieves zone information
-func (r *Reconciler) verifyDomain(ctx context.Context, domain *networkingv1alpha2.CloudflareDomain, creds *networkingv1alpha2.CloudflareCredentials) error {
+func (r *Reconciler) verifyDomain(
+	ctx context.Context, domain *networkingv1alpha2.CloudflareDomain, creds *networkingv1alpha2.CloudflareCredentials,
+) (*cfclient.ZoneResult, error) {
 	logger := log.FromContext(ctx)
 
-	// If ZoneID is manually specified, use it directly
-	if domain.Spec.ZoneID != "" {
-		domain.Status.ZoneID = domain.Spec.ZoneID
-		logger.Info("Using manually specified Zone ID", "zoneId", domain.Spec.ZoneID)
-		return r.fetchZoneDetails(ctx, domain, creds, domain.Spec.ZoneID)
-	}
-
-	// Create Cloudflare client
-	cfClient, err := r.createCloudflareClient(ctx, creds)
-	if err != nil {
-		return err
-	}
-
-	// Look up zone by name
-	zones, err := cfClient.ListZonesContext(ctx, cloudflare.WithZoneFilters(domain.Spec.Domain, creds.Spec.AccountID, ""))
+	cfAPI, err := cfclient.NewAPIClientFromCredentialsRef(ctx, r.Client,
+		&networkingv1alpha2.CloudflareCredentialsRef{Name: creds.Name})
 	if err != nil {
-		return fmt.Errorf("failed to list zones: %w", err)
-	}
-
-	if len(zones.Result) == 0 {
-		return fmt.Errorf("zone not found for domain '%s' in account '%s'", domain.Spec.Domain, creds.Spec.AccountID)
-	}
-
-	zone := zones.Result[0]
-
-	// Update status with zone information
-	domain.Status.ZoneID = zone.ID
-	domain.Status.ZoneName = zone.Name
-	domain.Status.AccountID = zone.Account.ID
-	domain.Status.NameServers = zone.NameServers
-	domain.Status.ZoneStatus = zone.Status
-
-	now := metav1.Now()
-	domain.Status.LastVerifiedTime = &now
-
-	logger.Info("Domain verified", "domain", domain.Spec.Domain, "zoneId", zone.ID, "zoneStatus", zone.Status)
-
-	return nil
-}
-
-// fetchZoneDetails fetches zone details for a given zone ID
-func (r *Reconciler) fetchZoneDetails(ctx context.Context, domain *networkingv1alpha2.CloudflareDomain, creds *networkingv1alpha2.CloudflareCredentials, zoneID string) error {
-	cfClient, err := r.createCloudflareClient(ctx, creds)
-	if err != nil {
-		return err
-	}
-
-	zone, err := cfClient.ZoneDetails(ctx, zoneID)
-	if err != nil {
-		return fmt.Errorf("failed to get zone details for ID '%s': %w", zoneID, err)
-	}
-
-	// Update status with zone information
-	domain.Status.ZoneID = zone.ID
-	domain.Status.ZoneName = zone.Name
-	domain.Status.AccountID = zone.Account.ID
-	domain.Status.NameServers = zone.NameServers
-	domain.Status.ZoneStatus = zone.Status
-
-	now := metav1.Now()
-	domain.Status.LastVerifiedTime = &now
-
-	return nil
-}
-
-// createCloudflareClient creates a Cloudflare API client from credentials
-func (r *Reconciler) createCloudflareClient(ctx context.Context, creds *networkingv1alpha2.CloudflareCredentials) (*cloudflare.API, error) {
-	// Get the secret
-	secret := &corev1.Secret{}
-	secretNamespace := creds.Spec.SecretRef.Namespace
-	if secretNamespace == "" {
-		secretNamespace = "cloudflare-operator-system"
-	}
-
-	if err := r.Get(ctx, types.NamespacedName{
-		Name:      creds.Spec.SecretRef.Name,
-		Namespace: secretNamespace,
-	}, secret); err != nil {
-		return nil, fmt.Errorf("failed to get secret: %w", err)
+		return nil, err
 	}
 
-	// Create Cloudflare client based on auth type
-	var cfAPI *cloudflare.API
-	var err error
-
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := cfclient.GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
-
-	switch creds.Spec.AuthType {
-	case networkingv1alpha2.AuthTypeAPIToken:
-		tokenKey := creds.Spec.SecretRef.APITokenKey
-		if tokenKey == "" {
-			tokenKey = "CLOUDFLARE_API_TOKEN"
-		}
-		token := string(secret.Data[tokenKey])
-		if token == "" {
-			return nil, fmt.Errorf("API token not found in secret (key: %s)", tokenKey)
-		}
-		cfAPI, err = cloudflare.NewWithAPIToken(token, opts...)
-
-	case networkingv1alpha2.AuthTypeGlobalAPIKey:
-		keyKey := creds.Spec.SecretRef.APIKeyKey
-		if keyKey == "" {
-			keyKey = "CLOUDFLARE_API_KEY"
-		}
-		emailKey := creds.Spec.SecretRef.EmailKey
-		if emailKey == "" {
-			emailKey = "CLOUDFLARE_EMAIL"
-		}
-
-		apiKey := string(secret.Data[keyKey])
-		email := string(secret.Data[emailKey])
-
-		if apiKey == "" {
-			return nil, fmt.Errorf("API key not found in secret (key: %s)", keyKey)
-		}
-		if email == "" {
-			return nil, fmt.Errorf("email not found in secret (key: %s)", emailKey)
+	// If ZoneID is manually specified, skip the lookup by name
+	if domain.Spec.ZoneID != "" {
+		logger.Info("Using manually specified Zone ID", "zoneId", domain.Spec.ZoneID)
+		zone, err := cfAPI.GetZone(ctx, domain.Spec.ZoneID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get zone details for ID '%s': %w", domain.Spec.ZoneID, err)
 		}
-		cfAPI, err = cloudflare.New(apiKey, email, opts...)
-
-	default:
-		return nil, fmt.Errorf("unknown auth type: %s", creds.Spec.AuthType)
+		return zone, nil
 	}
 
+	zone, err := cfAPI.GetZoneByName(ctx, domain.Spec.Domain)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Cloudflare client: %w", err)
+		return nil, err
 	}
 
-	return cfAPI, nil
+	logger.Info("Domain verified", "domain", domain.Spec.Domain, "zoneId", zone.ID, "zoneStatus", zone.Status)
+	return zone, nil
 }
 
 // ensureSingleDefault ensures only one CloudflareDomain is marked as default
@@ -323,28 +239,35 @@ func (r *Reconciler) ensureSingleDefault(ctx context.Context, domain *networking
 
 // updateState updates the state and status of the CloudflareDomain
 func (r *Reconciler) updateState(ctx context.Context, domain *networkingv1alpha2.CloudflareDomain, state networkingv1alpha2.CloudflareDomainState, message string) {
-	logger := log.FromContext(ctx)
+	r.updateStatus(ctx, domain, state, string(state), message, nil)
+}
 
-	// Update conditions
-	condition := metav1.Condition{
-		Type:               "Ready",
-		Status:             metav1.ConditionFalse,
-		ObservedGeneration: domain.Generation,
-		LastTransitionTime: metav1.Now(),
-		Reason:             string(state),
-		Message:            message,
-	}
+// updateStatus updates the state of the CloudflareDomain and, when zone is set, the resolved zone information
+func (r *Reconciler) updateStatus(
+	ctx context.Context, domain *networkingv1alpha2.CloudflareDomain,
+	state networkingv1alpha2.CloudflareDomainState, reason, message string, zone *cfclient.ZoneResult,
+) {
+	logger := log.FromContext(ctx)
 
+	conditionStatus := metav1.ConditionFalse
 	if state == networkingv1alpha2.CloudflareDomainStateReady {
-		condition.Status = metav1.ConditionTrue
-		condition.Reason = "Verified"
+		conditionStatus = metav1.ConditionTrue
 	}
 
 	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, domain, func() {
 		domain.Status.State = state
 		domain.Status.Message = message
 		domain.Status.ObservedGeneration = domain.Generation
-		controller.SetCondition(&domain.Status.Conditions, condition.Type, condition.Status, condition.Reason, condition.Message)
+		if zone != nil {
+			domain.Status.ZoneID = zone.ID
+			domain.Status.ZoneName = zone.Name
+			domain.Status.AccountID = zone.AccountID
+			domain.Status.NameServers = zone.NameServers
+			domain.Status.ZoneStatus = zone.Status
+			now := metav1.Now()
+			domain.Status.LastVerifiedTime = &now
+		}
+		controller.SetCondition(&domain.Status.Conditions, "Ready", conditionStatus, reason, message)
 	}); err != nil {
 		logger.Error(err, "failed to update status")
 	}
@@ -394,5 +317,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findDomainsForCredentials)).
 		Named("cloudflaredomain").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.CloudflareDomain{}, r))
 }
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package cloudflaredomain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+func zoneJSON(status string) string {
+	return fmt.Sprintf(`{"id":"zone-123","name":"example.com","status":%q,"paused":false,`+
+		`"name_servers":["ana.ns.cloudflare.com","bob.ns.cloudflare.com"],`+
+		`"original_name_servers":["ns1.registrar.test"],"account":{"id":"account-123","name":"Test"}}`, status)
+}
+
+// newZoneStub serves zone lookups by name and by ID, returning a zone with the given status.
+func newZoneStub(t *testing.T, status string) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		result := ""
+		if req.URL.Query().Get("name") == "example.com" {
+			result = zoneJSON(status)
+		}
+		count := 0
+		if result != "" {
+			count = 1
+		}
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":[%s],`+
+			`"result_info":{"page":1,"per_page":50,"total_pages":1,"count":%d,"total_count":%d}}`,
+			result, count, count)
+	})
+	mux.HandleFunc("/zones/zone-123", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, zoneJSON(status))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL)
+}
+
+func newZoneTestClient(t *testing.T, domain *networkingv1alpha2.CloudflareDomain) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "account-123",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			domain,
+		).
+		WithStatusSubresource(domain).
+		Build()
+}
+
+func reconcileDomain(t *testing.T, c client.Client, name string) ctrl.Result {
+	t.Helper()
+	r := &Reconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: name}}
+
+	// The first reconcile adds the finalizer
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	result, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	return result
+}
+
+func TestReconcile_ZoneStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		zoneStatus  string
+		zoneID      string
+		wantState   networkingv1alpha2.CloudflareDomainState
+		wantReady   metav1.ConditionStatus
+		wantReason  string
+		wantRequeue time.Duration
+	}{
+		{
+			name:        "active zone",
+			zoneStatus:  "active",
+			wantState:   networkingv1alpha2.CloudflareDomainStateReady,
+			wantReady:   metav1.ConditionTrue,
+			wantReason:  ReasonVerified,
+			wantRequeue: time.Hour,
+		},
+		{
+			name:        "pending zone",
+			zoneStatus:  "pending",
+			wantState:   networkingv1alpha2.CloudflareDomainStatePending,
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  ReasonZonePending,
+			wantRequeue: pendingZoneRequeueInterval,
+		},
+		{
+			name:        "pending zone with manual zone ID",
+			zoneStatus:  "pending",
+			zoneID:      "zone-123",
+			wantState:   networkingv1alpha2.CloudflareDomainStatePending,
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  ReasonZonePending,
+			wantRequeue: pendingZoneRequeueInterval,
+		},
+		{
+			name:        "moved zone",
+			zoneStatus:  "moved",
+			wantState:   networkingv1alpha2.CloudflareDomainStateError,
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  ReasonZoneNotActive,
+			wantRequeue: pendingZoneRequeueInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newZoneStub(t, tt.zoneStatus)
+			domain := &networkingv1alpha2.CloudflareDomain{
+				ObjectMeta: metav1.ObjectMeta{Name: "example-com"},
+				Spec:       networkingv1alpha2.CloudflareDomainSpec{Domain: "example.com", ZoneID: tt.zoneID},
+			}
+			c := newZoneTestClient(t, domain)
+
+			result := reconcileDomain(t, c, domain.Name)
+			assert.Equal(t, tt.wantRequeue, result.RequeueAfter)
+
+			updated := &networkingv1alpha2.CloudflareDomain{}
+			require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: domain.Name}, updated))
+			assert.Equal(t, tt.wantState, updated.Status.State)
+			assert.Equal(t, "zone-123", updated.Status.ZoneID)
+			assert.Equal(t, "example.com", updated.Status.ZoneName)
+			assert.Equal(t, "account-123", updated.Status.AccountID)
+			assert.Equal(t, tt.zoneStatus, updated.Status.ZoneStatus)
+			assert.Equal(t, []string{"ana.ns.cloudflare.com", "bob.ns.cloudflare.com"}, updated.Status.NameServers)
+			assert.NotNil(t, updated.Status.LastVerifiedTime)
+
+			ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+			require.NotNil(t, ready)
+			assert.Equal(t, tt.wantReady, ready.Status)
+			assert.Equal(t, tt.wantReason, ready.Reason)
+		})
+	}
+}
+
+func TestReconcile_PendingZoneActivates(t *testing.T) {
+	newZoneStub(t, "pending")
+	domain := &networkingv1alpha2.CloudflareDomain{
+		ObjectMeta: metav1.ObjectMeta{Name: "example-com"},
+		Spec:       networkingv1alpha2.CloudflareDomainSpec{Domain: "example.com"},
+	}
+	c := newZoneTestClient(t, domain)
+	reconcileDomain(t, c, domain.Name)
+
+	newZoneStub(t, "active")
+	r := &Reconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: domain.Name}})
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, result.RequeueAfter)
+
+	updated := &networkingv1alpha2.CloudflareDomain{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: domain.Name}, updated))
+	assert.Equal(t, networkingv1alpha2.CloudflareDomainStateReady, updated.Status.State)
+	assert.Equal(t, "active", updated.Status.ZoneStatus)
+}
+
+func TestReconcile_ZoneNotFound(t *testing.T) {
+	newZoneStub(t, "active")
+	domain := &networkingv1alpha2.CloudflareDomain{
+		ObjectMeta: metav1.ObjectMeta{Name: "missing-test"},
+		Spec:       networkingv1alpha2.CloudflareDomainSpec{Domain: "missing.test"},
+	}
+	c := newZoneTestClient(t, domain)
+
+	result := reconcileDomain(t, c, domain.Name)
+	assert.Equal(t, 5*time.Minute, result.RequeueAfter)
+
+	updated := &networkingv1alpha2.CloudflareDomain{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: domain.Name}, updated))
+	assert.Equal(t, networkingv1alpha2.CloudflareDomainStateError, updated.Status.State)
+	assert.Empty(t, updated.Status.ZoneID)
+	assert.Contains(t, updated.Status.Message, "not found")
+}
@@ -7,10 +7,12 @@ import (
 	"context"
 
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -102,6 +104,8 @@ func (r *ClusterTunnelReconciler) initStruct(ctx context.Context, tunnel Tunnel)
 	if r.cfAPI, r.cfSecret, err = getAPIDetails(r.ctx, r.Client, r.log, r.tunnel.GetSpec(), r.tunnel.GetStatus(), r.tunnel.GetNamespace()); err != nil {
 		r.log.Error(err, "unable to get API details")
 		r.Recorder.Event(r.tunnel.GetObject(), corev1.EventTypeWarning, "ErrSpecSecret", "Error reading Secret to configure API")
+		setTunnelState(r, "error", metav1.ConditionFalse, common.ReasonCredentialsInvalid,
+			"Error reading Secret to configure API: "+cf.SanitizeErrorMessage(err))
 		return err
 	}
 
@@ -164,11 +168,11 @@ func (r *ClusterTunnelReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterTunnelReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.Recorder = mgr.GetEventRecorderFor("cloudflare-operator")
+	r.Recorder = common.NewDedupRecorder(mgr.GetEventRecorderFor("cloudflare-operator"), common.DefaultEventDedupWindow)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.ClusterTunnel{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Secret{}).
 		Owns(&appsv1.Deployment{}).
-		Complete(r)
+		Complete(ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.ClusterTunnel{}, r))
 }
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// Adoption policies for the spec.adoptionPolicy field of resources that can take
+// over a Cloudflare resource that already exists.
+const (
+	// AdoptionPolicyIfExists adopts the Cloudflare resource if it exists and creates it otherwise.
+	AdoptionPolicyIfExists = "IfExists"
+	// AdoptionPolicyMustExist adopts the Cloudflare resource and fails if it does not exist.
+	AdoptionPolicyMustExist = "MustExist"
+	// AdoptionPolicyMustNotExist creates the Cloudflare resource and fails if it already exists.
+	AdoptionPolicyMustNotExist = "MustNotExist"
+)
+
+// ReasonAdoptionFailed is the condition reason when the adoption policy of a
+// resource does not allow it to take over or create its Cloudflare resource.
+const ReasonAdoptionFailed = "AdoptionFailed"
+
+// AdoptionError is returned by Adopt when the Cloudflare resource exists, or
+// does not exist, against the adoption policy.
+type AdoptionError struct {
+	// Policy is the adoption policy that was applied.
+	Policy string
+	// Exists reports whether the Cloudflare resource exists.
+	Exists bool
+}
+
+func (e *AdoptionError) Error() string {
+	if e.Exists {
+		return fmt.Sprintf("Cloudflare resource already exists and adoption policy is %s", e.Policy)
+	}
+	return fmt.Sprintf("Cloudflare resource is missing and adoption policy is %s", e.Policy)
+}
+
+// AdoptionResult is the Cloudflare resource resolved by Adopt.
+type AdoptionResult[T any] struct {
+	// Resource is the adopted or created Cloudflare resource. When adopted, it is
+	// the configuration the resource had before the operator changed it.
+	Resource *T
+	// Adopted reports whether Resource existed and was adopted.
+	Adopted bool
+}
+
+// Adopt resolves the Cloudflare resource of an object that does not manage one
+// yet, according to its adoption policy. get returns the existing resource, or
+// nil or a not-found error when there is none; create creates the resource. An
+// empty policy means AdoptionPolicyMustNotExist.
+//
+// A conflict from create means the resource was created concurrently: it is
+// adopted with AdoptionPolicyIfExists and rejected otherwise.
+func Adopt[T any](
+	ctx context.Context,
+	policy string,
+	get func(context.Context) (*T, error),
+	create func(context.Context) (*T, error),
+) (AdoptionResult[T], error) {
+	if policy == "" {
+		policy = AdoptionPolicyMustNotExist
+	}
+
+	existing, err := lookupForAdoption(ctx, get)
+	if err != nil {
+		return AdoptionResult[T]{}, err
+	}
+
+	if existing != nil {
+		if policy == AdoptionPolicyMustNotExist {
+			return AdoptionResult[T]{}, &AdoptionError{Policy: policy, Exists: true}
+		}
+		return AdoptionResult[T]{Resource: existing, Adopted: true}, nil
+	}
+
+	if policy == AdoptionPolicyMustExist {
+		return AdoptionResult[T]{}, &AdoptionError{Policy: policy}
+	}
+
+	created, err := create(ctx)
+	if err == nil {
+		return AdoptionResult[T]{Resource: created}, nil
+	}
+	if !cf.IsConflictError(err) {
+		return AdoptionResult[T]{}, err
+	}
+	if policy != AdoptionPolicyIfExists {
+		return AdoptionResult[T]{}, &AdoptionError{Policy: policy, Exists: true}
+	}
+
+	existing, lookupErr := lookupForAdoption(ctx, get)
+	if lookupErr != nil {
+		return AdoptionResult[T]{}, lookupErr
+	}
+	if existing == nil {
+		return AdoptionResult[T]{}, err
+	}
+	return AdoptionResult[T]{Resource: existing, Adopted: true}, nil
+}
+
+// lookupForAdoption calls get, treating a not-found error as no resource.
+func lookupForAdoption[T any](ctx context.Context, get func(context.Context) (*T, error)) (*T, error) {
+	existing, err := get(ctx)
+	if err != nil {
+		if cf.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return existing, nil
+}
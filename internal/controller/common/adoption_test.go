@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+type adoptionTestResource struct {
+	Name string
+}
+
+// adoptionTestAPI fakes the Cloudflare API of a resource for Adopt.
+type adoptionTestAPI struct {
+	existing *adoptionTestResource
+	// createdConcurrently makes create fail with a conflict after creating the resource
+	createdConcurrently bool
+	getErr              error
+	creates             int
+}
+
+func (a *adoptionTestAPI) get(context.Context) (*adoptionTestResource, error) {
+	if a.getErr != nil {
+		return nil, a.getErr
+	}
+	if a.existing == nil {
+		return nil, cf.ErrResourceNotFound
+	}
+	return a.existing, nil
+}
+
+func (a *adoptionTestAPI) create(context.Context) (*adoptionTestResource, error) {
+	a.creates++
+	if a.createdConcurrently {
+		a.existing = &adoptionTestResource{Name: "concurrent"}
+		return nil, fmt.Errorf("create: %w", cf.ErrResourceConflict)
+	}
+	a.existing = &adoptionTestResource{Name: "created"}
+	return a.existing, nil
+}
+
+func TestAdopt(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		existing    *adoptionTestResource
+		wantName    string
+		wantAdopted bool
+		wantCreates int
+		wantErr     *AdoptionError
+	}{
+		{
+			name:        "IfExists adopts existing",
+			policy:      AdoptionPolicyIfExists,
+			existing:    &adoptionTestResource{Name: "existing"},
+			wantName:    "existing",
+			wantAdopted: true,
+		},
+		{
+			name:        "IfExists creates missing",
+			policy:      AdoptionPolicyIfExists,
+			wantName:    "created",
+			wantCreates: 1,
+		},
+		{
+			name:        "MustExist adopts existing",
+			policy:      AdoptionPolicyMustExist,
+			existing:    &adoptionTestResource{Name: "existing"},
+			wantName:    "existing",
+			wantAdopted: true,
+		},
+		{
+			name:    "MustExist rejects missing",
+			policy:  AdoptionPolicyMustExist,
+			wantErr: &AdoptionError{Policy: AdoptionPolicyMustExist},
+		},
+		{
+			name:        "MustNotExist creates missing",
+			policy:      AdoptionPolicyMustNotExist,
+			wantName:    "created",
+			wantCreates: 1,
+		},
+		{
+			name:     "MustNotExist rejects existing",
+			policy:   AdoptionPolicyMustNotExist,
+			existing: &adoptionTestResource{Name: "existing"},
+			wantErr:  &AdoptionError{Policy: AdoptionPolicyMustNotExist, Exists: true},
+		},
+		{
+			name:     "empty policy is MustNotExist",
+			existing: &adoptionTestResource{Name: "existing"},
+			wantErr:  &AdoptionError{Policy: AdoptionPolicyMustNotExist, Exists: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &adoptionTestAPI{existing: tt.existing}
+
+			result, err := Adopt(context.Background(), tt.policy, api.get, api.create)
+
+			assert.Equal(t, tt.wantCreates, api.creates)
+			if tt.wantErr != nil {
+				var adoptionErr *AdoptionError
+				require.ErrorAs(t, err, &adoptionErr)
+				assert.Equal(t, tt.wantErr, adoptionErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, result.Resource)
+			assert.Equal(t, tt.wantName, result.Resource.Name)
+			assert.Equal(t, tt.wantAdopted, result.Adopted)
+		})
+	}
+}
+
+func TestAdopt_NilMeansMissing(t *testing.T) {
+	get := func(context.Context) (*adoptionTestResource, error) { return nil, nil }
+
+	_, err := Adopt(context.Background(), AdoptionPolicyMustExist, get, nil)
+
+	var adoptionErr *AdoptionError
+	require.ErrorAs(t, err, &adoptionErr)
+	assert.False(t, adoptionErr.Exists)
+}
+
+func TestAdopt_ConcurrentCreate(t *testing.T) {
+	t.Run("IfExists adopts", func(t *testing.T) {
+		api := &adoptionTestAPI{createdConcurrently: true}
+
+		result, err := Adopt(context.Background(), AdoptionPolicyIfExists, api.get, api.create)
+
+		require.NoError(t, err)
+		assert.True(t, result.Adopted)
+		assert.Equal(t, "concurrent", result.Resource.Name)
+	})
+
+	t.Run("MustNotExist rejects", func(t *testing.T) {
+		api := &adoptionTestAPI{createdConcurrently: true}
+
+		_, err := Adopt(context.Background(), AdoptionPolicyMustNotExist, api.get, api.create)
+
+		var adoptionErr *AdoptionError
+		require.ErrorAs(t, err, &adoptionErr)
+		assert.True(t, adoptionErr.Exists)
+	})
+}
+
+func TestAdopt_LookupError(t *testing.T) {
+	lookupErr := errors.New("rate limited")
+	api := &adoptionTestAPI{getErr: lookupErr}
+
+	_, err := Adopt(context.Background(), AdoptionPolicyIfExists, api.get, api.create)
+
+	require.ErrorIs(t, err, lookupErr)
+	assert.Zero(t, api.creates, "a failed lookup must not create the resource")
+}
@@ -110,13 +110,13 @@ func (f *APIClientFactory) GetClient(ctx context.Context, opts APIClientOptions)
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to load credentials: %w", err)
+		return nil, &CredentialsError{Err: fmt.Errorf("failed to load credentials: %w", err)}
 	}
 
 	// Create Cloudflare client
 	cloudflareClient, err := createCloudflareClient(creds)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Cloudflare client: %w", err)
+		return nil, &CredentialsError{Err: fmt.Errorf("failed to create Cloudflare client: %w", err)}
 	}
 
 	// Build API struct
@@ -193,10 +193,7 @@ func (f *APIClientFactory) GetClientForCredentials(ctx context.Context, credenti
 
 // createCloudflareClient creates a Cloudflare API client from loaded credentials.
 func createCloudflareClient(creds *credentials.Credentials) (*cloudflare.API, error) {
-	var opts []cloudflare.Option
-	if baseURL := cf.GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
+	opts := cf.ClientOptions()
 
 	switch creds.AuthType {
 	case networkingv1alpha2.AuthTypeAPIToken:
@@ -212,15 +209,3 @@ func createCloudflareClient(creds *credentials.Credentials) (*cloudflare.API, er
 		return nil, errors.New("no valid API credentials found")
 	}
 }
-
-// OperatorNamespace is the namespace where the operator runs.
-// This is used for cluster-scoped resources that need to look up secrets.
-var OperatorNamespace = "cloudflare-operator-system"
-
-// SetOperatorNamespace sets the operator namespace.
-// This should be called during operator initialization.
-func SetOperatorNamespace(ns string) {
-	if ns != "" {
-		OperatorNamespace = ns
-	}
-}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// DefaultConnectivityCheckInterval is how often the Cloudflare connectivity check runs.
+const DefaultConnectivityCheckInterval = time.Minute
+
+var (
+	errConnectivityNotChecked = errors.New("cloudflare connectivity has not been checked yet")
+	errNoCredentials          = errors.New("no CloudflareCredentials configured")
+)
+
+// ConnectivityChecker is a readiness check that reports whether at least one
+// CloudflareCredentials can reach the Cloudflare API.
+// The API is called in the background every Interval; readiness probes only read
+// the cached result, so probing the operator never calls Cloudflare.
+type ConnectivityChecker struct {
+	client   client.Reader
+	factory  *APIClientFactory
+	log      logr.Logger
+	interval time.Duration
+
+	mu      sync.RWMutex
+	checked bool
+	lastErr error
+}
+
+// NewConnectivityChecker creates a ConnectivityChecker.
+// A non-positive interval uses DefaultConnectivityCheckInterval.
+func NewConnectivityChecker(c client.Client, interval time.Duration, log logr.Logger) *ConnectivityChecker {
+	if interval <= 0 {
+		interval = DefaultConnectivityCheckInterval
+	}
+	return &ConnectivityChecker{
+		client:   c,
+		factory:  NewAPIClientFactory(c, log),
+		log:      log.WithName("cloudflare-connectivity"),
+		interval: interval,
+	}
+}
+
+// Check implements healthz.Checker. It returns the error of the last connectivity
+// check, or an error if no check has completed yet.
+func (c *ConnectivityChecker) Check(_ *http.Request) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.checked {
+		return errConnectivityNotChecked
+	}
+	return c.lastErr
+}
+
+// Start runs the connectivity check until the context is cancelled.
+// It implements manager.Runnable.
+func (c *ConnectivityChecker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckNow(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection reports that the check runs on every replica,
+// since each replica serves its own readiness probe.
+func (*ConnectivityChecker) NeedLeaderElection() bool {
+	return false
+}
+
+// CheckNow verifies connectivity immediately and caches the result.
+func (c *ConnectivityChecker) CheckNow(ctx context.Context) {
+	err := c.check(ctx)
+
+	c.mu.Lock()
+	wasReady := c.checked && c.lastErr == nil
+	c.checked = true
+	c.lastErr = err
+	c.mu.Unlock()
+
+	switch {
+	case err != nil && wasReady:
+		c.log.Error(err, "Cloudflare API is no longer reachable")
+	case err != nil:
+		c.log.V(1).Info("Cloudflare API is not reachable", "error", err.Error())
+	case !wasReady:
+		c.log.Info("Cloudflare API is reachable")
+	}
+}
+
+// check returns nil as soon as one CloudflareCredentials reaches the Cloudflare API.
+func (c *ConnectivityChecker) check(ctx context.Context) error {
+	credsList := &networkingv1alpha2.CloudflareCredentialsList{}
+	if err := c.client.List(ctx, credsList); err != nil {
+		return fmt.Errorf("failed to list CloudflareCredentials: %w", err)
+	}
+	if len(credsList.Items) == 0 {
+		return errNoCredentials
+	}
+
+	var errs []error
+	for _, creds := range credsList.Items {
+		apiResult, err := c.factory.GetClientForCredentials(ctx, creds.Name)
+		if err == nil {
+			err = apiResult.API.Ping(ctx)
+		}
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("credentials %q: %w", creds.Name, err))
+	}
+	return fmt.Errorf("no CloudflareCredentials can reach the Cloudflare API: %w", errors.Join(errs...))
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/injection"
+)
+
+func newConnectivityChecker(t *testing.T, objs ...client.Object) (*ConnectivityChecker, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+	return NewConnectivityChecker(c, 0, logr.Discard()), mock
+}
+
+func credentialsObjects() []client.Object {
+	return []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: OperatorNamespace},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	}
+}
+
+func TestConnectivityChecker_ReadyTransitions(t *testing.T) {
+	checker, mock := newConnectivityChecker(t, credentialsObjects()...)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, checker.Check(nil), errConnectivityNotChecked, "not ready before the first check")
+
+	checker.CheckNow(ctx)
+	require.NoError(t, checker.Check(nil))
+
+	require.NoError(t, mock.ErrorInjector().Add(injection.ErrorInjection{
+		PathPattern: "/accounts/test-account-id$",
+		ErrorType:   injection.ErrorTypeStatus,
+		TriggerMode: injection.TriggerModeAlways,
+		StatusCode:  http.StatusForbidden,
+	}))
+	checker.CheckNow(ctx)
+	err := checker.Check(nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `credentials "default"`)
+
+	mock.ErrorInjector().Clear()
+	checker.CheckNow(ctx)
+	assert.NoError(t, checker.Check(nil))
+}
+
+func TestConnectivityChecker_ProbesUseCachedResult(t *testing.T) {
+	checker, mock := newConnectivityChecker(t, credentialsObjects()...)
+
+	checker.CheckNow(context.Background())
+	calls := mock.CountRequests(http.MethodGet, "/accounts/test-account-id$")
+	require.Positive(t, calls)
+
+	for range 3 {
+		require.NoError(t, checker.Check(nil))
+	}
+	assert.Equal(t, calls, mock.CountRequests(http.MethodGet, "/accounts/test-account-id$"))
+}
+
+func TestConnectivityChecker_NoCredentials(t *testing.T) {
+	checker, _ := newConnectivityChecker(t)
+
+	checker.CheckNow(context.Background())
+	assert.ErrorIs(t, checker.Check(nil), errNoCredentials)
+}
+
+func TestConnectivityChecker_StartStopsWithContext(t *testing.T) {
+	checker, _ := newConnectivityChecker(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.NoError(t, checker.Start(ctx))
+	assert.NotErrorIs(t, checker.Check(nil), errConnectivityNotChecked, "Start checks once before waiting")
+	assert.False(t, checker.NeedLeaderElection())
+}
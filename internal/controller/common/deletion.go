@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Deletion policies for the spec.deletionPolicy field of resources backed by a
+// Cloudflare resource.
+const (
+	// DeletionPolicyDelete deletes the Cloudflare resource with the Kubernetes resource.
+	DeletionPolicyDelete = "Delete"
+	// DeletionPolicyOrphan leaves the Cloudflare resource in place.
+	DeletionPolicyOrphan = "Orphan"
+)
+
+// EventReasonDeletionPolicy is the reason of the event recording the deletion
+// policy applied to a resource being deleted.
+const EventReasonDeletionPolicy = "DeletionPolicy"
+
+// AnnotationDeletionPolicyRecorded holds the deletion policy already recorded in
+// an event, so that retried deletions do not record it again.
+const AnnotationDeletionPolicyRecorded = "cloudflare-operator.io/deletion-policy-recorded"
+
+// RecordDeletionPolicy reports whether the Cloudflare resource of obj is orphaned
+// and records the applied policy in an event. An empty policy means Delete. The
+// event is recorded once per deletion: obj is annotated with the policy first,
+// and later calls for the same policy record nothing.
+func RecordDeletionPolicy(
+	ctx context.Context,
+	c client.Client,
+	recorder record.EventRecorder,
+	obj client.Object,
+	policy string,
+) (orphan bool) {
+	orphan = policy == DeletionPolicyOrphan
+	applied, message := DeletionPolicyDelete, "Deletion policy is Delete, deleting the Cloudflare resource"
+	if orphan {
+		applied, message = DeletionPolicyOrphan, "Deletion policy is Orphan, leaving the Cloudflare resource in place"
+	}
+	if obj.GetAnnotations()[AnnotationDeletionPolicyRecorded] == applied {
+		return orphan
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[AnnotationDeletionPolicyRecorded] = applied
+	obj.SetAnnotations(annotations)
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		// The event is still recorded; it may be recorded again on the next attempt
+		log.FromContext(ctx).V(1).Info("Failed to mark the deletion policy as recorded", "error", err.Error())
+	}
+
+	recorder.Event(obj, corev1.EventTypeNormal, EventReasonDeletionPolicy, message)
+	return orphan
+}
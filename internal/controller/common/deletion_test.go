@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRecordDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		policy     string
+		wantOrphan bool
+		wantEvent  string
+	}{
+		{policy: "", wantOrphan: false, wantEvent: "Deletion policy is Delete"},
+		{policy: DeletionPolicyDelete, wantOrphan: false, wantEvent: "Deletion policy is Delete"},
+		{policy: DeletionPolicyOrphan, wantOrphan: true, wantEvent: "Deletion policy is Orphan"},
+	}
+
+	for _, tt := range tests {
+		t.Run("policy "+tt.policy, func(t *testing.T) {
+			ctx := context.Background()
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: "default"}}
+			c := fake.NewClientBuilder().WithObjects(secret).Build()
+			recorder := record.NewFakeRecorder(2)
+
+			// A retried deletion records the policy only once
+			for range 2 {
+				assert.Equal(t, tt.wantOrphan, RecordDeletionPolicy(ctx, c, recorder, secret, tt.policy))
+			}
+
+			require.Len(t, recorder.Events, 1)
+			event := <-recorder.Events
+			assert.Contains(t, event, EventReasonDeletionPolicy)
+			assert.Contains(t, event, tt.wantEvent)
+
+			stored := &corev1.Secret{}
+			require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(secret), stored))
+			assert.NotEmpty(t, stored.Annotations[AnnotationDeletionPolicyRecorded])
+		})
+	}
+}
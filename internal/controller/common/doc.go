@@ -15,6 +15,8 @@
 //   - APIClientFactory: Creates and manages Cloudflare API clients
 //   - Requeue utilities: Standard intervals and backoff for reconciliation
 //   - Re-exports from parent controller package: Status, Finalizer, Event, Deletion utilities
+//   - Deletion policy: Delete or Orphan the Cloudflare resource when the CR is deleted
+//   - Adoption policy: Adopt or create the Cloudflare resource of a new CR
 //
 // # Usage Pattern
 //
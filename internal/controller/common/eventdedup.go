@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// DefaultEventDedupWindow is how long DedupRecorder suppresses an event after
+// an identical one was recorded for the same object.
+const DefaultEventDedupWindow = 5 * time.Minute
+
+// eventKey identifies an event by its object, type, reason and message.
+type eventKey struct {
+	object    types.UID
+	name      string
+	eventType string
+	reason    string
+	message   string
+}
+
+// DedupRecorder wraps an EventRecorder and drops events identical to one
+// recorded for the same object within the window. Controllers that requeue
+// often or retry on conflicts would otherwise emit the same event on every
+// reconcile. Events that differ in type, reason or message are recorded
+// immediately.
+type DedupRecorder struct {
+	record.EventRecorder
+
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	recorded  map[eventKey]time.Time
+	lastPrune time.Time
+}
+
+var _ record.EventRecorder = &DedupRecorder{}
+
+// NewDedupRecorder returns a DedupRecorder that records through recorder and
+// suppresses identical events within window.
+func NewDedupRecorder(recorder record.EventRecorder, window time.Duration) *DedupRecorder {
+	return &DedupRecorder{
+		EventRecorder: recorder,
+		window:        window,
+		now:           time.Now,
+		recorded:      make(map[eventKey]time.Time),
+	}
+}
+
+// Event records the event unless an identical one was recorded within the window.
+func (r *DedupRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.shouldRecord(object, eventtype, reason, message) {
+		r.EventRecorder.Event(object, eventtype, reason, message)
+	}
+}
+
+// Eventf is like Event, but formats the message with fmt.Sprintf.
+func (r *DedupRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...any) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf is like Eventf, but adds annotations to the event.
+func (r *DedupRecorder) AnnotatedEventf(
+	object runtime.Object,
+	annotations map[string]string,
+	eventtype, reason, messageFmt string,
+	args ...any,
+) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.shouldRecord(object, eventtype, reason, message) {
+		r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+// shouldRecord reports whether the event is not a duplicate and remembers it.
+func (r *DedupRecorder) shouldRecord(object runtime.Object, eventtype, reason, message string) bool {
+	key := eventKey{eventType: eventtype, reason: reason, message: message}
+	if accessor, err := meta.Accessor(object); err == nil {
+		key.object = accessor.GetUID()
+		key.name = accessor.GetNamespace() + "/" + accessor.GetName()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.prune(now)
+	if last, ok := r.recorded[key]; ok && now.Sub(last) < r.window {
+		return false
+	}
+	r.recorded[key] = now
+	return true
+}
+
+// prune forgets events older than the window, at most once per window.
+func (r *DedupRecorder) prune(now time.Time) {
+	if now.Sub(r.lastPrune) < r.window {
+		return
+	}
+	for key, last := range r.recorded {
+		if now.Sub(last) >= r.window {
+			delete(r.recorded, key)
+		}
+	}
+	r.lastPrune = now
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+func newDedupTestRecorder() (*DedupRecorder, *record.FakeRecorder, *time.Time) {
+	fake := record.NewFakeRecorder(20)
+	recorder := NewDedupRecorder(fake, time.Minute)
+	now := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	recorder.now = func() time.Time { return now }
+	return recorder, fake, &now
+}
+
+func dedupTestObject(name, uid string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(uid)},
+	}
+}
+
+// drainEvents returns the events recorded by fake so far.
+func drainEvents(fake *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-fake.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestDedupRecorder_CoalescesIdenticalEvents(t *testing.T) {
+	recorder, fake, _ := newDedupTestRecorder()
+	obj := dedupTestObject("tunnel", "uid-1")
+
+	for range 5 {
+		recorder.Event(obj, corev1.EventTypeWarning, "SyncFailed", "API error")
+		recorder.Eventf(obj, corev1.EventTypeNormal, "Synced", "Synced %d routes", 3)
+	}
+
+	assert.Equal(t, []string{
+		"Warning SyncFailed API error",
+		"Normal Synced Synced 3 routes",
+	}, drainEvents(fake))
+}
+
+func TestDedupRecorder_NewEventsFireImmediately(t *testing.T) {
+	recorder, fake, _ := newDedupTestRecorder()
+	obj := dedupTestObject("tunnel", "uid-1")
+
+	recorder.Event(obj, corev1.EventTypeWarning, "SyncFailed", "API error")
+	recorder.Event(obj, corev1.EventTypeWarning, "SyncFailed", "rate limited")
+	recorder.Event(obj, corev1.EventTypeWarning, "DeleteFailed", "rate limited")
+	recorder.Event(obj, corev1.EventTypeNormal, "SyncFailed", "rate limited")
+	recorder.Event(dedupTestObject("other", "uid-2"), corev1.EventTypeWarning, "SyncFailed", "API error")
+	// A recreated object has a new UID
+	recorder.Event(dedupTestObject("tunnel", "uid-3"), corev1.EventTypeWarning, "SyncFailed", "API error")
+
+	assert.Len(t, drainEvents(fake), 6)
+}
+
+func TestDedupRecorder_RecordsAgainAfterWindow(t *testing.T) {
+	recorder, fake, now := newDedupTestRecorder()
+	obj := dedupTestObject("project", "uid-1")
+
+	recorder.Event(obj, corev1.EventTypeNormal, "Synced", "Pages project synced")
+	*now = now.Add(59 * time.Second)
+	recorder.Event(obj, corev1.EventTypeNormal, "Synced", "Pages project synced")
+	assert.Len(t, drainEvents(fake), 1)
+
+	*now = now.Add(time.Second)
+	recorder.Event(obj, corev1.EventTypeNormal, "Synced", "Pages project synced")
+	assert.Len(t, drainEvents(fake), 1)
+	assert.Len(t, recorder.recorded, 1, "expired events are pruned")
+}
+
+func TestDedupRecorder_AnnotatedEventf(t *testing.T) {
+	recorder, fake, _ := newDedupTestRecorder()
+	obj := dedupTestObject("deployment", "uid-1")
+	annotations := map[string]string{"deployment-id": "abc"}
+
+	recorder.AnnotatedEventf(obj, annotations, corev1.EventTypeNormal, "Deployed", "Deployment %s succeeded", "abc")
+	recorder.AnnotatedEventf(obj, annotations, corev1.EventTypeNormal, "Deployed", "Deployment %s succeeded", "abc")
+	recorder.Event(obj, corev1.EventTypeNormal, "Deployed", "Deployment abc succeeded")
+
+	assert.Equal(t, []string{"Normal Deployed Deployment abc succeeded map[deployment-id:abc]"}, drainEvents(fake))
+}
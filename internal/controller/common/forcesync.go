@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// AnnotationForceSync requests a full resync of a resource, bypassing the
+	// hash-based checks that skip unchanged configuration. Any new value, such as
+	// a timestamp, triggers exactly one resync:
+	//
+	//	kubectl annotate cloudflaresyncstates --all --overwrite \
+	//	    cloudflare-operator.io/force-sync="$(date +%s)"
+	AnnotationForceSync = "cloudflare-operator.io/force-sync"
+
+	// AnnotationLastForceSync records the force-sync value that has been consumed.
+	AnnotationLastForceSync = "cloudflare-operator.io/last-force-sync"
+)
+
+// ForceSyncRequested reports whether the object carries a force-sync value
+// that has not been consumed yet.
+func ForceSyncRequested(obj client.Object) bool {
+	annotations := obj.GetAnnotations()
+	value := annotations[AnnotationForceSync]
+	return value != "" && value != annotations[AnnotationLastForceSync]
+}
+
+// ConsumeForceSync marks the pending force-sync value of the object as consumed,
+// so the same value does not trigger another resync. It is a no-op when no
+// force-sync is pending.
+func ConsumeForceSync(ctx context.Context, c client.Client, obj client.Object) error {
+	if !ForceSyncRequested(obj) {
+		return nil
+	}
+
+	original, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", obj)
+	}
+	annotations := obj.GetAnnotations()
+	annotations[AnnotationLastForceSync] = annotations[AnnotationForceSync]
+	obj.SetAnnotations(annotations)
+
+	if err := c.Patch(ctx, obj, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("consume force-sync annotation: %w", err)
+	}
+	return nil
+}
+
+// ForceSyncPredicate passes update events that set a new force-sync value.
+// Controllers that filter updates by generation combine it with predicate.Or
+// so annotation-only force-sync requests still reach the reconciler.
+func ForceSyncPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return ForceSyncRequested(e.Object) },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return ForceSyncRequested(e.ObjectNew) &&
+				e.ObjectOld.GetAnnotations()[AnnotationForceSync] != e.ObjectNew.GetAnnotations()[AnnotationForceSync]
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func configMapWithAnnotations(annotations map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default", Annotations: annotations},
+	}
+}
+
+func TestForceSyncRequested(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "no annotations"},
+		{name: "empty value", annotations: map[string]string{AnnotationForceSync: ""}},
+		{name: "new value", annotations: map[string]string{AnnotationForceSync: "1"}, want: true},
+		{
+			name:        "consumed value",
+			annotations: map[string]string{AnnotationForceSync: "1", AnnotationLastForceSync: "1"},
+		},
+		{
+			name:        "value changed after consume",
+			annotations: map[string]string{AnnotationForceSync: "2", AnnotationLastForceSync: "1"},
+			want:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ForceSyncRequested(configMapWithAnnotations(tt.annotations)))
+		})
+	}
+}
+
+func TestConsumeForceSync(t *testing.T) {
+	cm := configMapWithAnnotations(map[string]string{AnnotationForceSync: "1"})
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(cm).Build()
+
+	require.NoError(t, ConsumeForceSync(context.Background(), c, cm))
+
+	stored := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(cm), stored))
+	assert.Equal(t, "1", stored.Annotations[AnnotationLastForceSync])
+	assert.False(t, ForceSyncRequested(stored))
+
+	// Nothing pending is a no-op
+	require.NoError(t, ConsumeForceSync(context.Background(), c, stored))
+}
+
+func TestForceSyncPredicate(t *testing.T) {
+	p := ForceSyncPredicate()
+
+	assert.True(t, p.Update(event.UpdateEvent{
+		ObjectOld: configMapWithAnnotations(nil),
+		ObjectNew: configMapWithAnnotations(map[string]string{AnnotationForceSync: "1"}),
+	}))
+	assert.False(t, p.Update(event.UpdateEvent{
+		ObjectOld: configMapWithAnnotations(map[string]string{AnnotationForceSync: "1"}),
+		ObjectNew: configMapWithAnnotations(map[string]string{AnnotationForceSync: "1"}),
+	}), "unrelated updates are not passed")
+	assert.False(t, p.Update(event.UpdateEvent{
+		ObjectOld: configMapWithAnnotations(map[string]string{AnnotationForceSync: "1"}),
+		ObjectNew: configMapWithAnnotations(map[string]string{AnnotationForceSync: "1", AnnotationLastForceSync: "1"}),
+	}), "consuming the value is not a new request")
+	assert.True(t, p.Create(event.CreateEvent{
+		Object: configMapWithAnnotations(map[string]string{AnnotationForceSync: "1"}),
+	}))
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/StringKe/cloudflare-operator/internal/credentials"
+)
+
+// OperatorNamespace is the namespace where the operator runs.
+// This is used for cluster-scoped resources that need to look up secrets
+// and for the tunnel configuration ConfigMaps of cluster-scoped tunnels.
+// It is set once at startup with SetOperatorNamespace.
+var OperatorNamespace = "cloudflare-operator-system"
+
+// serviceAccountNamespaceFile holds the namespace of the pod when running in a cluster.
+var serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// SetOperatorNamespace sets the operator namespace.
+// This should be called during operator initialization.
+func SetOperatorNamespace(ns string) {
+	if ns != "" {
+		OperatorNamespace = ns
+		// The credentials package cannot import this one, so it keeps its own copy
+		credentials.DefaultSecretNamespace = ns
+	}
+}
+
+// ResolveOperatorNamespace determines the operator namespace from, in order of
+// precedence, the --cluster-resource-namespace flag value, the POD_NAMESPACE
+// environment variable and the service account of the pod. It returns an error
+// when none of them is available, rather than silently falling back to a
+// namespace the operator may not be installed in.
+func ResolveOperatorNamespace(flagValue string) (string, error) {
+	if flagValue != "" {
+		return flagValue, nil
+	}
+	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+		return ns, nil
+	}
+	if data, err := os.ReadFile(serviceAccountNamespaceFile); err == nil {
+		if ns := strings.TrimSpace(string(data)); ns != "" {
+			return ns, nil
+		}
+	}
+	return "", errors.New("operator namespace could not be determined: " +
+		"set --cluster-resource-namespace or the POD_NAMESPACE environment variable")
+}
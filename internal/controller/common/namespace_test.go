@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/internal/credentials"
+)
+
+func TestResolveOperatorNamespace(t *testing.T) {
+	saFile := filepath.Join(t.TempDir(), "namespace")
+	require.NoError(t, os.WriteFile(saFile, []byte("from-service-account\n"), 0o600))
+
+	tests := []struct {
+		name      string
+		flagValue string
+		env       string
+		saFile    string
+		want      string
+		wantErr   bool
+	}{
+		{name: "flag wins", flagValue: "from-flag", env: "from-env", saFile: saFile, want: "from-flag"},
+		{name: "POD_NAMESPACE", env: "from-env", saFile: saFile, want: "from-env"},
+		{name: "service account", saFile: saFile, want: "from-service-account"},
+		{name: "not determinable", saFile: filepath.Join(t.TempDir(), "missing"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("POD_NAMESPACE", tt.env)
+			previous := serviceAccountNamespaceFile
+			serviceAccountNamespaceFile = tt.saFile
+			t.Cleanup(func() { serviceAccountNamespaceFile = previous })
+
+			got, err := ResolveOperatorNamespace(tt.flagValue)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "--cluster-resource-namespace")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetOperatorNamespace(t *testing.T) {
+	previous, previousSecretNamespace := OperatorNamespace, credentials.DefaultSecretNamespace
+	t.Cleanup(func() {
+		OperatorNamespace = previous
+		credentials.DefaultSecretNamespace = previousSecretNamespace
+	})
+
+	SetOperatorNamespace("custom-system")
+	assert.Equal(t, "custom-system", OperatorNamespace)
+	assert.Equal(t, "custom-system", credentials.DefaultSecretNamespace)
+
+	SetOperatorNamespace("")
+	assert.Equal(t, "custom-system", OperatorNamespace, "an empty namespace is ignored")
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"errors"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// Condition reasons shared across controllers. Using the same machine-readable
+// reasons for the main transitions lets scripts check the status of any kind the
+// same way, e.g. waiting for the Ready condition with reason Ready.
+const (
+	// ReasonReconciling means the resource is being reconciled and is not ready yet.
+	ReasonReconciling = "Reconciling"
+	// ReasonCreating means the Cloudflare resource is being created.
+	ReasonCreating = "Creating"
+	// ReasonDeleting means the resource is being deleted.
+	ReasonDeleting = "Deleting"
+	// ReasonReady means the resource is reconciled and in sync with Cloudflare.
+	ReasonReady = "Ready"
+	// ReasonAPIError means a Cloudflare API call failed.
+	ReasonAPIError = "APIError"
+	// ReasonCredentialsInvalid means the Cloudflare credentials could not be loaded or were rejected.
+	ReasonCredentialsInvalid = "CredentialsInvalid"
+)
+
+// CredentialsError is returned by APIClientFactory.GetClient when no API client
+// can be built from the configured credentials.
+type CredentialsError struct {
+	Err error
+}
+
+func (e *CredentialsError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CredentialsError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorReason returns the condition reason for a reconcile error: ReasonCredentialsInvalid
+// when the credentials could not be loaded or were rejected by Cloudflare, ReasonAPIError otherwise.
+func ErrorReason(err error) string {
+	var credErr *CredentialsError
+	if errors.As(err, &credErr) || cf.IsAuthError(err) {
+		return ReasonCredentialsInvalid
+	}
+	return ReasonAPIError
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+func TestErrorReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "credentials error",
+			err:  &CredentialsError{Err: errors.New("failed to load credentials: not found")},
+			want: ReasonCredentialsInvalid,
+		},
+		{
+			name: "wrapped credentials error",
+			err:  fmt.Errorf("reconcile: %w", &CredentialsError{Err: errors.New("boom")}),
+			want: ReasonCredentialsInvalid,
+		},
+		{
+			name: "authentication rejected by Cloudflare",
+			err:  fmt.Errorf("list zones: %w", cf.ErrAuthenticationFailed),
+			want: ReasonCredentialsInvalid,
+		},
+		{
+			name: "other API error",
+			err:  errors.New("zone not found"),
+			want: ReasonAPIError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorReason(tt.err))
+		})
+	}
+}
+
+func TestCredentialsErrorKeepsMessage(t *testing.T) {
+	inner := errors.New("failed to load credentials: secret not found")
+	err := &CredentialsError{Err: inner}
+
+	assert.Equal(t, inner.Error(), err.Error())
+	assert.ErrorIs(t, err, inner)
+}
@@ -4,6 +4,7 @@
 package common
 
 import (
+	"context"
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,6 +31,12 @@ const (
 
 	// PollingIntervalDNS is used for polling DNS propagation.
 	PollingIntervalDNS = 1 * time.Minute
+
+	// MaxRequeueInterval is the longest interval RequeueAfterOrDeadline schedules.
+	MaxRequeueInterval = 10 * time.Minute
+
+	// DeadlineMargin is the time left below which a context counts as nearly done.
+	DeadlineMargin = 1 * time.Second
 )
 
 // RequeueResult returns a ctrl.Result for requeuing after the specified duration.
@@ -37,6 +44,26 @@ func RequeueResult(after time.Duration) ctrl.Result {
 	return ctrl.Result{RequeueAfter: after}
 }
 
+// RequeueAfterOrDeadline returns a result for requeuing after d, capped at
+// MaxRequeueInterval and at the time left before the context's deadline, so a
+// poll is never scheduled after the caller has stopped waiting. A context that is
+// done or has less than DeadlineMargin left requeues immediately.
+func RequeueAfterOrDeadline(ctx context.Context, d time.Duration) ctrl.Result {
+	if ctx.Err() != nil || d <= 0 {
+		return ctrl.Result{Requeue: true}
+	}
+
+	d = min(d, MaxRequeueInterval)
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline)
+		if remaining < DeadlineMargin {
+			return ctrl.Result{Requeue: true}
+		}
+		d = min(d, remaining)
+	}
+	return ctrl.Result{RequeueAfter: d}
+}
+
 // RequeueShort returns a result for quick retry.
 func RequeueShort() ctrl.Result {
 	return ctrl.Result{RequeueAfter: RequeueIntervalShort}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestRequeueAfterOrDeadline(t *testing.T) {
+	t.Run("no deadline", func(t *testing.T) {
+		assert.Equal(t, ctrl.Result{RequeueAfter: 30 * time.Second},
+			RequeueAfterOrDeadline(context.Background(), 30*time.Second))
+	})
+
+	t.Run("capped at the ceiling", func(t *testing.T) {
+		assert.Equal(t, ctrl.Result{RequeueAfter: MaxRequeueInterval},
+			RequeueAfterOrDeadline(context.Background(), time.Hour))
+	})
+
+	t.Run("capped at the deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		result := RequeueAfterOrDeadline(ctx, 30*time.Second)
+		assert.False(t, result.Requeue)
+		assert.LessOrEqual(t, result.RequeueAfter, 5*time.Second)
+		assert.Greater(t, result.RequeueAfter, DeadlineMargin)
+	})
+
+	t.Run("shorter than the deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		assert.Equal(t, ctrl.Result{RequeueAfter: 2 * time.Second}, RequeueAfterOrDeadline(ctx, 2*time.Second))
+	})
+
+	t.Run("deadline nearly reached", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), DeadlineMargin/2)
+		defer cancel()
+
+		assert.Equal(t, ctrl.Result{Requeue: true}, RequeueAfterOrDeadline(ctx, 30*time.Second))
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.Equal(t, ctrl.Result{Requeue: true}, RequeueAfterOrDeadline(ctx, 30*time.Second))
+	})
+
+	t.Run("non-positive interval", func(t *testing.T) {
+		assert.Equal(t, ctrl.Result{Requeue: true}, RequeueAfterOrDeadline(context.Background(), 0))
+	})
+}
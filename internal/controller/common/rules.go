@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// ReasonRulesRejected is the Ready condition reason when Cloudflare rejected some rules of a ruleset.
+const ReasonRulesRejected = "RulesRejected"
+
+// RuleHash returns a hash identifying the content of a ruleset rule.
+func RuleHash(rule cloudflare.RulesetRule) string {
+	data, err := json.Marshal(rule)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// SkipRejectedRules removes the rules that Cloudflare rejected in a previous sync, as recorded in
+// previous, and that have not changed since, so they are not sent again until their spec changes.
+// It returns the remaining rules, the index in rules of each remaining rule, and the previous
+// rejection messages keyed by index in rules.
+func SkipRejectedRules(
+	rules []cloudflare.RulesetRule, previous []networkingv1alpha2.RuleStatus,
+) (remaining []cloudflare.RulesetRule, indexes []int, rejected map[int]string) {
+	known := map[string]string{}
+	for _, s := range previous {
+		if !s.Applied && s.Hash != "" {
+			known[s.Hash] = s.Error
+		}
+	}
+
+	rejected = map[int]string{}
+	for i, rule := range rules {
+		if msg, ok := known[RuleHash(rule)]; ok {
+			rejected[i] = msg
+			continue
+		}
+		remaining = append(remaining, rule)
+		indexes = append(indexes, i)
+	}
+	return remaining, indexes, rejected
+}
+
+// UpdateEntrypointRules updates the entrypoint ruleset of phase with rules, skipping the rules that
+// Cloudflare rejected in a previous sync with the same content and retrying without newly rejected
+// ones. It returns the rejection message of each rejected rule, keyed by its index in rules.
+// An error is returned when a failure cannot be attributed to specific rules or when every rule
+// is rejected; previously rejected rules alone do not cause an API call.
+func UpdateEntrypointRules(
+	ctx context.Context, api *cf.API, zoneID, phase, description string,
+	rules []cloudflare.RulesetRule, previous []networkingv1alpha2.RuleStatus,
+) (*cf.RulesetResult, map[int]string, error) {
+	remaining, indexes, rejected := SkipRejectedRules(rules, previous)
+	if len(rules) > 0 && len(remaining) == 0 {
+		return nil, rejected, errors.New("all rules were rejected")
+	}
+
+	result, newlyRejected, err := api.UpdateEntrypointRulesetSkippingRejected(ctx, zoneID, phase, description, remaining)
+	for i, msg := range newlyRejected {
+		rejected[indexes[i]] = msg
+	}
+	return result, rejected, err
+}
+
+// RuleStatuses builds the per-rule status of a ruleset from the rule names and rules, in ruleset
+// order, and the rejection messages keyed by rule index. Rejected rules record the hash of their
+// content so they are skipped until they change.
+func RuleStatuses(
+	names []string, rules []cloudflare.RulesetRule, rejected map[int]string,
+) []networkingv1alpha2.RuleStatus {
+	statuses := make([]networkingv1alpha2.RuleStatus, len(names))
+	for i, name := range names {
+		statuses[i] = networkingv1alpha2.RuleStatus{Index: i, Name: name, Applied: true}
+		if msg, ok := rejected[i]; ok {
+			statuses[i].Applied = false
+			statuses[i].Error = cf.SanitizeErrorMessage(errors.New(msg))
+			if i < len(rules) {
+				statuses[i].Hash = RuleHash(rules[i])
+			}
+		}
+	}
+	return statuses
+}
+
+// RejectedRulesMessage summarizes the rejected rules of statuses for a condition message.
+// It returns an empty string when every rule was applied.
+func RejectedRulesMessage(statuses []networkingv1alpha2.RuleStatus) string {
+	var rejected []string
+	for _, s := range statuses {
+		if !s.Applied {
+			rejected = append(rejected, fmt.Sprintf("rule %d (%s): %s", s.Index, s.Name, s.Error))
+		}
+	}
+	if len(rejected) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d of %d rules rejected by Cloudflare: %s",
+		len(rejected), len(statuses), strings.Join(rejected, "; "))
+}
+
+// UpdateStatusRulesRejected records that Cloudflare rejected every rule of obj, leaving its ruleset
+// unchanged. setStatus applies the rejection message and the Ready condition to the status of obj,
+// along with the rule statuses and the error state. The spec has to be fixed, so obj is not requeued.
+func UpdateStatusRulesRejected(
+	ctx context.Context,
+	c client.Client,
+	recorder record.EventRecorder,
+	obj client.Object,
+	statuses []networkingv1alpha2.RuleStatus,
+	setStatus func(msg string, ready metav1.Condition),
+) (ctrl.Result, error) {
+	msg := RejectedRulesMessage(statuses)
+	recorder.Event(obj, corev1.EventTypeWarning, ReasonRulesRejected, msg)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		setStatus(msg, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.GetGeneration(),
+			Reason:             ReasonRulesRejected,
+			Message:            msg,
+			LastTransitionTime: metav1.Now(),
+		})
+		err := c.Status().Update(ctx, obj)
+		if apierrors.IsConflict(err) {
+			if getErr := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); getErr != nil {
+				return getErr
+			}
+		}
+		return err
+	})
+
+	if err != nil {
+		return NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return NoRequeue(), nil
+}
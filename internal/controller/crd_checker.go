@@ -77,6 +77,16 @@ func (c *CRDChecker) HasTCPRoute() bool {
 	return c.HasGVK(tcpRouteGVK)
 }
 
+// HasTLSRoute checks if TLSRoute CRD is installed (alpha2)
+func (c *CRDChecker) HasTLSRoute() bool {
+	tlsRouteGVK := schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1alpha2",
+		Kind:    "TLSRoute",
+	}
+	return c.HasGVK(tlsRouteGVK)
+}
+
 // HasUDPRoute checks if UDPRoute CRD is installed (alpha2)
 func (c *CRDChecker) HasUDPRoute() bool {
 	udpRouteGVK := schema.GroupVersionKind{
@@ -87,6 +97,16 @@ func (c *CRDChecker) HasUDPRoute() bool {
 	return c.HasGVK(udpRouteGVK)
 }
 
+// HasReferenceGrant checks if ReferenceGrant CRD is installed (beta1)
+func (c *CRDChecker) HasReferenceGrant() bool {
+	referenceGrantGVK := schema.GroupVersionKind{
+		Group:   "gateway.networking.k8s.io",
+		Version: "v1beta1",
+		Kind:    "ReferenceGrant",
+	}
+	return c.HasGVK(referenceGrantGVK)
+}
+
 // GatewayAPIStatus contains the status of Gateway API CRDs
 type GatewayAPIStatus struct {
 	// GatewayClassAvailable indicates if GatewayClass CRD is available
@@ -97,8 +117,12 @@ type GatewayAPIStatus struct {
 	HTTPRouteAvailable bool
 	// TCPRouteAvailable indicates if TCPRoute CRD is available
 	TCPRouteAvailable bool
+	// TLSRouteAvailable indicates if TLSRoute CRD is available
+	TLSRouteAvailable bool
 	// UDPRouteAvailable indicates if UDPRoute CRD is available
 	UDPRouteAvailable bool
+	// ReferenceGrantAvailable indicates if ReferenceGrant CRD is available
+	ReferenceGrantAvailable bool
 }
 
 // GetGatewayAPIStatus returns the detailed status of Gateway API CRDs
@@ -114,9 +138,11 @@ func (c *CRDChecker) GetGatewayAPIStatus() GatewayAPIStatus {
 			Version: "v1",
 			Kind:    "Gateway",
 		}),
-		HTTPRouteAvailable: c.HasHTTPRoute(),
-		TCPRouteAvailable:  c.HasTCPRoute(),
-		UDPRouteAvailable:  c.HasUDPRoute(),
+		HTTPRouteAvailable:      c.HasHTTPRoute(),
+		TCPRouteAvailable:       c.HasTCPRoute(),
+		TLSRouteAvailable:       c.HasTLSRoute(),
+		UDPRouteAvailable:       c.HasUDPRoute(),
+		ReferenceGrantAvailable: c.HasReferenceGrant(),
 	}
 }
 
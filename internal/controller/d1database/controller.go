@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package d1database provides a controller for managing Cloudflare D1 databases.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package d1database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	finalizerName = "cloudflare.com/d1-database-finalizer"
+)
+
+// Reconciler reconciles a D1Database object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=d1databases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=d1databases/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=d1databases/finalizers,verbs=update
+
+// Reconcile handles D1Database reconciliation
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Get the D1Database resource
+	database := &networkingv1alpha2.D1Database{}
+	if err := r.Get(ctx, req.NamespacedName, database); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch D1Database")
+		return common.NoRequeue(), err
+	}
+
+	// Handle deletion
+	if !database.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, database)
+	}
+
+	// Ensure finalizer
+	if added, err := controller.EnsureFinalizer(ctx, r.Client, database, finalizerName); err != nil {
+		return common.NoRequeue(), err
+	} else if added {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Get API client
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CredentialsRef: database.Spec.CredentialsRef,
+		Namespace:      database.Namespace,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, database, err)
+	}
+
+	// Sync database to Cloudflare
+	return r.syncDatabase(ctx, database, apiResult)
+}
+
+// handleDeletion handles the deletion of D1Database.
+func (r *Reconciler) handleDeletion(
+	ctx context.Context,
+	database *networkingv1alpha2.D1Database,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(database, finalizerName) {
+		return common.NoRequeue(), nil
+	}
+
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, database, database.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CredentialsRef: database.Spec.CredentialsRef,
+			Namespace:      database.Namespace,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if database.Status.DatabaseID != "" {
+			// Delete database from Cloudflare
+			logger.Info("Deleting D1 database from Cloudflare",
+				"databaseName", database.Status.DatabaseName,
+				"databaseId", database.Status.DatabaseID)
+
+			if err := apiResult.API.DeleteD1Database(ctx, database.Status.DatabaseID); err != nil {
+				logger.Error(err, "Failed to delete D1 database from Cloudflare")
+				if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, database, database.Spec.DeletionTimeout, err); retry {
+					return result, nil
+				}
+			} else {
+				r.Recorder.Event(database, corev1.EventTypeNormal, "Deleted",
+					"D1 database deleted from Cloudflare")
+			}
+		}
+	}
+
+	// Remove finalizer
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, database, func() {
+		controllerutil.RemoveFinalizer(database, finalizerName)
+	}); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return common.NoRequeue(), err
+	}
+	r.Recorder.Event(database, corev1.EventTypeNormal, controller.EventReasonFinalizerRemoved, "Finalizer removed")
+
+	return common.NoRequeue(), nil
+}
+
+// syncDatabase syncs the D1 database to Cloudflare.
+func (r *Reconciler) syncDatabase(
+	ctx context.Context,
+	database *networkingv1alpha2.D1Database,
+	apiResult *common.APIClientResult,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	databaseName := database.Spec.Name
+	if databaseName == "" {
+		databaseName = database.Name
+	}
+
+	// Check the database of the status still exists
+	if database.Status.DatabaseID != "" {
+		existing, err := apiResult.API.GetD1Database(ctx, database.Status.DatabaseID)
+		switch {
+		case err == nil && existing.Name == databaseName:
+			logger.V(1).Info("D1 database already exists in Cloudflare",
+				"databaseName", databaseName,
+				"databaseId", existing.ID)
+			return r.updateStatusReady(ctx, database, existing)
+		case err != nil && !cf.IsNotFoundError(err):
+			logger.Error(err, "Failed to get D1 database from Cloudflare")
+			return r.updateStatusError(ctx, database, err)
+		case err != nil:
+			r.Recorder.Event(database, corev1.EventTypeWarning, "NotFound",
+				fmt.Sprintf("D1 database '%s' no longer exists in Cloudflare", database.Status.DatabaseID))
+		}
+		// The database was deleted outside the operator, or spec.name changed
+	}
+
+	getDatabase := func(ctx context.Context) (*cf.D1DatabaseResult, error) {
+		return apiResult.API.GetD1DatabaseByName(ctx, databaseName)
+	}
+	createDatabase := func(ctx context.Context) (*cf.D1DatabaseResult, error) {
+		logger.Info("Creating D1 database in Cloudflare",
+			"databaseName", databaseName,
+			"locationHint", database.Spec.LocationHint)
+		return apiResult.API.CreateD1Database(ctx, cf.D1DatabaseParams{
+			Name:                databaseName,
+			PrimaryLocationHint: string(database.Spec.LocationHint),
+		})
+	}
+
+	// A database with the name is only taken over as allowed by the adoption policy
+	adoption, err := common.Adopt(ctx, database.Spec.AdoptionPolicy, getDatabase, createDatabase)
+	if err != nil {
+		logger.Error(err, "Failed to adopt or create D1 database", "databaseName", databaseName)
+		return r.updateStatusError(ctx, database, err)
+	}
+
+	if adoption.Adopted {
+		logger.Info("D1 database already exists, adopting it", "databaseName", databaseName)
+		r.Recorder.Event(database, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing D1 database '%s'", databaseName))
+	} else {
+		r.Recorder.Event(database, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("D1 database '%s' created in Cloudflare", databaseName))
+	}
+
+	return r.updateStatusReady(ctx, database, adoption.Resource)
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	database *networkingv1alpha2.D1Database,
+	err error,
+) (ctrl.Result, error) {
+	reason := "Error"
+	var adoptionErr *common.AdoptionError
+	if errors.As(err, &adoptionErr) {
+		reason = common.ReasonAdoptionFailed
+		r.Recorder.Event(database, corev1.EventTypeWarning, common.ReasonAdoptionFailed, adoptionErr.Error())
+	}
+
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, database, func() {
+		database.Status.State = networkingv1alpha2.D1DatabaseStateError
+		database.Status.Message = cf.SanitizeErrorMessage(err)
+		meta.SetStatusCondition(&database.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: database.Generation,
+			Reason:             reason,
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		database.Status.ObservedGeneration = database.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+func (r *Reconciler) updateStatusReady(
+	ctx context.Context,
+	database *networkingv1alpha2.D1Database,
+	result *cf.D1DatabaseResult,
+) (ctrl.Result, error) {
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, database, func() {
+		database.Status.DatabaseID = result.ID
+		database.Status.DatabaseName = result.Name
+		database.Status.Version = result.Version
+		if !result.CreatedAt.IsZero() {
+			createdAt := metav1.NewTime(result.CreatedAt)
+			database.Status.CreatedAt = &createdAt
+		}
+		database.Status.State = networkingv1alpha2.D1DatabaseStateReady
+		database.Status.Message = ""
+		meta.SetStatusCondition(&database.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: database.Generation,
+			Reason:             "Synced",
+			Message:            "D1 database synced to Cloudflare",
+			LastTransitionTime: metav1.Now(),
+		})
+		database.Status.ObservedGeneration = database.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return common.NoRequeue(), nil
+}
+
+// findDatabasesForCredentials returns D1Databases that reference the given credentials
+func (r *Reconciler) findDatabasesForCredentials(ctx context.Context, obj client.Object) []reconcile.Request {
+	creds, ok := obj.(*networkingv1alpha2.CloudflareCredentials)
+	if !ok {
+		return nil
+	}
+
+	databaseList := &networkingv1alpha2.D1DatabaseList{}
+	if err := r.List(ctx, databaseList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, database := range databaseList.Items {
+		if (database.Spec.CredentialsRef != nil && database.Spec.CredentialsRef.Name == creds.Name) ||
+			(creds.Spec.IsDefault && database.Spec.CredentialsRef == nil) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      database.Name,
+					Namespace: database.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("d1database-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("d1database"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.D1Database{}).
+		Watches(&networkingv1alpha2.CloudflareCredentials{},
+			handler.EnqueueRequestsFromMapFunc(r.findDatabasesForCredentials)).
+		Named("d1database").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.D1Database{}, r))
+}
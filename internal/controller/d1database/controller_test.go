@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package d1database
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.D1Database{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newDatabase(spec networkingv1alpha2.D1DatabaseSpec) *networkingv1alpha2.D1Database {
+	return &networkingv1alpha2.D1Database{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "app",
+			Namespace:  "default",
+			Finalizers: []string{finalizerName},
+		},
+		Spec: spec,
+	}
+}
+
+// reconcileDatabase reconciles the "app" database and returns the result and the updated database.
+func reconcileDatabase(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.D1Database) {
+	t.Helper()
+	key := types.NamespacedName{Name: "app", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	database := &networkingv1alpha2.D1Database{}
+	require.NoError(t, c.Get(context.Background(), key, database))
+	return result, database
+}
+
+func TestReconcile_CreatesDatabase(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{
+		Name:         "app-db",
+		LocationHint: networkingv1alpha2.D1LocationWEUR,
+	}))
+
+	result, database := reconcileDatabase(t, r, c)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.D1DatabaseStateReady, database.Status.State)
+	assert.Equal(t, "app-db", database.Status.DatabaseName)
+	require.NotEmpty(t, database.Status.DatabaseID)
+	assert.NotNil(t, database.Status.CreatedAt)
+	assert.True(t, meta.IsStatusConditionTrue(database.Status.Conditions, "Ready"))
+
+	stored, ok := mock.Store().GetD1Database(database.Status.DatabaseID)
+	require.True(t, ok)
+	assert.Equal(t, "weur", stored.PrimaryLocationHint)
+
+	// A second reconcile changes nothing
+	_, again := reconcileDatabase(t, r, c)
+	assert.Equal(t, database.Status.DatabaseID, again.Status.DatabaseID)
+	assert.Len(t, mock.Store().ListD1Databases(""), 1)
+}
+
+func TestReconcile_DefaultsNameToResourceName(t *testing.T) {
+	newMockServer(t)
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{}))
+
+	_, database := reconcileDatabase(t, r, c)
+
+	assert.Equal(t, "app", database.Status.DatabaseName)
+}
+
+func TestReconcile_AdoptsExistingDatabase(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateD1Database(&models.D1Database{
+		UUID: "existing-db", Name: "app", Version: "production", CreatedAt: time.Now(),
+	})
+	mock.Store().CreateD1Database(&models.D1Database{UUID: "staging-db", Name: "app-staging"})
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{AdoptionPolicy: common.AdoptionPolicyIfExists}))
+
+	_, database := reconcileDatabase(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.D1DatabaseStateReady, database.Status.State)
+	assert.Equal(t, "existing-db", database.Status.DatabaseID)
+	assert.Equal(t, "production", database.Status.Version)
+	assert.Len(t, mock.Store().ListD1Databases(""), 2, "no database is created")
+}
+
+func TestReconcile_ExistingDatabaseIsNotAdoptedByDefault(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateD1Database(&models.D1Database{UUID: "existing-db", Name: "app"})
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{}))
+
+	_, database := reconcileDatabase(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.D1DatabaseStateError, database.Status.State)
+	assert.Empty(t, database.Status.DatabaseID)
+	cond := meta.FindStatusCondition(database.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, common.ReasonAdoptionFailed, cond.Reason)
+	assert.Len(t, mock.Store().ListD1Databases(""), 1, "no database is created")
+}
+
+func TestReconcile_RecreatesDeletedDatabase(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{}))
+	_, database := reconcileDatabase(t, r, c)
+	oldID := database.Status.DatabaseID
+	require.True(t, mock.Store().DeleteD1Database(oldID))
+
+	_, database = reconcileDatabase(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.D1DatabaseStateReady, database.Status.State)
+	assert.NotEqual(t, oldID, database.Status.DatabaseID)
+	_, ok := mock.Store().GetD1Database(database.Status.DatabaseID)
+	assert.True(t, ok)
+}
+
+func TestReconcile_DeleteDatabase(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{}))
+	_, database := reconcileDatabase(t, r, c)
+	require.NotEmpty(t, database.Status.DatabaseID)
+
+	require.NoError(t, c.Delete(context.Background(), database))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "app", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, mock.Store().ListD1Databases(""))
+	err = c.Get(context.Background(), types.NamespacedName{Name: "app", Namespace: "default"}, database)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_DeleteAlreadyDeletedDatabase(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{}))
+	_, database := reconcileDatabase(t, r, c)
+	require.True(t, mock.Store().DeleteD1Database(database.Status.DatabaseID))
+
+	require.NoError(t, c.Delete(context.Background(), database))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "app", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	err = c.Get(context.Background(), types.NamespacedName{Name: "app", Namespace: "default"}, database)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_OrphanKeepsDatabase(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDatabase(networkingv1alpha2.D1DatabaseSpec{DeletionPolicy: "Orphan"}))
+	_, database := reconcileDatabase(t, r, c)
+
+	require.NoError(t, c.Delete(context.Background(), database))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "app", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, mock.Store().ListD1Databases(""), 1)
+}
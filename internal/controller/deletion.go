@@ -5,20 +5,70 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
 )
 
 // DefaultRequeueAfter is the default requeue duration after an error
 const DefaultRequeueAfter = 30 * time.Second
 
+// DefaultDeletionTimeout is how long a failed deletion from Cloudflare is retried
+// before the finalizer is removed anyway, unless a resource sets spec.deletionTimeout.
+// It is set from the --deletion-timeout flag.
+var DefaultDeletionTimeout = 30 * time.Minute
+
+// DeletionTimeRemaining returns how much of the deletion timeout of obj is left.
+// The timeout starts at the deletion timestamp; a nil timeout uses DefaultDeletionTimeout.
+func DeletionTimeRemaining(obj client.Object, timeout *metav1.Duration) time.Duration {
+	deletedAt := obj.GetDeletionTimestamp()
+	if deletedAt == nil {
+		return 0
+	}
+	d := DefaultDeletionTimeout
+	if timeout != nil {
+		d = timeout.Duration
+	}
+	return time.Until(deletedAt.Add(d))
+}
+
+// HandleDeletionFailure decides how to proceed after deleting the Cloudflare
+// resource of obj failed. Within the deletion timeout it records a DeleteFailed
+// event and returns retry=true with a result that retries the deletion; the
+// caller must keep the finalizer. Once the timeout has passed it records a
+// ForcedDeletion event and returns retry=false, and the caller removes the
+// finalizer, leaving the Cloudflare resource orphaned.
+func HandleDeletionFailure(
+	ctx context.Context,
+	recorder record.EventRecorder,
+	obj client.Object,
+	timeout *metav1.Duration,
+	err error,
+) (result ctrl.Result, retry bool) {
+	remaining := DeletionTimeRemaining(obj, timeout)
+	if remaining > 0 {
+		recorder.Event(obj, corev1.EventTypeWarning, EventReasonDeleteFailed,
+			fmt.Sprintf("Failed to delete from Cloudflare (retrying for %s): %s",
+				remaining.Round(time.Second), cf.SanitizeErrorMessage(err)))
+		return common.RequeueAfterOrDeadline(ctx, min(DefaultRequeueAfter, remaining)), true
+	}
+
+	recorder.Event(obj, corev1.EventTypeWarning, EventReasonForcedDeletion,
+		fmt.Sprintf("Deletion timeout exceeded, removing finalizer; the Cloudflare resource may be orphaned: %s",
+			cf.SanitizeErrorMessage(err)))
+	return ctrl.Result{}, false
+}
+
 // DeletionHandler handles the standard deletion flow for resources
 type DeletionHandler struct {
 	Client        client.Client
@@ -4,11 +4,16 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 func TestDefaultRequeueAfter(t *testing.T) {
@@ -65,3 +70,79 @@ func TestDeletionHandlerStruct(t *testing.T) {
 	assert.Nil(t, handler.Client)
 	assert.Nil(t, handler.Recorder)
 }
+
+func deletingSecret(deletedAt time.Time) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test",
+			Namespace:         "default",
+			DeletionTimestamp: &metav1.Time{Time: deletedAt},
+		},
+	}
+}
+
+func TestDeletionTimeRemaining(t *testing.T) {
+	now := time.Now()
+
+	assert.Zero(t, DeletionTimeRemaining(&corev1.Secret{}, nil))
+	assert.InDelta(t, (DefaultDeletionTimeout - time.Minute).Seconds(),
+		DeletionTimeRemaining(deletingSecret(now.Add(-time.Minute)), nil).Seconds(), 1)
+	assert.InDelta(t, (4 * time.Minute).Seconds(),
+		DeletionTimeRemaining(deletingSecret(now.Add(-time.Minute)), &metav1.Duration{Duration: 5 * time.Minute}).Seconds(), 1)
+	assert.Negative(t, DeletionTimeRemaining(deletingSecret(now.Add(-time.Hour)), &metav1.Duration{Duration: 5 * time.Minute}))
+}
+
+func TestHandleDeletionFailure(t *testing.T) {
+	deleteErr := errors.New("api unavailable")
+
+	tests := []struct {
+		name       string
+		deletedAgo time.Duration
+		timeout    *metav1.Duration
+		wantRetry  bool
+		wantReason string
+	}{
+		{
+			name:       "within default timeout",
+			deletedAgo: time.Minute,
+			wantRetry:  true,
+			wantReason: EventReasonDeleteFailed,
+		},
+		{
+			name:       "default timeout exhausted",
+			deletedAgo: DefaultDeletionTimeout + time.Minute,
+			wantReason: EventReasonForcedDeletion,
+		},
+		{
+			name:       "within spec timeout",
+			deletedAgo: 2 * time.Hour,
+			timeout:    &metav1.Duration{Duration: 3 * time.Hour},
+			wantRetry:  true,
+			wantReason: EventReasonDeleteFailed,
+		},
+		{
+			name:       "spec timeout exhausted",
+			deletedAgo: 2 * time.Minute,
+			timeout:    &metav1.Duration{Duration: time.Minute},
+			wantReason: EventReasonForcedDeletion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			obj := deletingSecret(time.Now().Add(-tt.deletedAgo))
+
+			result, retry := HandleDeletionFailure(context.Background(), recorder, obj, tt.timeout, deleteErr)
+
+			assert.Equal(t, tt.wantRetry, retry)
+			if tt.wantRetry {
+				assert.Positive(t, result.RequeueAfter)
+				assert.LessOrEqual(t, result.RequeueAfter, DefaultRequeueAfter)
+			} else {
+				assert.Zero(t, result.RequeueAfter)
+			}
+			assert.Contains(t, <-recorder.Events, tt.wantReason)
+		})
+	}
+}
@@ -381,5 +381,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findDeviceSettingsPoliciesForNetworkRoute),
 		).
 		Named("devicesettingspolicy").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.DeviceSettingsPolicy{}, r))
 }
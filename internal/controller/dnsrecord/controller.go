@@ -496,8 +496,11 @@ func (r *DNSRecordReconciler) handleDeletion(
 		return ctrl.Result{}, nil
 	}
 
-	// Delete DNS record from Cloudflare
-	if dnsRecord.Status.RecordID != "" && zoneInfo.ZoneID != "" {
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, dnsRecord, dnsRecord.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else if dnsRecord.Status.RecordID != "" && zoneInfo.ZoneID != "" {
+		// Delete DNS record from Cloudflare
 		apiResult, err := r.getAPIClient(ctx, dnsRecord, zoneInfo)
 		if err != nil {
 			logger.Error(err, "Failed to get API client for deletion")
@@ -505,12 +508,11 @@ func (r *DNSRecordReconciler) handleDeletion(
 		} else {
 			if err := apiResult.API.DeleteDNSRecordInZone(ctx, zoneInfo.ZoneID, dnsRecord.Status.RecordID); err != nil {
 				if !cf.IsNotFoundError(err) {
-					logger.Error(err, "Failed to delete DNS record from Cloudflare, continuing with finalizer removal")
-					r.Recorder.Event(dnsRecord, corev1.EventTypeWarning, controller.EventReasonDeleteFailed,
-						fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-					// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+					logger.Error(err, "Failed to delete DNS record from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, dnsRecord, dnsRecord.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
 				}
-				// Record already deleted or deletion failed, continue with finalizer removal
 			} else {
 				r.Recorder.Event(dnsRecord, corev1.EventTypeNormal, controller.EventReasonDeleted,
 					fmt.Sprintf("Deleted DNS record %s from Cloudflare", dnsRecord.Status.RecordID))
@@ -729,7 +731,7 @@ func (r *DNSRecordReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findDNSRecordsForHTTPRoute)).
 		Watches(&corev1.Node{},
 			handler.EnqueueRequestsFromMapFunc(r.findDNSRecordsForNode)).
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.DNSRecord{}, r))
 }
 
 // sourceRefMatcher is a function that checks if a DNSRecord's sourceRef matches a given resource.
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package domainregistration
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+const (
+	// AnnotationAllowNameServerChange allows replacing Cloudflare nameservers with
+	// external ones. Without it, such a change is rejected because DNS records
+	// served by Cloudflare stop resolving for the domain.
+	AnnotationAllowNameServerChange = "cloudflare-operator.io/allow-nameserver-change"
+
+	// ReasonInvalidNameServers is the Ready condition reason for rejected nameservers
+	ReasonInvalidNameServers = "InvalidNameServers"
+
+	cloudflareNameServerSuffix = ".ns.cloudflare.com"
+)
+
+// normalizeNameServers lowercases nameservers and strips trailing dots.
+func normalizeNameServers(nameServers []string) []string {
+	normalized := make([]string, 0, len(nameServers))
+	for _, ns := range nameServers {
+		normalized = append(normalized, strings.TrimSuffix(strings.ToLower(strings.TrimSpace(ns)), "."))
+	}
+	return normalized
+}
+
+// sameNameServers reports whether two nameserver lists are equal, ignoring order and case.
+func sameNameServers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	na, nb := normalizeNameServers(a), normalizeNameServers(b)
+	slices.Sort(na)
+	slices.Sort(nb)
+	return slices.Equal(na, nb)
+}
+
+// usesCloudflareNameServers reports whether any nameserver is a Cloudflare nameserver.
+func usesCloudflareNameServers(nameServers []string) bool {
+	for _, ns := range normalizeNameServers(nameServers) {
+		if strings.HasSuffix(ns, cloudflareNameServerSuffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNameServers checks a nameserver change requested in the spec.
+// Invalid or duplicate hostnames are rejected, and moving the domain off
+// Cloudflare nameservers requires the allow-nameserver-change annotation.
+func validateNameServers(domain *networkingv1alpha2.DomainRegistration, info *cf.RegistrarDomainInfo) error {
+	desired := domain.Spec.Configuration.NameServers
+	if len(desired) == 0 || sameNameServers(desired, info.NameServers) {
+		return nil
+	}
+
+	if len(desired) < 2 {
+		return fmt.Errorf("at least two nameservers are required, got %d", len(desired))
+	}
+	seen := make(map[string]bool, len(desired))
+	for _, ns := range normalizeNameServers(desired) {
+		if errs := validation.IsDNS1123Subdomain(ns); len(errs) > 0 || !strings.Contains(ns, ".") {
+			return fmt.Errorf("nameserver %q is not a valid hostname", ns)
+		}
+		if seen[ns] {
+			return fmt.Errorf("nameserver %q is listed more than once", ns)
+		}
+		seen[ns] = true
+	}
+
+	if usesCloudflareNameServers(info.NameServers) && !usesCloudflareNameServers(desired) &&
+		domain.Annotations[AnnotationAllowNameServerChange] != "true" {
+		return fmt.Errorf("replacing Cloudflare nameservers %s stops DNS served by Cloudflare; "+
+			"set annotation %s=true to allow it",
+			strings.Join(info.NameServers, ", "), AnnotationAllowNameServerChange)
+	}
+
+	return nil
+}
+
+// desiredConfig returns the settings of the spec that differ from the domain.
+func desiredConfig(spec *networkingv1alpha2.DomainConfiguration, info *cf.RegistrarDomainInfo) cf.RegistrarDomainConfig {
+	config := cf.RegistrarDomainConfig{}
+	if spec.AutoRenew != nil && *spec.AutoRenew != info.AutoRenew {
+		config.AutoRenew = ptr.To(*spec.AutoRenew)
+	}
+	if spec.Privacy != info.Privacy {
+		config.Privacy = ptr.To(spec.Privacy)
+	}
+	if spec.Locked != info.Locked {
+		config.Locked = ptr.To(spec.Locked)
+	}
+	if len(spec.NameServers) > 0 && !sameNameServers(spec.NameServers, info.NameServers) {
+		config.NameServers = normalizeNameServers(spec.NameServers)
+	}
+	return config
+}
+
+// describeConfig summarizes a configuration change for events.
+func describeConfig(config cf.RegistrarDomainConfig) string {
+	var changes []string
+	if config.AutoRenew != nil {
+		changes = append(changes, fmt.Sprintf("autoRenew=%t", *config.AutoRenew))
+	}
+	if config.Privacy != nil {
+		changes = append(changes, fmt.Sprintf("privacy=%t", *config.Privacy))
+	}
+	if config.Locked != nil {
+		changes = append(changes, fmt.Sprintf("locked=%t", *config.Locked))
+	}
+	if config.NameServers != nil {
+		changes = append(changes, "nameServers="+strings.Join(config.NameServers, ","))
+	}
+	return strings.Join(changes, ", ")
+}
@@ -8,6 +8,7 @@ package domainregistration
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -137,28 +138,38 @@ func (r *Reconciler) syncDomainRegistration(
 		return r.updateStatusError(ctx, domain, err)
 	}
 
+	// Settings cannot change while a transfer is in progress
+	if r.determineState(domainInfo) == networkingv1alpha2.DomainRegistrationStateTransferPending {
+		logger.Info("Domain transfer in progress, deferring configuration",
+			"transferStatus", domainInfo.TransferInStatus)
+		return r.updateStatusReady(ctx, domain, apiResult.AccountID, domainInfo)
+	}
+
 	// Update configuration if specified
 	if domain.Spec.Configuration != nil {
-		config := cf.RegistrarDomainConfig{
-			AutoRenew:   domain.Spec.Configuration.AutoRenew,
-			Privacy:     domain.Spec.Configuration.Privacy,
-			Locked:      domain.Spec.Configuration.Locked,
-			NameServers: domain.Spec.Configuration.NameServers,
+		if err := validateNameServers(domain, domainInfo); err != nil {
+			return r.updateStatusInvalid(ctx, domain, err)
 		}
 
-		logger.V(1).Info("Updating domain configuration in Cloudflare",
-			"autoRenew", config.AutoRenew,
-			"privacy", config.Privacy,
-			"locked", config.Locked)
-
-		updatedInfo, err := apiResult.API.UpdateRegistrarDomain(ctx, domain.Spec.DomainName, config)
-		if err != nil {
-			logger.Error(err, "Failed to update registrar domain config")
-			// Continue with current info, don't fail the sync
-			r.Recorder.Event(domain, corev1.EventTypeWarning, "ConfigUpdateFailed",
-				fmt.Sprintf("Failed to update domain config: %s", cf.SanitizeErrorMessage(err)))
-		} else {
-			domainInfo = updatedInfo
+		config := desiredConfig(domain.Spec.Configuration, domainInfo)
+		if !config.IsEmpty() {
+			logger.V(1).Info("Updating domain configuration in Cloudflare",
+				"autoRenew", config.AutoRenew,
+				"privacy", config.Privacy,
+				"locked", config.Locked,
+				"nameServers", config.NameServers)
+
+			updatedInfo, err := apiResult.API.UpdateRegistrarDomain(ctx, domain.Spec.DomainName, config)
+			if err != nil {
+				logger.Error(err, "Failed to update registrar domain config")
+				// Continue with current info, don't fail the sync
+				r.Recorder.Event(domain, corev1.EventTypeWarning, "ConfigUpdateFailed",
+					fmt.Sprintf("Failed to update domain config: %s", cf.SanitizeErrorMessage(err)))
+			} else {
+				domainInfo = updatedInfo
+				r.Recorder.Event(domain, corev1.EventTypeNormal, "ConfigUpdated",
+					fmt.Sprintf("Domain configuration updated: %s", describeConfig(config)))
+			}
 		}
 	}
 
@@ -256,7 +267,9 @@ func (r *Reconciler) updateStatusReady(
 		domain.Status.RegistryStatuses = domainInfo.RegistryStatuses
 		domain.Status.Locked = domainInfo.Locked
 		domain.Status.TransferInStatus = domainInfo.TransferInStatus
-		domain.Status.AutoRenew = domainInfo.CanCancelTransfer // Use existing field for auto-renew state
+		domain.Status.AutoRenew = domainInfo.AutoRenew
+		domain.Status.Privacy = domainInfo.Privacy
+		domain.Status.NameServers = domainInfo.NameServers
 
 		if !domainInfo.ExpiresAt.IsZero() {
 			domain.Status.ExpiresAt = &metav1.Time{Time: domainInfo.ExpiresAt}
@@ -288,16 +301,54 @@ func (r *Reconciler) updateStatusReady(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
+	// Check transfers frequently so the configuration is applied once they complete
+	if state == networkingv1alpha2.DomainRegistrationStateTransferPending {
+		return common.RequeueLong(), nil
+	}
+
 	// Requeue periodically to monitor expiration
 	return ctrl.Result{RequeueAfter: 1 * time.Hour}, nil
 }
 
+// updateStatusInvalid reports a configuration that is not applied.
+// Nothing is changed in Cloudflare until the spec is fixed.
+func (r *Reconciler) updateStatusInvalid(
+	ctx context.Context,
+	domain *networkingv1alpha2.DomainRegistration,
+	err error,
+) (ctrl.Result, error) {
+	message := err.Error()
+	r.Recorder.Event(domain, corev1.EventTypeWarning, ReasonInvalidNameServers, message)
+
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, domain, func() {
+		domain.Status.State = networkingv1alpha2.DomainRegistrationStateError
+		domain.Status.Message = message
+		meta.SetStatusCondition(&domain.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: domain.Generation,
+			Reason:             ReasonInvalidNameServers,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		domain.Status.ObservedGeneration = domain.Generation
+	})
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueLong(), nil
+}
+
 // determineState determines the domain state based on domain info.
 func (*Reconciler) determineState(info *cf.RegistrarDomainInfo) networkingv1alpha2.DomainRegistrationState {
 	// Check for transfer in progress
 	if info.TransferInStatus != "" && info.TransferInStatus != "complete" {
 		return networkingv1alpha2.DomainRegistrationStateTransferPending
 	}
+	if strings.Contains(strings.ToLower(info.RegistryStatuses), "pendingtransfer") {
+		return networkingv1alpha2.DomainRegistrationStateTransferPending
+	}
 
 	// Check for expiration
 	if !info.ExpiresAt.IsZero() && info.ExpiresAt.Before(time.Now()) {
@@ -373,5 +424,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findDomainsForCredentials)).
 		Named("domainregistration").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.DomainRegistration{}, r))
 }
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package domainregistration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+var cloudflareNameServers = []string{"ana.ns.cloudflare.com", "bob.ns.cloudflare.com"}
+
+// registrarStub serves a single registrar domain and records the updates.
+type registrarStub struct {
+	mu      sync.Mutex
+	domain  map[string]any
+	updates []map[string]any
+}
+
+func newRegistrarStub(t *testing.T, domain map[string]any) *registrarStub {
+	t.Helper()
+	stub := &registrarStub{domain: map[string]any{
+		"id":                "domain-1",
+		"current_registrar": "Cloudflare",
+		"expires_at":        time.Now().AddDate(1, 0, 0).UTC().Format(time.RFC3339),
+		"created_at":        time.Now().AddDate(-1, 0, 0).UTC().Format(time.RFC3339),
+		"auto_renew":        true,
+		"privacy":           true,
+		"locked":            true,
+		"name_servers":      cloudflareNameServers,
+	}}
+	for k, v := range domain {
+		stub.domain[k] = v
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/test-account-id", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"test-account-id","name":"test"}}`))
+	})
+	mux.HandleFunc("/accounts/test-account-id/registrar/domains/example.com", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		if req.Method == http.MethodPut {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			stub.updates = append(stub.updates, body)
+			for k, v := range body {
+				stub.domain[k] = v
+			}
+		}
+		result, _ := json.Marshal(stub.domain)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":` + string(result) + `}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL)
+	return stub
+}
+
+func newTestReconciler(t *testing.T, domain *networkingv1alpha2.DomainRegistration) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "test-account-id",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			domain,
+		).
+		WithStatusSubresource(domain).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestDomain(config *networkingv1alpha2.DomainConfiguration) *networkingv1alpha2.DomainRegistration {
+	return &networkingv1alpha2.DomainRegistration{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Generation: 1, Finalizers: []string{finalizerName}},
+		Spec: networkingv1alpha2.DomainRegistrationSpec{
+			DomainName:    "example.com",
+			Configuration: config,
+		},
+	}
+}
+
+func reconcileDomain(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.DomainRegistration) {
+	t.Helper()
+	key := types.NamespacedName{Name: "example"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.DomainRegistration{}
+	require.NoError(t, c.Get(context.Background(), key, updated))
+	return result, updated
+}
+
+func TestReconcile_ToggleAutoRenew(t *testing.T) {
+	stub := newRegistrarStub(t, nil)
+	r, c := newTestReconciler(t, newTestDomain(&networkingv1alpha2.DomainConfiguration{
+		AutoRenew: ptr.To(false), Privacy: true, Locked: true,
+	}))
+
+	_, updated := reconcileDomain(t, r, c)
+
+	require.Len(t, stub.updates, 1)
+	// Only the changed setting is sent
+	assert.Equal(t, map[string]any{"auto_renew": false}, stub.updates[0])
+	assert.Equal(t, networkingv1alpha2.DomainRegistrationStateActive, updated.Status.State)
+	assert.False(t, updated.Status.AutoRenew)
+	require.NotNil(t, updated.Status.ExpiresAt)
+	assert.Equal(t, cloudflareNameServers, updated.Status.NameServers)
+
+	// Once in sync, no update is sent
+	_, updated = reconcileDomain(t, r, c)
+	assert.Len(t, stub.updates, 1)
+
+	// Turning auto-renew back on
+	updated.Spec.Configuration.AutoRenew = ptr.To(true)
+	require.NoError(t, c.Update(context.Background(), updated))
+	_, updated = reconcileDomain(t, r, c)
+
+	require.Len(t, stub.updates, 2)
+	assert.Equal(t, map[string]any{"auto_renew": true}, stub.updates[1])
+	assert.True(t, updated.Status.AutoRenew)
+}
+
+func TestReconcile_UpdateNameServers(t *testing.T) {
+	stub := newRegistrarStub(t, map[string]any{"name_servers": []string{"ns1.example.net", "ns2.example.net"}})
+	r, c := newTestReconciler(t, newTestDomain(&networkingv1alpha2.DomainConfiguration{
+		Privacy: true, Locked: true,
+		NameServers: []string{"NS3.example.net.", "ns4.example.net"},
+	}))
+
+	_, updated := reconcileDomain(t, r, c)
+
+	require.Len(t, stub.updates, 1)
+	assert.Equal(t, []any{"ns3.example.net", "ns4.example.net"}, stub.updates[0]["name_servers"])
+	assert.Equal(t, []string{"ns3.example.net", "ns4.example.net"}, updated.Status.NameServers)
+	assert.Equal(t, networkingv1alpha2.DomainRegistrationStateActive, updated.Status.State)
+}
+
+func TestReconcile_ReplacingCloudflareNameServersRequiresAnnotation(t *testing.T) {
+	stub := newRegistrarStub(t, nil)
+	domain := newTestDomain(&networkingv1alpha2.DomainConfiguration{
+		AutoRenew: ptr.To(false), Privacy: true, Locked: true,
+		NameServers: []string{"ns1.example.net", "ns2.example.net"},
+	})
+	r, c := newTestReconciler(t, domain)
+
+	result, updated := reconcileDomain(t, r, c)
+
+	assert.Equal(t, common.RequeueLong(), result)
+	assert.Empty(t, stub.updates, "nothing is changed while the nameservers are rejected")
+	assert.Equal(t, networkingv1alpha2.DomainRegistrationStateError, updated.Status.State)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonInvalidNameServers, cond.Reason)
+	assert.Contains(t, cond.Message, AnnotationAllowNameServerChange)
+
+	updated.Annotations = map[string]string{AnnotationAllowNameServerChange: "true"}
+	require.NoError(t, c.Update(context.Background(), updated))
+	_, updated = reconcileDomain(t, r, c)
+
+	require.Len(t, stub.updates, 1)
+	assert.Equal(t, []any{"ns1.example.net", "ns2.example.net"}, stub.updates[0]["name_servers"])
+	assert.Equal(t, networkingv1alpha2.DomainRegistrationStateActive, updated.Status.State)
+}
+
+func TestReconcile_TransferPendingDefersConfiguration(t *testing.T) {
+	stub := newRegistrarStub(t, map[string]any{
+		"transfer_in": map[string]any{"enter_auth_code": "needed", "can_cancel_transfer": true},
+	})
+	r, c := newTestReconciler(t, newTestDomain(&networkingv1alpha2.DomainConfiguration{
+		AutoRenew: ptr.To(false), Privacy: true, Locked: true,
+	}))
+
+	result, updated := reconcileDomain(t, r, c)
+
+	assert.Equal(t, common.RequeueLong(), result)
+	assert.Empty(t, stub.updates)
+	assert.Equal(t, networkingv1alpha2.DomainRegistrationStateTransferPending, updated.Status.State)
+	assert.Equal(t, "enter_auth_code:needed", updated.Status.TransferInStatus)
+}
+
+func TestValidateNameServers(t *testing.T) {
+	info := &cf.RegistrarDomainInfo{NameServers: []string{"ns1.example.net", "ns2.example.net"}}
+	tests := []struct {
+		name        string
+		nameServers []string
+		wantErr     string
+	}{
+		{name: "unchanged", nameServers: []string{"NS2.example.net.", "ns1.example.net"}},
+		{name: "valid change", nameServers: []string{"ns3.example.net", "ns4.example.net"}},
+		{name: "too few", nameServers: []string{"ns3.example.net"}, wantErr: "at least two"},
+		{name: "duplicate", nameServers: []string{"ns3.example.net", "NS3.example.net"}, wantErr: "more than once"},
+		{name: "invalid hostname", nameServers: []string{"ns3.example.net", "ns_4"}, wantErr: "not a valid hostname"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domain := newTestDomain(&networkingv1alpha2.DomainConfiguration{NameServers: tt.nameServers})
+			err := validateNameServers(domain, info)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
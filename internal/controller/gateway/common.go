@@ -2,7 +2,7 @@
 // Copyright 2025-2026 The Cloudflare Operator Authors
 
 // Package gateway implements Kubernetes Gateway API controllers for cloudflared tunnels.
-// This includes controllers for GatewayClass, Gateway, HTTPRoute, TCPRoute, and UDPRoute.
+// This includes controllers for GatewayClass, Gateway, HTTPRoute, TCPRoute, TLSRoute, and UDPRoute.
 package gateway
 
 import (
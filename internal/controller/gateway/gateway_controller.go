@@ -7,10 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,6 +20,7 @@ import (
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -25,9 +28,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/clients/k8s"
 	"github.com/StringKe/cloudflare-operator/internal/controller"
 	"github.com/StringKe/cloudflare-operator/internal/controller/route"
 	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
@@ -40,6 +45,12 @@ type GatewayReconciler struct {
 	Scheme            *runtime.Scheme
 	Recorder          record.EventRecorder
 	OperatorNamespace string
+	// TLSRouteEnabled enables counting TLSRoutes attached to listeners.
+	// Set only when the TLSRoute CRD is installed.
+	TLSRouteEnabled bool
+	// ReferenceGrantEnabled re-reconciles Gateways when ReferenceGrants change.
+	// Set only when the ReferenceGrant CRD is installed.
+	ReferenceGrantEnabled bool
 }
 
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;update;patch
@@ -47,7 +58,9 @@ type GatewayReconciler struct {
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/finalizers,verbs=update
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=udproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
 
 // Reconcile handles Gateway reconciliation
 // nolint:revive // Cognitive complexity for Gateway reconciliation
@@ -114,25 +127,38 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			"Tunnel not found: "+err.Error())
 	}
 
+	// Validate listener TLS configuration and hostname collisions
+	listeners := processListeners(gateway)
+	if err := r.resolveCertificateRefs(ctx, gateway, listeners); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Build ingress rules from all attached routes
-	rules, err := r.buildIngressRules(ctx, gateway, config)
+	rules, err := r.buildIngressRules(ctx, gateway, config, listeners)
 	if err != nil {
-		r.Recorder.Event(gateway, corev1.EventTypeWarning, "BuildRulesFailed", err.Error())
+		r.Recorder.Event(gateway, corev1.EventTypeWarning, "BuildRulesFailed", cf.SanitizeErrorMessage(err))
 		return r.setCondition(ctx, gateway, gatewayv1.GatewayConditionProgrammed, false, "BuildRulesFailed",
-			"Failed to build ingress rules: "+err.Error())
+			"Failed to build ingress rules: "+cf.SanitizeErrorMessage(err))
 	}
 
 	// Sync configuration to Cloudflare API
 	// In token mode, cloudflared pulls configuration from cloud automatically
 	if err := r.syncTunnelConfigToAPI(ctx, tunnel, config, rules); err != nil {
+		var ruleErr *tunnelconfig.RuleValidationError
+		if errors.As(err, &ruleErr) {
+			r.Recorder.Event(gateway, corev1.EventTypeWarning, tunnelconfig.ReasonInvalidIngressRules, ruleErr.Error())
+			return r.setCondition(ctx, gateway, gatewayv1.GatewayConditionProgrammed, false,
+				tunnelconfig.ReasonInvalidIngressRules, ruleErr.Error())
+		}
 		r.Recorder.Event(gateway, corev1.EventTypeWarning, "APISyncFailed", cf.SanitizeErrorMessage(err))
 		return r.setCondition(ctx, gateway, gatewayv1.GatewayConditionProgrammed, false, "APISyncFailed",
 			"Failed to sync configuration to Cloudflare API: "+cf.SanitizeErrorMessage(err))
 	}
 
-	// Handle DNS management if not Manual mode
-	if config.Spec.DNSManagement != networkingv1alpha2.DNSManagementManual {
+	// Handle DNS management if not Manual mode or enabled for this Gateway
+	if tunnelpkg.AutoDNSEnabled(gateway, config.Spec.DNSManagement) {
 		hostnames := r.extractHostnamesFromRules(rules)
+		hostnames = mergeHostnames(hostnames, tcpHostnames(listeners))
 		tunnelCNAME := fmt.Sprintf("%s.cfargotunnel.com", tunnel.GetStatus().TunnelId)
 		if err := r.reconcileDNS(ctx, gateway, tunnel, config, hostnames, tunnelCNAME); err != nil {
 			logger.Error(err, "Failed to reconcile DNS records", "hostnames", hostnames)
@@ -140,18 +166,35 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			r.Recorder.Event(gateway, corev1.EventTypeWarning, "DNSSyncWarning",
 				"DNS sync incomplete: "+cf.SanitizeErrorMessage(err))
 		}
+	} else if pruned, err := tunnelpkg.PruneDNSRecords(ctx, r.Client, gateway, dnsRecordLabels(gateway), nil); err != nil {
+		// DNS management was turned off: the records created before are removed
+		logger.Error(err, "Failed to delete DNSRecords")
+		r.Recorder.Event(gateway, corev1.EventTypeWarning, "DNSSyncWarning",
+			"DNS cleanup incomplete: "+cf.SanitizeErrorMessage(err))
+	} else if len(pruned) > 0 {
+		logger.Info("Deleted DNSRecords of the Gateway", "hostnames", pruned)
 	}
 
 	// Update status
+	accepted := metav1.Condition{
+		Type:               string(gatewayv1.GatewayConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             "Accepted",
+		Message:            "Gateway is accepted",
+		ObservedGeneration: gateway.Generation,
+	}
+	if msg := listenersNotValidMessage(listeners); msg != "" {
+		r.Recorder.Event(gateway, corev1.EventTypeWarning, string(gatewayv1.GatewayReasonListenersNotValid), msg)
+		accepted.Reason = string(gatewayv1.GatewayReasonListenersNotValid)
+		accepted.Message = "Some listeners are not valid: " + msg
+		if !hasAcceptedListener(listeners) {
+			accepted.Status = metav1.ConditionFalse
+		}
+	}
+
 	r.Recorder.Event(gateway, corev1.EventTypeNormal, "Reconciled", "Gateway configured successfully")
-	return r.setConditions(ctx, gateway,
-		metav1.Condition{
-			Type:               string(gatewayv1.GatewayConditionAccepted),
-			Status:             metav1.ConditionTrue,
-			Reason:             "Accepted",
-			Message:            "Gateway is accepted",
-			ObservedGeneration: gateway.Generation,
-		},
+	return r.setStatus(ctx, gateway, listenerStatuses(listeners, gateway.Status.Listeners, gateway.Generation),
+		accepted,
 		metav1.Condition{
 			Type:               string(gatewayv1.GatewayConditionProgrammed),
 			Status:             metav1.ConditionTrue,
@@ -177,6 +220,14 @@ func (r *GatewayReconciler) handleDeletion(
 
 	logger.Info("Handling Gateway deletion", "name", gateway.Name)
 
+	// Delete the Gateway's DNSRecords; their finalizer removes the records from Cloudflare
+	if pruned, err := tunnelpkg.PruneDNSRecords(ctx, r.Client, gateway, dnsRecordLabels(gateway), nil); err != nil {
+		logger.Error(err, "Failed to delete DNSRecords")
+		// Continue with finalizer removal - owner references still garbage collect them
+	} else if len(pruned) > 0 {
+		logger.Info("Deleted DNSRecords of the Gateway", "hostnames", pruned)
+	}
+
 	// Resolve tunnel to get tunnel ID for unregistering
 	resolver := tunnelpkg.NewResolver(r.Client, r.OperatorNamespace)
 	tunnel, err := resolver.Resolve(ctx, config.Spec.TunnelRef, config.Namespace)
@@ -215,6 +266,7 @@ func (r *GatewayReconciler) buildIngressRules(
 	ctx context.Context,
 	gateway *gatewayv1.Gateway,
 	config *networkingv1alpha2.TunnelGatewayClassConfig,
+	listeners []*processedListener,
 ) ([]cf.UnvalidatedIngressRule, error) {
 	var allRules []cf.UnvalidatedIngressRule
 	var errs []error
@@ -225,19 +277,31 @@ func (r *GatewayReconciler) buildIngressRules(
 		errs = append(errs, fmt.Errorf("list HTTPRoutes: %w", err))
 	} else {
 		for _, hr := range httpRoutes {
-			rules := r.convertHTTPRouteToRules(ctx, &hr, gateway, config)
+			rules := r.convertHTTPRouteToRules(ctx, &hr, gateway, config, listeners)
 			allRules = append(allRules, rules...)
 		}
 	}
 
-	// Get TCPRoutes
+	// Get TCPRoutes - their rules are written by the TCPRoute reconciler,
+	// the Gateway only tracks attachments for listener status and DNS
 	tcpRoutes, err := r.getTCPRoutesForGateway(ctx, gateway)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("list TCPRoutes: %w", err))
 	} else {
 		for _, tr := range tcpRoutes {
-			rules := r.convertTCPRouteToRules(ctx, &tr, gateway)
-			allRules = append(allRules, rules...)
+			r.attachL4Route(tr.Spec.ParentRefs, KindTCPRoute, nil, gateway, listeners)
+		}
+	}
+
+	// Get TLSRoutes - their rules are written by the TLSRoute reconciler
+	if r.TLSRouteEnabled {
+		tlsRoutes, err := r.getTLSRoutesForGateway(ctx, gateway)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("list TLSRoutes: %w", err))
+		} else {
+			for _, tr := range tlsRoutes {
+				r.attachL4Route(tr.Spec.ParentRefs, KindTLSRoute, tr.Spec.Hostnames, gateway, listeners)
+			}
 		}
 	}
 
@@ -247,7 +311,7 @@ func (r *GatewayReconciler) buildIngressRules(
 		errs = append(errs, fmt.Errorf("list UDPRoutes: %w", err))
 	} else {
 		for _, ur := range udpRoutes {
-			rules := r.convertUDPRouteToRules(ctx, &ur, gateway)
+			rules := r.convertUDPRouteToRules(ctx, &ur, gateway, listeners)
 			allRules = append(allRules, rules...)
 		}
 	}
@@ -261,9 +325,15 @@ func (r *GatewayReconciler) buildIngressRules(
 		if allRules[i].Hostname != allRules[j].Hostname {
 			return allRules[i].Hostname < allRules[j].Hostname
 		}
-		return allRules[i].Path < allRules[j].Path
+		if allRules[i].Path != allRules[j].Path {
+			return allRules[i].Path < allRules[j].Path
+		}
+		return allRules[i].Service < allRules[j].Service
 	})
 
+	// Routes attached to several listeners (e.g. HTTP and HTTPS) yield identical rules
+	allRules = dedupIngressRules(allRules)
+
 	// Add fallback rule
 	fallbackTarget := config.Spec.FallbackTarget
 	if fallbackTarget == "" {
@@ -276,6 +346,21 @@ func (r *GatewayReconciler) buildIngressRules(
 	return allRules, nil
 }
 
+// dedupIngressRules removes rules with the same hostname, path and service from a sorted rule list
+func dedupIngressRules(rules []cf.UnvalidatedIngressRule) []cf.UnvalidatedIngressRule {
+	result := make([]cf.UnvalidatedIngressRule, 0, len(rules))
+	for i, rule := range rules {
+		if i > 0 {
+			prev := result[len(result)-1]
+			if prev.Hostname == rule.Hostname && prev.Path == rule.Path && prev.Service == rule.Service {
+				continue
+			}
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
 // getHTTPRoutesForGateway returns HTTPRoutes attached to the gateway
 func (r *GatewayReconciler) getHTTPRoutesForGateway(ctx context.Context, gateway *gatewayv1.Gateway) ([]gatewayv1.HTTPRoute, error) {
 	httpRouteList := &gatewayv1.HTTPRouteList{}
@@ -308,6 +393,22 @@ func (r *GatewayReconciler) getTCPRoutesForGateway(ctx context.Context, gateway
 	return result, nil
 }
 
+// getTLSRoutesForGateway returns TLSRoutes attached to the gateway
+func (r *GatewayReconciler) getTLSRoutesForGateway(ctx context.Context, gateway *gatewayv1.Gateway) ([]gatewayv1alpha2.TLSRoute, error) {
+	tlsRouteList := &gatewayv1alpha2.TLSRouteList{}
+	if err := r.List(ctx, tlsRouteList); err != nil {
+		return nil, err
+	}
+
+	var result []gatewayv1alpha2.TLSRoute
+	for _, tr := range tlsRouteList.Items {
+		if r.routeReferencesGateway(tr.Spec.ParentRefs, gateway) {
+			result = append(result, tr)
+		}
+	}
+	return result, nil
+}
+
 // getUDPRoutesForGateway returns UDPRoutes attached to the gateway
 func (r *GatewayReconciler) getUDPRoutesForGateway(ctx context.Context, gateway *gatewayv1.Gateway) ([]gatewayv1alpha2.UDPRoute, error) {
 	udpRouteList := &gatewayv1alpha2.UDPRouteList{}
@@ -354,16 +455,29 @@ func (r *GatewayReconciler) routeReferencesGateway(parentRefs []gatewayv1.Parent
 func (r *GatewayReconciler) convertHTTPRouteToRules(
 	ctx context.Context,
 	httpRoute *gatewayv1.HTTPRoute,
-	_ *gatewayv1.Gateway,
+	gateway *gatewayv1.Gateway,
 	config *networkingv1alpha2.TunnelGatewayClassConfig,
+	listeners []*processedListener,
 ) []cf.UnvalidatedIngressRule {
 	var rules []cf.UnvalidatedIngressRule
 	logger := log.FromContext(ctx)
 
-	// Get hostnames - use route hostnames or empty for all
-	hostnames := httpRoute.Spec.Hostnames
-	if len(hostnames) == 0 {
-		hostnames = []gatewayv1.Hostname{""}
+	// Get hostnames from the HTTP/HTTPS listeners the route attaches to
+	attached := r.attachedListeners(httpRoute.Spec.ParentRefs, gateway, listeners, KindHTTPRoute)
+	if len(attached) == 0 {
+		logger.V(1).Info("HTTPRoute does not attach to any accepted listener", "route", httpRoute.Name)
+		return nil
+	}
+	var hostnames []string
+	seen := make(map[string]bool)
+	for _, listener := range attached {
+		listener.AttachedRoutes++
+		for _, hostname := range routeHostnames(httpRoute.Spec.Hostnames, listener) {
+			if !seen[hostname] {
+				seen[hostname] = true
+				hostnames = append(hostnames, hostname)
+			}
+		}
 	}
 
 	for _, rule := range httpRoute.Spec.Rules {
@@ -390,7 +504,7 @@ func (r *GatewayReconciler) convertHTTPRouteToRules(
 						Build()
 
 					rules = append(rules, cf.UnvalidatedIngressRule{
-						Hostname:      string(hostname),
+						Hostname:      hostname,
 						Path:          path,
 						Service:       target,
 						OriginRequest: originReq,
@@ -411,7 +525,7 @@ func (r *GatewayReconciler) convertHTTPRouteToRules(
 						Build()
 
 					rules = append(rules, cf.UnvalidatedIngressRule{
-						Hostname:      string(hostname),
+						Hostname:      hostname,
 						Service:       target,
 						OriginRequest: originReq,
 					})
@@ -440,6 +554,10 @@ func (r *GatewayReconciler) resolveHTTPBackendRef(
 		namespace = string(*backendRef.Namespace)
 	}
 
+	if !r.backendRefPermitted(ctx, KindHTTPRoute, routeNamespace, namespace, string(backendRef.Name)) {
+		return ""
+	}
+
 	port := "80"
 	if backendRef.Port != nil {
 		port = fmt.Sprintf("%d", *backendRef.Port)
@@ -464,61 +582,55 @@ func (r *GatewayReconciler) resolveHTTPBackendRef(
 	return route.BuildServiceURL(protocol, string(backendRef.Name), namespace, port)
 }
 
-// convertTCPRouteToRules converts a TCPRoute to cloudflared ingress rules
-func (r *GatewayReconciler) convertTCPRouteToRules(
+// backendRefPermitted reports whether a route may reference a Service in the backend
+// namespace. Cross-namespace references require a ReferenceGrant.
+func (r *GatewayReconciler) backendRefPermitted(
 	ctx context.Context,
-	tcpRoute *gatewayv1alpha2.TCPRoute,
-	_ *gatewayv1.Gateway,
-) []cf.UnvalidatedIngressRule {
-	var rules []cf.UnvalidatedIngressRule
-
-	for _, rule := range tcpRoute.Spec.Rules {
-		for _, backendRef := range rule.BackendRefs {
-			target := r.resolveTCPBackendRef(ctx, tcpRoute.Namespace, backendRef)
-			if target == "" {
-				continue
-			}
-
-			rules = append(rules, cf.UnvalidatedIngressRule{
-				Service: target,
-			})
-		}
+	routeKind, routeNamespace, backendNamespace, backendName string,
+) bool {
+	err := k8s.CheckReferenceGrant(ctx, r.Client,
+		k8s.ReferenceFrom{Group: gatewayv1.GroupName, Kind: routeKind, Namespace: routeNamespace},
+		k8s.ReferenceTo{Kind: KindService, Namespace: backendNamespace, Name: backendName})
+	if err != nil {
+		log.FromContext(ctx).Info("Skipping backendRef", "kind", routeKind, "namespace", routeNamespace,
+			"backend", backendNamespace+"/"+backendName, "reason", err.Error())
+		return false
 	}
-
-	return rules
+	return true
 }
 
-// resolveTCPBackendRef resolves a TCP backend reference to a service URL
-func (*GatewayReconciler) resolveTCPBackendRef(
-	_ context.Context,
-	routeNamespace string,
-	backendRef gatewayv1.BackendRef,
-) string {
-	if backendRef.Kind != nil && *backendRef.Kind != KindService {
-		return ""
-	}
-
-	namespace := routeNamespace
-	if backendRef.Namespace != nil {
-		namespace = string(*backendRef.Namespace)
-	}
-
-	port := "80"
-	if backendRef.Port != nil {
-		port = fmt.Sprintf("%d", *backendRef.Port)
+// attachL4Route records a TCPRoute or TLSRoute on the listeners it attaches to,
+// together with the hostnames it is reachable on.
+func (r *GatewayReconciler) attachL4Route(
+	parentRefs []gatewayv1.ParentReference,
+	kind string,
+	hostnames []gatewayv1.Hostname,
+	gateway *gatewayv1.Gateway,
+	listeners []*processedListener,
+) {
+	for _, listener := range r.attachedListeners(parentRefs, gateway, listeners, kind) {
+		listener.AttachedRoutes++
+		listener.TCPHostnames = append(listener.TCPHostnames, l4RouteHostnames(kind, hostnames, listener)...)
 	}
-
-	return route.BuildServiceURL(route.ProtocolTCP, string(backendRef.Name), namespace, port)
 }
 
 // convertUDPRouteToRules converts a UDPRoute to cloudflared ingress rules
 func (r *GatewayReconciler) convertUDPRouteToRules(
 	ctx context.Context,
 	udpRoute *gatewayv1alpha2.UDPRoute,
-	_ *gatewayv1.Gateway,
+	gateway *gatewayv1.Gateway,
+	listeners []*processedListener,
 ) []cf.UnvalidatedIngressRule {
 	var rules []cf.UnvalidatedIngressRule
 
+	attached := r.attachedListeners(udpRoute.Spec.ParentRefs, gateway, listeners, KindUDPRoute)
+	if len(attached) == 0 {
+		return nil
+	}
+	for _, listener := range attached {
+		listener.AttachedRoutes++
+	}
+
 	for _, rule := range udpRoute.Spec.Rules {
 		for _, backendRef := range rule.BackendRefs {
 			target := r.resolveUDPBackendRef(ctx, udpRoute.Namespace, backendRef)
@@ -536,8 +648,8 @@ func (r *GatewayReconciler) convertUDPRouteToRules(
 }
 
 // resolveUDPBackendRef resolves a UDP backend reference to a service URL
-func (*GatewayReconciler) resolveUDPBackendRef(
-	_ context.Context,
+func (r *GatewayReconciler) resolveUDPBackendRef(
+	ctx context.Context,
 	routeNamespace string,
 	backendRef gatewayv1.BackendRef,
 ) string {
@@ -549,6 +661,9 @@ func (*GatewayReconciler) resolveUDPBackendRef(
 	if backendRef.Namespace != nil {
 		namespace = string(*backendRef.Namespace)
 	}
+	if !r.backendRefPermitted(ctx, KindUDPRoute, routeNamespace, namespace, string(backendRef.Name)) {
+		return ""
+	}
 
 	port := "80"
 	if backendRef.Port != nil {
@@ -587,8 +702,22 @@ func (r *GatewayReconciler) setConditions(
 	ctx context.Context,
 	gateway *gatewayv1.Gateway,
 	conditions ...metav1.Condition,
+) (ctrl.Result, error) {
+	return r.setStatus(ctx, gateway, nil, conditions...)
+}
+
+// setStatus updates the listener statuses and conditions on the Gateway status.
+// Listener statuses are left unchanged when listeners is nil.
+func (r *GatewayReconciler) setStatus(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	listeners []gatewayv1.ListenerStatus,
+	conditions ...metav1.Condition,
 ) (ctrl.Result, error) {
 	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, gateway, func() {
+		if listeners != nil {
+			gateway.Status.Listeners = listeners
+		}
 		for _, cond := range conditions {
 			meta.SetStatusCondition(&gateway.Status.Conditions, cond)
 		}
@@ -600,8 +729,10 @@ func (r *GatewayReconciler) setConditions(
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&gatewayv1.Gateway{}).
+		// Write the managed TXT markers once the CNAME DNSRecords are synced
+		Owns(&networkingv1alpha2.DNSRecord{}, builder.WithPredicates(tunnelpkg.DNSRecordChangedPredicate())).
 		Watches(
 			&gatewayv1.HTTPRoute{},
 			handler.EnqueueRequestsFromMapFunc(r.findGatewaysForHTTPRoute),
@@ -613,8 +744,95 @@ func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(
 			&gatewayv1alpha2.UDPRoute{},
 			handler.EnqueueRequestsFromMapFunc(r.findGatewaysForUDPRoute),
-		).
-		Complete(r)
+		)
+	if r.TLSRouteEnabled {
+		b = b.Watches(
+			&gatewayv1alpha2.TLSRoute{},
+			handler.EnqueueRequestsFromMapFunc(r.findGatewaysForTLSRoute),
+		)
+	}
+	if r.ReferenceGrantEnabled {
+		b = b.Watches(
+			&gatewayv1beta1.ReferenceGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.findGatewaysForReferenceGrant),
+		)
+	}
+	return b.Complete(r)
+}
+
+// findGatewaysForReferenceGrant finds the Gateways affected by a ReferenceGrant: Gateways
+// in the namespaces it allows Gateway references from, and the Gateways that HTTPRoutes
+// and UDPRoutes in the namespaces it allows route references from are attached to.
+func (r *GatewayReconciler) findGatewaysForReferenceGrant(ctx context.Context, obj client.Object) []reconcile.Request {
+	grant, ok := obj.(*gatewayv1beta1.ReferenceGrant)
+	if !ok {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	seen := make(map[apitypes.NamespacedName]bool)
+	add := func(found ...reconcile.Request) {
+		for _, req := range found {
+			if !seen[req.NamespacedName] {
+				seen[req.NamespacedName] = true
+				requests = append(requests, req)
+			}
+		}
+	}
+
+	for _, namespace := range referenceGrantFromNamespaces(grant, KindGateway) {
+		gateways := &gatewayv1.GatewayList{}
+		if err := r.List(ctx, gateways, client.InNamespace(namespace)); err != nil {
+			continue
+		}
+		for _, gw := range gateways.Items {
+			add(reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: gw.Name, Namespace: gw.Namespace},
+			})
+		}
+	}
+	for _, namespace := range referenceGrantFromNamespaces(grant, KindHTTPRoute) {
+		routes := &gatewayv1.HTTPRouteList{}
+		if err := r.List(ctx, routes, client.InNamespace(namespace)); err != nil {
+			continue
+		}
+		for _, rt := range routes.Items {
+			add(r.findGatewaysFromParentRefs(ctx, rt.Spec.ParentRefs, rt.Namespace)...)
+		}
+	}
+	for _, namespace := range referenceGrantFromNamespaces(grant, KindUDPRoute) {
+		routes := &gatewayv1alpha2.UDPRouteList{}
+		if err := r.List(ctx, routes, client.InNamespace(namespace)); err != nil {
+			continue
+		}
+		for _, rt := range routes.Items {
+			add(r.findGatewaysFromParentRefs(ctx, rt.Spec.ParentRefs, rt.Namespace)...)
+		}
+	}
+	return requests
+}
+
+// referenceGrantFromNamespaces returns the namespaces a ReferenceGrant allows
+// references from for the given Gateway API kind.
+func referenceGrantFromNamespaces(grant *gatewayv1beta1.ReferenceGrant, kind string) []string {
+	var namespaces []string
+	for _, from := range grant.Spec.From {
+		if string(from.Group) != gatewayv1.GroupName || string(from.Kind) != kind ||
+			slices.Contains(namespaces, string(from.Namespace)) {
+			continue
+		}
+		namespaces = append(namespaces, string(from.Namespace))
+	}
+	return namespaces
+}
+
+// findGatewaysForTLSRoute finds Gateways that a TLSRoute is attached to
+func (r *GatewayReconciler) findGatewaysForTLSRoute(ctx context.Context, obj client.Object) []reconcile.Request {
+	tlsRoute, ok := obj.(*gatewayv1alpha2.TLSRoute)
+	if !ok {
+		return nil
+	}
+	return r.findGatewaysFromParentRefs(ctx, tlsRoute.Spec.ParentRefs, tlsRoute.Namespace)
 }
 
 // findGatewaysForHTTPRoute finds Gateways that an HTTPRoute is attached to
@@ -726,7 +944,7 @@ func (r *GatewayReconciler) syncTunnelConfigToAPI(
 		}
 
 		// Convert origin request config if present
-		cfgRule.OriginRequest = convertOriginRequest(&rule.OriginRequest)
+		cfgRule.OriginRequest = tunnelconfig.NewOriginRequestConfig(&rule.OriginRequest)
 
 		configRules = append(configRules, cfgRule)
 	}
@@ -773,63 +991,6 @@ func (*GatewayReconciler) getCredentialsReferenceFromTunnel(tunnel tunnelpkg.Int
 	return networkingv1alpha2.CredentialsReference{}
 }
 
-// convertOriginRequest converts cf.OriginRequestConfig to tunnelconfig.OriginRequestConfig
-func convertOriginRequest(req *cf.OriginRequestConfig) *tunnelconfig.OriginRequestConfig {
-	if req == nil {
-		return nil
-	}
-
-	result := &tunnelconfig.OriginRequestConfig{}
-
-	if req.ConnectTimeout != nil {
-		result.ConnectTimeout = req.ConnectTimeout.String()
-	}
-	if req.TLSTimeout != nil {
-		result.TLSTimeout = req.TLSTimeout.String()
-	}
-	if req.TCPKeepAlive != nil {
-		result.TCPKeepAlive = req.TCPKeepAlive.String()
-	}
-	if req.NoHappyEyeballs != nil {
-		result.NoHappyEyeballs = *req.NoHappyEyeballs
-	}
-	if req.KeepAliveConnections != nil {
-		result.KeepAliveConnections = *req.KeepAliveConnections
-	}
-	if req.KeepAliveTimeout != nil {
-		result.KeepAliveTimeout = req.KeepAliveTimeout.String()
-	}
-	if req.HTTPHostHeader != nil {
-		result.HTTPHostHeader = *req.HTTPHostHeader
-	}
-	if req.OriginServerName != nil {
-		result.OriginServerName = *req.OriginServerName
-	}
-	if req.NoTLSVerify != nil {
-		result.NoTLSVerify = *req.NoTLSVerify
-	}
-	if req.DisableChunkedEncoding != nil {
-		result.DisableChunkedEncoding = *req.DisableChunkedEncoding
-	}
-	if req.BastionMode != nil {
-		result.BastionMode = *req.BastionMode
-	}
-	if req.ProxyAddress != nil {
-		result.ProxyAddress = *req.ProxyAddress
-	}
-	if req.ProxyPort != nil {
-		result.ProxyPort = int(*req.ProxyPort)
-	}
-	if req.ProxyType != nil {
-		result.ProxyType = *req.ProxyType
-	}
-	if req.HTTP2Origin != nil {
-		result.HTTP2Origin = *req.HTTP2Origin
-	}
-
-	return result
-}
-
 // extractHostnamesFromRules extracts unique hostnames from ingress rules
 func (*GatewayReconciler) extractHostnamesFromRules(rules []cf.UnvalidatedIngressRule) []string {
 	hostnameSet := make(map[string]struct{})
@@ -856,11 +1017,29 @@ func (*GatewayReconciler) extractHostnamesFromRules(rules []cf.UnvalidatedIngres
 	return hostnames
 }
 
+// mergeHostnames returns the sorted union of two hostname lists
+func mergeHostnames(a, b []string) []string {
+	set := make(map[string]struct{}, len(a)+len(b))
+	for _, h := range a {
+		set[h] = struct{}{}
+	}
+	for _, h := range b {
+		set[h] = struct{}{}
+	}
+	result := make([]string, 0, len(set))
+	for h := range set {
+		result = append(result, h)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // reconcileDNS manages DNS records for Gateway hostnames.
 // Supports three modes:
-// - Automatic: Registers DNS records directly via DNS Service
-// - DNSRecord: Creates DNSRecord CRDs with OwnerReference
-// - Manual: Skips DNS management (handled externally)
+//   - Automatic: Registers DNS records directly via DNS Service
+//   - DNSRecord: Creates DNSRecord CRDs with OwnerReference
+//   - Manual: Skips DNS management (handled externally), unless the Gateway
+//     sets the auto-dns annotation
 //
 // nolint:revive // Cognitive complexity for DNS reconciliation
 func (r *GatewayReconciler) reconcileDNS(
@@ -871,13 +1050,6 @@ func (r *GatewayReconciler) reconcileDNS(
 	hostnames []string,
 	tunnelCNAME string,
 ) error {
-	logger := log.FromContext(ctx)
-
-	if len(hostnames) == 0 {
-		logger.V(1).Info("No hostnames to manage DNS for")
-		return nil
-	}
-
 	// Get zone info from tunnel status
 	zoneID := tunnel.GetStatus().ZoneId
 	accountID := tunnel.GetStatus().AccountId
@@ -888,19 +1060,15 @@ func (r *GatewayReconciler) reconcileDNS(
 	switch config.Spec.DNSManagement {
 	case networkingv1alpha2.DNSManagementAutomatic:
 		// Register DNS records directly via DNS Service
-		return r.reconcileDNSAutomatic(ctx, gateway, config, hostnames, tunnelCNAME, zoneID, accountID, credRef)
+		return r.reconcileDNSAutomatic(ctx, gateway, tunnel, config, hostnames, tunnelCNAME, zoneID, accountID, credRef)
 
 	case networkingv1alpha2.DNSManagementDNSRecord:
 		// Create DNSRecord CRDs with OwnerReference
-		return r.reconcileDNSRecordCRDs(ctx, gateway, config, hostnames, tunnelCNAME)
-
-	case networkingv1alpha2.DNSManagementManual:
-		logger.V(1).Info("DNS management is manual, skipping")
-		return nil
+		return r.reconcileDNSRecordCRDs(ctx, gateway, tunnel, config, hostnames, tunnelCNAME)
 
 	default:
-		// Default to Automatic if not specified
-		return r.reconcileDNSAutomatic(ctx, gateway, config, hostnames, tunnelCNAME, zoneID, accountID, credRef)
+		// Automatic if not specified, or Manual with the auto-dns annotation
+		return r.reconcileDNSAutomatic(ctx, gateway, tunnel, config, hostnames, tunnelCNAME, zoneID, accountID, credRef)
 	}
 }
 
@@ -909,6 +1077,7 @@ func (r *GatewayReconciler) reconcileDNS(
 func (r *GatewayReconciler) reconcileDNSAutomatic(
 	ctx context.Context,
 	gateway *gatewayv1.Gateway,
+	tunnel tunnelpkg.Interface,
 	config *networkingv1alpha2.TunnelGatewayClassConfig,
 	hostnames []string,
 	tunnelCNAME string,
@@ -918,20 +1087,23 @@ func (r *GatewayReconciler) reconcileDNSAutomatic(
 ) error {
 	// Automatic mode now creates DNSRecord CRDs just like DNSRecord mode
 	// The DNSRecord controller will handle the actual API calls
-	return r.reconcileDNSRecordCRDs(ctx, gateway, config, hostnames, tunnelCNAME)
+	return r.reconcileDNSRecordCRDs(ctx, gateway, tunnel, config, hostnames, tunnelCNAME)
 }
 
-// reconcileDNSRecordCRDs creates DNSRecord CRDs for each hostname
+// reconcileDNSRecordCRDs creates DNSRecord CRDs for each hostname, together with
+// the managed TXT marker, and removes the records of hostnames no longer in use
 // nolint:revive // Cognitive complexity for DNSRecord CRD creation
 func (r *GatewayReconciler) reconcileDNSRecordCRDs(
 	ctx context.Context,
 	gateway *gatewayv1.Gateway,
+	tunnel tunnelpkg.Interface,
 	config *networkingv1alpha2.TunnelGatewayClassConfig,
 	hostnames []string,
 	tunnelCNAME string,
 ) error {
 	logger := log.FromContext(ctx)
 
+	keep := make(map[string]bool)
 	var errs []error
 	for _, hostname := range hostnames {
 		// Sanitize hostname for K8s resource name
@@ -941,10 +1113,7 @@ func (r *GatewayReconciler) reconcileDNSRecordCRDs(
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      resourceName,
 				Namespace: gateway.Namespace,
-				Labels: map[string]string{
-					"cloudflare-operator.io/managed-by": "gateway-controller",
-					"cloudflare-operator.io/gateway":    gateway.Name,
-				},
+				Labels:    dnsRecordLabels(gateway),
 				OwnerReferences: []metav1.OwnerReference{
 					{
 						APIVersion:         gatewayv1.GroupVersion.String(),
@@ -968,36 +1137,36 @@ func (r *GatewayReconciler) reconcileDNSRecordCRDs(
 			},
 		}
 
-		// Create or Update
-		existing := &networkingv1alpha2.DNSRecord{}
-		err := r.Get(ctx, apitypes.NamespacedName{
-			Name:      resourceName,
-			Namespace: gateway.Namespace,
-		}, existing)
+		keep[dnsRecord.Name] = true
 
+		recordID, err := r.createOrUpdateDNSRecord(ctx, dnsRecord)
 		if err != nil {
-			if apierrors.IsNotFound(err) {
-				// Create new DNSRecord
-				if createErr := r.Create(ctx, dnsRecord); createErr != nil {
-					errs = append(errs, fmt.Errorf("create DNSRecord for %s: %w", hostname, createErr))
-					continue
-				}
-				logger.Info("Created DNSRecord CRD", "name", resourceName, "hostname", hostname)
-			} else {
-				errs = append(errs, fmt.Errorf("get DNSRecord %s: %w", resourceName, err))
+			errs = append(errs, fmt.Errorf("DNSRecord for %s: %w", hostname, err))
+			continue
+		}
+
+		// Mark the record as managed for the tunnel. The marker needs the CNAME's
+		// record ID; the owned DNSRecord requeues the Gateway once it is synced.
+		if txt := tunnelpkg.ManagedTXTRecord(dnsRecord, tunnel, recordID); txt != nil {
+			keep[txt.Name] = true
+			if recordID == "" {
 				continue
 			}
-		} else {
-			// Update existing DNSRecord
-			existing.Spec = dnsRecord.Spec
-			if updateErr := r.Update(ctx, existing); updateErr != nil {
-				errs = append(errs, fmt.Errorf("update DNSRecord for %s: %w", hostname, updateErr))
-				continue
+			if _, err := r.createOrUpdateDNSRecord(ctx, txt); err != nil {
+				errs = append(errs, fmt.Errorf("managed TXT DNSRecord for %s: %w", hostname, err))
 			}
-			logger.V(1).Info("Updated DNSRecord CRD", "name", resourceName, "hostname", hostname)
 		}
 	}
 
+	// Remove the records of hostnames the Gateway no longer serves
+	pruned, err := tunnelpkg.PruneDNSRecords(ctx, r.Client, gateway, dnsRecordLabels(gateway), keep)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(pruned) > 0 {
+		logger.Info("Removed DNSRecords of hostnames no longer served", "hostnames", pruned)
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -1009,14 +1178,50 @@ func (r *GatewayReconciler) reconcileDNSRecordCRDs(
 	return nil
 }
 
+// createOrUpdateDNSRecord creates or updates a DNSRecord CRD and returns the
+// Cloudflare record ID, once the DNSRecord controller has synced it
+func (r *GatewayReconciler) createOrUpdateDNSRecord(ctx context.Context, dnsRecord *networkingv1alpha2.DNSRecord) (string, error) {
+	logger := log.FromContext(ctx)
+
+	existing := &networkingv1alpha2.DNSRecord{}
+	err := r.Get(ctx, apitypes.NamespacedName{
+		Name:      dnsRecord.Name,
+		Namespace: dnsRecord.Namespace,
+	}, existing)
+	if apierrors.IsNotFound(err) {
+		if createErr := r.Create(ctx, dnsRecord); createErr != nil {
+			return "", fmt.Errorf("create: %w", createErr)
+		}
+		logger.Info("Created DNSRecord CRD", "name", dnsRecord.Name, "hostname", dnsRecord.Spec.Name)
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get: %w", err)
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, dnsRecord.Spec) {
+		return existing.Status.RecordID, nil
+	}
+	existing.Spec = dnsRecord.Spec
+	if updateErr := r.Update(ctx, existing); updateErr != nil {
+		return "", fmt.Errorf("update: %w", updateErr)
+	}
+	logger.V(1).Info("Updated DNSRecord CRD", "name", dnsRecord.Name, "hostname", dnsRecord.Spec.Name)
+	return existing.Status.RecordID, nil
+}
+
+// dnsRecordLabels returns the labels of the DNSRecords created for a Gateway
+func dnsRecordLabels(gateway *gatewayv1.Gateway) client.MatchingLabels {
+	return client.MatchingLabels{
+		"cloudflare-operator.io/managed-by": "gateway-controller",
+		"cloudflare-operator.io/gateway":    gateway.Name,
+	}
+}
+
 // sanitizeHostnameForK8s converts a hostname to a valid K8s resource name suffix
 func sanitizeHostnameForK8s(hostname string) string {
-	// Replace dots with dashes, remove wildcards
-	result := strings.ReplaceAll(hostname, ".", "-")
-	result = strings.TrimPrefix(result, "*-")
-	result = strings.TrimPrefix(result, "-")
+	result := tunnelpkg.SanitizeHostname(hostname)
 
-	// Ensure it's a valid K8s name (lowercase, alphanumeric, dash)
 	// Max length consideration
 	if len(result) > 50 {
 		result = result[:50]
@@ -112,7 +112,7 @@ func TestSanitizeHostnameForK8s(t *testing.T) {
 		{
 			name:     "wildcard hostname",
 			hostname: "*.example.com",
-			want:     "example-com",
+			want:     "wildcard-example-com",
 		},
 		{
 			name:     "single label",
@@ -137,7 +137,7 @@ func TestSanitizeHostnameForK8s(t *testing.T) {
 		{
 			name:     "wildcard with subdomain",
 			hostname: "*.api.example.com",
-			want:     "api-example-com",
+			want:     "wildcard-api-example-com",
 		},
 	}
 
@@ -0,0 +1,460 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/k8s"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/route"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
+)
+
+// l4Route is the common view of a TCPRoute or TLSRoute.
+type l4Route struct {
+	// Kind is KindTCPRoute or KindTLSRoute.
+	Kind   string
+	Object client.Object
+
+	ParentRefs []gatewayv1.ParentReference
+	// Hostnames are the SNI hostnames of a TLSRoute. Always empty for TCPRoutes.
+	Hostnames   []gatewayv1.Hostname
+	BackendRefs []gatewayv1.BackendRef
+
+	// Status points into the route object so status updates survive conflict retries.
+	Status *gatewayv1.RouteStatus
+}
+
+// l4RouteParent is the resolution of one parentRef of an L4 route.
+type l4RouteParent struct {
+	Ref      gatewayv1.ParentReference
+	Accepted bool
+	Reason   gatewayv1.RouteConditionReason
+	Message  string
+
+	Tunnel    tunnelpkg.Interface
+	Hostnames []string
+}
+
+// l4RouteReconciler holds the logic shared by the TCPRoute and TLSRoute reconcilers.
+// Each route is written to the tunnel ConfigMap as its own source, with one tcp://
+// ingress rule per hostname.
+type l4RouteReconciler struct {
+	client.Client
+	Recorder          record.EventRecorder
+	OperatorNamespace string
+}
+
+// reconcile programs the tunnel configuration for an L4 route and updates its status.
+//
+//nolint:revive // cognitive complexity acceptable for route reconciliation
+func (r *l4RouteReconciler) reconcile(ctx context.Context, rt *l4Route) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	obj := rt.Object
+	sourceKey := tunnelconfig.SourceKey(rt.Kind, obj.GetNamespace(), obj.GetName())
+
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return r.handleDeletion(ctx, rt, sourceKey)
+	}
+
+	parents, err := r.resolveParents(ctx, rt)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Not attached to any of our Gateways: clean up anything written earlier
+	if len(parents) == 0 {
+		if controllerutil.ContainsFinalizer(obj, FinalizerName) {
+			return r.handleDeletion(ctx, rt, sourceKey)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, FinalizerName) {
+		if err := controller.UpdateWithConflictRetry(ctx, r.Client, obj, func() {
+			controllerutil.AddFinalizer(obj, FinalizerName)
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	backendReason, backendMessage := validateL4BackendRefs(rt.BackendRefs)
+	if backendReason == "" {
+		backendReason, backendMessage, err = r.checkBackendRefGrant(ctx, rt)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Group rules by tunnel; several parents may share a tunnel
+	type tunnelRules struct {
+		tunnel    tunnelpkg.Interface
+		hostnames []string
+	}
+	byTunnel := make(map[string]*tunnelRules)
+	if backendReason == "" {
+		for _, parent := range parents {
+			if !parent.Accepted {
+				continue
+			}
+			tunnelID := parent.Tunnel.GetStatus().TunnelId
+			entry, ok := byTunnel[tunnelID]
+			if !ok {
+				entry = &tunnelRules{tunnel: parent.Tunnel}
+				byTunnel[tunnelID] = entry
+			}
+			entry.hostnames = append(entry.hostnames, parent.Hostnames...)
+		}
+	}
+
+	var errs []error
+	if backendReason == "" {
+		service := l4ServiceURL(obj.GetNamespace(), rt.BackendRefs[0])
+		for tunnelID, entry := range byTunnel {
+			if err := r.writeRules(ctx, rt, entry.tunnel, entry.hostnames, service); err != nil {
+				var ruleErr *tunnelconfig.RuleValidationError
+				if errors.As(err, &ruleErr) {
+					r.Recorder.Event(obj, corev1.EventTypeWarning, tunnelconfig.ReasonInvalidIngressRules, ruleErr.Error())
+				}
+				errs = append(errs, fmt.Errorf("tunnel %s: %w", tunnelID, err))
+			}
+		}
+	}
+
+	// Remove the route from tunnels it no longer targets
+	writer := tunnelconfig.NewWriter(r.Client, r.OperatorNamespace)
+	existing, err := writer.SourceTunnelIDs(ctx, sourceKey)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, tunnelID := range existing {
+		if _, ok := byTunnel[tunnelID]; ok {
+			continue
+		}
+		if err := writer.RemoveSourceConfig(ctx, tunnelID, sourceKey); err != nil {
+			errs = append(errs, fmt.Errorf("remove from tunnel %s: %w", tunnelID, err))
+		}
+	}
+
+	if backendReason != "" {
+		r.Recorder.Event(obj, corev1.EventTypeWarning, string(backendReason), backendMessage)
+	}
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, obj, func() {
+		setL4RouteParentStatuses(rt.Status, parents, backendReason, backendMessage, obj.GetGeneration())
+	}); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return ctrl.Result{}, errors.Join(errs...)
+	}
+
+	logger.V(1).Info("L4 route reconciled", "kind", rt.Kind, "tunnels", len(byTunnel))
+	return ctrl.Result{}, nil
+}
+
+// handleDeletion removes the route from every tunnel ConfigMap and drops the finalizer.
+func (r *l4RouteReconciler) handleDeletion(ctx context.Context, rt *l4Route, sourceKey string) (ctrl.Result, error) {
+	obj := rt.Object
+	if !controllerutil.ContainsFinalizer(obj, FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	writer := tunnelconfig.NewWriter(r.Client, r.OperatorNamespace)
+	tunnelIDs, err := writer.SourceTunnelIDs(ctx, sourceKey)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for _, tunnelID := range tunnelIDs {
+		if err := writer.RemoveSourceConfig(ctx, tunnelID, sourceKey); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to remove %s from tunnel %s: %w", sourceKey, tunnelID, err)
+		}
+	}
+
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, obj, func() {
+		controllerutil.RemoveFinalizer(obj, FinalizerName)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// writeRules writes one tcp:// ingress rule per hostname to the tunnel ConfigMap.
+func (r *l4RouteReconciler) writeRules(
+	ctx context.Context,
+	rt *l4Route,
+	tunnel tunnelpkg.Interface,
+	hostnames []string,
+	service string,
+) error {
+	hostnames = mergeHostnames(hostnames, nil)
+	rules := make([]tunnelconfig.IngressRule, 0, len(hostnames))
+	for _, hostname := range hostnames {
+		rules = append(rules, tunnelconfig.IngressRule{
+			Hostname: hostname,
+			Service:  service,
+			Priority: tunnelconfig.PriorityGateway,
+		})
+	}
+
+	source := &tunnelconfig.SourceConfig{
+		Kind:       rt.Kind,
+		Namespace:  rt.Object.GetNamespace(),
+		Name:       rt.Object.GetName(),
+		Generation: rt.Object.GetGeneration(),
+		Rules:      rules,
+	}
+	ownerGVK := metav1.GroupVersionKind{
+		Group:   "networking.cloudflare-operator.io",
+		Version: "v1alpha2",
+		Kind:    tunnel.GetKind(),
+	}
+
+	writer := tunnelconfig.NewWriter(r.Client, r.OperatorNamespace)
+	if err := writer.WriteSourceConfig(ctx, tunnel.GetStatus().TunnelId, tunnel.GetStatus().AccountId,
+		source, tunnel.GetObject(), ownerGVK); err != nil {
+		return fmt.Errorf("failed to write to ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// resolveParents resolves the parentRefs of the route that point to Gateways managed by us.
+// ParentRefs to other kinds, missing Gateways or Gateways of other controllers are skipped.
+//
+//nolint:revive // cognitive complexity acceptable for parent resolution
+func (r *l4RouteReconciler) resolveParents(ctx context.Context, rt *l4Route) ([]l4RouteParent, error) {
+	var parents []l4RouteParent
+	for _, ref := range rt.ParentRefs {
+		if ref.Group != nil && *ref.Group != gatewayv1.GroupName {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != KindGateway {
+			continue
+		}
+		namespace := rt.Object.GetNamespace()
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+
+		gateway := &gatewayv1.Gateway{}
+		if err := r.Get(ctx, apitypes.NamespacedName{Name: string(ref.Name), Namespace: namespace}, gateway); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		gatewayClass, err := GetGatewayClassForGateway(ctx, r.Client, gateway)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !IsOurGatewayClass(gatewayClass) {
+			continue
+		}
+
+		parents = append(parents, r.resolveParent(ctx, rt, ref, gateway, gatewayClass))
+	}
+	return parents, nil
+}
+
+// resolveParent matches the route against the Gateway listeners and resolves the tunnel.
+func (r *l4RouteReconciler) resolveParent(
+	ctx context.Context,
+	rt *l4Route,
+	ref gatewayv1.ParentReference,
+	gateway *gatewayv1.Gateway,
+	gatewayClass *gatewayv1.GatewayClass,
+) l4RouteParent {
+	parent := l4RouteParent{Ref: ref}
+
+	var hostnames []string
+	matched := false
+	for _, listener := range processListeners(gateway) {
+		if !listener.Accepted() || !listener.supportsKind(rt.Kind) {
+			continue
+		}
+		if ref.SectionName != nil && *ref.SectionName != listener.Name {
+			continue
+		}
+		matched = true
+		hostnames = append(hostnames, l4RouteHostnames(rt.Kind, rt.Hostnames, listener)...)
+	}
+	if !matched {
+		parent.Reason = gatewayv1.RouteReasonNotAllowedByListeners
+		parent.Message = fmt.Sprintf("Gateway %s/%s has no accepted listener for %s",
+			gateway.Namespace, gateway.Name, rt.Kind)
+		return parent
+	}
+	if len(hostnames) == 0 {
+		parent.Reason = gatewayv1.RouteReasonNoMatchingListenerHostname
+		parent.Message = "no hostname to route: cloudflared routes TCP traffic by hostname, " +
+			"set a listener hostname or route hostnames"
+		return parent
+	}
+
+	config, err := GetTunnelGatewayClassConfig(ctx, r.Client, gatewayClass)
+	if err != nil {
+		parent.Reason = gatewayv1.RouteReasonPending
+		parent.Message = err.Error()
+		return parent
+	}
+	tunnel, err := tunnelpkg.NewResolver(r.Client, r.OperatorNamespace).Resolve(ctx, config.Spec.TunnelRef, config.Namespace)
+	if err != nil {
+		parent.Reason = gatewayv1.RouteReasonPending
+		parent.Message = "Tunnel not found: " + err.Error()
+		return parent
+	}
+	if tunnel.GetStatus().TunnelId == "" {
+		parent.Reason = gatewayv1.RouteReasonPending
+		parent.Message = fmt.Sprintf("Tunnel %s is not ready", tunnel.GetName())
+		return parent
+	}
+
+	parent.Accepted = true
+	parent.Reason = gatewayv1.RouteReasonAccepted
+	parent.Message = "Route is accepted"
+	parent.Tunnel = tunnel
+	sort.Strings(hostnames)
+	parent.Hostnames = hostnames
+	return parent
+}
+
+// validateL4BackendRefs checks that the route has exactly one Service backendRef with a port.
+// cloudflared maps a hostname to a single tcp:// origin, so traffic splitting is not supported.
+func validateL4BackendRefs(refs []gatewayv1.BackendRef) (gatewayv1.RouteConditionReason, string) {
+	if len(refs) != 1 {
+		return gatewayv1.RouteReasonUnsupportedValue,
+			fmt.Sprintf("exactly one backendRef is required, got %d", len(refs))
+	}
+	ref := refs[0]
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != KindService) {
+		return gatewayv1.RouteReasonInvalidKind,
+			fmt.Sprintf("backendRef %s must reference a Service", ref.Name)
+	}
+	if ref.Port == nil {
+		return gatewayv1.RouteReasonUnsupportedValue,
+			fmt.Sprintf("backendRef %s requires a port", ref.Name)
+	}
+	return "", ""
+}
+
+// checkBackendRefGrant checks that a cross-namespace backendRef is allowed by a ReferenceGrant.
+// Must be called after validateL4BackendRefs succeeded.
+func (r *l4RouteReconciler) checkBackendRefGrant(
+	ctx context.Context,
+	rt *l4Route,
+) (gatewayv1.RouteConditionReason, string, error) {
+	ref := rt.BackendRefs[0]
+	if ref.Namespace == nil {
+		return "", "", nil
+	}
+	err := k8s.CheckReferenceGrant(ctx, r.Client,
+		k8s.ReferenceFrom{Group: gatewayv1.GroupName, Kind: rt.Kind, Namespace: rt.Object.GetNamespace()},
+		k8s.ReferenceTo{Kind: KindService, Namespace: string(*ref.Namespace), Name: string(ref.Name)})
+	if errors.Is(err, k8s.ErrRefNotPermitted) {
+		return gatewayv1.RouteReasonRefNotPermitted, err.Error(), nil
+	}
+	return "", "", err
+}
+
+// l4ServiceURL returns the tcp:// origin URL for a validated backendRef.
+func l4ServiceURL(routeNamespace string, ref gatewayv1.BackendRef) string {
+	namespace := routeNamespace
+	if ref.Namespace != nil {
+		namespace = string(*ref.Namespace)
+	}
+	return route.BuildServiceURL(route.ProtocolTCP, string(ref.Name), namespace, fmt.Sprintf("%d", *ref.Port))
+}
+
+// setL4RouteParentStatuses replaces the parent statuses owned by this controller.
+func setL4RouteParentStatuses(
+	status *gatewayv1.RouteStatus,
+	parents []l4RouteParent,
+	backendReason gatewayv1.RouteConditionReason,
+	backendMessage string,
+	generation int64,
+) {
+	kept := make([]gatewayv1.RouteParentStatus, 0, len(status.Parents))
+	for _, p := range status.Parents {
+		if p.ControllerName != ControllerName {
+			kept = append(kept, p)
+		}
+	}
+
+	for _, parent := range parents {
+		ps := gatewayv1.RouteParentStatus{
+			ParentRef:      parent.Ref,
+			ControllerName: ControllerName,
+		}
+
+		accepted := metav1.Condition{
+			Type:               string(gatewayv1.RouteConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(parent.Reason),
+			Message:            parent.Message,
+			ObservedGeneration: generation,
+		}
+		if !parent.Accepted {
+			accepted.Status = metav1.ConditionFalse
+		}
+		meta.SetStatusCondition(&ps.Conditions, accepted)
+
+		resolvedRefs := metav1.Condition{
+			Type:               string(gatewayv1.RouteConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1.RouteReasonResolvedRefs),
+			Message:            "All references resolved",
+			ObservedGeneration: generation,
+		}
+		if backendReason != "" {
+			resolvedRefs.Status = metav1.ConditionFalse
+			resolvedRefs.Reason = string(backendReason)
+			resolvedRefs.Message = backendMessage
+		}
+		meta.SetStatusCondition(&ps.Conditions, resolvedRefs)
+
+		kept = append(kept, ps)
+	}
+	status.Parents = kept
+}
+
+// parentRefsSelectGateway reports whether any parentRef points to the Gateway.
+// A parentRef without namespace refers to the route's namespace.
+func parentRefsSelectGateway(refs []gatewayv1.ParentReference, routeNamespace string, gateway *gatewayv1.Gateway) bool {
+	for _, ref := range refs {
+		if ref.Group != nil && *ref.Group != gatewayv1.GroupName {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != KindGateway {
+			continue
+		}
+		namespace := routeNamespace
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		if string(ref.Name) == gateway.Name && namespace == gateway.Namespace {
+			return true
+		}
+	}
+	return false
+}
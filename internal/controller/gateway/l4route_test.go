@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
+)
+
+const l4TestTunnelID = "tunnel-123"
+
+func newL4TestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, gatewayv1.Install(scheme))
+	require.NoError(t, gatewayv1alpha2.Install(scheme))
+	require.NoError(t, gatewayv1beta1.Install(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	namespace := gatewayv1.Namespace("default")
+	base := []client.Object{
+		&gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare"},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: ControllerName,
+				ParametersRef: &gatewayv1.ParametersReference{
+					Group:     ParametersGroup,
+					Kind:      ParametersKind,
+					Name:      "tunnel-config",
+					Namespace: &namespace,
+				},
+			},
+		},
+		&networkingv1alpha2.TunnelGatewayClassConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "tunnel-config", Namespace: "default"},
+			Spec: networkingv1alpha2.TunnelGatewayClassConfigSpec{
+				TunnelRef: networkingv1alpha2.TunnelReference{Kind: "Tunnel", Name: "my-tunnel"},
+			},
+		},
+		&networkingv1alpha2.Tunnel{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-tunnel", Namespace: "default"},
+			Status: networkingv1alpha2.TunnelStatus{
+				TunnelId:  l4TestTunnelID,
+				AccountId: "account-123",
+			},
+		},
+		newListenerTestGateway(
+			gatewayv1.Listener{Name: "postgres", Protocol: gatewayv1.TCPProtocolType, Port: 5432,
+				Hostname: hostnamePtr("db.example.com")},
+			gatewayv1.Listener{Name: "tls", Protocol: gatewayv1.TLSProtocolType, Port: 443,
+				Hostname: hostnamePtr("*.example.com"), TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+		),
+	}
+
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(base, objs...)...)
+	for _, obj := range objs {
+		builder = builder.WithStatusSubresource(obj)
+	}
+	return builder.Build()
+}
+
+func newTestTCPRoute(backendRefs ...gatewayv1.BackendRef) *gatewayv1alpha2.TCPRoute {
+	section := gatewayv1.SectionName("postgres")
+	return &gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "postgres", Namespace: "default", Generation: 1},
+		Spec: gatewayv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw", SectionName: &section}},
+			},
+			Rules: []gatewayv1alpha2.TCPRouteRule{{BackendRefs: backendRefs}},
+		},
+	}
+}
+
+func serviceBackendRef(name string, port *gatewayv1.PortNumber) gatewayv1.BackendRef {
+	return gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{
+			Name: gatewayv1.ObjectName(name),
+			Port: port,
+		},
+	}
+}
+
+func getL4TunnelSource(t *testing.T, c client.Client, sourceKey string) *tunnelconfig.SourceConfig {
+	t.Helper()
+	config, err := tunnelconfig.NewWriter(c, "cloudflare-operator-system").GetTunnelConfig(context.Background(), l4TestTunnelID)
+	require.NoError(t, err)
+	if config == nil {
+		return nil
+	}
+	return config.Sources[sourceKey]
+}
+
+func TestTCPRouteReconcile_WritesTunnelRule(t *testing.T) {
+	ctx := context.Background()
+	tcpRoute := newTestTCPRoute(serviceBackendRef("postgres", ptr.To(gatewayv1.PortNumber(5432))))
+	c := newL4TestClient(t, tcpRoute)
+	r := &TCPRouteReconciler{Client: c, Recorder: record.NewFakeRecorder(10), OperatorNamespace: "cloudflare-operator-system"}
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tcpRoute)})
+	require.NoError(t, err)
+
+	source := getL4TunnelSource(t, c, "TCPRoute/default/postgres")
+	require.NotNil(t, source)
+	assert.Equal(t, tunnelconfig.SourceKindTCPRoute, source.Kind)
+	require.Len(t, source.Rules, 1)
+	assert.Equal(t, "db.example.com", source.Rules[0].Hostname)
+	assert.Equal(t, "tcp://postgres.default.svc:5432", source.Rules[0].Service)
+
+	updated := &gatewayv1alpha2.TCPRoute{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(tcpRoute), updated))
+	assert.Contains(t, updated.Finalizers, FinalizerName)
+	require.Len(t, updated.Status.Parents, 1)
+	conds := updated.Status.Parents[0].Conditions
+	assert.True(t, meta.IsStatusConditionTrue(conds, string(gatewayv1.RouteConditionAccepted)))
+	assert.True(t, meta.IsStatusConditionTrue(conds, string(gatewayv1.RouteConditionResolvedRefs)))
+	assert.Equal(t, gatewayv1.GatewayController(ControllerName), updated.Status.Parents[0].ControllerName)
+}
+
+func TestTCPRouteReconcile_InvalidBackendRefs(t *testing.T) {
+	tests := []struct {
+		name        string
+		backendRefs []gatewayv1.BackendRef
+	}{
+		{
+			name: "multiple backendRefs",
+			backendRefs: []gatewayv1.BackendRef{
+				serviceBackendRef("a", ptr.To(gatewayv1.PortNumber(5432))),
+				serviceBackendRef("b", ptr.To(gatewayv1.PortNumber(5432))),
+			},
+		},
+		{
+			name:        "missing port",
+			backendRefs: []gatewayv1.BackendRef{serviceBackendRef("postgres", nil)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tcpRoute := newTestTCPRoute(tt.backendRefs...)
+			c := newL4TestClient(t, tcpRoute)
+			recorder := record.NewFakeRecorder(10)
+			r := &TCPRouteReconciler{Client: c, Recorder: recorder, OperatorNamespace: "cloudflare-operator-system"}
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tcpRoute)})
+			require.NoError(t, err)
+
+			assert.Nil(t, getL4TunnelSource(t, c, "TCPRoute/default/postgres"))
+
+			updated := &gatewayv1alpha2.TCPRoute{}
+			require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(tcpRoute), updated))
+			require.Len(t, updated.Status.Parents, 1)
+			resolved := meta.FindStatusCondition(updated.Status.Parents[0].Conditions,
+				string(gatewayv1.RouteConditionResolvedRefs))
+			require.NotNil(t, resolved)
+			assert.Equal(t, metav1.ConditionFalse, resolved.Status)
+			assert.Equal(t, string(gatewayv1.RouteReasonUnsupportedValue), resolved.Reason)
+			assert.Len(t, recorder.Events, 1)
+		})
+	}
+}
+
+func TestTCPRouteReconcile_CrossNamespaceBackendRef(t *testing.T) {
+	ctx := context.Background()
+	ref := serviceBackendRef("postgres", ptr.To(gatewayv1.PortNumber(5432)))
+	ref.Namespace = ptr.To(gatewayv1.Namespace("databases"))
+	tcpRoute := newTestTCPRoute(ref)
+	c := newL4TestClient(t, tcpRoute)
+	r := &TCPRouteReconciler{Client: c, Recorder: record.NewFakeRecorder(10), OperatorNamespace: "cloudflare-operator-system"}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tcpRoute)}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, getL4TunnelSource(t, c, "TCPRoute/default/postgres"))
+
+	updated := &gatewayv1alpha2.TCPRoute{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, updated))
+	resolved := meta.FindStatusCondition(updated.Status.Parents[0].Conditions,
+		string(gatewayv1.RouteConditionResolvedRefs))
+	require.NotNil(t, resolved)
+	assert.Equal(t, string(gatewayv1.RouteReasonRefNotPermitted), resolved.Reason)
+
+	require.NoError(t, c.Create(ctx, &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-tcproutes", Namespace: "databases"},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group: gatewayv1.GroupName, Kind: KindTCPRoute, Namespace: "default",
+			}},
+			To: []gatewayv1beta1.ReferenceGrantTo{{Group: "", Kind: KindService}},
+		},
+	}))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	source := getL4TunnelSource(t, c, "TCPRoute/default/postgres")
+	require.NotNil(t, source)
+	assert.Equal(t, "tcp://postgres.databases.svc:5432", source.Rules[0].Service)
+}
+
+func TestReferenceGrantMappers(t *testing.T) {
+	ctx := context.Background()
+	tcpRoute := newTestTCPRoute(serviceBackendRef("postgres", ptr.To(gatewayv1.PortNumber(5432))))
+	// An HTTPRoute in another namespace attached to the Gateway in default
+	httpRoute := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw", Namespace: ptr.To(gatewayv1.Namespace("default"))}},
+			},
+		},
+	}
+	c := newL4TestClient(t, tcpRoute, httpRoute)
+	grant := func(kind, namespace string) *gatewayv1beta1.ReferenceGrant {
+		return &gatewayv1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "allow", Namespace: "backends"},
+			Spec: gatewayv1beta1.ReferenceGrantSpec{
+				From: []gatewayv1beta1.ReferenceGrantFrom{{
+					Group: gatewayv1.GroupName, Kind: gatewayv1.Kind(kind), Namespace: gatewayv1.Namespace(namespace),
+				}},
+				To: []gatewayv1beta1.ReferenceGrantTo{{Group: "", Kind: KindService}},
+			},
+		}
+	}
+
+	tcp := &TCPRouteReconciler{Client: c}
+	requests := tcp.findTCPRoutesForReferenceGrant(ctx, grant(KindTCPRoute, "default"))
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(tcpRoute), requests[0].NamespacedName)
+	assert.Empty(t, tcp.findTCPRoutesForReferenceGrant(ctx, grant(KindHTTPRoute, "default")))
+
+	gw := &GatewayReconciler{Client: c}
+	requests = gw.findGatewaysForReferenceGrant(ctx, grant(KindHTTPRoute, "apps"))
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.ObjectKey{Namespace: "default", Name: "gw"}, requests[0].NamespacedName)
+	assert.Empty(t, gw.findGatewaysForReferenceGrant(ctx, grant(KindHTTPRoute, "default")))
+	requests = gw.findGatewaysForReferenceGrant(ctx, grant(KindGateway, "default"))
+	require.Len(t, requests, 1)
+}
+
+func TestTCPRouteReconcile_Deletion(t *testing.T) {
+	ctx := context.Background()
+	tcpRoute := newTestTCPRoute(serviceBackendRef("postgres", ptr.To(gatewayv1.PortNumber(5432))))
+	c := newL4TestClient(t, tcpRoute)
+	r := &TCPRouteReconciler{Client: c, Recorder: record.NewFakeRecorder(10), OperatorNamespace: "cloudflare-operator-system"}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tcpRoute)}
+
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, getL4TunnelSource(t, c, "TCPRoute/default/postgres"))
+
+	current := &gatewayv1alpha2.TCPRoute{}
+	require.NoError(t, c.Get(ctx, req.NamespacedName, current))
+	require.NoError(t, c.Delete(ctx, current))
+
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, getL4TunnelSource(t, c, "TCPRoute/default/postgres"))
+}
+
+func TestTLSRouteReconcile_UsesSNIHostnames(t *testing.T) {
+	ctx := context.Background()
+	tlsRoute := &gatewayv1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "secure", Namespace: "default", Generation: 1},
+		Spec: gatewayv1alpha2.TLSRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}},
+			},
+			Hostnames: []gatewayv1.Hostname{"secure.example.com", "other.test"},
+			Rules: []gatewayv1alpha2.TLSRouteRule{{
+				BackendRefs: []gatewayv1.BackendRef{serviceBackendRef("secure", ptr.To(gatewayv1.PortNumber(8443)))},
+			}},
+		},
+	}
+	c := newL4TestClient(t, tlsRoute)
+	r := &TLSRouteReconciler{Client: c, Recorder: record.NewFakeRecorder(10), OperatorNamespace: "cloudflare-operator-system"}
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tlsRoute)})
+	require.NoError(t, err)
+
+	source := getL4TunnelSource(t, c, "TLSRoute/default/secure")
+	require.NotNil(t, source)
+	require.Len(t, source.Rules, 1, "only hostnames matching the passthrough listener are routed")
+	assert.Equal(t, "secure.example.com", source.Rules[0].Hostname)
+	assert.Equal(t, "tcp://secure.default.svc:8443", source.Rules[0].Service)
+}
+
+func TestValidateL4BackendRefs(t *testing.T) {
+	port := ptr.To(gatewayv1.PortNumber(22))
+
+	reason, _ := validateL4BackendRefs([]gatewayv1.BackendRef{serviceBackendRef("ssh", port)})
+	assert.Empty(t, reason)
+
+	reason, _ = validateL4BackendRefs(nil)
+	assert.Equal(t, gatewayv1.RouteReasonUnsupportedValue, reason)
+
+	ref := serviceBackendRef("ssh", port)
+	ref.Kind = ptr.To(gatewayv1.Kind("ConfigMap"))
+	reason, _ = validateL4BackendRefs([]gatewayv1.BackendRef{ref})
+	assert.Equal(t, gatewayv1.RouteReasonInvalidKind, reason)
+
+	reason, msg := validateL4BackendRefs([]gatewayv1.BackendRef{serviceBackendRef("ssh", nil)})
+	assert.Equal(t, gatewayv1.RouteReasonUnsupportedValue, reason)
+	assert.Contains(t, msg, "requires a port")
+}
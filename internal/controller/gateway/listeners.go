@@ -0,0 +1,463 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/StringKe/cloudflare-operator/internal/clients/k8s"
+)
+
+// Route kinds that can attach to Gateway listeners
+const (
+	KindHTTPRoute = "HTTPRoute"
+	KindTCPRoute  = "TCPRoute"
+	KindTLSRoute  = "TLSRoute"
+	KindUDPRoute  = "UDPRoute"
+)
+
+// listenerMode describes how a listener is mapped to cloudflared ingress.
+type listenerMode string
+
+const (
+	// listenerModeHTTP covers HTTP listeners and HTTPS listeners in Terminate mode.
+	// TLS is terminated at the Cloudflare edge with the zone's edge certificate,
+	// and the listener produces hostname-based HTTP ingress rules.
+	listenerModeHTTP listenerMode = "HTTP"
+	// listenerModePassthrough covers TLS listeners in Passthrough mode.
+	// Route hostnames (SNI) are routed to a tcp:// origin without termination.
+	listenerModePassthrough listenerMode = "Passthrough"
+	// listenerModeTCP covers plain TCP listeners.
+	listenerModeTCP listenerMode = "TCP"
+	// listenerModeUDP covers plain UDP listeners.
+	listenerModeUDP listenerMode = "UDP"
+)
+
+// processedListener is the validated view of a Gateway listener.
+type processedListener struct {
+	Name     gatewayv1.SectionName
+	Hostname string
+	Port     gatewayv1.PortNumber
+	Mode     listenerMode
+
+	// Valid is false when the protocol or TLS configuration is unsupported.
+	Valid bool
+	// Conflicted is true when the listener hostname collides with an earlier listener.
+	Conflicted bool
+	// RefsResolved is false when a certificate reference is invalid.
+	RefsResolved bool
+
+	Reason  gatewayv1.ListenerConditionReason
+	Message string
+
+	AttachedRoutes int32
+	// TCPHostnames are the hostnames routed to tcp:// origins through this listener.
+	TCPHostnames []string
+}
+
+// Accepted reports whether the listener produces ingress rules.
+func (l *processedListener) Accepted() bool {
+	return l.Valid && !l.Conflicted && l.RefsResolved
+}
+
+// supportsKind reports whether a route kind can attach to the listener.
+func (l *processedListener) supportsKind(kind string) bool {
+	for _, k := range l.supportedKinds() {
+		if string(k.Kind) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// supportedKinds returns the route kinds the listener accepts.
+func (l *processedListener) supportedKinds() []gatewayv1.RouteGroupKind {
+	group := gatewayv1.Group(gatewayv1.GroupName)
+	switch l.Mode {
+	case listenerModeHTTP:
+		return []gatewayv1.RouteGroupKind{{Group: &group, Kind: KindHTTPRoute}}
+	case listenerModePassthrough:
+		return []gatewayv1.RouteGroupKind{{Group: &group, Kind: KindTCPRoute}, {Group: &group, Kind: KindTLSRoute}}
+	case listenerModeTCP:
+		return []gatewayv1.RouteGroupKind{{Group: &group, Kind: KindTCPRoute}}
+	case listenerModeUDP:
+		return []gatewayv1.RouteGroupKind{{Group: &group, Kind: KindUDPRoute}}
+	default:
+		return []gatewayv1.RouteGroupKind{}
+	}
+}
+
+// processListeners validates the TLS configuration of each listener and detects
+// hostname collisions. Listeners are processed in order, so when two listeners
+// collide the later one is marked as conflicted.
+func processListeners(gateway *gatewayv1.Gateway) []*processedListener {
+	listeners := make([]*processedListener, 0, len(gateway.Spec.Listeners))
+	for i := range gateway.Spec.Listeners {
+		listener := &gateway.Spec.Listeners[i]
+		processed := validateListener(listener)
+
+		if processed.Valid {
+			for _, prev := range listeners {
+				if prev.Valid && listenersCollide(prev, processed) {
+					processed.Conflicted = true
+					processed.Reason = gatewayv1.ListenerReasonHostnameConflict
+					processed.Message = fmt.Sprintf("hostname %q is already used by listener %q",
+						displayHostname(processed.Hostname), prev.Name)
+					break
+				}
+			}
+		}
+
+		listeners = append(listeners, processed)
+	}
+	return listeners
+}
+
+// validateListener maps a listener protocol and TLS mode to a listener mode.
+// Unsupported combinations are reported as invalid.
+//
+//nolint:revive // cyclomatic complexity acceptable for protocol/TLS matrix
+func validateListener(listener *gatewayv1.Listener) *processedListener {
+	processed := &processedListener{
+		Name:         listener.Name,
+		Port:         listener.Port,
+		Valid:        true,
+		RefsResolved: true,
+	}
+	if listener.Hostname != nil {
+		processed.Hostname = strings.ToLower(string(*listener.Hostname))
+	}
+
+	invalid := func(reason gatewayv1.ListenerConditionReason, format string, args ...any) *processedListener {
+		processed.Valid = false
+		processed.Reason = reason
+		processed.Message = fmt.Sprintf(format, args...)
+		return processed
+	}
+
+	mode := gatewayv1.TLSModeTerminate
+	if listener.TLS != nil && listener.TLS.Mode != nil {
+		mode = *listener.TLS.Mode
+	}
+
+	switch listener.Protocol {
+	case gatewayv1.HTTPProtocolType, gatewayv1.TCPProtocolType, gatewayv1.UDPProtocolType:
+		if listener.TLS != nil {
+			return invalid(gatewayv1.ListenerReasonUnsupportedProtocol,
+				"tls configuration is not supported for %s listeners", listener.Protocol)
+		}
+		switch listener.Protocol {
+		case gatewayv1.TCPProtocolType:
+			processed.Mode = listenerModeTCP
+		case gatewayv1.UDPProtocolType:
+			processed.Mode = listenerModeUDP
+		default:
+			processed.Mode = listenerModeHTTP
+		}
+
+	case gatewayv1.HTTPSProtocolType:
+		if mode != gatewayv1.TLSModeTerminate {
+			return invalid(gatewayv1.ListenerReasonUnsupportedProtocol,
+				"HTTPS listeners only support tls mode Terminate, got %s", mode)
+		}
+		processed.Mode = listenerModeHTTP
+		if listener.TLS != nil {
+			if reason, msg := validateCertificateRefs(listener.TLS.CertificateRefs); reason != "" {
+				processed.RefsResolved = false
+				processed.Reason = reason
+				processed.Message = msg
+			}
+		}
+
+	case gatewayv1.TLSProtocolType:
+		if mode != gatewayv1.TLSModePassthrough {
+			return invalid(gatewayv1.ListenerReasonUnsupportedProtocol,
+				"TLS listeners only support tls mode Passthrough, got %s: "+
+					"cloudflared cannot terminate TLS for non-HTTP traffic", mode)
+		}
+		if processed.Hostname == "" {
+			return invalid(gatewayv1.ListenerReasonUnsupportedProtocol,
+				"TLS Passthrough listeners require a hostname for SNI routing")
+		}
+		processed.Mode = listenerModePassthrough
+
+	default:
+		return invalid(gatewayv1.ListenerReasonUnsupportedProtocol,
+			"protocol %s is not supported", listener.Protocol)
+	}
+
+	return processed
+}
+
+// validateCertificateRefs checks that certificate references point to core Secrets.
+// Namespaces and existence are checked by resolveCertificateRefs.
+func validateCertificateRefs(refs []gatewayv1.SecretObjectReference) (gatewayv1.ListenerConditionReason, string) {
+	for _, ref := range refs {
+		if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Secret") {
+			return gatewayv1.ListenerReasonInvalidCertificateRef,
+				fmt.Sprintf("certificateRef %s must reference a core Secret", ref.Name)
+		}
+	}
+	return "", ""
+}
+
+// resolveCertificateRefs checks that the certificateRefs of accepted HTTPS listeners
+// point to existing Secrets. Secrets in another namespace must be allowed by a
+// ReferenceGrant. The certificates are not used, TLS is terminated at the Cloudflare
+// edge, but the references are resolved as the Gateway API requires.
+// listeners must be the result of processListeners for the same gateway.
+func (r *GatewayReconciler) resolveCertificateRefs(
+	ctx context.Context,
+	gateway *gatewayv1.Gateway,
+	listeners []*processedListener,
+) error {
+	from := k8s.ReferenceFrom{Group: gatewayv1.GroupName, Kind: KindGateway, Namespace: gateway.Namespace}
+	for i, processed := range listeners {
+		listener := &gateway.Spec.Listeners[i]
+		if !processed.Accepted() || listener.TLS == nil {
+			continue
+		}
+		for _, ref := range listener.TLS.CertificateRefs {
+			namespace := ""
+			if ref.Namespace != nil {
+				namespace = string(*ref.Namespace)
+			}
+			_, err := k8s.ResolveSecretRef(ctx, r.Client, from, namespace, string(ref.Name))
+			if err == nil {
+				continue
+			}
+			processed.RefsResolved = false
+			processed.Message = err.Error()
+			switch {
+			case errors.Is(err, k8s.ErrRefNotPermitted):
+				processed.Reason = gatewayv1.ListenerReasonRefNotPermitted
+			case apierrors.IsNotFound(err):
+				processed.Reason = gatewayv1.ListenerReasonInvalidCertificateRef
+			default:
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// listenersCollide reports whether two listeners would produce conflicting ingress
+// rules for the same hostname. Cloudflare routes by hostname only, so listener ports
+// do not separate traffic. HTTP and HTTPS listeners on distinct ports may share a
+// hostname because both map to the same HTTP ingress rules; any other pair of
+// listeners with the same hostname would produce duplicate ingress rules.
+func listenersCollide(a, b *processedListener) bool {
+	if a.Hostname != b.Hostname {
+		return false
+	}
+	return a.Mode != listenerModeHTTP || b.Mode != listenerModeHTTP || a.Port == b.Port
+}
+
+// displayHostname returns a readable hostname for messages.
+func displayHostname(hostname string) string {
+	if hostname == "" {
+		return "*"
+	}
+	return hostname
+}
+
+// attachedListeners returns the accepted listeners of the gateway that a route of the
+// given kind attaches to via its parent references.
+// A parentRef with a sectionName selects that listener only; otherwise all listeners
+// that support the route kind are selected.
+func (r *GatewayReconciler) attachedListeners(
+	parentRefs []gatewayv1.ParentReference,
+	gateway *gatewayv1.Gateway,
+	listeners []*processedListener,
+	kind string,
+) []*processedListener {
+	var result []*processedListener
+	seen := make(map[gatewayv1.SectionName]bool)
+	for _, ref := range parentRefs {
+		if !r.routeReferencesGateway([]gatewayv1.ParentReference{ref}, gateway) {
+			continue
+		}
+		for _, listener := range listeners {
+			if seen[listener.Name] || !listener.Accepted() || !listener.supportsKind(kind) {
+				continue
+			}
+			if ref.SectionName != nil && *ref.SectionName != listener.Name {
+				continue
+			}
+			seen[listener.Name] = true
+			result = append(result, listener)
+		}
+	}
+	return result
+}
+
+// routeHostnames returns the ingress hostnames for a route attached to a listener.
+// Route hostnames are filtered to those matching the listener hostname; a route
+// without hostnames inherits the listener hostname.
+func routeHostnames(routeHostnames []gatewayv1.Hostname, listener *processedListener) []string {
+	if len(routeHostnames) == 0 {
+		return []string{listener.Hostname}
+	}
+
+	result := make([]string, 0, len(routeHostnames))
+	for _, h := range routeHostnames {
+		hostname := strings.ToLower(string(h))
+		if hostnameMatches(listener.Hostname, hostname) {
+			result = append(result, hostname)
+		}
+	}
+	return result
+}
+
+// l4RouteHostnames returns the hostnames a TCPRoute or TLSRoute is reachable on through a listener.
+// cloudflared routes TCP traffic by hostname, so a TCPRoute uses the listener hostname and a
+// TLSRoute uses its own hostnames (SNI) matching the listener hostname.
+func l4RouteHostnames(kind string, hostnames []gatewayv1.Hostname, listener *processedListener) []string {
+	var candidates []string
+	if kind == KindTLSRoute {
+		candidates = routeHostnames(hostnames, listener)
+	} else {
+		candidates = []string{listener.Hostname}
+	}
+
+	result := make([]string, 0, len(candidates))
+	for _, hostname := range candidates {
+		if hostname != "" {
+			result = append(result, hostname)
+		}
+	}
+	return result
+}
+
+// hostnameMatches reports whether a route hostname is allowed by a listener hostname.
+// An empty listener hostname matches everything and a wildcard listener hostname
+// matches any subdomain.
+func hostnameMatches(listenerHostname, routeHostname string) bool {
+	if listenerHostname == "" || listenerHostname == routeHostname {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(listenerHostname, "*"); ok {
+		return strings.HasSuffix(routeHostname, suffix) && routeHostname != suffix[1:]
+	}
+	return false
+}
+
+// listenerStatuses builds the Gateway listener status entries. The conditions are set
+// on the listener's existing conditions, so their transition times are kept when
+// their status does not change.
+func listenerStatuses(
+	listeners []*processedListener,
+	existing []gatewayv1.ListenerStatus,
+	generation int64,
+) []gatewayv1.ListenerStatus {
+	previous := make(map[gatewayv1.SectionName][]metav1.Condition, len(existing))
+	for _, status := range existing {
+		previous[status.Name] = status.Conditions
+	}
+
+	statuses := make([]gatewayv1.ListenerStatus, 0, len(listeners))
+	for _, listener := range listeners {
+		status := gatewayv1.ListenerStatus{
+			Name:           listener.Name,
+			SupportedKinds: listener.supportedKinds(),
+			AttachedRoutes: listener.AttachedRoutes,
+		}
+		newCondition := func(condType gatewayv1.ListenerConditionType, ok bool,
+			reason gatewayv1.ListenerConditionReason, message string) metav1.Condition {
+			condStatus := metav1.ConditionTrue
+			if !ok {
+				condStatus = metav1.ConditionFalse
+			}
+			return metav1.Condition{
+				Type:               string(condType),
+				Status:             condStatus,
+				Reason:             string(reason),
+				Message:            message,
+				ObservedGeneration: generation,
+			}
+		}
+
+		accepted := newCondition(gatewayv1.ListenerConditionAccepted, true,
+			gatewayv1.ListenerReasonAccepted, "Listener is accepted")
+		if !listener.Valid || listener.Conflicted {
+			accepted = newCondition(gatewayv1.ListenerConditionAccepted, false, listener.Reason, listener.Message)
+		}
+
+		conflicted := newCondition(gatewayv1.ListenerConditionConflicted, false,
+			gatewayv1.ListenerReasonNoConflicts, "No conflicts")
+		if listener.Conflicted {
+			conflicted = newCondition(gatewayv1.ListenerConditionConflicted, true, listener.Reason, listener.Message)
+		}
+
+		resolvedRefs := newCondition(gatewayv1.ListenerConditionResolvedRefs, true,
+			gatewayv1.ListenerReasonResolvedRefs, "All references resolved")
+		if !listener.RefsResolved {
+			resolvedRefs = newCondition(gatewayv1.ListenerConditionResolvedRefs, false, listener.Reason, listener.Message)
+		}
+
+		programmed := newCondition(gatewayv1.ListenerConditionProgrammed, true,
+			gatewayv1.ListenerReasonProgrammed, fmt.Sprintf("Listener programmed in %s mode", listener.Mode))
+		if !listener.Accepted() {
+			programmed = newCondition(gatewayv1.ListenerConditionProgrammed, false,
+				gatewayv1.ListenerReasonInvalid, listener.Message)
+		}
+
+		conditions := slices.Clone(previous[listener.Name])
+		for _, cond := range []metav1.Condition{accepted, conflicted, resolvedRefs, programmed} {
+			meta.SetStatusCondition(&conditions, cond)
+		}
+		status.Conditions = conditions
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// listenersNotValidMessage returns a summary of rejected listeners, or an empty
+// string when all listeners are accepted.
+func listenersNotValidMessage(listeners []*processedListener) string {
+	var rejected []string
+	for _, listener := range listeners {
+		if !listener.Accepted() {
+			rejected = append(rejected, fmt.Sprintf("%s: %s", listener.Name, listener.Message))
+		}
+	}
+	return strings.Join(rejected, "; ")
+}
+
+// tcpHostnames returns the sorted, unique hostnames routed to tcp:// origins by all listeners.
+func tcpHostnames(listeners []*processedListener) []string {
+	seen := make(map[string]bool)
+	var result []string
+	for _, listener := range listeners {
+		for _, hostname := range listener.TCPHostnames {
+			if !seen[hostname] {
+				seen[hostname] = true
+				result = append(result, hostname)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// hasAcceptedListener reports whether at least one listener is accepted.
+func hasAcceptedListener(listeners []*processedListener) bool {
+	for _, listener := range listeners {
+		if listener.Accepted() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+func tlsConfig(mode gatewayv1.TLSModeType, refs ...gatewayv1.SecretObjectReference) *gatewayv1.ListenerTLSConfig {
+	return &gatewayv1.ListenerTLSConfig{Mode: &mode, CertificateRefs: refs}
+}
+
+func hostnamePtr(h string) *gatewayv1.Hostname {
+	hostname := gatewayv1.Hostname(h)
+	return &hostname
+}
+
+func newListenerTestGateway(listeners ...gatewayv1.Listener) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default", Generation: 2},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "cloudflare",
+			Listeners:        listeners,
+		},
+	}
+}
+
+func TestProcessListeners_TLSModes(t *testing.T) {
+	tests := []struct {
+		name         string
+		listener     gatewayv1.Listener
+		wantMode     listenerMode
+		wantAccepted bool
+		wantReason   gatewayv1.ListenerConditionReason
+	}{
+		{
+			name:         "HTTP without TLS",
+			listener:     gatewayv1.Listener{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80},
+			wantMode:     listenerModeHTTP,
+			wantAccepted: true,
+		},
+		{
+			name: "HTTP with TLS is unsupported",
+			listener: gatewayv1.Listener{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80,
+				TLS: tlsConfig(gatewayv1.TLSModeTerminate)},
+			wantReason: gatewayv1.ListenerReasonUnsupportedProtocol,
+		},
+		{
+			name: "HTTPS Terminate",
+			listener: gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443,
+				Hostname: hostnamePtr("app.example.com"),
+				TLS:      tlsConfig(gatewayv1.TLSModeTerminate, gatewayv1.SecretObjectReference{Name: "app-cert"})},
+			wantMode:     listenerModeHTTP,
+			wantAccepted: true,
+		},
+		{
+			name: "HTTPS defaults to Terminate",
+			listener: gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443,
+				TLS: &gatewayv1.ListenerTLSConfig{}},
+			wantMode:     listenerModeHTTP,
+			wantAccepted: true,
+		},
+		{
+			name: "HTTPS Passthrough is unsupported",
+			listener: gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443,
+				TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+			wantReason: gatewayv1.ListenerReasonUnsupportedProtocol,
+		},
+		{
+			name: "HTTPS Terminate with non-Secret certificate ref",
+			listener: gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443,
+				TLS: tlsConfig(gatewayv1.TLSModeTerminate, gatewayv1.SecretObjectReference{
+					Kind: ptr.To(gatewayv1.Kind("ConfigMap")), Name: "app-cert",
+				})},
+			wantMode:   listenerModeHTTP,
+			wantReason: gatewayv1.ListenerReasonInvalidCertificateRef,
+		},
+		{
+			name: "TLS Passthrough",
+			listener: gatewayv1.Listener{Name: "tls", Protocol: gatewayv1.TLSProtocolType, Port: 443,
+				Hostname: hostnamePtr("db.example.com"), TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+			wantMode:     listenerModePassthrough,
+			wantAccepted: true,
+		},
+		{
+			name: "TLS Passthrough without hostname",
+			listener: gatewayv1.Listener{Name: "tls", Protocol: gatewayv1.TLSProtocolType, Port: 443,
+				TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+			wantReason: gatewayv1.ListenerReasonUnsupportedProtocol,
+		},
+		{
+			name: "TLS Terminate is unsupported",
+			listener: gatewayv1.Listener{Name: "tls", Protocol: gatewayv1.TLSProtocolType, Port: 443,
+				Hostname: hostnamePtr("db.example.com"), TLS: tlsConfig(gatewayv1.TLSModeTerminate)},
+			wantReason: gatewayv1.ListenerReasonUnsupportedProtocol,
+		},
+		{
+			name:         "TCP",
+			listener:     gatewayv1.Listener{Name: "tcp", Protocol: gatewayv1.TCPProtocolType, Port: 5432},
+			wantMode:     listenerModeTCP,
+			wantAccepted: true,
+		},
+		{
+			name:       "unknown protocol",
+			listener:   gatewayv1.Listener{Name: "grpc", Protocol: "example.com/grpc", Port: 9000},
+			wantReason: gatewayv1.ListenerReasonUnsupportedProtocol,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listeners := processListeners(newListenerTestGateway(tt.listener))
+			require.Len(t, listeners, 1)
+			listener := listeners[0]
+
+			assert.Equal(t, tt.wantAccepted, listener.Accepted())
+			assert.Equal(t, tt.wantReason, listener.Reason)
+			if tt.wantMode != "" {
+				assert.Equal(t, tt.wantMode, listener.Mode)
+			}
+		})
+	}
+}
+
+func TestResolveCertificateRefs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, gatewayv1beta1.Install(scheme))
+
+	secret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "app-cert", Namespace: namespace}}
+	}
+	grant := &gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-gateways", Namespace: "granted"},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{{
+				Group: gatewayv1.GroupName, Kind: KindGateway, Namespace: "default",
+			}},
+			To: []gatewayv1beta1.ReferenceGrantTo{{Group: "", Kind: "Secret"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(secret("default"), secret("granted"), secret("certs"), grant).
+		Build()
+	r := &GatewayReconciler{Client: c}
+
+	tests := []struct {
+		name         string
+		namespace    string
+		certName     gatewayv1.ObjectName
+		wantResolved bool
+		wantReason   gatewayv1.ListenerConditionReason
+	}{
+		{name: "same namespace", certName: "app-cert", wantResolved: true},
+		{name: "cross-namespace allowed by ReferenceGrant", namespace: "granted", certName: "app-cert", wantResolved: true},
+		{name: "cross-namespace without ReferenceGrant", namespace: "certs", certName: "app-cert",
+			wantReason: gatewayv1.ListenerReasonRefNotPermitted},
+		{name: "missing Secret", certName: "missing", wantReason: gatewayv1.ListenerReasonInvalidCertificateRef},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := gatewayv1.SecretObjectReference{Name: tt.certName}
+			if tt.namespace != "" {
+				ref.Namespace = ptr.To(gatewayv1.Namespace(tt.namespace))
+			}
+			gateway := newListenerTestGateway(gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType,
+				Port: 443, TLS: tlsConfig(gatewayv1.TLSModeTerminate, ref)})
+			listeners := processListeners(gateway)
+
+			require.NoError(t, r.resolveCertificateRefs(context.Background(), gateway, listeners))
+			assert.Equal(t, tt.wantResolved, listeners[0].RefsResolved)
+			assert.Equal(t, tt.wantResolved, listeners[0].Accepted())
+			assert.Equal(t, tt.wantReason, listeners[0].Reason)
+		})
+	}
+}
+
+func TestProcessListeners_HostnameCollision(t *testing.T) {
+	gateway := newListenerTestGateway(
+		gatewayv1.Listener{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80,
+			Hostname: hostnamePtr("app.example.com")},
+		gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443,
+			Hostname: hostnamePtr("app.example.com"), TLS: tlsConfig(gatewayv1.TLSModeTerminate)},
+		gatewayv1.Listener{Name: "tls", Protocol: gatewayv1.TLSProtocolType, Port: 8443,
+			Hostname: hostnamePtr("app.example.com"), TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+		gatewayv1.Listener{Name: "db", Protocol: gatewayv1.TLSProtocolType, Port: 8443,
+			Hostname: hostnamePtr("db.example.com"), TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+	)
+
+	listeners := processListeners(gateway)
+	require.Len(t, listeners, 4)
+
+	// HTTP and HTTPS share the hostname without conflict
+	assert.True(t, listeners[0].Accepted())
+	assert.True(t, listeners[1].Accepted())
+
+	// Passthrough on a hostname already used by another listener conflicts
+	assert.True(t, listeners[2].Conflicted)
+	assert.False(t, listeners[2].Accepted())
+	assert.Equal(t, gatewayv1.ListenerReasonHostnameConflict, listeners[2].Reason)
+	assert.Contains(t, listeners[2].Message, `"http"`)
+
+	assert.True(t, listeners[3].Accepted())
+
+	statuses := listenerStatuses(listeners, nil, gateway.Generation)
+	require.Len(t, statuses, 4)
+	conflicted := meta.FindStatusCondition(statuses[2].Conditions, string(gatewayv1.ListenerConditionConflicted))
+	require.NotNil(t, conflicted)
+	assert.Equal(t, metav1.ConditionTrue, conflicted.Status)
+	accepted := meta.FindStatusCondition(statuses[2].Conditions, string(gatewayv1.ListenerConditionAccepted))
+	require.NotNil(t, accepted)
+	assert.Equal(t, metav1.ConditionFalse, accepted.Status)
+	assert.Equal(t, int64(2), accepted.ObservedGeneration)
+	assert.False(t, accepted.LastTransitionTime.IsZero(), "the API server requires a transition time")
+
+	// An unchanged condition keeps its transition time
+	transitioned := metav1.NewTime(accepted.LastTransitionTime.Add(-time.Hour))
+	statuses[2].Conditions[0].LastTransitionTime = transitioned
+	statuses = listenerStatuses(listeners, statuses, gateway.Generation)
+	accepted = meta.FindStatusCondition(statuses[2].Conditions, string(gatewayv1.ListenerConditionAccepted))
+	require.NotNil(t, accepted)
+	assert.Equal(t, transitioned, accepted.LastTransitionTime)
+
+	assert.Contains(t, listenersNotValidMessage(listeners), "tls: ")
+	assert.True(t, hasAcceptedListener(listeners))
+}
+
+func TestListenersCollide(t *testing.T) {
+	listener := func(mode listenerMode, port gatewayv1.PortNumber) *processedListener {
+		return &processedListener{Hostname: "app.example.com", Mode: mode, Port: port}
+	}
+
+	tests := []struct {
+		name string
+		a, b *processedListener
+		want bool
+	}{
+		{name: "HTTP and HTTPS on distinct ports", a: listener(listenerModeHTTP, 80), b: listener(listenerModeHTTP, 443)},
+		{name: "HTTP listeners on the same port", a: listener(listenerModeHTTP, 80), b: listener(listenerModeHTTP, 80), want: true},
+		{name: "two TCP listeners", a: listener(listenerModeTCP, 5432), b: listener(listenerModeTCP, 6432), want: true},
+		{name: "TCP and HTTP", a: listener(listenerModeTCP, 8080), b: listener(listenerModeHTTP, 80), want: true},
+		{name: "Passthrough and HTTP", a: listener(listenerModePassthrough, 443), b: listener(listenerModeHTTP, 80), want: true},
+		{
+			name: "different hostnames",
+			a:    listener(listenerModeTCP, 5432),
+			b:    &processedListener{Hostname: "db.example.com", Mode: listenerModeTCP, Port: 5432},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, listenersCollide(tt.a, tt.b))
+			assert.Equal(t, tt.want, listenersCollide(tt.b, tt.a))
+		})
+	}
+}
+
+func TestHostnameMatches(t *testing.T) {
+	assert.True(t, hostnameMatches("", "app.example.com"))
+	assert.True(t, hostnameMatches("app.example.com", "app.example.com"))
+	assert.True(t, hostnameMatches("*.example.com", "app.example.com"))
+	assert.False(t, hostnameMatches("*.example.com", "example.com"))
+	assert.False(t, hostnameMatches("app.example.com", "api.example.com"))
+}
+
+func TestBuildIngressRules_ListenerModes(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, gatewayv1.Install(scheme))
+	require.NoError(t, gatewayv1alpha2.Install(scheme))
+
+	gateway := newListenerTestGateway(
+		gatewayv1.Listener{Name: "http", Protocol: gatewayv1.HTTPProtocolType, Port: 80,
+			Hostname: hostnamePtr("app.example.com")},
+		gatewayv1.Listener{Name: "https", Protocol: gatewayv1.HTTPSProtocolType, Port: 443,
+			Hostname: hostnamePtr("app.example.com"), TLS: tlsConfig(gatewayv1.TLSModeTerminate)},
+		gatewayv1.Listener{Name: "db", Protocol: gatewayv1.TLSProtocolType, Port: 5432,
+			Hostname: hostnamePtr("db.example.com"), TLS: tlsConfig(gatewayv1.TLSModePassthrough)},
+		gatewayv1.Listener{Name: "bad", Protocol: gatewayv1.TLSProtocolType, Port: 6379,
+			Hostname: hostnamePtr("cache.example.com"), TLS: tlsConfig(gatewayv1.TLSModeTerminate)},
+	)
+
+	httpRoute := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}},
+			},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{
+					BackendObjectReference: gatewayv1.BackendObjectReference{Name: "web", Port: ptr.To(gatewayv1.PortNumber(80))},
+				}}},
+			}},
+		},
+	}
+	dbRoute := &gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec: gatewayv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw", SectionName: ptr.To(gatewayv1.SectionName("db"))}},
+			},
+			Rules: []gatewayv1alpha2.TCPRouteRule{{
+				BackendRefs: []gatewayv1.BackendRef{{
+					BackendObjectReference: gatewayv1.BackendObjectReference{Name: "postgres", Port: ptr.To(gatewayv1.PortNumber(5432))},
+				}},
+			}},
+		},
+	}
+	cacheRoute := &gatewayv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "default"},
+		Spec: gatewayv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw", SectionName: ptr.To(gatewayv1.SectionName("bad"))}},
+			},
+			Rules: []gatewayv1alpha2.TCPRouteRule{{
+				BackendRefs: []gatewayv1.BackendRef{{
+					BackendObjectReference: gatewayv1.BackendObjectReference{Name: "redis", Port: ptr.To(gatewayv1.PortNumber(6379))},
+				}},
+			}},
+		},
+	}
+
+	r := &GatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(httpRoute, dbRoute, cacheRoute).Build(),
+		Scheme: scheme,
+	}
+	config := &networkingv1alpha2.TunnelGatewayClassConfig{}
+	listeners := processListeners(gateway)
+
+	rules, err := r.buildIngressRules(ctx, gateway, config, listeners)
+	require.NoError(t, err)
+
+	// TCPRoute rules are written by the TCPRoute reconciler, the Gateway only tracks hostnames for DNS
+	require.Len(t, rules, 2)
+	assert.Equal(t, "app.example.com", rules[0].Hostname)
+	assert.Equal(t, "http://web.default.svc:80", rules[0].Service)
+	assert.Equal(t, cf.UnvalidatedIngressRule{Service: "http_status:404"}, rules[1])
+	assert.Equal(t, []string{"db.example.com"}, tcpHostnames(listeners))
+
+	// The HTTPRoute attaches to both HTTP and HTTPS listeners, the unsupported listener gets nothing
+	assert.Equal(t, int32(1), listeners[0].AttachedRoutes)
+	assert.Equal(t, int32(1), listeners[1].AttachedRoutes)
+	assert.Equal(t, int32(1), listeners[2].AttachedRoutes)
+	assert.Equal(t, int32(0), listeners[3].AttachedRoutes)
+}
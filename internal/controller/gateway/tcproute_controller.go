@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gateway
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// TCPRouteReconciler reconciles a TCPRoute object.
+// It programs a tcp:// ingress rule on the tunnel for each hostname the route is
+// reachable on, e.g. to expose databases or SSH through a Gateway.
+type TCPRouteReconciler struct {
+	client.Client
+	Scheme            *runtime.Scheme
+	Recorder          record.EventRecorder
+	OperatorNamespace string
+	// ReferenceGrantEnabled re-reconciles TCPRoutes when ReferenceGrants change.
+	// Set only when the ReferenceGrant CRD is installed.
+	ReferenceGrantEnabled bool
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes/finalizers,verbs=update
+
+// Reconcile handles TCPRoute reconciliation
+func (r *TCPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	tcpRoute := &gatewayv1alpha2.TCPRoute{}
+	if err := r.Get(ctx, req.NamespacedName, tcpRoute); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log.FromContext(ctx).V(1).Info("Reconciling TCPRoute", "name", tcpRoute.Name, "namespace", tcpRoute.Namespace)
+
+	var backendRefs []gatewayv1.BackendRef
+	for _, rule := range tcpRoute.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+
+	l4 := &l4RouteReconciler{Client: r.Client, Recorder: r.Recorder, OperatorNamespace: r.OperatorNamespace}
+	return l4.reconcile(ctx, &l4Route{
+		Kind:        KindTCPRoute,
+		Object:      tcpRoute,
+		ParentRefs:  tcpRoute.Spec.ParentRefs,
+		BackendRefs: backendRefs,
+		Status:      &tcpRoute.Status.RouteStatus,
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TCPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha2.TCPRoute{}).
+		Watches(
+			&gatewayv1.Gateway{},
+			handler.EnqueueRequestsFromMapFunc(r.findTCPRoutesForGateway),
+		)
+	if r.ReferenceGrantEnabled {
+		builder = builder.Watches(
+			&gatewayv1beta1.ReferenceGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.findTCPRoutesForReferenceGrant),
+		)
+	}
+	return builder.Complete(r)
+}
+
+// findTCPRoutesForReferenceGrant finds TCPRoutes in the namespaces a ReferenceGrant
+// allows TCPRoute references from.
+func (r *TCPRouteReconciler) findTCPRoutesForReferenceGrant(ctx context.Context, obj client.Object) []reconcile.Request {
+	grant, ok := obj.(*gatewayv1beta1.ReferenceGrant)
+	if !ok {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, namespace := range referenceGrantFromNamespaces(grant, KindTCPRoute) {
+		routes := &gatewayv1alpha2.TCPRouteList{}
+		if err := r.List(ctx, routes, client.InNamespace(namespace)); err != nil {
+			continue
+		}
+		for _, rt := range routes.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: rt.Name, Namespace: rt.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// findTCPRoutesForGateway finds TCPRoutes attached to a Gateway
+func (r *TCPRouteReconciler) findTCPRoutesForGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	gateway, ok := obj.(*gatewayv1.Gateway)
+	if !ok {
+		return nil
+	}
+
+	tcpRouteList := &gatewayv1alpha2.TCPRouteList{}
+	if err := r.List(ctx, tcpRouteList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, tr := range tcpRouteList.Items {
+		if parentRefsSelectGateway(tr.Spec.ParentRefs, tr.Namespace, gateway) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: tr.Name, Namespace: tr.Namespace},
+			})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gateway
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// TLSRouteReconciler reconciles a TLSRoute object.
+// TLSRoutes attach to TLS Passthrough listeners; each route hostname (SNI) is
+// programmed as a tcp:// ingress rule on the tunnel without terminating TLS.
+type TLSRouteReconciler struct {
+	client.Client
+	Scheme            *runtime.Scheme
+	Recorder          record.EventRecorder
+	OperatorNamespace string
+	// ReferenceGrantEnabled re-reconciles TLSRoutes when ReferenceGrants change.
+	// Set only when the ReferenceGrant CRD is installed.
+	ReferenceGrantEnabled bool
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes/finalizers,verbs=update
+
+// Reconcile handles TLSRoute reconciliation
+func (r *TLSRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	tlsRoute := &gatewayv1alpha2.TLSRoute{}
+	if err := r.Get(ctx, req.NamespacedName, tlsRoute); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log.FromContext(ctx).V(1).Info("Reconciling TLSRoute", "name", tlsRoute.Name, "namespace", tlsRoute.Namespace)
+
+	var backendRefs []gatewayv1.BackendRef
+	for _, rule := range tlsRoute.Spec.Rules {
+		backendRefs = append(backendRefs, rule.BackendRefs...)
+	}
+
+	l4 := &l4RouteReconciler{Client: r.Client, Recorder: r.Recorder, OperatorNamespace: r.OperatorNamespace}
+	return l4.reconcile(ctx, &l4Route{
+		Kind:        KindTLSRoute,
+		Object:      tlsRoute,
+		ParentRefs:  tlsRoute.Spec.ParentRefs,
+		Hostnames:   tlsRoute.Spec.Hostnames,
+		BackendRefs: backendRefs,
+		Status:      &tlsRoute.Status.RouteStatus,
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TLSRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha2.TLSRoute{}).
+		Watches(
+			&gatewayv1.Gateway{},
+			handler.EnqueueRequestsFromMapFunc(r.findTLSRoutesForGateway),
+		)
+	if r.ReferenceGrantEnabled {
+		builder = builder.Watches(
+			&gatewayv1beta1.ReferenceGrant{},
+			handler.EnqueueRequestsFromMapFunc(r.findTLSRoutesForReferenceGrant),
+		)
+	}
+	return builder.Complete(r)
+}
+
+// findTLSRoutesForReferenceGrant finds TLSRoutes in the namespaces a ReferenceGrant
+// allows TLSRoute references from.
+func (r *TLSRouteReconciler) findTLSRoutesForReferenceGrant(ctx context.Context, obj client.Object) []reconcile.Request {
+	grant, ok := obj.(*gatewayv1beta1.ReferenceGrant)
+	if !ok {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, namespace := range referenceGrantFromNamespaces(grant, KindTLSRoute) {
+		routes := &gatewayv1alpha2.TLSRouteList{}
+		if err := r.List(ctx, routes, client.InNamespace(namespace)); err != nil {
+			continue
+		}
+		for _, rt := range routes.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: rt.Name, Namespace: rt.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// findTLSRoutesForGateway finds TLSRoutes attached to a Gateway
+func (r *TLSRouteReconciler) findTLSRoutesForGateway(ctx context.Context, obj client.Object) []reconcile.Request {
+	gateway, ok := obj.(*gatewayv1.Gateway)
+	if !ok {
+		return nil
+	}
+
+	tlsRouteList := &gatewayv1alpha2.TLSRouteList{}
+	if err := r.List(ctx, tlsRouteList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, tr := range tlsRouteList.Items {
+		if parentRefsSelectGateway(tr.Spec.ParentRefs, tr.Namespace, gateway) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: apitypes.NamespacedName{Name: tr.Name, Namespace: tr.Namespace},
+			})
+		}
+	}
+	return requests
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,6 +33,9 @@ import (
 
 const (
 	finalizerName = "gatewaylist.networking.cloudflare-operator.io/finalizer"
+
+	// driftCheckInterval is how often the list items are compared with Cloudflare.
+	driftCheckInterval = 30 * time.Minute
 )
 
 // Reconciler reconciles a GatewayList object.
@@ -164,6 +168,17 @@ func (r *Reconciler) syncGatewayList(
 		return r.updateStatusError(ctx, list, err)
 	}
 
+	// Drop duplicates and items that are invalid for the list type,
+	// which Cloudflare would reject together with the whole request
+	items, rejected, duplicates := prepareItems(list.Spec.Type, items)
+	if duplicates > 0 {
+		logger.V(1).Info("Removed duplicate Gateway List items", "count", duplicates)
+	}
+	if len(rejected) > 0 {
+		r.Recorder.Event(list, corev1.EventTypeWarning, "ItemsRejected",
+			fmt.Sprintf("%d items are invalid for list type %s and were not synced", len(rejected), list.Spec.Type))
+	}
+
 	// Build params
 	params := cf.GatewayListParams{
 		Name:        listName,
@@ -189,16 +204,18 @@ func (r *Reconciler) syncGatewayList(
 				"listId", existing.ID,
 				"name", listName)
 
-			result, err := apiResult.API.UpdateGatewayList(ctx, existing.ID, params)
+			changed, err := r.updateGatewayList(ctx, apiResult.API, existing, params)
 			if err != nil {
 				logger.Error(err, "Failed to update Gateway List")
 				return r.updateStatusError(ctx, list, err)
 			}
 
-			r.Recorder.Event(list, corev1.EventTypeNormal, "Updated",
-				fmt.Sprintf("Gateway List '%s' updated in Cloudflare", listName))
+			if changed {
+				r.Recorder.Event(list, corev1.EventTypeNormal, "Updated",
+					fmt.Sprintf("Gateway List '%s' updated in Cloudflare", listName))
+			}
 
-			return r.updateStatusReady(ctx, list, apiResult.AccountID, result.ID, len(items))
+			return r.updateStatusReady(ctx, list, apiResult.AccountID, existing.ID, len(items), rejected)
 		}
 	}
 
@@ -216,8 +233,7 @@ func (r *Reconciler) syncGatewayList(
 			"name", listName)
 
 		// Update the existing list
-		result, err := apiResult.API.UpdateGatewayList(ctx, existingByName.ID, params)
-		if err != nil {
+		if _, err := r.updateGatewayList(ctx, apiResult.API, existingByName, params); err != nil {
 			logger.Error(err, "Failed to update existing Gateway List")
 			return r.updateStatusError(ctx, list, err)
 		}
@@ -225,7 +241,7 @@ func (r *Reconciler) syncGatewayList(
 		r.Recorder.Event(list, corev1.EventTypeNormal, "Adopted",
 			fmt.Sprintf("Adopted existing Gateway List '%s'", listName))
 
-		return r.updateStatusReady(ctx, list, apiResult.AccountID, result.ID, len(items))
+		return r.updateStatusReady(ctx, list, apiResult.AccountID, existingByName.ID, len(items), rejected)
 	}
 
 	// Create new list
@@ -241,7 +257,49 @@ func (r *Reconciler) syncGatewayList(
 	r.Recorder.Event(list, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("Gateway List '%s' created in Cloudflare", listName))
 
-	return r.updateStatusReady(ctx, list, apiResult.AccountID, result.ID, len(items))
+	return r.updateStatusReady(ctx, list, apiResult.AccountID, result.ID, len(items), rejected)
+}
+
+// updateGatewayList brings an existing list in line with params. The name and description
+// are only updated when they differ, and items are synced incrementally by appending and
+// removing the delta, so that large lists are never replaced or emptied mid-update.
+// Items added or removed outside of the operator are reverted the same way.
+// It reports whether anything was changed.
+func (r *Reconciler) updateGatewayList(
+	ctx context.Context,
+	api *cf.API,
+	existing *cf.GatewayListResult,
+	params cf.GatewayListParams,
+) (bool, error) {
+	logger := log.FromContext(ctx)
+	changed := false
+
+	if existing.Name != params.Name || existing.Description != params.Description {
+		if _, err := api.UpdateGatewayList(ctx, existing.ID, params); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	current, err := api.GetGatewayListItems(ctx, existing.ID)
+	if err != nil {
+		return false, err
+	}
+
+	toAppend, toRemove := diffItems(params.Type, params.Items, current)
+	if len(toAppend) == 0 && len(toRemove) == 0 {
+		return changed, nil
+	}
+
+	logger.Info("Syncing Gateway List items",
+		"listId", existing.ID,
+		"append", len(toAppend),
+		"remove", len(toRemove))
+
+	if _, err := api.PatchGatewayListItems(ctx, existing.ID, toAppend, toRemove); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // collectItems collects items from spec and ConfigMap.
@@ -334,18 +392,30 @@ func (r *Reconciler) updateStatusReady(
 	list *networkingv1alpha2.GatewayList,
 	accountID, listID string,
 	itemCount int,
+	rejected []networkingv1alpha2.GatewayListRejectedItem,
 ) (ctrl.Result, error) {
+	message := "Gateway List synced to Cloudflare"
+	if len(rejected) > 0 {
+		message = fmt.Sprintf("Gateway List synced to Cloudflare, %d invalid items rejected", len(rejected))
+	}
+	rejectedCount := len(rejected)
+	if len(rejected) > maxRejectedItemsInStatus {
+		rejected = rejected[:maxRejectedItemsInStatus]
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, list, func() {
 		list.Status.AccountID = accountID
 		list.Status.ListID = listID
 		list.Status.ItemCount = itemCount
+		list.Status.RejectedItemCount = rejectedCount
+		list.Status.RejectedItems = rejected
 		list.Status.State = "Ready"
 		meta.SetStatusCondition(&list.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: list.Generation,
 			Reason:             "Synced",
-			Message:            "Gateway List synced to Cloudflare",
+			Message:            message,
 			LastTransitionTime: metav1.Now(),
 		})
 		list.Status.ObservedGeneration = list.Generation
@@ -355,7 +425,8 @@ func (r *Reconciler) updateStatusReady(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
-	return common.NoRequeue(), nil
+	// Requeue to revert items changed outside of the operator
+	return common.RequeueResult(driftCheckInterval), nil
 }
 
 // gatewayListReferencesConfigMap checks if a GatewayList references the given ConfigMap.
@@ -415,5 +486,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findGatewayListsForConfigMap),
 		).
 		Named("gatewaylist").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.GatewayList{}, r))
 }
@@ -0,0 +1,353 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gatewaylist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+// listStub serves the Gateway List endpoints of account-123 for a single list,
+// recording the items sent with each create and patch request.
+type listStub struct {
+	mu      sync.Mutex
+	exists  bool
+	name    string
+	items   []string
+	created [][]string
+	patched []cloudflare.PatchTeamsListParams
+	updates int
+}
+
+func newListStub(t *testing.T) *listStub {
+	t.Helper()
+	stub := &listStub{name: "blocked"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/account-123", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"account-123","name":"test"}}`))
+	})
+	mux.HandleFunc("/accounts/account-123/gateway/lists", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		if req.Method == http.MethodGet {
+			lists := []cloudflare.TeamsList{}
+			if stub.exists {
+				lists = append(lists, stub.list())
+			}
+			writeResult(w, lists)
+			return
+		}
+		var body cloudflare.TeamsList
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		stub.exists = true
+		stub.name = body.Name
+		stub.items = itemValues(body.Items)
+		stub.created = append(stub.created, stub.items)
+		writeResult(w, stub.list())
+	})
+	mux.HandleFunc("/accounts/account-123/gateway/lists/list-123", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch req.Method {
+		case http.MethodPatch:
+			var body cloudflare.PatchTeamsListParams
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			stub.patched = append(stub.patched, body)
+			stub.items = append(slices.DeleteFunc(stub.items, func(v string) bool {
+				return slices.Contains(body.Remove, v)
+			}), itemValues(body.Append)...)
+		case http.MethodPut:
+			var body cloudflare.TeamsList
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			stub.name = body.Name
+			stub.updates++
+		}
+		writeResult(w, stub.list())
+	})
+	mux.HandleFunc("/accounts/account-123/gateway/lists/list-123/items", func(w http.ResponseWriter, _ *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		items := make([]cloudflare.TeamsListItem, 0, len(stub.items))
+		for _, v := range stub.items {
+			items = append(items, cloudflare.TeamsListItem{Value: v})
+		}
+		writeResult(w, items)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL)
+	return stub
+}
+
+func (s *listStub) list() cloudflare.TeamsList {
+	return cloudflare.TeamsList{ID: "list-123", Name: s.name, Type: "IP", Count: uint64(len(s.items))}
+}
+
+func writeResult(w http.ResponseWriter, result any) {
+	data, _ := json.Marshal(result)
+	_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, data)
+}
+
+func itemValues(items []cloudflare.TeamsListItem) []string {
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		values = append(values, item.Value)
+	}
+	return values
+}
+
+func newTestReconciler(t *testing.T, list *networkingv1alpha2.GatewayList) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "account-123",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			list,
+		).
+		WithStatusSubresource(list).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(10),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestList(items ...string) *networkingv1alpha2.GatewayList {
+	list := &networkingv1alpha2.GatewayList{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "blocked",
+			Generation: 1,
+			Finalizers: []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.GatewayListSpec{
+			Type: "IP",
+		},
+	}
+	for _, item := range items {
+		list.Spec.Items = append(list.Spec.Items, networkingv1alpha2.GatewayListItem{Value: item})
+	}
+	return list
+}
+
+func reconcileList(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.GatewayList {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "blocked"}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.GatewayList{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "blocked"}, updated))
+	return updated
+}
+
+func TestReconcile_DeduplicatesAndRejectsInvalidItems(t *testing.T) {
+	stub := newListStub(t)
+	r, c := newTestReconciler(t, newTestList("10.0.0.1", "10.0.0.0/8", "10.0.0.1", "not-an-ip", "10.0.0.0/8"))
+
+	list := reconcileList(t, r, c)
+
+	require.Len(t, stub.created, 1)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.0/8"}, stub.created[0])
+	assert.Empty(t, stub.patched)
+
+	assert.Equal(t, "Ready", list.Status.State)
+	assert.Equal(t, "list-123", list.Status.ListID)
+	assert.Equal(t, 2, list.Status.ItemCount)
+	assert.Equal(t, 1, list.Status.RejectedItemCount)
+	require.Len(t, list.Status.RejectedItems, 1)
+	assert.Equal(t, "not-an-ip", list.Status.RejectedItems[0].Value)
+	assert.Contains(t, list.Status.RejectedItems[0].Reason, "not a valid IP address or CIDR")
+}
+
+func TestReconcile_ChunksOversizedList(t *testing.T) {
+	stub := newListStub(t)
+	items := make([]string, 0, 2500)
+	for i := range 2500 {
+		items = append(items, fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))
+	}
+	r, c := newTestReconciler(t, newTestList(items...))
+
+	list := reconcileList(t, r, c)
+
+	require.Len(t, stub.created, 1)
+	assert.Len(t, stub.created[0], cfclient.GatewayListMaxItemsPerRequest)
+	require.Len(t, stub.patched, 2)
+	assert.Len(t, stub.patched[0].Append, cfclient.GatewayListMaxItemsPerRequest)
+	assert.Len(t, stub.patched[1].Append, 500)
+	assert.Equal(t, items, stub.items)
+
+	assert.Equal(t, "Ready", list.Status.State)
+	assert.Equal(t, 2500, list.Status.ItemCount)
+	assert.Zero(t, list.Status.RejectedItemCount)
+}
+
+func TestReconcile_AppliesOnlyItemDelta(t *testing.T) {
+	stub := newListStub(t)
+	stub.exists = true
+	stub.items = []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}
+	list := newTestList("10.0.0.2", "10.0.0.3", "10.0.0.4")
+	list.Status.ListID = "list-123"
+	r, c := newTestReconciler(t, list)
+
+	updated := reconcileList(t, r, c)
+
+	assert.Empty(t, stub.created)
+	assert.Zero(t, stub.updates, "name and description are unchanged")
+	require.Len(t, stub.patched, 1)
+	assert.Equal(t, []string{"10.0.0.4"}, itemValues(stub.patched[0].Append))
+	assert.Equal(t, []string{"10.0.0.1"}, stub.patched[0].Remove)
+	assert.ElementsMatch(t, []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"}, stub.items)
+	assert.Equal(t, "Ready", updated.Status.State)
+	assert.Equal(t, 3, updated.Status.ItemCount)
+
+	// A second reconcile finds the list in sync and sends nothing
+	reconcileList(t, r, c)
+	assert.Len(t, stub.patched, 1)
+}
+
+func TestReconcile_RevertsOutOfBandItemChanges(t *testing.T) {
+	stub := newListStub(t)
+	list := newTestList("10.0.0.1", "10.0.0.2")
+	r, c := newTestReconciler(t, list)
+
+	updated := reconcileList(t, r, c)
+	require.Equal(t, "list-123", updated.Status.ListID)
+
+	// Someone removes an item and adds another one in the dashboard
+	stub.items = []string{"10.0.0.2", "192.0.2.1"}
+
+	reconcileList(t, r, c)
+	require.Len(t, stub.created, 1)
+	require.Len(t, stub.patched, 1)
+	assert.Equal(t, []string{"10.0.0.1"}, itemValues(stub.patched[0].Append))
+	assert.Equal(t, []string{"192.0.2.1"}, stub.patched[0].Remove)
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2"}, stub.items)
+}
+
+func TestReconcile_AdoptsExistingListByName(t *testing.T) {
+	stub := newListStub(t)
+	stub.exists = true
+	stub.items = []string{"10.0.0.1"}
+	r, c := newTestReconciler(t, newTestList("10.0.0.1", "10.0.0.2"))
+
+	updated := reconcileList(t, r, c)
+
+	assert.Empty(t, stub.created)
+	require.Len(t, stub.patched, 1)
+	assert.Equal(t, []string{"10.0.0.2"}, itemValues(stub.patched[0].Append))
+	assert.Empty(t, stub.patched[0].Remove)
+	assert.Equal(t, "list-123", updated.Status.ListID)
+}
+
+func TestDiffItems(t *testing.T) {
+	desired := []cfclient.GatewayListItem{{Value: "example.com"}, {Value: "new.example.com"}}
+	current := []cfclient.GatewayListItem{{Value: "EXAMPLE.com"}, {Value: "old.example.com"}}
+
+	toAppend, toRemove := diffItems("DOMAIN", desired, current)
+
+	assert.Equal(t, []cfclient.GatewayListItem{{Value: "new.example.com"}}, toAppend)
+	assert.Equal(t, []string{"old.example.com"}, toRemove)
+}
+
+func TestValidateItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		listType string
+		value    string
+		wantKey  string
+		wantErr  string
+	}{
+		{name: "ipv4", listType: "IP", value: "192.0.2.1", wantKey: "192.0.2.1"},
+		{name: "ipv6 cidr", listType: "IP", value: "2001:DB8::/32", wantKey: "2001:db8::/32"},
+		{name: "cidr with host bits", listType: "IP", value: "10.0.0.1/8", wantErr: "use 10.0.0.0/8"},
+		{name: "invalid ip", listType: "IP", value: "example.com", wantErr: "not a valid IP"},
+		{name: "domain", listType: "DOMAIN", value: "Example.COM", wantKey: "example.com"},
+		{name: "domain with wildcard", listType: "DOMAIN", value: "*.example.com", wantErr: "invalid character"},
+		{name: "domain with empty label", listType: "DOMAIN", value: "example..com", wantErr: "not a valid domain"},
+		{name: "email", listType: "EMAIL", value: "User@example.com", wantKey: "user@example.com"},
+		{name: "invalid email", listType: "EMAIL", value: "user", wantErr: "not a valid email"},
+		{name: "url without scheme", listType: "URL", value: "example.com/path", wantKey: "example.com/path"},
+		{name: "invalid url", listType: "URL", value: "https://", wantErr: "not a valid URL"},
+		{name: "serial", listType: "SERIAL", value: "0123abcd", wantKey: "0123abcd"},
+		{name: "whitespace", listType: "SERIAL", value: "01 23", wantErr: "whitespace"},
+		{name: "empty", listType: "DOMAIN", value: "", wantErr: "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := validateItem(tt.listType, tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKey, key)
+		})
+	}
+}
+
+func TestPrepareItems_KeepsFirstDuplicate(t *testing.T) {
+	items := []cfclient.GatewayListItem{
+		{Value: "example.com", Description: "first"},
+		{Value: " EXAMPLE.com ", Description: "second"},
+		{Value: "bad domain"},
+	}
+
+	valid, rejected, duplicates := prepareItems("DOMAIN", items)
+
+	assert.Equal(t, []cfclient.GatewayListItem{{Value: "example.com", Description: "first"}}, valid)
+	assert.Equal(t, 1, duplicates)
+	require.Len(t, rejected, 1)
+	assert.Equal(t, "bad domain", rejected[0].Value)
+}
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gatewaylist
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// maxRejectedItemsInStatus caps the rejected items reported in status.
+const maxRejectedItemsInStatus = 100
+
+// Gateway List types with type-specific validation; SERIAL items are only
+// checked for being non-empty.
+const (
+	listTypeURL    = "URL"
+	listTypeDomain = "DOMAIN"
+	listTypeEmail  = "EMAIL"
+	listTypeIP     = "IP"
+)
+
+// prepareItems validates items against the list type and removes duplicates.
+// The first occurrence of a duplicated value is kept. Invalid items are returned
+// as rejected instead of failing the whole list.
+func prepareItems(
+	listType string,
+	items []cf.GatewayListItem,
+) (valid []cf.GatewayListItem, rejected []networkingv1alpha2.GatewayListRejectedItem, duplicates int) {
+	seen := make(map[string]struct{}, len(items))
+	valid = make([]cf.GatewayListItem, 0, len(items))
+
+	for _, item := range items {
+		item.Value = strings.TrimSpace(item.Value)
+		key, err := validateItem(listType, item.Value)
+		if err != nil {
+			rejected = append(rejected, networkingv1alpha2.GatewayListRejectedItem{
+				Value:  item.Value,
+				Reason: err.Error(),
+			})
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			duplicates++
+			continue
+		}
+		seen[key] = struct{}{}
+		valid = append(valid, item)
+	}
+
+	return valid, rejected, duplicates
+}
+
+// validateItem checks value against the list type and returns the key used
+// to detect duplicates, so that e.g. differently cased domains match.
+func validateItem(listType, value string) (string, error) {
+	if value == "" {
+		return "", errors.New("value is empty")
+	}
+	if strings.ContainsAny(value, " \t\r\n") {
+		return "", errors.New("value contains whitespace")
+	}
+
+	switch listType {
+	case listTypeIP:
+		return validateIPItem(value)
+	case listTypeDomain:
+		key := strings.ToLower(strings.TrimSuffix(value, "."))
+		if err := validateDomain(key); err != nil {
+			return "", err
+		}
+		return key, nil
+	case listTypeEmail:
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Address != value {
+			return "", errors.New("not a valid email address")
+		}
+		return strings.ToLower(value), nil
+	case listTypeURL:
+		raw := value
+		if !strings.Contains(raw, "://") {
+			raw = "https://" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" {
+			return "", errors.New("not a valid URL")
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// validateIPItem accepts an IP address or a CIDR without host bits set.
+func validateIPItem(value string) (string, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return "", errors.New("not a valid IP address or CIDR")
+		}
+		return addr.String(), nil
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return "", errors.New("not a valid IP address or CIDR")
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return "", fmt.Errorf("CIDR has host bits set, use %s", masked)
+	}
+	return prefix.String(), nil
+}
+
+// validateDomain checks that domain is a DNS name made of valid labels.
+func validateDomain(domain string) error {
+	if len(domain) > 253 {
+		return errors.New("domain is longer than 253 characters")
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > 63 {
+			return errors.New("not a valid domain name")
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return errors.New("domain labels must not start or end with a hyphen")
+		}
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+				return fmt.Errorf("domain contains invalid character %q", c)
+			}
+		}
+	}
+	return nil
+}
+
+// diffItems returns the desired items missing from the current list and the values
+// of current items that are no longer desired. Items are matched by value using the
+// same normalization as deduplication; descriptions are not compared.
+func diffItems(listType string, desired, current []cf.GatewayListItem) (toAppend []cf.GatewayListItem, toRemove []string) {
+	currentKeys := make(map[string]struct{}, len(current))
+	for _, item := range current {
+		currentKeys[itemKey(listType, item.Value)] = struct{}{}
+	}
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, item := range desired {
+		key := itemKey(listType, item.Value)
+		desiredKeys[key] = struct{}{}
+		if _, ok := currentKeys[key]; !ok {
+			toAppend = append(toAppend, item)
+		}
+	}
+
+	for _, item := range current {
+		if _, ok := desiredKeys[itemKey(listType, item.Value)]; !ok {
+			toRemove = append(toRemove, item.Value)
+		}
+	}
+
+	return toAppend, toRemove
+}
+
+// itemKey returns the deduplication key of value, or value itself if it is invalid.
+func itemKey(listType, value string) string {
+	key, err := validateItem(listType, strings.TrimSpace(value))
+	if err != nil {
+		return value
+	}
+	return key
+}
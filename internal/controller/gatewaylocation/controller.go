@@ -0,0 +1,565 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package gatewaylocation provides a controller for managing Cloudflare Gateway Locations.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package gatewaylocation
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"slices"
+
+	"github.com/cloudflare/cloudflare-go"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	finalizerName = "gatewaylocation.networking.cloudflare-operator.io/finalizer"
+
+	// ConditionTypeClientDefault reports whether the location is the account's default location.
+	ConditionTypeClientDefault = "ClientDefault"
+
+	// Reasons for the ClientDefault condition
+	ReasonDefault         = "Default"
+	ReasonNotDefault      = "NotDefault"
+	ReasonDefaultConflict = "DefaultConflict"
+	ReasonAccountDefault  = "AccountDefault"
+)
+
+// Reconciler reconciles a GatewayLocation object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=gatewaylocations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=gatewaylocations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=gatewaylocations/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile handles GatewayLocation reconciliation
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Get the GatewayLocation resource
+	location := &networkingv1alpha2.GatewayLocation{}
+	if err := r.Get(ctx, req.NamespacedName, location); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch GatewayLocation")
+		return common.NoRequeue(), err
+	}
+
+	// Handle deletion
+	if !location.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, location)
+	}
+
+	// Ensure finalizer
+	if added, err := controller.EnsureFinalizer(ctx, r.Client, location, finalizerName); err != nil {
+		return common.NoRequeue(), err
+	} else if added {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := validateNetworks(&location.Spec); err != nil {
+		return r.updateStatusError(ctx, location, err)
+	}
+
+	// Get API client
+	// GatewayLocation is cluster-scoped, use operator namespace for legacy inline secrets
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CloudflareDetails: &location.Spec.Cloudflare,
+		Namespace:         common.OperatorNamespace,
+		StatusAccountID:   location.Status.AccountID,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, location, err)
+	}
+
+	// Sync Gateway location to Cloudflare
+	return r.syncGatewayLocation(ctx, location, apiResult)
+}
+
+// handleDeletion handles the deletion of GatewayLocation.
+func (r *Reconciler) handleDeletion(
+	ctx context.Context,
+	location *networkingv1alpha2.GatewayLocation,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(location, finalizerName) {
+		return common.NoRequeue(), nil
+	}
+
+	// Get API client
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CloudflareDetails: &location.Spec.Cloudflare,
+		Namespace:         common.OperatorNamespace,
+		StatusAccountID:   location.Status.AccountID,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client for deletion")
+		// Continue with finalizer removal
+	} else if location.Status.LocationID != "" {
+		// Delete Gateway location from Cloudflare
+		logger.Info("Deleting Gateway Location from Cloudflare",
+			"locationId", location.Status.LocationID)
+
+		if err := apiResult.API.DeleteGatewayLocation(ctx, location.Status.LocationID); err != nil {
+			logger.Error(err, "Failed to delete Gateway Location from Cloudflare, continuing with finalizer removal")
+			message := fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err))
+			if location.Status.ClientDefault {
+				// Cloudflare keeps exactly one default location, which cannot be deleted
+				message += "; make another location the client default before deleting the default location"
+			}
+			r.Recorder.Event(location, corev1.EventTypeWarning, "DeleteFailed", message)
+			// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+		} else {
+			r.Recorder.Event(location, corev1.EventTypeNormal, "Deleted",
+				"Gateway Location deleted from Cloudflare")
+		}
+	}
+
+	// Remove finalizer
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, location, func() {
+		controllerutil.RemoveFinalizer(location, finalizerName)
+	}); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return common.NoRequeue(), err
+	}
+	r.Recorder.Event(location, corev1.EventTypeNormal, controller.EventReasonFinalizerRemoved, "Finalizer removed")
+
+	return common.NoRequeue(), nil
+}
+
+// syncGatewayLocation syncs the Gateway Location to Cloudflare.
+func (r *Reconciler) syncGatewayLocation(
+	ctx context.Context,
+	location *networkingv1alpha2.GatewayLocation,
+	apiResult *common.APIClientResult,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	locationName := location.GetGatewayLocationName()
+
+	// Only one GatewayLocation can claim the client default
+	conflict, err := r.findClientDefaultConflict(ctx, location)
+	if err != nil {
+		return r.updateStatusError(ctx, location, err)
+	}
+	if conflict != "" {
+		r.Recorder.Event(location, corev1.EventTypeWarning, ReasonDefaultConflict,
+			fmt.Sprintf("GatewayLocation '%s' already claims the client default", conflict))
+	}
+
+	// Find the existing location by ID, then by name
+	var existing *cf.GatewayLocationResult
+	if location.Status.LocationID != "" {
+		existing, err = apiResult.API.GetGatewayLocation(ctx, location.Status.LocationID)
+		if err != nil {
+			if !cf.IsNotFoundError(err) {
+				logger.Error(err, "Failed to get Gateway Location from Cloudflare")
+				return r.updateStatusError(ctx, location, err)
+			}
+			logger.Info("Gateway Location not found in Cloudflare, will recreate",
+				"locationId", location.Status.LocationID)
+			existing = nil
+		}
+	}
+
+	adopted := false
+	if existing == nil {
+		existing, err = apiResult.API.ListGatewayLocationsByName(ctx, locationName)
+		if err != nil && !cf.IsNotFoundError(err) {
+			logger.Error(err, "Failed to search for existing Gateway Location")
+			return r.updateStatusError(ctx, location, err)
+		}
+		adopted = existing != nil
+	}
+
+	if existing == nil {
+		// Create the location with Cloudflare's default endpoints, which are then
+		// merged with the configured ones below
+		logger.Info("Creating Gateway Location in Cloudflare", "name", locationName)
+
+		params := buildParams(location, locationName, conflict == "", nil)
+		params.Endpoints = nil
+		created, err := apiResult.API.CreateGatewayLocation(ctx, params)
+		if err != nil {
+			logger.Error(err, "Failed to create Gateway Location")
+			return r.updateStatusError(ctx, location, err)
+		}
+
+		r.Recorder.Event(location, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Gateway Location '%s' created in Cloudflare", locationName))
+
+		if location.Spec.Endpoints == nil {
+			return r.updateStatusReady(ctx, location, apiResult.AccountID, created, conflict)
+		}
+		existing = created
+	}
+
+	params := buildParams(location, locationName, conflict == "", existing.Endpoints)
+	// Cloudflare always keeps one default location: it is moved by making another
+	// location the default, not by unsetting it
+	if existing.ClientDefault && !params.ClientDefault {
+		params.ClientDefault = true
+	}
+
+	result := existing
+	if !locationUpToDate(existing, params) {
+		logger.V(1).Info("Updating Gateway Location in Cloudflare",
+			"locationId", existing.ID,
+			"name", locationName)
+
+		result, err = apiResult.API.UpdateGatewayLocation(ctx, existing.ID, params)
+		if err != nil {
+			logger.Error(err, "Failed to update Gateway Location")
+			return r.updateStatusError(ctx, location, err)
+		}
+	}
+
+	if adopted {
+		r.Recorder.Event(location, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing Gateway Location '%s'", locationName))
+	}
+
+	return r.updateStatusReady(ctx, location, apiResult.AccountID, result, conflict)
+}
+
+// findClientDefaultConflict returns the name of another GatewayLocation that claims the
+// client default before this one, or "" if this location may claim it. The oldest
+// claiming resource wins, with the name as tie-breaker, so the outcome is stable.
+func (r *Reconciler) findClientDefaultConflict(
+	ctx context.Context,
+	location *networkingv1alpha2.GatewayLocation,
+) (string, error) {
+	if !location.Spec.ClientDefault {
+		return "", nil
+	}
+
+	locations := &networkingv1alpha2.GatewayLocationList{}
+	if err := r.List(ctx, locations); err != nil {
+		return "", fmt.Errorf("failed to list GatewayLocations: %w", err)
+	}
+
+	winner := location
+	for i := range locations.Items {
+		other := &locations.Items[i]
+		if !other.Spec.ClientDefault || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if other.CreationTimestamp.Before(&winner.CreationTimestamp) ||
+			(other.CreationTimestamp.Equal(&winner.CreationTimestamp) && other.Name < winner.Name) {
+			winner = other
+		}
+	}
+
+	if winner.Name == location.Name {
+		return "", nil
+	}
+	return winner.Name, nil
+}
+
+// buildParams builds the GatewayLocationParams from the spec. The configured endpoints
+// are merged over current, so that endpoints not set in the spec are left unchanged.
+func buildParams(
+	location *networkingv1alpha2.GatewayLocation,
+	locationName string,
+	claimDefault bool,
+	current *cloudflare.TeamsLocationEndpoints,
+) cf.GatewayLocationParams {
+	return cf.GatewayLocationParams{
+		Name:                locationName,
+		Networks:            location.Spec.Networks,
+		ClientDefault:       location.Spec.ClientDefault && claimDefault,
+		ECSSupport:          location.Spec.ECSSupport,
+		DNSDestinationIPsID: location.Spec.DNSDestinationIPsID,
+		PolicyIDs:           location.Spec.PolicyIDs,
+		Endpoints:           mergeEndpoints(location.Spec.Endpoints, current),
+	}
+}
+
+// locationUpToDate reports whether the existing location already matches params.
+// Optional fields that are not set in params are left to Cloudflare and not compared.
+func locationUpToDate(existing *cf.GatewayLocationResult, params cf.GatewayLocationParams) bool {
+	if existing.Name != params.Name ||
+		existing.ClientDefault != params.ClientDefault ||
+		!slices.Equal(existing.Networks, params.Networks) ||
+		!slices.Equal(existing.PolicyIDs, params.PolicyIDs) {
+		return false
+	}
+	if params.ECSSupport != nil && (existing.ECSSupport == nil || *existing.ECSSupport != *params.ECSSupport) {
+		return false
+	}
+	if params.DNSDestinationIPsID != "" && existing.DNSDestinationIPsID != params.DNSDestinationIPsID {
+		return false
+	}
+	return params.Endpoints == nil ||
+		(existing.Endpoints != nil && reflect.DeepEqual(comparableEndpoints(*existing.Endpoints),
+			comparableEndpoints(*params.Endpoints)))
+}
+
+// comparableEndpoints strips the fields Cloudflare fills in by itself, the network
+// IDs and the UI authentication helpers, so endpoints can be compared with the spec.
+func comparableEndpoints(endpoints cloudflare.TeamsLocationEndpoints) cloudflare.TeamsLocationEndpoints {
+	strip := func(fields *cloudflare.TeamsLocationEndpointFields) {
+		fields.AuthenticationEnabledUIHelper = false
+		var stripped []cloudflare.TeamsLocationNetwork
+		for _, network := range fields.Networks {
+			stripped = append(stripped, cloudflare.TeamsLocationNetwork{Network: network.Network})
+		}
+		fields.Networks = stripped
+	}
+	endpoints.IPv4Endpoint.AuthenticationEnabled = false
+	strip(&endpoints.IPv6Endpoint.TeamsLocationEndpointFields)
+	strip(&endpoints.DotEndpoint.TeamsLocationEndpointFields)
+	strip(&endpoints.DohEndpoint.TeamsLocationEndpointFields)
+	return endpoints
+}
+
+// mergeEndpoints applies the configured endpoints over current.
+// It returns nil when no endpoints are configured.
+func mergeEndpoints(
+	spec *networkingv1alpha2.GatewayLocationEndpoints,
+	current *cloudflare.TeamsLocationEndpoints,
+) *cloudflare.TeamsLocationEndpoints {
+	if spec == nil {
+		return nil
+	}
+
+	merged := &cloudflare.TeamsLocationEndpoints{}
+	if current != nil {
+		*merged = *current
+	}
+
+	if spec.IPv4 != nil {
+		merged.IPv4Endpoint.Enabled = spec.IPv4.Enabled
+	}
+	if spec.IPv6 != nil {
+		merged.IPv6Endpoint.TeamsLocationEndpointFields = endpointFields(spec.IPv6)
+	}
+	if spec.DoT != nil {
+		merged.DotEndpoint = cloudflare.TeamsLocationDotEndpointFields{
+			RequireToken:                spec.DoT.RequireToken,
+			TeamsLocationEndpointFields: endpointFields(spec.DoT),
+		}
+	}
+	if spec.DoH != nil {
+		merged.DohEndpoint = cloudflare.TeamsLocationDohEndpointFields{
+			RequireToken:                spec.DoH.RequireToken,
+			TeamsLocationEndpointFields: endpointFields(spec.DoH),
+		}
+	}
+	return merged
+}
+
+func endpointFields(endpoint *networkingv1alpha2.GatewayLocationEndpoint) cloudflare.TeamsLocationEndpointFields {
+	fields := cloudflare.TeamsLocationEndpointFields{Enabled: endpoint.Enabled}
+	for _, network := range endpoint.Networks {
+		fields.Networks = append(fields.Networks, cloudflare.TeamsLocationNetwork{Network: network})
+	}
+	return fields
+}
+
+// validateNetworks checks that all networks in the spec are valid CIDRs.
+func validateNetworks(spec *networkingv1alpha2.GatewayLocationSpec) error {
+	check := func(field string, networks []string) error {
+		for _, network := range networks {
+			if _, err := netip.ParsePrefix(network); err != nil {
+				return fmt.Errorf("%s: %q is not a valid CIDR", field, network)
+			}
+		}
+		return nil
+	}
+
+	if err := check("networks", spec.Networks); err != nil {
+		return err
+	}
+	if spec.Endpoints == nil {
+		return nil
+	}
+	endpoints := []struct {
+		field    string
+		endpoint *networkingv1alpha2.GatewayLocationEndpoint
+	}{
+		{"endpoints.ipv6.networks", spec.Endpoints.IPv6},
+		{"endpoints.dot.networks", spec.Endpoints.DoT},
+		{"endpoints.doh.networks", spec.Endpoints.DoH},
+	}
+	for _, e := range endpoints {
+		if e.endpoint == nil {
+			continue
+		}
+		if err := check(e.field, e.endpoint.Networks); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	location *networkingv1alpha2.GatewayLocation,
+	err error,
+) (ctrl.Result, error) {
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, location, func() {
+		location.Status.State = "Error"
+		meta.SetStatusCondition(&location.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: location.Generation,
+			Reason:             "Error",
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		location.Status.ObservedGeneration = location.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+func (r *Reconciler) updateStatusReady(
+	ctx context.Context,
+	location *networkingv1alpha2.GatewayLocation,
+	accountID string,
+	result *cf.GatewayLocationResult,
+	conflict string,
+) (ctrl.Result, error) {
+	defaultCondition := clientDefaultCondition(location, result.ClientDefault, conflict)
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, location, func() {
+		location.Status.AccountID = accountID
+		location.Status.LocationID = result.ID
+		location.Status.DoHSubdomain = result.DoHSubdomain
+		location.Status.IPv6Address = result.IPv6Address
+		location.Status.ClientDefault = result.ClientDefault
+		location.Status.State = "Ready"
+		meta.SetStatusCondition(&location.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: location.Generation,
+			Reason:             "Synced",
+			Message:            "Gateway Location synced to Cloudflare",
+			LastTransitionTime: metav1.Now(),
+		})
+		meta.SetStatusCondition(&location.Status.Conditions, defaultCondition)
+		location.Status.ObservedGeneration = location.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return common.NoRequeue(), nil
+}
+
+// clientDefaultCondition describes whether the location is the client default and why.
+func clientDefaultCondition(
+	location *networkingv1alpha2.GatewayLocation,
+	isDefault bool,
+	conflict string,
+) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               ConditionTypeClientDefault,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: location.Generation,
+		Reason:             ReasonNotDefault,
+		Message:            "Location is not the client default",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	switch {
+	case conflict != "":
+		condition.Reason = ReasonDefaultConflict
+		condition.Message = fmt.Sprintf("GatewayLocation '%s' already claims the client default", conflict)
+		if isDefault {
+			condition.Status = metav1.ConditionTrue
+		}
+	case isDefault && location.Spec.ClientDefault:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonDefault
+		condition.Message = "Location is the client default"
+	case isDefault:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonAccountDefault
+		condition.Message = "Location remains the account default until another location is made the client default"
+	}
+	return condition
+}
+
+// findLocationsForDefaultChange returns the other GatewayLocations involved in the client
+// default, so that they re-evaluate the default when a location changes or is deleted.
+func (r *Reconciler) findLocationsForDefaultChange(ctx context.Context, obj client.Object) []reconcile.Request {
+	changed, ok := obj.(*networkingv1alpha2.GatewayLocation)
+	if !ok {
+		return nil
+	}
+
+	locations := &networkingv1alpha2.GatewayLocationList{}
+	if err := r.List(ctx, locations); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list GatewayLocations for client default change")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, location := range locations.Items {
+		if location.Name == changed.Name {
+			continue
+		}
+		if location.Spec.ClientDefault || location.Status.ClientDefault {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: location.Name},
+			})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("gatewaylocation-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("gatewaylocation"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.GatewayLocation{}).
+		Watches(
+			&networkingv1alpha2.GatewayLocation{},
+			handler.EnqueueRequestsFromMapFunc(r.findLocationsForDefaultChange),
+		).
+		Named("gatewaylocation").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.GatewayLocation{}, r))
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package gatewaylocation
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.GatewayLocation{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestLocation(name string, created time.Time) *networkingv1alpha2.GatewayLocation {
+	return &networkingv1alpha2.GatewayLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(created),
+			Finalizers:        []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.GatewayLocationSpec{
+			Networks: []string{"203.0.113.0/24"},
+		},
+	}
+}
+
+func reconcileLocation(t *testing.T, r *Reconciler, c client.Client, name string) *networkingv1alpha2.GatewayLocation {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.GatewayLocation{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: name}, updated))
+	return updated
+}
+
+func networks(location *models.GatewayLocation) []string {
+	result := make([]string, 0, len(location.Networks))
+	for _, n := range location.Networks {
+		result = append(result, n.Network)
+	}
+	return result
+}
+
+func TestReconcile_CreatesLocationWithDoHEndpoint(t *testing.T) {
+	mock := newMockServer(t)
+	location := newTestLocation("office", time.Now())
+	location.Spec.Endpoints = &networkingv1alpha2.GatewayLocationEndpoints{
+		DoH: &networkingv1alpha2.GatewayLocationEndpoint{
+			Enabled:      true,
+			RequireToken: true,
+			Networks:     []string{"198.51.100.0/24"},
+		},
+	}
+	r, c := newTestReconciler(t, location)
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	require.Equal(t, "Ready", updated.Status.State)
+	remote, ok := mock.Store().GetGatewayLocation(updated.Status.LocationID)
+	require.True(t, ok)
+	assert.Equal(t, "office", remote.Name)
+	assert.Equal(t, []string{"203.0.113.0/24"}, networks(remote))
+	assert.Equal(t, remote.DoHSubdomain, updated.Status.DoHSubdomain)
+	assert.NotEmpty(t, updated.Status.IPv6Address)
+
+	doh := remote.Endpoints["doh"].(map[string]interface{})
+	assert.Equal(t, true, doh["require_token"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"id": "", "network": "198.51.100.0/24"}}, doh["networks"])
+	// Endpoints that are not configured keep Cloudflare's defaults
+	ipv4 := remote.Endpoints["ipv4"].(map[string]interface{})
+	assert.Equal(t, true, ipv4["enabled"])
+}
+
+func TestReconcile_UpdatesNetworks(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newTestLocation("office", time.Now()))
+
+	created := reconcileLocation(t, r, c, "office")
+	created.Spec.Networks = []string{"203.0.113.0/24", "192.0.2.0/24"}
+	require.NoError(t, c.Update(context.Background(), created))
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	assert.Equal(t, created.Status.LocationID, updated.Status.LocationID)
+	remote, ok := mock.Store().GetGatewayLocation(updated.Status.LocationID)
+	require.True(t, ok)
+	assert.Equal(t, []string{"203.0.113.0/24", "192.0.2.0/24"}, networks(remote))
+	assert.Len(t, mock.Store().ListGatewayLocations(), 1)
+}
+
+func TestReconcile_SendsPolicyIDs(t *testing.T) {
+	mock := newMockServer(t)
+	location := newTestLocation("office", time.Now())
+	location.Spec.PolicyIDs = []string{"policy-1", "policy-2"}
+	r, c := newTestReconciler(t, location)
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	remote, ok := mock.Store().GetGatewayLocation(updated.Status.LocationID)
+	require.True(t, ok)
+	assert.Equal(t, []string{"policy-1", "policy-2"}, remote.PolicyIDs)
+}
+
+func TestReconcile_SkipsUpdateWhenUnchanged(t *testing.T) {
+	mock := newMockServer(t)
+	location := newTestLocation("office", time.Now())
+	location.Spec.PolicyIDs = []string{"policy-1"}
+	location.Spec.Endpoints = &networkingv1alpha2.GatewayLocationEndpoints{
+		DoH: &networkingv1alpha2.GatewayLocationEndpoint{Enabled: true, Networks: []string{"198.51.100.0/24"}},
+	}
+	r, c := newTestReconciler(t, location)
+
+	created := reconcileLocation(t, r, c, "office")
+	remote, ok := mock.Store().GetGatewayLocation(created.Status.LocationID)
+	require.True(t, ok)
+	lastUpdate := remote.UpdatedAt
+
+	reconcileLocation(t, r, c, "office")
+
+	remote, _ = mock.Store().GetGatewayLocation(created.Status.LocationID)
+	assert.Equal(t, lastUpdate, remote.UpdatedAt, "an unchanged location is not updated")
+}
+
+func TestReconcile_AdoptsExistingLocationByName(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateGatewayLocation(&models.GatewayLocation{ID: "loc-existing", Name: "office"})
+	r, c := newTestReconciler(t, newTestLocation("office", time.Now()))
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	assert.Equal(t, "loc-existing", updated.Status.LocationID)
+	remote, _ := mock.Store().GetGatewayLocation("loc-existing")
+	assert.Equal(t, []string{"203.0.113.0/24"}, networks(remote))
+	assert.Len(t, mock.Store().ListGatewayLocations(), 1)
+}
+
+func TestReconcile_MovesClientDefault(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateGatewayLocation(&models.GatewayLocation{ID: "loc-default", Name: "Default", ClientDefault: true})
+	location := newTestLocation("office", time.Now())
+	location.Spec.ClientDefault = true
+	r, c := newTestReconciler(t, location)
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	assert.True(t, updated.Status.ClientDefault)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeClientDefault)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, ReasonDefault, cond.Reason)
+
+	previous, _ := mock.Store().GetGatewayLocation("loc-default")
+	assert.False(t, previous.ClientDefault)
+}
+
+func TestReconcile_OldestLocationWinsClientDefault(t *testing.T) {
+	mock := newMockServer(t)
+	now := time.Now()
+	older := newTestLocation("older", now.Add(-time.Hour))
+	older.Spec.ClientDefault = true
+	newer := newTestLocation("newer", now)
+	newer.Spec.ClientDefault = true
+	newer.Spec.Networks = []string{"192.0.2.0/24"}
+	r, c := newTestReconciler(t, older, newer)
+
+	olderStatus := reconcileLocation(t, r, c, "older")
+	newerStatus := reconcileLocation(t, r, c, "newer")
+
+	assert.True(t, olderStatus.Status.ClientDefault)
+	assert.Equal(t, "Ready", newerStatus.Status.State)
+	assert.False(t, newerStatus.Status.ClientDefault)
+	cond := meta.FindStatusCondition(newerStatus.Status.Conditions, ConditionTypeClientDefault)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonDefaultConflict, cond.Reason)
+	assert.Contains(t, cond.Message, "older")
+
+	remote, _ := mock.Store().GetGatewayLocation(olderStatus.Status.LocationID)
+	assert.True(t, remote.ClientDefault)
+}
+
+func TestReconcile_KeepsAccountDefaultWhenNotRequested(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateGatewayLocation(&models.GatewayLocation{ID: "loc-default", Name: "office", ClientDefault: true})
+	r, c := newTestReconciler(t, newTestLocation("office", time.Now()))
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	require.Equal(t, "Ready", updated.Status.State)
+	assert.True(t, updated.Status.ClientDefault)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeClientDefault)
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonAccountDefault, cond.Reason)
+}
+
+func TestReconcile_RejectsInvalidNetwork(t *testing.T) {
+	newMockServer(t)
+	location := newTestLocation("office", time.Now())
+	location.Spec.Networks = []string{"203.0.113.0"}
+	r, c := newTestReconciler(t, location)
+
+	updated := reconcileLocation(t, r, c, "office")
+
+	assert.Equal(t, "Error", updated.Status.State)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Contains(t, cond.Message, "not a valid CIDR")
+}
+
+func TestReconcile_DeletesLocation(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateGatewayLocation(&models.GatewayLocation{ID: "loc-123", Name: "office"})
+	location := newTestLocation("office", time.Now())
+	location.Status.LocationID = "loc-123"
+	location.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	r, c := newTestReconciler(t, location)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "office"}})
+	require.NoError(t, err)
+
+	_, ok := mock.Store().GetGatewayLocation("loc-123")
+	assert.False(t, ok)
+	err = c.Get(context.Background(), types.NamespacedName{Name: "office"}, &networkingv1alpha2.GatewayLocation{})
+	assert.True(t, apierrors.IsNotFound(err), "finalizer removed and object deleted")
+}
+
+func TestMergeEndpoints(t *testing.T) {
+	assert.Nil(t, mergeEndpoints(nil, nil))
+
+	spec := &networkingv1alpha2.GatewayLocationEndpoints{
+		IPv4: &networkingv1alpha2.GatewayLocationEndpoint{Enabled: false},
+		DoT:  &networkingv1alpha2.GatewayLocationEndpoint{Enabled: true, RequireToken: true},
+	}
+	merged := mergeEndpoints(spec, nil)
+
+	require.NotNil(t, merged)
+	assert.False(t, merged.IPv4Endpoint.Enabled)
+	assert.True(t, merged.DotEndpoint.Enabled)
+	assert.True(t, merged.DotEndpoint.RequireToken)
+	assert.False(t, merged.DohEndpoint.Enabled)
+}
@@ -4,11 +4,13 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
 	"github.com/StringKe/cloudflare-operator/internal/clients/k8s"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
 	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
 	"github.com/StringKe/cloudflare-operator/internal/service"
 	tunnelsvc "github.com/StringKe/cloudflare-operator/internal/service/tunnel"
@@ -227,12 +229,12 @@ func setupNewTunnel(r GenericTunnelReconciler) error {
 	if _, err := lifecycleSvc.RequestCreate(ctx, opts); err != nil {
 		log.Error(err, "Failed to request tunnel creation")
 		r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeWarning,
-			"CreateFailed", fmt.Sprintf("Failed to request tunnel creation: %v", err))
+			"CreateFailed", fmt.Sprintf("Failed to request tunnel creation: %s", cf.SanitizeErrorMessage(err)))
 		return err
 	}
 
 	// Update status to creating
-	setTunnelState(r, "creating", metav1.ConditionFalse, "Creating", "Tunnel creation in progress")
+	setTunnelState(r, "creating", metav1.ConditionFalse, common.ReasonCreating, "Tunnel creation in progress")
 
 	// Return special error to trigger requeue
 	return &lifecyclePendingError{tunnelName: tunnelName}
@@ -429,7 +431,7 @@ func updateTunnelStatusMinimal(r GenericTunnelReconciler) error {
 	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
 		Type:               "Ready",
 		Status:             metav1.ConditionFalse,
-		Reason:             "Creating",
+		Reason:             common.ReasonCreating,
 		Message:            "Tunnel is being created",
 		ObservedGeneration: r.GetTunnel().GetObject().GetGeneration(),
 	})
@@ -457,7 +459,7 @@ func applyTunnelStatusCreating(r GenericTunnelReconciler) {
 	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
 		Type:               "Ready",
 		Status:             metav1.ConditionFalse,
-		Reason:             "Creating",
+		Reason:             common.ReasonCreating,
 		Message:            "Tunnel is being created",
 		ObservedGeneration: r.GetTunnel().GetObject().GetGeneration(),
 	})
@@ -559,6 +561,48 @@ func setTunnelState(r GenericTunnelReconciler, state string, conditionStatus met
 	}
 }
 
+// verifyTunnelRoutesRemoved checks once that no routes of a deleted tunnel remain in
+// Cloudflare. Leftover routes are deleted again and retry is true, so the caller requeues
+// and checks again. Once the deletion timeout has passed, routes that still remain are
+// reported in a Warning event so they can be removed manually, without blocking the
+// deletion. It returns the networks of the remaining routes.
+func verifyTunnelRoutesRemoved(r GenericTunnelReconciler, tunnelID string) (remaining []string, retry bool) {
+	ctx := r.GetContext()
+	log := r.GetLog()
+	tunnel := r.GetTunnel()
+
+	routes, err := r.GetCfAPI().ListTunnelRoutesByTunnelID(ctx, tunnelID)
+	if err != nil {
+		log.Error(err, "Failed to verify tunnel route cleanup", "tunnelId", tunnelID)
+		r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeWarning, "RouteCleanupUnverified",
+			fmt.Sprintf("Could not verify that the routes of tunnel %s were removed: %s",
+				tunnelID, cf.SanitizeErrorMessage(err)))
+		return nil, false
+	}
+	if len(routes) == 0 {
+		return nil, false
+	}
+
+	networks := make([]string, 0, len(routes))
+	for _, route := range routes {
+		networks = append(networks, route.Network)
+	}
+
+	if DeletionTimeRemaining(tunnel.GetObject(), nil) <= 0 {
+		log.Info("Tunnel routes remain after cleanup", "tunnelId", tunnelID, "networks", networks)
+		r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeWarning, "OrphanedRoutes",
+			fmt.Sprintf("%d route(s) of tunnel %s remain in Cloudflare and must be removed manually: %s",
+				len(networks), tunnelID, strings.Join(networks, ", ")))
+		return networks, false
+	}
+
+	log.Info("Tunnel routes remain after deletion, retrying cleanup", "tunnelId", tunnelID, "count", len(routes))
+	if _, err := r.GetCfAPI().DeleteTunnelRoutesByTunnelID(ctx, tunnelID); err != nil {
+		log.Error(err, "Failed to delete remaining tunnel routes", "tunnelId", tunnelID)
+	}
+	return networks, true
+}
+
 // cleanupTunnel handles tunnel deletion using the SyncState-based lifecycle pattern.
 // This follows the six-layer architecture where deletion is performed by L5 Sync Controller.
 //
@@ -576,7 +620,7 @@ func cleanupTunnel(r GenericTunnelReconciler) (ctrl.Result, bool, error) {
 	r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeNormal, "Deleting", "Starting Tunnel Deletion")
 
 	// Set deleting state
-	setTunnelState(r, "deleting", metav1.ConditionFalse, "Deleting", "Tunnel is being deleted")
+	setTunnelState(r, "deleting", metav1.ConditionFalse, common.ReasonDeleting, "Tunnel is being deleted")
 
 	// Step 1: Scale down deployment
 	cfDeployment := &appsv1.Deployment{}
@@ -615,13 +659,7 @@ func cleanupTunnel(r GenericTunnelReconciler) (ctrl.Result, bool, error) {
 			tunnelKind = kindClusterTunnel
 		}
 
-		// Get operator namespace for ConfigMap
-		operatorNamespace := tunnel.GetNamespace()
-		if operatorNamespace == "" {
-			operatorNamespace = "cloudflare-operator-system"
-		}
-
-		writer := tunnelconfig.NewWriter(r.GetClient(), operatorNamespace)
+		writer := tunnelconfig.NewWriter(r.GetClient(), tunnelConfigNamespace(tunnel))
 		sourceKey := fmt.Sprintf("%s/%s/%s", tunnelKind, tunnel.GetNamespace(), tunnel.GetName())
 		if err := writer.RemoveSourceConfig(ctx, tunnelID, sourceKey); err != nil {
 			log.Error(err, "Failed to remove from ConfigMap", "tunnelId", tunnelID)
@@ -666,40 +704,52 @@ func cleanupTunnel(r GenericTunnelReconciler) (ctrl.Result, bool, error) {
 				}
 
 				if _, err := lifecycleSvc.RequestDelete(ctx, opts); err != nil {
-					log.Error(err, "Failed to request tunnel deletion, continuing with finalizer removal")
-					r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeWarning,
-						"DeleteFailed", fmt.Sprintf("Failed to request tunnel deletion (will remove finalizer anyway): %v", err))
-					// Don't block finalizer removal - tunnel may need manual cleanup in Cloudflare
-					// Skip waiting for SyncState and proceed directly to finalizer removal
+					log.Error(err, "Failed to request tunnel deletion")
+					// Retried until the deletion timeout; after it the tunnel may need manual cleanup in Cloudflare
+					if result, retry := HandleDeletionFailure(ctx, r.GetRecorder(), tunnel.GetObject(), nil,
+						fmt.Errorf("request tunnel deletion: %w", err)); retry {
+						return result, false, nil
+					}
 				} else {
 					r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeNormal,
 						"DeletionRequested", "Tunnel deletion requested via SyncState")
-					return ctrl.Result{RequeueAfter: tunnelLifecycleCheckInterval}, false, nil
+					return common.RequeueAfterOrDeadline(ctx, tunnelLifecycleCheckInterval), false, nil
 				}
 			} else {
 				// Deletion in progress, check status
 				if syncState.Status.SyncStatus == v1alpha2.SyncStatusError {
-					errMsg := syncState.Status.Error
-					log.Error(errors.New(errMsg), "Tunnel deletion failed, continuing with finalizer removal")
-					r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeWarning,
-						"DeleteFailed", fmt.Sprintf("Tunnel deletion failed (will remove finalizer anyway): %s", errMsg))
+					deleteErr := errors.New(syncState.Status.Error)
+					log.Error(deleteErr, "Tunnel deletion failed")
+					if result, retry := HandleDeletionFailure(ctx, r.GetRecorder(), tunnel.GetObject(), nil, deleteErr); retry {
+						// The failed request is removed so the next attempt requests the deletion again
+						if err := lifecycleSvc.CleanupSyncState(ctx, tunnelName); err != nil {
+							log.Error(err, "Failed to cleanup failed lifecycle SyncState")
+						}
+						return result, false, nil
+					}
 					// Continue to finalizer removal
 				} else if syncState.Status.SyncStatus != v1alpha2.SyncStatusSynced {
 					// Still in progress
 					log.Info("Tunnel deletion in progress, waiting",
 						"status", syncState.Status.SyncStatus)
-					return ctrl.Result{RequeueAfter: tunnelLifecycleCheckInterval}, false, nil
+					return common.RequeueAfterOrDeadline(ctx, tunnelLifecycleCheckInterval), false, nil
 				}
 			}
+		}
 
-			// Cleanup lifecycle SyncState (best effort)
-			if err := lifecycleSvc.CleanupSyncState(ctx, tunnelName); err != nil {
-				log.Error(err, "Failed to cleanup lifecycle SyncState")
-			}
+		// Routes are deleted with the tunnel, make sure none were left behind.
+		// The lifecycle SyncState is kept until then so the deletion is not requested again.
+		if _, retry := verifyTunnelRoutesRemoved(r, tunnelID); retry {
+			return common.RequeueShort(), false, nil
+		}
 
-			log.Info("Tunnel cleanup completed", "tunnelId", tunnelID)
-			r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeNormal, "Deleted", "Tunnel cleanup completed")
+		// Cleanup lifecycle SyncState (best effort)
+		if err := lifecycleSvc.CleanupSyncState(ctx, tunnelName); err != nil {
+			log.Error(err, "Failed to cleanup lifecycle SyncState")
 		}
+
+		log.Info("Tunnel cleanup completed", "tunnelId", tunnelID)
+		r.GetRecorder().Event(tunnel.GetObject(), corev1.EventTypeNormal, "Deleted", "Tunnel cleanup completed")
 	}
 
 	// Step 4: Remove Secret finalizer
@@ -805,7 +855,7 @@ func applyTunnelStatusActive(r GenericTunnelReconciler) {
 	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
 		Type:               "Ready",
 		Status:             metav1.ConditionTrue,
-		Reason:             "Reconciled",
+		Reason:             common.ReasonReady,
 		Message:            "Tunnel is active and ready",
 		ObservedGeneration: r.GetTunnel().GetObject().GetGeneration(),
 	})
@@ -837,12 +887,16 @@ func updateTunnelStatus(r GenericTunnelReconciler) error {
 		r.GetLog().Error(err, "Failed to validate Account ID")
 		r.GetRecorder().Event(r.GetTunnel().GetObject(), corev1.EventTypeWarning,
 			"ErrSpecApi", "Error validating Cloudflare Account ID")
+		setTunnelState(r, "error", metav1.ConditionFalse, common.ErrorReason(err),
+			"Error validating Cloudflare Account ID: "+cf.SanitizeErrorMessage(err))
 		return err
 	}
 	if _, err := r.GetCfAPI().GetTunnelId(ctx); err != nil {
 		r.GetLog().Error(err, "Failed to validate Tunnel ID")
 		r.GetRecorder().Event(r.GetTunnel().GetObject(), corev1.EventTypeWarning,
 			"ErrSpecApi", "Error validating Cloudflare Tunnel ID")
+		setTunnelState(r, "error", metav1.ConditionFalse, common.ErrorReason(err),
+			"Error validating Cloudflare Tunnel ID: "+cf.SanitizeErrorMessage(err))
 		return err
 	}
 
@@ -941,7 +995,7 @@ func syncWarpRoutingConfig(r GenericTunnelReconciler) error {
 	credRef := getCredentialsReference(r)
 
 	// Write to ConfigMap
-	if err := writeTunnelSettingsToConfigMap(r, tunnelID, tunnelKind, enableWarpRouting, credRef); err != nil {
+	if err := writeTunnelSettingsToConfigMap(r, tunnelID, tunnelKind, enableWarpRouting, fallbackTarget, credRef); err != nil {
 		return fmt.Errorf("failed to write tunnel settings to ConfigMap: %w", err)
 	}
 
@@ -955,13 +1009,29 @@ func syncWarpRoutingConfig(r GenericTunnelReconciler) error {
 	return nil
 }
 
+// tunnelConfigNamespace returns the namespace of the tunnel configuration ConfigMap:
+// the namespace of a Tunnel, or the operator namespace for a ClusterTunnel.
+func tunnelConfigNamespace(tunnel Tunnel) string {
+	if ns := tunnel.GetNamespace(); ns != "" {
+		return ns
+	}
+	return common.OperatorNamespace
+}
+
 // writeTunnelSettingsToConfigMap writes tunnel settings to the ConfigMap for the new architecture.
-func writeTunnelSettingsToConfigMap(r GenericTunnelReconciler, tunnelID, tunnelKind string, enableWarpRouting bool, credRef v1alpha2.CredentialsReference) error {
+func writeTunnelSettingsToConfigMap(
+	r GenericTunnelReconciler,
+	tunnelID, tunnelKind string,
+	enableWarpRouting bool,
+	fallbackTarget string,
+	credRef v1alpha2.CredentialsReference,
+) error {
 	tunnel := r.GetTunnel()
 
 	// Build tunnel settings for ConfigMap
 	settings := &tunnelconfig.TunnelSettings{
-		WARPRouting: enableWarpRouting,
+		WARPRouting:    enableWarpRouting,
+		FallbackTarget: fallbackTarget,
 	}
 
 	// Build source config
@@ -986,15 +1056,8 @@ func writeTunnelSettingsToConfigMap(r GenericTunnelReconciler, tunnelID, tunnelK
 		configCredRef = &tunnelconfig.CredentialsRef{Name: credRef.Name}
 	}
 
-	// Get operator namespace from tunnel namespace (for namespaced Tunnel) or use default
-	operatorNamespace := tunnel.GetNamespace()
-	if operatorNamespace == "" {
-		// ClusterTunnel - get from context or use default
-		operatorNamespace = "cloudflare-operator-system"
-	}
-
 	// Write to ConfigMap
-	writer := tunnelconfig.NewWriter(r.GetClient(), operatorNamespace)
+	writer := tunnelconfig.NewWriter(r.GetClient(), tunnelConfigNamespace(tunnel))
 	if err := writer.SetTunnelSettings(
 		r.GetContext(),
 		tunnelID,
@@ -1053,7 +1116,8 @@ func createManagedResources(r GenericTunnelReconciler) (ctrl.Result, error) {
 	// Following six-layer architecture: Token is retrieved from:
 	// 1. Annotation (set by applyLifecycleResult from SyncState)
 	// 2. Existing token Secret (for re-reconciles)
-	// Resource Controller MUST NOT call Cloudflare API directly
+	// Resource Controller MUST NOT call Cloudflare API directly, except to
+	// recover a token that has been lost from all of the above
 	token, err := getTunnelToken(r)
 	if err != nil {
 		r.GetLog().Error(err, "failed to get tunnel token")
@@ -1083,11 +1147,14 @@ func createManagedResources(r GenericTunnelReconciler) (ctrl.Result, error) {
 }
 
 // getTunnelToken retrieves the tunnel token from available sources.
-// Following six-layer architecture, this does NOT call Cloudflare API directly.
+// Following six-layer architecture, the Cloudflare API is only used as a last resort.
 // Token sources (in order of precedence):
 // 1. Annotation cloudflare-operator.io/tunnel-token (set by lifecycle result)
 // 2. Existing token Secret (for re-reconciles after restart)
 // 3. SyncState lifecycle result (if still available)
+// 4. Cloudflare API, when the token was lost from all of the above (e.g. the token
+// Secret was deleted). The token can be re-fetched at any time, unlike the tunnel
+// credentials which are only returned once at creation.
 //
 //nolint:revive // cyclomatic complexity is acceptable for multi-source lookup logic
 func getTunnelToken(r GenericTunnelReconciler) (string, error) {
@@ -1140,7 +1207,29 @@ func getTunnelToken(r GenericTunnelReconciler) (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("tunnel token not found: check lifecycle SyncState or annotation")
+	// Source 4: Re-fetch the token from Cloudflare
+	return recoverTunnelToken(r)
+}
+
+// recoverTunnelToken re-fetches a lost tunnel token from Cloudflare.
+func recoverTunnelToken(r GenericTunnelReconciler) (string, error) {
+	tunnelID := r.GetCfAPI().ValidTunnelId
+	if tunnelID == "" {
+		tunnelID = r.GetTunnel().GetStatus().TunnelId
+	}
+	if tunnelID == "" {
+		return "", fmt.Errorf("tunnel token not found: check lifecycle SyncState or annotation")
+	}
+
+	r.GetLog().Info("Tunnel token not found in annotation, Secret or SyncState, re-fetching from Cloudflare",
+		"tunnelId", tunnelID)
+	token, err := r.GetCfAPI().GetTunnelToken(r.GetContext(), tunnelID)
+	if err != nil {
+		return "", fmt.Errorf("tunnel token not found locally and re-fetching it failed: %w", err)
+	}
+	r.GetRecorder().Event(r.GetTunnel().GetObject(), corev1.EventTypeNormal,
+		"RecoveredToken", "Tunnel token was missing and has been re-fetched from Cloudflare")
+	return token, nil
 }
 
 // tokenSecretForTunnel returns a Secret containing the tunnel token for cloudflared --token mode
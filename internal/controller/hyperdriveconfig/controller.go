@@ -0,0 +1,455 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package hyperdriveconfig provides a controller for managing Cloudflare Hyperdrive configurations.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package hyperdriveconfig
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	finalizerName = "cloudflare.com/hyperdrive-config-finalizer"
+
+	defaultPasswordKey  = "password"
+	defaultPostgresPort = 5432
+	defaultMySQLPort    = 3306
+)
+
+// Reconciler reconciles a HyperdriveConfig object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=hyperdriveconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=hyperdriveconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=hyperdriveconfigs/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile handles HyperdriveConfig reconciliation
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Get the HyperdriveConfig resource
+	config := &networkingv1alpha2.HyperdriveConfig{}
+	if err := r.Get(ctx, req.NamespacedName, config); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch HyperdriveConfig")
+		return common.NoRequeue(), err
+	}
+
+	// Handle deletion
+	if !config.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, config)
+	}
+
+	// Ensure finalizer
+	if added, err := controller.EnsureFinalizer(ctx, r.Client, config, finalizerName); err != nil {
+		return common.NoRequeue(), err
+	} else if added {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Get API client
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CredentialsRef: config.Spec.CredentialsRef,
+		Namespace:      config.Namespace,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, config, err)
+	}
+
+	// Sync configuration to Cloudflare
+	return r.syncConfig(ctx, config, apiResult)
+}
+
+// handleDeletion handles the deletion of HyperdriveConfig.
+func (r *Reconciler) handleDeletion(
+	ctx context.Context,
+	config *networkingv1alpha2.HyperdriveConfig,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(config, finalizerName) {
+		return common.NoRequeue(), nil
+	}
+
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, config, config.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CredentialsRef: config.Spec.CredentialsRef,
+			Namespace:      config.Namespace,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if config.Status.HyperdriveID != "" {
+			// Delete configuration from Cloudflare
+			logger.Info("Deleting Hyperdrive config from Cloudflare",
+				"name", config.Status.HyperdriveName,
+				"hyperdriveId", config.Status.HyperdriveID)
+
+			if err := apiResult.API.DeleteHyperdriveConfig(ctx, config.Status.HyperdriveID); err != nil {
+				logger.Error(err, "Failed to delete Hyperdrive config from Cloudflare")
+				if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, config, config.Spec.DeletionTimeout, err); retry {
+					return result, nil
+				}
+			} else {
+				r.Recorder.Event(config, corev1.EventTypeNormal, "Deleted",
+					"Hyperdrive config deleted from Cloudflare")
+			}
+		}
+	}
+
+	// Remove finalizer
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, config, func() {
+		controllerutil.RemoveFinalizer(config, finalizerName)
+	}); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return common.NoRequeue(), err
+	}
+	r.Recorder.Event(config, corev1.EventTypeNormal, controller.EventReasonFinalizerRemoved, "Finalizer removed")
+
+	return common.NoRequeue(), nil
+}
+
+// syncConfig syncs the Hyperdrive configuration to Cloudflare.
+func (r *Reconciler) syncConfig(
+	ctx context.Context,
+	config *networkingv1alpha2.HyperdriveConfig,
+	apiResult *common.APIClientResult,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	secret, password, err := r.getPassword(ctx, config)
+	if err != nil {
+		logger.Error(err, "Failed to get database password")
+		// The status message is sanitized, so name the Secret in an event.
+		r.Recorder.Event(config, corev1.EventTypeWarning, "PasswordSecretError", err.Error())
+		return r.updateStatusError(ctx, config, err)
+	}
+	params := buildParams(config, password)
+
+	// Check the configuration of the status still exists
+	if config.Status.HyperdriveID != "" {
+		existing, err := apiResult.API.GetHyperdriveConfig(ctx, config.Status.HyperdriveID)
+		switch {
+		case err == nil:
+			// Cloudflare never returns the password, so a changed Secret is
+			// detected by its resourceVersion.
+			if !configMatches(existing, params) || config.Status.PasswordSecretVersion != secret.ResourceVersion {
+				logger.Info("Updating Hyperdrive config", "hyperdriveId", existing.ID)
+				existing, err = apiResult.API.UpdateHyperdriveConfig(ctx, existing.ID, params)
+				if err != nil {
+					logger.Error(err, "Failed to update Hyperdrive config")
+					return r.updateStatusError(ctx, config, err)
+				}
+				r.Recorder.Event(config, corev1.EventTypeNormal, "Updated",
+					fmt.Sprintf("Hyperdrive config '%s' updated in Cloudflare", params.Name))
+			}
+			return r.updateStatusReady(ctx, config, existing, secret.ResourceVersion)
+		case !cf.IsNotFoundError(err):
+			logger.Error(err, "Failed to get Hyperdrive config from Cloudflare")
+			return r.updateStatusError(ctx, config, err)
+		}
+		// The configuration was deleted outside the operator
+		r.Recorder.Event(config, corev1.EventTypeWarning, "NotFound",
+			fmt.Sprintf("Hyperdrive config '%s' no longer exists in Cloudflare", config.Status.HyperdriveID))
+	}
+
+	// Adopt an existing configuration with the name. Its password is unknown,
+	// so it is always updated to the spec.
+	existing, err := apiResult.API.GetHyperdriveConfigByName(ctx, params.Name)
+	if err == nil {
+		logger.Info("Hyperdrive config already exists, adopting it", "name", params.Name)
+		updated, err := apiResult.API.UpdateHyperdriveConfig(ctx, existing.ID, params)
+		if err != nil {
+			logger.Error(err, "Failed to update adopted Hyperdrive config")
+			return r.updateStatusError(ctx, config, err)
+		}
+		r.Recorder.Event(config, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing Hyperdrive config '%s'", params.Name))
+		return r.updateStatusReady(ctx, config, updated, secret.ResourceVersion)
+	}
+	if !cf.IsNotFoundError(err) {
+		logger.Error(err, "Failed to look up Hyperdrive config by name")
+		return r.updateStatusError(ctx, config, err)
+	}
+
+	// Create new configuration
+	logger.Info("Creating Hyperdrive config in Cloudflare", "name", params.Name)
+
+	result, err := apiResult.API.CreateHyperdriveConfig(ctx, params)
+	if err != nil {
+		logger.Error(err, "Failed to create Hyperdrive config")
+		return r.updateStatusError(ctx, config, err)
+	}
+
+	r.Recorder.Event(config, corev1.EventTypeNormal, "Created",
+		fmt.Sprintf("Hyperdrive config '%s' created in Cloudflare", params.Name))
+
+	return r.updateStatusReady(ctx, config, result, secret.ResourceVersion)
+}
+
+// getPassword reads the database password from the Secret referenced by the origin.
+func (r *Reconciler) getPassword(
+	ctx context.Context,
+	config *networkingv1alpha2.HyperdriveConfig,
+) (*corev1.Secret, string, error) {
+	ref := config.Spec.Origin.PasswordSecretRef
+	key := ref.Key
+	if key == "" {
+		key = defaultPasswordKey
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: config.Namespace}, secret); err != nil {
+		return nil, "", fmt.Errorf("failed to get password secret %s/%s: %w", config.Namespace, ref.Name, err)
+	}
+
+	password, ok := secret.Data[key]
+	if !ok || len(password) == 0 {
+		return nil, "", fmt.Errorf("password secret %s/%s has no key %q", config.Namespace, ref.Name, key)
+	}
+
+	return secret, string(password), nil
+}
+
+// buildParams builds the Cloudflare parameters of the configuration.
+func buildParams(config *networkingv1alpha2.HyperdriveConfig, password string) cf.HyperdriveConfigParams {
+	origin := config.Spec.Origin
+
+	name := config.Spec.Name
+	if name == "" {
+		name = config.Name
+	}
+
+	scheme := origin.Scheme
+	if scheme == "" {
+		scheme = "postgres"
+	}
+
+	port := origin.Port
+	if port == 0 {
+		port = defaultPostgresPort
+		if scheme == "mysql" {
+			port = defaultMySQLPort
+		}
+	}
+
+	params := cf.HyperdriveConfigParams{
+		Name: name,
+		Origin: cf.HyperdriveOrigin{
+			Scheme:   scheme,
+			Host:     origin.Host,
+			Port:     port,
+			Database: origin.Database,
+			User:     origin.User,
+		},
+		Password: password,
+	}
+
+	if caching := config.Spec.Caching; caching != nil {
+		params.Caching.Disabled = caching.Disabled
+		if caching.MaxAge != nil {
+			params.Caching.MaxAge = *caching.MaxAge
+		}
+		if caching.StaleWhileRevalidate != nil {
+			params.Caching.StaleWhileRevalidate = *caching.StaleWhileRevalidate
+		}
+	}
+
+	return params
+}
+
+// configMatches returns whether the existing configuration matches the parameters.
+// Caching settings not set in the spec are left to Cloudflare's defaults.
+func configMatches(existing *cf.HyperdriveConfigResult, params cf.HyperdriveConfigParams) bool {
+	if existing.Name != params.Name ||
+		normalizeScheme(existing.Origin.Scheme) != normalizeScheme(params.Origin.Scheme) ||
+		existing.Origin.Host != params.Origin.Host ||
+		existing.Origin.Port != params.Origin.Port ||
+		existing.Origin.Database != params.Origin.Database ||
+		existing.Origin.User != params.Origin.User {
+		return false
+	}
+
+	caching := params.Caching
+	if caching.Disabled != nil {
+		existingDisabled := existing.Caching.Disabled != nil && *existing.Caching.Disabled
+		if existingDisabled != *caching.Disabled {
+			return false
+		}
+	}
+	if caching.MaxAge != 0 && existing.Caching.MaxAge != caching.MaxAge {
+		return false
+	}
+	if caching.StaleWhileRevalidate != 0 && existing.Caching.StaleWhileRevalidate != caching.StaleWhileRevalidate {
+		return false
+	}
+
+	return true
+}
+
+// normalizeScheme treats postgres and postgresql as the same scheme.
+func normalizeScheme(scheme string) string {
+	if scheme == "postgresql" {
+		return "postgres"
+	}
+	return scheme
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	config *networkingv1alpha2.HyperdriveConfig,
+	err error,
+) (ctrl.Result, error) {
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, config, func() {
+		config.Status.State = networkingv1alpha2.HyperdriveConfigStateError
+		config.Status.Message = cf.SanitizeErrorMessage(err)
+		meta.SetStatusCondition(&config.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: config.Generation,
+			Reason:             "Error",
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		config.Status.ObservedGeneration = config.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+func (r *Reconciler) updateStatusReady(
+	ctx context.Context,
+	config *networkingv1alpha2.HyperdriveConfig,
+	result *cf.HyperdriveConfigResult,
+	secretVersion string,
+) (ctrl.Result, error) {
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, config, func() {
+		config.Status.HyperdriveID = result.ID
+		config.Status.HyperdriveName = result.Name
+		config.Status.PasswordSecretVersion = secretVersion
+		config.Status.State = networkingv1alpha2.HyperdriveConfigStateReady
+		config.Status.Message = ""
+		meta.SetStatusCondition(&config.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: config.Generation,
+			Reason:             "Synced",
+			Message:            "Hyperdrive config synced to Cloudflare",
+			LastTransitionTime: metav1.Now(),
+		})
+		config.Status.ObservedGeneration = config.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return common.NoRequeue(), nil
+}
+
+// findConfigsForCredentials returns HyperdriveConfigs that reference the given credentials
+func (r *Reconciler) findConfigsForCredentials(ctx context.Context, obj client.Object) []reconcile.Request {
+	creds, ok := obj.(*networkingv1alpha2.CloudflareCredentials)
+	if !ok {
+		return nil
+	}
+
+	configList := &networkingv1alpha2.HyperdriveConfigList{}
+	if err := r.List(ctx, configList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, config := range configList.Items {
+		if (config.Spec.CredentialsRef != nil && config.Spec.CredentialsRef.Name == creds.Name) ||
+			(creds.Spec.IsDefault && config.Spec.CredentialsRef == nil) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      config.Name,
+					Namespace: config.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// findConfigsForSecret returns the HyperdriveConfigs whose password is in the Secret,
+// so that a rotated password is sent to Cloudflare as soon as the Secret changes.
+func (r *Reconciler) findConfigsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	configList := &networkingv1alpha2.HyperdriveConfigList{}
+	if err := r.List(ctx, configList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, config := range configList.Items {
+		if config.Spec.Origin.PasswordSecretRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      config.Name,
+					Namespace: config.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("hyperdriveconfig-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("hyperdriveconfig"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.HyperdriveConfig{}).
+		Watches(&networkingv1alpha2.CloudflareCredentials{},
+			handler.EnqueueRequestsFromMapFunc(r.findConfigsForCredentials)).
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.findConfigsForSecret)).
+		Named("hyperdriveconfig").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.HyperdriveConfig{}, r))
+}
@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package hyperdriveconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+const testPassword = "s3cret-password"
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.HyperdriveConfig{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newPasswordSecret(password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "orders-db", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte(password)},
+	}
+}
+
+func newConfig(mutate func(spec *networkingv1alpha2.HyperdriveConfigSpec)) *networkingv1alpha2.HyperdriveConfig {
+	config := &networkingv1alpha2.HyperdriveConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "orders",
+			Namespace:  "default",
+			Finalizers: []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.HyperdriveConfigSpec{
+			Origin: networkingv1alpha2.HyperdriveOrigin{
+				Host:              "db.example.com",
+				Database:          "orders",
+				User:              "app",
+				PasswordSecretRef: networkingv1alpha2.HyperdrivePasswordSecretRef{Name: "orders-db"},
+			},
+		},
+	}
+	if mutate != nil {
+		mutate(&config.Spec)
+	}
+	return config
+}
+
+// reconcileConfig reconciles the "orders" config and returns the result and the updated config.
+func reconcileConfig(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.HyperdriveConfig) {
+	t.Helper()
+	key := types.NamespacedName{Name: "orders", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	config := &networkingv1alpha2.HyperdriveConfig{}
+	require.NoError(t, c.Get(context.Background(), key, config))
+	return result, config
+}
+
+// deleteConfig deletes the config and reconciles the deletion.
+func deleteConfig(t *testing.T, r *Reconciler, c client.Client, config *networkingv1alpha2.HyperdriveConfig) {
+	t.Helper()
+	require.NoError(t, c.Delete(context.Background(), config))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "orders", Namespace: "default"},
+	})
+	require.NoError(t, err)
+}
+
+func TestReconcile_CreatesConfig(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+
+	result, config := reconcileConfig(t, r, c)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.HyperdriveConfigStateReady, config.Status.State)
+	assert.Equal(t, "orders", config.Status.HyperdriveName)
+	require.NotEmpty(t, config.Status.HyperdriveID)
+	assert.NotEmpty(t, config.Status.PasswordSecretVersion)
+	assert.True(t, meta.IsStatusConditionTrue(config.Status.Conditions, "Ready"))
+
+	stored, ok := mock.Store().GetHyperdriveConfig(config.Status.HyperdriveID)
+	require.True(t, ok)
+	assert.Equal(t, testPassword, stored.Password)
+	assert.Equal(t, "postgres", stored.Origin.Scheme)
+	assert.Equal(t, 5432, stored.Origin.Port, "the port defaults from the scheme")
+
+	// The password is never written back to the resource
+	raw, err := json.Marshal(config)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), testPassword)
+
+	// A second reconcile changes nothing
+	_, again := reconcileConfig(t, r, c)
+	assert.Equal(t, config.Status.HyperdriveID, again.Status.HyperdriveID)
+	assert.Zero(t, mock.CountRequests(http.MethodPut, "/hyperdrive/configs/"))
+	assert.Len(t, mock.Store().ListHyperdriveConfigs(), 1)
+}
+
+func TestReconcile_MySQLDefaultPortAndCaching(t *testing.T) {
+	mock := newMockServer(t)
+	maxAge := 120
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(func(spec *networkingv1alpha2.HyperdriveConfigSpec) {
+		spec.Origin.Scheme = "mysql"
+		spec.Caching = &networkingv1alpha2.HyperdriveCaching{MaxAge: &maxAge}
+	}))
+
+	_, config := reconcileConfig(t, r, c)
+
+	stored, ok := mock.Store().GetHyperdriveConfig(config.Status.HyperdriveID)
+	require.True(t, ok)
+	assert.Equal(t, 3306, stored.Origin.Port)
+	assert.Equal(t, 120, stored.Caching.MaxAge)
+	assert.False(t, stored.Caching.Disabled)
+}
+
+func TestReconcile_MissingPasswordSecret(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newConfig(nil))
+
+	result, config := reconcileConfig(t, r, c)
+
+	assert.NotZero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.HyperdriveConfigStateError, config.Status.State)
+	assert.NotEmpty(t, config.Status.Message)
+	assert.False(t, meta.IsStatusConditionTrue(config.Status.Conditions, "Ready"))
+	assert.Empty(t, mock.Store().ListHyperdriveConfigs())
+}
+
+func TestReconcile_MissingPasswordKey(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(func(spec *networkingv1alpha2.HyperdriveConfigSpec) {
+		spec.Origin.PasswordSecretRef.Key = "db-password"
+	}))
+
+	_, config := reconcileConfig(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.HyperdriveConfigStateError, config.Status.State)
+	assert.Empty(t, config.Status.HyperdriveID)
+	assert.Empty(t, mock.Store().ListHyperdriveConfigs())
+}
+
+func TestReconcile_RotatedPasswordUpdatesConfig(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+	_, config := reconcileConfig(t, r, c)
+	version := config.Status.PasswordSecretVersion
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "orders-db", Namespace: "default"}, secret))
+	secret.Data["password"] = []byte("rotated-password")
+	require.NoError(t, c.Update(context.Background(), secret))
+
+	_, config = reconcileConfig(t, r, c)
+
+	assert.NotEqual(t, version, config.Status.PasswordSecretVersion)
+	stored, ok := mock.Store().GetHyperdriveConfig(config.Status.HyperdriveID)
+	require.True(t, ok)
+	assert.Equal(t, "rotated-password", stored.Password)
+	assert.Equal(t, 1, mock.CountRequests(http.MethodPut, "/hyperdrive/configs/"))
+}
+
+func TestReconcile_UpdatesChangedOrigin(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+	_, config := reconcileConfig(t, r, c)
+	id := config.Status.HyperdriveID
+
+	config.Spec.Origin.Host = "replica.example.com"
+	require.NoError(t, c.Update(context.Background(), config))
+	_, config = reconcileConfig(t, r, c)
+
+	assert.Equal(t, id, config.Status.HyperdriveID)
+	stored, ok := mock.Store().GetHyperdriveConfig(id)
+	require.True(t, ok)
+	assert.Equal(t, "replica.example.com", stored.Origin.Host)
+	assert.Equal(t, testPassword, stored.Password, "the password is sent with every update")
+}
+
+func TestReconcile_AdoptsExistingConfig(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateHyperdriveConfig(&models.HyperdriveConfig{
+		ID:       "existing-hd",
+		Name:     "orders",
+		Origin:   models.HyperdriveOrigin{Scheme: "postgres", Host: "old.example.com", Port: 5432, Database: "orders", User: "app"},
+		Password: "unknown",
+	})
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+
+	_, config := reconcileConfig(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.HyperdriveConfigStateReady, config.Status.State)
+	assert.Equal(t, "existing-hd", config.Status.HyperdriveID)
+	assert.Len(t, mock.Store().ListHyperdriveConfigs(), 1, "no config is created")
+	stored, ok := mock.Store().GetHyperdriveConfig("existing-hd")
+	require.True(t, ok)
+	assert.Equal(t, "db.example.com", stored.Origin.Host)
+	assert.Equal(t, testPassword, stored.Password, "the adopted config gets the password of the Secret")
+}
+
+func TestReconcile_RecreatesDeletedConfig(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+	_, config := reconcileConfig(t, r, c)
+	oldID := config.Status.HyperdriveID
+	require.True(t, mock.Store().DeleteHyperdriveConfig(oldID))
+
+	_, config = reconcileConfig(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.HyperdriveConfigStateReady, config.Status.State)
+	assert.NotEqual(t, oldID, config.Status.HyperdriveID)
+	_, ok := mock.Store().GetHyperdriveConfig(config.Status.HyperdriveID)
+	assert.True(t, ok)
+}
+
+func TestReconcile_DeleteConfig(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+	_, config := reconcileConfig(t, r, c)
+	require.NotEmpty(t, config.Status.HyperdriveID)
+
+	deleteConfig(t, r, c, config)
+
+	assert.Empty(t, mock.Store().ListHyperdriveConfigs())
+	err := c.Get(context.Background(), types.NamespacedName{Name: "orders", Namespace: "default"}, config)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_DeleteAlreadyDeletedConfig(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(nil))
+	_, config := reconcileConfig(t, r, c)
+	require.True(t, mock.Store().DeleteHyperdriveConfig(config.Status.HyperdriveID))
+
+	deleteConfig(t, r, c, config)
+
+	err := c.Get(context.Background(), types.NamespacedName{Name: "orders", Namespace: "default"}, config)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_OrphanKeepsConfig(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newPasswordSecret(testPassword), newConfig(func(spec *networkingv1alpha2.HyperdriveConfigSpec) {
+		spec.DeletionPolicy = "Orphan"
+	}))
+	_, config := reconcileConfig(t, r, c)
+
+	deleteConfig(t, r, c, config)
+
+	assert.Len(t, mock.Store().ListHyperdriveConfigs(), 1)
+}
+
+func TestFindConfigsForSecret(t *testing.T) {
+	other := newConfig(func(spec *networkingv1alpha2.HyperdriveConfigSpec) {
+		spec.Origin.PasswordSecretRef.Name = "other-db"
+	})
+	other.Name = "other"
+	r, _ := newTestReconciler(t, newConfig(nil), other)
+
+	requests := r.findConfigsForSecret(context.Background(), newPasswordSecret(testPassword))
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, "orders", requests[0].Name)
+
+	otherNamespace := newPasswordSecret(testPassword)
+	otherNamespace.Namespace = "production"
+	assert.Empty(t, r.findConfigsForSecret(context.Background(), otherNamespace))
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
+)
+
+// reconcileAccess protects the hostnames of an Ingress with Cloudflare Access as
+// set by its access annotations. It runs after the hostnames are added to the
+// tunnel configuration and their DNS records are created, so a hostname is
+// routable before Access is attached to it. AccessApplications created for
+// hostnames that are no longer protected are deleted.
+func (r *Reconciler) reconcileAccess(
+	ctx context.Context,
+	ingress *networkingv1.Ingress,
+	config *networkingv1alpha2.TunnelIngressClassConfig,
+) error {
+	logger := log.FromContext(ctx)
+
+	opts, err := NewAnnotationParser(ingress.Annotations).AccessOptions()
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool)
+	var errs []error
+	switch {
+	case opts == nil:
+		// Not protected; AccessApplications created earlier are pruned below
+	case opts.ApplicationRef != "":
+		for _, hostname := range r.collectHostnames(ingress) {
+			if err := tunnelpkg.CheckAccessApplication(ctx, r.Client, ingress.Namespace,
+				opts.ApplicationRef, hostname); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	default:
+		tunnel, err := r.getTunnel(ctx, config)
+		if err != nil {
+			return err
+		}
+		for _, hostname := range r.collectHostnames(ingress) {
+			app := tunnelpkg.AccessApplicationFor(tunnelpkg.AccessApplicationName(ingress.Name, hostname),
+				ingress.Namespace, hostname, *opts, tunnel.GetSpec().Cloudflare)
+			app.Labels = r.accessApplicationLabels(ingress)
+			keep[app.Name] = true
+
+			if err := ctrl.SetControllerReference(ingress, app, r.Scheme); err != nil {
+				errs = append(errs, fmt.Errorf("set owner ref for %s: %w", hostname, err))
+				continue
+			}
+			if err := tunnelpkg.EnsureAccessApplication(ctx, r.Client, ingress, app); err != nil {
+				logger.Error(err, "Failed to create/update AccessApplication", "hostname", hostname)
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	pruned, err := tunnelpkg.PruneAccessApplications(ctx, r.Client, ingress, r.accessApplicationLabels(ingress), keep)
+	if len(pruned) > 0 {
+		logger.Info("Removed AccessApplications of hostnames no longer protected", "hostnames", pruned)
+	}
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to protect %d hostnames with Access: %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
+
+// accessApplicationLabels returns the labels of the AccessApplications created for an Ingress
+func (*Reconciler) accessApplicationLabels(ingress *networkingv1.Ingress) client.MatchingLabels {
+	return client.MatchingLabels{
+		ManagedByAnnotation: ManagedByValue,
+		ingressNameLabel:    ingress.Name,
+	}
+}
+
+// cleanupAccess deletes the AccessApplications created for a deleted Ingress.
+// Their finalizer removes the applications from Cloudflare unless their
+// deletion policy is Orphan.
+func (r *Reconciler) cleanupAccess(ctx context.Context, ingress *networkingv1.Ingress) error {
+	pruned, err := tunnelpkg.PruneAccessApplications(ctx, r.Client, ingress, r.accessApplicationLabels(ingress), nil)
+	if len(pruned) > 0 {
+		log.FromContext(ctx).Info("Deleted AccessApplications of the Ingress", "hostnames", pruned)
+	}
+	return err
+}
+
+// findIngressesForAccessApplication returns the Ingresses in the namespace of an
+// AccessApplication that reference it with the access-application annotation, so a
+// change to the application is checked against their hostnames.
+func (r *Reconciler) findIngressesForAccessApplication(ctx context.Context, obj client.Object) []reconcile.Request {
+	ingressList := &networkingv1.IngressList{}
+	if err := r.List(ctx, ingressList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, ing := range ingressList.Items {
+		if ing.Annotations[AnnotationAccessApplication] == obj.GetName() && r.isOurIngress(ctx, &ing) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&ing)})
+		}
+	}
+	return requests
+}
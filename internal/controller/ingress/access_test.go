@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package ingress
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/accessapplication"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+)
+
+const accessTestPolicyID = "0b1c2d3e-4f50-4a6b-8c7d-9e0f1a2b3c4d"
+
+// newAccessTestReconciler returns an Ingress reconciler and an AccessApplication
+// reconciler sharing a fake client, with the Cloudflare API served by mock.
+func newAccessTestReconciler(
+	t *testing.T,
+	objs ...client.Object,
+) (*Reconciler, *accessapplication.Reconciler, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+
+	scheme := setupTestScheme(t)
+	policy := &networkingv1alpha2.AccessPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "employees"},
+		Status:     networkingv1alpha2.AccessPolicyStatus{PolicyID: accessTestPolicyID},
+	}
+	objs = append(objs,
+		&networkingv1alpha2.Tunnel{
+			ObjectMeta: metav1.ObjectMeta{Name: "tunnel", Namespace: "default"},
+			Status:     networkingv1alpha2.TunnelStatus{TunnelId: dnsTestTunnelID, TunnelName: "tunnel"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+		policy,
+	)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.AccessApplication{}, policy).Build()
+
+	return &Reconciler{Client: c, Scheme: scheme, Recorder: record.NewFakeRecorder(10)},
+		&accessapplication.Reconciler{
+			Client:     c,
+			Scheme:     scheme,
+			Recorder:   record.NewFakeRecorder(10),
+			APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+		}, mock
+}
+
+// newAccessTestIngress returns an Ingress for hosts with the given annotations.
+func newAccessTestIngress(annotations map[string]string, hosts ...string) *networkingv1.Ingress {
+	ingress := newDNSTestIngress(hosts...)
+	ingress.Annotations = annotations
+	ingress.Finalizers = []string{FinalizerName}
+	return ingress
+}
+
+// accessApplications returns the AccessApplications in the default namespace by name.
+func accessApplications(t *testing.T, c client.Client) map[string]*networkingv1alpha2.AccessApplication {
+	t.Helper()
+	list := &networkingv1alpha2.AccessApplicationList{}
+	require.NoError(t, c.List(context.Background(), list, client.InNamespace("default")))
+	apps := make(map[string]*networkingv1alpha2.AccessApplication, len(list.Items))
+	for i := range list.Items {
+		apps[list.Items[i].Name] = &list.Items[i]
+	}
+	return apps
+}
+
+// syncAccessApplication reconciles an AccessApplication until it is in sync or gone.
+func syncAccessApplication(t *testing.T, r *accessapplication.Reconciler, name string) {
+	t.Helper()
+	for range 3 {
+		result, err := r.Reconcile(context.Background(),
+			ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: name}})
+		require.NoError(t, err)
+		if !result.Requeue {
+			return
+		}
+	}
+}
+
+func TestReconcileAccess_ProtectsAndReleasesHostname(t *testing.T) {
+	for _, policy := range []string{common.DeletionPolicyDelete, common.DeletionPolicyOrphan} {
+		t.Run(policy, func(t *testing.T) {
+			ctx := context.Background()
+			ingress := newAccessTestIngress(map[string]string{
+				AnnotationAccessPolicies:       "employees",
+				AnnotationAccessDeletionPolicy: policy,
+			}, "app.example.com")
+			r, appReconciler, mock := newAccessTestReconciler(t, ingress)
+
+			require.NoError(t, r.reconcileAccess(ctx, ingress, newDNSTestConfig()))
+
+			app := accessApplications(t, r.Client)["app-app-example-com"]
+			require.NotNil(t, app)
+			assert.Equal(t, "app.example.com", app.Spec.Domain)
+			assert.Equal(t, policy, app.Spec.DeletionPolicy)
+			assert.True(t, metav1.IsControlledBy(app, ingress))
+
+			// The AccessApplication controller creates the application in Cloudflare
+			syncAccessApplication(t, appReconciler, app.Name)
+			remote := mock.Store().ListAccessApplications()
+			require.Len(t, remote, 1)
+			assert.Equal(t, "app.example.com", remote[0].Domain)
+
+			// Deleting the Ingress deletes the AccessApplication, which honors its deletion policy
+			require.NoError(t, r.Delete(ctx, ingress))
+			require.NoError(t, r.cleanupAccess(ctx, ingress))
+			syncAccessApplication(t, appReconciler, app.Name)
+
+			err := r.Get(ctx, client.ObjectKeyFromObject(app), &networkingv1alpha2.AccessApplication{})
+			assert.True(t, apierrors.IsNotFound(err))
+			if policy == common.DeletionPolicyOrphan {
+				assert.Len(t, mock.Store().ListAccessApplications(), 1)
+			} else {
+				assert.Empty(t, mock.Store().ListAccessApplications())
+			}
+		})
+	}
+}
+
+func TestReconcileAccess_PrunesUnprotectedHostnames(t *testing.T) {
+	ctx := context.Background()
+	annotations := map[string]string{AnnotationAccessPolicies: "employees"}
+	r, _, _ := newAccessTestReconciler(t)
+	config := newDNSTestConfig()
+
+	require.NoError(t, r.reconcileAccess(ctx, newAccessTestIngress(annotations, "app.example.com", "old.example.com"), config))
+	assert.Len(t, accessApplications(t, r.Client), 2)
+
+	require.NoError(t, r.reconcileAccess(ctx, newAccessTestIngress(annotations, "app.example.com"), config))
+	apps := accessApplications(t, r.Client)
+	assert.Contains(t, apps, "app-app-example-com")
+	assert.NotContains(t, apps, "app-old-example-com")
+
+	// Removing the annotations removes the protection
+	require.NoError(t, r.reconcileAccess(ctx, newAccessTestIngress(nil, "app.example.com"), config))
+	assert.Empty(t, accessApplications(t, r.Client))
+}
+
+func TestReconcileAccess_ApplicationRef(t *testing.T) {
+	ctx := context.Background()
+	r, _, _ := newAccessTestReconciler(t, &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Type:              "self_hosted",
+			Domain:            "app.example.com",
+			SelfHostedDomains: []string{"api.example.com/v1"},
+		},
+	})
+	annotations := map[string]string{AnnotationAccessApplication: "internal"}
+
+	require.NoError(t, r.reconcileAccess(ctx,
+		newAccessTestIngress(annotations, "app.example.com", "api.example.com"), newDNSTestConfig()))
+	assert.Len(t, accessApplications(t, r.Client), 1, "no AccessApplication is created for a reference")
+
+	err := r.reconcileAccess(ctx, newAccessTestIngress(annotations, "app.example.com", "web.example.com"), newDNSTestConfig())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessApplication internal does not protect web.example.com")
+}
+
+func TestReconcileAccess_InvalidAnnotations(t *testing.T) {
+	r, _, _ := newAccessTestReconciler(t)
+	ingress := newAccessTestIngress(map[string]string{
+		AnnotationAccessApplication: "internal",
+		AnnotationAccessPolicies:    "employees",
+	}, "app.example.com")
+
+	err := r.reconcileAccess(context.Background(), ingress, newDNSTestConfig())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mutually exclusive")
+	assert.Empty(t, accessApplications(t, r.Client))
+}
+
+func TestAccessApplicationName_LongHostnames(t *testing.T) {
+	prefix := "a-very-long-subdomain-name-that-is-shared-by-several-hosts"
+	first := tunnelpkg.AccessApplicationName("app", prefix+"-first.example.com")
+	second := tunnelpkg.AccessApplicationName("app", prefix+"-second.example.com")
+
+	assert.NotEqual(t, first, second)
+	assert.LessOrEqual(t, len(first), 63)
+	assert.LessOrEqual(t, len(second), 63)
+	assert.Equal(t, "app-app-example-com", tunnelpkg.AccessApplicationName("app", "app.example.com"))
+}
+
+func TestFindIngressesForAccessApplication(t *testing.T) {
+	className := testIngressClassName
+	referencing := newAccessTestIngress(map[string]string{AnnotationAccessApplication: "internal"}, "app.example.com")
+	referencing.Spec.IngressClassName = &className
+	other := newAccessTestIngress(map[string]string{AnnotationAccessApplication: "other"}, "web.example.com")
+	other.Name = "web"
+	other.Spec.IngressClassName = &className
+	r, _, _ := newAccessTestReconciler(t, referencing, other, &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: testIngressClassName},
+		Spec:       networkingv1.IngressClassSpec{Controller: ControllerName},
+	})
+
+	requests := r.findIngressesForAccessApplication(context.Background(), &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"},
+	})
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(referencing), requests[0].NamespacedName)
+}
@@ -7,13 +7,23 @@
 package ingress
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
 )
 
 // Annotation prefix for Cloudflare-specific annotations
 const AnnotationPrefix = "cloudflare.com/"
 
+// OperatorAnnotationPrefix is the prefix for annotations owned by the operator
+const OperatorAnnotationPrefix = "cloudflare-operator.io/"
+
 // Protocol annotations
 const (
 	// AnnotationProtocol specifies the backend protocol: http, https, tcp, udp, ssh, rdp, smb, wss, ws
@@ -36,6 +46,32 @@ const (
 	AnnotationCAPool = AnnotationPrefix + "ca-pool"
 )
 
+// Origin annotations under the operator prefix. Each is an alias of a
+// cloudflare.com/ annotation; setting both names to different values is rejected.
+const (
+	// AnnotationBackendProtocol is an alias of AnnotationProtocol
+	AnnotationBackendProtocol = OperatorAnnotationPrefix + "backend-protocol"
+
+	// AnnotationOriginNoTLSVerify is an alias of AnnotationNoTLSVerify
+	AnnotationOriginNoTLSVerify = OperatorAnnotationPrefix + "no-tls-verify"
+
+	// AnnotationHostHeader is an alias of AnnotationHTTPHostHeader
+	AnnotationHostHeader = OperatorAnnotationPrefix + "host-header"
+)
+
+// annotationAliases maps an annotation to its alias under the operator prefix
+var annotationAliases = map[string]string{
+	AnnotationProtocol:       AnnotationBackendProtocol,
+	AnnotationNoTLSVerify:    AnnotationOriginNoTLSVerify,
+	AnnotationHTTPHostHeader: AnnotationHostHeader,
+}
+
+// backendProtocols are the values accepted for the backend protocol annotations
+var backendProtocols = map[string]bool{
+	"http": true, "https": true, "ws": true, "wss": true,
+	"tcp": true, "udp": true, "ssh": true, "rdp": true, "smb": true,
+}
+
 // Proxy annotations (for bastion/SOCKS mode)
 const (
 	// AnnotationProxyAddress specifies the proxy address for bastion mode
@@ -61,8 +97,19 @@ const (
 
 	// AnnotationKeepAliveConnections specifies max idle connections
 	AnnotationKeepAliveConnections = AnnotationPrefix + "keep-alive-connections"
+
+	// AnnotationTCPKeepAlive specifies the TCP keep-alive interval (e.g., "30s")
+	AnnotationTCPKeepAlive = AnnotationPrefix + "tcp-keep-alive"
+
+	// AnnotationNoHappyEyeballs disables Happy Eyeballs for IPv4/IPv6 fallback
+	AnnotationNoHappyEyeballs = AnnotationPrefix + "no-happy-eyeballs"
 )
 
+// originTimeouts are the annotations holding origin timeouts, validated against tunnelconfig.MaxOriginTimeout
+var originTimeouts = []string{
+	AnnotationConnectTimeout, AnnotationTLSTimeout, AnnotationKeepAliveTimeout, AnnotationTCPKeepAlive,
+}
+
 // Origin header settings
 const (
 	// AnnotationOriginServerName overrides the hostname used for TLS verification
@@ -81,6 +128,22 @@ const (
 	AnnotationDNSProxied = AnnotationPrefix + "dns-proxied"
 )
 
+// Access annotations. An Ingress with either annotation is protected with
+// Cloudflare Access once its hostnames are routed through the tunnel.
+const (
+	// AnnotationAccessApplication names an existing AccessApplication in the
+	// Ingress namespace that protects every hostname of the Ingress
+	AnnotationAccessApplication = OperatorAnnotationPrefix + "access-application"
+
+	// AnnotationAccessPolicies lists the AccessPolicy names (comma-separated)
+	// of the self-hosted AccessApplication created for each hostname
+	AnnotationAccessPolicies = OperatorAnnotationPrefix + "access-policies"
+
+	// AnnotationAccessDeletionPolicy is the deletion policy of the created
+	// AccessApplications: "Delete" (default) or "Orphan"
+	AnnotationAccessDeletionPolicy = OperatorAnnotationPrefix + "access-deletion-policy"
+)
+
 // Advanced settings
 const (
 	// AnnotationDisableChunkedEncoding disables chunked transfer encoding
@@ -103,16 +166,50 @@ func NewAnnotationParser(annotations map[string]string) *AnnotationParser {
 	return &AnnotationParser{annotations: annotations}
 }
 
+// lookup returns the value of an annotation, falling back to its alias
+func (p *AnnotationParser) lookup(key string) (string, bool) {
+	if v, ok := p.annotations[key]; ok {
+		return v, true
+	}
+	if alias, ok := annotationAliases[key]; ok {
+		v, found := p.annotations[alias]
+		return v, found
+	}
+	return "", false
+}
+
 // GetString returns the string value of an annotation
 func (p *AnnotationParser) GetString(key string) (string, bool) {
-	v, ok := p.annotations[key]
-	return v, ok
+	return p.lookup(key)
+}
+
+// GetProtocol returns the lower-cased backend protocol set by key or its alias.
+// An empty value counts as unset and a value outside backendProtocols is an error.
+// nolint:revive // (value, ok, err) extends the (value, ok) pattern of GetString
+func (p *AnnotationParser) GetProtocol(key string) (string, bool, error) {
+	for _, k := range []string{key, annotationAliases[key]} {
+		v, ok := p.annotations[k]
+		protocol := normalizeProtocol(v)
+		if k == "" || !ok || protocol == "" {
+			continue
+		}
+		if !backendProtocols[protocol] {
+			return "", false, fmt.Errorf("unsupported backend protocol %q in %s", v, k)
+		}
+		return protocol, true, nil
+	}
+	return "", false, nil
+}
+
+// normalizeProtocol trims and lower-cases a backend protocol value
+func normalizeProtocol(v string) string {
+	return strings.ToLower(strings.TrimSpace(v))
 }
 
 // GetBool returns the boolean value of an annotation and whether it was found
 // nolint:revive // (value, ok) pattern is standard Go idiom
 func (p *AnnotationParser) GetBool(key string) (bool, bool) {
-	v, ok := p.annotations[key]
+	v, ok := p.lookup(key)
 	if !ok {
 		return false, false
 	}
@@ -133,7 +230,7 @@ func (p *AnnotationParser) GetBoolPtr(key string) *bool {
 
 // GetInt returns the integer value of an annotation
 func (p *AnnotationParser) GetInt(key string) (int, bool) {
-	v, ok := p.annotations[key]
+	v, ok := p.lookup(key)
 	if !ok {
 		return 0, false
 	}
@@ -146,7 +243,7 @@ func (p *AnnotationParser) GetInt(key string) (int, bool) {
 
 // GetUint16 returns the uint16 value of an annotation
 func (p *AnnotationParser) GetUint16(key string) (uint16, bool) {
-	v, ok := p.annotations[key]
+	v, ok := p.lookup(key)
 	if !ok {
 		return 0, false
 	}
@@ -159,7 +256,7 @@ func (p *AnnotationParser) GetUint16(key string) (uint16, bool) {
 
 // GetDuration returns the duration value of an annotation
 func (p *AnnotationParser) GetDuration(key string) (time.Duration, bool) {
-	v, ok := p.annotations[key]
+	v, ok := p.lookup(key)
 	if !ok {
 		return 0, false
 	}
@@ -169,3 +266,103 @@ func (p *AnnotationParser) GetDuration(key string) (time.Duration, bool) {
 	}
 	return d, true
 }
+
+// ValidateOrigin checks the origin annotations for conflicts: an annotation and
+// its alias with different values, and disabling TLS verification together with
+// a CA pool or for an origin that does not use TLS. Timeouts and the number of
+// keep-alive connections must be within range. An unsupported backend protocol
+// only affects the backends it applies to and is reported by determineProtocol.
+func (p *AnnotationParser) ValidateOrigin() error {
+	var errs []error
+
+	for _, key := range []string{AnnotationProtocol, AnnotationNoTLSVerify, AnnotationHTTPHostHeader} {
+		alias := annotationAliases[key]
+		v, ok := p.annotations[key]
+		aliasValue, aliasOK := p.annotations[alias]
+		if key == AnnotationProtocol {
+			// An empty protocol is unset and protocols are case-insensitive
+			v, aliasValue = normalizeProtocol(v), normalizeProtocol(aliasValue)
+			ok, aliasOK = v != "", aliasValue != ""
+		}
+		if ok && aliasOK && v != aliasValue {
+			errs = append(errs, fmt.Errorf("annotations %s=%q and %s=%q conflict", key, v, alias, aliasValue))
+		}
+	}
+
+	protocol, hasProtocol, _ := p.GetProtocol(AnnotationProtocol)
+
+	if v, ok := p.GetString(AnnotationNoTLSVerify); ok {
+		noTLSVerify, err := strconv.ParseBool(v)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("no-tls-verify value %q is not a boolean", v))
+		case !noTLSVerify:
+		case p.has(AnnotationCAPool):
+			errs = append(errs, fmt.Errorf("no-tls-verify cannot be combined with %s", AnnotationCAPool))
+		case hasProtocol && protocol != "https" && protocol != "wss":
+			errs = append(errs, fmt.Errorf("no-tls-verify requires an https or wss backend protocol, got %q", protocol))
+		}
+	}
+
+	for _, key := range originTimeouts {
+		if v, ok := p.GetString(key); ok {
+			if err := tunnelconfig.ValidateOriginTimeout(strings.TrimPrefix(key, AnnotationPrefix), v); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if v, ok := p.GetString(AnnotationKeepAliveConnections); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("keep-alive-connections value %q is not an integer", v))
+		} else if err := tunnelconfig.ValidateKeepAliveConnections("keep-alive-connections", n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// AccessOptions returns how the Ingress is protected with Cloudflare Access, or
+// nil when it has no access annotation. Exactly one of the application and the
+// policies annotations may be set.
+func (p *AnnotationParser) AccessOptions() (*tunnelpkg.AccessOptions, error) {
+	application, hasApplication := p.GetString(AnnotationAccessApplication)
+	policies, hasPolicies := p.GetString(AnnotationAccessPolicies)
+	deletionPolicy, hasDeletionPolicy := p.GetString(AnnotationAccessDeletionPolicy)
+
+	switch {
+	case hasApplication && hasPolicies:
+		return nil, fmt.Errorf("annotations %s and %s are mutually exclusive",
+			AnnotationAccessApplication, AnnotationAccessPolicies)
+	case hasDeletionPolicy && !hasPolicies:
+		return nil, fmt.Errorf("annotation %s requires %s", AnnotationAccessDeletionPolicy, AnnotationAccessPolicies)
+	case hasDeletionPolicy && deletionPolicy != common.DeletionPolicyDelete && deletionPolicy != common.DeletionPolicyOrphan:
+		return nil, fmt.Errorf("access-deletion-policy value %q must be %s or %s",
+			deletionPolicy, common.DeletionPolicyDelete, common.DeletionPolicyOrphan)
+	case hasApplication:
+		if application == "" {
+			return nil, fmt.Errorf("annotation %s is empty", AnnotationAccessApplication)
+		}
+		return &tunnelpkg.AccessOptions{ApplicationRef: application}, nil
+	case hasPolicies:
+		opts := &tunnelpkg.AccessOptions{DeletionPolicy: deletionPolicy}
+		for policy := range strings.SplitSeq(policies, ",") {
+			if policy = strings.TrimSpace(policy); policy != "" {
+				opts.Policies = append(opts.Policies, policy)
+			}
+		}
+		if len(opts.Policies) == 0 {
+			return nil, fmt.Errorf("annotation %s lists no policies", AnnotationAccessPolicies)
+		}
+		return opts, nil
+	}
+	return nil, nil
+}
+
+// has reports whether an annotation or its alias is set
+func (p *AnnotationParser) has(key string) bool {
+	_, ok := p.lookup(key)
+	return ok
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
 )
 
 func TestAnnotationConstants(t *testing.T) {
@@ -20,6 +22,11 @@ func TestAnnotationConstants(t *testing.T) {
 	assert.Equal(t, "cloudflare.com/http2-origin", AnnotationHTTP2Origin)
 	assert.Equal(t, "cloudflare.com/ca-pool", AnnotationCAPool)
 
+	// Operator origin annotations
+	assert.Equal(t, "cloudflare-operator.io/backend-protocol", AnnotationBackendProtocol)
+	assert.Equal(t, "cloudflare-operator.io/no-tls-verify", AnnotationOriginNoTLSVerify)
+	assert.Equal(t, "cloudflare-operator.io/host-header", AnnotationHostHeader)
+
 	// Proxy annotations
 	assert.Equal(t, "cloudflare.com/proxy-address", AnnotationProxyAddress)
 	assert.Equal(t, "cloudflare.com/proxy-port", AnnotationProxyPort)
@@ -551,3 +558,198 @@ func TestAnnotationParserRealWorldScenarios(t *testing.T) {
 		assert.Equal(t, 100, keepAliveConns)
 	})
 }
+
+func TestAnnotationParserOperatorAliases(t *testing.T) {
+	parser := NewAnnotationParser(map[string]string{
+		AnnotationBackendProtocol:   "https",
+		AnnotationOriginNoTLSVerify: "true",
+		AnnotationHostHeader:        "internal.example.com",
+	})
+
+	protocol, ok := parser.GetString(AnnotationProtocol)
+	assert.True(t, ok)
+	assert.Equal(t, "https", protocol)
+
+	noTLSVerify, ok := parser.GetBool(AnnotationNoTLSVerify)
+	assert.True(t, ok)
+	assert.True(t, noTLSVerify)
+
+	hostHeader, ok := parser.GetString(AnnotationHTTPHostHeader)
+	assert.True(t, ok)
+	assert.Equal(t, "internal.example.com", hostHeader)
+
+	// The cloudflare.com/ name wins when both are set
+	parser = NewAnnotationParser(map[string]string{
+		AnnotationProtocol:        "http",
+		AnnotationBackendProtocol: "https",
+	})
+	protocol, _ = parser.GetString(AnnotationProtocol)
+	assert.Equal(t, "http", protocol)
+}
+
+func TestAnnotationParserValidateOrigin(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     string
+	}{
+		{name: "no annotations", annotations: nil},
+		{
+			name: "operator annotations",
+			annotations: map[string]string{
+				AnnotationBackendProtocol:   "https",
+				AnnotationOriginNoTLSVerify: "true",
+				AnnotationHostHeader:        "internal.example.com",
+			},
+		},
+		{
+			name:        "same value under both names",
+			annotations: map[string]string{AnnotationProtocol: "https", AnnotationBackendProtocol: "https"},
+		},
+		{
+			name:        "no-tls-verify false with ca-pool",
+			annotations: map[string]string{AnnotationOriginNoTLSVerify: "false", AnnotationCAPool: "origin-ca"},
+		},
+		{
+			name:        "no-tls-verify without protocol",
+			annotations: map[string]string{AnnotationOriginNoTLSVerify: "true"},
+		},
+		{
+			name:        "alias conflict",
+			annotations: map[string]string{AnnotationHTTPHostHeader: "a.example.com", AnnotationHostHeader: "b.example.com"},
+			wantErr:     `annotations cloudflare.com/http-host-header="a.example.com" and cloudflare-operator.io/host-header="b.example.com" conflict`,
+		},
+		{
+			name:        "protocol differing only in case",
+			annotations: map[string]string{AnnotationProtocol: "HTTPS", AnnotationBackendProtocol: "https"},
+		},
+		{
+			name:        "empty protocol next to its alias",
+			annotations: map[string]string{AnnotationProtocol: "", AnnotationBackendProtocol: "https"},
+		},
+		{
+			name:        "unsupported protocol is left to the backend",
+			annotations: map[string]string{AnnotationBackendProtocol: "grpc"},
+		},
+		{
+			name:        "invalid no-tls-verify",
+			annotations: map[string]string{AnnotationOriginNoTLSVerify: "yes please"},
+			wantErr:     `no-tls-verify value "yes please" is not a boolean`,
+		},
+		{
+			name:        "no-tls-verify with ca-pool",
+			annotations: map[string]string{AnnotationOriginNoTLSVerify: "true", AnnotationCAPool: "origin-ca"},
+			wantErr:     "no-tls-verify cannot be combined with cloudflare.com/ca-pool",
+		},
+		{
+			name:        "no-tls-verify with plain http",
+			annotations: map[string]string{AnnotationOriginNoTLSVerify: "true", AnnotationBackendProtocol: "http"},
+			wantErr:     `no-tls-verify requires an https or wss backend protocol, got "http"`,
+		},
+		{
+			name: "timeouts and keep-alive in range",
+			annotations: map[string]string{
+				AnnotationConnectTimeout:       "30s",
+				AnnotationTCPKeepAlive:         "1h",
+				AnnotationKeepAliveConnections: "1000",
+			},
+		},
+		{
+			name:        "zero timeout",
+			annotations: map[string]string{AnnotationConnectTimeout: "0s"},
+			wantErr:     "connect-timeout 0s must be greater than 0s and at most 1h0m0s",
+		},
+		{
+			name:        "timeout too long",
+			annotations: map[string]string{AnnotationKeepAliveTimeout: "90m"},
+			wantErr:     "keep-alive-timeout 90m must be greater than 0s and at most 1h0m0s",
+		},
+		{
+			name:        "invalid timeout",
+			annotations: map[string]string{AnnotationTLSTimeout: "ten seconds"},
+			wantErr:     `tls-timeout "ten seconds" is not a valid duration`,
+		},
+		{
+			name:        "keep-alive connections out of range",
+			annotations: map[string]string{AnnotationKeepAliveConnections: "0"},
+			wantErr:     "keep-alive-connections 0 must be between 1 and 1000",
+		},
+		{
+			name:        "keep-alive connections not a number",
+			annotations: map[string]string{AnnotationKeepAliveConnections: "many"},
+			wantErr:     `keep-alive-connections value "many" is not an integer`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewAnnotationParser(tt.annotations).ValidateOrigin()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAnnotationParserAccessOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        *tunnelpkg.AccessOptions
+		wantErr     string
+	}{
+		{name: "no annotations", annotations: nil},
+		{
+			name:        "application reference",
+			annotations: map[string]string{AnnotationAccessApplication: "internal"},
+			want:        &tunnelpkg.AccessOptions{ApplicationRef: "internal"},
+		},
+		{
+			name: "policies",
+			annotations: map[string]string{
+				AnnotationAccessPolicies:       "employees, contractors,",
+				AnnotationAccessDeletionPolicy: "Orphan",
+			},
+			want: &tunnelpkg.AccessOptions{Policies: []string{"employees", "contractors"}, DeletionPolicy: "Orphan"},
+		},
+		{
+			name:        "application and policies",
+			annotations: map[string]string{AnnotationAccessApplication: "internal", AnnotationAccessPolicies: "employees"},
+			wantErr:     "are mutually exclusive",
+		},
+		{
+			name:        "empty policy list",
+			annotations: map[string]string{AnnotationAccessPolicies: " , "},
+			wantErr:     "lists no policies",
+		},
+		{
+			name:        "empty application",
+			annotations: map[string]string{AnnotationAccessApplication: ""},
+			wantErr:     "cloudflare-operator.io/access-application is empty",
+		},
+		{
+			name:        "deletion policy without policies",
+			annotations: map[string]string{AnnotationAccessApplication: "internal", AnnotationAccessDeletionPolicy: "Orphan"},
+			wantErr:     "cloudflare-operator.io/access-deletion-policy requires cloudflare-operator.io/access-policies",
+		},
+		{
+			name:        "unknown deletion policy",
+			annotations: map[string]string{AnnotationAccessPolicies: "employees", AnnotationAccessDeletionPolicy: "Keep"},
+			wantErr:     `access-deletion-policy value "Keep" must be Delete or Orphan`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAnnotationParser(tt.annotations).AccessOptions()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
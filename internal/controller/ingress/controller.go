@@ -5,6 +5,7 @@ package ingress
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -17,16 +18,19 @@ import (
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
 	"github.com/StringKe/cloudflare-operator/internal/controller"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
 	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
 	"github.com/StringKe/cloudflare-operator/internal/resolver"
 )
@@ -46,6 +50,13 @@ const (
 
 	// IngressClassAnnotation is the legacy annotation for ingress class
 	IngressClassAnnotation = "kubernetes.io/ingress.class"
+
+	// DefaultIngressClassAnnotation marks an IngressClass as the default for
+	// Ingresses that do not name a class.
+	DefaultIngressClassAnnotation = networkingv1.AnnotationIsDefaultIngressClass
+
+	// ingressPredicateTimeout bounds the IngressClass lookups of the event filter
+	ingressPredicateTimeout = 5 * time.Second
 )
 
 // Reconciler reconciles standard Kubernetes Ingress resources
@@ -67,6 +78,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=tunnelingressclassconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=tunnelingressclassconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accessapplications,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile handles Ingress reconciliation
 // nolint:revive // Cognitive complexity is acceptable for a controller's main reconciliation loop
@@ -86,7 +98,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// 2. Check if this Ingress is for our controller
 	if !r.isOurIngress(ctx, ingress) {
-		return ctrl.Result{}, nil
+		return r.skipIngress(ctx, ingress)
 	}
 
 	logger.Info("Reconciling Ingress", "ingress", req.NamespacedName)
@@ -123,7 +135,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	// 6. Reconcile: aggregate all Ingresses for this tunnel and update ConfigMap
+	// 6. Refuse to route an Ingress whose Access annotations are invalid, so its
+	// hostnames are never public while they are meant to be protected. The rebuild
+	// leaves its rules out of the tunnel configuration.
+	if _, err := NewAnnotationParser(ingress.Annotations).AccessOptions(); err != nil {
+		logger.Info("Not routing Ingress with invalid Access annotations", "error", err.Error())
+		if rebuildErr := r.reconcileIngressConfig(ctx, ingress, config); rebuildErr != nil {
+			logger.Error(rebuildErr, "Failed to remove Ingress rules from tunnel config")
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, rebuildErr
+		}
+		if statusErr := r.updateIngressStatus(ctx, ingress, config, err); statusErr != nil {
+			logger.Error(statusErr, "Failed to update Ingress status after Access error")
+		}
+		// The Ingress is routed once its annotations are fixed; no requeue
+		return ctrl.Result{}, nil
+	}
+
+	// 7. Reconcile: aggregate all Ingresses for this tunnel and update ConfigMap
 	if err := r.reconcileIngressConfig(ctx, ingress, config); err != nil {
 		logger.Error(err, "Failed to reconcile Ingress config")
 		r.Recorder.Event(ingress, corev1.EventTypeWarning, "ReconcileError", cf.SanitizeErrorMessage(err))
@@ -133,19 +161,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
-	// 7. Handle DNS
-	if config.Spec.DNSManagement != networkingv1alpha2.DNSManagementManual {
-		if err := r.reconcileDNS(ctx, ingress, config); err != nil {
-			logger.Error(err, "Failed to reconcile DNS")
-			r.Recorder.Event(ingress, corev1.EventTypeWarning, "DNSError", cf.SanitizeErrorMessage(err))
-			if statusErr := r.updateIngressStatus(ctx, ingress, config, err); statusErr != nil {
-				logger.Error(statusErr, "Failed to update Ingress status after DNS error")
-			}
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	// 8. Handle DNS
+	if err := r.reconcileDNS(ctx, ingress, config); err != nil {
+		logger.Error(err, "Failed to reconcile DNS")
+		r.Recorder.Event(ingress, corev1.EventTypeWarning, "DNSError", cf.SanitizeErrorMessage(err))
+		if statusErr := r.updateIngressStatus(ctx, ingress, config, err); statusErr != nil {
+			logger.Error(statusErr, "Failed to update Ingress status after DNS error")
+		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	// 9. Protect hostnames with Access, once they are routable
+	if err := r.reconcileAccess(ctx, ingress, config); err != nil {
+		logger.Error(err, "Failed to reconcile Access")
+		r.Recorder.Event(ingress, corev1.EventTypeWarning, "AccessError", cf.SanitizeErrorMessage(err))
+		if statusErr := r.updateIngressStatus(ctx, ingress, config, err); statusErr != nil {
+			logger.Error(statusErr, "Failed to update Ingress status after Access error")
 		}
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
-	// 8. Update Ingress status
+	// 10. Update Ingress status
 	if err := r.updateIngressStatus(ctx, ingress, config, nil); err != nil {
 		logger.Error(err, "Failed to update Ingress status")
 		return ctrl.Result{}, err
@@ -157,15 +193,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
-// isOurIngress checks if this Ingress should be handled by our controller
-func (r *Reconciler) isOurIngress(ctx context.Context, ingress *networkingv1.Ingress) bool {
-	// Check spec.ingressClassName first
+// ingressClassName returns the IngressClass named by the Ingress: spec.ingressClassName,
+// or the legacy annotation. It is empty when the Ingress uses the default IngressClass.
+func ingressClassName(ingress *networkingv1.Ingress) string {
 	if ingress.Spec.IngressClassName != nil && *ingress.Spec.IngressClassName != "" {
-		return r.isOurIngressClass(ctx, *ingress.Spec.IngressClassName)
+		return *ingress.Spec.IngressClassName
 	}
+	return ingress.Annotations[IngressClassAnnotation]
+}
 
-	// Check legacy annotation
-	if className, ok := ingress.Annotations[IngressClassAnnotation]; ok && className != "" {
+// isOurIngress checks if this Ingress should be handled by our controller
+func (r *Reconciler) isOurIngress(ctx context.Context, ingress *networkingv1.Ingress) bool {
+	if className := ingressClassName(ingress); className != "" {
 		return r.isOurIngressClass(ctx, className)
 	}
 
@@ -173,6 +212,81 @@ func (r *Reconciler) isOurIngress(ctx context.Context, ingress *networkingv1.Ing
 	return r.isDefaultIngressClass(ctx)
 }
 
+// ingressPredicate passes events for Ingresses of our IngressClasses, so the
+// controller does not act on Ingresses of other ingress controllers. Ingresses
+// that still carry our finalizer also pass, so they are released after moving
+// to another IngressClass. IngressClasses are read from the manager's cache with
+// a bounded context, so a cache that is not synced yet cannot block the event
+// handler; Reconcile checks the IngressClass again.
+func (r *Reconciler) ingressPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		ingress, ok := obj.(*networkingv1.Ingress)
+		if !ok {
+			return false
+		}
+		if controllerutil.ContainsFinalizer(ingress, FinalizerName) {
+			return true
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), ingressPredicateTimeout)
+		defer cancel()
+		return r.isOurIngress(ctx, ingress)
+	})
+}
+
+// skipIngress handles an Ingress that is not for our controller. An Ingress that
+// carries our finalizer has moved to another IngressClass: its rules are removed
+// from the tunnel configurations, its DNSRecords are deleted and the finalizer is
+// released once both succeeded; until then the Ingress is requeued. Only the
+// release is recorded as a Normal event, so resyncs of other controllers'
+// Ingresses stay quiet.
+func (r *Reconciler) skipIngress(ctx context.Context, ingress *networkingv1.Ingress) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	className := ingressClassName(ingress)
+	if className == "" {
+		className = "<default>"
+	}
+	logger.V(1).Info("Skipping Ingress of another IngressClass", "ingressClass", className)
+
+	if !controllerutil.ContainsFinalizer(ingress, FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	configs, err := r.getIngressClassConfigs(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var errs []error
+	for _, config := range configs {
+		// The Ingress no longer matches the config, so the rebuild drops its rules
+		if err := r.rebuildTunnelConfig(ctx, config, ingress); err != nil {
+			logger.Error(err, "Failed to rebuild tunnel config after IngressClass change",
+				"config", config.Name)
+			errs = append(errs, fmt.Errorf("rebuild tunnel config %s: %w", config.Name, err))
+		}
+	}
+	if err := r.cleanupDNS(ctx, ingress); err != nil {
+		logger.Error(err, "Failed to delete DNSRecords after IngressClass change")
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		// Keep the finalizer so the cleanup is retried
+		r.Recorder.Event(ingress, corev1.EventTypeWarning, "CleanupWarning",
+			"Failed to release Ingress: "+cf.SanitizeErrorMessage(err))
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, ingress, func() {
+		controllerutil.RemoveFinalizer(ingress, FinalizerName)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+	logger.Info("Released Ingress moved to another IngressClass", "ingressClass", className)
+	r.Recorder.Event(ingress, corev1.EventTypeNormal, "Released",
+		fmt.Sprintf("IngressClass %s is not handled by %s", className, ControllerName))
+	return ctrl.Result{}, nil
+}
+
 // isOurIngressClass checks if the given IngressClass is controlled by us
 func (r *Reconciler) isOurIngressClass(ctx context.Context, className string) bool {
 	ingressClass := &networkingv1.IngressClass{}
@@ -193,7 +307,7 @@ func (r *Reconciler) isDefaultIngressClass(ctx context.Context) bool {
 	for _, ic := range ingressClasses.Items {
 		if ic.Spec.Controller == ControllerName {
 			if ic.Annotations != nil {
-				if ic.Annotations["ingressclass.kubernetes.io/is-default-class"] == "true" {
+				if ic.Annotations[DefaultIngressClassAnnotation] == "true" {
 					return true
 				}
 			}
@@ -226,6 +340,43 @@ func (r *Reconciler) getIngressClassConfig(ctx context.Context, ingress *network
 		return nil, fmt.Errorf("IngressClass %q not found: %w", className, err)
 	}
 
+	return r.getConfigForIngressClass(ctx, ingressClass)
+}
+
+// getIngressClassConfigs returns the TunnelIngressClassConfigs of all our IngressClasses.
+// IngressClasses whose config cannot be resolved are skipped.
+func (r *Reconciler) getIngressClassConfigs(ctx context.Context) ([]*networkingv1alpha2.TunnelIngressClassConfig, error) {
+	ingressClasses := &networkingv1.IngressClassList{}
+	if err := r.List(ctx, ingressClasses); err != nil {
+		return nil, fmt.Errorf("failed to list IngressClasses: %w", err)
+	}
+
+	var configs []*networkingv1alpha2.TunnelIngressClassConfig
+	seen := make(map[apitypes.NamespacedName]bool)
+	for i := range ingressClasses.Items {
+		if ingressClasses.Items[i].Spec.Controller != ControllerName {
+			continue
+		}
+		config, err := r.getConfigForIngressClass(ctx, &ingressClasses.Items[i])
+		if err != nil {
+			continue
+		}
+		key := apitypes.NamespacedName{Name: config.Name, Namespace: config.Namespace}
+		if !seen[key] {
+			seen[key] = true
+			configs = append(configs, config)
+		}
+	}
+	return configs, nil
+}
+
+// getConfigForIngressClass retrieves the TunnelIngressClassConfig referenced by an IngressClass.
+func (r *Reconciler) getConfigForIngressClass(
+	ctx context.Context,
+	ingressClass *networkingv1.IngressClass,
+) (*networkingv1alpha2.TunnelIngressClassConfig, error) {
+	className := ingressClass.Name
+
 	// Validate controller
 	if ingressClass.Spec.Controller != ControllerName {
 		return nil, fmt.Errorf("IngressClass %q is not managed by %s", className, ControllerName)
@@ -279,7 +430,7 @@ func (r *Reconciler) getDefaultIngressClassName(ctx context.Context) (string, er
 	for _, ic := range ingressClasses.Items {
 		if ic.Spec.Controller == ControllerName {
 			if ic.Annotations != nil {
-				if ic.Annotations["ingressclass.kubernetes.io/is-default-class"] == "true" {
+				if ic.Annotations[DefaultIngressClassAnnotation] == "true" {
 					return ic.Name, nil
 				}
 			}
@@ -327,11 +478,9 @@ func (r *Reconciler) handleDeletion(
 	logger.Info("Handling Ingress deletion")
 
 	// Clean up DNS records
-	if config.Spec.DNSManagement != networkingv1alpha2.DNSManagementManual {
-		if err := r.cleanupDNS(ctx, ingress, config); err != nil {
-			logger.Error(err, "Failed to cleanup DNS")
-			// Continue with deletion even if DNS cleanup fails
-		}
+	if err := r.cleanupDNS(ctx, ingress); err != nil {
+		logger.Error(err, "Failed to cleanup DNS")
+		// Continue with deletion even if DNS cleanup fails
 	}
 
 	// Trigger config rebuild (without this Ingress)
@@ -368,6 +517,12 @@ func (r *Reconciler) handleDeletion(
 		}
 	}
 
+	// Remove Access last, once the hostnames are no longer routed
+	if err := r.cleanupAccess(ctx, ingress); err != nil {
+		logger.Error(err, "Failed to cleanup Access")
+		// Continue with deletion; the AccessApplications are garbage collected with the Ingress
+	}
+
 	// Remove finalizer
 	if err := controller.UpdateWithConflictRetry(ctx, r.Client, ingress, func() {
 		controllerutil.RemoveFinalizer(ingress, FinalizerName)
@@ -447,6 +602,10 @@ func (r *Reconciler) rebuildTunnelConfig(
 	// In token mode, cloudflared pulls configuration from cloud automatically
 	// This is also required for AccessApplication domain validation
 	if err := r.syncTunnelConfigToAPI(ctx, tunnel, rules, config); err != nil {
+		var ruleErr *tunnelconfig.RuleValidationError
+		if errors.As(err, &ruleErr) {
+			r.setInvalidRulesCondition(ctx, config, ruleErr)
+		}
 		return fmt.Errorf("failed to sync tunnel configuration to API: %w", err)
 	}
 
@@ -487,6 +646,10 @@ func (r *Reconciler) getIngressesForConfig(
 
 		if ic.Spec.Parameters.Name == config.Name && namespace == config.Namespace {
 			matchingClassNames = append(matchingClassNames, ic.Name)
+			// Ingresses without a class use the default IngressClass
+			if ic.Annotations[DefaultIngressClassAnnotation] == "true" {
+				matchingClassNames = append(matchingClassNames, "")
+			}
 		}
 	}
 
@@ -525,7 +688,8 @@ func (r *Reconciler) getIngressesForConfig(
 	return r.filterIngressesByClass(result, matchingClassNames), nil
 }
 
-// filterIngressesByClass filters Ingresses by IngressClass names
+// filterIngressesByClass filters Ingresses by IngressClass names.
+// The empty class name matches Ingresses that do not name a class.
 // nolint:revive // Cognitive complexity for filtering logic
 func (r *Reconciler) filterIngressesByClass(ingresses []*networkingv1.Ingress, classNames []string) []*networkingv1.Ingress {
 	classNameSet := make(map[string]bool)
@@ -535,19 +699,8 @@ func (r *Reconciler) filterIngressesByClass(ingresses []*networkingv1.Ingress, c
 
 	var result []*networkingv1.Ingress
 	for _, ing := range ingresses {
-		// Check spec.ingressClassName
-		if ing.Spec.IngressClassName != nil && *ing.Spec.IngressClassName != "" {
-			if classNameSet[*ing.Spec.IngressClassName] {
-				result = append(result, ing)
-				continue
-			}
-		}
-
-		// Check legacy annotation
-		if className, ok := ing.Annotations[IngressClassAnnotation]; ok {
-			if classNameSet[className] {
-				result = append(result, ing)
-			}
+		if classNameSet[ingressClassName(ing)] {
+			result = append(result, ing)
 		}
 	}
 
@@ -673,6 +826,22 @@ func (r *Reconciler) updateConfigStatus(ctx context.Context, config *networkingv
 	})
 }
 
+// setInvalidRulesCondition marks the TunnelIngressClassConfig not ready because
+// the tunnel config rejected the rules built from its Ingresses.
+func (r *Reconciler) setInvalidRulesCondition(
+	ctx context.Context,
+	config *networkingv1alpha2.TunnelIngressClassConfig,
+	ruleErr *tunnelconfig.RuleValidationError,
+) {
+	r.Recorder.Event(config, corev1.EventTypeWarning, tunnelconfig.ReasonInvalidIngressRules, ruleErr.Error())
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, config, func() {
+		controller.SetReadyCondition(&config.Status.Conditions, metav1.ConditionFalse,
+			tunnelconfig.ReasonInvalidIngressRules, ruleErr.Error())
+	}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update TunnelIngressClassConfig status")
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Recorder = mgr.GetEventRecorderFor("cloudflare-ingress-controller")
@@ -681,7 +850,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.domainResolver = resolver.NewDomainResolver(mgr.GetClient(), ctrl.Log.WithName("ingress-domain-resolver"))
 
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&networkingv1.Ingress{}).
+		For(&networkingv1.Ingress{}, builder.WithPredicates(r.ingressPredicate())).
 		// Watch IngressClass changes
 		Watches(
 			&networkingv1.IngressClass{},
@@ -712,6 +881,16 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&networkingv1alpha2.CloudflareDomain{},
 			handler.EnqueueRequestsFromMapFunc(r.findIngressesForDomain),
 		).
+		// Write the managed TXT markers once the CNAME DNSRecords are synced, and
+		// restore DNSRecords whose spec is edited
+		Owns(&networkingv1alpha2.DNSRecord{}, builder.WithPredicates(tunnelpkg.DNSRecordChangedPredicate())).
+		// Restore generated AccessApplications that are edited or deleted
+		Owns(&networkingv1alpha2.AccessApplication{}).
+		// Check hostnames again when a referenced AccessApplication changes
+		Watches(
+			&networkingv1alpha2.AccessApplication{},
+			handler.EnqueueRequestsFromMapFunc(r.findIngressesForAccessApplication),
+		).
 		Complete(r)
 }
 
@@ -770,9 +949,11 @@ func (r *Reconciler) findIngressesForIngressClass(ctx context.Context, obj clien
 		return nil
 	}
 
+	// Ingresses without a class are included, as the class may have become
+	// or stopped being the default
 	var requests []reconcile.Request
 	for _, ing := range ingressList.Items {
-		if ing.Spec.IngressClassName != nil && *ing.Spec.IngressClassName == ingressClass.Name {
+		if className := ingressClassName(&ing); className == ingressClass.Name || className == "" {
 			requests = append(requests, reconcile.Request{
 				NamespacedName: apitypes.NamespacedName{
 					Name:      ing.Name,
@@ -942,30 +1123,8 @@ func (r *Reconciler) syncTunnelConfigToAPI(
 			Priority: tunnelconfig.PriorityIngress,
 		}
 
-		// Convert origin request config if present
-		if rule.OriginRequest.NoTLSVerify != nil ||
-			rule.OriginRequest.HTTP2Origin != nil ||
-			rule.OriginRequest.ProxyAddress != nil ||
-			rule.OriginRequest.ProxyPort != nil ||
-			rule.OriginRequest.ProxyType != nil {
-			originReq := &tunnelconfig.OriginRequestConfig{}
-			if rule.OriginRequest.NoTLSVerify != nil {
-				originReq.NoTLSVerify = *rule.OriginRequest.NoTLSVerify
-			}
-			if rule.OriginRequest.HTTP2Origin != nil {
-				originReq.HTTP2Origin = *rule.OriginRequest.HTTP2Origin
-			}
-			if rule.OriginRequest.ProxyAddress != nil {
-				originReq.ProxyAddress = *rule.OriginRequest.ProxyAddress
-			}
-			if rule.OriginRequest.ProxyPort != nil {
-				originReq.ProxyPort = int(*rule.OriginRequest.ProxyPort)
-			}
-			if rule.OriginRequest.ProxyType != nil {
-				originReq.ProxyType = *rule.OriginRequest.ProxyType
-			}
-			cfgRule.OriginRequest = originReq
-		}
+		// Convert origin request config, including TLS and Host header settings
+		cfgRule.OriginRequest = tunnelconfig.NewOriginRequestConfig(&rule.OriginRequest)
 
 		configRules = append(configRules, cfgRule)
 	}
@@ -9,13 +9,18 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 )
@@ -380,6 +385,20 @@ func TestFilterIngressesByClass(t *testing.T) {
 			classNames: []string{"testIngressClassName", "cloudflare-internal"},
 			wantCount:  2,
 		},
+		{
+			name: "ingress without class matches the default class",
+			ingresses: []*networkingv1.Ingress{
+				{ObjectMeta: metav1.ObjectMeta{Name: "ingress-1"}},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "ingress-2"},
+					Spec: networkingv1.IngressSpec{
+						IngressClassName: &otherClassName,
+					},
+				},
+			},
+			classNames: []string{"testIngressClassName", ""},
+			wantCount:  1,
+		},
 		{
 			name:       "empty ingresses",
 			ingresses:  []*networkingv1.Ingress{},
@@ -846,3 +865,175 @@ func TestHandleIngressDeletion(t *testing.T) {
 	require.NoError(t, err)
 	assert.Zero(t, result.RequeueAfter)
 }
+
+// classTestObjects returns our IngressClass, marked default when isDefault is set,
+// and an IngressClass of another controller.
+func classTestObjects(isDefault bool) []client.Object {
+	ours := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: testIngressClassName},
+		Spec:       networkingv1.IngressClassSpec{Controller: ControllerName},
+	}
+	if isDefault {
+		ours.Annotations = map[string]string{DefaultIngressClassAnnotation: "true"}
+	}
+	return []client.Object{ours, &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+		Spec:       networkingv1.IngressClassSpec{Controller: "k8s.io/ingress-nginx"},
+	}}
+}
+
+func newClassTestIngress(className string, finalizers ...string) *networkingv1.Ingress {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Finalizers: finalizers},
+	}
+	if className != "" {
+		ingress.Spec.IngressClassName = &className
+	}
+	return ingress
+}
+
+func TestIngressPredicate(t *testing.T) {
+	scheme := setupTestScheme(t)
+
+	tests := []struct {
+		name      string
+		ingress   *networkingv1.Ingress
+		isDefault bool
+		want      bool
+	}{
+		{name: "matched class", ingress: newClassTestIngress(testIngressClassName), want: true},
+		{name: "unmatched class", ingress: newClassTestIngress("nginx")},
+		{name: "no class, our class is default", ingress: newClassTestIngress(""), isDefault: true, want: true},
+		{name: "no class, no default class", ingress: newClassTestIngress("")},
+		{
+			name:    "unmatched class with our finalizer",
+			ingress: newClassTestIngress("nginx", FinalizerName),
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(classTestObjects(tt.isDefault)...).Build(),
+			}
+			p := r.ingressPredicate()
+			assert.Equal(t, tt.want, p.Create(event.CreateEvent{Object: tt.ingress}))
+			assert.Equal(t, tt.want, p.Update(event.UpdateEvent{ObjectOld: tt.ingress, ObjectNew: tt.ingress}))
+		})
+	}
+}
+
+func TestReconcile_SkipsUnmatchedIngress(t *testing.T) {
+	scheme := setupTestScheme(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		ingress *networkingv1.Ingress
+		events  []string
+	}{
+		{name: "other controller's Ingress", ingress: newClassTestIngress("nginx")},
+		{
+			name:    "Ingress moved to another class is released",
+			ingress: newClassTestIngress("nginx", FinalizerName),
+			events: []string{
+				corev1.EventTypeNormal + " Released IngressClass nginx is not handled by " + ControllerName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(classTestObjects(false), tt.ingress)...).
+				Build()
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+
+			// A second reconcile, as on a resync, records no further events
+			for range 2 {
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(tt.ingress)})
+				require.NoError(t, err)
+			}
+
+			require.Len(t, recorder.Events, len(tt.events))
+			for _, want := range tt.events {
+				assert.Equal(t, want, <-recorder.Events)
+			}
+
+			updated := &networkingv1.Ingress{}
+			require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(tt.ingress), updated))
+			assert.Empty(t, updated.Finalizers)
+		})
+	}
+}
+
+func TestReconcile_ReleasedIngressDeletesDNSRecords(t *testing.T) {
+	scheme := setupTestScheme(t)
+	ctx := context.Background()
+
+	ingress := newClassTestIngress("nginx", FinalizerName)
+	ingress.UID = "ingress-uid"
+	dnsRecord := &networkingv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-app-example-com",
+			Namespace: "default",
+			Labels:    map[string]string{ManagedByAnnotation: ManagedByValue, ingressNameLabel: "app"},
+		},
+		Spec: networkingv1alpha2.DNSRecordSpec{Name: "app.example.com", Type: "CNAME", Content: "tunnel.cfargotunnel.com"},
+	}
+	require.NoError(t, controllerutil.SetControllerReference(ingress, dnsRecord, scheme))
+
+	failDeletes := true
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(classTestObjects(false), ingress, dnsRecord)...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if failDeletes {
+					return assert.AnError
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := &Reconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(10)}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(ingress)}
+
+	// A failed cleanup keeps the finalizer and is retried
+	result, err := r.Reconcile(ctx, req)
+	require.Error(t, err)
+	assert.NotZero(t, result.RequeueAfter)
+	updated := &networkingv1.Ingress{}
+	require.NoError(t, fakeClient.Get(ctx, req.NamespacedName, updated))
+	assert.Contains(t, updated.Finalizers, FinalizerName)
+
+	failDeletes = false
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(ctx, req.NamespacedName, updated))
+	assert.Empty(t, updated.Finalizers)
+	records := &networkingv1alpha2.DNSRecordList{}
+	require.NoError(t, fakeClient.List(ctx, records))
+	assert.Empty(t, records.Items)
+}
+
+func TestFindIngressesForIngressClass_IncludesIngressesWithoutClass(t *testing.T) {
+	scheme := setupTestScheme(t)
+	objects := append(classTestObjects(true),
+		newClassTestIngress(testIngressClassName),
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "no-class", Namespace: "default"}},
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: "default"},
+			Spec: networkingv1.IngressSpec{IngressClassName: strPtr("nginx")}},
+	)
+	r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()}
+
+	requests := r.findIngressesForIngressClass(context.Background(), objects[0])
+	var names []string
+	for _, req := range requests {
+		names = append(names, req.Name)
+	}
+	assert.ElementsMatch(t, []string{"app", "no-class"}, names)
+}
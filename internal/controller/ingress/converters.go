@@ -6,7 +6,9 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -86,6 +88,19 @@ func (r *Reconciler) convertIngressToRules(
 	var rules []cf.UnvalidatedIngressRule
 	parser := NewAnnotationParser(ing.Annotations)
 
+	// Conflicting origin annotations or invalid Access annotations leave the Ingress
+	// out of the tunnel; a hostname meant to be protected is never routed unprotected
+	err := parser.ValidateOrigin()
+	if err == nil {
+		_, err = parser.AccessOptions()
+	}
+	if err != nil {
+		log.FromContext(ctx).Info("Skipping Ingress with invalid annotations",
+			"ingress", ing.Namespace+"/"+ing.Name, "error", err.Error())
+		r.Recorder.Event(ing, corev1.EventTypeWarning, "InvalidAnnotations", err.Error())
+		return nil
+	}
+
 	// Build a set of TLS hosts for automatic HTTPS detection
 	tlsHosts := make(map[string]string) // hostname -> secretName
 	for _, tls := range ing.Spec.TLS {
@@ -100,7 +115,15 @@ func (r *Reconciler) convertIngressToRules(
 		}
 
 		for _, path := range rule.HTTP.Paths {
-			ingressRule := r.buildRuleFromIngressPath(ctx, ing, rule.Host, path, config, parser, tlsHosts)
+			ingressRule, err := r.buildRuleFromIngressPath(ctx, ing, rule.Host, path, config, parser, tlsHosts)
+			if err != nil {
+				// Only this backend is left out; the other paths are still routed
+				log.FromContext(ctx).Info("Skipping Ingress backend with invalid protocol",
+					"ingress", ing.Namespace+"/"+ing.Name, "host", rule.Host, "path", path.Path, "error", err.Error())
+				r.Recorder.Eventf(ing, corev1.EventTypeWarning, "InvalidAnnotations",
+					"Backend %s%s: %s", rule.Host, path.Path, err.Error())
+				continue
+			}
 			rules = append(rules, ingressRule)
 		}
 	}
@@ -108,7 +131,8 @@ func (r *Reconciler) convertIngressToRules(
 	return rules
 }
 
-// buildRuleFromIngressPath creates a cloudflared ingress rule from Kubernetes Ingress path
+// buildRuleFromIngressPath creates a cloudflared ingress rule from Kubernetes Ingress path.
+// It fails when a protocol annotation that applies to the backend is not supported.
 func (r *Reconciler) buildRuleFromIngressPath(
 	ctx context.Context,
 	ing *networkingv1.Ingress,
@@ -117,9 +141,12 @@ func (r *Reconciler) buildRuleFromIngressPath(
 	config *networkingv1alpha2.TunnelIngressClassConfig,
 	parser *AnnotationParser,
 	tlsHosts map[string]string,
-) cf.UnvalidatedIngressRule {
+) (cf.UnvalidatedIngressRule, error) {
 	// Determine service target with multi-level protocol detection
-	target := r.resolveIngressBackend(ctx, ing.Namespace, path.Backend, parser, config)
+	target, err := r.resolveIngressBackend(ctx, ing.Namespace, path.Backend, parser, config)
+	if err != nil {
+		return cf.UnvalidatedIngressRule{}, err
+	}
 
 	// Build origin request from annotations + defaults
 	originRequest := r.buildOriginRequest(parser, config.Spec.DefaultOriginRequest, tlsHosts, host)
@@ -132,16 +159,20 @@ func (r *Reconciler) buildRuleFromIngressPath(
 		Path:          pathStr,
 		Service:       target,
 		OriginRequest: originRequest,
-	}
+	}, nil
 }
 
-// convertPathType converts Kubernetes PathType to cloudflared path regex
-// nolint:revive // Cognitive complexity for path conversion logic
+// convertPathType converts an Ingress path and pathType to a cloudflared path regex.
+// cloudflared matches the regex anywhere in the request path, so Exact and Prefix
+// paths are anchored and their regex metacharacters escaped:
+//   - Exact matches the path only: /foo matches /foo but not /foo/
+//   - Prefix matches whole path elements: /foo matches /foo, /foo/ and /foo/bar but
+//     not /foobar, and a trailing slash is ignored. Prefix / matches every path and
+//     yields no path, so the rule covers the whole host.
+//   - ImplementationSpecific passes the path through as a regex; / covers the whole host.
+//
+// A missing pathType is treated as Prefix.
 func convertPathType(path string, pathType *networkingv1.PathType) string {
-	if path == "" || path == "/" {
-		return ""
-	}
-
 	pt := networkingv1.PathTypePrefix
 	if pathType != nil {
 		pt = *pathType
@@ -149,23 +180,21 @@ func convertPathType(path string, pathType *networkingv1.PathType) string {
 
 	switch pt {
 	case networkingv1.PathTypeExact:
-		// Exact match
-		return "^" + path + "$"
-	case networkingv1.PathTypePrefix:
-		// Prefix match - cloudflared uses regex
-		// /foo should match /foo, /foo/, /foo/bar
-		if path[len(path)-1] == '/' {
-			return path + ".*"
-		}
-		return path + "(/.*)?$"
+		if path == "" {
+			path = "/"
+		}
+		return "^" + regexp.QuoteMeta(path) + "$"
 	case networkingv1.PathTypeImplementationSpecific:
-		// Treat as prefix
-		if path[len(path)-1] == '/' {
-			return path + ".*"
+		if path == "/" {
+			return ""
 		}
-		return path + "(/.*)?$"
-	default:
 		return path
+	default:
+		trimmed := strings.TrimRight(path, "/")
+		if trimmed == "" {
+			return ""
+		}
+		return "^" + regexp.QuoteMeta(trimmed) + "(/.*)?$"
 	}
 }
 
@@ -186,18 +215,21 @@ func (r *Reconciler) resolveIngressBackend(
 	backend networkingv1.IngressBackend,
 	parser *AnnotationParser,
 	config *networkingv1alpha2.TunnelIngressClassConfig,
-) string {
+) (string, error) {
 	if backend.Service == nil {
-		return "http_status:503"
+		return "http_status:503", nil
 	}
 
 	// Get Service info including port, annotations, appProtocol
 	svcInfo := r.getServiceInfo(ctx, namespace, backend.Service)
 
 	// Determine protocol using multi-level detection
-	protocol := r.determineProtocol(parser, svcInfo, config)
+	protocol, err := r.determineProtocol(parser, svcInfo, config)
+	if err != nil {
+		return "", err
+	}
 
-	return fmt.Sprintf("%s://%s.%s.svc:%s", protocol, backend.Service.Name, namespace, svcInfo.Port)
+	return fmt.Sprintf("%s://%s.%s.svc:%s", protocol, backend.Service.Name, namespace, svcInfo.Port), nil
 }
 
 // getServiceInfo retrieves Service information for protocol detection
@@ -271,49 +303,53 @@ func (r *Reconciler) getServiceInfo(ctx context.Context, namespace string, svcBa
 // 6. TunnelIngressClassConfig defaultProtocol
 // 7. Port number inference (443→https, 22→ssh, others→http)
 //
+// Annotation values are case-insensitive and an empty value is skipped. An
+// unsupported value in an annotation that applies to the backend is an error.
+//
 // nolint:revive // cyclomatic complexity is acceptable for multi-level detection
 func (*Reconciler) determineProtocol(
 	ingressParser *AnnotationParser,
 	svcInfo ServiceInfo,
 	config *networkingv1alpha2.TunnelIngressClassConfig,
-) string {
-	// 1. Check Ingress annotation: cloudflare.com/protocol
-	if protocol, ok := ingressParser.GetString(AnnotationProtocol); ok && protocol != "" {
-		return protocol
-	}
-
-	// 2. Check Ingress annotation: cloudflare.com/protocol-{port}
-	portSpecificKey := AnnotationProtocolPrefix + svcInfo.Port
-	if protocol, ok := ingressParser.GetString(portSpecificKey); ok && protocol != "" {
-		return protocol
-	}
-
-	// 3. Check Service annotation: cloudflare.com/protocol
-	if svcInfo.Annotations != nil {
-		if protocol, ok := svcInfo.Annotations[AnnotationProtocol]; ok && protocol != "" {
-			return protocol
+) (string, error) {
+	// 1-3. Ingress annotation cloudflare.com/protocol or cloudflare-operator.io/backend-protocol,
+	// then Ingress annotation cloudflare.com/protocol-{port}, then Service annotation cloudflare.com/protocol
+	for _, source := range []struct {
+		parser *AnnotationParser
+		key    string
+	}{
+		{ingressParser, AnnotationProtocol},
+		{ingressParser, AnnotationProtocolPrefix + svcInfo.Port},
+		{NewAnnotationParser(svcInfo.Annotations), AnnotationProtocol},
+	} {
+		protocol, ok, err := source.parser.GetProtocol(source.key)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return protocol, nil
 		}
 	}
 
 	// 4. Check Service port appProtocol field (Kubernetes native)
 	if svcInfo.AppProtocol != nil && *svcInfo.AppProtocol != "" {
-		return inferProtocolFromAppProtocol(*svcInfo.AppProtocol)
+		return inferProtocolFromAppProtocol(*svcInfo.AppProtocol), nil
 	}
 
 	// 5. Check Service port name
 	if svcInfo.PortName != "" {
 		if protocol := inferProtocolFromPortName(svcInfo.PortName); protocol != "" {
-			return protocol
+			return protocol, nil
 		}
 	}
 
 	// 6. Check TunnelIngressClassConfig defaultProtocol
 	if config != nil && config.Spec.DefaultProtocol != "" {
-		return string(config.Spec.DefaultProtocol)
+		return string(config.Spec.DefaultProtocol), nil
 	}
 
 	// 7. Fall back to port number inference
-	return inferProtocolFromPort(svcInfo.Port)
+	return inferProtocolFromPort(svcInfo.Port), nil
 }
 
 // inferProtocolFromAppProtocol converts Kubernetes appProtocol to tunnel protocol
@@ -494,6 +530,12 @@ func (*Reconciler) buildOriginRequest(
 		config.KeepAliveConnections = &n
 	}
 
+	if d, ok := parser.GetDuration(AnnotationTCPKeepAlive); ok {
+		config.TCPKeepAlive = &d
+	}
+
+	config.NoHappyEyeballs = parser.GetBoolPtr(AnnotationNoHappyEyeballs)
+
 	if v, ok := parser.GetString(AnnotationOriginServerName); ok {
 		config.OriginServerName = &v
 	}
@@ -558,6 +600,9 @@ func (*Reconciler) convertTunnelBindingToRules(binding networkingv1alpha1.Tunnel
 			caPath := fmt.Sprintf("/etc/cloudflared/certs/%s", subject.Spec.CaPool)
 			originRequest.CAPool = &caPath
 		}
+		if origin := subject.Spec.OriginRequest; origin != nil {
+			applyBindingOriginRequest(&originRequest, origin)
+		}
 
 		rules = append(rules, cf.UnvalidatedIngressRule{
 			Hostname:      svcStatus.Hostname,
@@ -569,3 +614,29 @@ func (*Reconciler) convertTunnelBindingToRules(binding networkingv1alpha1.Tunnel
 
 	return rules
 }
+
+// applyBindingOriginRequest copies the connection settings of a TunnelBinding subject into config.
+// The durations were validated by the TunnelBinding controller.
+func applyBindingOriginRequest(config *cf.OriginRequestConfig, origin *networkingv1alpha1.TunnelBindingOriginRequest) {
+	durations := []struct {
+		value string
+		field **time.Duration
+	}{
+		{origin.ConnectTimeout, &config.ConnectTimeout},
+		{origin.TLSTimeout, &config.TLSTimeout},
+		{origin.TCPKeepAlive, &config.TCPKeepAlive},
+		{origin.KeepAliveTimeout, &config.KeepAliveTimeout},
+	}
+	for _, duration := range durations {
+		if d, err := time.ParseDuration(duration.value); err == nil {
+			*duration.field = &d
+		}
+	}
+	if origin.KeepAliveConnections != nil {
+		n := *origin.KeepAliveConnections
+		config.KeepAliveConnections = &n
+	}
+	if origin.NoHappyEyeballs {
+		config.NoHappyEyeballs = &origin.NoHappyEyeballs
+	}
+}
@@ -4,15 +4,23 @@
 package ingress
 
 import (
+	"context"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
 )
 
 func TestConvertPathType(t *testing.T) {
@@ -33,7 +41,7 @@ func TestConvertPathType(t *testing.T) {
 			want:     "",
 		},
 		{
-			name:     "root path",
+			name:     "root path prefix is a catch-all for the host",
 			path:     "/",
 			pathType: &prefixType,
 			want:     "",
@@ -42,13 +50,19 @@ func TestConvertPathType(t *testing.T) {
 			name:     "prefix type simple",
 			path:     "/api",
 			pathType: &prefixType,
-			want:     "/api(/.*)?$",
+			want:     "^/api(/.*)?$",
 		},
 		{
 			name:     "prefix type with trailing slash",
 			path:     "/api/",
 			pathType: &prefixType,
-			want:     "/api/.*",
+			want:     "^/api(/.*)?$",
+		},
+		{
+			name:     "prefix escapes regex metacharacters",
+			path:     "/v1.0/files+",
+			pathType: &prefixType,
+			want:     `^/v1\.0/files\+(/.*)?$`,
 		},
 		{
 			name:     "exact type",
@@ -57,28 +71,40 @@ func TestConvertPathType(t *testing.T) {
 			want:     "^/api/users$",
 		},
 		{
-			name:     "implementation specific without trailing slash",
-			path:     "/static",
+			name:     "exact root path",
+			path:     "/",
+			pathType: &exactType,
+			want:     "^/$",
+		},
+		{
+			name:     "exact escapes regex metacharacters",
+			path:     "/robots.txt",
+			pathType: &exactType,
+			want:     `^/robots\.txt$`,
+		},
+		{
+			name:     "implementation specific passes the regex through",
+			path:     "/static/.*\\.css",
 			pathType: &implType,
-			want:     "/static(/.*)?$",
+			want:     "/static/.*\\.css",
 		},
 		{
-			name:     "implementation specific with trailing slash",
-			path:     "/static/",
+			name:     "implementation specific root path",
+			path:     "/",
 			pathType: &implType,
-			want:     "/static/.*",
+			want:     "",
 		},
 		{
 			name:     "nil pathType defaults to prefix",
 			path:     "/default",
 			pathType: nil,
-			want:     "/default(/.*)?$",
+			want:     "^/default(/.*)?$",
 		},
 		{
 			name:     "deep path prefix",
 			path:     "/api/v1/users",
 			pathType: &prefixType,
-			want:     "/api/v1/users(/.*)?$",
+			want:     "^/api/v1/users(/.*)?$",
 		},
 		{
 			name:     "deep path exact",
@@ -96,6 +122,46 @@ func TestConvertPathType(t *testing.T) {
 	}
 }
 
+func TestConvertPathType_Matching(t *testing.T) {
+	prefixType := networkingv1.PathTypePrefix
+	exactType := networkingv1.PathTypeExact
+
+	tests := []struct {
+		name      string
+		path      string
+		pathType  *networkingv1.PathType
+		matches   []string
+		noMatches []string
+	}{
+		{
+			name:      "prefix matches whole path elements",
+			path:      "/foo",
+			pathType:  &prefixType,
+			matches:   []string{"/foo", "/foo/", "/foo/bar"},
+			noMatches: []string{"/foobar", "/bar/foo"},
+		},
+		{
+			name:      "exact matches the path only",
+			path:      "/foo",
+			pathType:  &exactType,
+			matches:   []string{"/foo"},
+			noMatches: []string{"/foo/", "/foo/bar", "/bar/foo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re := regexp.MustCompile(convertPathType(tt.path, tt.pathType))
+			for _, p := range tt.matches {
+				assert.True(t, re.MatchString(p), p)
+			}
+			for _, p := range tt.noMatches {
+				assert.False(t, re.MatchString(p), p)
+			}
+		})
+	}
+}
+
 func TestInferProtocolFromPort(t *testing.T) {
 	tests := []struct {
 		port string
@@ -130,6 +196,7 @@ func TestDetermineProtocol(t *testing.T) {
 		svcInfo           ServiceInfo
 		defaultProtocol   *networkingv1alpha2.ProtocolType
 		want              string
+		wantErr           string
 	}{
 		{
 			name:              "1. Ingress annotation cloudflare.com/protocol takes highest priority",
@@ -243,6 +310,30 @@ func TestDetermineProtocol(t *testing.T) {
 			defaultProtocol: &httpsProtocol,
 			want:            "tcp",
 		},
+		{
+			name:              "Annotation values are case-insensitive",
+			ingressAnnotation: map[string]string{AnnotationBackendProtocol: " HTTPS "},
+			svcInfo:           ServiceInfo{Port: "8080"},
+			want:              "https",
+		},
+		{
+			name:              "Empty annotation is unset",
+			ingressAnnotation: map[string]string{AnnotationProtocol: ""},
+			svcInfo: ServiceInfo{
+				Port:        "8080",
+				Annotations: map[string]string{AnnotationProtocol: "wss"},
+			},
+			want: "wss",
+		},
+		{
+			name:              "Unsupported Service annotation",
+			ingressAnnotation: map[string]string{},
+			svcInfo: ServiceInfo{
+				Port:        "8080",
+				Annotations: map[string]string{AnnotationProtocol: "grpc"},
+			},
+			wantErr: `unsupported backend protocol "grpc" in cloudflare.com/protocol`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -259,7 +350,12 @@ func TestDetermineProtocol(t *testing.T) {
 				}
 			}
 
-			got := r.determineProtocol(parser, tt.svcInfo, config)
+			got, err := r.determineProtocol(parser, tt.svcInfo, config)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -520,6 +616,12 @@ func TestConvertTunnelBindingToRules(t *testing.T) {
 				Spec: networkingv1alpha1.TunnelBindingSubjectSpec{
 					Target: "http://custom-target:9000",
 					Path:   "/custom",
+					OriginRequest: &networkingv1alpha1.TunnelBindingOriginRequest{
+						ConnectTimeout:       "5s",
+						TCPKeepAlive:         "15s",
+						KeepAliveConnections: ptr.To(20),
+						NoHappyEyeballs:      true,
+					},
 				},
 			},
 		},
@@ -562,6 +664,12 @@ func TestConvertTunnelBindingToRules(t *testing.T) {
 	assert.Equal(t, "custom.example.com", rules[1].Hostname)
 	assert.Equal(t, "/custom", rules[1].Path)
 	assert.Equal(t, "http://custom-target:9000", rules[1].Service)
+	assert.Equal(t, &tunnelconfig.OriginRequestConfig{
+		ConnectTimeout:       "5s",
+		TCPKeepAlive:         "15s",
+		KeepAliveConnections: 20,
+		NoHappyEyeballs:      true,
+	}, tunnelconfig.NewOriginRequestConfig(&rules[1].OriginRequest))
 }
 
 // nolint:staticcheck // TunnelBinding is deprecated but still tested for backward compatibility
@@ -614,3 +722,170 @@ func TestConvertTunnelBindingToRules_SubjectWithoutStatus(t *testing.T) {
 	require.Len(t, rules, 1)
 	assert.Equal(t, "service.example.com", rules[0].Hostname)
 }
+
+func newAnnotatedIngress(annotations map[string]string) *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Annotations: annotations},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: "app.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: "app",
+							Port: networkingv1.ServiceBackendPort{Number: 8443},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+}
+
+func TestConvertIngressToRules_OriginAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantScheme  string
+		wantOrigin  tunnelconfig.OriginRequestConfig
+	}{
+		{
+			name:        "backend-protocol",
+			annotations: map[string]string{AnnotationBackendProtocol: "https"},
+			wantScheme:  "https://",
+		},
+		{
+			name:        "no-tls-verify",
+			annotations: map[string]string{AnnotationBackendProtocol: "https", AnnotationOriginNoTLSVerify: "true"},
+			wantScheme:  "https://",
+			wantOrigin:  tunnelconfig.OriginRequestConfig{NoTLSVerify: true},
+		},
+		{
+			name:        "host-header",
+			annotations: map[string]string{AnnotationHostHeader: "internal.example.com"},
+			wantScheme:  "http://",
+			wantOrigin:  tunnelconfig.OriginRequestConfig{HTTPHostHeader: "internal.example.com"},
+		},
+		{
+			name: "origin server name and CA pool",
+			annotations: map[string]string{
+				AnnotationBackendProtocol:  "https",
+				AnnotationOriginServerName: "app.internal",
+				AnnotationCAPool:           "origin-ca",
+			},
+			wantScheme: "https://",
+			wantOrigin: tunnelconfig.OriginRequestConfig{
+				OriginServerName: "app.internal",
+				CAPool:           "/etc/cloudflared/certs/origin-ca",
+			},
+		},
+		{
+			name: "timeouts and keep-alive",
+			annotations: map[string]string{
+				AnnotationConnectTimeout:       "5s",
+				AnnotationTLSTimeout:           "3s",
+				AnnotationTCPKeepAlive:         "15s",
+				AnnotationKeepAliveTimeout:     "2m",
+				AnnotationKeepAliveConnections: "50",
+				AnnotationNoHappyEyeballs:      "true",
+			},
+			wantScheme: "http://",
+			wantOrigin: tunnelconfig.OriginRequestConfig{
+				ConnectTimeout:       "5s",
+				TLSTimeout:           "3s",
+				TCPKeepAlive:         "15s",
+				KeepAliveTimeout:     "2m0s",
+				KeepAliveConnections: 50,
+				NoHappyEyeballs:      true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{
+				Client:   fake.NewClientBuilder().WithScheme(setupTestScheme(t)).Build(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+			config := &networkingv1alpha2.TunnelIngressClassConfig{}
+
+			rules := r.convertIngressToRules(context.Background(), newAnnotatedIngress(tt.annotations), config)
+			require.Len(t, rules, 1)
+			assert.Regexp(t, "^"+regexp.QuoteMeta(tt.wantScheme), rules[0].Service)
+
+			// The origin settings reach the rule written to the tunnel ConfigMap
+			assert.Equal(t, &tt.wantOrigin, tunnelconfig.NewOriginRequestConfig(&rules[0].OriginRequest))
+		})
+	}
+}
+
+func TestConvertIngressToRules_ConflictingAnnotations(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithScheme(setupTestScheme(t)).Build(),
+		Recorder: recorder,
+	}
+	ing := newAnnotatedIngress(map[string]string{
+		AnnotationNoTLSVerify:       "true",
+		AnnotationOriginNoTLSVerify: "false",
+	})
+
+	rules := r.convertIngressToRules(context.Background(), ing, &networkingv1alpha2.TunnelIngressClassConfig{})
+	assert.Empty(t, rules)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Warning InvalidAnnotations annotations cloudflare.com/no-tls-verify")
+}
+
+func TestConvertIngressToRules_InvalidBackendProtocol(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	grpcService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "grpc",
+			Namespace:   "default",
+			Annotations: map[string]string{AnnotationProtocol: "grpc"},
+		},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8443}}},
+	}
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithScheme(setupTestScheme(t)).WithObjects(grpcService).Build(),
+		Recorder: recorder,
+	}
+	ing := newAnnotatedIngress(nil)
+	paths := &ing.Spec.Rules[0].HTTP.Paths
+	grpcPath := (*paths)[0]
+	grpcPath.Path = "/grpc"
+	grpcPath.Backend.Service = &networkingv1.IngressServiceBackend{
+		Name: "grpc",
+		Port: networkingv1.ServiceBackendPort{Number: 8443},
+	}
+	*paths = append(*paths, grpcPath)
+
+	// Only the backend with the unsupported protocol is left out
+	rules := r.convertIngressToRules(context.Background(), ing, &networkingv1alpha2.TunnelIngressClassConfig{})
+	require.Len(t, rules, 1)
+	assert.Equal(t, "http://app.default.svc:8443", rules[0].Service)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events,
+		`Warning InvalidAnnotations Backend app.example.com/grpc: unsupported backend protocol "grpc"`)
+}
+
+func TestConvertIngressToRules_InvalidAccessAnnotations(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithScheme(setupTestScheme(t)).Build(),
+		Recorder: recorder,
+	}
+	ing := newAnnotatedIngress(map[string]string{
+		AnnotationAccessApplication: "internal",
+		AnnotationAccessPolicies:    "employees",
+	})
+
+	// The hostname is not routed while it cannot be protected
+	rules := r.convertIngressToRules(context.Background(), ing, &networkingv1alpha2.TunnelIngressClassConfig{})
+	assert.Empty(t, rules)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Warning InvalidAnnotations")
+}
@@ -8,46 +8,46 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/controller"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
 )
 
-// reconcileDNS creates/updates DNS records for Ingress hostnames
+// ingressNameLabel records the Ingress a DNSRecord was created for
+const ingressNameLabel = "cloudflare.com/ingress-name"
+
+// reconcileDNS creates/updates DNS records for Ingress hostnames. When DNS
+// management is off for the Ingress, the records created before are deleted.
 func (r *Reconciler) reconcileDNS(ctx context.Context, ingress *networkingv1.Ingress, config *networkingv1alpha2.TunnelIngressClassConfig) error {
 	logger := log.FromContext(ctx)
 
+	if !tunnelpkg.AutoDNSEnabled(ingress, config.Spec.DNSManagement) {
+		return r.cleanupDNS(ctx, ingress)
+	}
+
 	// Check if DNS is disabled for this Ingress
 	parser := NewAnnotationParser(ingress.Annotations)
 	if disabled, ok := parser.GetBool(AnnotationDisableDNS); ok && disabled {
 		logger.Info("DNS management disabled for this Ingress via annotation")
-		return nil
-	}
-
-	// Collect all hostnames
-	hostnames := r.collectHostnames(ingress)
-	if len(hostnames) == 0 {
-		logger.Info("No hostnames found in Ingress, skipping DNS reconciliation")
-		return nil
+		return r.cleanupDNS(ctx, ingress)
 	}
 
-	switch config.Spec.DNSManagement {
-	case networkingv1alpha2.DNSManagementAutomatic:
-		return r.reconcileDNSAutomatic(ctx, ingress, hostnames, config)
-	case networkingv1alpha2.DNSManagementDNSRecord:
-		return r.reconcileDNSRecords(ctx, ingress, hostnames, config)
-	default:
-		// Manual - do nothing
-		return nil
-	}
+	// Automatic and DNSRecord modes, and Manual mode with the auto-dns
+	// annotation, all create DNSRecord CRDs; the DNSRecord controller
+	// handles the actual API calls
+	return r.reconcileDNSRecords(ctx, ingress, r.collectHostnames(ingress), config)
 }
 
 // collectHostnames extracts all unique hostnames from an Ingress
@@ -71,24 +71,14 @@ func (*Reconciler) collectHostnames(ingress *networkingv1.Ingress) []string {
 	for host := range hostnameSet {
 		hostnames = append(hostnames, host)
 	}
+	sort.Strings(hostnames)
 
 	return hostnames
 }
 
-// reconcileDNSAutomatic creates DNSRecord CRDs for automatic DNS management.
-// This replaces the old DNS Service (SyncState) approach with direct CRD creation.
-func (r *Reconciler) reconcileDNSAutomatic(
-	ctx context.Context,
-	ingress *networkingv1.Ingress,
-	hostnames []string,
-	config *networkingv1alpha2.TunnelIngressClassConfig,
-) error {
-	// Automatic mode now creates DNSRecord CRDs just like DNSRecord mode
-	// The DNSRecord controller will handle the actual API calls
-	return r.reconcileDNSRecords(ctx, ingress, hostnames, config)
-}
-
-// reconcileDNSRecords creates DNSRecord CRDs for each hostname
+// reconcileDNSRecords creates a proxied CNAME DNSRecord CRD pointing at the tunnel
+// for each hostname, together with the managed TXT marker, and removes the
+// records of hostnames the Ingress no longer has.
 // nolint:revive // Cognitive complexity for DNSRecord creation
 func (r *Reconciler) reconcileDNSRecords(ctx context.Context, ingress *networkingv1.Ingress, hostnames []string, config *networkingv1alpha2.TunnelIngressClassConfig) error {
 	logger := log.FromContext(ctx)
@@ -115,6 +105,7 @@ func (r *Reconciler) reconcileDNSRecords(ctx context.Context, ingress *networkin
 	cloudflare := tunnel.GetSpec().Cloudflare
 
 	// Create DNSRecords with error aggregation
+	keep := make(map[string]bool)
 	var errs []error
 	for _, hostname := range hostnames {
 		dnsRecord := &networkingv1alpha2.DNSRecord{
@@ -122,8 +113,8 @@ func (r *Reconciler) reconcileDNSRecords(ctx context.Context, ingress *networkin
 				Name:      r.sanitizeDNSRecordName(hostname, ingress),
 				Namespace: ingress.Namespace,
 				Labels: map[string]string{
-					ManagedByAnnotation:           ManagedByValue,
-					"cloudflare.com/ingress-name": ingress.Name,
+					ManagedByAnnotation: ManagedByValue,
+					ingressNameLabel:    ingress.Name,
 				},
 			},
 			Spec: networkingv1alpha2.DNSRecordSpec{
@@ -132,9 +123,11 @@ func (r *Reconciler) reconcileDNSRecords(ctx context.Context, ingress *networkin
 				Content:    fmt.Sprintf("%s.cfargotunnel.com", tunnelID),
 				TTL:        1, // Auto
 				Proxied:    proxied,
+				Comment:    fmt.Sprintf("Managed by Ingress %s/%s", ingress.Namespace, ingress.Name),
 				Cloudflare: cloudflare,
 			},
 		}
+		keep[dnsRecord.Name] = true
 
 		// Set owner reference for garbage collection
 		if err := ctrl.SetControllerReference(ingress, dnsRecord, r.Scheme); err != nil {
@@ -144,10 +137,34 @@ func (r *Reconciler) reconcileDNSRecords(ctx context.Context, ingress *networkin
 		}
 
 		// Create or update
-		if err := r.createOrUpdateDNSRecord(ctx, dnsRecord); err != nil {
+		recordID, err := r.createOrUpdateDNSRecord(ctx, dnsRecord)
+		if err != nil {
 			logger.Error(err, "Failed to create/update DNSRecord", "hostname", hostname)
 			errs = append(errs, fmt.Errorf("create/update DNSRecord %s: %w", hostname, err))
+			continue
 		}
+
+		// Mark the record as managed for the tunnel. The marker needs the CNAME's
+		// record ID; the owned DNSRecord requeues the Ingress once it is synced.
+		if txt := tunnelpkg.ManagedTXTRecord(dnsRecord, tunnel, recordID); txt != nil {
+			keep[txt.Name] = true
+			if recordID == "" {
+				continue
+			}
+			if _, err := r.createOrUpdateDNSRecord(ctx, txt); err != nil {
+				logger.Error(err, "Failed to create/update managed TXT DNSRecord", "hostname", hostname)
+				errs = append(errs, fmt.Errorf("create/update managed TXT DNSRecord %s: %w", hostname, err))
+			}
+		}
+	}
+
+	// Remove the records of hostnames that were removed from the Ingress
+	pruned, err := tunnelpkg.PruneDNSRecords(ctx, r.Client, ingress, r.dnsRecordLabels(ingress), keep)
+	if len(pruned) > 0 {
+		logger.Info("Removed DNSRecords of hostnames no longer in the Ingress", "hostnames", pruned)
+	}
+	if err != nil {
+		errs = append(errs, err)
 	}
 
 	if len(errs) > 0 {
@@ -156,13 +173,18 @@ func (r *Reconciler) reconcileDNSRecords(ctx context.Context, ingress *networkin
 	return nil
 }
 
+// dnsRecordLabels returns the labels of the DNSRecords created for an Ingress
+func (*Reconciler) dnsRecordLabels(ingress *networkingv1.Ingress) client.MatchingLabels {
+	return client.MatchingLabels{
+		ManagedByAnnotation: ManagedByValue,
+		ingressNameLabel:    ingress.Name,
+	}
+}
+
 // sanitizeDNSRecordName creates a valid Kubernetes resource name from hostname
 func (*Reconciler) sanitizeDNSRecordName(hostname string, ingress *networkingv1.Ingress) string {
-	// Replace dots with dashes
-	name := strings.ReplaceAll(hostname, ".", "-")
-
 	// Add ingress name prefix to avoid conflicts
-	name = fmt.Sprintf("%s-%s", ingress.Name, name)
+	name := fmt.Sprintf("%s-%s", ingress.Name, tunnelpkg.SanitizeHostname(hostname))
 
 	// Remove invalid characters
 	reg := regexp.MustCompile(`[^a-z0-9-]`)
@@ -180,8 +202,9 @@ func (*Reconciler) sanitizeDNSRecordName(hostname string, ingress *networkingv1.
 	return name
 }
 
-// createOrUpdateDNSRecord creates or updates a DNSRecord CRD
-func (r *Reconciler) createOrUpdateDNSRecord(ctx context.Context, dnsRecord *networkingv1alpha2.DNSRecord) error {
+// createOrUpdateDNSRecord creates or updates a DNSRecord CRD and returns the
+// Cloudflare record ID, once the DNSRecord controller has synced it
+func (r *Reconciler) createOrUpdateDNSRecord(ctx context.Context, dnsRecord *networkingv1alpha2.DNSRecord) (string, error) {
 	logger := log.FromContext(ctx)
 
 	// Try to get existing
@@ -195,12 +218,17 @@ func (r *Reconciler) createOrUpdateDNSRecord(ctx context.Context, dnsRecord *net
 		if apierrors.IsNotFound(err) {
 			// Create new
 			if err := r.Create(ctx, dnsRecord); err != nil {
-				return fmt.Errorf("failed to create DNSRecord: %w", err)
+				return "", fmt.Errorf("failed to create DNSRecord: %w", err)
 			}
 			logger.Info("DNSRecord created", "name", dnsRecord.Name, "hostname", dnsRecord.Spec.Name)
-			return nil
+			return "", nil
 		}
-		return err
+		return "", err
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, dnsRecord.Spec) &&
+		equality.Semantic.DeepEqual(existing.Labels, dnsRecord.Labels) {
+		return existing.Status.RecordID, nil
 	}
 
 	// Update existing
@@ -208,47 +236,22 @@ func (r *Reconciler) createOrUpdateDNSRecord(ctx context.Context, dnsRecord *net
 		existing.Spec = dnsRecord.Spec
 		existing.Labels = dnsRecord.Labels
 	}); err != nil {
-		return fmt.Errorf("failed to update DNSRecord: %w", err)
+		return "", fmt.Errorf("failed to update DNSRecord: %w", err)
 	}
 
 	logger.Info("DNSRecord updated", "name", dnsRecord.Name, "hostname", dnsRecord.Spec.Name)
-	return nil
+	return existing.Status.RecordID, nil
 }
 
-// cleanupDNS cleans up DNS records for a deleted Ingress
-func (r *Reconciler) cleanupDNS(ctx context.Context, ingress *networkingv1.Ingress, config *networkingv1alpha2.TunnelIngressClassConfig) error {
-	logger := log.FromContext(ctx)
-
-	hostnames := r.collectHostnames(ingress)
-	if len(hostnames) == 0 {
-		return nil
-	}
-
-	switch config.Spec.DNSManagement {
-	case networkingv1alpha2.DNSManagementAutomatic:
-		return r.cleanupDNSAutomatic(ctx, ingress, hostnames, config)
-	case networkingv1alpha2.DNSManagementDNSRecord:
-		// DNSRecords are cleaned up automatically via owner reference
-		logger.Info("DNSRecords will be garbage collected via owner reference")
-		return nil
-	default:
-		return nil
+// cleanupDNS deletes the DNSRecords created for an Ingress that is deleted,
+// released or no longer has DNS management. Their finalizer removes the
+// records from Cloudflare.
+func (r *Reconciler) cleanupDNS(ctx context.Context, ingress *networkingv1.Ingress) error {
+	pruned, err := tunnelpkg.PruneDNSRecords(ctx, r.Client, ingress, r.dnsRecordLabels(ingress), nil)
+	if len(pruned) > 0 {
+		log.FromContext(ctx).Info("Deleted DNSRecords of the Ingress", "hostnames", pruned)
 	}
-}
-
-// cleanupDNSAutomatic cleanup for automatic DNS mode.
-// Since automatic mode now uses DNSRecord CRDs (with owner references),
-// cleanup is handled automatically via garbage collection.
-func (r *Reconciler) cleanupDNSAutomatic(
-	ctx context.Context,
-	_ *networkingv1.Ingress,
-	_ []string,
-	_ *networkingv1alpha2.TunnelIngressClassConfig,
-) error {
-	logger := log.FromContext(ctx)
-	// DNSRecords are cleaned up automatically via owner reference
-	logger.Info("DNSRecords will be garbage collected via owner reference")
-	return nil
+	return err
 }
 
 // hostnameBelongsToDomain checks if a hostname belongs to a domain.
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
+)
+
+const dnsTestTunnelID = "6ff42ae2-765d-4adf-8112-31c55c1551ef"
+
+func newDNSTestReconciler(t *testing.T, objs ...client.Object) *Reconciler {
+	t.Helper()
+	scheme := setupTestScheme(t)
+	tunnel := &networkingv1alpha2.Tunnel{
+		ObjectMeta: metav1.ObjectMeta{Name: "tunnel", Namespace: "default"},
+		Status:     networkingv1alpha2.TunnelStatus{TunnelId: dnsTestTunnelID, TunnelName: "tunnel"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, tunnel)...).
+		WithStatusSubresource(&networkingv1alpha2.DNSRecord{}).Build()
+	return &Reconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+}
+
+func newDNSTestConfig() *networkingv1alpha2.TunnelIngressClassConfig {
+	return &networkingv1alpha2.TunnelIngressClassConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"},
+		Spec: networkingv1alpha2.TunnelIngressClassConfigSpec{
+			TunnelRef:     networkingv1alpha2.TunnelReference{Kind: "Tunnel", Name: "tunnel"},
+			DNSManagement: networkingv1alpha2.DNSManagementAutomatic,
+		},
+	}
+}
+
+func newDNSTestIngress(hosts ...string) *networkingv1.Ingress {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", UID: "ingress-uid"},
+	}
+	for _, host := range hosts {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{Host: host})
+	}
+	return ingress
+}
+
+// dnsRecordsByName returns the DNSRecords in the default namespace keyed by record name
+func dnsRecordsByName(t *testing.T, c client.Client) map[string]networkingv1alpha2.DNSRecord {
+	t.Helper()
+	list := &networkingv1alpha2.DNSRecordList{}
+	require.NoError(t, c.List(context.Background(), list, client.InNamespace("default")))
+	records := make(map[string]networkingv1alpha2.DNSRecord)
+	for _, item := range list.Items {
+		records[item.Spec.Name] = item
+	}
+	return records
+}
+
+func TestReconcileDNS_CreatesCNAMEAndManagedTXT(t *testing.T) {
+	ctx := context.Background()
+	r := newDNSTestReconciler(t)
+	ingress := newDNSTestIngress("app.example.com", "example.com", "*.example.com")
+
+	require.NoError(t, r.reconcileDNS(ctx, ingress, newDNSTestConfig()))
+
+	records := dnsRecordsByName(t, r.Client)
+	for _, host := range []string{"app.example.com", "example.com", "*.example.com"} {
+		cname, ok := records[host]
+		require.True(t, ok, "CNAME for %s", host)
+		assert.Equal(t, "CNAME", cname.Spec.Type)
+		assert.Equal(t, dnsTestTunnelID+".cfargotunnel.com", cname.Spec.Content)
+		assert.True(t, cname.Spec.Proxied)
+		assert.True(t, metav1.IsControlledBy(&cname, ingress))
+	}
+	assert.Equal(t, "app-wildcard-example-com", records["*.example.com"].Name)
+	assert.Equal(t, "app-example-com", records["example.com"].Name)
+
+	// No marker is written before the CNAMEs have a Cloudflare record ID
+	assert.Len(t, records, 3)
+
+	// The DNSRecord controller syncs the CNAMEs to Cloudflare
+	for _, name := range []string{"app-app-example-com", "app-example-com", "app-wildcard-example-com"} {
+		cname := &networkingv1alpha2.DNSRecord{}
+		require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, cname))
+		cname.Status.RecordID = "record-" + name
+		require.NoError(t, r.Status().Update(ctx, cname))
+	}
+	require.NoError(t, r.reconcileDNS(ctx, ingress, newDNSTestConfig()))
+
+	// The apex and subdomain carry the TXT marker; the wildcard cannot
+	records = dnsRecordsByName(t, r.Client)
+	txt, ok := records["_managed.example.com"]
+	require.True(t, ok)
+	assert.Equal(t, "TXT", txt.Spec.Type)
+	assert.JSONEq(t, `{"DnsId":"record-app-example-com","TunnelName":"tunnel","TunnelId":"`+dnsTestTunnelID+`"}`, txt.Spec.Content)
+	assert.Contains(t, records, "_managed.app.example.com")
+	assert.NotContains(t, records, "_managed.*.example.com")
+	assert.Len(t, records, 5)
+}
+
+func TestReconcileDNS_UpdatesMarkerAndPrunesRemovedHostnames(t *testing.T) {
+	ctx := context.Background()
+	r := newDNSTestReconciler(t)
+	config := newDNSTestConfig()
+
+	require.NoError(t, r.reconcileDNS(ctx, newDNSTestIngress("app.example.com", "old.example.com"), config))
+
+	// The DNSRecord controller syncs the CNAME to Cloudflare
+	cname := &networkingv1alpha2.DNSRecord{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "app-app-example-com"}, cname))
+	cname.Status.RecordID = "record-1"
+	require.NoError(t, r.Status().Update(ctx, cname))
+
+	require.NoError(t, r.reconcileDNS(ctx, newDNSTestIngress("app.example.com"), config))
+
+	records := dnsRecordsByName(t, r.Client)
+	assert.NotContains(t, records, "old.example.com")
+	assert.NotContains(t, records, "_managed.old.example.com")
+	assert.Contains(t, records, "app.example.com")
+	assert.Contains(t, records["_managed.app.example.com"].Spec.Content, `"DnsId":"record-1"`)
+}
+
+func TestReconcileDNS_AutoDNSAnnotation(t *testing.T) {
+	ctx := context.Background()
+	r := newDNSTestReconciler(t)
+	config := newDNSTestConfig()
+	config.Spec.DNSManagement = networkingv1alpha2.DNSManagementManual
+	ingress := newDNSTestIngress("app.example.com")
+
+	assert.False(t, tunnelpkg.AutoDNSEnabled(ingress, config.Spec.DNSManagement))
+
+	ingress.Annotations = map[string]string{tunnelpkg.AutoDNSAnnotation: "true"}
+	require.True(t, tunnelpkg.AutoDNSEnabled(ingress, config.Spec.DNSManagement))
+	require.NoError(t, r.reconcileDNS(ctx, ingress, config))
+	assert.Contains(t, dnsRecordsByName(t, r.Client), "app.example.com")
+
+	// Turning DNS management off again removes the records
+	ingress.Annotations[tunnelpkg.AutoDNSAnnotation] = "false"
+	require.NoError(t, r.reconcileDNS(ctx, ingress, config))
+	assert.Empty(t, dnsRecordsByName(t, r.Client))
+}
+
+func TestCleanupDNS_DeletesOwnedRecordsOnly(t *testing.T) {
+	ctx := context.Background()
+	foreign := &networkingv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-manual",
+			Namespace: "default",
+			Labels:    map[string]string{ManagedByAnnotation: ManagedByValue, ingressNameLabel: "app"},
+		},
+		Spec: networkingv1alpha2.DNSRecordSpec{Name: "manual.example.com", Type: "A", Content: "192.0.2.1"},
+	}
+	r := newDNSTestReconciler(t, foreign)
+	ingress := newDNSTestIngress("app.example.com")
+
+	require.NoError(t, r.reconcileDNS(ctx, ingress, newDNSTestConfig()))
+	cname := &networkingv1alpha2.DNSRecord{}
+	require.NoError(t, r.Get(ctx, client.ObjectKey{Namespace: "default", Name: "app-app-example-com"}, cname))
+	cname.Status.RecordID = "record-1"
+	require.NoError(t, r.Status().Update(ctx, cname))
+	require.NoError(t, r.reconcileDNS(ctx, ingress, newDNSTestConfig()))
+	require.Len(t, dnsRecordsByName(t, r.Client), 3)
+
+	require.NoError(t, r.cleanupDNS(ctx, ingress))
+	records := dnsRecordsByName(t, r.Client)
+	assert.Len(t, records, 1)
+	assert.Contains(t, records, "manual.example.com")
+}
@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package kvnamespace provides a controller for managing Cloudflare Workers KV namespaces.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package kvnamespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	finalizerName = "cloudflare.com/kv-namespace-finalizer"
+)
+
+// Reconciler reconciles a KVNamespace object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=kvnamespaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=kvnamespaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=kvnamespaces/finalizers,verbs=update
+
+// Reconcile handles KVNamespace reconciliation
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Get the KVNamespace resource
+	namespace := &networkingv1alpha2.KVNamespace{}
+	if err := r.Get(ctx, req.NamespacedName, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch KVNamespace")
+		return common.NoRequeue(), err
+	}
+
+	// Handle deletion
+	if !namespace.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, namespace)
+	}
+
+	// Ensure finalizer
+	if added, err := controller.EnsureFinalizer(ctx, r.Client, namespace, finalizerName); err != nil {
+		return common.NoRequeue(), err
+	} else if added {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Get API client
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CredentialsRef: namespace.Spec.CredentialsRef,
+		Namespace:      namespace.Namespace,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, namespace, err)
+	}
+
+	// Sync namespace to Cloudflare
+	return r.syncNamespace(ctx, namespace, apiResult)
+}
+
+// handleDeletion handles the deletion of KVNamespace.
+func (r *Reconciler) handleDeletion(
+	ctx context.Context,
+	namespace *networkingv1alpha2.KVNamespace,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(namespace, finalizerName) {
+		return common.NoRequeue(), nil
+	}
+
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, namespace, namespace.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CredentialsRef: namespace.Spec.CredentialsRef,
+			Namespace:      namespace.Namespace,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if namespace.Status.NamespaceID != "" {
+			// Delete namespace from Cloudflare
+			logger.Info("Deleting KV namespace from Cloudflare",
+				"title", namespace.Status.Title,
+				"namespaceId", namespace.Status.NamespaceID)
+
+			if err := apiResult.API.DeleteKVNamespace(ctx, namespace.Status.NamespaceID); err != nil {
+				logger.Error(err, "Failed to delete KV namespace from Cloudflare")
+				if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, namespace, namespace.Spec.DeletionTimeout, err); retry {
+					return result, nil
+				}
+			} else {
+				r.Recorder.Event(namespace, corev1.EventTypeNormal, "Deleted",
+					"KV namespace deleted from Cloudflare")
+			}
+		}
+	}
+
+	// Remove finalizer
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, namespace, func() {
+		controllerutil.RemoveFinalizer(namespace, finalizerName)
+	}); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return common.NoRequeue(), err
+	}
+	r.Recorder.Event(namespace, corev1.EventTypeNormal, controller.EventReasonFinalizerRemoved, "Finalizer removed")
+
+	return common.NoRequeue(), nil
+}
+
+// syncNamespace syncs the KV namespace to Cloudflare.
+func (r *Reconciler) syncNamespace(
+	ctx context.Context,
+	namespace *networkingv1alpha2.KVNamespace,
+	apiResult *common.APIClientResult,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	title := namespace.Spec.Title
+	if title == "" {
+		title = namespace.Name
+	}
+
+	// Check the namespace of the status still exists
+	if namespace.Status.NamespaceID != "" {
+		existing, err := apiResult.API.GetKVNamespace(ctx, namespace.Status.NamespaceID)
+		switch {
+		case err == nil:
+			if existing.Title != title {
+				logger.Info("Renaming KV namespace", "from", existing.Title, "to", title)
+				if err := apiResult.API.RenameKVNamespace(ctx, existing.ID, title); err != nil {
+					logger.Error(err, "Failed to rename KV namespace")
+					return r.updateStatusError(ctx, namespace, err)
+				}
+				r.Recorder.Event(namespace, corev1.EventTypeNormal, "Renamed",
+					fmt.Sprintf("KV namespace renamed from '%s' to '%s'", existing.Title, title))
+				existing.Title = title
+			}
+			return r.updateStatusReady(ctx, namespace, existing)
+		case !cf.IsNotFoundError(err):
+			logger.Error(err, "Failed to get KV namespace from Cloudflare")
+			return r.updateStatusError(ctx, namespace, err)
+		}
+		// The namespace was deleted outside the operator
+		r.Recorder.Event(namespace, corev1.EventTypeWarning, "NotFound",
+			fmt.Sprintf("KV namespace '%s' no longer exists in Cloudflare", namespace.Status.NamespaceID))
+	}
+
+	getNamespace := func(ctx context.Context) (*cf.KVNamespaceResult, error) {
+		return apiResult.API.GetKVNamespaceByTitle(ctx, title)
+	}
+	createNamespace := func(ctx context.Context) (*cf.KVNamespaceResult, error) {
+		logger.Info("Creating KV namespace in Cloudflare", "title", title)
+		return apiResult.API.CreateKVNamespace(ctx, title)
+	}
+
+	// A namespace with the title is only taken over as allowed by the adoption policy
+	adoption, err := common.Adopt(ctx, namespace.Spec.AdoptionPolicy, getNamespace, createNamespace)
+	if err != nil {
+		logger.Error(err, "Failed to adopt or create KV namespace", "title", title)
+		return r.updateStatusError(ctx, namespace, err)
+	}
+
+	if adoption.Adopted {
+		logger.Info("KV namespace already exists, adopting it", "title", title)
+		r.Recorder.Event(namespace, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing KV namespace '%s'", title))
+	} else {
+		r.Recorder.Event(namespace, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("KV namespace '%s' created in Cloudflare", title))
+	}
+
+	return r.updateStatusReady(ctx, namespace, adoption.Resource)
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	namespace *networkingv1alpha2.KVNamespace,
+	err error,
+) (ctrl.Result, error) {
+	reason := "Error"
+	var adoptionErr *common.AdoptionError
+	if errors.As(err, &adoptionErr) {
+		reason = common.ReasonAdoptionFailed
+		r.Recorder.Event(namespace, corev1.EventTypeWarning, common.ReasonAdoptionFailed, adoptionErr.Error())
+	}
+
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, namespace, func() {
+		namespace.Status.State = networkingv1alpha2.KVNamespaceStateError
+		namespace.Status.Message = cf.SanitizeErrorMessage(err)
+		meta.SetStatusCondition(&namespace.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: namespace.Generation,
+			Reason:             reason,
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		namespace.Status.ObservedGeneration = namespace.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+func (r *Reconciler) updateStatusReady(
+	ctx context.Context,
+	namespace *networkingv1alpha2.KVNamespace,
+	result *cf.KVNamespaceResult,
+) (ctrl.Result, error) {
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, namespace, func() {
+		namespace.Status.NamespaceID = result.ID
+		namespace.Status.Title = result.Title
+		namespace.Status.State = networkingv1alpha2.KVNamespaceStateReady
+		namespace.Status.Message = ""
+		meta.SetStatusCondition(&namespace.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: namespace.Generation,
+			Reason:             "Synced",
+			Message:            "KV namespace synced to Cloudflare",
+			LastTransitionTime: metav1.Now(),
+		})
+		namespace.Status.ObservedGeneration = namespace.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return common.NoRequeue(), nil
+}
+
+// findNamespacesForCredentials returns KVNamespaces that reference the given credentials
+func (r *Reconciler) findNamespacesForCredentials(ctx context.Context, obj client.Object) []reconcile.Request {
+	creds, ok := obj.(*networkingv1alpha2.CloudflareCredentials)
+	if !ok {
+		return nil
+	}
+
+	namespaceList := &networkingv1alpha2.KVNamespaceList{}
+	if err := r.List(ctx, namespaceList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, namespace := range namespaceList.Items {
+		if (namespace.Spec.CredentialsRef != nil && namespace.Spec.CredentialsRef.Name == creds.Name) ||
+			(creds.Spec.IsDefault && namespace.Spec.CredentialsRef == nil) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      namespace.Name,
+					Namespace: namespace.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("kvnamespace-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("kvnamespace"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.KVNamespace{}).
+		Watches(&networkingv1alpha2.CloudflareCredentials{},
+			handler.EnqueueRequestsFromMapFunc(r.findNamespacesForCredentials)).
+		Named("kvnamespace").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.KVNamespace{}, r))
+}
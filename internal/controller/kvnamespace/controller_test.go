@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package kvnamespace
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.KVNamespace{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newNamespace(spec networkingv1alpha2.KVNamespaceSpec) *networkingv1alpha2.KVNamespace {
+	return &networkingv1alpha2.KVNamespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "sessions",
+			Namespace:  "default",
+			Finalizers: []string{finalizerName},
+		},
+		Spec: spec,
+	}
+}
+
+// reconcileNamespace reconciles the "sessions" namespace and returns the result and the updated namespace.
+func reconcileNamespace(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.KVNamespace) {
+	t.Helper()
+	key := types.NamespacedName{Name: "sessions", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	namespace := &networkingv1alpha2.KVNamespace{}
+	require.NoError(t, c.Get(context.Background(), key, namespace))
+	return result, namespace
+}
+
+// deleteNamespace deletes the namespace and reconciles the deletion.
+func deleteNamespace(t *testing.T, r *Reconciler, c client.Client, namespace *networkingv1alpha2.KVNamespace) {
+	t.Helper()
+	require.NoError(t, c.Delete(context.Background(), namespace))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "sessions", Namespace: "default"},
+	})
+	require.NoError(t, err)
+}
+
+func TestReconcile_CreatesNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{Title: "app-sessions"}))
+
+	result, namespace := reconcileNamespace(t, r, c)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.KVNamespaceStateReady, namespace.Status.State)
+	assert.Equal(t, "app-sessions", namespace.Status.Title)
+	require.NotEmpty(t, namespace.Status.NamespaceID)
+	assert.True(t, meta.IsStatusConditionTrue(namespace.Status.Conditions, "Ready"))
+
+	stored, ok := mock.Store().GetKVNamespace(namespace.Status.NamespaceID)
+	require.True(t, ok)
+	assert.Equal(t, "app-sessions", stored.Title)
+
+	// A second reconcile changes nothing
+	_, again := reconcileNamespace(t, r, c)
+	assert.Equal(t, namespace.Status.NamespaceID, again.Status.NamespaceID)
+	assert.Len(t, mock.Store().ListKVNamespaces(), 1)
+}
+
+func TestReconcile_DefaultsTitleToResourceName(t *testing.T) {
+	newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{}))
+
+	_, namespace := reconcileNamespace(t, r, c)
+
+	assert.Equal(t, "sessions", namespace.Status.Title)
+}
+
+func TestReconcile_AdoptsExistingNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateKVNamespace(&models.KVNamespace{ID: "existing-ns", Title: "sessions"})
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{AdoptionPolicy: common.AdoptionPolicyIfExists}))
+
+	_, namespace := reconcileNamespace(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.KVNamespaceStateReady, namespace.Status.State)
+	assert.Equal(t, "existing-ns", namespace.Status.NamespaceID)
+	assert.Len(t, mock.Store().ListKVNamespaces(), 1, "no namespace is created")
+}
+
+func TestReconcile_ExistingNamespaceIsNotAdoptedByDefault(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateKVNamespace(&models.KVNamespace{ID: "existing-ns", Title: "sessions"})
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{}))
+
+	_, namespace := reconcileNamespace(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.KVNamespaceStateError, namespace.Status.State)
+	assert.Empty(t, namespace.Status.NamespaceID)
+	cond := meta.FindStatusCondition(namespace.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, common.ReasonAdoptionFailed, cond.Reason)
+	assert.Len(t, mock.Store().ListKVNamespaces(), 1, "no namespace is created")
+}
+
+func TestReconcile_RenamesNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{}))
+	_, namespace := reconcileNamespace(t, r, c)
+	id := namespace.Status.NamespaceID
+
+	namespace.Spec.Title = "user-sessions"
+	require.NoError(t, c.Update(context.Background(), namespace))
+	_, namespace = reconcileNamespace(t, r, c)
+
+	assert.Equal(t, id, namespace.Status.NamespaceID)
+	assert.Equal(t, "user-sessions", namespace.Status.Title)
+	stored, ok := mock.Store().GetKVNamespace(id)
+	require.True(t, ok)
+	assert.Equal(t, "user-sessions", stored.Title)
+}
+
+func TestReconcile_RecreatesDeletedNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{}))
+	_, namespace := reconcileNamespace(t, r, c)
+	oldID := namespace.Status.NamespaceID
+	require.True(t, mock.Store().DeleteKVNamespace(oldID))
+
+	_, namespace = reconcileNamespace(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.KVNamespaceStateReady, namespace.Status.State)
+	assert.NotEqual(t, oldID, namespace.Status.NamespaceID)
+	_, ok := mock.Store().GetKVNamespace(namespace.Status.NamespaceID)
+	assert.True(t, ok)
+}
+
+func TestReconcile_DeleteNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{}))
+	_, namespace := reconcileNamespace(t, r, c)
+	require.NotEmpty(t, namespace.Status.NamespaceID)
+
+	deleteNamespace(t, r, c, namespace)
+
+	assert.Empty(t, mock.Store().ListKVNamespaces())
+	err := c.Get(context.Background(), types.NamespacedName{Name: "sessions", Namespace: "default"}, namespace)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_DeleteAlreadyDeletedNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{}))
+	_, namespace := reconcileNamespace(t, r, c)
+	require.True(t, mock.Store().DeleteKVNamespace(namespace.Status.NamespaceID))
+
+	deleteNamespace(t, r, c, namespace)
+
+	err := c.Get(context.Background(), types.NamespacedName{Name: "sessions", Namespace: "default"}, namespace)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_OrphanKeepsNamespace(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNamespace(networkingv1alpha2.KVNamespaceSpec{DeletionPolicy: "Orphan"}))
+	_, namespace := reconcileNamespace(t, r, c)
+
+	deleteNamespace(t, r, c, namespace)
+
+	assert.Len(t, mock.Store().ListKVNamespaces(), 1)
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+// Values of the status label of the resource status gauge
+const (
+	// ResourceStatusReady means the Ready condition is True.
+	ResourceStatusReady = "Ready"
+	// ResourceStatusPending means the Ready condition is False while the
+	// resource is being reconciled, created or deleted.
+	ResourceStatusPending = "Pending"
+	// ResourceStatusError means the Ready condition is False for any other reason.
+	ResourceStatusError = "Error"
+	// ResourceStatusUnknown means the resource has no Ready condition yet, or its status is Unknown.
+	ResourceStatusUnknown = "Unknown"
+)
+
+// pendingReasons are the Ready condition reasons of a resource that is not ready yet
+// without having failed.
+var pendingReasons = map[string]bool{
+	common.ReasonReconciling: true,
+	common.ReasonCreating:    true,
+	common.ReasonDeleting:    true,
+}
+
+// resourceStatusGauge counts the resources of each kind by the status of their Ready condition.
+var resourceStatusGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "cloudflare_operator_resource_status",
+	Help: "Number of resources of each kind by the status of their Ready condition.",
+}, []string{"kind", "status"})
+
+// resourceStatuses tracks the status recorded in resourceStatusGauge for each resource.
+var resourceStatuses = newResourceStatusTracker(resourceStatusGauge)
+
+func init() {
+	metrics.Registry.MustRegister(resourceStatusGauge)
+}
+
+// resourceKey identifies a resource in a resourceStatusTracker.
+type resourceKey struct {
+	kind string
+	name types.NamespacedName
+}
+
+// resourceStatusTracker keeps a gauge of resources per kind and status. It remembers
+// the status recorded for each resource, so a resource moves between statuses and
+// leaves the gauge when it is deleted instead of being counted twice.
+type resourceStatusTracker struct {
+	gauge *prometheus.GaugeVec
+
+	mu       sync.Mutex
+	statuses map[resourceKey]string
+}
+
+func newResourceStatusTracker(gauge *prometheus.GaugeVec) *resourceStatusTracker {
+	return &resourceStatusTracker{gauge: gauge, statuses: make(map[resourceKey]string)}
+}
+
+// set records the status of a resource.
+func (t *resourceStatusTracker) set(kind string, name types.NamespacedName, status string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := resourceKey{kind: kind, name: name}
+	previous, ok := t.statuses[key]
+	if ok && previous == status {
+		return
+	}
+	if ok {
+		t.gauge.WithLabelValues(kind, previous).Dec()
+	}
+	t.gauge.WithLabelValues(kind, status).Inc()
+	t.statuses[key] = status
+}
+
+// forget removes a deleted resource from the gauge.
+func (t *resourceStatusTracker) forget(kind string, name types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := resourceKey{kind: kind, name: name}
+	if previous, ok := t.statuses[key]; ok {
+		t.gauge.WithLabelValues(kind, previous).Dec()
+		delete(t.statuses, key)
+	}
+}
+
+// ResourceStatus returns the status of obj for the resource status gauge, from the
+// Ready condition in its status.conditions.
+func ResourceStatus(obj runtime.Object) string {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return ResourceStatusUnknown
+	}
+	items, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+
+	conditions := make([]metav1.Condition, 0, len(items))
+	for _, item := range items {
+		var condition metav1.Condition
+		if m, ok := item.(map[string]any); ok &&
+			runtime.DefaultUnstructuredConverter.FromUnstructured(m, &condition) == nil {
+			conditions = append(conditions, condition)
+		}
+	}
+
+	ready := meta.FindStatusCondition(conditions, "Ready")
+	switch {
+	case ready == nil:
+		return ResourceStatusUnknown
+	case ready.Status == metav1.ConditionTrue:
+		return ResourceStatusReady
+	case ready.Status == metav1.ConditionFalse && pendingReasons[ready.Reason]:
+		return ResourceStatusPending
+	case ready.Status == metav1.ConditionFalse:
+		return ResourceStatusError
+	}
+	return ResourceStatusUnknown
+}
+
+// ObserveResourceStatus wraps the reconciler of a kind so that, at the end of each
+// reconcile, the status of the reconciled resource is recorded in the
+// cloudflare_operator_resource_status gauge. A resource that no longer exists is
+// removed from the gauge. obj is an empty object of the reconciled kind; its Go
+// type name is the kind label.
+func ObserveResourceStatus(c client.Reader, obj client.Object, r reconcile.Reconciler) reconcile.Reconciler {
+	return &resourceStatusObserver{
+		reader:     c,
+		obj:        obj,
+		kind:       reflect.TypeOf(obj).Elem().Name(),
+		reconciler: r,
+		tracker:    resourceStatuses,
+	}
+}
+
+// resourceStatusObserver records the status of each reconciled resource.
+type resourceStatusObserver struct {
+	reader     client.Reader
+	obj        client.Object
+	kind       string
+	reconciler reconcile.Reconciler
+	tracker    *resourceStatusTracker
+}
+
+// Reconcile runs the wrapped reconciler and records the resulting status of the resource.
+func (o *resourceStatusObserver) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := o.reconciler.Reconcile(ctx, req)
+
+	obj, _ := o.obj.DeepCopyObject().(client.Object)
+	if getErr := o.reader.Get(ctx, req.NamespacedName, obj); getErr != nil {
+		if apierrors.IsNotFound(getErr) {
+			o.tracker.forget(o.kind, req.NamespacedName)
+		}
+		return result, err
+	}
+	o.tracker.set(o.kind, req.NamespacedName, ResourceStatus(obj))
+	return result, err
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+func TestResourceStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		want       string
+	}{
+		{name: "no conditions", want: ResourceStatusUnknown},
+		{
+			name:       "ready",
+			conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced"}},
+			want:       ResourceStatusReady,
+		},
+		{
+			name:       "reconciling",
+			conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Reconciling"}},
+			want:       ResourceStatusPending,
+		},
+		{
+			name:       "failed",
+			conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Error"}},
+			want:       ResourceStatusError,
+		},
+		{
+			name:       "unknown",
+			conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionUnknown, Reason: "Pending"}},
+			want:       ResourceStatusUnknown,
+		},
+		{
+			name:       "other condition only",
+			conditions: []metav1.Condition{{Type: "Synced", Status: metav1.ConditionTrue, Reason: "Synced"}},
+			want:       ResourceStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &networkingv1alpha2.AccessGroup{
+				Status: networkingv1alpha2.AccessGroupStatus{Conditions: tt.conditions},
+			}
+			assert.Equal(t, tt.want, ResourceStatus(obj))
+		})
+	}
+}
+
+// readyReconciler sets the Ready condition of the reconciled resource, or deletes it when ready is nil.
+type readyReconciler struct {
+	client client.Client
+	ready  *metav1.Condition
+}
+
+func (r *readyReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	obj := &networkingv1alpha2.AccessGroup{}
+	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if r.ready == nil {
+		return reconcile.Result{}, r.client.Delete(ctx, obj)
+	}
+	obj.Status.Conditions = []metav1.Condition{*r.ready}
+	obj.Status.Conditions[0].LastTransitionTime = metav1.Now()
+	return reconcile.Result{}, r.client.Status().Update(ctx, obj)
+}
+
+func TestObserveResourceStatus_Transitions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+	groups := []*networkingv1alpha2.AccessGroup{
+		{ObjectMeta: metav1.ObjectMeta{Name: "employees"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "contractors"}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(groups[0], groups[1]).
+		WithStatusSubresource(&networkingv1alpha2.AccessGroup{}).Build()
+
+	gauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test_resource_status"}, []string{"kind", "status"})
+	inner := &readyReconciler{client: c}
+	observer := ObserveResourceStatus(c, &networkingv1alpha2.AccessGroup{}, inner).(*resourceStatusObserver)
+	observer.tracker = newResourceStatusTracker(gauge)
+
+	count := func(status string) float64 {
+		return testutil.ToFloat64(gauge.WithLabelValues("AccessGroup", status))
+	}
+	reconcileGroup := func(name string, ready *metav1.Condition) {
+		t.Helper()
+		inner.ready = ready
+		_, err := observer.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKey{Name: name}})
+		require.NoError(t, err)
+	}
+
+	reconcileGroup("employees", &metav1.Condition{Type: "Ready", Status: metav1.ConditionUnknown, Reason: "Pending"})
+	assert.Equal(t, 1.0, count(ResourceStatusUnknown))
+
+	reconcileGroup("employees", &metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Reconciling"})
+	assert.Equal(t, 0.0, count(ResourceStatusUnknown))
+	assert.Equal(t, 1.0, count(ResourceStatusPending))
+
+	reconcileGroup("employees", &metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced"})
+	reconcileGroup("contractors", &metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced"})
+	assert.Equal(t, 0.0, count(ResourceStatusPending))
+	assert.Equal(t, 2.0, count(ResourceStatusReady))
+
+	// Reconciling an unchanged resource again does not count it twice
+	reconcileGroup("contractors", &metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Synced"})
+	assert.Equal(t, 2.0, count(ResourceStatusReady))
+
+	reconcileGroup("employees", &metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Error"})
+	assert.Equal(t, 1.0, count(ResourceStatusReady))
+	assert.Equal(t, 1.0, count(ResourceStatusError))
+
+	// A deleted resource leaves the gauge
+	reconcileGroup("employees", nil)
+	assert.Equal(t, 0.0, count(ResourceStatusError))
+	assert.Equal(t, 1.0, count(ResourceStatusReady))
+
+	// A reconcile of a resource that is already gone does not decrement again
+	reconcileGroup("employees", nil)
+	assert.Equal(t, 0.0, count(ResourceStatusError))
+}
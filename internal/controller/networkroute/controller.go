@@ -8,6 +8,7 @@ package networkroute
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,6 +33,17 @@ import (
 const (
 	finalizerName = "networkroute.networking.cloudflare-operator.io/finalizer"
 	defaultVNet   = "default"
+
+	// AnnotationAllowRouteOverlap allows a route to overlap routes of other tunnels
+	// in the same virtual network when set to "true".
+	AnnotationAllowRouteOverlap = "cloudflare-operator.io/allow-route-overlap"
+
+	// ReasonRouteConflict is the Ready condition reason when the route overlaps existing routes.
+	ReasonRouteConflict = "RouteConflict"
+
+	// ReasonTunnelNotConnected is the Ready condition reason when the route is programmed
+	// but the target tunnel has no active connections.
+	ReasonTunnelNotConnected = "TunnelNotConnected"
 )
 
 // Reconciler reconciles a NetworkRoute object.
@@ -190,6 +202,20 @@ func (r *Reconciler) syncNetworkRoute(
 		Comment:          r.buildManagedComment(route),
 	}
 
+	// Overlapping routes are rejected by Cloudflare and a route with the same CIDR
+	// belongs to another tunnel, so check before creating or moving the route.
+	// The account-wide route list is only fetched when the desired route changed.
+	if !routeSynced(route, network, virtualNetworkID, tunnelID) {
+		conflicts, err := r.findRouteConflicts(ctx, route, apiResult.API, network, virtualNetworkID, tunnelID)
+		if err != nil {
+			logger.Error(err, "Failed to check NetworkRoute conflicts")
+			return r.updateStatusError(ctx, route, err)
+		}
+		if len(conflicts) > 0 {
+			return r.updateStatusConflict(ctx, route, conflicts)
+		}
+	}
+
 	// Check if route already exists
 	existing, err := apiResult.API.GetTunnelRoute(ctx, network, virtualNetworkID)
 	if err != nil && !cf.IsNotFoundError(err) {
@@ -216,14 +242,14 @@ func (r *Reconciler) syncNetworkRoute(
 			r.Recorder.Event(route, corev1.EventTypeNormal, "Updated",
 				fmt.Sprintf("NetworkRoute '%s' updated in Cloudflare", network))
 
-			return r.updateStatusReady(ctx, route, apiResult.AccountID, result, tunnelName)
+			return r.updateStatusReady(ctx, route, apiResult, result, tunnelName)
 		}
 
 		// No changes needed
 		logger.V(1).Info("NetworkRoute already exists and is up to date",
 			"network", network)
 
-		return r.updateStatusReady(ctx, route, apiResult.AccountID, existing, tunnelName)
+		return r.updateStatusReady(ctx, route, apiResult, existing, tunnelName)
 	}
 
 	// Create new route
@@ -241,7 +267,7 @@ func (r *Reconciler) syncNetworkRoute(
 					"network", network)
 				r.Recorder.Event(route, corev1.EventTypeNormal, "Adopted",
 					fmt.Sprintf("Adopted existing NetworkRoute '%s'", network))
-				return r.updateStatusReady(ctx, route, apiResult.AccountID, existing, tunnelName)
+				return r.updateStatusReady(ctx, route, apiResult, existing, tunnelName)
 			}
 		}
 		logger.Error(err, "Failed to create NetworkRoute")
@@ -251,7 +277,64 @@ func (r *Reconciler) syncNetworkRoute(
 	r.Recorder.Event(route, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("NetworkRoute '%s' created in Cloudflare", network))
 
-	return r.updateStatusReady(ctx, route, apiResult.AccountID, result, tunnelName)
+	return r.updateStatusReady(ctx, route, apiResult, result, tunnelName)
+}
+
+// routeSynced reports whether the status records the desired route as synced for the
+// current generation, so that it was already checked for conflicts.
+func routeSynced(route *networkingv1alpha2.NetworkRoute, network, virtualNetworkID, tunnelID string) bool {
+	return route.Status.ObservedGeneration == route.Generation &&
+		(route.Status.State == "active" || route.Status.State == "inactive") &&
+		route.Status.Network == network &&
+		route.Status.TunnelID == tunnelID &&
+		(virtualNetworkID == "" || route.Status.VirtualNetworkID == virtualNetworkID)
+}
+
+// findRouteConflicts returns the routes of other tunnels whose CIDR overlaps or equals the
+// desired network in the same virtual network. Routes created by this NetworkRoute are not
+// conflicts, so it can move its route to another tunnel. Overlaps are allowed with the
+// allow-route-overlap annotation.
+func (r *Reconciler) findRouteConflicts(
+	ctx context.Context,
+	route *networkingv1alpha2.NetworkRoute,
+	api *cf.API,
+	network, virtualNetworkID, tunnelID string,
+) ([]cf.TunnelRouteResult, error) {
+	logger := log.FromContext(ctx)
+
+	if route.Annotations[AnnotationAllowRouteOverlap] == "true" {
+		return nil, nil
+	}
+
+	// Routes without a virtual network use the account default
+	if virtualNetworkID == "" {
+		vnet, err := api.GetDefaultVirtualNetwork(ctx)
+		if err != nil {
+			logger.V(1).Info("Default virtual network not found, checking routes in all virtual networks",
+				"error", err.Error())
+		} else {
+			virtualNetworkID = vnet.ID
+		}
+	}
+
+	routes, err := api.ListTunnelRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overlapping, err := cf.FindOverlappingTunnelRoutes(routes, network, virtualNetworkID, tunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := controller.NewManagementInfo(route, "NetworkRoute")
+	var conflicts []cf.TunnelRouteResult
+	for _, existing := range overlapping {
+		if owner := controller.ParseManagementMarker(existing.Comment); owner != nil && owner.Equals(info) {
+			continue
+		}
+		conflicts = append(conflicts, existing)
+	}
+	return conflicts, nil
 }
 
 // resolveTunnelRef resolves the TunnelRef to get the tunnel ID.
@@ -319,7 +402,7 @@ func (r *Reconciler) updateStatusError(
 			Type:               "Ready",
 			Status:             metav1.ConditionFalse,
 			ObservedGeneration: route.Generation,
-			Reason:             "Error",
+			Reason:             common.ErrorReason(err),
 			Message:            cf.SanitizeErrorMessage(err),
 			LastTransitionTime: metav1.Now(),
 		})
@@ -333,15 +416,77 @@ func (r *Reconciler) updateStatusError(
 	return common.RequeueShort(), nil
 }
 
+// updateStatusConflict reports the routes that overlap the desired network.
+// The route is not created until the conflicting routes are removed or the overlap is allowed.
+func (r *Reconciler) updateStatusConflict(
+	ctx context.Context,
+	route *networkingv1alpha2.NetworkRoute,
+	conflicts []cf.TunnelRouteResult,
+) (ctrl.Result, error) {
+	described := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		tunnel := conflict.TunnelName
+		if tunnel == "" {
+			tunnel = conflict.TunnelID
+		}
+		described = append(described, fmt.Sprintf("%s (tunnel %s)", conflict.Network, tunnel))
+	}
+	message := fmt.Sprintf("Network %s overlaps existing routes: %s. Set annotation %s=true to allow the overlap",
+		route.Spec.Network, strings.Join(described, ", "), AnnotationAllowRouteOverlap)
+
+	log.FromContext(ctx).Info("NetworkRoute overlaps existing routes", "network", route.Spec.Network,
+		"conflicts", described)
+	r.Recorder.Event(route, corev1.EventTypeWarning, ReasonRouteConflict, message)
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, route, func() {
+		route.Status.State = "error"
+		meta.SetStatusCondition(&route.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: route.Generation,
+			Reason:             ReasonRouteConflict,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		route.Status.ObservedGeneration = route.Generation
+	})
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	// Conflicting routes may be removed outside the cluster
+	return common.RequeueLong(), nil
+}
+
+// updateStatusReady records the synced route together with the health of its tunnel.
+// The route only carries traffic while the tunnel is connected, so Ready is false
+// otherwise, and the route is requeued to refresh the tunnel health.
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	route *networkingv1alpha2.NetworkRoute,
-	accountID string,
+	apiResult *common.APIClientResult,
 	result *cf.TunnelRouteResult,
 	tunnelName string,
 ) (ctrl.Result, error) {
-	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, route, func() {
-		route.Status.AccountID = accountID
+	health, err := apiResult.API.GetTunnelHealth(ctx, result.TunnelID)
+	if err != nil {
+		if !cf.IsNotFoundError(err) {
+			log.FromContext(ctx).Error(err, "Failed to get tunnel health")
+			return r.updateStatusError(ctx, route, err)
+		}
+		health = nil
+	}
+	if health != nil && health.Deleted {
+		health = nil
+	}
+
+	condition := tunnelCondition(route, health, result.TunnelID)
+	if condition.Status == metav1.ConditionFalse && !isTunnelNotConnected(route) {
+		r.Recorder.Event(route, corev1.EventTypeWarning, ReasonTunnelNotConnected, condition.Message)
+	}
+
+	err = controller.UpdateStatusWithConflictRetry(ctx, r.Client, route, func() {
+		route.Status.AccountID = apiResult.AccountID
 		route.Status.Network = result.Network
 		route.Status.TunnelID = result.TunnelID
 		route.Status.TunnelName = tunnelName
@@ -349,15 +494,15 @@ func (r *Reconciler) updateStatusReady(
 			route.Status.TunnelName = result.TunnelName
 		}
 		route.Status.VirtualNetworkID = result.VirtualNetworkID
+		route.Status.TunnelStatus = ""
+		if health != nil {
+			route.Status.TunnelStatus = health.Status
+		}
 		route.Status.State = "active"
-		meta.SetStatusCondition(&route.Status.Conditions, metav1.Condition{
-			Type:               "Ready",
-			Status:             metav1.ConditionTrue,
-			ObservedGeneration: route.Generation,
-			Reason:             "Synced",
-			Message:            "NetworkRoute synced to Cloudflare",
-			LastTransitionTime: metav1.Now(),
-		})
+		if condition.Status == metav1.ConditionFalse {
+			route.Status.State = "inactive"
+		}
+		meta.SetStatusCondition(&route.Status.Conditions, condition)
 		route.Status.ObservedGeneration = route.Generation
 	})
 
@@ -365,7 +510,46 @@ func (r *Reconciler) updateStatusReady(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
-	return common.NoRequeue(), nil
+	if condition.Status == metav1.ConditionFalse {
+		return common.RequeueLong(), nil
+	}
+	return common.RequeueVeryLong(), nil
+}
+
+// tunnelCondition builds the Ready condition of a synced route from the health of its
+// tunnel. A nil health means the tunnel does not exist in Cloudflare or was deleted.
+func tunnelCondition(
+	route *networkingv1alpha2.NetworkRoute,
+	health *cf.TunnelHealthResult,
+	tunnelID string,
+) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: route.Generation,
+		Reason:             common.ReasonReady,
+		Message:            "NetworkRoute synced to Cloudflare",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	switch {
+	case health == nil:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonTunnelNotConnected
+		condition.Message = fmt.Sprintf("NetworkRoute synced, but tunnel %s was not found in Cloudflare", tunnelID)
+	case !health.Connected():
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonTunnelNotConnected
+		condition.Message = fmt.Sprintf("NetworkRoute synced, but tunnel %s is %s and has no active connections",
+			health.Name, health.Status)
+	}
+	return condition
+}
+
+// isTunnelNotConnected reports whether the route already reports a disconnected tunnel.
+func isTunnelNotConnected(route *networkingv1alpha2.NetworkRoute) bool {
+	ready := meta.FindStatusCondition(route.Status.Conditions, "Ready")
+	return ready != nil && ready.Reason == ReasonTunnelNotConnected
 }
 
 // findNetworkRoutesForVirtualNetwork returns reconcile requests for NetworkRoutes
@@ -462,5 +646,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.ClusterTunnel{},
 			handler.EnqueueRequestsFromMapFunc(r.findNetworkRoutesForClusterTunnel)).
 		Named("networkroute").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.NetworkRoute{}, r))
 }
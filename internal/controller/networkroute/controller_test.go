@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package networkroute
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// newMockServer starts a mock Cloudflare API with the "office" tunnel in the given health state.
+func newMockServer(t *testing.T, tunnelStatus string) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	mock.Store().CreateTunnel(&models.Tunnel{ID: "tunnel-office", Name: "office", Status: tunnelStatus})
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+		&networkingv1alpha2.ClusterTunnel{
+			ObjectMeta: metav1.ObjectMeta{Name: "office"},
+			Status:     networkingv1alpha2.TunnelStatus{TunnelId: "tunnel-office", TunnelName: "office"},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.NetworkRoute{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestRoute(network string) *networkingv1alpha2.NetworkRoute {
+	return &networkingv1alpha2.NetworkRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "office-lan",
+			Generation: 1,
+			Finalizers: []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.NetworkRouteSpec{
+			Network:   network,
+			TunnelRef: networkingv1alpha2.TunnelRef{Kind: "ClusterTunnel", Name: "office"},
+		},
+	}
+}
+
+func reconcileRoute(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.NetworkRoute) {
+	t.Helper()
+	key := types.NamespacedName{Name: "office-lan"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.NetworkRoute{}
+	require.NoError(t, c.Get(context.Background(), key, updated))
+	return result, updated
+}
+
+func TestReconcile_CreatesNonOverlappingRoute(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{Network: "10.1.0.0/16", TunnelID: "tunnel-dc"})
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	_, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, "active", updated.Status.State)
+	_, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "")
+	assert.True(t, ok)
+}
+
+func TestReconcile_ConflictCheckOnlyWhenRouteChanges(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	// countConflictChecks counts the account-wide route listings of the conflict check
+	countConflictChecks := func() int {
+		count := 0
+		for _, req := range mock.FindRequests("GET", "/teamnet/routes$") {
+			if strings.Contains(req.Query, "is_deleted=false") {
+				count++
+			}
+		}
+		return count
+	}
+
+	_, updated := reconcileRoute(t, r, c)
+	require.Equal(t, "active", updated.Status.State)
+	assert.Equal(t, 1, countConflictChecks())
+
+	// An unchanged, synced route is not checked again
+	_, updated = reconcileRoute(t, r, c)
+	assert.Equal(t, 1, countConflictChecks())
+
+	// A new network is checked before the route is created
+	updated.Spec.Network = "10.3.0.0/16"
+	updated.Generation = 2
+	require.NoError(t, c.Update(context.Background(), updated))
+	reconcileRoute(t, r, c)
+	assert.Equal(t, 2, countConflictChecks())
+}
+
+func TestReconcile_OverlappingRouteReportsConflict(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{
+		Network: "10.0.0.0/8", TunnelID: "tunnel-dc", TunnelName: "datacenter",
+	})
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	result, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, common.RequeueLong(), result)
+	assert.Equal(t, "error", updated.Status.State)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonRouteConflict, cond.Reason)
+	assert.Contains(t, cond.Message, "10.0.0.0/8 (tunnel datacenter)")
+	assert.Contains(t, cond.Message, AnnotationAllowRouteOverlap)
+
+	_, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "")
+	assert.False(t, ok, "conflicting route must not be created")
+}
+
+func TestReconcile_OverlapAllowedByAnnotation(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{Network: "10.0.0.0/8", TunnelID: "tunnel-dc"})
+	route := newTestRoute("10.2.0.0/16")
+	route.Annotations = map[string]string{AnnotationAllowRouteOverlap: "true"}
+	r, c := newTestReconciler(t, route)
+
+	_, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, "active", updated.Status.State)
+	_, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "")
+	assert.True(t, ok)
+}
+
+func TestReconcile_OverlapInOtherVirtualNetworkIsIgnored(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{
+		Network: "10.0.0.0/8", TunnelID: "tunnel-dc", VirtualNetworkID: "vnet-staging",
+	})
+	vnet := &networkingv1alpha2.VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Status:     networkingv1alpha2.VirtualNetworkStatus{VirtualNetworkId: "vnet-production"},
+	}
+	route := newTestRoute("10.2.0.0/16")
+	route.Spec.VirtualNetworkRef = &networkingv1alpha2.VirtualNetworkRef{Name: "production"}
+	r, c := newTestReconciler(t, route, vnet)
+
+	_, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, "active", updated.Status.State)
+	_, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "vnet-production")
+	assert.True(t, ok)
+}
+
+func TestReconcile_HealthyTunnelIsReady(t *testing.T) {
+	newMockServer(t, cfclient.TunnelStatusHealthy)
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	result, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, common.RequeueVeryLong(), result, "tunnel health is refreshed periodically")
+	assert.Equal(t, "active", updated.Status.State)
+	assert.Equal(t, cfclient.TunnelStatusHealthy, updated.Status.TunnelStatus)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+}
+
+func TestReconcile_DownTunnelIsNotReady(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusDown)
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	result, updated := reconcileRoute(t, r, c)
+
+	// The route is programmed, but carries no traffic until the tunnel reconnects
+	_, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "")
+	assert.True(t, ok)
+	assert.Equal(t, common.RequeueLong(), result)
+	assert.Equal(t, "inactive", updated.Status.State)
+	assert.Equal(t, cfclient.TunnelStatusDown, updated.Status.TunnelStatus)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonTunnelNotConnected, cond.Reason)
+	assert.Contains(t, cond.Message, "office is down")
+
+	// Once the tunnel reconnects, the refresh marks the route ready
+	tunnel, _ := mock.Store().GetTunnel("tunnel-office")
+	tunnel.Status = cfclient.TunnelStatusHealthy
+
+	_, updated = reconcileRoute(t, r, c)
+
+	assert.Equal(t, "active", updated.Status.State)
+	cond = meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+}
+
+func TestReconcile_MissingTunnelIsNotReady(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	mock.Store().DeleteTunnel("tunnel-office")
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	_, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, "inactive", updated.Status.State)
+	assert.Empty(t, updated.Status.TunnelStatus)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonTunnelNotConnected, cond.Reason)
+	assert.Contains(t, cond.Message, "not found")
+}
+
+func TestReconcile_SameCIDROnOtherTunnelReportsConflict(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{
+		Network: "10.2.0.0/16", TunnelID: "tunnel-dc", TunnelName: "datacenter",
+	})
+	r, c := newTestReconciler(t, newTestRoute("10.2.0.0/16"))
+
+	_, updated := reconcileRoute(t, r, c)
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonRouteConflict, cond.Reason)
+	existing, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "")
+	require.True(t, ok)
+	assert.Equal(t, "tunnel-dc", existing.TunnelID, "route of another tunnel must not be moved")
+}
+
+func TestReconcile_OwnRouteOnOtherTunnelIsMoved(t *testing.T) {
+	mock := newMockServer(t, cfclient.TunnelStatusHealthy)
+	route := newTestRoute("10.2.0.0/16")
+	r, c := newTestReconciler(t, route)
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{
+		Network: "10.2.0.0/16", TunnelID: "tunnel-dc", Comment: r.buildManagedComment(route),
+	})
+
+	_, updated := reconcileRoute(t, r, c)
+
+	assert.Equal(t, "active", updated.Status.State)
+	existing, ok := mock.Store().GetTunnelRoute("10.2.0.0/16", "")
+	require.True(t, ok)
+	assert.Equal(t, "tunnel-office", existing.TunnelID)
+}
@@ -7,10 +7,7 @@ package origincacertificate
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
@@ -170,6 +167,18 @@ func (r *Reconciler) reconcileExisting(
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	keys, err := resolveKeySpec(cert)
+	if err != nil {
+		return r.invalidKeySpec(ctx, cert, err)
+	}
+
+	// Reissue with a new key when the private key settings changed
+	if keys.algorithm != "" && cert.Status.KeyAlgorithm != "" && keys.String() != cert.Status.KeyAlgorithm {
+		logger.Info("Private key settings changed, reissuing certificate",
+			"from", cert.Status.KeyAlgorithm, "to", keys.String())
+		return r.renewCertificate(ctx, cert)
+	}
+
 	// Certificate already exists - check if renewal is needed
 	if r.shouldRenew(cert) {
 		logger.Info("Certificate needs renewal", "expiresAt", cert.Status.ExpiresAt)
@@ -195,10 +204,15 @@ func (r *Reconciler) issueCertificate(
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	keys, err := resolveKeySpec(cert)
+	if err != nil {
+		return r.invalidKeySpec(ctx, cert, err)
+	}
+
 	r.updateState(ctx, cert, networkingv1alpha2.OriginCACertificateStateIssuing, "Issuing certificate")
 
 	// Get or generate CSR
-	csr, privateKey, err := r.getOrGenerateCSR(ctx, cert)
+	csr, privateKey, err := r.getOrGenerateCSR(ctx, cert, keys)
 	if err != nil {
 		r.updateState(ctx, cert, networkingv1alpha2.OriginCACertificateStateError,
 			fmt.Sprintf("Failed to generate CSR: %v", err))
@@ -222,10 +236,7 @@ func (r *Reconciler) issueCertificate(
 		validity = 5475 // Default 15 years
 	}
 
-	requestType := string(cert.Spec.RequestType)
-	if requestType == "" {
-		requestType = "origin-rsa"
-	}
+	requestType := keys.requestType
 
 	// Create certificate via Cloudflare API
 	logger.Info("Creating Origin CA certificate in Cloudflare",
@@ -248,7 +259,7 @@ func (r *Reconciler) issueCertificate(
 		fmt.Sprintf("Origin CA certificate created with ID %s", result.ID))
 
 	// Update status with certificate data
-	return r.updateStatusWithCertificate(ctx, cert, result, privateKey)
+	return r.updateStatusWithCertificate(ctx, cert, result, privateKey, keys.String())
 }
 
 // renewCertificate renews the certificate.
@@ -260,10 +271,15 @@ func (r *Reconciler) renewCertificate(
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	keys, err := resolveKeySpec(cert)
+	if err != nil {
+		return r.invalidKeySpec(ctx, cert, err)
+	}
+
 	r.updateState(ctx, cert, networkingv1alpha2.OriginCACertificateStateRenewing, "Renewing certificate")
 
 	// Get or generate CSR
-	csr, privateKey, err := r.getOrGenerateCSR(ctx, cert)
+	csr, privateKey, err := r.getOrGenerateCSR(ctx, cert, keys)
 	if err != nil {
 		r.updateState(ctx, cert, networkingv1alpha2.OriginCACertificateStateError,
 			fmt.Sprintf("Failed to generate CSR: %v", err))
@@ -298,10 +314,7 @@ func (r *Reconciler) renewCertificate(
 		validity = 5475 // Default 15 years
 	}
 
-	requestType := string(cert.Spec.RequestType)
-	if requestType == "" {
-		requestType = "origin-rsa"
-	}
+	requestType := keys.requestType
 
 	// Create new certificate
 	logger.Info("Creating renewed Origin CA certificate in Cloudflare",
@@ -324,7 +337,7 @@ func (r *Reconciler) renewCertificate(
 		fmt.Sprintf("Origin CA certificate renewed with new ID %s", result.ID))
 
 	// Update status with new certificate data
-	return r.updateStatusWithCertificate(ctx, cert, result, privateKey)
+	return r.updateStatusWithCertificate(ctx, cert, result, privateKey, keys.String())
 }
 
 // shouldRenew checks if the certificate should be renewed.
@@ -362,11 +375,10 @@ func (*Reconciler) calculateRequeueTime(cert *networkingv1alpha2.OriginCACertifi
 }
 
 // getOrGenerateCSR gets an existing CSR or generates a new one.
-//
-//nolint:revive // cyclomatic complexity is acceptable for CSR generation logic
 func (r *Reconciler) getOrGenerateCSR(
 	ctx context.Context,
 	cert *networkingv1alpha2.OriginCACertificate,
+	keys *keySpec,
 ) (string, []byte, error) {
 	// If CSR is provided in spec, use it
 	if cert.Spec.CSR != "" {
@@ -379,57 +391,9 @@ func (r *Reconciler) getOrGenerateCSR(
 	}
 
 	// Generate new key pair and CSR
-	algorithm := "RSA"
-	keySize := 2048
-	if cert.Spec.PrivateKey != nil {
-		if cert.Spec.PrivateKey.Algorithm != "" {
-			algorithm = cert.Spec.PrivateKey.Algorithm
-		}
-		if cert.Spec.PrivateKey.Size != 0 {
-			keySize = cert.Spec.PrivateKey.Size
-		}
-	}
-
-	var privateKey any
-	var privateKeyPEM []byte
-	var err error
-
-	switch algorithm {
-	case "RSA":
-		key, err := rsa.GenerateKey(rand.Reader, keySize)
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to generate RSA key: %w", err)
-		}
-		privateKey = key
-		privateKeyPEM = pem.EncodeToMemory(&pem.Block{
-			Type:  "RSA PRIVATE KEY",
-			Bytes: x509.MarshalPKCS1PrivateKey(key),
-		})
-	case "ECDSA":
-		var curve elliptic.Curve
-		switch keySize {
-		case 256:
-			curve = elliptic.P256()
-		case 384:
-			curve = elliptic.P384()
-		default:
-			curve = elliptic.P256()
-		}
-		key, err := ecdsa.GenerateKey(curve, rand.Reader)
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to generate ECDSA key: %w", err)
-		}
-		privateKey = key
-		keyBytes, err := x509.MarshalECPrivateKey(key)
-		if err != nil {
-			return "", nil, fmt.Errorf("failed to marshal ECDSA key: %w", err)
-		}
-		privateKeyPEM = pem.EncodeToMemory(&pem.Block{
-			Type:  "EC PRIVATE KEY",
-			Bytes: keyBytes,
-		})
-	default:
-		return "", nil, fmt.Errorf("unsupported algorithm: %s", algorithm)
+	privateKey, privateKeyPEM, err := generatePrivateKey(keys)
+	if err != nil {
+		return "", nil, err
 	}
 
 	// Create CSR
@@ -675,6 +639,19 @@ func (r *Reconciler) updateState(
 	}
 }
 
+// invalidKeySpec reports private key settings that cannot be issued.
+// The certificate is not retried until its spec changes.
+func (r *Reconciler) invalidKeySpec(
+	ctx context.Context,
+	cert *networkingv1alpha2.OriginCACertificate,
+	err error,
+) (ctrl.Result, error) {
+	message := fmt.Sprintf("Invalid private key settings: %v", err)
+	r.updateState(ctx, cert, networkingv1alpha2.OriginCACertificateStateError, message)
+	r.Recorder.Event(cert, corev1.EventTypeWarning, "InvalidKeySpec", message)
+	return common.NoRequeue(), nil
+}
+
 func (r *Reconciler) updateStatusError(
 	ctx context.Context,
 	cert *networkingv1alpha2.OriginCACertificate,
@@ -732,6 +709,7 @@ func (r *Reconciler) updateStatusWithCertificate(
 	cert *networkingv1alpha2.OriginCACertificate,
 	result *cf.OriginCACertificateResult,
 	privateKey []byte,
+	keyAlgorithm string,
 ) (ctrl.Result, error) {
 	// Sync to Secret if configured
 	if cert.Spec.SecretSync != nil && cert.Spec.SecretSync.Enabled && len(privateKey) > 0 {
@@ -759,6 +737,7 @@ func (r *Reconciler) updateStatusWithCertificate(
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, cert, func() {
 		cert.Status.CertificateID = result.ID
 		cert.Status.Certificate = result.Certificate
+		cert.Status.KeyAlgorithm = keyAlgorithm
 		cert.Status.IssuedAt = &now
 		cert.Status.ExpiresAt = &expiresAt
 		cert.Status.RenewalTime = renewalTime
@@ -837,7 +816,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findCertificatesForCredentials)).
 		Named("origincacertificate").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.OriginCACertificate{}, r))
 }
 
 // Cloudflare Origin CA root certificate
@@ -4,9 +4,36 @@
 package origincacertificate
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
 )
 
 func TestFinalizerName(t *testing.T) {
@@ -23,3 +50,211 @@ func TestReconcilerFields(t *testing.T) {
 	assert.Nil(t, r.Scheme)
 	assert.Nil(t, r.Recorder)
 }
+
+// originCAStub serves the Origin CA endpoints and records the issued requests.
+type originCAStub struct {
+	mu       sync.Mutex
+	requests []originCARequest
+	revoked  []string
+}
+
+type originCARequest struct {
+	RequestType string   `json:"request_type"`
+	Hostnames   []string `json:"hostnames"`
+	CSR         string   `json:"csr"`
+}
+
+func newOriginCAStub(t *testing.T) *originCAStub {
+	t.Helper()
+	stub := &originCAStub{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/test-account-id", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"test-account-id","name":"test"}}`))
+	})
+	mux.HandleFunc("POST /certificates", func(w http.ResponseWriter, req *http.Request) {
+		var body originCARequest
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		stub.mu.Lock()
+		stub.requests = append(stub.requests, body)
+		id := fmt.Sprintf("cert-%d", len(stub.requests))
+		stub.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":{"id":%q,"certificate":"-----BEGIN CERTIFICATE-----\n%s\n-----END CERTIFICATE-----","hostnames":["example.com"],"request_type":%q,"expires_on":%q}}`,
+			id, id, body.RequestType, time.Now().AddDate(15, 0, 0).Format(time.RFC3339))
+	})
+	mux.HandleFunc("DELETE /certificates/{id}", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		stub.revoked = append(stub.revoked, req.PathValue("id"))
+		stub.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":{"id":%q}}`, req.PathValue("id"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL)
+	return stub
+}
+
+func newTestReconciler(t *testing.T, cert *networkingv1alpha2.OriginCACertificate) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "test-account-id",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			cert,
+		).
+		WithStatusSubresource(cert).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestCertificate(privateKey *networkingv1alpha2.PrivateKeySpec) *networkingv1alpha2.OriginCACertificate {
+	return &networkingv1alpha2.OriginCACertificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "origin", Namespace: "default", Generation: 1, Finalizers: []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.OriginCACertificateSpec{
+			Hostnames:  []string{"example.com"},
+			PrivateKey: privateKey,
+			SecretSync: &networkingv1alpha2.SecretSyncConfig{Enabled: true, CertManagerCompatible: true},
+		},
+	}
+}
+
+func reconcileCertificate(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.OriginCACertificate) {
+	t.Helper()
+	key := types.NamespacedName{Name: "origin", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.OriginCACertificate{}
+	require.NoError(t, c.Get(context.Background(), key, updated))
+	return result, updated
+}
+
+// parsePrivateKey parses the PEM private key stored in the synced Secret.
+func parsePrivateKey(t *testing.T, c client.Client) any {
+	t.Helper()
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "origin", Namespace: "default"}, secret))
+	assert.NotEmpty(t, secret.Data["tls.crt"])
+
+	block, _ := pem.Decode(secret.Data["tls.key"])
+	require.NotNil(t, block)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		require.NoError(t, err)
+		return key
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		require.NoError(t, err)
+		return key
+	}
+	t.Fatalf("unexpected private key type %s", block.Type)
+	return nil
+}
+
+// parseCSR parses a CSR sent to the stub.
+func parseCSR(t *testing.T, csrPEM string) *x509.CertificateRequest {
+	t.Helper()
+	block, _ := pem.Decode([]byte(csrPEM))
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+	return csr
+}
+
+func TestReconcile_IssuesRSACertificate(t *testing.T) {
+	stub := newOriginCAStub(t)
+	r, c := newTestReconciler(t, newTestCertificate(&networkingv1alpha2.PrivateKeySpec{Algorithm: "RSA", Size: 3072}))
+
+	_, updated := reconcileCertificate(t, r, c)
+
+	require.Equal(t, networkingv1alpha2.OriginCACertificateStateReady, updated.Status.State)
+	assert.Equal(t, "RSA-3072", updated.Status.KeyAlgorithm)
+	require.Len(t, stub.requests, 1)
+	assert.Equal(t, "origin-rsa", stub.requests[0].RequestType)
+	assert.Equal(t, x509.RSA, parseCSR(t, stub.requests[0].CSR).PublicKeyAlgorithm)
+
+	key, ok := parsePrivateKey(t, c).(*rsa.PrivateKey)
+	require.True(t, ok)
+	assert.Equal(t, 3072, key.N.BitLen())
+}
+
+func TestReconcile_IssuesECDSACertificate(t *testing.T) {
+	stub := newOriginCAStub(t)
+	r, c := newTestReconciler(t, newTestCertificate(&networkingv1alpha2.PrivateKeySpec{
+		Algorithm: "ECDSA", Curve: networkingv1alpha2.PrivateKeyCurveP384,
+	}))
+
+	_, updated := reconcileCertificate(t, r, c)
+
+	require.Equal(t, networkingv1alpha2.OriginCACertificateStateReady, updated.Status.State)
+	assert.Equal(t, "ECDSA-P384", updated.Status.KeyAlgorithm)
+	require.Len(t, stub.requests, 1)
+	assert.Equal(t, "origin-ecc", stub.requests[0].RequestType)
+	assert.Equal(t, x509.ECDSA, parseCSR(t, stub.requests[0].CSR).PublicKeyAlgorithm)
+
+	key, ok := parsePrivateKey(t, c).(*ecdsa.PrivateKey)
+	require.True(t, ok)
+	assert.Equal(t, elliptic.P384(), key.Curve)
+}
+
+func TestReconcile_InvalidKeySpecIsNotIssued(t *testing.T) {
+	stub := newOriginCAStub(t)
+	cert := newTestCertificate(&networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA", Size: 521})
+	r, c := newTestReconciler(t, cert)
+
+	result, updated := reconcileCertificate(t, r, c)
+
+	assert.Equal(t, common.NoRequeue(), result)
+	assert.Empty(t, stub.requests)
+	assert.Equal(t, networkingv1alpha2.OriginCACertificateStateError, updated.Status.State)
+	assert.Contains(t, updated.Status.Message, "unsupported ECDSA key size 521")
+}
+
+func TestReconcile_KeyChangeReissuesCertificate(t *testing.T) {
+	stub := newOriginCAStub(t)
+	r, c := newTestReconciler(t, newTestCertificate(nil))
+
+	_, issued := reconcileCertificate(t, r, c)
+	require.Equal(t, "RSA-2048", issued.Status.KeyAlgorithm)
+
+	issued.Spec.PrivateKey = &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA"}
+	require.NoError(t, c.Update(context.Background(), issued))
+
+	_, reissued := reconcileCertificate(t, r, c)
+
+	assert.Equal(t, "ECDSA-P256", reissued.Status.KeyAlgorithm)
+	assert.NotEqual(t, issued.Status.CertificateID, reissued.Status.CertificateID)
+	assert.Equal(t, []string{issued.Status.CertificateID}, stub.revoked)
+	require.Len(t, stub.requests, 2)
+	assert.Equal(t, "origin-ecc", stub.requests[1].RequestType)
+	_, ok := parsePrivateKey(t, c).(*ecdsa.PrivateKey)
+	assert.True(t, ok)
+}
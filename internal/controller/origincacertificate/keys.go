@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package origincacertificate
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+const (
+	keyAlgorithmRSA   = "RSA"
+	keyAlgorithmECDSA = "ECDSA"
+
+	requestTypeRSA = string(networkingv1alpha2.CertificateRequestTypeOriginRSA)
+	requestTypeECC = string(networkingv1alpha2.CertificateRequestTypeOriginECC)
+
+	defaultRSAKeySize = 2048
+
+	// legacyDefaultKeySize and legacyDefaultRequestType were defaulted by earlier
+	// versions of the CRD and are stored on existing certificates of any algorithm.
+	legacyDefaultKeySize     = 2048
+	legacyDefaultRequestType = requestTypeRSA
+)
+
+// supportedRSAKeySizes are the RSA key sizes accepted for generated keys.
+var supportedRSAKeySizes = map[int]bool{2048: true, 3072: true, 4096: true}
+
+// ecdsaSizeCurves maps the ECDSA key sizes to their curves.
+var ecdsaSizeCurves = map[int]networkingv1alpha2.PrivateKeyCurve{
+	256: networkingv1alpha2.PrivateKeyCurveP256,
+	384: networkingv1alpha2.PrivateKeyCurveP384,
+}
+
+// keySpec is the validated private key and request type of a certificate.
+type keySpec struct {
+	// algorithm is empty when the CSR and its key are provided by the user.
+	algorithm   string
+	rsaBits     int
+	curve       networkingv1alpha2.PrivateKeyCurve
+	requestType string
+}
+
+// String describes the key, e.g. "RSA-2048" or "ECDSA-P256".
+func (k *keySpec) String() string {
+	switch k.algorithm {
+	case keyAlgorithmRSA:
+		return fmt.Sprintf("%s-%d", keyAlgorithmRSA, k.rsaBits)
+	case keyAlgorithmECDSA:
+		return fmt.Sprintf("%s-%s", keyAlgorithmECDSA, k.curve)
+	default:
+		return ""
+	}
+}
+
+// resolveKeySpec applies the defaults to the private key settings of a certificate
+// and rejects combinations Cloudflare cannot issue.
+//
+//nolint:revive // cyclomatic complexity is acceptable for validation logic
+func resolveKeySpec(cert *networkingv1alpha2.OriginCACertificate) (*keySpec, error) {
+	requestType := string(cert.Spec.RequestType)
+
+	// The key of a user-provided CSR is not generated, so only the request type applies
+	if cert.Spec.CSR != "" {
+		if requestType == "" {
+			requestType = requestTypeRSA
+		}
+		return &keySpec{requestType: requestType}, nil
+	}
+
+	spec := &keySpec{algorithm: keyAlgorithmRSA}
+	var size int
+	var curve networkingv1alpha2.PrivateKeyCurve
+	if pk := cert.Spec.PrivateKey; pk != nil {
+		if pk.Algorithm != "" {
+			spec.algorithm = pk.Algorithm
+		}
+		size = pk.Size
+		curve = pk.Curve
+	}
+
+	switch spec.algorithm {
+	case keyAlgorithmRSA:
+		if curve != "" {
+			return nil, fmt.Errorf("curve %s is only supported with the ECDSA algorithm", curve)
+		}
+		if size == 0 {
+			size = defaultRSAKeySize
+		}
+		if !supportedRSAKeySizes[size] {
+			return nil, fmt.Errorf("unsupported RSA key size %d (supported: 2048, 3072, 4096)", size)
+		}
+		spec.rsaBits = size
+		if requestType == "" {
+			requestType = requestTypeRSA
+		}
+	case keyAlgorithmECDSA:
+		// The legacy RSA defaults stored on existing ECDSA certificates are treated as unset
+		if size == legacyDefaultKeySize {
+			size = 0
+		}
+		if requestType == legacyDefaultRequestType {
+			requestType = ""
+		}
+		sizeCurve, ok := ecdsaSizeCurves[size]
+		if size != 0 && !ok {
+			return nil, fmt.Errorf("unsupported ECDSA key size %d (supported: 256, 384)", size)
+		}
+		if curve != "" && sizeCurve != "" && curve != sizeCurve {
+			return nil, fmt.Errorf("ECDSA key size %d does not match curve %s", size, curve)
+		}
+		switch {
+		case curve != "":
+			spec.curve = curve
+		case sizeCurve != "":
+			spec.curve = sizeCurve
+		default:
+			spec.curve = networkingv1alpha2.PrivateKeyCurveP256
+		}
+		if requestType == "" {
+			requestType = requestTypeECC
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %s", spec.algorithm)
+	}
+
+	// Cloudflare signs RSA keys with origin-rsa and ECDSA keys with origin-ecc
+	if requestType == requestTypeRSA && spec.algorithm != keyAlgorithmRSA ||
+		requestType == requestTypeECC && spec.algorithm != keyAlgorithmECDSA {
+		return nil, fmt.Errorf("request type %s cannot be used with an %s private key", requestType, spec.algorithm)
+	}
+	spec.requestType = requestType
+
+	return spec, nil
+}
+
+// generatePrivateKey generates a private key and returns it with its PEM encoding.
+func generatePrivateKey(spec *keySpec) (crypto.Signer, []byte, error) {
+	switch spec.algorithm {
+	case keyAlgorithmRSA:
+		key, err := rsa.GenerateKey(rand.Reader, spec.rsaBits)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate RSA key: %w", err)
+		}
+		return key, pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}), nil
+	case keyAlgorithmECDSA:
+		curve := elliptic.P256()
+		if spec.curve == networkingv1alpha2.PrivateKeyCurveP384 {
+			curve = elliptic.P384()
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate ECDSA key: %w", err)
+		}
+		keyBytes, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal ECDSA key: %w", err)
+		}
+		return key, pem.EncodeToMemory(&pem.Block{
+			Type:  "EC PRIVATE KEY",
+			Bytes: keyBytes,
+		}), nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported algorithm: %s", spec.algorithm)
+	}
+}
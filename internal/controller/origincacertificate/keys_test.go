@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package origincacertificate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+func TestResolveKeySpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		requestType networkingv1alpha2.CertificateRequestType
+		privateKey  *networkingv1alpha2.PrivateKeySpec
+		csr         string
+		want        string
+		wantRequest string
+		wantErr     string
+	}{
+		{name: "defaults to RSA 2048", want: "RSA-2048", wantRequest: "origin-rsa"},
+		{
+			name:        "RSA 4096",
+			privateKey:  &networkingv1alpha2.PrivateKeySpec{Algorithm: "RSA", Size: 4096},
+			want:        "RSA-4096",
+			wantRequest: "origin-rsa",
+		},
+		{
+			name:        "ECDSA defaults to P256 and origin-ecc",
+			privateKey:  &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA"},
+			want:        "ECDSA-P256",
+			wantRequest: "origin-ecc",
+		},
+		{
+			name:        "ECDSA size selects the curve",
+			privateKey:  &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA", Size: 384},
+			want:        "ECDSA-P384",
+			wantRequest: "origin-ecc",
+		},
+		{
+			name:        "ECDSA curve",
+			privateKey:  &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA", Curve: networkingv1alpha2.PrivateKeyCurveP384},
+			want:        "ECDSA-P384",
+			wantRequest: "origin-ecc",
+		},
+		{
+			name:        "provided CSR keeps the request type",
+			requestType: networkingv1alpha2.CertificateRequestTypeOriginECC,
+			csr:         "-----BEGIN CERTIFICATE REQUEST-----",
+			wantRequest: "origin-ecc",
+		},
+		{
+			name:       "unsupported RSA size",
+			privateKey: &networkingv1alpha2.PrivateKeySpec{Algorithm: "RSA", Size: 1024},
+			wantErr:    "unsupported RSA key size 1024",
+		},
+		{
+			name:       "curve with RSA",
+			privateKey: &networkingv1alpha2.PrivateKeySpec{Algorithm: "RSA", Curve: networkingv1alpha2.PrivateKeyCurveP256},
+			wantErr:    "only supported with the ECDSA algorithm",
+		},
+		{
+			name:       "unsupported ECDSA size",
+			privateKey: &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA", Size: 521},
+			wantErr:    "unsupported ECDSA key size 521",
+		},
+		{
+			name: "ECDSA size and curve disagree",
+			privateKey: &networkingv1alpha2.PrivateKeySpec{
+				Algorithm: "ECDSA", Size: 256, Curve: networkingv1alpha2.PrivateKeyCurveP384,
+			},
+			wantErr: "does not match curve P384",
+		},
+		{
+			name:        "ECDSA with the legacy defaults",
+			requestType: networkingv1alpha2.CertificateRequestTypeOriginRSA,
+			privateKey:  &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA", Size: 2048},
+			want:        "ECDSA-P256",
+			wantRequest: "origin-ecc",
+		},
+		{
+			name:        "ECDSA curve with the legacy default size",
+			privateKey:  &networkingv1alpha2.PrivateKeySpec{Algorithm: "ECDSA", Size: 2048, Curve: networkingv1alpha2.PrivateKeyCurveP384},
+			want:        "ECDSA-P384",
+			wantRequest: "origin-ecc",
+		},
+		{
+			name:        "origin-ecc with RSA key",
+			requestType: networkingv1alpha2.CertificateRequestTypeOriginECC,
+			wantErr:     "origin-ecc cannot be used with an RSA private key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert := &networkingv1alpha2.OriginCACertificate{
+				Spec: networkingv1alpha2.OriginCACertificateSpec{
+					Hostnames:   []string{"example.com"},
+					RequestType: tt.requestType,
+					PrivateKey:  tt.privateKey,
+					CSR:         tt.csr,
+				},
+			}
+
+			keys, err := resolveKeySpec(cert)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, keys.String())
+			assert.Equal(t, tt.wantRequest, keys.requestType)
+		})
+	}
+}
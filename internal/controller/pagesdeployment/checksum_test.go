@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdeployment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/uploader"
+)
+
+func TestSetChecksumMismatchStatus(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "site-v1", Namespace: "default", Generation: 1},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment).
+		WithStatusSubresource(deployment).
+		Build()
+	r := &PagesDeploymentReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(5)}
+
+	result, err := r.setChecksumMismatchStatus(ctx, deployment, &uploader.ChecksumMismatchError{
+		Algorithm: "sha256",
+		Expected:  "aaaa",
+		Actual:    "bbbb",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ChecksumRetryInterval, result.RequeueAfter)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	require.NotNil(t, updated.Status.ChecksumVerification)
+	assert.Equal(t, "aaaa", updated.Status.ChecksumVerification.Expected)
+	assert.Equal(t, "bbbb", updated.Status.ChecksumVerification.Actual)
+	assert.False(t, updated.Status.ChecksumVerification.Matched)
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, EventReasonChecksumMismatch, cond.Reason)
+
+	// The deployment is not terminal, so it is retried and recovers once the source is fixed
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentStatePending, updated.Status.State)
+	assert.True(t, r.needsNewDeployment(updated))
+}
+
+func TestChecksumVerificationStatus(t *testing.T) {
+	assert.Nil(t, checksumVerificationStatus(&uploader.FileManifest{SourceHash: "abc"}))
+
+	status := checksumVerificationStatus(&uploader.FileManifest{ChecksumAlgorithm: "sha512", Checksum: "abc"})
+	require.NotNil(t, status)
+	assert.Equal(t, "sha512", status.Algorithm)
+	assert.Equal(t, "abc", status.Expected)
+	assert.Equal(t, "abc", status.Actual)
+	assert.True(t, status.Matched)
+}
@@ -63,6 +63,17 @@ const (
 	// Values: "transient", "permanent", "unknown"
 	AnnotationFailureReason = "cloudflare-operator.io/failure-reason"
 
+	// AnnotationIdempotencyKey stores the idempotency key of the deployment being created.
+	// This is managed internally by the controller to prevent duplicate deployments.
+	AnnotationIdempotencyKey = "cloudflare-operator.io/idempotency-key"
+
+	// AnnotationIdempotencyClaimedAt stores when the idempotency key was claimed.
+	AnnotationIdempotencyClaimedAt = "cloudflare-operator.io/idempotency-claimed-at"
+
+	// AnnotationIdempotencyDeploymentID stores the Cloudflare deployment ID created for the claimed key.
+	// Only this deployment is adopted when its ID is missing from status.
+	AnnotationIdempotencyDeploymentID = "cloudflare-operator.io/idempotency-deployment-id"
+
 	// EventReasonProductionConflict indicates another production deployment exists
 	EventReasonProductionConflict = "ProductionConflict"
 	// EventReasonProductionProtected indicates production deletion is blocked
@@ -79,6 +90,8 @@ const (
 	EventReasonDeploymentFailed = "DeploymentFailed"
 	// EventReasonDeploymentRetrying indicates deployment is being retried
 	EventReasonDeploymentRetrying = "DeploymentRetrying"
+	// EventReasonChecksumMismatch indicates the direct upload source failed checksum verification
+	EventReasonChecksumMismatch = "ChecksumMismatch"
 
 	// PollingInterval is the interval for polling in-progress deployments
 	PollingInterval = 30 * time.Second
@@ -91,6 +104,9 @@ const (
 
 	// MaxRetryDelay is the maximum delay between retries
 	MaxRetryDelay = 5 * time.Minute
+
+	// ChecksumRetryInterval is the interval for re-fetching a source that failed checksum verification
+	ChecksumRetryInterval = 2 * time.Minute
 )
 
 // PagesDeploymentReconciler reconciles a PagesDeployment object.
@@ -403,6 +419,10 @@ func (r *PagesDeploymentReconciler) clearRetryAnnotations(
 // - This prevents duplicate deployments and unnecessary production switches
 // - EXCEPTION: force-redeploy bypasses idempotency check (user explicitly wants new deployment)
 //
+// Independently of the commit hash, an idempotency key is claimed on the object
+// before creating, so stale or repeated reconciles adopt the deployment created
+// for the key instead of creating a duplicate.
+//
 //nolint:revive // cognitive complexity acceptable for deployment creation
 func (r *PagesDeploymentReconciler) createDeployment(
 	ctx context.Context,
@@ -446,6 +466,35 @@ func (r *PagesDeploymentReconciler) createDeployment(
 			"commitHash", commitHash)
 	}
 
+	// === Idempotency Key: Guard against duplicate creation from stale reconciles ===
+	idempotencyKey := computeIdempotencyKey(deployment, projectName)
+	claimed, err := r.findClaimedDeployment(ctx, deployment, projectName, api, idempotencyKey)
+	if err != nil {
+		// Best-effort like the commit hash check - proceed to claim and create
+		logger.Info("Failed to check deployments for idempotency key, proceeding with creation",
+			"idempotencyKey", idempotencyKey,
+			"error", err.Error())
+	} else if claimed != nil {
+		logger.Info("Found deployment already created for idempotency key, adopting",
+			"idempotencyKey", idempotencyKey,
+			"existingDeploymentId", claimed.ID)
+
+		r.Recorder.Event(deployment, corev1.EventTypeNormal, "DeploymentAdopted",
+			fmt.Sprintf("Adopted existing deployment %s for idempotency key %s (no new deployment created)",
+				claimed.ID, idempotencyKey))
+
+		return r.updateDeploymentStatus(ctx, deployment, projectName, apiResult.AccountID, claimed)
+	}
+
+	if err := r.claimIdempotencyKey(ctx, deployment, idempotencyKey); err != nil {
+		if apierrors.IsConflict(err) {
+			// Another reconcile updated the object first - retry with the latest version
+			logger.Info("Idempotency key claim conflicted, requeueing", "idempotencyKey", idempotencyKey)
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
 	// === No existing deployment found (or force-redeploy) - create new one ===
 	logger.Info("Creating new Pages deployment",
 		"project", projectName,
@@ -455,7 +504,7 @@ func (r *PagesDeploymentReconciler) createDeployment(
 		"sourceType", r.getSourceType(deployment))
 
 	var result *cf.PagesDeploymentResult
-	var err error
+	var manifest *uploader.FileManifest
 
 	// Determine source type and create deployment
 	if deployment.Spec.Source != nil {
@@ -473,10 +522,16 @@ func (r *PagesDeploymentReconciler) createDeployment(
 			if deployment.Spec.Source.DirectUpload == nil || deployment.Spec.Source.DirectUpload.Source == nil {
 				return r.setErrorStatus(ctx, deployment, errors.New("direct upload source is required"))
 			}
-			files, loadErr := r.loadDirectUploadFiles(ctx, deployment)
+			var loadErr error
+			manifest, loadErr = r.loadDirectUploadFiles(ctx, deployment)
 			if loadErr != nil {
+				var mismatch *uploader.ChecksumMismatchError
+				if errors.As(loadErr, &mismatch) {
+					return r.setChecksumMismatchStatus(ctx, deployment, mismatch)
+				}
 				return r.setErrorStatus(ctx, deployment, fmt.Errorf("failed to load files: %w", loadErr))
 			}
+			files := manifest.Files
 
 			// Build deployment metadata
 			metadata := r.buildDeploymentMetadata(deployment)
@@ -485,13 +540,7 @@ func (r *PagesDeploymentReconciler) createDeployment(
 			if uploadErr != nil {
 				return r.setErrorStatus(ctx, deployment, fmt.Errorf("failed to create direct upload deployment: %w", uploadErr))
 			}
-			// Convert direct upload result to deployment result
-			result = &cf.PagesDeploymentResult{
-				ID:          directResult.ID,
-				URL:         directResult.URL,
-				Stage:       directResult.Stage,
-				ProjectName: projectName,
-			}
+			result = directUploadDeploymentResult(directResult, projectName, metadata)
 
 		default:
 			return r.setErrorStatus(ctx, deployment, fmt.Errorf("unsupported source type: %s", deployment.Spec.Source.Type))
@@ -514,6 +563,10 @@ func (r *PagesDeploymentReconciler) createDeployment(
 
 	if err != nil {
 		logger.Error(err, "Failed to create Pages deployment")
+		// Nothing was created for the key - let the next attempt create again
+		if releaseErr := r.releaseIdempotencyClaim(ctx, deployment); releaseErr != nil {
+			logger.Error(releaseErr, "Failed to release idempotency key")
+		}
 		return r.setErrorStatus(ctx, deployment, err)
 	}
 
@@ -521,16 +574,24 @@ func (r *PagesDeploymentReconciler) createDeployment(
 	r.Recorder.Event(deployment, corev1.EventTypeNormal, EventReasonDeploymentCreated,
 		fmt.Sprintf("Created deployment %s (stage: %s)", result.ID, result.Stage))
 
-	// Update force-redeploy tracking
+	// Record the created deployment for the claim and update force-redeploy tracking
 	if deployment.Annotations == nil {
 		deployment.Annotations = make(map[string]string)
 	}
+	deployment.Annotations[AnnotationIdempotencyDeploymentID] = result.ID
 	if forceRedeploy := deployment.Annotations[AnnotationForceRedeploy]; forceRedeploy != "" {
 		deployment.Annotations[AnnotationLastForceRedeploy] = forceRedeploy
-		if updateErr := r.Update(ctx, deployment); updateErr != nil {
-			logger.Error(updateErr, "Failed to update force-redeploy annotation")
-			// Don't fail reconciliation for this
-		}
+	}
+	if updateErr := r.Update(ctx, deployment); updateErr != nil {
+		logger.Error(updateErr, "Failed to record created deployment annotations")
+		// Don't fail reconciliation for this
+	}
+
+	// Record the uploaded source so PagesProject history can deduplicate it
+	if manifest != nil {
+		deployment.Status.SourceHash = manifest.SourceHash
+		deployment.Status.SourceURL = manifest.SourceURL
+		deployment.Status.ChecksumVerification = checksumVerificationStatus(manifest)
 	}
 
 	// Update status with deployment info
@@ -558,6 +619,17 @@ func (r *PagesDeploymentReconciler) pollDeploymentStatus(
 		return r.setErrorStatus(ctx, deployment, err)
 	}
 
+	// Release the claim and capture the build logs once when the deployment has failed
+	if isFailedStage(deploymentStage(result)) {
+		// A retry of the same spec must create a new deployment, not adopt the failed one
+		if err := r.releaseIdempotencyClaim(ctx, deployment); err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(deployment.Status.FailureLogs) == 0 {
+			r.recordFailureLogs(ctx, deployment, api, projectName, result.ID)
+		}
+	}
+
 	// Update status
 	return r.updateDeploymentStatus(ctx, deployment, projectName, apiResult.AccountID, result)
 }
@@ -775,17 +847,20 @@ func (r *PagesDeploymentReconciler) updateDeploymentStatus(
 		deployment.Status.URL = result.URL
 		deployment.Status.Environment = result.Environment
 
-		// Extract HashURL from Aliases (first .pages.dev URL containing the short ID)
-		deployment.Status.HashURL = extractHashURL(result.Aliases, result.ShortID, projectName)
+		// Keep the URLs of earlier polls when a response lacks the data to derive them
+		hashURL, branchURL := deploymentURLs(result, projectName)
+		if hashURL != "" {
+			deployment.Status.HashURL = hashURL
+		}
+		if branchURL != "" {
+			deployment.Status.BranchURL = branchURL
+		}
 
 		// Extract VersionName from labels or deployment name
 		deployment.Status.VersionName = extractVersionName(deployment)
 
 		// Determine state based on stage
-		stage := result.Stage
-		if result.StageStatus != "" && result.StageStatus != "active" {
-			stage = result.StageStatus
-		}
+		stage := deploymentStage(result)
 
 		switch stage {
 		case "queued":
@@ -873,7 +948,7 @@ func (r *PagesDeploymentReconciler) updateDeploymentStatus(
 			logger.Error(annotationErr, "Failed to update retry annotations")
 		}
 		r.Recorder.Event(deployment, corev1.EventTypeWarning, EventReasonDeploymentFailed,
-			"Deployment failed")
+			failureMessage(deployment.Status.FailureLogs))
 		// Check if auto-retry is applicable
 		retryCount := r.getRetryCount(deployment)
 		if retryCount < MaxAutoRetries && r.shouldAutoRetry(deployment) {
@@ -887,7 +962,7 @@ func (r *PagesDeploymentReconciler) updateDeploymentStatus(
 				"nextRetryIn", delay)
 			r.Recorder.Event(deployment, corev1.EventTypeNormal, EventReasonDeploymentRetrying,
 				fmt.Sprintf("Will retry deployment (attempt %d/%d) in %s", retryCount+1, MaxAutoRetries, delay))
-			return ctrl.Result{RequeueAfter: delay}, nil
+			return common.RequeueAfterOrDeadline(ctx, delay), nil
 		}
 		return ctrl.Result{}, nil
 
@@ -903,7 +978,7 @@ func (r *PagesDeploymentReconciler) updateDeploymentStatus(
 			"interval", PollingInterval)
 		r.Recorder.Event(deployment, corev1.EventTypeNormal, EventReasonDeploymentPolling,
 			fmt.Sprintf("Deployment in progress: %s", result.Stage))
-		return ctrl.Result{RequeueAfter: PollingInterval}, nil
+		return common.RequeueAfterOrDeadline(ctx, PollingInterval), nil
 	}
 }
 
@@ -1074,6 +1149,58 @@ func (r *PagesDeploymentReconciler) setErrorStatus(
 	return ctrl.Result{}, nil
 }
 
+// setChecksumMismatchStatus records a checksum mismatch in status.
+// The deployment stays Pending rather than Failed, so it is retried periodically and
+// recovers once the source artifact or the configured checksum is corrected.
+func (r *PagesDeploymentReconciler) setChecksumMismatchStatus(
+	ctx context.Context,
+	deployment *networkingv1alpha2.PagesDeployment,
+	mismatch *uploader.ChecksumMismatchError,
+) (ctrl.Result, error) {
+	message := fmt.Sprintf("Source checksum mismatch (%s): expected %s, got %s",
+		mismatch.Algorithm, mismatch.Expected, mismatch.Actual)
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, deployment, func() {
+		deployment.Status.State = networkingv1alpha2.PagesDeploymentStatePending
+		deployment.Status.Message = message
+		deployment.Status.ChecksumVerification = &networkingv1alpha2.ChecksumVerificationStatus{
+			Algorithm: mismatch.Algorithm,
+			Expected:  mismatch.Expected,
+			Actual:    mismatch.Actual,
+			Matched:   false,
+		}
+		meta.SetStatusCondition(&deployment.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: deployment.Generation,
+			Reason:             EventReasonChecksumMismatch,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		deployment.Status.ObservedGeneration = deployment.Generation
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to update status: %w", err)
+	}
+
+	r.Recorder.Event(deployment, corev1.EventTypeWarning, EventReasonChecksumMismatch, message)
+	return ctrl.Result{RequeueAfter: ChecksumRetryInterval}, nil
+}
+
+// checksumVerificationStatus returns the verification status for a loaded source,
+// or nil if no checksum was configured.
+func checksumVerificationStatus(manifest *uploader.FileManifest) *networkingv1alpha2.ChecksumVerificationStatus {
+	if manifest.Checksum == "" {
+		return nil
+	}
+	return &networkingv1alpha2.ChecksumVerificationStatus{
+		Algorithm: manifest.ChecksumAlgorithm,
+		Expected:  manifest.Checksum,
+		Actual:    manifest.Checksum,
+		Matched:   true,
+	}
+}
+
 // getSourceType returns a string describing the deployment source type.
 func (r *PagesDeploymentReconciler) getSourceType(deployment *networkingv1alpha2.PagesDeployment) string {
 	if deployment.Spec.Source != nil {
@@ -1093,7 +1220,7 @@ func (r *PagesDeploymentReconciler) getSourceType(deployment *networkingv1alpha2
 func (r *PagesDeploymentReconciler) loadDirectUploadFiles(
 	ctx context.Context,
 	deployment *networkingv1alpha2.PagesDeployment,
-) (map[string][]byte, error) {
+) (*uploader.FileManifest, error) {
 	logger := log.FromContext(ctx)
 
 	if deployment.Spec.Source == nil || deployment.Spec.Source.DirectUpload == nil {
@@ -1136,7 +1263,7 @@ func (r *PagesDeploymentReconciler) loadDirectUploadFiles(
 		"totalSize", manifest.TotalSize,
 		"sourceHash", manifest.SourceHash)
 
-	return manifest.Files, nil
+	return manifest, nil
 }
 
 // resolveProjectName resolves the Cloudflare project name from the ProjectRef.
@@ -1399,7 +1526,7 @@ func (r *PagesDeploymentReconciler) findDeploymentsForSameProject(ctx context.Co
 }
 
 func (r *PagesDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.Recorder = mgr.GetEventRecorderFor("pagesdeployment-controller")
+	r.Recorder = common.NewDedupRecorder(mgr.GetEventRecorderFor("pagesdeployment-controller"), common.DefaultEventDedupWindow)
 	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), mgr.GetLogger())
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -1408,7 +1535,90 @@ func (r *PagesDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findDeploymentsForProject)).
 		Watches(&networkingv1alpha2.PagesDeployment{},
 			handler.EnqueueRequestsFromMapFunc(r.findDeploymentsForSameProject)).
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.PagesDeployment{}, r))
+}
+
+// pagesBranchAliasMaxLen is the maximum length of the subdomain of a Pages branch alias.
+const pagesBranchAliasMaxLen = 28
+
+// directUploadDeploymentResult converts the result of a direct upload to a
+// deployment result, recording the branch of the upload as its trigger.
+func directUploadDeploymentResult(
+	directResult *cf.PagesDirectUploadResult,
+	projectName string,
+	metadata *cf.PagesDeploymentMetadata,
+) *cf.PagesDeploymentResult {
+	result := &cf.PagesDeploymentResult{
+		ID:          directResult.ID,
+		URL:         directResult.URL,
+		Stage:       directResult.Stage,
+		ProjectName: projectName,
+	}
+	if metadata != nil && metadata.Branch != "" {
+		result.DeploymentTrigger = &cf.PagesDeploymentTrigger{
+			Type: "ad_hoc",
+			Metadata: &cf.PagesDeploymentTriggerMetadata{
+				Branch:     metadata.Branch,
+				CommitHash: metadata.CommitHash,
+			},
+		}
+	}
+	return result
+}
+
+// deploymentURLs returns the hash and branch URLs of a deployment. The hash URL
+// is the deployment URL returned by the API. The branch URL is the alias of the
+// deployment's branch, <branch>.<project>.pages.dev, and is empty when the
+// branch is not known.
+func deploymentURLs(result *cf.PagesDeploymentResult, projectName string) (hashURL, branchURL string) {
+	hashURL = result.URL
+	if hashURL == "" {
+		hashURL = extractHashURL(result.Aliases, result.ShortID, projectName)
+	}
+
+	if result.DeploymentTrigger == nil || result.DeploymentTrigger.Metadata == nil {
+		return hashURL, ""
+	}
+	alias := pagesBranchAlias(result.DeploymentTrigger.Metadata.Branch)
+	if alias == "" {
+		return hashURL, ""
+	}
+
+	// Prefer the alias returned by the API, as it has the project's actual subdomain
+	for _, a := range result.Aliases {
+		if strings.HasPrefix(strings.TrimPrefix(a, "https://"), alias+".") {
+			return hashURL, a
+		}
+	}
+	return hashURL, fmt.Sprintf("https://%s.%s", alias, pagesProjectHost(hashURL, projectName))
+}
+
+// pagesBranchAlias returns the subdomain of the alias Cloudflare creates for a
+// branch: the lowercased branch with every character other than a letter or
+// digit replaced by a dash, truncated to pagesBranchAliasMaxLen characters.
+func pagesBranchAlias(branch string) string {
+	alias := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(branch))
+	if len(alias) > pagesBranchAliasMaxLen {
+		alias = alias[:pagesBranchAliasMaxLen]
+	}
+	return strings.Trim(alias, "-")
+}
+
+// pagesProjectHost returns the pages.dev host of a project, taken from the
+// deployment's hash URL <hash>.<subdomain>.pages.dev since the subdomain may
+// differ from the project name. It falls back to <project>.pages.dev.
+func pagesProjectHost(hashURL, projectName string) string {
+	host := strings.TrimPrefix(hashURL, "https://")
+	host, _, _ = strings.Cut(host, "/")
+	if _, rest, ok := strings.Cut(host, "."); ok && strings.HasSuffix(rest, ".pages.dev") {
+		return rest
+	}
+	return projectName + ".pages.dev"
 }
 
 // extractHashURL extracts the hash-based URL from aliases.
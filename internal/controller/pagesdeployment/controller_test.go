@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdeployment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func TestPollDeploymentStatus_PollsUntilActive(t *testing.T) {
+	ctx := context.Background()
+
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	defer server.Close()
+
+	mock.Store().SetPagesDeploymentProgression(models.PagesDeploymentProgression{AdvancePolls: 1})
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-1",
+		ShortID:     "abc123",
+		ProjectName: "my-site",
+		Environment: "production",
+		URL:         "https://abc123.my-site.pages.dev",
+		CreatedOn:   time.Now(),
+		LatestStage: models.PagesDeploymentStage{Name: "queued", Status: "active"},
+	})
+
+	cfClient, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	apiResult := &common.APIClientResult{
+		API:       &cf.API{Log: logr.Discard(), CloudflareClient: cfClient, ValidAccountId: "test-account-id"},
+		AccountID: "test-account-id",
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site-prod", Namespace: "default", Generation: 1},
+		Status: networkingv1alpha2.PagesDeploymentStatus{
+			DeploymentID: "dep-1",
+			State:        networkingv1alpha2.PagesDeploymentStateQueued,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment).
+		WithStatusSubresource(deployment).
+		Build()
+	r := &PagesDeploymentReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(20)}
+
+	var states []networkingv1alpha2.PagesDeploymentState
+	for range 10 {
+		current := &networkingv1alpha2.PagesDeployment{}
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), current))
+
+		result, err := r.pollDeploymentStatus(ctx, current, "my-site", apiResult)
+		require.NoError(t, err)
+		states = append(states, current.Status.State)
+
+		if current.Status.State == networkingv1alpha2.PagesDeploymentStateSucceeded {
+			assert.Zero(t, result.RequeueAfter)
+			break
+		}
+		assert.Positive(t, result.RequeueAfter, "in-progress deployments are polled again")
+	}
+
+	assert.Equal(t, []networkingv1alpha2.PagesDeploymentState{
+		networkingv1alpha2.PagesDeploymentStateBuilding,
+		networkingv1alpha2.PagesDeploymentStateBuilding,
+		networkingv1alpha2.PagesDeploymentStateBuilding,
+		networkingv1alpha2.PagesDeploymentStateDeploying,
+		networkingv1alpha2.PagesDeploymentStateSucceeded,
+	}, states)
+	assert.Equal(t, 5, mock.CountRequests(http.MethodGet, "/deployments/dep-1$"))
+}
+
+func newURLTestReconciler(
+	t *testing.T,
+	deployment *networkingv1alpha2.PagesDeployment,
+) (*PagesDeploymentReconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment).
+		WithStatusSubresource(deployment).
+		Build()
+	return &PagesDeploymentReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(20)}, fakeClient
+}
+
+func newURLTestAPI(t *testing.T) (*cf.API, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+
+	cfClient, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	return &cf.API{Log: logr.Discard(), CloudflareClient: cfClient, ValidAccountId: "test-account-id"}, mock
+}
+
+func TestUpdateDeploymentStatus_GitDeploymentURLs(t *testing.T) {
+	ctx := context.Background()
+	api, _ := newURLTestAPI(t)
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site-login", Namespace: "default", Generation: 1},
+	}
+	r, fakeClient := newURLTestReconciler(t, deployment)
+
+	result, err := api.CreatePagesDeployment(ctx, "my-site", "Feature/Login")
+	require.NoError(t, err)
+	_, err = r.updateDeploymentStatus(ctx, deployment, "my-site", "test-account-id", result)
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, result.URL, updated.Status.HashURL)
+	assert.Equal(t, "https://"+result.ShortID+".my-site.pages.dev", updated.Status.HashURL)
+	assert.Equal(t, "https://feature-login.my-site.pages.dev", updated.Status.BranchURL)
+}
+
+func TestUpdateDeploymentStatus_BranchURLWithoutAlias(t *testing.T) {
+	ctx := context.Background()
+	api, mock := newURLTestAPI(t)
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-1",
+		ShortID:     "abc123",
+		ProjectName: "my-site",
+		Environment: "preview",
+		URL:         "https://abc123.my-site-4xz.pages.dev",
+		CreatedOn:   time.Now(),
+		LatestStage: models.PagesDeploymentStage{Name: "deploy", Status: "success"},
+		Trigger: models.PagesDeploymentTrigger{
+			Type:     "github:push",
+			Metadata: models.PagesDeploymentTriggerMetadata{Branch: "dependabot/npm_and_yarn/lodash-4.17.21"},
+		},
+	})
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site-deps", Namespace: "default", Generation: 1},
+	}
+	r, fakeClient := newURLTestReconciler(t, deployment)
+
+	result, err := api.GetPagesDeployment(ctx, "my-site", "dep-1")
+	require.NoError(t, err)
+	_, err = r.updateDeploymentStatus(ctx, deployment, "my-site", "test-account-id", result)
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, "https://abc123.my-site-4xz.pages.dev", updated.Status.HashURL)
+	assert.Equal(t, "https://dependabot-npm-and-yarn-loda.my-site-4xz.pages.dev", updated.Status.BranchURL,
+		"the branch URL uses the project subdomain of the hash URL")
+}
+
+func TestUpdateDeploymentStatus_DirectUploadURLs(t *testing.T) {
+	ctx := context.Background()
+	api, mock := newURLTestAPI(t)
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site-preview", Namespace: "default", Generation: 1},
+	}
+	r, fakeClient := newURLTestReconciler(t, deployment)
+
+	result := directUploadDeploymentResult(&cf.PagesDirectUploadResult{
+		ID:    "dep-1",
+		URL:   "https://abc123.my-site.pages.dev",
+		Stage: "queued",
+	}, "my-site", &cf.PagesDeploymentMetadata{Branch: "staging"})
+	_, err := r.updateDeploymentStatus(ctx, deployment, "my-site", "test-account-id", result)
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, "https://abc123.my-site.pages.dev", updated.Status.HashURL)
+	assert.Equal(t, "https://staging.my-site.pages.dev", updated.Status.BranchURL)
+
+	// A poll whose response has no branch keeps the branch URL
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-1",
+		ShortID:     "abc123",
+		ProjectName: "my-site",
+		URL:         "https://abc123.my-site.pages.dev",
+		CreatedOn:   time.Now(),
+		LatestStage: models.PagesDeploymentStage{Name: "deploy", Status: "success"},
+	})
+	_, err = r.pollDeploymentStatus(ctx, updated, "my-site", &common.APIClientResult{API: api, AccountID: "test-account-id"})
+	require.NoError(t, err)
+
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentStateSucceeded, updated.Status.State)
+	assert.Equal(t, "https://abc123.my-site.pages.dev", updated.Status.HashURL)
+	assert.Equal(t, "https://staging.my-site.pages.dev", updated.Status.BranchURL)
+}
+
+func TestPagesBranchAlias(t *testing.T) {
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{branch: "main", want: "main"},
+		{branch: "Feature/Login", want: "feature-login"},
+		{branch: "fix_bug.123", want: "fix-bug-123"},
+		{branch: "renovate/a-very-long-branch-name-indeed", want: "renovate-a-very-long-branch"},
+		{branch: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			assert.Equal(t, tt.want, pagesBranchAlias(tt.branch))
+		})
+	}
+}
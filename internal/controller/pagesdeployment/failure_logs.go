@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdeployment
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+const (
+	// DefaultFailureLogLines is the number of build log lines kept when spec.failureLogLines is not set
+	DefaultFailureLogLines = 50
+
+	// MaxFailureLogLineLength is the maximum length of a single stored build log line
+	MaxFailureLogLineLength = 512
+)
+
+// deploymentStage returns the effective stage of a Cloudflare deployment.
+// A non-active stage status (e.g. "failure") takes precedence over the stage name.
+func deploymentStage(result *cf.PagesDeploymentResult) string {
+	if result.StageStatus != "" && result.StageStatus != "active" {
+		return result.StageStatus
+	}
+	return result.Stage
+}
+
+// isFailedStage reports whether the stage indicates a failed deployment.
+func isFailedStage(stage string) bool {
+	return stage == "failure" || stage == "failed"
+}
+
+// failureLogLines returns the number of build log lines to keep for a failed deployment.
+func failureLogLines(deployment *networkingv1alpha2.PagesDeployment) int {
+	if deployment.Spec.FailureLogLines != nil {
+		return int(*deployment.Spec.FailureLogLines)
+	}
+	return DefaultFailureLogLines
+}
+
+// recordFailureLogs fetches the build logs of a failed deployment and stores the
+// last lines in the status. Fetching is best-effort: errors are logged and the
+// status is updated without logs.
+func (r *PagesDeploymentReconciler) recordFailureLogs(
+	ctx context.Context,
+	deployment *networkingv1alpha2.PagesDeployment,
+	api cf.CloudflareClient,
+	projectName, deploymentID string,
+) {
+	limit := failureLogLines(deployment)
+	if limit <= 0 {
+		return
+	}
+
+	logs, err := api.GetPagesDeploymentLogs(ctx, projectName, deploymentID)
+	if err != nil {
+		log.FromContext(ctx).Info("Failed to get deployment logs for failed deployment",
+			"deploymentId", deploymentID,
+			"error", err.Error())
+		return
+	}
+
+	deployment.Status.FailureLogs = tailLogLines(logs.Data, limit)
+}
+
+// tailLogLines returns the last limit log lines, truncating long lines.
+func tailLogLines(entries []cf.PagesDeploymentLogEntry, limit int) []string {
+	if len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		line := entry.Message
+		if len(line) > MaxFailureLogLineLength {
+			line = line[:MaxFailureLogLineLength] + "..."
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// failureMessage builds the deployment failed event message, including the last build log line when known.
+func failureMessage(failureLogs []string) string {
+	if len(failureLogs) == 0 {
+		return "Deployment failed"
+	}
+	return fmt.Sprintf("Deployment failed: %s", failureLogs[len(failureLogs)-1])
+}
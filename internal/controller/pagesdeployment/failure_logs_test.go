@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdeployment
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func TestPollDeploymentStatus_SurfacesFailureLogs(t *testing.T) {
+	ctx := context.Background()
+
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	defer server.Close()
+
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-failed",
+		ShortID:     "abc123",
+		ProjectName: "my-site",
+		Environment: "preview",
+		LatestStage: models.PagesDeploymentStage{Name: "build", Status: "failure"},
+	})
+	lines := make([]string, 0, 10)
+	for i := 1; i <= 10; i++ {
+		lines = append(lines, fmt.Sprintf("build step %d", i))
+	}
+	lines[9] = "Error: npm run build exited with code 1"
+	mock.Store().SetPagesDeploymentLogs("dep-failed", lines)
+
+	cfClient, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL+"/client/v4"))
+	require.NoError(t, err)
+	apiResult := &common.APIClientResult{
+		API:       &cf.API{Log: logr.Discard(), CloudflareClient: cfClient, ValidAccountId: "test-account-id"},
+		AccountID: "test-account-id",
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site-preview", Namespace: "default", Generation: 1},
+		Spec:       networkingv1alpha2.PagesDeploymentSpec{FailureLogLines: ptr.To(int32(3))},
+		Status: networkingv1alpha2.PagesDeploymentStatus{
+			DeploymentID: "dep-failed",
+			State:        networkingv1alpha2.PagesDeploymentStateBuilding,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment).
+		WithStatusSubresource(deployment).
+		Build()
+	recorder := record.NewFakeRecorder(20)
+	r := &PagesDeploymentReconciler{Client: fakeClient, Scheme: scheme, Recorder: recorder}
+
+	_, err = r.pollDeploymentStatus(ctx, deployment, "my-site", apiResult)
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentStateFailed, updated.Status.State)
+	assert.Equal(t, []string{"build step 8", "build step 9", "Error: npm run build exited with code 1"},
+		updated.Status.FailureLogs)
+
+	var failedEvent string
+	for len(recorder.Events) > 0 {
+		event := <-recorder.Events
+		if strings.Contains(event, EventReasonDeploymentFailed) {
+			failedEvent = event
+		}
+	}
+	assert.Contains(t, failedEvent, "Error: npm run build exited with code 1")
+}
+
+func TestTailLogLines(t *testing.T) {
+	entries := []cf.PagesDeploymentLogEntry{
+		{Message: "first"},
+		{Message: "second"},
+		{Message: strings.Repeat("x", MaxFailureLogLineLength+10)},
+	}
+
+	lines := tailLogLines(entries, 2)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "second", lines[0])
+	assert.Equal(t, strings.Repeat("x", MaxFailureLogLineLength)+"...", lines[1])
+
+	assert.Len(t, tailLogLines(entries, 10), 3)
+	assert.Empty(t, tailLogLines(nil, 10))
+}
+
+func TestFailureLogLines(t *testing.T) {
+	deployment := &networkingv1alpha2.PagesDeployment{}
+	assert.Equal(t, DefaultFailureLogLines, failureLogLines(deployment))
+
+	deployment.Spec.FailureLogLines = ptr.To(int32(0))
+	assert.Equal(t, 0, failureLogLines(deployment))
+}
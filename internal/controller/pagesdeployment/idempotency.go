@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdeployment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// idempotencyKeyLength is the number of hex characters kept from the key digest.
+const idempotencyKeyLength = 32
+
+// computeIdempotencyKey returns a deterministic key for a deployment attempt.
+// The key covers the project, the PagesDeployment identity, the spec (source,
+// environment, rollback target), the version label and the force-redeploy value,
+// so the same desired deployment always maps to the same key.
+func computeIdempotencyKey(deployment *networkingv1alpha2.PagesDeployment, projectName string) string {
+	spec, _ := json.Marshal(deployment.Spec)
+
+	h := sha256.New()
+	for _, part := range []string{
+		projectName,
+		deployment.Namespace + "/" + deployment.Name,
+		string(deployment.UID),
+		string(spec),
+		extractVersionName(deployment),
+		deployment.Annotations[AnnotationForceRedeploy],
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:idempotencyKeyLength]
+}
+
+// claimIdempotencyKey records the key on the PagesDeployment before a deployment is created.
+// The update uses the object's resourceVersion, so a reconcile working from a stale
+// copy gets a conflict instead of creating a second Cloudflare deployment.
+// An existing claim for the same key keeps its original timestamp.
+func (r *PagesDeploymentReconciler) claimIdempotencyKey(
+	ctx context.Context,
+	deployment *networkingv1alpha2.PagesDeployment,
+	key string,
+) error {
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	if deployment.Annotations[AnnotationIdempotencyKey] != key {
+		deployment.Annotations[AnnotationIdempotencyKey] = key
+		deployment.Annotations[AnnotationIdempotencyClaimedAt] = time.Now().UTC().Format(time.RFC3339)
+	}
+	return r.Update(ctx, deployment)
+}
+
+// findClaimedDeployment returns the Cloudflare deployment recorded for the claimed
+// idempotency key whose ID was never written to status, e.g. because the status
+// update failed or a reconcile read a stale cache.
+// Only the deployment ID this resource recorded at creation is adopted, so
+// deployments created by anything else are never picked up.
+// Returns nil (not error) if the key was not claimed or no deployment was recorded.
+func (*PagesDeploymentReconciler) findClaimedDeployment(
+	ctx context.Context,
+	deployment *networkingv1alpha2.PagesDeployment,
+	projectName string,
+	api cf.CloudflareClient,
+	key string,
+) (*cf.PagesDeploymentResult, error) {
+	if deployment.Status.DeploymentID != "" || deployment.Annotations[AnnotationIdempotencyKey] != key {
+		return nil, nil
+	}
+	deploymentID := deployment.Annotations[AnnotationIdempotencyDeploymentID]
+	if deploymentID == "" {
+		return nil, nil
+	}
+
+	result, err := api.GetPagesDeployment(ctx, projectName, deploymentID)
+	if err != nil {
+		if cf.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// releaseIdempotencyClaim removes the idempotency claim from the PagesDeployment,
+// so the next attempt for the same key creates a new deployment instead of
+// adopting one that failed.
+func (r *PagesDeploymentReconciler) releaseIdempotencyClaim(
+	ctx context.Context,
+	deployment *networkingv1alpha2.PagesDeployment,
+) error {
+	if deployment.Annotations[AnnotationIdempotencyKey] == "" {
+		return nil
+	}
+	delete(deployment.Annotations, AnnotationIdempotencyKey)
+	delete(deployment.Annotations, AnnotationIdempotencyClaimedAt)
+	delete(deployment.Annotations, AnnotationIdempotencyDeploymentID)
+	if err := r.Update(ctx, deployment); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
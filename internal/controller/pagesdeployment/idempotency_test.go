@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdeployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+// pagesDeploymentsStub serves the Pages deployment create, get and list endpoints.
+type pagesDeploymentsStub struct {
+	mu          sync.Mutex
+	creates     int
+	stage       string
+	deployments []map[string]any
+}
+
+func (s *pagesDeploymentsStub) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rw.Header().Set("Content-Type", "application/json")
+	var result any
+	switch req.Method {
+	case http.MethodPost:
+		s.creates++
+		dep := map[string]any{
+			"id":           fmt.Sprintf("dep-%d", s.creates),
+			"short_id":     fmt.Sprintf("short%d", s.creates),
+			"project_name": "my-site",
+			"environment":  "preview",
+			"created_on":   time.Now().UTC().Format(time.RFC3339Nano),
+			"latest_stage": map[string]any{"name": "queued", "status": "active"},
+		}
+		s.deployments = append(s.deployments, dep)
+		result = dep
+	default:
+		result = s.deployments
+		id := path.Base(req.URL.Path)
+		for _, dep := range s.deployments {
+			if dep["id"] == id {
+				if s.stage != "" {
+					dep["latest_stage"] = map[string]any{"name": s.stage, "status": s.stage}
+				}
+				result = dep
+			}
+		}
+	}
+
+	_ = json.NewEncoder(rw).Encode(map[string]any{
+		"success":     true,
+		"errors":      []any{},
+		"messages":    []any{},
+		"result":      result,
+		"result_info": map[string]any{"page": 1, "per_page": 25, "count": len(s.deployments), "total_count": len(s.deployments), "total_pages": 1},
+	})
+}
+
+func newIdempotencyTestReconciler(t *testing.T) (*PagesDeploymentReconciler, client.Client, *networkingv1alpha2.PagesDeployment) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-site-preview",
+			Namespace:  "default",
+			UID:        "deployment-uid",
+			Generation: 1,
+			Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.PagesDeploymentSpec{
+			Environment: networkingv1alpha2.PagesDeploymentEnvironmentPreview,
+			Source: &networkingv1alpha2.PagesDeploymentSourceSpec{
+				Type: networkingv1alpha2.PagesDeploymentSourceTypeGit,
+				Git:  &networkingv1alpha2.PagesGitSourceSpec{Branch: "feature"},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(deployment).
+		WithStatusSubresource(deployment).
+		Build()
+	r := &PagesDeploymentReconciler{Client: fakeClient, Scheme: scheme, Recorder: record.NewFakeRecorder(20)}
+	return r, fakeClient, deployment
+}
+
+func newIdempotencyTestAPI(t *testing.T, stub *pagesDeploymentsStub) *common.APIClientResult {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	cfClient, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+	return &common.APIClientResult{
+		API:       &cf.API{Log: logr.Discard(), CloudflareClient: cfClient, ValidAccountId: "account-id"},
+		AccountID: "account-id",
+	}
+}
+
+func TestCreateDeployment_ConcurrentReconcilesCreateOnce(t *testing.T) {
+	ctx := context.Background()
+	r, fakeClient, deployment := newIdempotencyTestReconciler(t)
+	stub := &pagesDeploymentsStub{}
+	apiResult := newIdempotencyTestAPI(t, stub)
+
+	current := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), current))
+
+	// Both reconciles start from the same observed version of the object
+	var wg sync.WaitGroup
+	for range 2 {
+		copied := current.DeepCopy()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := r.createDeployment(ctx, copied, "my-site", apiResult)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, stub.creates)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, "dep-1", updated.Status.DeploymentID)
+	assert.Equal(t, computeIdempotencyKey(updated, "my-site"), updated.Annotations[AnnotationIdempotencyKey])
+	assert.NotEmpty(t, updated.Annotations[AnnotationIdempotencyClaimedAt])
+	assert.Equal(t, "dep-1", updated.Annotations[AnnotationIdempotencyDeploymentID])
+}
+
+func TestCreateDeployment_AdoptsDeploymentForClaimedKey(t *testing.T) {
+	ctx := context.Background()
+	r, fakeClient, deployment := newIdempotencyTestReconciler(t)
+	stub := &pagesDeploymentsStub{}
+	apiResult := newIdempotencyTestAPI(t, stub)
+
+	current := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), current))
+	_, err := r.createDeployment(ctx, current, "my-site", apiResult)
+	require.NoError(t, err)
+	require.Equal(t, 1, stub.creates)
+
+	// A reconcile that sees the claim but not the recorded deployment ID adopts it
+	stale := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), stale))
+	stale.Status.DeploymentID = ""
+	_, err = r.createDeployment(ctx, stale, "my-site", apiResult)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stub.creates)
+	assert.Equal(t, "dep-1", stale.Status.DeploymentID)
+
+	// A new force-redeploy value is a new key and creates a new deployment
+	latest := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), latest))
+	latest.Annotations[AnnotationForceRedeploy] = "v2"
+	_, err = r.createDeployment(ctx, latest, "my-site", apiResult)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stub.creates)
+	assert.Equal(t, "dep-2", latest.Status.DeploymentID)
+}
+
+func TestCreateDeployment_DoesNotAdoptUnrecordedDeployment(t *testing.T) {
+	ctx := context.Background()
+	r, fakeClient, deployment := newIdempotencyTestReconciler(t)
+	stub := &pagesDeploymentsStub{}
+	apiResult := newIdempotencyTestAPI(t, stub)
+
+	// A deployment created by something else after the claim
+	stub.deployments = append(stub.deployments, map[string]any{
+		"id":           "foreign",
+		"project_name": "my-site",
+		"environment":  "preview",
+		"created_on":   time.Now().UTC().Format(time.RFC3339Nano),
+		"latest_stage": map[string]any{"name": "queued", "status": "active"},
+	})
+
+	current := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), current))
+	current.Annotations = map[string]string{
+		AnnotationIdempotencyKey:       computeIdempotencyKey(current, "my-site"),
+		AnnotationIdempotencyClaimedAt: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+	}
+	require.NoError(t, fakeClient.Update(ctx, current))
+
+	_, err := r.createDeployment(ctx, current, "my-site", apiResult)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stub.creates)
+	assert.Equal(t, "dep-1", current.Status.DeploymentID)
+}
+
+func TestPollDeploymentStatus_FailureReleasesClaim(t *testing.T) {
+	ctx := context.Background()
+	r, fakeClient, deployment := newIdempotencyTestReconciler(t)
+	stub := &pagesDeploymentsStub{}
+	apiResult := newIdempotencyTestAPI(t, stub)
+
+	current := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), current))
+	_, err := r.createDeployment(ctx, current, "my-site", apiResult)
+	require.NoError(t, err)
+	require.Equal(t, "dep-1", current.Annotations[AnnotationIdempotencyDeploymentID])
+
+	stub.stage = "failure"
+	_, err = r.pollDeploymentStatus(ctx, current, "my-site", apiResult)
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), updated))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentStateFailed, updated.Status.State)
+	assert.NotContains(t, updated.Annotations, AnnotationIdempotencyKey)
+	assert.NotContains(t, updated.Annotations, AnnotationIdempotencyDeploymentID)
+}
+
+func TestComputeIdempotencyKey(t *testing.T) {
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "site-v1", Namespace: "default", UID: "uid-1"},
+		Spec: networkingv1alpha2.PagesDeploymentSpec{
+			Environment: networkingv1alpha2.PagesDeploymentEnvironmentProduction,
+			Source: &networkingv1alpha2.PagesDeploymentSourceSpec{
+				Type: networkingv1alpha2.PagesDeploymentSourceTypeGit,
+				Git:  &networkingv1alpha2.PagesGitSourceSpec{Branch: "main"},
+			},
+		},
+	}
+
+	key := computeIdempotencyKey(deployment, "my-site")
+	assert.Len(t, key, idempotencyKeyLength)
+	assert.Equal(t, key, computeIdempotencyKey(deployment.DeepCopy(), "my-site"))
+	assert.NotEqual(t, key, computeIdempotencyKey(deployment, "other-site"))
+
+	changed := deployment.DeepCopy()
+	changed.Spec.Source.Git.Branch = "release"
+	assert.NotEqual(t, key, computeIdempotencyKey(changed, "my-site"))
+
+	forced := deployment.DeepCopy()
+	forced.Annotations = map[string]string{AnnotationForceRedeploy: "again"}
+	assert.NotEqual(t, key, computeIdempotencyKey(forced, "my-site"))
+}
@@ -150,7 +150,7 @@ func (r *PagesDomainReconciler) handleDeletion(
 	}
 
 	// Check deletion policy
-	if domain.Spec.DeletionPolicy == "Orphan" {
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, domain, domain.Spec.DeletionPolicy) {
 		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
 	} else {
 		// Get API client
@@ -163,8 +163,12 @@ func (r *PagesDomainReconciler) handleDeletion(
 			logger.Error(err, "Failed to get API client for deletion")
 			// Continue with finalizer removal even if API client fails
 		} else {
-			// Resolve project name
-			projectName, err := r.resolveProjectName(ctx, domain)
+			// Prefer the project the domain was added to, as a referenced
+			// PagesProject may already be gone
+			projectName := domain.Status.ProjectName
+			if projectName == "" {
+				projectName, err = r.resolveProjectName(ctx, domain)
+			}
 			if err != nil {
 				logger.Error(err, "Failed to resolve project name for deletion")
 				// Continue with finalizer removal
@@ -175,14 +179,9 @@ func (r *PagesDomainReconciler) handleDeletion(
 					"domain", domain.Spec.Domain)
 
 				if err := apiResult.API.DeletePagesDomain(ctx, projectName, domain.Spec.Domain); err != nil {
-					if !cf.IsNotFoundError(err) {
-						logger.Error(err, "Failed to delete Pages domain from Cloudflare, continuing with finalizer removal")
-						r.Recorder.Event(domain, corev1.EventTypeWarning, "DeleteFailed",
-							fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-						// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
-					} else {
-						// NotFound is fine, domain already deleted
-						logger.Info("Pages domain not found in Cloudflare, may have been already deleted")
+					logger.Error(err, "Failed to delete Pages domain from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, domain, domain.Spec.DeletionTimeout, err); retry {
+						return result, nil
 					}
 				} else {
 					r.Recorder.Event(domain, corev1.EventTypeNormal, "Deleted",
@@ -256,58 +255,105 @@ func (r *PagesDomainReconciler) handleCreateDomain(
 	return r.setSuccessStatus(ctx, domain, apiResult.AccountID, projectName, result)
 }
 
-// setSuccessStatus updates the domain status with success.
+// domainState maps the status of a domain in Cloudflare to its State.
+func domainState(status string) networkingv1alpha2.PagesDomainState {
+	switch status {
+	case "active":
+		return networkingv1alpha2.PagesDomainStateActive
+	case "verifying":
+		return networkingv1alpha2.PagesDomainStateVerifying
+	case "moved":
+		return networkingv1alpha2.PagesDomainStateMoved
+	case "deleting":
+		return networkingv1alpha2.PagesDomainStateDeleting
+	case "error", "blocked":
+		return networkingv1alpha2.PagesDomainStateError
+	default:
+		// initializing and pending
+		return networkingv1alpha2.PagesDomainStatePending
+	}
+}
+
+// readyCondition returns the status, reason and message of the Ready condition
+// for a domain: whether it is active, failed, or still waiting for ownership
+// verification or SSL certificate validation.
+func readyCondition(result *cf.PagesDomainResult) (metav1.ConditionStatus, string, string) {
+	switch {
+	case result.Status == "active":
+		return metav1.ConditionTrue, "Active", "Pages domain is active and serving traffic"
+	case domainState(result.Status) == networkingv1alpha2.PagesDomainStateError:
+		message := fmt.Sprintf("Pages domain is %s", result.Status)
+		if result.ErrorMessage != "" {
+			message += ": " + result.ErrorMessage
+		}
+		return metav1.ConditionFalse, "Failed", message
+	case result.VerificationStatus != "" && result.VerificationStatus != "active":
+		return metav1.ConditionFalse, "PendingVerification",
+			fmt.Sprintf("Domain ownership verification is %s", result.VerificationStatus)
+	case result.ValidationStatus != "" && result.ValidationStatus != "active":
+		return metav1.ConditionFalse, "PendingCertificate",
+			fmt.Sprintf("SSL certificate validation is %s", result.ValidationStatus)
+	default:
+		return metav1.ConditionFalse, "Pending", fmt.Sprintf("Pages domain is %s", result.Status)
+	}
+}
+
+// verificationData returns the DNS record that validates the SSL certificate of
+// the domain, or nil when no record is needed.
+func verificationData(result *cf.PagesDomainResult) *networkingv1alpha2.PagesDomainVerificationData {
+	if result.ValidationMethod != "txt" || result.ValidationTXTName == "" || result.ValidationStatus == "active" {
+		return nil
+	}
+	return &networkingv1alpha2.PagesDomainVerificationData{
+		RecordType:  "TXT",
+		RecordName:  result.ValidationTXTName,
+		RecordValue: result.ValidationTXTValue,
+	}
+}
+
+// setSuccessStatus updates the domain status from Cloudflare. Domains that are
+// not active yet are polled until verification and validation have finished.
 func (r *PagesDomainReconciler) setSuccessStatus(
 	ctx context.Context,
 	domain *networkingv1alpha2.PagesDomain,
 	accountID, projectName string,
 	result *cf.PagesDomainResult,
 ) (ctrl.Result, error) {
+	state := domainState(result.Status)
+	conditionStatus, reason, message := readyCondition(result)
+
+	if state == networkingv1alpha2.PagesDomainStateError && domain.Status.State != state {
+		r.Recorder.Event(domain, corev1.EventTypeWarning, "DomainFailed", message)
+	} else if state == networkingv1alpha2.PagesDomainStateActive && domain.Status.State != state {
+		r.Recorder.Event(domain, corev1.EventTypeNormal, "Active",
+			fmt.Sprintf("Pages domain '%s' is active", domain.Spec.Domain))
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, domain, func() {
 		domain.Status.AccountID = accountID
 		domain.Status.ProjectName = projectName
 		domain.Status.DomainID = result.ID
 		domain.Status.Status = result.Status
 		domain.Status.ZoneID = result.ZoneTag
+		domain.Status.CertificateAuthority = result.CertificateAuthority
+		domain.Status.VerificationStatus = result.VerificationStatus
 		domain.Status.ValidationMethod = result.ValidationMethod
 		domain.Status.ValidationStatus = result.ValidationStatus
-
-		// Map Cloudflare status to State
-		switch result.Status {
-		case "active":
-			domain.Status.State = networkingv1alpha2.PagesDomainStateActive
-		case "pending":
-			domain.Status.State = networkingv1alpha2.PagesDomainStatePending
-		case "verifying":
-			domain.Status.State = networkingv1alpha2.PagesDomainStateVerifying
-		case "moved":
-			domain.Status.State = networkingv1alpha2.PagesDomainStateMoved
-		case "deleting":
-			domain.Status.State = networkingv1alpha2.PagesDomainStateDeleting
-		default:
-			domain.Status.State = networkingv1alpha2.PagesDomainStatePending
+		domain.Status.VerificationData = verificationData(result)
+		domain.Status.State = state
+		domain.Status.Message = ""
+		if conditionStatus != metav1.ConditionTrue {
+			domain.Status.Message = message
 		}
 
-		// Set Ready condition based on status
-		if result.Status == "active" {
-			meta.SetStatusCondition(&domain.Status.Conditions, metav1.Condition{
-				Type:               "Ready",
-				Status:             metav1.ConditionTrue,
-				ObservedGeneration: domain.Generation,
-				Reason:             "Active",
-				Message:            "Pages domain is active and serving traffic",
-				LastTransitionTime: metav1.Now(),
-			})
-		} else {
-			meta.SetStatusCondition(&domain.Status.Conditions, metav1.Condition{
-				Type:               "Ready",
-				Status:             metav1.ConditionFalse,
-				ObservedGeneration: domain.Generation,
-				Reason:             "Pending",
-				Message:            fmt.Sprintf("Pages domain is %s", result.Status),
-				LastTransitionTime: metav1.Now(),
-			})
-		}
+		meta.SetStatusCondition(&domain.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             conditionStatus,
+			ObservedGeneration: domain.Generation,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
 		domain.Status.ObservedGeneration = domain.Generation
 	})
 
@@ -315,12 +361,16 @@ func (r *PagesDomainReconciler) setSuccessStatus(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
-	// If domain is not yet active, poll for status updates
-	if result.Status != "active" {
+	switch state {
+	case networkingv1alpha2.PagesDomainStateActive:
+		return common.NoRequeue(), nil
+	case networkingv1alpha2.PagesDomainStateError:
+		// A failed domain may recover once its DNS is fixed
+		return common.RequeueVeryLong(), nil
+	default:
+		// Poll until verification and validation have finished
 		return common.RequeueMedium(), nil
 	}
-
-	return common.NoRequeue(), nil
 }
 
 // autoConfigureDNSRecord automatically creates a CNAME DNS record if autoConfigureDNS is enabled.
@@ -527,7 +577,7 @@ func (r *PagesDomainReconciler) findDomainsForProject(ctx context.Context, obj c
 }
 
 func (r *PagesDomainReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.Recorder = mgr.GetEventRecorderFor("pagesdomain-controller")
+	r.Recorder = common.NewDedupRecorder(mgr.GetEventRecorderFor("pagesdomain-controller"), common.DefaultEventDedupWindow)
 
 	// Initialize APIClientFactory
 	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("pagesdomain"))
@@ -536,5 +586,5 @@ func (r *PagesDomainReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&networkingv1alpha2.PagesDomain{}).
 		Watches(&networkingv1alpha2.PagesProject{},
 			handler.EnqueueRequestsFromMapFunc(r.findDomainsForProject)).
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.PagesDomain{}, r))
 }
@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesdomain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/injection"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+const (
+	testProject = "my-app"
+	testDomain  = "www.example.com"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*PagesDomainReconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesDomain{}).
+		Build()
+
+	return &PagesDomainReconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newDomain(mutate func(spec *networkingv1alpha2.PagesDomainSpec)) *networkingv1alpha2.PagesDomain {
+	autoConfigureDNS := false
+	domain := &networkingv1alpha2.PagesDomain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "www",
+			Namespace:  "default",
+			Finalizers: []string{FinalizerName},
+		},
+		Spec: networkingv1alpha2.PagesDomainSpec{
+			Domain:     testDomain,
+			ProjectRef: networkingv1alpha2.PagesProjectRef{CloudflareName: testProject},
+			Cloudflare: networkingv1alpha2.CloudflareDetails{
+				CredentialsRef: &networkingv1alpha2.CloudflareCredentialsRef{Name: "default"},
+			},
+			AutoConfigureDNS: &autoConfigureDNS,
+		},
+	}
+	if mutate != nil {
+		mutate(&domain.Spec)
+	}
+	return domain
+}
+
+// reconcileDomain reconciles the "www" domain and returns the result and the updated domain.
+func reconcileDomain(t *testing.T, r *PagesDomainReconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.PagesDomain) {
+	t.Helper()
+	key := types.NamespacedName{Name: "www", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	domain := &networkingv1alpha2.PagesDomain{}
+	require.NoError(t, c.Get(context.Background(), key, domain))
+	return result, domain
+}
+
+// deleteDomain deletes the domain and reconciles the deletion.
+func deleteDomain(t *testing.T, r *PagesDomainReconciler, c client.Client, domain *networkingv1alpha2.PagesDomain) ctrl.Result {
+	t.Helper()
+	require.NoError(t, c.Delete(context.Background(), domain))
+	result, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "www", Namespace: "default"},
+	})
+	require.NoError(t, err)
+	return result
+}
+
+func TestReconcile_AddsDomainPendingVerification(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(nil))
+
+	result, domain := reconcileDomain(t, r, c)
+
+	assert.Equal(t, common.RequeueIntervalMedium, result.RequeueAfter, "a pending domain is polled")
+	assert.Equal(t, networkingv1alpha2.PagesDomainStatePending, domain.Status.State)
+	assert.Equal(t, testProject, domain.Status.ProjectName)
+	assert.NotEmpty(t, domain.Status.DomainID)
+	assert.Equal(t, "pending", domain.Status.VerificationStatus)
+	assert.Equal(t, "pending", domain.Status.ValidationStatus)
+	assert.Equal(t, "google", domain.Status.CertificateAuthority)
+
+	condition := meta.FindStatusCondition(domain.Status.Conditions, "Ready")
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, "PendingVerification", condition.Reason)
+
+	require.NotNil(t, domain.Status.VerificationData)
+	assert.Equal(t, "TXT", domain.Status.VerificationData.RecordType)
+	assert.Equal(t, "_cf-custom-hostname."+testDomain, domain.Status.VerificationData.RecordName)
+	assert.NotEmpty(t, domain.Status.VerificationData.RecordValue)
+
+	_, ok := mock.Store().GetPagesDomain(testProject, testDomain)
+	assert.True(t, ok)
+
+	// A second reconcile polls the domain instead of adding it again
+	reconcileDomain(t, r, c)
+	assert.Equal(t, 1, mock.CountRequests(http.MethodPost, "/domains$"))
+}
+
+func TestReconcile_PendingCertificate(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(nil))
+	reconcileDomain(t, r, c)
+	require.True(t, mock.Store().SetPagesDomainStatus(testProject, testDomain, "pending", "active", "pending"))
+
+	result, domain := reconcileDomain(t, r, c)
+
+	assert.Equal(t, common.RequeueIntervalMedium, result.RequeueAfter)
+	condition := meta.FindStatusCondition(domain.Status.Conditions, "Ready")
+	require.NotNil(t, condition)
+	assert.Equal(t, "PendingCertificate", condition.Reason)
+}
+
+func TestReconcile_DomainBecomesActive(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(nil))
+	reconcileDomain(t, r, c)
+	require.True(t, mock.Store().SetPagesDomainStatus(testProject, testDomain, "active", "active", "active"))
+
+	result, domain := reconcileDomain(t, r, c)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.PagesDomainStateActive, domain.Status.State)
+	assert.True(t, meta.IsStatusConditionTrue(domain.Status.Conditions, "Ready"))
+	assert.Nil(t, domain.Status.VerificationData, "no record is needed once the certificate is validated")
+	assert.Empty(t, domain.Status.Message)
+}
+
+func TestReconcile_FailedDomain(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(nil))
+	reconcileDomain(t, r, c)
+	require.True(t, mock.Store().UpdatePagesDomain(testProject, testDomain, func(d *models.PagesDomain) {
+		d.Status = "error"
+		d.ValidationData.Status = "error"
+		d.ValidationData.ErrorMessage = "CAA records block issuance"
+	}))
+
+	result, domain := reconcileDomain(t, r, c)
+
+	assert.Equal(t, common.RequeueIntervalVeryLong, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.PagesDomainStateError, domain.Status.State)
+	assert.Contains(t, domain.Status.Message, "CAA records block issuance")
+	condition := meta.FindStatusCondition(domain.Status.Conditions, "Ready")
+	require.NotNil(t, condition)
+	assert.Equal(t, "Failed", condition.Reason)
+}
+
+func TestReconcile_ResolvesProjectFromPagesProject(t *testing.T) {
+	mock := newMockServer(t)
+	project := &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       networkingv1alpha2.PagesProjectSpec{Name: testProject},
+	}
+	r, c := newTestReconciler(t, project, newDomain(func(spec *networkingv1alpha2.PagesDomainSpec) {
+		spec.ProjectRef = networkingv1alpha2.PagesProjectRef{Name: "app"}
+	}))
+
+	_, domain := reconcileDomain(t, r, c)
+
+	assert.Equal(t, testProject, domain.Status.ProjectName)
+	_, ok := mock.Store().GetPagesDomain(testProject, testDomain)
+	assert.True(t, ok)
+}
+
+func TestReconcile_DeleteDomain(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(nil))
+	_, domain := reconcileDomain(t, r, c)
+
+	deleteDomain(t, r, c, domain)
+
+	assert.Empty(t, mock.Store().ListPagesDomains(testProject))
+	err := c.Get(context.Background(), types.NamespacedName{Name: "www", Namespace: "default"}, domain)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_DeleteDomainOfDeletedProject(t *testing.T) {
+	mock := newMockServer(t)
+	project := &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       networkingv1alpha2.PagesProjectSpec{Name: testProject},
+	}
+	r, c := newTestReconciler(t, project, newDomain(func(spec *networkingv1alpha2.PagesDomainSpec) {
+		spec.ProjectRef = networkingv1alpha2.PagesProjectRef{Name: "app"}
+	}))
+	_, domain := reconcileDomain(t, r, c)
+	require.NoError(t, c.Delete(context.Background(), project))
+
+	deleteDomain(t, r, c, domain)
+
+	assert.Empty(t, mock.Store().ListPagesDomains(testProject), "the project name is taken from status")
+}
+
+func TestReconcile_DeleteAlreadyDeletedDomain(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(nil))
+	_, domain := reconcileDomain(t, r, c)
+	require.True(t, mock.Store().DeletePagesDomain(testProject, testDomain))
+
+	deleteDomain(t, r, c, domain)
+
+	err := c.Get(context.Background(), types.NamespacedName{Name: "www", Namespace: "default"}, domain)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_FailedDeletionIsRetried(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(func(spec *networkingv1alpha2.PagesDomainSpec) {
+		spec.DeletionTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}))
+	_, domain := reconcileDomain(t, r, c)
+	require.NoError(t, mock.ErrorInjector().Add(injection.ErrorInjection{
+		PathPattern:   "/domains/" + testDomain + "$",
+		MethodPattern: http.MethodDelete,
+		ErrorType:     injection.ErrorTypeConflict,
+		TriggerMode:   injection.TriggerModeAlways,
+	}))
+
+	result := deleteDomain(t, r, c, domain)
+
+	assert.Positive(t, result.RequeueAfter)
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "www", Namespace: "default"}, domain))
+	assert.Contains(t, domain.Finalizers, FinalizerName)
+	_, ok := mock.Store().GetPagesDomain(testProject, testDomain)
+	assert.True(t, ok)
+}
+
+func TestReconcile_OrphanKeepsDomain(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain(func(spec *networkingv1alpha2.PagesDomainSpec) {
+		spec.DeletionPolicy = "Orphan"
+	}))
+	_, domain := reconcileDomain(t, r, c)
+
+	deleteDomain(t, r, c, domain)
+
+	assert.Len(t, mock.Store().ListPagesDomains(testProject), 1)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -21,13 +22,20 @@ import (
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
 )
 
 const (
 	// DefaultHealthCheckTimeout is the default timeout for health checks.
 	DefaultHealthCheckTimeout = 30 * time.Second
-	// HealthCheckSuccessCode is the HTTP status code considered as healthy.
-	HealthCheckSuccessCode = http.StatusOK
+	// HealthCheckRetryInterval is the default interval between failed health checks.
+	HealthCheckRetryInterval = 30 * time.Second
+
+	// ConditionTypeHealthCheck reports the result of the last autoPromote health check.
+	ConditionTypeHealthCheck = "HealthCheckPassed"
+
+	// maxValidationHistory is the maximum number of validation history entries.
+	maxValidationHistory = 50
 )
 
 // AutoPromoteReconciler handles the autoPromote version management policy.
@@ -133,11 +141,17 @@ func (r *AutoPromoteReconciler) Reconcile(
 				"url", healthCheckURL)
 			r.Recorder.Event(project, corev1.EventTypeWarning, "HealthCheckFailed",
 				fmt.Sprintf("Health check failed for deployment %s: %s",
-					latest.Name, err.Error()))
+					latest.Name, cf.SanitizeErrorMessage(err)))
+			if recordErr := r.recordHealthCheck(ctx, project, latest, err); recordErr != nil {
+				log.Error(recordErr, "Failed to record health check result")
+			}
 			// Requeue to retry health check
-			return 30 * time.Second, nil
+			return healthCheckRetryInterval(config), nil
 		}
 		log.Info("Health check passed", "url", healthCheckURL)
+		if err := r.recordHealthCheck(ctx, project, latest, nil); err != nil {
+			log.Error(err, "Failed to record health check result")
+		}
 	}
 
 	// 6. Promote to production
@@ -299,9 +313,8 @@ func (r *AutoPromoteReconciler) performHealthCheck(
 		_ = resp.Body.Close()
 	}()
 
-	if resp.StatusCode != HealthCheckSuccessCode {
-		return fmt.Errorf("health check returned status %d, expected %d",
-			resp.StatusCode, HealthCheckSuccessCode)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("health check returned status %d, expected 2xx", resp.StatusCode)
 	}
 
 	return nil
@@ -326,7 +339,7 @@ func (r *AutoPromoteReconciler) promoteToProduction(
 	if err := PromoteDeploymentToProduction(ctx, r.Client, deployment); err != nil {
 		r.Recorder.Event(project, corev1.EventTypeWarning, "AutoPromoteFailed",
 			fmt.Sprintf("Failed to auto-promote deployment %s: %s",
-				deployment.Name, err.Error()))
+				deployment.Name, cf.SanitizeErrorMessage(err)))
 		return fmt.Errorf("failed to promote deployment: %w", err)
 	}
 
@@ -418,13 +431,76 @@ func (r *AutoPromoteReconciler) recordValidation(
 		[]networkingv1alpha2.VersionValidation{validation},
 		fresh.Status.ValidationHistory...,
 	)
-	if len(fresh.Status.ValidationHistory) > 50 {
-		fresh.Status.ValidationHistory = fresh.Status.ValidationHistory[:50]
+	if len(fresh.Status.ValidationHistory) > maxValidationHistory {
+		fresh.Status.ValidationHistory = fresh.Status.ValidationHistory[:maxValidationHistory]
 	}
 
 	return r.Status().Update(ctx, fresh)
 }
 
+// recordHealthCheck records a health check result in validation history
+// and reflects it in the HealthCheckPassed condition.
+// Repeated checks of the same deployment replace the previous entry instead
+// of growing the history on every retry.
+func (r *AutoPromoteReconciler) recordHealthCheck(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	deployment *networkingv1alpha2.PagesDeployment,
+	checkErr error,
+) error {
+	now := metav1.Now()
+	validation := networkingv1alpha2.VersionValidation{
+		VersionName:      GetDeploymentVersionName(deployment),
+		DeploymentID:     deployment.Status.DeploymentID,
+		ValidatedAt:      &now,
+		ValidatedBy:      "healthCheck",
+		ValidationResult: "passed",
+		Message:          "Health check passed",
+	}
+	condStatus := metav1.ConditionTrue
+	reason := "HealthCheckSucceeded"
+	if checkErr != nil {
+		validation.ValidationResult = "failed"
+		validation.Message = cf.SanitizeErrorMessage(checkErr)
+		condStatus = metav1.ConditionFalse
+		reason = "HealthCheckFailed"
+	}
+
+	return controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		history := project.Status.ValidationHistory
+		if len(history) > 0 &&
+			history[0].ValidatedBy == validation.ValidatedBy &&
+			history[0].DeploymentID == validation.DeploymentID {
+			history[0] = validation
+		} else {
+			history = append([]networkingv1alpha2.VersionValidation{validation}, history...)
+		}
+		if len(history) > maxValidationHistory {
+			history = history[:maxValidationHistory]
+		}
+		project.Status.ValidationHistory = history
+
+		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeHealthCheck,
+			Status:             condStatus,
+			ObservedGeneration: project.Generation,
+			Reason:             reason,
+			Message:            fmt.Sprintf("Deployment %s: %s", deployment.Name, validation.Message),
+			LastTransitionTime: now,
+		})
+	})
+}
+
+// healthCheckRetryInterval returns how long to wait before retrying a failed health check.
+// A configured PromoteAfter shorter than the default bounds the retry interval.
+func healthCheckRetryInterval(config *networkingv1alpha2.AutoPromoteConfig) time.Duration {
+	if config.PromoteAfter != nil && config.PromoteAfter.Duration > 0 &&
+		config.PromoteAfter.Duration < HealthCheckRetryInterval {
+		return config.PromoteAfter.Duration
+	}
+	return HealthCheckRetryInterval
+}
+
 // GetRequeueAfter returns the recommended requeue duration for autoPromote mode.
 func (*AutoPromoteReconciler) GetRequeueAfter() time.Duration {
 	// Check for new preview deployments every 30 seconds
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+func newAutoPromoteTestObjects(healthCheckURL string) (*networkingv1alpha2.PagesProject, *networkingv1alpha2.PagesDeployment) {
+	finishedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+	project := &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+			VersionManagement: &networkingv1alpha2.VersionManagement{
+				Policy: networkingv1alpha2.VersionPolicyAutoPromote,
+				AutoPromote: &networkingv1alpha2.AutoPromoteConfig{
+					RequireHealthCheck: true,
+					HealthCheckURL:     healthCheckURL,
+					HealthCheckTimeout: &metav1.Duration{Duration: 5 * time.Second},
+				},
+			},
+		},
+	}
+	deployment := &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site-v1",
+			Namespace: "default",
+		},
+		Spec: networkingv1alpha2.PagesDeploymentSpec{
+			ProjectRef:  networkingv1alpha2.PagesProjectRef{Name: "my-site"},
+			VersionName: "v1",
+			Environment: networkingv1alpha2.PagesDeploymentEnvironmentPreview,
+		},
+		Status: networkingv1alpha2.PagesDeploymentStatus{
+			DeploymentID: "dep-1",
+			State:        networkingv1alpha2.PagesDeploymentStateSucceeded,
+			FinishedAt:   &finishedAt,
+		},
+	}
+	return project, deployment
+}
+
+func newAutoPromoteTestReconciler(objs ...client.Object) (*AutoPromoteReconciler, client.Client) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}, &networkingv1alpha2.PagesDeployment{}).
+		Build()
+	return NewAutoPromoteReconciler(fakeClient, scheme.Scheme, record.NewFakeRecorder(10), logr.Discard()), fakeClient
+}
+
+func TestAutoPromoteReconciler_HealthCheckPassed(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	project, deployment := newAutoPromoteTestObjects(server.URL)
+	r, fakeClient := newAutoPromoteTestReconciler(project, deployment)
+
+	requeue, err := r.Reconcile(ctx, project, nil)
+	require.NoError(t, err)
+	assert.Zero(t, requeue)
+
+	promoted := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), promoted))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, promoted.Spec.Environment)
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeHealthCheck)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+
+	require.Len(t, updated.Status.ValidationHistory, 2)
+	assert.Equal(t, "autoPromote", updated.Status.ValidationHistory[0].ValidatedBy)
+	assert.Equal(t, "healthCheck", updated.Status.ValidationHistory[1].ValidatedBy)
+	assert.Equal(t, "passed", updated.Status.ValidationHistory[1].ValidationResult)
+	assert.Equal(t, "dep-1", updated.Status.ValidationHistory[1].DeploymentID)
+}
+
+func TestAutoPromoteReconciler_HealthCheckFailed(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	project, deployment := newAutoPromoteTestObjects(server.URL)
+	r, fakeClient := newAutoPromoteTestReconciler(project, deployment)
+
+	requeue, err := r.Reconcile(ctx, project, nil)
+	require.NoError(t, err)
+	assert.Equal(t, HealthCheckRetryInterval, requeue)
+
+	notPromoted := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), notPromoted))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentPreview, notPromoted.Spec.Environment)
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeHealthCheck)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "HealthCheckFailed", cond.Reason)
+
+	require.Len(t, updated.Status.ValidationHistory, 1)
+	assert.Equal(t, "failed", updated.Status.ValidationHistory[0].ValidationResult)
+	assert.Contains(t, updated.Status.ValidationHistory[0].Message, "503")
+
+	// A retry of the same deployment must not grow the history
+	_, err = r.Reconcile(ctx, updated, nil)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Len(t, updated.Status.ValidationHistory, 1)
+}
+
+func TestHealthCheckRetryInterval(t *testing.T) {
+	assert.Equal(t, HealthCheckRetryInterval,
+		healthCheckRetryInterval(&networkingv1alpha2.AutoPromoteConfig{}))
+	assert.Equal(t, 10*time.Second, healthCheckRetryInterval(&networkingv1alpha2.AutoPromoteConfig{
+		PromoteAfter: &metav1.Duration{Duration: 10 * time.Second},
+	}))
+	assert.Equal(t, HealthCheckRetryInterval, healthCheckRetryInterval(&networkingv1alpha2.AutoPromoteConfig{
+		PromoteAfter: &metav1.Duration{Duration: 10 * time.Minute},
+	}))
+}
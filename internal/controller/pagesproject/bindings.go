@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+const (
+	// ConditionTypeBindingsResolved reports whether the bindings that reference
+	// their target by name were resolved to Cloudflare IDs.
+	ConditionTypeBindingsResolved = "BindingsResolved"
+
+	// EventReasonBindingsUnresolved is recorded when a binding reference could not be resolved.
+	EventReasonBindingsUnresolved = "BindingsUnresolved"
+)
+
+// hasBindingRefs returns whether any D1 or KV binding of the project references
+// its target by name instead of ID.
+func hasBindingRefs(project *networkingv1alpha2.PagesProject) bool {
+	configs := project.Spec.DeploymentConfigs
+	if configs == nil {
+		return false
+	}
+	for _, spec := range []*networkingv1alpha2.PagesDeploymentConfig{configs.Preview, configs.Production} {
+		if spec == nil {
+			continue
+		}
+		for _, b := range spec.D1Bindings {
+			if b.DatabaseID == "" && b.DatabaseRef != nil {
+				return true
+			}
+		}
+		for _, b := range spec.KVBindings {
+			if b.NamespaceID == "" && b.NamespaceRef != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bindingResolver resolves binding references to Cloudflare IDs, looking each
+// target up once per reconcile.
+type bindingResolver struct {
+	api          cf.CloudflareClient
+	databaseIDs  map[string]string
+	namespaceIDs map[string]string
+}
+
+// resolveBindingRefs sets the IDs of the D1 and KV bindings in params that
+// reference their target by name. An explicit ID takes precedence over a
+// reference. All failures are returned together.
+func resolveBindingRefs(
+	ctx context.Context,
+	api cf.CloudflareClient,
+	project *networkingv1alpha2.PagesProject,
+	params *cf.PagesProjectParams,
+) error {
+	configs := project.Spec.DeploymentConfigs
+	if configs == nil || params.DeploymentConfig == nil {
+		return nil
+	}
+
+	resolver := &bindingResolver{
+		api:          api,
+		databaseIDs:  make(map[string]string),
+		namespaceIDs: make(map[string]string),
+	}
+	return errors.Join(
+		resolver.resolve(ctx, "preview", configs.Preview, params.DeploymentConfig.Preview),
+		resolver.resolve(ctx, "production", configs.Production, params.DeploymentConfig.Production),
+	)
+}
+
+func (b *bindingResolver) resolve(
+	ctx context.Context,
+	env string,
+	spec *networkingv1alpha2.PagesDeploymentConfig,
+	config *cf.PagesDeploymentEnvConfig,
+) error {
+	if spec == nil || config == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, binding := range spec.D1Bindings {
+		if binding.DatabaseID != "" {
+			continue
+		}
+		if binding.DatabaseRef == nil {
+			errs = append(errs, fmt.Errorf("%s D1 binding %s: databaseId or databaseRef is required", env, binding.Name))
+			continue
+		}
+		id, err := b.databaseID(ctx, binding.DatabaseRef.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s D1 binding %s: %w", env, binding.Name, err))
+			continue
+		}
+		config.D1Bindings[binding.Name] = id
+	}
+
+	for _, binding := range spec.KVBindings {
+		if binding.NamespaceID != "" {
+			continue
+		}
+		if binding.NamespaceRef == nil {
+			errs = append(errs, fmt.Errorf("%s KV binding %s: namespaceId or namespaceRef is required", env, binding.Name))
+			continue
+		}
+		id, err := b.namespaceID(ctx, binding.NamespaceRef.Title)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s KV binding %s: %w", env, binding.Name, err))
+			continue
+		}
+		config.KVBindings[binding.Name] = id
+	}
+
+	return errors.Join(errs...)
+}
+
+func (b *bindingResolver) databaseID(ctx context.Context, name string) (string, error) {
+	if id, ok := b.databaseIDs[name]; ok {
+		return id, nil
+	}
+	database, err := b.api.GetD1DatabaseByName(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	b.databaseIDs[name] = database.ID
+	return database.ID, nil
+}
+
+func (b *bindingResolver) namespaceID(ctx context.Context, title string) (string, error) {
+	if id, ok := b.namespaceIDs[title]; ok {
+		return id, nil
+	}
+	namespace, err := b.api.GetKVNamespaceByTitle(ctx, title)
+	if err != nil {
+		return "", err
+	}
+	b.namespaceIDs[title] = namespace.ID
+	return namespace.ID, nil
+}
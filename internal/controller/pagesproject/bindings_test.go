@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf/mock"
+)
+
+func newBindingsTestProject(preview, production *networkingv1alpha2.PagesDeploymentConfig) *networkingv1alpha2.PagesProject {
+	return &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-site", Namespace: "default"},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+			DeploymentConfigs: &networkingv1alpha2.PagesDeploymentConfigs{
+				Preview:    preview,
+				Production: production,
+			},
+		},
+	}
+}
+
+func TestResolveBindingRefs_ResolvesNamesToIDs(t *testing.T) {
+	config := &networkingv1alpha2.PagesDeploymentConfig{
+		D1Bindings: []networkingv1alpha2.PagesD1Binding{
+			{Name: "DB", DatabaseRef: &networkingv1alpha2.PagesD1DatabaseRef{Name: "app-db"}},
+			{Name: "LEGACY", DatabaseID: "legacy-id"},
+		},
+		KVBindings: []networkingv1alpha2.PagesKVBinding{
+			{Name: "SESSIONS", NamespaceRef: &networkingv1alpha2.PagesKVNamespaceRef{Title: "sessions"}},
+		},
+	}
+	project := newBindingsTestProject(config, config)
+	r := &PagesProjectReconciler{}
+	params := r.buildProjectParams(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	// Each target is looked up once, although both environments reference it
+	api.EXPECT().GetD1DatabaseByName(gomock.Any(), "app-db").
+		Return(&cf.D1DatabaseResult{ID: "db-id", Name: "app-db"}, nil)
+	api.EXPECT().GetKVNamespaceByTitle(gomock.Any(), "sessions").
+		Return(&cf.KVNamespaceResult{ID: "kv-id", Title: "sessions"}, nil)
+
+	require.NoError(t, resolveBindingRefs(context.Background(), api, project, &params))
+
+	for _, env := range []*cf.PagesDeploymentEnvConfig{params.DeploymentConfig.Preview, params.DeploymentConfig.Production} {
+		assert.Equal(t, map[string]string{"DB": "db-id", "LEGACY": "legacy-id"}, env.D1Bindings)
+		assert.Equal(t, map[string]string{"SESSIONS": "kv-id"}, env.KVBindings)
+	}
+	assert.True(t, hasBindingRefs(project))
+}
+
+func TestResolveBindingRefs_ExplicitIDTakesPrecedence(t *testing.T) {
+	project := newBindingsTestProject(nil, &networkingv1alpha2.PagesDeploymentConfig{
+		D1Bindings: []networkingv1alpha2.PagesD1Binding{{
+			Name:        "DB",
+			DatabaseID:  "explicit-id",
+			DatabaseRef: &networkingv1alpha2.PagesD1DatabaseRef{Name: "app-db"},
+		}},
+	})
+	r := &PagesProjectReconciler{}
+	params := r.buildProjectParams(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+
+	require.NoError(t, resolveBindingRefs(context.Background(), api, project, &params))
+	assert.Equal(t, "explicit-id", params.DeploymentConfig.Production.D1Bindings["DB"])
+	assert.False(t, hasBindingRefs(project))
+}
+
+func TestResolveBindingRefs_MissingTarget(t *testing.T) {
+	project := newBindingsTestProject(nil, &networkingv1alpha2.PagesDeploymentConfig{
+		D1Bindings: []networkingv1alpha2.PagesD1Binding{
+			{Name: "DB", DatabaseRef: &networkingv1alpha2.PagesD1DatabaseRef{Name: "missing-db"}},
+			{Name: "EMPTY"},
+		},
+		KVBindings: []networkingv1alpha2.PagesKVBinding{
+			{Name: "SESSIONS", NamespaceRef: &networkingv1alpha2.PagesKVNamespaceRef{Title: "sessions"}},
+		},
+	})
+	r := &PagesProjectReconciler{}
+	params := r.buildProjectParams(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().GetD1DatabaseByName(gomock.Any(), "missing-db").
+		Return(nil, fmt.Errorf("%w: D1 database missing-db", cf.ErrResourceNotFound))
+	api.EXPECT().GetKVNamespaceByTitle(gomock.Any(), "sessions").
+		Return(&cf.KVNamespaceResult{ID: "kv-id", Title: "sessions"}, nil)
+
+	err := resolveBindingRefs(context.Background(), api, project, &params)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, cf.ErrResourceNotFound)
+	assert.Contains(t, err.Error(), "production D1 binding DB")
+	assert.Contains(t, err.Error(), "production D1 binding EMPTY: databaseId or databaseRef is required")
+	assert.Equal(t, "kv-id", params.DeploymentConfig.Production.KVBindings["SESSIONS"])
+}
+
+func TestUpdateStatusBindingsUnresolved(t *testing.T) {
+	ctx := context.Background()
+	project := newBindingsTestProject(nil, nil)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(project).
+		WithStatusSubresource(project).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &PagesProjectReconciler{Client: fakeClient, Scheme: scheme.Scheme, Recorder: recorder}
+
+	result, err := r.updateStatusBindingsUnresolved(ctx, project,
+		errors.New("production D1 binding DB: resource not found: D1 database missing-db"))
+
+	require.NoError(t, err)
+	assert.NotZero(t, result.RequeueAfter)
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, networkingv1alpha2.PagesProjectStateError, updated.Status.State)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeBindingsResolved)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Contains(t, condition.Message, "missing-db")
+	assert.False(t, meta.IsStatusConditionTrue(updated.Status.Conditions, "Ready"))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonBindingsUnresolved)
+}
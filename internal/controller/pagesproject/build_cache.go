@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+)
+
+const (
+	// AnnotationPurgeBuildCache requests a one-shot purge of the project's build cache
+	// when set to "true". The annotation is removed after a successful purge.
+	AnnotationPurgeBuildCache = "cloudflare-operator.io/purge-build-cache"
+
+	// EventReasonBuildCachePurged is recorded after the build cache was purged.
+	EventReasonBuildCachePurged = "BuildCachePurged"
+	// EventReasonBuildCachePurgeFailed is recorded when purging the build cache failed.
+	EventReasonBuildCachePurgeFailed = "BuildCachePurgeFailed"
+)
+
+// purgeBuildCacheIfRequested purges the Cloudflare build cache when the
+// purge-build-cache annotation is set. On failure the annotation is kept so
+// the purge is retried on the next reconcile.
+func (r *PagesProjectReconciler) purgeBuildCacheIfRequested(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	api cf.CloudflareClient,
+) error {
+	if project.Annotations[AnnotationPurgeBuildCache] != "true" {
+		return nil
+	}
+
+	projectName := r.getProjectName(project)
+	if err := api.PurgePagesProjectBuildCache(ctx, projectName); err != nil {
+		r.Recorder.Event(project, corev1.EventTypeWarning, EventReasonBuildCachePurgeFailed,
+			cf.SanitizeErrorMessage(err))
+		return err
+	}
+
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, project, func() {
+		delete(project.Annotations, AnnotationPurgeBuildCache)
+	}); err != nil {
+		return fmt.Errorf("failed to remove %s annotation: %w", AnnotationPurgeBuildCache, err)
+	}
+
+	log.FromContext(ctx).Info("Pages build cache purged", "project", projectName)
+	r.Recorder.Event(project, corev1.EventTypeNormal, EventReasonBuildCachePurged,
+		fmt.Sprintf("Build cache purged for Pages project %s", projectName))
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf/mock"
+)
+
+func newBuildCacheTestReconciler(
+	annotations map[string]string,
+) (*PagesProjectReconciler, client.Client, *record.FakeRecorder, *networkingv1alpha2.PagesProject) {
+	project := &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-site",
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{ProductionBranch: "main"},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(project).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	return &PagesProjectReconciler{Client: fakeClient, Scheme: scheme.Scheme, Recorder: recorder},
+		fakeClient, recorder, project
+}
+
+func TestPurgeBuildCacheIfRequested_NotRequested(t *testing.T) {
+	r, _, recorder, project := newBuildCacheTestReconciler(nil)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+
+	require.NoError(t, r.purgeBuildCacheIfRequested(context.Background(), project, api))
+	assert.Empty(t, recorder.Events)
+}
+
+func TestPurgeBuildCacheIfRequested_PurgesAndClearsAnnotation(t *testing.T) {
+	ctx := context.Background()
+	r, fakeClient, recorder, project := newBuildCacheTestReconciler(map[string]string{
+		AnnotationPurgeBuildCache: "true",
+		"keep":                    "me",
+	})
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().PurgePagesProjectBuildCache(gomock.Any(), "my-site").Return(nil)
+
+	require.NoError(t, r.purgeBuildCacheIfRequested(ctx, project, api))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.NotContains(t, updated.Annotations, AnnotationPurgeBuildCache)
+	assert.Equal(t, "me", updated.Annotations["keep"])
+
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonBuildCachePurged)
+
+	// A second reconcile does not purge again
+	require.NoError(t, r.purgeBuildCacheIfRequested(ctx, updated, api))
+}
+
+func TestPurgeBuildCacheIfRequested_KeepsAnnotationOnError(t *testing.T) {
+	ctx := context.Background()
+	r, fakeClient, recorder, project := newBuildCacheTestReconciler(map[string]string{
+		AnnotationPurgeBuildCache: "true",
+	})
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().PurgePagesProjectBuildCache(gomock.Any(), "my-site").
+		Return(fmt.Errorf("failed to purge build cache: %w", cf.WrapNotFound(`pages project "my-site"`, nil)))
+
+	err := r.purgeBuildCacheIfRequested(ctx, project, api)
+	require.Error(t, err)
+	assert.True(t, cf.IsNotFoundError(err))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, "true", updated.Annotations[AnnotationPurgeBuildCache])
+
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, EventReasonBuildCachePurgeFailed)
+}
+
+func TestPurgeBuildCacheIfRequested_IgnoresOtherValues(t *testing.T) {
+	r, _, _, project := newBuildCacheTestReconciler(map[string]string{
+		AnnotationPurgeBuildCache: "false",
+	})
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+
+	require.NoError(t, r.purgeBuildCacheIfRequested(context.Background(), project, api))
+}
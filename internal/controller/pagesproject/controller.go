@@ -20,6 +20,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
@@ -41,9 +42,11 @@ type PagesProjectReconciler struct {
 	autoPromoteReconciler   *AutoPromoteReconciler
 	externalReconciler      *ExternalReconciler
 	webAnalyticsReconciler  *WebAnalyticsReconciler
+	rollbackReconciler      *RollbackReconciler
 }
 
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=pagesprojects,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=pagesprojects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=pagesprojects/finalizers,verbs=update
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=pagesdeployments,verbs=get;list;watch;create;update;patch;delete
@@ -90,12 +93,35 @@ func (r *PagesProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return result, err
 	}
 
+	// Handle a one-shot build cache purge requested via annotation
+	if err := r.purgeBuildCacheIfRequested(ctx, project, apiResult.API); err != nil {
+		logger.Error(err, "Failed to purge build cache")
+		// Non-fatal, the annotation is kept and the purge retried
+	}
+
 	// Reconcile Web Analytics (RUM) after project sync
 	if err := r.webAnalyticsReconciler.Reconcile(ctx, project, apiResult.API); err != nil {
 		logger.Error(err, "Failed to reconcile Web Analytics")
 		// Non-fatal, continue with version management
 	}
 
+	// Record succeeded deployments so they can be used as rollback targets
+	if err := r.syncDeploymentHistory(ctx, project); err != nil {
+		logger.Error(err, "Failed to sync deployment history")
+		// Non-fatal, continue
+	}
+
+	// Handle spec.rollbackTo before version management
+	if err := r.rollbackReconciler.Reconcile(ctx, project, apiResult.API); err != nil {
+		if errors.Is(err, errRollbackTargetNotFound) {
+			return r.updateStatusRollbackTargetNotFound(ctx, project, err)
+		}
+		logger.Error(err, "Failed to roll back Pages project")
+		controller.RecordErrorEventAndCondition(r.Recorder, project,
+			&project.Status.Conditions, "RollbackFailed", err)
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
 	// Handle version management based on policy
 	policy := r.versionManager.GetPolicy(project)
 	if r.versionManager.HasVersions(project) {
@@ -226,7 +252,7 @@ func (r *PagesProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 		// Return with requeue if needed
 		if requeueAfter > 0 {
-			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+			return common.RequeueAfterOrDeadline(ctx, requeueAfter), nil
 		}
 	}
 
@@ -260,7 +286,7 @@ func (r *PagesProjectReconciler) handleDeletion(
 	}
 
 	// Check deletion policy
-	if project.Spec.DeletionPolicy == "Orphan" {
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, project, project.Spec.DeletionPolicy) {
 		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
 	} else {
 		// Get API client
@@ -323,19 +349,52 @@ func (r *PagesProjectReconciler) syncProject(
 
 	// Build API parameters
 	params := r.buildProjectParams(project)
+	if err := resolveBindingRefs(ctx, apiResult.API, project, &params); err != nil {
+		logger.Error(err, "Failed to resolve binding references")
+		return r.updateStatusBindingsUnresolved(ctx, project, err)
+	}
 
-	// Check if project exists
-	existing, err := apiResult.API.GetPagesProject(ctx, projectName)
-	if err != nil {
-		if !cf.IsNotFoundError(err) {
-			logger.Error(err, "Failed to get Pages project from Cloudflare")
+	getProject := func(ctx context.Context) (*cf.PagesProjectResult, error) {
+		return apiResult.API.GetPagesProject(ctx, projectName)
+	}
+	createProject := func(ctx context.Context) (*cf.PagesProjectResult, error) {
+		return apiResult.API.CreatePagesProject(ctx, params)
+	}
+
+	var existing *cf.PagesProjectResult
+	if project.Status.ProjectID == "" {
+		// Until the project is synced once, the adoption policy decides whether
+		// an existing Cloudflare project may be taken over
+		adoption, err := common.Adopt(ctx, project.Spec.AdoptionPolicy, getProject, createProject)
+		if err != nil {
+			logger.Error(err, "Failed to adopt or create Pages project", "projectName", projectName)
 			return r.updateStatusError(ctx, project, err)
 		}
-		// Project doesn't exist, create it
-		existing = nil
+		if !adoption.Adopted {
+			r.Recorder.Event(project, corev1.EventTypeNormal, "Created",
+				fmt.Sprintf("Pages project '%s' created in Cloudflare", projectName))
+			return r.updateStatusReady(ctx, project, apiResult.AccountID, adoption.Resource.Subdomain)
+		}
+		logger.Info("Adopting existing Pages project", "projectName", projectName)
+		if err := r.recordAdoption(ctx, project, adoption.Resource); err != nil {
+			return common.NoRequeue(), err
+		}
+		existing = adoption.Resource
+	} else {
+		// Check if project exists
+		var err error
+		existing, err = getProject(ctx)
+		if err != nil {
+			if !cf.IsNotFoundError(err) {
+				logger.Error(err, "Failed to get Pages project from Cloudflare")
+				return r.updateStatusError(ctx, project, err)
+			}
+			existing = nil
+		}
 	}
 
 	var result *cf.PagesProjectResult
+	var err error
 	if existing != nil {
 		// Update existing project
 		result, err = apiResult.API.UpdatePagesProject(ctx, projectName, params)
@@ -346,40 +405,70 @@ func (r *PagesProjectReconciler) syncProject(
 		logger.V(1).Info("Pages project updated in Cloudflare",
 			"projectName", projectName)
 	} else {
-		// Create new project
-		result, err = apiResult.API.CreatePagesProject(ctx, params)
+		// The managed project was deleted outside the operator, create it again
+		result, err = createProject(ctx)
 		if err != nil {
-			// Check adoption policy
-			if cf.IsConflictError(err) && project.Spec.AdoptionPolicy == "Adopt" {
-				// Try to adopt existing project
-				logger.Info("Pages project already exists, attempting to adopt",
-					"projectName", projectName)
-				existing, err = apiResult.API.GetPagesProject(ctx, projectName)
-				if err != nil {
-					logger.Error(err, "Failed to get existing project for adoption")
-					return r.updateStatusError(ctx, project, err)
-				}
-				result, err = apiResult.API.UpdatePagesProject(ctx, projectName, params)
-				if err != nil {
-					logger.Error(err, "Failed to update adopted project")
-					return r.updateStatusError(ctx, project, err)
-				}
-				r.Recorder.Event(project, corev1.EventTypeNormal, "Adopted",
-					fmt.Sprintf("Adopted existing Pages project '%s'", projectName))
-			} else {
-				logger.Error(err, "Failed to create Pages project")
-				return r.updateStatusError(ctx, project, err)
-			}
-		} else {
-			r.Recorder.Event(project, corev1.EventTypeNormal, "Created",
-				fmt.Sprintf("Pages project '%s' created in Cloudflare", projectName))
+			logger.Error(err, "Failed to create Pages project")
+			return r.updateStatusError(ctx, project, err)
 		}
+		r.Recorder.Event(project, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Pages project '%s' created in Cloudflare", projectName))
 	}
 
 	// Update status
 	return r.updateStatusReady(ctx, project, apiResult.AccountID, result.Subdomain)
 }
 
+// recordAdoption marks the project as adopted and captures the configuration
+// the Cloudflare project had before it is changed to match the spec.
+func (r *PagesProjectReconciler) recordAdoption(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	existing *cf.PagesProjectResult,
+) error {
+	// A failed update after adoption retries it; keep the first capture
+	if project.Status.Adopted && project.Status.OriginalConfig != nil {
+		return nil
+	}
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		now := metav1.Now()
+		project.Status.Adopted = true
+		project.Status.AdoptedAt = &now
+		project.Status.OriginalConfig = originalProjectConfig(existing, now)
+	}); err != nil {
+		return fmt.Errorf("failed to record adoption: %w", err)
+	}
+	r.Recorder.Event(project, corev1.EventTypeNormal, "Adopted",
+		fmt.Sprintf("Adopted existing Pages project '%s'", existing.Name))
+	return nil
+}
+
+// originalProjectConfig converts the configuration of an adopted Cloudflare
+// project to its status representation.
+func originalProjectConfig(existing *cf.PagesProjectResult, capturedAt metav1.Time) *networkingv1alpha2.PagesProjectOriginalConfig {
+	original := &networkingv1alpha2.PagesProjectOriginalConfig{
+		ProductionBranch: existing.ProductionBranch,
+		Subdomain:        existing.Subdomain,
+		CapturedAt:       capturedAt,
+	}
+	if src := existing.Source; src != nil {
+		original.Source = &networkingv1alpha2.PagesSourceConfig{Type: networkingv1alpha2.PagesSourceType(src.Type)}
+		if src.GitHub != nil {
+			github := networkingv1alpha2.PagesGitHubConfig(*src.GitHub)
+			original.Source.GitHub = &github
+		}
+		if src.GitLab != nil {
+			gitlab := networkingv1alpha2.PagesGitLabConfig(*src.GitLab)
+			original.Source.GitLab = &gitlab
+		}
+	}
+	if existing.BuildConfig != nil {
+		build := networkingv1alpha2.PagesBuildConfig(*existing.BuildConfig)
+		original.BuildConfig = &build
+	}
+	return original
+}
+
 // getProjectName returns the project name from spec or uses K8s resource name.
 func (*PagesProjectReconciler) getProjectName(project *networkingv1alpha2.PagesProject) string {
 	if project.Spec.Name != "" {
@@ -577,13 +666,20 @@ func (r *PagesProjectReconciler) updateStatusError(
 	project *networkingv1alpha2.PagesProject,
 	err error,
 ) (ctrl.Result, error) {
+	reason := "ReconcileError"
+	var adoptionErr *common.AdoptionError
+	if errors.As(err, &adoptionErr) {
+		reason = common.ReasonAdoptionFailed
+		r.Recorder.Event(project, corev1.EventTypeWarning, common.ReasonAdoptionFailed, adoptionErr.Error())
+	}
+
 	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
 		project.Status.State = networkingv1alpha2.PagesProjectStateError
 		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
 			Status:             metav1.ConditionFalse,
 			ObservedGeneration: project.Generation,
-			Reason:             "ReconcileError",
+			Reason:             reason,
 			Message:            cf.SanitizeErrorMessage(err),
 			LastTransitionTime: metav1.Now(),
 		})
@@ -597,6 +693,84 @@ func (r *PagesProjectReconciler) updateStatusError(
 	return common.RequeueShort(), nil
 }
 
+// updateStatusBindingsUnresolved reports binding references that could not be
+// resolved. The project is not synced until they resolve, as Cloudflare would
+// otherwise get bindings without IDs.
+func (r *PagesProjectReconciler) updateStatusBindingsUnresolved(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	err error,
+) (ctrl.Result, error) {
+	message := cf.SanitizeErrorMessage(err)
+	r.Recorder.Event(project, corev1.EventTypeWarning, EventReasonBindingsUnresolved, message)
+
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		project.Status.State = networkingv1alpha2.PagesProjectStateError
+		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeBindingsResolved,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: project.Generation,
+			Reason:             EventReasonBindingsUnresolved,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: project.Generation,
+			Reason:             EventReasonBindingsUnresolved,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		project.Status.ObservedGeneration = project.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+// updateStatusRollbackTargetNotFound reports a spec.rollbackTo that matches no
+// deployment history entry. Retrying cannot help, so the project is not requeued;
+// the next spec change triggers a new reconcile.
+func (r *PagesProjectReconciler) updateStatusRollbackTargetNotFound(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	err error,
+) (ctrl.Result, error) {
+	message := cf.SanitizeErrorMessage(err)
+	r.Recorder.Event(project, corev1.EventTypeWarning, ReasonRollbackTargetNotFound, message)
+
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		project.Status.State = networkingv1alpha2.PagesProjectStateError
+		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
+			Type:               ConditionTypeRolledBack,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: project.Generation,
+			Reason:             ReasonRollbackTargetNotFound,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: project.Generation,
+			Reason:             ReasonRollbackTargetNotFound,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+		project.Status.ObservedGeneration = project.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.NoRequeue(), nil
+}
+
 func (r *PagesProjectReconciler) updateStatusReady(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
@@ -607,6 +781,18 @@ func (r *PagesProjectReconciler) updateStatusReady(
 		project.Status.ProjectID = r.getProjectName(project)
 		project.Status.Subdomain = subdomain
 		project.Status.State = networkingv1alpha2.PagesProjectStateReady
+		if hasBindingRefs(project) {
+			meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
+				Type:               ConditionTypeBindingsResolved,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: project.Generation,
+				Reason:             "Resolved",
+				Message:            "Binding references resolved to Cloudflare IDs",
+				LastTransitionTime: metav1.Now(),
+			})
+		} else {
+			meta.RemoveStatusCondition(&project.Status.Conditions, ConditionTypeBindingsResolved)
+		}
 		meta.SetStatusCondition(&project.Status.Conditions, metav1.Condition{
 			Type:               "Ready",
 			Status:             metav1.ConditionTrue,
@@ -626,7 +812,7 @@ func (r *PagesProjectReconciler) updateStatusReady(
 }
 
 func (r *PagesProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.Recorder = mgr.GetEventRecorderFor("pagesproject-controller")
+	r.Recorder = common.NewDedupRecorder(mgr.GetEventRecorderFor("pagesproject-controller"), common.DefaultEventDedupWindow)
 
 	// Initialize APIClientFactory
 	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("pagesproject"))
@@ -672,6 +858,14 @@ func (r *PagesProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		logger,
 	)
 
+	// Initialize RollbackReconciler
+	r.rollbackReconciler = NewRollbackReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		r.Recorder,
+		logger,
+	)
+
 	// Initialize WebAnalyticsReconciler
 	r.webAnalyticsReconciler = NewWebAnalyticsReconciler(
 		mgr.GetClient(),
@@ -682,5 +876,7 @@ func (r *PagesProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.PagesProject{}).
 		Owns(&networkingv1alpha2.PagesDeployment{}). // Watch managed PagesDeployment resources
-		Complete(r)
+		Watches(&networkingv1alpha2.PagesDeployment{},
+			handler.EnqueueRequestsFromMapFunc(r.latestPreviewReconciler.FindProjectsForDeployment)).
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.PagesProject{}, r))
 }
@@ -6,6 +6,7 @@ package pagesproject
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -28,9 +29,16 @@ const DefaultExternalSyncInterval = 5 * time.Minute
 // It allows external systems to control versioning by updating the External config fields.
 type ExternalReconciler struct {
 	client.Client
-	Scheme   *runtime.Scheme
-	Recorder record.EventRecorder
-	Log      logr.Logger
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	Log        logr.Logger
+	HTTPClient *http.Client
+
+	// WebhookMaxAttempts bounds the delivery attempts per webhook notification
+	// before delivery waits for the next sync.
+	WebhookMaxAttempts int
+	// WebhookRetryBackoff is the initial backoff between delivery attempts, doubled after each failure.
+	WebhookRetryBackoff time.Duration
 }
 
 // NewExternalReconciler creates a new ExternalReconciler.
@@ -45,6 +53,11 @@ func NewExternalReconciler(
 		Scheme:   scheme,
 		Recorder: recorder,
 		Log:      log.WithName("external"),
+		HTTPClient: &http.Client{
+			Timeout: DefaultWebhookTimeout,
+		},
+		WebhookMaxAttempts:  DefaultWebhookMaxAttempts,
+		WebhookRetryBackoff: DefaultWebhookRetryBackoff,
 	}
 }
 
@@ -71,54 +84,85 @@ func (r *ExternalReconciler) Reconcile(
 		syncInterval = config.SyncInterval.Duration
 	}
 
-	// 1. Handle currentVersion - ensure deployment exists
+	// 1. Poll external state into spec, at most once per sync interval
+	if config.WebhookURL != "" {
+		if err := r.pollExternalState(ctx, project, config, syncInterval); err != nil {
+			log.Error(err, "Failed to poll external state")
+			// Non-fatal, continue with the current spec
+		}
+		config = project.Spec.VersionManagement.External
+	}
+
+	// 2. Handle currentVersion - ensure deployment exists
+	createdCurrent := false
 	if config.CurrentVersion != "" {
-		if err := r.reconcileCurrentVersion(ctx, project, config); err != nil {
+		created, err := r.reconcileCurrentVersion(ctx, project, config)
+		if err != nil {
 			log.Error(err, "Failed to reconcile current version", "version", config.CurrentVersion)
 			return syncInterval, err
 		}
+		createdCurrent = created
+	}
+
+	// 3. Notify the external system about needed changes
+	requeueAfter = syncInterval
+	if config.WebhookURL != "" {
+		if payload := detectExternalChange(project, config, createdCurrent); payload != nil {
+			retryAfter, err := r.notifyWebhook(ctx, project, config, payload)
+			if err != nil {
+				log.Error(err, "Failed to notify external webhook", "reason", payload.Reason)
+				// Non-fatal, delivery is retried after the backoff or on the next sync
+			}
+			if retryAfter > 0 {
+				requeueAfter = min(syncInterval, retryAfter)
+			}
+		}
 	}
 
-	// 2. Handle productionVersion - promote to production
+	// 4. Handle productionVersion - promote to production
 	if config.ProductionVersion != "" {
 		if err := r.reconcileProductionVersion(ctx, project, config, apiClient); err != nil {
 			log.Error(err, "Failed to reconcile production version", "version", config.ProductionVersion)
-			return syncInterval, err
+			return requeueAfter, err
 		}
 	}
 
-	// 3. Update version mapping in status
+	// 5. Update version mapping in status
 	if err := r.updateVersionMapping(ctx, project); err != nil {
 		log.Error(err, "Failed to update version mapping")
 		// Non-fatal, continue
 	}
 
-	return syncInterval, nil
+	return requeueAfter, nil
 }
 
 // reconcileCurrentVersion ensures a deployment exists for the current version.
+// Returns true when a new deployment was created.
 func (r *ExternalReconciler) reconcileCurrentVersion(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
 	config *networkingv1alpha2.ExternalVersionConfig,
-) error {
+) (bool, error) {
 	versionName := config.CurrentVersion
 	log := r.Log.WithValues("version", versionName, "type", "current")
 
 	// Find existing deployment by version name
 	deployment, err := r.findDeploymentByVersion(ctx, project, versionName)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if deployment != nil {
 		log.V(1).Info("Deployment for current version already exists", "deployment", deployment.Name)
-		return nil
+		return false, nil
 	}
 
 	// Create new deployment for this version
 	log.Info("Creating deployment for current version")
-	return r.createDeployment(ctx, project, versionName, config)
+	if err := r.createDeployment(ctx, project, versionName, config); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // reconcileProductionVersion validates and promotes the production version.
@@ -161,7 +205,7 @@ func (r *ExternalReconciler) reconcileProductionVersion(
 
 	if err := PromoteDeploymentToProduction(ctx, r.Client, deployment); err != nil {
 		r.Recorder.Event(project, corev1.EventTypeWarning, "PromotionFailed",
-			fmt.Sprintf("Failed to promote version %s: %s", versionName, err.Error()))
+			fmt.Sprintf("Failed to promote version %s: %s", versionName, cf.SanitizeErrorMessage(err)))
 		return fmt.Errorf("failed to promote deployment: %w", err)
 	}
 
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+)
+
+const (
+	// WebhookSignatureHeader carries the hex HMAC-SHA256 of the request body.
+	WebhookSignatureHeader = "X-Cloudflare-Operator-Signature"
+
+	// ConditionTypeWebhookDelivered reports whether the last external webhook notification was delivered.
+	ConditionTypeWebhookDelivered = "WebhookDelivered"
+
+	// DefaultWebhookMaxAttempts is the default number of delivery attempts per notification
+	// before delivery waits for the next sync.
+	DefaultWebhookMaxAttempts = 3
+	// DefaultWebhookRetryBackoff is the initial backoff between delivery attempts, doubled after each failure.
+	DefaultWebhookRetryBackoff = time.Second
+	// DefaultWebhookTimeout is the timeout of a single webhook request.
+	DefaultWebhookTimeout = 10 * time.Second
+
+	// Webhook notification reasons
+	WebhookReasonCurrentVersionDeploying = "CurrentVersionDeploying"
+	WebhookReasonProductionVersionChange = "ProductionVersionChange"
+)
+
+// ExternalWebhookPayload is the JSON body POSTed to ExternalVersionConfig.WebhookURL.
+type ExternalWebhookPayload struct {
+	Project           string    `json:"project"`
+	Namespace         string    `json:"namespace"`
+	CloudflareProject string    `json:"cloudflareProject"`
+	CurrentVersion    string    `json:"currentVersion"`
+	DesiredVersion    string    `json:"desiredVersion"`
+	Reason            string    `json:"reason"`
+	Timestamp         time.Time `json:"timestamp"`
+}
+
+// ExternalVersionState is the JSON body returned by a GET on ExternalVersionConfig.WebhookURL.
+// Empty fields leave the corresponding spec field untouched.
+type ExternalVersionState struct {
+	CurrentVersion    string `json:"currentVersion,omitempty"`
+	ProductionVersion string `json:"productionVersion,omitempty"`
+}
+
+// detectExternalChange returns the notification needed for the current external config, if any.
+func detectExternalChange(
+	project *networkingv1alpha2.PagesProject,
+	config *networkingv1alpha2.ExternalVersionConfig,
+	createdCurrent bool,
+) *ExternalWebhookPayload {
+	currentProduction := ""
+	if project.Status.CurrentProduction != nil {
+		currentProduction = project.Status.CurrentProduction.Version
+	}
+
+	payload := &ExternalWebhookPayload{
+		Project:           project.Name,
+		Namespace:         project.Namespace,
+		CloudflareProject: getProjectNameFromSpec(project),
+		CurrentVersion:    currentProduction,
+		Timestamp:         time.Now().UTC(),
+	}
+
+	switch {
+	case config.ProductionVersion != "" && config.ProductionVersion != currentProduction:
+		payload.DesiredVersion = config.ProductionVersion
+		payload.Reason = WebhookReasonProductionVersionChange
+	case createdCurrent:
+		payload.DesiredVersion = config.CurrentVersion
+		payload.Reason = WebhookReasonCurrentVersionDeploying
+	default:
+		return nil
+	}
+
+	return payload
+}
+
+// notifyWebhook makes one delivery attempt for a notification unless the same one was
+// already delivered, and records the outcome in status.externalSync and the WebhookDelivered
+// condition. A failed attempt returns the backoff after which the next attempt is due, or zero
+// once the attempts are exhausted and delivery is retried on the next sync.
+func (r *ExternalReconciler) notifyWebhook(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	config *networkingv1alpha2.ExternalVersionConfig,
+	payload *ExternalWebhookPayload,
+) (retryAfter time.Duration, err error) {
+	attempt := 1
+	if sync := project.Status.ExternalSync; sync != nil &&
+		sync.LastNotificationReason == payload.Reason &&
+		sync.LastNotifiedVersion == payload.DesiredVersion {
+		if sync.LastDeliveryError == "" {
+			return 0, nil
+		}
+		attempt = int(sync.DeliveryAttempts) + 1
+	}
+	maxAttempts := r.WebhookMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultWebhookMaxAttempts
+	}
+	if attempt > maxAttempts {
+		// The previous round of attempts was exhausted, start a new one
+		attempt = 1
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	secret, err := r.getWebhookSecret(ctx, project, config)
+	if err != nil {
+		return 0, err
+	}
+
+	deliveryErr := r.post(ctx, config.WebhookURL, body, secret)
+
+	switch {
+	case deliveryErr == nil:
+		r.Recorder.Event(project, corev1.EventTypeNormal, "WebhookDelivered",
+			fmt.Sprintf("Notified external system: %s %s", payload.Reason, payload.DesiredVersion))
+	case attempt < maxAttempts:
+		retryAfter = r.WebhookRetryBackoff << (attempt - 1)
+	default:
+		r.Recorder.Event(project, corev1.EventTypeWarning, "WebhookDeliveryFailed",
+			fmt.Sprintf("Failed to notify external system after %d attempts: %s",
+				attempt, cf.SanitizeErrorMessage(deliveryErr)))
+	}
+
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		now := metav1.Now()
+		if project.Status.ExternalSync == nil {
+			project.Status.ExternalSync = &networkingv1alpha2.ExternalSyncStatus{}
+		}
+		sync := project.Status.ExternalSync
+		sync.LastNotificationReason = payload.Reason
+		sync.LastNotifiedVersion = payload.DesiredVersion
+		sync.DeliveryAttempts = int32(attempt)
+
+		cond := metav1.Condition{
+			Type:               ConditionTypeWebhookDelivered,
+			ObservedGeneration: project.Generation,
+			LastTransitionTime: now,
+		}
+		if deliveryErr != nil {
+			sync.LastDeliveryError = cf.SanitizeErrorMessage(deliveryErr)
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = "DeliveryFailed"
+			cond.Message = sync.LastDeliveryError
+		} else {
+			sync.LastDeliveryError = ""
+			sync.LastNotifiedAt = &now
+			cond.Status = metav1.ConditionTrue
+			cond.Reason = "Delivered"
+			cond.Message = fmt.Sprintf("%s %s", payload.Reason, payload.DesiredVersion)
+		}
+		meta.SetStatusCondition(&project.Status.Conditions, cond)
+	}); err != nil {
+		return retryAfter, fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+
+	return retryAfter, deliveryErr
+}
+
+// post sends a single signed webhook request and requires a 2xx response.
+func (r *ExternalReconciler) post(ctx context.Context, url string, body, secret []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "cloudflare-operator/external-webhook")
+	if len(secret) > 0 {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(secret, body))
+	}
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// pollExternalState fetches the external version state and copies it into the spec.
+// Polling happens at most once per SyncInterval. Endpoints that do not answer
+// GET with a 2xx JSON document are treated as push-only.
+//
+//nolint:revive // cognitive complexity acceptable for polling logic
+func (r *ExternalReconciler) pollExternalState(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	config *networkingv1alpha2.ExternalVersionConfig,
+	syncInterval time.Duration,
+) error {
+	if sync := project.Status.ExternalSync; sync != nil && sync.LastPolledAt != nil &&
+		time.Since(sync.LastPolledAt.Time) < syncInterval {
+		return nil
+	}
+
+	secret, err := r.getWebhookSecret(ctx, project, config)
+	if err != nil {
+		return err
+	}
+
+	state, err := r.fetchState(ctx, config.WebhookURL, secret)
+	if err != nil {
+		r.Log.V(1).Info("External state not available", "reason", err.Error())
+	}
+
+	if state != nil &&
+		((state.CurrentVersion != "" && state.CurrentVersion != config.CurrentVersion) ||
+			(state.ProductionVersion != "" && state.ProductionVersion != config.ProductionVersion)) {
+		if err := controller.UpdateWithConflictRetry(ctx, r.Client, project, func() {
+			external := project.Spec.VersionManagement.External
+			if state.CurrentVersion != "" {
+				external.CurrentVersion = state.CurrentVersion
+			}
+			if state.ProductionVersion != "" {
+				external.ProductionVersion = state.ProductionVersion
+			}
+		}); err != nil {
+			return fmt.Errorf("failed to apply external version state: %w", err)
+		}
+		r.Recorder.Event(project, corev1.EventTypeNormal, "ExternalStateSynced",
+			fmt.Sprintf("Synced external versions: current=%s production=%s",
+				state.CurrentVersion, state.ProductionVersion))
+	}
+
+	return controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		now := metav1.Now()
+		if project.Status.ExternalSync == nil {
+			project.Status.ExternalSync = &networkingv1alpha2.ExternalSyncStatus{}
+		}
+		project.Status.ExternalSync.LastPolledAt = &now
+	})
+}
+
+// fetchState issues a signed GET against the webhook URL.
+func (r *ExternalReconciler) fetchState(ctx context.Context, url string, secret []byte) (*ExternalVersionState, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create poll request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "cloudflare-operator/external-webhook")
+	if len(secret) > 0 {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(secret, nil))
+	}
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("poll request failed: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("poll returned status %d", resp.StatusCode)
+	}
+
+	state := &ExternalVersionState{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(state); err != nil {
+		return nil, fmt.Errorf("failed to decode external state: %w", err)
+	}
+	return state, nil
+}
+
+// getWebhookSecret reads the HMAC secret referenced by WebhookSecretRef.
+// Returns nil when no secret is configured.
+func (r *ExternalReconciler) getWebhookSecret(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	config *networkingv1alpha2.ExternalVersionConfig,
+) ([]byte, error) {
+	ref := config.WebhookSecretRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: project.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get webhook secret %s: %w", ref.Name, err)
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if !ok || len(value) == 0 {
+		return nil, fmt.Errorf("webhook secret %s has no key %s", ref.Name, ref.Key)
+	}
+	return value, nil
+}
+
+// SignWebhookPayload returns the hex HMAC-SHA256 signature of body, prefixed with "sha256=".
+func SignWebhookPayload(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// webhookRecorder captures requests delivered to a stub webhook endpoint.
+type webhookRecorder struct {
+	mu         sync.Mutex
+	posts      [][]byte
+	signatures []string
+	postStatus int
+	getBody    string
+}
+
+func (w *webhookRecorder) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if req.Method == http.MethodGet {
+		if w.getBody == "" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(rw, w.getBody)
+		return
+	}
+
+	body, _ := io.ReadAll(req.Body)
+	w.posts = append(w.posts, body)
+	w.signatures = append(w.signatures, req.Header.Get(WebhookSignatureHeader))
+	rw.WriteHeader(w.postStatus)
+}
+
+func newExternalTestProject(webhookURL string) *networkingv1alpha2.PagesProject {
+	return &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+			VersionManagement: &networkingv1alpha2.VersionManagement{
+				Policy: networkingv1alpha2.VersionPolicyExternal,
+				External: &networkingv1alpha2.ExternalVersionConfig{
+					WebhookURL:        webhookURL,
+					WebhookSecretRef:  &networkingv1alpha2.SecretKeySelector{Name: "webhook-secret", Key: "hmac"},
+					ProductionVersion: "v2",
+				},
+			},
+		},
+		Status: networkingv1alpha2.PagesProjectStatus{
+			CurrentProduction: &networkingv1alpha2.ProductionDeploymentInfo{
+				Version:      "v1",
+				DeploymentID: "dep-1",
+			},
+		},
+	}
+}
+
+func newExternalTestReconciler(objs ...client.Object) (*ExternalReconciler, client.Client) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}).
+		Build()
+	r := NewExternalReconciler(fakeClient, scheme.Scheme, record.NewFakeRecorder(20), logr.Discard())
+	r.WebhookRetryBackoff = time.Millisecond
+	return r, fakeClient
+}
+
+func webhookSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook-secret", Namespace: "default"},
+		Data:       map[string][]byte{"hmac": []byte("s3cr3t")},
+	}
+}
+
+func TestExternalWebhook_DeliversSignedPayload(t *testing.T) {
+	ctx := context.Background()
+	stub := &webhookRecorder{postStatus: http.StatusOK}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	project := newExternalTestProject(server.URL)
+	r, fakeClient := newExternalTestReconciler(project, webhookSecret())
+	config := project.Spec.VersionManagement.External
+
+	payload := detectExternalChange(project, config, false)
+	require.NotNil(t, payload)
+	retryAfter, err := r.notifyWebhook(ctx, project, config, payload)
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	require.Len(t, stub.posts, 1)
+	delivered := ExternalWebhookPayload{}
+	require.NoError(t, json.Unmarshal(stub.posts[0], &delivered))
+	assert.Equal(t, "my-site", delivered.Project)
+	assert.Equal(t, "default", delivered.Namespace)
+	assert.Equal(t, "v1", delivered.CurrentVersion)
+	assert.Equal(t, "v2", delivered.DesiredVersion)
+	assert.Equal(t, WebhookReasonProductionVersionChange, delivered.Reason)
+	assert.Equal(t, SignWebhookPayload([]byte("s3cr3t"), stub.posts[0]), stub.signatures[0])
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	require.NotNil(t, updated.Status.ExternalSync)
+	assert.Equal(t, "v2", updated.Status.ExternalSync.LastNotifiedVersion)
+	assert.Empty(t, updated.Status.ExternalSync.LastDeliveryError)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeWebhookDelivered)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+
+	// The same notification is not delivered twice
+	_, err = r.notifyWebhook(ctx, updated, config, payload)
+	require.NoError(t, err)
+	assert.Len(t, stub.posts, 1)
+}
+
+func TestExternalWebhook_RetriesAndReportsFailure(t *testing.T) {
+	ctx := context.Background()
+	stub := &webhookRecorder{postStatus: http.StatusBadGateway}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	project := newExternalTestProject(server.URL)
+	r, fakeClient := newExternalTestReconciler(project, webhookSecret())
+	config := project.Spec.VersionManagement.External
+	payload := detectExternalChange(project, config, false)
+
+	// Each reconcile makes one attempt and backs off exponentially
+	updated := project
+	for attempt := 1; attempt <= DefaultWebhookMaxAttempts; attempt++ {
+		retryAfter, err := r.notifyWebhook(ctx, updated, config, payload)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "502")
+		assert.Len(t, stub.posts, attempt)
+		if attempt < DefaultWebhookMaxAttempts {
+			assert.Equal(t, r.WebhookRetryBackoff<<(attempt-1), retryAfter)
+		} else {
+			assert.Zero(t, retryAfter, "exhausted attempts wait for the next sync")
+		}
+
+		updated = &networkingv1alpha2.PagesProject{}
+		require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+		require.NotNil(t, updated.Status.ExternalSync)
+		assert.Equal(t, int32(attempt), updated.Status.ExternalSync.DeliveryAttempts)
+	}
+
+	assert.NotEmpty(t, updated.Status.ExternalSync.LastDeliveryError)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeWebhookDelivered)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, <-r.Recorder.(*record.FakeRecorder).Events, "WebhookDeliveryFailed")
+
+	// A failed notification is retried on the next sync
+	stub.postStatus = http.StatusAccepted
+	retryAfter, err := r.notifyWebhook(ctx, updated, config, payload)
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+	assert.Len(t, stub.posts, DefaultWebhookMaxAttempts+1)
+}
+
+func TestExternalWebhook_SanitizesDeliveryError(t *testing.T) {
+	ctx := context.Background()
+	project := newExternalTestProject("http://127.0.0.1:1/hook?token=abcdefghijklmnopqrstuvwxyz0123456789ABCD")
+	r, fakeClient := newExternalTestReconciler(project, webhookSecret())
+	config := project.Spec.VersionManagement.External
+
+	_, err := r.notifyWebhook(ctx, project, config, detectExternalChange(project, config, false))
+	require.Error(t, err)
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	require.NotNil(t, updated.Status.ExternalSync)
+	assert.NotContains(t, updated.Status.ExternalSync.LastDeliveryError, "abcdefghijklmnopqrstuvwxyz0123456789ABCD")
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeWebhookDelivered)
+	require.NotNil(t, cond)
+	assert.NotContains(t, cond.Message, "abcdefghijklmnopqrstuvwxyz0123456789ABCD")
+}
+
+func TestExternalWebhook_MissingSecret(t *testing.T) {
+	stub := &webhookRecorder{postStatus: http.StatusOK}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	project := newExternalTestProject(server.URL)
+	r, _ := newExternalTestReconciler(project)
+	config := project.Spec.VersionManagement.External
+
+	_, err := r.notifyWebhook(context.Background(), project, config, detectExternalChange(project, config, false))
+	require.Error(t, err)
+	assert.Empty(t, stub.posts)
+}
+
+func TestExternalWebhook_PollsStateIntoSpec(t *testing.T) {
+	ctx := context.Background()
+	stub := &webhookRecorder{postStatus: http.StatusOK, getBody: `{"currentVersion":"v3","productionVersion":"v3"}`}
+	server := httptest.NewServer(stub)
+	defer server.Close()
+
+	project := newExternalTestProject(server.URL)
+	r, fakeClient := newExternalTestReconciler(project, webhookSecret())
+
+	require.NoError(t, r.pollExternalState(ctx, project, project.Spec.VersionManagement.External, time.Minute))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, "v3", updated.Spec.VersionManagement.External.CurrentVersion)
+	assert.Equal(t, "v3", updated.Spec.VersionManagement.External.ProductionVersion)
+	require.NotNil(t, updated.Status.ExternalSync)
+	require.NotNil(t, updated.Status.ExternalSync.LastPolledAt)
+
+	// Within the sync interval the state is not polled again
+	stub.getBody = `{"currentVersion":"v4"}`
+	require.NoError(t, r.pollExternalState(ctx, updated, updated.Spec.VersionManagement.External, time.Minute))
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, "v3", updated.Spec.VersionManagement.External.CurrentVersion)
+}
+
+func TestDetectExternalChange(t *testing.T) {
+	project := newExternalTestProject("https://example.com/hook")
+	config := project.Spec.VersionManagement.External
+
+	payload := detectExternalChange(project, config, false)
+	require.NotNil(t, payload)
+	assert.Equal(t, WebhookReasonProductionVersionChange, payload.Reason)
+
+	config.ProductionVersion = "v1"
+	assert.Nil(t, detectExternalChange(project, config, false))
+
+	config.CurrentVersion = "v5"
+	payload = detectExternalChange(project, config, true)
+	require.NotNil(t, payload)
+	assert.Equal(t, WebhookReasonCurrentVersionDeploying, payload.Reason)
+	assert.Equal(t, "v5", payload.DesiredVersion)
+}
@@ -6,10 +6,14 @@ package pagesproject
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -18,6 +22,19 @@ import (
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+)
+
+// ConditionTypePreviewValidated reports whether the GitOps production version
+// has passed preview validation and may be promoted.
+const ConditionTypePreviewValidated = "PreviewValidated"
+
+// Validation results and sources recorded in status.validationHistory.
+const (
+	validationResultPassed = "passed"
+	validationResultFailed = "failed"
+	validatedByPreview     = "preview"
+	validatedByGitOps      = "gitops"
 )
 
 // GitOpsReconciler handles the gitops version management policy.
@@ -88,7 +105,7 @@ func (r *GitOpsReconciler) reconcilePreviewVersion(
 
 	if deployment != nil {
 		log.V(1).Info("Preview deployment already exists", "deployment", deployment.Name)
-		return nil
+		return r.recordPreviewValidation(ctx, project, versionName, deployment)
 	}
 
 	// Create new preview deployment with preview metadata
@@ -118,12 +135,27 @@ func (r *GitOpsReconciler) reconcileProductionVersion(
 		return fmt.Errorf("version %s not found, cannot promote to production", versionName)
 	}
 
-	// Check if validation is required
+	// Check if already production
+	if project.Status.CurrentProduction != nil &&
+		project.Status.CurrentProduction.DeploymentID == deployment.Status.DeploymentID {
+		log.V(1).Info("Version is already production")
+		return nil
+	}
+
+	// Refuse the promotion until the version has passed preview validation
 	requireValidation := gitops.RequirePreviewValidation == nil || *gitops.RequirePreviewValidation
 	if requireValidation {
-		if !r.isVersionValidated(ctx, project, deployment, gitops.ValidationLabels) {
-			return fmt.Errorf("version %s has not passed preview validation", versionName)
+		reason, message, validated := checkPreviewValidation(project, deployment, versionName, gitops.ValidationLabels)
+		if err := r.setPreviewValidatedCondition(ctx, project, validated, reason, message); err != nil {
+			return err
 		}
+		if !validated {
+			log.Info("Production promotion blocked", "reason", reason)
+			return nil
+		}
+	} else if err := r.setPreviewValidatedCondition(ctx, project, true, "ValidationNotRequired",
+		"Preview validation is not required for production promotion"); err != nil {
+		return err
 	}
 
 	// Check if deployment has succeeded
@@ -137,19 +169,12 @@ func (r *GitOpsReconciler) reconcileProductionVersion(
 		return err
 	}
 
-	// Check if already production
-	if project.Status.CurrentProduction != nil &&
-		project.Status.CurrentProduction.DeploymentID == deployment.Status.DeploymentID {
-		log.V(1).Info("Version is already production")
-		return nil
-	}
-
 	// Promote by changing environment (works for all deployments, not just previous production)
 	log.Info("Promoting deployment to production", "deployment", deployment.Name)
 
 	if err := PromoteDeploymentToProduction(ctx, r.Client, deployment); err != nil {
 		r.Recorder.Event(project, corev1.EventTypeWarning, "PromotionFailed",
-			fmt.Sprintf("Failed to promote version %s: %s", versionName, err.Error()))
+			fmt.Sprintf("Failed to promote version %s: %s", versionName, cf.SanitizeErrorMessage(err)))
 		return fmt.Errorf("failed to promote deployment: %w", err)
 	}
 
@@ -198,45 +223,143 @@ func (r *GitOpsReconciler) findDeploymentByVersion(
 	return nil, nil
 }
 
-// isVersionValidated checks if a version has been validated through preview.
-//
-//nolint:revive // cognitive complexity acceptable for validation logic
-func (*GitOpsReconciler) isVersionValidated(
-	_ context.Context,
+// checkPreviewValidation checks whether the deployment of a version has passed
+// preview validation: the newest status.validationHistory entry of the version
+// and deployment must have passed, and the deployment must have all validation
+// labels. A succeeded preview deployment of a version without any history entry
+// counts as validated, as it did before the history was recorded. It returns the condition reason and message of the result.
+func checkPreviewValidation(
 	project *networkingv1alpha2.PagesProject,
 	deployment *networkingv1alpha2.PagesDeployment,
+	versionName string,
 	validationLabels map[string]string,
-) bool {
-	// Check deployment state
-	if deployment.Status.State != networkingv1alpha2.PagesDeploymentStateSucceeded {
-		return false
+) (reason, message string, validated bool) {
+	validatedBy := validatedByPreview
+	validation := findVersionValidation(project.Status.ValidationHistory, versionName, deployment.Status.DeploymentID)
+	switch {
+	case validation == nil && !versionHasHistory(project.Status.ValidationHistory, versionName) &&
+		deployment.Status.Environment == "preview" &&
+		deployment.Status.State == networkingv1alpha2.PagesDeploymentStateSucceeded:
+		// Deployments validated as previews before validation history was recorded
+	case validation == nil:
+		return "PreviewValidationRequired", fmt.Sprintf(
+			"Version %s cannot be promoted to production: it has not passed preview validation", versionName), false
+	case validation.ValidationResult != validationResultPassed:
+		return "PreviewValidationFailed", fmt.Sprintf(
+			"Version %s cannot be promoted to production: preview validation failed", versionName), false
+	default:
+		validatedBy = validation.ValidatedBy
+	}
+
+	var missing []string
+	for k, v := range validationLabels {
+		if deployment.Labels[k] != v {
+			missing = append(missing, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "ValidationLabelsMissing", fmt.Sprintf(
+			"Version %s cannot be promoted to production: deployment %s is missing validation labels %s",
+			versionName, deployment.Name, strings.Join(missing, ", ")), false
 	}
 
-	// Check validation labels if specified
-	if len(validationLabels) > 0 {
-		for k, v := range validationLabels {
-			if deployment.Labels[k] != v {
-				return false
-			}
+	return "PreviewValidationPassed", fmt.Sprintf(
+		"Version %s passed preview validation (%s)", versionName, validatedBy), true
+}
+
+// findVersionValidation returns the newest validation history entry of a
+// version. Entries of another deployment of the version are ignored, so a
+// redeployed version must be validated again.
+func findVersionValidation(
+	history []networkingv1alpha2.VersionValidation,
+	versionName, deploymentID string,
+) *networkingv1alpha2.VersionValidation {
+	for i := range history {
+		validation := &history[i]
+		if validation.VersionName != versionName {
+			continue
+		}
+		if validation.DeploymentID != "" && deploymentID != "" && validation.DeploymentID != deploymentID {
+			continue
 		}
+		return validation
 	}
+	return nil
+}
 
-	// Check validation history
-	for _, validation := range project.Status.ValidationHistory {
-		if validation.VersionName == deployment.Spec.VersionName ||
-			validation.VersionName == deployment.Status.VersionName {
-			if validation.ValidationResult == "passed" {
-				return true
-			}
-		}
+// versionHasHistory reports whether the validation history has an entry of the version.
+func versionHasHistory(history []networkingv1alpha2.VersionValidation, versionName string) bool {
+	return slices.ContainsFunc(history, func(validation networkingv1alpha2.VersionValidation) bool {
+		return validation.VersionName == versionName
+	})
+}
+
+// setPreviewValidatedCondition sets the PreviewValidated condition and records
+// an event when a promotion becomes blocked.
+func (r *GitOpsReconciler) setPreviewValidatedCondition(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	validated bool,
+	reason, message string,
+) error {
+	status := metav1.ConditionFalse
+	if validated {
+		status = metav1.ConditionTrue
+	}
+	existing := meta.FindStatusCondition(project.Status.Conditions, ConditionTypePreviewValidated)
+	if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+		return nil
+	}
+
+	if !validated {
+		r.Recorder.Event(project, corev1.EventTypeWarning, "PromotionBlocked", message)
+	}
+	return controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		controller.SetCondition(&project.Status.Conditions, ConditionTypePreviewValidated, status, reason, message)
+	})
+}
+
+// recordPreviewValidation records the result of the preview deployment of a
+// version in status.validationHistory once the deployment has finished.
+func (r *GitOpsReconciler) recordPreviewValidation(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	versionName string,
+	deployment *networkingv1alpha2.PagesDeployment,
+) error {
+	var result, message string
+	switch deployment.Status.State {
+	case networkingv1alpha2.PagesDeploymentStateSucceeded:
+		result, message = validationResultPassed, "Preview deployment succeeded"
+	case networkingv1alpha2.PagesDeploymentStateFailed:
+		result, message = validationResultFailed, "Preview deployment failed"
+	default:
+		return nil
 	}
 
-	// If environment was preview and succeeded, consider validated
-	if deployment.Status.Environment == "preview" {
-		return true
+	if existing := findVersionValidation(project.Status.ValidationHistory, versionName,
+		deployment.Status.DeploymentID); existing != nil && existing.ValidationResult == result {
+		return nil
 	}
 
-	return false
+	now := metav1.Now()
+	validation := networkingv1alpha2.VersionValidation{
+		VersionName:      versionName,
+		DeploymentID:     deployment.Status.DeploymentID,
+		ValidatedAt:      &now,
+		ValidatedBy:      validatedByPreview,
+		ValidationResult: result,
+		Message:          message,
+	}
+	// Update the project itself, so the production version is checked against the new entry
+	return controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		history := append([]networkingv1alpha2.VersionValidation{validation}, project.Status.ValidationHistory...)
+		if len(history) > maxValidationHistory {
+			history = history[:maxValidationHistory]
+		}
+		project.Status.ValidationHistory = history
+	})
 }
 
 // createPreviewDeployment creates a new PagesDeployment for preview.
@@ -379,16 +502,16 @@ func (r *GitOpsReconciler) recordValidation(
 		VersionName:      versionName,
 		DeploymentID:     deploymentID,
 		ValidatedAt:      &now,
-		ValidatedBy:      "gitops",
-		ValidationResult: "passed",
+		ValidatedBy:      validatedByGitOps,
+		ValidationResult: validationResultPassed,
+		Message:          "Promoted to production",
 	}
 
 	// Update project status
 	return r.updateProjectStatus(ctx, project, func(status *networkingv1alpha2.PagesProjectStatus) {
-		// Add to validation history (keep last 50)
 		status.ValidationHistory = append([]networkingv1alpha2.VersionValidation{validation}, status.ValidationHistory...)
-		if len(status.ValidationHistory) > 50 {
-			status.ValidationHistory = status.ValidationHistory[:50]
+		if len(status.ValidationHistory) > maxValidationHistory {
+			status.ValidationHistory = status.ValidationHistory[:maxValidationHistory]
 		}
 	})
 }
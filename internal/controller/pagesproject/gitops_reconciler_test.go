@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+func newGitOpsTestProject(previewVersion, productionVersion string) *networkingv1alpha2.PagesProject {
+	return &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+			UID:       "project-uid",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+			VersionManagement: &networkingv1alpha2.VersionManagement{
+				Policy: networkingv1alpha2.VersionPolicyGitOps,
+				GitOps: &networkingv1alpha2.GitOpsVersionConfig{
+					PreviewVersion:    previewVersion,
+					ProductionVersion: productionVersion,
+				},
+			},
+		},
+	}
+}
+
+func newGitOpsTestDeployment(version, deploymentID string, state networkingv1alpha2.PagesDeploymentState) *networkingv1alpha2.PagesDeployment {
+	return &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site-" + version,
+			Namespace: "default",
+			Labels: map[string]string{
+				ManagedByLabel: ManagedByValue,
+				VersionLabel:   version,
+			},
+		},
+		Spec: networkingv1alpha2.PagesDeploymentSpec{
+			ProjectRef:  networkingv1alpha2.PagesProjectRef{Name: "my-site"},
+			VersionName: version,
+			Environment: networkingv1alpha2.PagesDeploymentEnvironmentPreview,
+		},
+		Status: networkingv1alpha2.PagesDeploymentStatus{
+			DeploymentID: deploymentID,
+			State:        state,
+			VersionName:  version,
+		},
+	}
+}
+
+func newGitOpsTestReconciler(objs ...client.Object) (*GitOpsReconciler, client.Client, *record.FakeRecorder) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}, &networkingv1alpha2.PagesDeployment{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	return NewGitOpsReconciler(fakeClient, scheme.Scheme, recorder, logr.Discard()), fakeClient, recorder
+}
+
+func passedValidation(version, deploymentID, validatedBy string) networkingv1alpha2.VersionValidation {
+	validatedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	return networkingv1alpha2.VersionValidation{
+		VersionName:      version,
+		DeploymentID:     deploymentID,
+		ValidatedAt:      &validatedAt,
+		ValidatedBy:      validatedBy,
+		ValidationResult: validationResultPassed,
+	}
+}
+
+func getGitOpsTestState(
+	t *testing.T,
+	c client.Client,
+	project *networkingv1alpha2.PagesProject,
+	deployment *networkingv1alpha2.PagesDeployment,
+) (*networkingv1alpha2.PagesProject, *networkingv1alpha2.PagesDeployment) {
+	t.Helper()
+	ctx := context.Background()
+	updatedProject := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(project), updatedProject))
+	updatedDeployment := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(deployment), updatedDeployment))
+	return updatedProject, updatedDeployment
+}
+
+func TestGitOpsReconciler_PromotesAfterPreviewValidation(t *testing.T) {
+	project := newGitOpsTestProject("v1", "v1")
+	deployment := newGitOpsTestDeployment("v1", "dep-1", networkingv1alpha2.PagesDeploymentStateSucceeded)
+	r, fakeClient, _ := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, updatedDeployment.Spec.Environment)
+
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, "PreviewValidationPassed", cond.Reason)
+
+	require.Len(t, updatedProject.Status.ValidationHistory, 2)
+	promotion := updatedProject.Status.ValidationHistory[0]
+	assert.Equal(t, validatedByGitOps, promotion.ValidatedBy)
+	assert.Equal(t, "v1", promotion.VersionName)
+	assert.Equal(t, "dep-1", promotion.DeploymentID)
+	assert.Equal(t, validationResultPassed, promotion.ValidationResult)
+	preview := updatedProject.Status.ValidationHistory[1]
+	assert.Equal(t, validatedByPreview, preview.ValidatedBy)
+	assert.Equal(t, validationResultPassed, preview.ValidationResult)
+}
+
+func TestGitOpsReconciler_BlocksUnvalidatedVersion(t *testing.T) {
+	project := newGitOpsTestProject("", "v2")
+	deployment := newGitOpsTestDeployment("v2", "dep-2", networkingv1alpha2.PagesDeploymentStateSucceeded)
+	r, fakeClient, recorder := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentPreview, updatedDeployment.Spec.Environment,
+		"an unvalidated version must not be promoted")
+	assert.Empty(t, updatedProject.Status.ValidationHistory)
+
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "PreviewValidationRequired", cond.Reason)
+	assert.Contains(t, cond.Message, "v2")
+	assert.Contains(t, <-recorder.Events, "PromotionBlocked")
+
+	// A second reconcile does not repeat the event
+	require.NoError(t, r.Reconcile(context.Background(), updatedProject, nil))
+	assert.Empty(t, recorder.Events)
+}
+
+func TestGitOpsReconciler_PromotesPreviewWithoutHistory(t *testing.T) {
+	// Deployed and validated as a preview before validation history was recorded
+	project := newGitOpsTestProject("", "v2")
+	deployment := newGitOpsTestDeployment("v2", "dep-2", networkingv1alpha2.PagesDeploymentStateSucceeded)
+	deployment.Status.Environment = "preview"
+	r, fakeClient, _ := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, updatedDeployment.Spec.Environment)
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, "PreviewValidationPassed", cond.Reason)
+}
+
+func TestGitOpsReconciler_BlocksFailedPreview(t *testing.T) {
+	project := newGitOpsTestProject("v2", "v2")
+	deployment := newGitOpsTestDeployment("v2", "dep-2", networkingv1alpha2.PagesDeploymentStateFailed)
+	r, fakeClient, _ := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentPreview, updatedDeployment.Spec.Environment)
+	require.Len(t, updatedProject.Status.ValidationHistory, 1)
+	assert.Equal(t, validationResultFailed, updatedProject.Status.ValidationHistory[0].ValidationResult)
+
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, "PreviewValidationFailed", cond.Reason)
+}
+
+func TestGitOpsReconciler_IgnoresValidationOfOtherDeployment(t *testing.T) {
+	project := newGitOpsTestProject("", "v2")
+	project.Status.ValidationHistory = []networkingv1alpha2.VersionValidation{
+		passedValidation("v2", "dep-old", validatedByPreview),
+	}
+	deployment := newGitOpsTestDeployment("v2", "dep-2", networkingv1alpha2.PagesDeploymentStateSucceeded)
+	deployment.Status.Environment = "preview"
+	r, fakeClient, _ := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentPreview, updatedDeployment.Spec.Environment)
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, "PreviewValidationRequired", cond.Reason)
+}
+
+func TestGitOpsReconciler_RequiresValidationLabels(t *testing.T) {
+	ctx := context.Background()
+	project := newGitOpsTestProject("", "v2")
+	project.Spec.VersionManagement.GitOps.ValidationLabels = map[string]string{"qa.example.com/approved": "true"}
+	project.Status.ValidationHistory = []networkingv1alpha2.VersionValidation{
+		passedValidation("v2", "dep-2", validatedByPreview),
+	}
+	deployment := newGitOpsTestDeployment("v2", "dep-2", networkingv1alpha2.PagesDeploymentStateSucceeded)
+	r, fakeClient, _ := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(ctx, project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentPreview, updatedDeployment.Spec.Environment)
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, "ValidationLabelsMissing", cond.Reason)
+	assert.Contains(t, cond.Message, "qa.example.com/approved=true")
+
+	// Labelling the deployment as validated allows the promotion
+	updatedDeployment.Labels["qa.example.com/approved"] = "true"
+	require.NoError(t, fakeClient.Update(ctx, updatedDeployment))
+	require.NoError(t, r.Reconcile(ctx, updatedProject, nil))
+
+	updatedProject, updatedDeployment = getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, updatedDeployment.Spec.Environment)
+	cond = meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, validatedByGitOps, updatedProject.Status.ValidationHistory[0].ValidatedBy)
+}
+
+func TestGitOpsReconciler_PromotesWithoutRequiredValidation(t *testing.T) {
+	project := newGitOpsTestProject("", "v2")
+	project.Spec.VersionManagement.GitOps.RequirePreviewValidation = new(bool)
+	deployment := newGitOpsTestDeployment("v2", "dep-2", networkingv1alpha2.PagesDeploymentStateSucceeded)
+	r, fakeClient, _ := newGitOpsTestReconciler(project, deployment)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, nil))
+
+	updatedProject, updatedDeployment := getGitOpsTestState(t, fakeClient, project, deployment)
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, updatedDeployment.Spec.Environment)
+	cond := meta.FindStatusCondition(updatedProject.Status.Conditions, ConditionTypePreviewValidated)
+	require.NotNil(t, cond)
+	assert.Equal(t, "ValidationNotRequired", cond.Reason)
+}
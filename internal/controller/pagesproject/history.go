@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+)
+
+const (
+	// HistoryStatusActive marks a history entry that is currently serving traffic.
+	HistoryStatusActive = "active"
+	// HistoryStatusSuperseded marks a history entry replaced by a newer production deployment.
+	HistoryStatusSuperseded = "superseded"
+
+	// HistorySourceRollbackPrefix prefixes the source of history entries created by rollbacks.
+	HistorySourceRollbackPrefix = "rollback:"
+
+	// DefaultDeploymentHistoryLimit is the number of history entries kept when
+	// spec.deploymentHistoryLimit is not set.
+	DefaultDeploymentHistoryLimit = 10
+)
+
+// syncDeploymentHistory records succeeded PagesDeployments of the project
+// in status.deploymentHistory so they become valid rollback targets.
+//
+//nolint:revive // cognitive complexity acceptable for history bookkeeping
+func (r *PagesProjectReconciler) syncDeploymentHistory(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+) error {
+	deployments := &networkingv1alpha2.PagesDeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(project.Namespace)); err != nil {
+		return err
+	}
+
+	index := NewVersionIndex(r.Client)
+	known := make(map[string]bool, len(project.Status.DeploymentHistory))
+	for _, entry := range project.Status.DeploymentHistory {
+		known[entry.DeploymentID] = true
+	}
+
+	// Deployments that finished before the latest entry were already considered,
+	// so trimmed entries are not recorded again
+	var recordedUntil *metav1.Time
+	if n := len(project.Status.DeploymentHistory); n > 0 {
+		recordedUntil = &project.Status.DeploymentHistory[n-1].CreatedAt
+	}
+
+	candidates := make([]*networkingv1alpha2.PagesDeployment, 0, len(deployments.Items))
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		if !index.belongsToProject(d, project) {
+			continue
+		}
+		if d.Status.State != networkingv1alpha2.PagesDeploymentStateSucceeded ||
+			d.Status.DeploymentID == "" || known[d.Status.DeploymentID] {
+			continue
+		}
+		if finishedAt := deploymentFinishedAt(d); recordedUntil != nil && !recordedUntil.Before(&finishedAt) {
+			continue
+		}
+		candidates = append(candidates, d)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Record oldest first so version numbers follow deployment order
+	sort.Slice(candidates, func(i, j int) bool {
+		ti, tj := deploymentFinishedAt(candidates[i]), deploymentFinishedAt(candidates[j])
+		return ti.Before(&tj)
+	})
+
+	// Deployments deduplicated by source hash stay unknown, so skip the
+	// status update when nothing would be recorded
+	if !recordDeployments(project.DeepCopy(), candidates) {
+		return nil
+	}
+
+	return controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		recordDeployments(project, candidates)
+	})
+}
+
+// recordDeployments appends history entries for the given deployments.
+// Returns true if at least one entry was appended.
+func recordDeployments(project *networkingv1alpha2.PagesProject, deployments []*networkingv1alpha2.PagesDeployment) bool {
+	appended := false
+	for _, d := range deployments {
+		if historyContains(project.Status.DeploymentHistory, d.Status.DeploymentID) {
+			continue
+		}
+		if appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{
+			DeploymentID: d.Status.DeploymentID,
+			URL:          d.Status.URL,
+			Environment:  d.Status.Environment,
+			Source:       describeDeploymentSource(d),
+			SourceHash:   d.Status.SourceHash,
+			SourceURL:    d.Status.SourceURL,
+			K8sResource:  fmt.Sprintf("%s/%s", d.Namespace, d.Name),
+			CreatedAt:    deploymentFinishedAt(d),
+			Status:       HistoryStatusActive,
+			IsProduction: d.Status.Environment == string(networkingv1alpha2.PagesDeploymentEnvironmentProduction),
+		}) {
+			appended = true
+		}
+	}
+	return appended
+}
+
+// appendHistoryEntry appends an entry with the next sequential version number
+// and trims the history to spec.deploymentHistoryLimit.
+// A new production entry supersedes the previous production entry. The entry is
+// skipped when the latest entry has the same source hash and environment, unless
+// it records a rollback. Returns true if the entry was appended.
+func appendHistoryEntry(project *networkingv1alpha2.PagesProject, entry networkingv1alpha2.DeploymentHistoryEntry) bool {
+	if isDuplicateSource(project.Status.DeploymentHistory, entry) {
+		return false
+	}
+
+	maxVersion := 0
+	for i := range project.Status.DeploymentHistory {
+		existing := &project.Status.DeploymentHistory[i]
+		if existing.Version > maxVersion {
+			maxVersion = existing.Version
+		}
+		if entry.IsProduction && existing.IsProduction {
+			existing.IsProduction = false
+			existing.Status = HistoryStatusSuperseded
+		}
+	}
+	entry.Version = maxVersion + 1
+
+	project.Status.DeploymentHistory = append(project.Status.DeploymentHistory, entry)
+	if entry.IsProduction {
+		project.Status.LastSuccessfulDeploymentID = entry.DeploymentID
+	}
+
+	// Drop the oldest entries beyond the limit. LastSuccessfulDeploymentID is
+	// kept as-is even when its entry is trimmed.
+	if limit := deploymentHistoryLimit(project); len(project.Status.DeploymentHistory) > limit {
+		trimmed := project.Status.DeploymentHistory[len(project.Status.DeploymentHistory)-limit:]
+		project.Status.DeploymentHistory = append([]networkingv1alpha2.DeploymentHistoryEntry(nil), trimmed...)
+	}
+	return true
+}
+
+// isDuplicateSource reports whether the entry repeats the source hash and
+// environment of the latest history entry.
+func isDuplicateSource(history []networkingv1alpha2.DeploymentHistoryEntry, entry networkingv1alpha2.DeploymentHistoryEntry) bool {
+	if len(history) == 0 || entry.SourceHash == "" || strings.HasPrefix(entry.Source, HistorySourceRollbackPrefix) {
+		return false
+	}
+	latest := history[len(history)-1]
+	return latest.SourceHash == entry.SourceHash && latest.Environment == entry.Environment
+}
+
+// deploymentHistoryLimit returns the history limit (default 10). Values below 1,
+// which older objects may still carry, fall back to the default.
+func deploymentHistoryLimit(project *networkingv1alpha2.PagesProject) int {
+	if project.Spec.DeploymentHistoryLimit != nil && *project.Spec.DeploymentHistoryLimit >= 1 {
+		return *project.Spec.DeploymentHistoryLimit
+	}
+	return DefaultDeploymentHistoryLimit
+}
+
+// historyContains reports whether the history already has an entry for the deployment ID.
+func historyContains(history []networkingv1alpha2.DeploymentHistoryEntry, deploymentID string) bool {
+	for _, entry := range history {
+		if entry.DeploymentID == deploymentID {
+			return true
+		}
+	}
+	return false
+}
+
+// latestRollbackEntry returns the newest history entry if it is an active production rollback.
+func latestRollbackEntry(project *networkingv1alpha2.PagesProject) *networkingv1alpha2.DeploymentHistoryEntry {
+	history := project.Status.DeploymentHistory
+	if len(history) == 0 {
+		return nil
+	}
+	latest := &history[len(history)-1]
+	if !latest.IsProduction || !strings.HasPrefix(latest.Source, HistorySourceRollbackPrefix) {
+		return nil
+	}
+	return latest
+}
+
+// describeDeploymentSource returns a short description of the deployment source,
+// e.g. "git:main" or "direct-upload:http".
+func describeDeploymentSource(d *networkingv1alpha2.PagesDeployment) string {
+	src := d.Spec.Source
+	if src == nil {
+		if d.Spec.Branch != "" {
+			return "git:" + d.Spec.Branch
+		}
+		return "unknown"
+	}
+
+	switch src.Type {
+	case networkingv1alpha2.PagesDeploymentSourceTypeGit:
+		if src.Git != nil && src.Git.Branch != "" {
+			return "git:" + src.Git.Branch
+		}
+		return "git"
+	case networkingv1alpha2.PagesDeploymentSourceTypeDirectUpload:
+		if src.DirectUpload != nil && src.DirectUpload.Source != nil {
+			switch {
+			case src.DirectUpload.Source.HTTP != nil:
+				return "direct-upload:http"
+			case src.DirectUpload.Source.S3 != nil:
+				return "direct-upload:s3"
+			case src.DirectUpload.Source.OCI != nil:
+				return "direct-upload:oci"
+			}
+		}
+		return "direct-upload"
+	default:
+		return string(src.Type)
+	}
+}
+
+// deploymentFinishedAt returns when the deployment finished, falling back to its creation time.
+func deploymentFinishedAt(d *networkingv1alpha2.PagesDeployment) metav1.Time {
+	if d.Status.FinishedAt != nil {
+		return *d.Status.FinishedAt
+	}
+	return d.CreationTimestamp
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+func TestAppendHistoryEntry_TrimsToLimit(t *testing.T) {
+	project := &networkingv1alpha2.PagesProject{
+		Spec: networkingv1alpha2.PagesProjectSpec{DeploymentHistoryLimit: ptr.To(3)},
+	}
+
+	// The first deployment is production, the rest are previews
+	assert.True(t, appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{
+		DeploymentID: "dep-0",
+		Environment:  "production",
+		IsProduction: true,
+		SourceHash:   "hash-0",
+	}))
+	for i := 1; i <= 5; i++ {
+		assert.True(t, appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{
+			DeploymentID: fmt.Sprintf("dep-%d", i),
+			Environment:  "preview",
+			SourceHash:   fmt.Sprintf("hash-%d", i),
+		}))
+	}
+
+	require.Len(t, project.Status.DeploymentHistory, 3)
+	assert.Equal(t, "dep-3", project.Status.DeploymentHistory[0].DeploymentID)
+	assert.Equal(t, 4, project.Status.DeploymentHistory[0].Version)
+	assert.Equal(t, 6, project.Status.DeploymentHistory[2].Version)
+	// The production entry was trimmed but is still the last successful deployment
+	assert.Equal(t, "dep-0", project.Status.LastSuccessfulDeploymentID)
+}
+
+func TestDeploymentHistoryLimit_ZeroUsesDefault(t *testing.T) {
+	project := &networkingv1alpha2.PagesProject{
+		Spec: networkingv1alpha2.PagesProjectSpec{DeploymentHistoryLimit: ptr.To(0)},
+	}
+	assert.Equal(t, DefaultDeploymentHistoryLimit, deploymentHistoryLimit(project))
+
+	// An entry is kept rather than trimmed away on every reconcile
+	assert.True(t, appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{
+		DeploymentID: "dep-1",
+		Environment:  "preview",
+		SourceHash:   "hash-1",
+	}))
+	assert.Len(t, project.Status.DeploymentHistory, 1)
+}
+
+func TestAppendHistoryEntry_DedupsSourceHash(t *testing.T) {
+	project := &networkingv1alpha2.PagesProject{}
+
+	entry := networkingv1alpha2.DeploymentHistoryEntry{
+		DeploymentID: "dep-1",
+		Environment:  "preview",
+		SourceHash:   "abc",
+	}
+	assert.True(t, appendHistoryEntry(project, entry))
+
+	// Same hash and environment is skipped
+	entry.DeploymentID = "dep-2"
+	assert.False(t, appendHistoryEntry(project, entry))
+
+	// Same hash in another environment is recorded
+	entry.DeploymentID = "dep-3"
+	entry.Environment = "production"
+	entry.IsProduction = true
+	assert.True(t, appendHistoryEntry(project, entry))
+
+	// Rollbacks are always recorded
+	entry.DeploymentID = "dep-1"
+	entry.Source = HistorySourceRollbackPrefix + "v1"
+	assert.True(t, appendHistoryEntry(project, entry))
+
+	// Entries without a hash are never deduplicated
+	assert.True(t, appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{DeploymentID: "dep-4"}))
+	assert.True(t, appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{DeploymentID: "dep-5"}))
+
+	assert.Len(t, project.Status.DeploymentHistory, 5)
+}
+
+func TestSyncDeploymentHistory_TrimsAndDedups(t *testing.T) {
+	ctx := context.Background()
+	project, objs := newPruneTestObjects(10, 0)
+	project.Spec.DeploymentHistoryLimit = ptr.To(4)
+
+	// Twelve succeeded previews, every pair built from the same source package
+	base := time.Now().Add(-time.Hour)
+	for i := 1; i <= 12; i++ {
+		finishedAt := metav1.NewTime(base.Add(time.Duration(i) * time.Minute))
+		objs = append(objs, &networkingv1alpha2.PagesDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("preview-%d", i),
+				Namespace: "default",
+			},
+			Spec: networkingv1alpha2.PagesDeploymentSpec{
+				ProjectRef:  networkingv1alpha2.PagesProjectRef{Name: project.Name},
+				Environment: networkingv1alpha2.PagesDeploymentEnvironmentPreview,
+			},
+			Status: networkingv1alpha2.PagesDeploymentStatus{
+				DeploymentID: fmt.Sprintf("preview-dep-%d", i),
+				Environment:  "preview",
+				State:        networkingv1alpha2.PagesDeploymentStateSucceeded,
+				SourceHash:   fmt.Sprintf("hash-%d", (i+1)/2),
+				FinishedAt:   &finishedAt,
+			},
+		})
+	}
+	r, fakeClient := newPruneTestReconciler(objs...)
+
+	require.NoError(t, r.syncDeploymentHistory(ctx, project))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	history := updated.Status.DeploymentHistory
+	require.Len(t, history, 4)
+	for i := 1; i < len(history); i++ {
+		assert.NotEqual(t, history[i-1].SourceHash, history[i].SourceHash)
+	}
+	assert.Equal(t, "hash-6", history[len(history)-1].SourceHash)
+
+	// A second sync with nothing new leaves the history untouched
+	rv := updated.ResourceVersion
+	require.NoError(t, r.syncDeploymentHistory(ctx, updated))
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, rv, updated.ResourceVersion)
+}
@@ -11,16 +11,21 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
 )
 
+const validatedByLatestPreview = "latestPreview"
+
 // LatestPreviewReconciler handles the latestPreview version management policy.
 // It automatically tracks the latest successful preview deployment and optionally auto-promotes.
 type LatestPreviewReconciler struct {
@@ -113,14 +118,9 @@ func (r *LatestPreviewReconciler) findMatchingDeployments(
 		return nil, err
 	}
 
-	// Build label selector if specified
-	var selector labels.Selector
-	if config.LabelSelector != nil {
-		var err error
-		selector, err = metav1.LabelSelectorAsSelector(config.LabelSelector)
-		if err != nil {
-			return nil, fmt.Errorf("invalid label selector: %w", err)
-		}
+	selector, err := latestPreviewSelector(config)
+	if err != nil {
+		return nil, err
 	}
 
 	result := make([]*networkingv1alpha2.PagesDeployment, 0, len(deployments.Items))
@@ -148,6 +148,54 @@ func (r *LatestPreviewReconciler) findMatchingDeployments(
 	return result, nil
 }
 
+// latestPreviewSelector returns the selector of config, or nil when every
+// deployment of the project is tracked.
+func latestPreviewSelector(config *networkingv1alpha2.LatestPreviewConfig) (labels.Selector, error) {
+	if config.LabelSelector == nil {
+		return nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(config.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %w", err)
+	}
+	return selector, nil
+}
+
+// FindProjectsForDeployment maps a PagesDeployment to the PagesProjects using
+// the latestPreview policy whose selector matches it. Deployments created
+// outside the operator, for example by CI, are not owned by the project, so
+// they are not seen through Owns.
+func (r *LatestPreviewReconciler) FindProjectsForDeployment(ctx context.Context, obj client.Object) []reconcile.Request {
+	deployment, ok := obj.(*networkingv1alpha2.PagesDeployment)
+	if !ok {
+		return nil
+	}
+
+	projects := &networkingv1alpha2.PagesProjectList{}
+	if err := r.List(ctx, projects, client.InNamespace(deployment.Namespace)); err != nil {
+		r.Log.Error(err, "Failed to list PagesProjects for deployment", "deployment", deployment.Name)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range projects.Items {
+		project := &projects.Items[i]
+		vm := project.Spec.VersionManagement
+		if vm == nil || vm.Policy != networkingv1alpha2.VersionPolicyLatestPreview || vm.LatestPreview == nil {
+			continue
+		}
+		if !r.belongsToProject(deployment, project) {
+			continue
+		}
+		selector, err := latestPreviewSelector(vm.LatestPreview)
+		if err != nil || (selector != nil && !selector.Matches(labels.Set(deployment.Labels))) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)})
+	}
+	return requests
+}
+
 // belongsToProject checks if a deployment belongs to the given project.
 func (*LatestPreviewReconciler) belongsToProject(
 	deployment *networkingv1alpha2.PagesDeployment,
@@ -179,6 +227,8 @@ func (*LatestPreviewReconciler) belongsToProject(
 }
 
 // findLatestSuccessfulPreview finds the most recently succeeded preview deployment.
+// Deployments are ordered by FinishedAt, then by creation time, then by name, so
+// deployments finishing in the same second always resolve to the same one.
 //
 //nolint:revive // cognitive complexity acceptable for sorting logic
 func (*LatestPreviewReconciler) findLatestSuccessfulPreview(
@@ -200,34 +250,33 @@ func (*LatestPreviewReconciler) findLatestSuccessfulPreview(
 	sort.Slice(succeeded, func(i, j int) bool {
 		ti := succeeded[i].Status.FinishedAt
 		tj := succeeded[j].Status.FinishedAt
-		if ti == nil && tj == nil {
-			// Fall back to creation timestamp
-			return succeeded[i].CreationTimestamp.After(succeeded[j].CreationTimestamp.Time)
-		}
-		if ti == nil {
+		if ti == nil && tj != nil {
 			return false
 		}
-		if tj == nil {
+		if ti != nil && tj == nil {
 			return true
 		}
-		return ti.After(tj.Time)
+		if ti != nil && !ti.Equal(tj) {
+			return ti.After(tj.Time)
+		}
+		// Fall back to creation timestamp, then name
+		ci, cj := succeeded[i].CreationTimestamp, succeeded[j].CreationTimestamp
+		if !ci.Equal(&cj) {
+			return ci.After(cj.Time)
+		}
+		return succeeded[i].Name > succeeded[j].Name
 	})
 
 	return succeeded[0]
 }
 
-// updatePreviewDeploymentStatus updates the preview deployment info in project status.
+// updatePreviewDeploymentStatus updates the preview deployment info in project
+// status. The status is only written when the tracked deployment changed.
 func (r *LatestPreviewReconciler) updatePreviewDeploymentStatus(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
 	deployment *networkingv1alpha2.PagesDeployment,
 ) error {
-	// Get fresh copy
-	fresh := &networkingv1alpha2.PagesProject{}
-	if err := r.Get(ctx, client.ObjectKeyFromObject(project), fresh); err != nil {
-		return err
-	}
-
 	versionName := deployment.Spec.VersionName
 	if versionName == "" {
 		versionName = deployment.Status.VersionName
@@ -236,20 +285,36 @@ func (r *LatestPreviewReconciler) updatePreviewDeploymentStatus(
 		versionName = deployment.Labels[VersionLabel]
 	}
 
-	fresh.Status.PreviewDeployment = &networkingv1alpha2.PreviewDeploymentInfo{
+	preview := &networkingv1alpha2.PreviewDeploymentInfo{
 		VersionName:    versionName,
 		DeploymentID:   deployment.Status.DeploymentID,
 		DeploymentName: deployment.Name,
 		URL:            deployment.Status.URL,
 		HashURL:        deployment.Status.HashURL,
 		State:          string(deployment.Status.State),
+		DeployedAt:     deployment.Status.FinishedAt,
+	}
+
+	if equality.Semantic.DeepEqual(project.Status.PreviewDeployment, preview) {
+		return nil
+	}
+
+	previous := ""
+	if project.Status.PreviewDeployment != nil {
+		previous = project.Status.PreviewDeployment.DeploymentName
 	}
 
-	if deployment.Status.FinishedAt != nil {
-		fresh.Status.PreviewDeployment.DeployedAt = deployment.Status.FinishedAt
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		project.Status.PreviewDeployment = preview
+	}); err != nil {
+		return err
 	}
 
-	return r.Status().Update(ctx, fresh)
+	if previous != deployment.Name {
+		r.Recorder.Event(project, corev1.EventTypeNormal, "LatestPreviewUpdated",
+			fmt.Sprintf("Tracking preview deployment %s (version: %s)", deployment.Name, versionName))
+	}
+	return nil
 }
 
 // handleAutoPromote promotes the latest successful preview to production if enabled.
@@ -271,6 +336,17 @@ func (r *LatestPreviewReconciler) handleAutoPromote(
 		return nil
 	}
 
+	// A promoted deployment leaves the preview environment, so the previous
+	// preview becomes the latest one again and must not be promoted over it
+	if last := lastLatestPreviewPromotion(project); last != nil {
+		if last.DeploymentID == deployment.Status.DeploymentID ||
+			(deployment.Status.FinishedAt != nil && last.ValidatedAt != nil &&
+				deployment.Status.FinishedAt.Before(last.ValidatedAt)) {
+			log.V(1).Info("Deployment is not newer than the last promoted preview")
+			return nil
+		}
+	}
+
 	// Promote by changing environment (works for all deployments, not just previous production)
 	log.Info("Auto-promoting preview deployment to production",
 		"deployment", deployment.Name)
@@ -278,7 +354,7 @@ func (r *LatestPreviewReconciler) handleAutoPromote(
 	if err := PromoteDeploymentToProduction(ctx, r.Client, deployment); err != nil {
 		r.Recorder.Event(project, corev1.EventTypeWarning, "AutoPromoteFailed",
 			fmt.Sprintf("Failed to auto-promote deployment %s: %s",
-				deployment.Name, err.Error()))
+				deployment.Name, cf.SanitizeErrorMessage(err)))
 		return fmt.Errorf("failed to promote deployment: %w", err)
 	}
 
@@ -297,18 +373,23 @@ func (r *LatestPreviewReconciler) handleAutoPromote(
 	return nil
 }
 
+// lastLatestPreviewPromotion returns the newest promotion recorded by the
+// latestPreview policy, or nil.
+func lastLatestPreviewPromotion(project *networkingv1alpha2.PagesProject) *networkingv1alpha2.VersionValidation {
+	for i := range project.Status.ValidationHistory {
+		if project.Status.ValidationHistory[i].ValidatedBy == validatedByLatestPreview {
+			return &project.Status.ValidationHistory[i]
+		}
+	}
+	return nil
+}
+
 // recordValidation records a version validation in the project status.
 func (r *LatestPreviewReconciler) recordValidation(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
 	deployment *networkingv1alpha2.PagesDeployment,
 ) error {
-	// Get fresh copy
-	fresh := &networkingv1alpha2.PagesProject{}
-	if err := r.Get(ctx, client.ObjectKeyFromObject(project), fresh); err != nil {
-		return err
-	}
-
 	versionName := deployment.Spec.VersionName
 	if versionName == "" {
 		versionName = deployment.Status.VersionName
@@ -319,24 +400,23 @@ func (r *LatestPreviewReconciler) recordValidation(
 		VersionName:      versionName,
 		DeploymentID:     deployment.Status.DeploymentID,
 		ValidatedAt:      &now,
-		ValidatedBy:      "latestPreview",
-		ValidationResult: "passed",
-	}
-
-	// Add to validation history (keep last 50)
-	fresh.Status.ValidationHistory = append(
-		[]networkingv1alpha2.VersionValidation{validation},
-		fresh.Status.ValidationHistory...,
-	)
-	if len(fresh.Status.ValidationHistory) > 50 {
-		fresh.Status.ValidationHistory = fresh.Status.ValidationHistory[:50]
+		ValidatedBy:      validatedByLatestPreview,
+		ValidationResult: validationResultPassed,
 	}
 
-	return r.Status().Update(ctx, fresh)
+	return controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		project.Status.ValidationHistory = append(
+			[]networkingv1alpha2.VersionValidation{validation},
+			project.Status.ValidationHistory...,
+		)
+		if len(project.Status.ValidationHistory) > maxValidationHistory {
+			project.Status.ValidationHistory = project.Status.ValidationHistory[:maxValidationHistory]
+		}
+	})
 }
 
 // GetRequeueAfter returns the recommended requeue duration for latestPreview mode.
 func (*LatestPreviewReconciler) GetRequeueAfter() time.Duration {
-	// Check for new preview deployments every 30 seconds
+	// Deployment changes are watched; the requeue only catches missed events
 	return 30 * time.Second
 }
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+var latestPreviewBaseTime = time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+
+func newLatestPreviewTestProject(autoPromote bool) *networkingv1alpha2.PagesProject {
+	return &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+			VersionManagement: &networkingv1alpha2.VersionManagement{
+				Policy: networkingv1alpha2.VersionPolicyLatestPreview,
+				LatestPreview: &networkingv1alpha2.LatestPreviewConfig{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"pipeline": "ci"},
+					},
+					AutoPromote: autoPromote,
+				},
+			},
+		},
+	}
+}
+
+// newLatestPreviewTestDeployment returns a succeeded preview deployment of
+// my-site from the CI pipeline that finished finishedAfter after the base time.
+func newLatestPreviewTestDeployment(name string, finishedAfter time.Duration) *networkingv1alpha2.PagesDeployment {
+	finishedAt := metav1.NewTime(latestPreviewBaseTime.Add(finishedAfter))
+	return &networkingv1alpha2.PagesDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{"pipeline": "ci"},
+			CreationTimestamp: metav1.NewTime(latestPreviewBaseTime),
+		},
+		Spec: networkingv1alpha2.PagesDeploymentSpec{
+			ProjectRef:  networkingv1alpha2.PagesProjectRef{Name: "my-site"},
+			VersionName: name,
+			Environment: networkingv1alpha2.PagesDeploymentEnvironmentPreview,
+		},
+		Status: networkingv1alpha2.PagesDeploymentStatus{
+			DeploymentID: name + "-id",
+			State:        networkingv1alpha2.PagesDeploymentStateSucceeded,
+			URL:          "https://" + name + ".my-site.pages.dev",
+			FinishedAt:   &finishedAt,
+		},
+	}
+}
+
+func newLatestPreviewTestReconciler(objs ...client.Object) (*LatestPreviewReconciler, client.Client, *record.FakeRecorder) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}, &networkingv1alpha2.PagesDeployment{}).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	return NewLatestPreviewReconciler(fakeClient, scheme.Scheme, recorder, logr.Discard()), fakeClient, recorder
+}
+
+func getLatestPreviewTestProject(t *testing.T, c client.Client) *networkingv1alpha2.PagesProject {
+	t.Helper()
+	project := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "my-site", Namespace: "default"}, project))
+	return project
+}
+
+func TestLatestPreview_TracksNewestMatchingDeployment(t *testing.T) {
+	failed := newLatestPreviewTestDeployment("failed", 4*time.Minute)
+	failed.Status.State = networkingv1alpha2.PagesDeploymentStateFailed
+	unselected := newLatestPreviewTestDeployment("manual", 5*time.Minute)
+	unselected.Labels = map[string]string{"pipeline": "manual"}
+	otherProject := newLatestPreviewTestDeployment("other", 6*time.Minute)
+	otherProject.Spec.ProjectRef.Name = "other-site"
+	production := newLatestPreviewTestDeployment("production", 7*time.Minute)
+	production.Spec.Environment = networkingv1alpha2.PagesDeploymentEnvironmentProduction
+
+	r, c, recorder := newLatestPreviewTestReconciler(
+		newLatestPreviewTestProject(false),
+		newLatestPreviewTestDeployment("v1", time.Minute),
+		newLatestPreviewTestDeployment("v3", 3*time.Minute),
+		newLatestPreviewTestDeployment("v2", 2*time.Minute),
+		failed, unselected, otherProject, production,
+	)
+	ctx := context.Background()
+
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+
+	project := getLatestPreviewTestProject(t, c)
+	require.NotNil(t, project.Status.PreviewDeployment)
+	assert.Equal(t, "v3", project.Status.PreviewDeployment.DeploymentName)
+	assert.Equal(t, "v3", project.Status.PreviewDeployment.VersionName)
+	assert.Equal(t, "v3-id", project.Status.PreviewDeployment.DeploymentID)
+	assert.Equal(t, "https://v3.my-site.pages.dev", project.Status.PreviewDeployment.URL)
+	assert.Contains(t, <-recorder.Events, "LatestPreviewUpdated")
+
+	// A newer deployment moves the pointer
+	require.NoError(t, c.Create(ctx, newLatestPreviewTestDeployment("v4", 8*time.Minute)))
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+	assert.Equal(t, "v4", getLatestPreviewTestProject(t, c).Status.PreviewDeployment.DeploymentName)
+}
+
+func TestLatestPreview_UnchangedPreviewIsNotWritten(t *testing.T) {
+	r, c, recorder := newLatestPreviewTestReconciler(
+		newLatestPreviewTestProject(false),
+		newLatestPreviewTestDeployment("v1", time.Minute),
+	)
+	ctx := context.Background()
+
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+	<-recorder.Events
+	resourceVersion := getLatestPreviewTestProject(t, c).ResourceVersion
+
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+	assert.Equal(t, resourceVersion, getLatestPreviewTestProject(t, c).ResourceVersion)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestLatestPreview_TieBreak(t *testing.T) {
+	r, _, _ := newLatestPreviewTestReconciler()
+
+	sameFinish := func() []*networkingv1alpha2.PagesDeployment {
+		older := newLatestPreviewTestDeployment("a-older", time.Minute)
+		newer := newLatestPreviewTestDeployment("b-newer", time.Minute)
+		newer.CreationTimestamp = metav1.NewTime(latestPreviewBaseTime.Add(time.Second))
+		return []*networkingv1alpha2.PagesDeployment{older, newer}
+	}
+	sameCreation := func() []*networkingv1alpha2.PagesDeployment {
+		return []*networkingv1alpha2.PagesDeployment{
+			newLatestPreviewTestDeployment("v-a", time.Minute),
+			newLatestPreviewTestDeployment("v-b", time.Minute),
+			newLatestPreviewTestDeployment("v-c", time.Minute),
+		}
+	}
+	unfinished := func() []*networkingv1alpha2.PagesDeployment {
+		finished := newLatestPreviewTestDeployment("finished", time.Minute)
+		unknown := newLatestPreviewTestDeployment("unknown", 0)
+		unknown.Status.FinishedAt = nil
+		unknown.CreationTimestamp = metav1.NewTime(latestPreviewBaseTime.Add(time.Hour))
+		return []*networkingv1alpha2.PagesDeployment{finished, unknown}
+	}
+
+	tests := []struct {
+		name        string
+		deployments func() []*networkingv1alpha2.PagesDeployment
+		want        string
+	}{
+		{name: "same finish time uses creation time", deployments: sameFinish, want: "b-newer"},
+		{name: "same timestamps use name", deployments: sameCreation, want: "v-c"},
+		{name: "finish time before unknown finish time", deployments: unfinished, want: "finished"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployments := tt.deployments()
+			assert.Equal(t, tt.want, r.findLatestSuccessfulPreview(deployments).Name)
+
+			// The result does not depend on the listing order
+			for i, j := 0, len(deployments)-1; i < j; i, j = i+1, j-1 {
+				deployments[i], deployments[j] = deployments[j], deployments[i]
+			}
+			assert.Equal(t, tt.want, r.findLatestSuccessfulPreview(deployments).Name)
+		})
+	}
+}
+
+func TestLatestPreview_AutoPromote(t *testing.T) {
+	r, c, _ := newLatestPreviewTestReconciler(
+		newLatestPreviewTestProject(true),
+		newLatestPreviewTestDeployment("v1", time.Minute),
+		newLatestPreviewTestDeployment("v2", 2*time.Minute),
+	)
+	ctx := context.Background()
+
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+
+	v2 := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "v2", Namespace: "default"}, v2))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, v2.Spec.Environment)
+
+	project := getLatestPreviewTestProject(t, c)
+	require.NotEmpty(t, project.Status.ValidationHistory)
+	assert.Equal(t, "v2-id", project.Status.ValidationHistory[0].DeploymentID)
+	assert.Equal(t, validatedByLatestPreview, project.Status.ValidationHistory[0].ValidatedBy)
+
+	// v2 left the preview environment, so v1 is the latest preview again, but
+	// it is older than v2 and must not replace it in production
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+	v1 := &networkingv1alpha2.PagesDeployment{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "v1", Namespace: "default"}, v1))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentPreview, v1.Spec.Environment)
+	assert.Len(t, getLatestPreviewTestProject(t, c).Status.ValidationHistory, 1)
+
+	// A preview finishing after the promotion is promoted
+	v3 := newLatestPreviewTestDeployment("v3", 0)
+	finishedAt := metav1.NewTime(time.Now().Add(time.Minute))
+	v3.Status.FinishedAt = &finishedAt
+	require.NoError(t, c.Create(ctx, v3))
+	require.NoError(t, r.Reconcile(ctx, getLatestPreviewTestProject(t, c), nil))
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "v3", Namespace: "default"}, v3))
+	assert.Equal(t, networkingv1alpha2.PagesDeploymentEnvironmentProduction, v3.Spec.Environment)
+}
+
+func TestLatestPreview_FindProjectsForDeployment(t *testing.T) {
+	tracking := newLatestPreviewTestProject(false)
+	otherSelector := newLatestPreviewTestProject(false)
+	otherSelector.Name = "other-selector"
+	otherSelector.Spec.Name = "my-site"
+	otherSelector.Spec.VersionManagement.LatestPreview.LabelSelector.MatchLabels = map[string]string{"pipeline": "manual"}
+	gitops := newGitOpsTestProject("v1", "")
+	gitops.Name = "gitops"
+	gitops.Spec.Name = "my-site"
+
+	r, _, _ := newLatestPreviewTestReconciler(tracking, otherSelector, gitops)
+
+	requests := r.FindProjectsForDeployment(context.Background(), newLatestPreviewTestDeployment("v1", time.Minute))
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: "my-site", Namespace: "default"}},
+	}, requests)
+}
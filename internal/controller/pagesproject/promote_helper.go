@@ -92,3 +92,11 @@ func ValidateDeploymentForPromotion(deployment *networkingv1alpha2.PagesDeployme
 
 	return nil
 }
+
+// getProjectNameFromSpec returns the Cloudflare project name of a PagesProject.
+func getProjectNameFromSpec(project *networkingv1alpha2.PagesProject) string {
+	if project.Spec.Name != "" {
+		return project.Spec.Name
+	}
+	return project.Name
+}
@@ -14,23 +14,23 @@ import (
 )
 
 // pruneOldVersions deletes old managed deployments based on revisionHistoryLimit.
+// The limit counts all managed deployments, but production deployments, the
+// current production deployment and in-flight deployments are never pruned, so a
+// limit of 0 keeps only production.
 func (r *PagesProjectReconciler) pruneOldVersions(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
 ) error {
 	logger := log.FromContext(ctx)
 
-	// Get the revision limit and check if pruning is needed
 	limit := r.getRevisionLimit(project)
-	if limit == 0 {
-		return nil
-	}
 
-	// Get and check all managed deployments
+	// Get and check all managed deployments, ignoring those already being deleted
 	allDeployments, err := r.versionManager.listManagedDeployments(ctx, project)
 	if err != nil {
 		return err
 	}
+	allDeployments = activeDeployments(allDeployments)
 
 	if len(allDeployments) <= int(limit) {
 		logger.V(1).Info("Under revision limit, no pruning needed",
@@ -40,11 +40,43 @@ func (r *PagesProjectReconciler) pruneOldVersions(
 
 	// Sort and prune deployments
 	r.sortDeploymentsByPriority(allDeployments)
-	r.deleteOldDeployments(ctx, logger, allDeployments[limit:])
+	r.deleteOldDeployments(ctx, logger, project, allDeployments[limit:])
 
 	return nil
 }
 
+// activeDeployments filters out deployments that are already being deleted.
+func activeDeployments(deployments []networkingv1alpha2.PagesDeployment) []networkingv1alpha2.PagesDeployment {
+	result := make([]networkingv1alpha2.PagesDeployment, 0, len(deployments))
+	for i := range deployments {
+		if deployments[i].DeletionTimestamp.IsZero() {
+			result = append(result, deployments[i])
+		}
+	}
+	return result
+}
+
+// isPruneProtected returns true if the deployment must be kept regardless of the limit.
+func isPruneProtected(project *networkingv1alpha2.PagesProject, dep *networkingv1alpha2.PagesDeployment) bool {
+	if dep.Spec.Environment == networkingv1alpha2.PagesDeploymentEnvironmentProduction {
+		return true
+	}
+	if cp := project.Status.CurrentProduction; cp != nil {
+		if cp.DeploymentName == dep.Name || (cp.DeploymentID != "" && cp.DeploymentID == dep.Status.DeploymentID) {
+			return true
+		}
+	}
+	// In-flight deployments may still be promoted
+	switch dep.Status.State {
+	case networkingv1alpha2.PagesDeploymentStateSucceeded,
+		networkingv1alpha2.PagesDeploymentStateFailed,
+		networkingv1alpha2.PagesDeploymentStateCancelled:
+		return false
+	default:
+		return true
+	}
+}
+
 // getRevisionLimit returns the revision history limit (default 10).
 func (*PagesProjectReconciler) getRevisionLimit(project *networkingv1alpha2.PagesProject) int32 {
 	if project.Spec.RevisionHistoryLimit != nil {
@@ -73,15 +105,16 @@ func (*PagesProjectReconciler) sortDeploymentsByPriority(deployments []networkin
 func (r *PagesProjectReconciler) deleteOldDeployments(
 	ctx context.Context,
 	logger logr.Logger,
+	project *networkingv1alpha2.PagesProject,
 	toDelete []networkingv1alpha2.PagesDeployment,
 ) {
 	for i := range toDelete {
 		dep := &toDelete[i]
 
-		// Safety check: never delete production deployments during pruning
-		if dep.Spec.Environment == networkingv1alpha2.PagesDeploymentEnvironmentProduction {
-			logger.Info("Skipping production deployment during pruning (safety protection)",
-				"deployment", dep.Name)
+		// Safety check: never delete production or in-flight deployments during pruning
+		if isPruneProtected(project, dep) {
+			logger.Info("Skipping protected deployment during pruning",
+				"deployment", dep.Name, "state", dep.Status.State)
 			continue
 		}
 
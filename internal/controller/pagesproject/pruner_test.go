@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// newPruneTestObjects creates a project with one production deployment and
+// previews preview deployments, oldest first.
+func newPruneTestObjects(limit int32, previews int) (*networkingv1alpha2.PagesProject, []client.Object) {
+	project := &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+			UID:       "project-uid",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch:     "main",
+			RevisionHistoryLimit: ptr.To(limit),
+		},
+	}
+
+	base := time.Now().Add(-time.Hour)
+	newDeployment := func(version string, age int, env networkingv1alpha2.PagesDeploymentEnvironment) *networkingv1alpha2.PagesDeployment {
+		return &networkingv1alpha2.PagesDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("my-site-%s", version),
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(base.Add(time.Duration(age) * time.Minute)),
+				Labels: map[string]string{
+					ManagedByLabel:     ManagedByValue,
+					ManagedByNameLabel: project.Name,
+					ManagedByUIDLabel:  string(project.UID),
+					VersionLabel:       version,
+				},
+			},
+			Spec: networkingv1alpha2.PagesDeploymentSpec{
+				ProjectRef:  networkingv1alpha2.PagesProjectRef{Name: project.Name},
+				VersionName: version,
+				Environment: env,
+			},
+			Status: networkingv1alpha2.PagesDeploymentStatus{
+				DeploymentID: "dep-" + version,
+				State:        networkingv1alpha2.PagesDeploymentStateSucceeded,
+			},
+		}
+	}
+
+	// The production deployment is the oldest one
+	objs := []client.Object{project, newDeployment("prod", 0, networkingv1alpha2.PagesDeploymentEnvironmentProduction)}
+	for i := 1; i <= previews; i++ {
+		objs = append(objs, newDeployment(fmt.Sprintf("v%d", i), i, networkingv1alpha2.PagesDeploymentEnvironmentPreview))
+	}
+	return project, objs
+}
+
+func newPruneTestReconciler(objs ...client.Object) (*PagesProjectReconciler, client.Client) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}, &networkingv1alpha2.PagesDeployment{}).
+		Build()
+	r := &PagesProjectReconciler{
+		Client:         fakeClient,
+		Scheme:         scheme.Scheme,
+		Recorder:       record.NewFakeRecorder(10),
+		versionManager: NewVersionManager(fakeClient, scheme.Scheme, logr.Discard()),
+	}
+	return r, fakeClient
+}
+
+func listDeploymentNames(t *testing.T, c client.Client) []string {
+	t.Helper()
+	list := &networkingv1alpha2.PagesDeploymentList{}
+	require.NoError(t, c.List(context.Background(), list, client.InNamespace("default")))
+	names := make([]string, 0, len(list.Items))
+	for i := range list.Items {
+		names = append(names, list.Items[i].Name)
+	}
+	return names
+}
+
+func TestPruneOldVersions_PrunesBeyondLimit(t *testing.T) {
+	ctx := context.Background()
+	const limit = 3
+	// N+2 deployments: production plus limit+1 previews
+	project, objs := newPruneTestObjects(limit, limit+1)
+	r, fakeClient := newPruneTestReconciler(objs...)
+
+	require.NoError(t, r.pruneOldVersions(ctx, project))
+
+	// Production and the newest previews fill the limit
+	assert.ElementsMatch(t, []string{"my-site-prod", "my-site-v3", "my-site-v4"}, listDeploymentNames(t, fakeClient))
+
+	require.NoError(t, r.aggregateVersionStatus(ctx, project))
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, int32(limit), updated.Status.ManagedDeployments)
+	assert.Len(t, updated.Status.ManagedVersions, limit)
+}
+
+func TestPruneOldVersions_ZeroLimitKeepsOnlyProduction(t *testing.T) {
+	ctx := context.Background()
+	project, objs := newPruneTestObjects(0, 2)
+	r, fakeClient := newPruneTestReconciler(objs...)
+
+	require.NoError(t, r.pruneOldVersions(ctx, project))
+
+	assert.Equal(t, []string{"my-site-prod"}, listDeploymentNames(t, fakeClient))
+}
+
+func TestPruneOldVersions_SkipsProtectedDeployments(t *testing.T) {
+	ctx := context.Background()
+	project, objs := newPruneTestObjects(0, 3)
+
+	// v1 is the current production (e.g. after a rollback) and v2 is still building
+	project.Status.CurrentProduction = &networkingv1alpha2.ProductionDeploymentInfo{
+		Version:        "v1",
+		DeploymentID:   "dep-v1",
+		DeploymentName: "my-site-v1",
+	}
+	objs[3].(*networkingv1alpha2.PagesDeployment).Status.State = networkingv1alpha2.PagesDeploymentStateBuilding
+	r, fakeClient := newPruneTestReconciler(objs...)
+
+	require.NoError(t, r.pruneOldVersions(ctx, project))
+
+	assert.ElementsMatch(t, []string{"my-site-prod", "my-site-v1", "my-site-v2"}, listDeploymentNames(t, fakeClient))
+}
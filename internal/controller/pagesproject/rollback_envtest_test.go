@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf/mock"
+)
+
+// TestRollbackReconciler_Envtest runs the rollback against a real API server, where the
+// status subresource and the generation bump of spec changes behave as in a cluster.
+func TestRollbackReconciler_Envtest(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set; run with make test")
+	}
+
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := testEnv.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = testEnv.Stop() })
+
+	k8sClient, err := client.NewWithWatch(cfg, client.Options{Scheme: scheme.Scheme})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	project := newRollbackTestProject("v1.0.0")
+	status := project.Status
+	require.NoError(t, k8sClient.Create(ctx, project))
+	project.Status = status
+	require.NoError(t, k8sClient.Status().Update(ctx, project))
+	requestedGeneration := project.Generation
+
+	// Clearing spec.rollbackTo fails once, after the rollback was sent
+	failUpdates := true
+	c := interceptor.NewClient(k8sClient, interceptor.Funcs{
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if failUpdates {
+				return assert.AnError
+			}
+			return c.Update(ctx, obj, opts...)
+		},
+	})
+	r := NewRollbackReconciler(c, scheme.Scheme, record.NewFakeRecorder(10), logr.Discard())
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().
+		RollbackPagesDeployment(gomock.Any(), "my-site", "dep-1").
+		Return(&cf.PagesDeploymentResult{ID: "dep-1"}, nil).
+		Times(1)
+	api.EXPECT().
+		RollbackPagesDeployment(gomock.Any(), "my-site", "dep-2").
+		Return(&cf.PagesDeploymentResult{ID: "dep-2"}, nil).
+		Times(1)
+
+	require.Error(t, r.Reconcile(ctx, project, api))
+
+	failUpdates = false
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, "v1.0.0", updated.Spec.RollbackTo)
+	require.NotNil(t, updated.Status.HandledRollback)
+	assert.Equal(t, "v1.0.0", updated.Status.HandledRollback.Target)
+	assert.Equal(t, requestedGeneration, updated.Status.HandledRollback.Generation)
+
+	// The handled rollback is not sent again, only cleared
+	require.NoError(t, r.Reconcile(ctx, updated, api))
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Empty(t, updated.Spec.RollbackTo)
+	require.NotNil(t, updated.Status.CurrentProduction)
+	assert.Equal(t, "dep-1", updated.Status.CurrentProduction.DeploymentID)
+
+	// A new rollbackTo is a new spec generation and is handled again
+	updated.Spec.RollbackTo = "v1.1.0"
+	require.NoError(t, k8sClient.Update(ctx, updated))
+	require.Greater(t, updated.Generation, requestedGeneration)
+	require.NoError(t, r.Reconcile(ctx, updated, api))
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Empty(t, updated.Spec.RollbackTo)
+	assert.Equal(t, "dep-2", updated.Status.CurrentProduction.DeploymentID)
+}
@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+)
+
+const (
+	// ConditionTypeRolledBack reports the outcome of the last spec.rollbackTo action.
+	ConditionTypeRolledBack = "RolledBack"
+	// ReasonRollbackTargetNotFound is set when spec.rollbackTo matches no history entry.
+	ReasonRollbackTargetNotFound = "RollbackTargetNotFound"
+)
+
+// errRollbackTargetNotFound is returned when spec.rollbackTo matches no entry of
+// status.deploymentHistory. Retrying cannot succeed until the spec changes.
+var errRollbackTargetNotFound = errors.New("not found in deployment history")
+
+// RollbackReconciler handles the spec.rollbackTo action of a PagesProject.
+// It rolls the production deployment back to an entry of status.deploymentHistory
+// and clears spec.rollbackTo once the rollback succeeds.
+type RollbackReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Log      logr.Logger
+}
+
+// NewRollbackReconciler creates a new RollbackReconciler.
+func NewRollbackReconciler(
+	k8sClient client.Client,
+	scheme *runtime.Scheme,
+	recorder record.EventRecorder,
+	log logr.Logger,
+) *RollbackReconciler {
+	return &RollbackReconciler{
+		Client:   k8sClient,
+		Scheme:   scheme,
+		Recorder: recorder,
+		Log:      log.WithName("rollback"),
+	}
+}
+
+// Reconcile performs the rollback requested by spec.rollbackTo, if any.
+func (r *RollbackReconciler) Reconcile(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	apiClient cf.CloudflareClient,
+) error {
+	target := strings.TrimSpace(project.Spec.RollbackTo)
+	if target == "" {
+		return nil
+	}
+
+	log := r.Log.WithValues("project", project.Name, "namespace", project.Namespace, "rollbackTo", target)
+
+	if handled := project.Status.HandledRollback; handled != nil &&
+		handled.Target == target && handled.Generation == project.Generation {
+		log.Info("Rollback was already handled, clearing rollbackTo")
+		return r.clearRollbackTo(ctx, project)
+	}
+
+	found := resolveRollbackTarget(project, target)
+	if found == nil {
+		return fmt.Errorf("rollback target %q %w", target, errRollbackTargetNotFound)
+	}
+	// Copy the entry, the history slice is rewritten by the status update below
+	entry := *found
+
+	if project.Status.CurrentProduction != nil &&
+		project.Status.CurrentProduction.DeploymentID == entry.DeploymentID {
+		log.Info("Rollback target is already production, clearing rollbackTo")
+		return r.clearRollbackTo(ctx, project)
+	}
+
+	// Record the rollback before calling the API, so a rollback whose spec.rollbackTo
+	// could not be cleared is not sent to Cloudflare again
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		project.Status.HandledRollback = &networkingv1alpha2.HandledRollback{
+			Target:     target,
+			Generation: project.Generation,
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to record rollback in status: %w", err)
+	}
+
+	log.Info("Rolling back Pages project", "deploymentId", entry.DeploymentID)
+	result, err := apiClient.RollbackPagesDeployment(ctx, getProjectNameFromSpec(project), entry.DeploymentID)
+	if err != nil {
+		// The rollback did not happen, allow it to be retried
+		if statusErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+			project.Status.HandledRollback = nil
+		}); statusErr != nil {
+			log.Error(statusErr, "Failed to reset handled rollback")
+		}
+		return fmt.Errorf("failed to rollback to deployment %s: %w", entry.DeploymentID, err)
+	}
+
+	source := fmt.Sprintf("%sv%d", HistorySourceRollbackPrefix, entry.Version)
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, project, func() {
+		now := metav1.Now()
+		deploymentID := result.ID
+		if deploymentID == "" {
+			deploymentID = entry.DeploymentID
+		}
+		url := result.URL
+		if url == "" {
+			url = entry.URL
+		}
+
+		appendHistoryEntry(project, networkingv1alpha2.DeploymentHistoryEntry{
+			DeploymentID: deploymentID,
+			URL:          url,
+			Environment:  string(networkingv1alpha2.PagesDeploymentEnvironmentProduction),
+			Source:       source,
+			SourceHash:   entry.SourceHash,
+			SourceURL:    entry.SourceURL,
+			K8sResource:  entry.K8sResource,
+			CreatedAt:    now,
+			Status:       HistoryStatusActive,
+			IsProduction: true,
+		})
+
+		project.Status.CurrentProduction = &networkingv1alpha2.ProductionDeploymentInfo{
+			Version:      versionNameForDeployment(project, entry.DeploymentID),
+			DeploymentID: deploymentID,
+			URL:          url,
+			DeployedAt:   &now,
+		}
+		controller.SetCondition(&project.Status.Conditions, ConditionTypeRolledBack, metav1.ConditionTrue,
+			"RollbackSucceeded", fmt.Sprintf("Rolled back to deployment %s (%s)", entry.DeploymentID, target))
+	}); err != nil {
+		return fmt.Errorf("failed to record rollback in status: %w", err)
+	}
+
+	r.Recorder.Event(project, corev1.EventTypeNormal, "RolledBack",
+		fmt.Sprintf("Rolled back production to deployment %s (%s)", entry.DeploymentID, target))
+
+	return r.clearRollbackTo(ctx, project)
+}
+
+// clearRollbackTo resets spec.rollbackTo after the rollback has been handled.
+func (r *RollbackReconciler) clearRollbackTo(ctx context.Context, project *networkingv1alpha2.PagesProject) error {
+	return controller.UpdateWithConflictRetry(ctx, r.Client, project, func() {
+		project.Spec.RollbackTo = ""
+	})
+}
+
+// resolveRollbackTarget finds the history entry referenced by target.
+// The target may be a deployment ID, a version name, or a history version ("v5" or "5").
+func resolveRollbackTarget(
+	project *networkingv1alpha2.PagesProject,
+	target string,
+) *networkingv1alpha2.DeploymentHistoryEntry {
+	history := project.Status.DeploymentHistory
+
+	// Exact deployment ID
+	for i := range history {
+		if history[i].DeploymentID == target {
+			return &history[i]
+		}
+	}
+
+	// Version name mapped to a deployment ID
+	if deploymentID := deploymentIDForVersion(project, target); deploymentID != "" {
+		for i := range history {
+			if history[i].DeploymentID == deploymentID {
+				return &history[i]
+			}
+		}
+	}
+
+	// Sequential history version
+	if n, err := strconv.Atoi(strings.TrimPrefix(target, "v")); err == nil {
+		for i := range history {
+			if history[i].Version == n {
+				return &history[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// deploymentIDForVersion maps a version name to its deployment ID using project status.
+func deploymentIDForVersion(project *networkingv1alpha2.PagesProject, versionName string) string {
+	if id, ok := project.Status.VersionMapping[versionName]; ok && id != "" {
+		return id
+	}
+	for _, v := range project.Status.ManagedVersions {
+		if v.Name == versionName && v.DeploymentID != "" {
+			return v.DeploymentID
+		}
+	}
+	return ""
+}
+
+// versionNameForDeployment maps a deployment ID back to its version name, if known.
+func versionNameForDeployment(project *networkingv1alpha2.PagesProject, deploymentID string) string {
+	for _, v := range project.Status.ManagedVersions {
+		if v.DeploymentID == deploymentID {
+			return v.Name
+		}
+	}
+	for name, id := range project.Status.VersionMapping {
+		if id == deploymentID {
+			return name
+		}
+	}
+	return ""
+}
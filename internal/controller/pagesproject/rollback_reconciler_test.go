@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf/mock"
+)
+
+func init() {
+	_ = networkingv1alpha2.AddToScheme(scheme.Scheme)
+}
+
+func newRollbackTestProject(rollbackTo string) *networkingv1alpha2.PagesProject {
+	deployedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	return &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+			RollbackTo:       rollbackTo,
+		},
+		Status: networkingv1alpha2.PagesProjectStatus{
+			DeploymentHistory: []networkingv1alpha2.DeploymentHistoryEntry{
+				{
+					DeploymentID: "dep-1",
+					Version:      1,
+					URL:          "https://dep-1.my-site.pages.dev",
+					Environment:  "production",
+					Source:       "direct-upload:http",
+					CreatedAt:    metav1.NewTime(deployedAt.Add(-time.Hour)),
+					Status:       HistoryStatusSuperseded,
+				},
+				{
+					DeploymentID: "dep-2",
+					Version:      2,
+					URL:          "https://dep-2.my-site.pages.dev",
+					Environment:  "production",
+					Source:       "direct-upload:http",
+					CreatedAt:    deployedAt,
+					Status:       HistoryStatusActive,
+					IsProduction: true,
+				},
+			},
+			ManagedVersions: []networkingv1alpha2.ManagedVersionStatus{
+				{Name: "v1.0.0", DeploymentID: "dep-1"},
+				{Name: "v1.1.0", DeploymentID: "dep-2", IsProduction: true},
+			},
+			CurrentProduction: &networkingv1alpha2.ProductionDeploymentInfo{
+				Version:      "v1.1.0",
+				DeploymentID: "dep-2",
+				URL:          "https://dep-2.my-site.pages.dev",
+				DeployedAt:   &deployedAt,
+			},
+		},
+	}
+}
+
+func newRollbackTestReconciler(objs ...client.Object) (*RollbackReconciler, client.Client) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}).
+		Build()
+	return NewRollbackReconciler(fakeClient, scheme.Scheme, record.NewFakeRecorder(10), logr.Discard()), fakeClient
+}
+
+func TestRollbackReconciler_NoRollbackRequested(t *testing.T) {
+	project := newRollbackTestProject("")
+	r, _ := newRollbackTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, api))
+}
+
+func TestRollbackReconciler_RollsBackByVersionName(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("v1.0.0")
+	r, fakeClient := newRollbackTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().
+		RollbackPagesDeployment(gomock.Any(), "my-site", "dep-1").
+		Return(&cf.PagesDeploymentResult{
+			ID:          "dep-1",
+			URL:         "https://dep-1.my-site.pages.dev",
+			Environment: "production",
+		}, nil)
+
+	require.NoError(t, r.Reconcile(ctx, project, api))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+
+	assert.Empty(t, updated.Spec.RollbackTo, "rollbackTo should be cleared after success")
+
+	require.NotNil(t, updated.Status.CurrentProduction)
+	assert.Equal(t, "dep-1", updated.Status.CurrentProduction.DeploymentID)
+	assert.Equal(t, "v1.0.0", updated.Status.CurrentProduction.Version)
+
+	require.Len(t, updated.Status.DeploymentHistory, 3)
+	latest := updated.Status.DeploymentHistory[2]
+	assert.Equal(t, "dep-1", latest.DeploymentID)
+	assert.Equal(t, 3, latest.Version)
+	assert.Equal(t, "rollback:v1", latest.Source)
+	assert.True(t, latest.IsProduction)
+	assert.False(t, updated.Status.DeploymentHistory[1].IsProduction)
+	assert.Equal(t, HistoryStatusSuperseded, updated.Status.DeploymentHistory[1].Status)
+	assert.Equal(t, "dep-1", updated.Status.LastSuccessfulDeploymentID)
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeRolledBack)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+}
+
+func TestRollbackReconciler_RollsBackByHistoryVersion(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("v1")
+	r, _ := newRollbackTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().
+		RollbackPagesDeployment(gomock.Any(), "my-site", "dep-1").
+		Return(&cf.PagesDeploymentResult{ID: "dep-1"}, nil)
+
+	require.NoError(t, r.Reconcile(ctx, project, api))
+	assert.Equal(t, "dep-1", project.Status.CurrentProduction.DeploymentID)
+}
+
+func TestRollbackReconciler_TargetNotInHistory(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("dep-unknown")
+	r, fakeClient := newRollbackTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	// No RollbackPagesDeployment call is expected
+
+	err := r.Reconcile(ctx, project, api)
+	require.ErrorIs(t, err, errRollbackTargetNotFound)
+	assert.Contains(t, err.Error(), "not found in deployment history")
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, "dep-unknown", updated.Spec.RollbackTo, "rollbackTo must be kept on failure")
+	assert.Equal(t, "dep-2", updated.Status.CurrentProduction.DeploymentID)
+	assert.Len(t, updated.Status.DeploymentHistory, 2)
+}
+
+func TestUpdateStatusRollbackTargetNotFound(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("dep-unknown")
+	_, fakeClient := newRollbackTestReconciler(project)
+	recorder := record.NewFakeRecorder(10)
+	r := &PagesProjectReconciler{Client: fakeClient, Scheme: scheme.Scheme, Recorder: recorder}
+
+	result, err := r.updateStatusRollbackTargetNotFound(ctx, project,
+		fmt.Errorf("rollback target %q %w", "dep-unknown", errRollbackTargetNotFound))
+
+	require.NoError(t, err)
+	assert.Zero(t, result, "a missing rollback target must not be retried")
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, ReasonRollbackTargetNotFound, ready.Reason)
+	assert.Contains(t, ready.Message, "dep-unknown")
+	rolledBack := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeRolledBack)
+	require.NotNil(t, rolledBack)
+	assert.Equal(t, ReasonRollbackTargetNotFound, rolledBack.Reason)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, ReasonRollbackTargetNotFound)
+}
+
+func TestRollbackReconciler_TargetAlreadyProduction(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("dep-2")
+	r, fakeClient := newRollbackTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+
+	require.NoError(t, r.Reconcile(ctx, project, api))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Empty(t, updated.Spec.RollbackTo)
+	assert.Len(t, updated.Status.DeploymentHistory, 2)
+}
+
+func TestRollbackReconciler_APIError(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("dep-1")
+	r, fakeClient := newRollbackTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().
+		RollbackPagesDeployment(gomock.Any(), "my-site", "dep-1").
+		Return(nil, assert.AnError)
+
+	require.Error(t, r.Reconcile(ctx, project, api))
+
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Equal(t, "dep-1", updated.Spec.RollbackTo)
+	assert.Nil(t, updated.Status.HandledRollback, "a failed rollback is retried")
+}
+
+func TestRollbackReconciler_NotRepeatedWhenClearFails(t *testing.T) {
+	ctx := context.Background()
+	project := newRollbackTestProject("dep-1")
+	project.Generation = 1
+	failUpdates := true
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(project).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				if failUpdates {
+					return assert.AnError
+				}
+				return c.Update(ctx, obj, opts...)
+			},
+		}).
+		Build()
+	r := NewRollbackReconciler(fakeClient, scheme.Scheme, record.NewFakeRecorder(10), logr.Discard())
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().
+		RollbackPagesDeployment(gomock.Any(), "my-site", "dep-1").
+		Return(&cf.PagesDeploymentResult{ID: "dep-1"}, nil).
+		Times(1)
+
+	require.Error(t, r.Reconcile(ctx, project, api), "clearing rollbackTo fails")
+
+	failUpdates = false
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	require.NotNil(t, updated.Status.HandledRollback)
+	assert.Equal(t, "dep-1", updated.Status.HandledRollback.Target)
+
+	require.NoError(t, r.Reconcile(ctx, updated, api))
+	require.NoError(t, fakeClient.Get(ctx, client.ObjectKeyFromObject(project), updated))
+	assert.Empty(t, updated.Spec.RollbackTo)
+}
+
+func TestResolveRollbackTarget(t *testing.T) {
+	project := newRollbackTestProject("")
+
+	tests := []struct {
+		name   string
+		target string
+		wantID string
+	}{
+		{name: "deployment ID", target: "dep-2", wantID: "dep-2"},
+		{name: "version name", target: "v1.0.0", wantID: "dep-1"},
+		{name: "history version with prefix", target: "v2", wantID: "dep-2"},
+		{name: "history version without prefix", target: "1", wantID: "dep-1"},
+		{name: "unknown", target: "v9", wantID: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := resolveRollbackTarget(project, tt.target)
+			if tt.wantID == "" {
+				assert.Nil(t, entry)
+				return
+			}
+			require.NotNil(t, entry)
+			assert.Equal(t, tt.wantID, entry.DeploymentID)
+		})
+	}
+}
+
+func TestDescribeDeploymentSource(t *testing.T) {
+	tests := []struct {
+		name string
+		spec networkingv1alpha2.PagesDeploymentSpec
+		want string
+	}{
+		{
+			name: "legacy branch",
+			spec: networkingv1alpha2.PagesDeploymentSpec{Branch: "main"},
+			want: "git:main",
+		},
+		{
+			name: "git source",
+			spec: networkingv1alpha2.PagesDeploymentSpec{
+				Source: &networkingv1alpha2.PagesDeploymentSourceSpec{
+					Type: networkingv1alpha2.PagesDeploymentSourceTypeGit,
+					Git:  &networkingv1alpha2.PagesGitSourceSpec{Branch: "release"},
+				},
+			},
+			want: "git:release",
+		},
+		{
+			name: "direct upload from http",
+			spec: networkingv1alpha2.PagesDeploymentSpec{
+				Source: &networkingv1alpha2.PagesDeploymentSourceSpec{
+					Type: networkingv1alpha2.PagesDeploymentSourceTypeDirectUpload,
+					DirectUpload: &networkingv1alpha2.PagesDirectUploadSourceSpec{
+						Source: &networkingv1alpha2.DirectUploadSource{
+							HTTP: &networkingv1alpha2.HTTPSource{URL: "https://example.com/dist.tar.gz"},
+						},
+					},
+				},
+			},
+			want: "direct-upload:http",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &networkingv1alpha2.PagesDeployment{Spec: tt.spec}
+			assert.Equal(t, tt.want, describeDeploymentSource(d))
+		})
+	}
+}
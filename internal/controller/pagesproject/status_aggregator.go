@@ -18,6 +18,8 @@ func (r *PagesProjectReconciler) aggregateVersionStatus(ctx context.Context, pro
 	if err != nil {
 		return err
 	}
+	// Deployments deleted by pruning no longer count as managed
+	deployments = activeDeployments(deployments)
 
 	managedVersions := make([]networkingv1alpha2.ManagedVersionStatus, 0, len(deployments))
 	var currentProduction *networkingv1alpha2.ProductionDeploymentInfo
@@ -55,6 +57,16 @@ func (r *PagesProjectReconciler) aggregateVersionStatus(ctx context.Context, pro
 		}
 	}
 
+	// Keep the production deployment selected by spec.rollbackTo until a newer
+	// production deployment supersedes it
+	if rb := latestRollbackEntry(project); rb != nil && project.Status.CurrentProduction != nil &&
+		project.Status.CurrentProduction.DeploymentID == rb.DeploymentID {
+		if currentProduction == nil || currentProduction.DeployedAt == nil ||
+			currentProduction.DeployedAt.Before(&rb.CreatedAt) {
+			currentProduction = project.Status.CurrentProduction.DeepCopy()
+		}
+	}
+
 	// Get spec.version for gitopsLatest mode
 	var specVersion string
 	if project.Spec.VersionManagement != nil &&
@@ -890,6 +890,8 @@ func s3SourceEqual(a, b *networkingv1alpha2.S3Source) bool {
 		a.Region == b.Region &&
 		a.Endpoint == b.Endpoint &&
 		a.UsePathStyle == b.UsePathStyle &&
+		a.RoleARN == b.RoleARN &&
+		a.Anonymous == b.Anonymous &&
 		localObjectRefEqual(a.CredentialsSecretRef, b.CredentialsSecretRef)
 }
 
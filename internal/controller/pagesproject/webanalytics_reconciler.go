@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -36,15 +37,18 @@ func NewWebAnalyticsReconciler(k8sClient client.Client, recorder record.EventRec
 	}
 }
 
-// Reconcile ensures Web Analytics is configured for all hostnames according to spec.
+// Reconcile ensures Web Analytics is configured for all hostnames according to spec,
+// and removes the sites of hostnames the project no longer has.
 // It should be called after the project is successfully synced and subdomain is available.
 func (r *WebAnalyticsReconciler) Reconcile(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
-	apiClient *cf.API,
+	apiClient cf.CloudflareClient,
 ) error {
 	log := r.Log.WithValues("project", project.Name, "namespace", project.Namespace)
 
+	migrateLegacyStatus(project.Status.WebAnalytics)
+
 	// Collect all hostnames that need Web Analytics
 	hostnames := r.collectHostnames(project)
 	if len(hostnames) == 0 {
@@ -61,6 +65,27 @@ func (r *WebAnalyticsReconciler) Reconcile(
 	return r.disableAllSites(ctx, project, apiClient)
 }
 
+// migrateLegacyStatus adds the site of the deprecated single-site fields, as
+// written by earlier versions of the operator, to Sites. The deprecated fields
+// are kept as a mirror of the first site.
+func migrateLegacyStatus(status *networkingv1alpha2.WebAnalyticsStatus) {
+	if status == nil || status.SiteTag == "" {
+		return
+	}
+	for _, site := range status.Sites {
+		if site.SiteTag == status.SiteTag {
+			return
+		}
+	}
+	status.Sites = append(status.Sites, networkingv1alpha2.WebAnalyticsSiteStatus{
+		Hostname:    status.Hostname,
+		SiteTag:     status.SiteTag,
+		SiteToken:   status.SiteToken,
+		AutoInstall: status.AutoInstall,
+		Enabled:     true,
+	})
+}
+
 // collectHostnames gathers all hostnames that need Web Analytics.
 // Returns *.pages.dev hostname plus all custom domains.
 func (*WebAnalyticsReconciler) collectHostnames(project *networkingv1alpha2.PagesProject) []string {
@@ -77,24 +102,39 @@ func (*WebAnalyticsReconciler) collectHostnames(project *networkingv1alpha2.Page
 	}
 
 	// 2. Add all custom domains from status
-	hostnames = append(hostnames, project.Status.Domains...)
+	for _, domain := range project.Status.Domains {
+		if !slices.Contains(hostnames, domain) {
+			hostnames = append(hostnames, domain)
+		}
+	}
 
 	return hostnames
 }
 
-// enableAllSites enables Web Analytics for all specified hostnames.
+// enableAllSites enables Web Analytics for all specified hostnames and removes
+// the sites of hostnames that are no longer in the list.
 func (r *WebAnalyticsReconciler) enableAllSites(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
-	apiClient *cf.API,
+	apiClient cf.CloudflareClient,
 	hostnames []string,
 ) error {
 	log := r.Log.WithValues("project", project.Name)
+
+	existingSites, err := apiClient.ListWebAnalyticsSites(ctx)
+	if err != nil {
+		return fmt.Errorf("list Web Analytics sites: %w", err)
+	}
+	sitesByHost := make(map[string]*cf.RUMSite, len(existingSites))
+	for i := range existingSites {
+		sitesByHost[existingSites[i].Host] = &existingSites[i]
+	}
+
 	sites := make([]networkingv1alpha2.WebAnalyticsSiteStatus, 0, len(hostnames))
 	var errs []error
 
 	for _, hostname := range hostnames {
-		site, err := r.enableSite(ctx, project, apiClient, hostname)
+		site, err := r.enableSite(ctx, project, apiClient, hostname, sitesByHost[hostname])
 		if err != nil {
 			log.Error(err, "Failed to enable Web Analytics for hostname", "hostname", hostname)
 			errs = append(errs, fmt.Errorf("hostname %s: %w", hostname, err))
@@ -103,6 +143,20 @@ func (r *WebAnalyticsReconciler) enableAllSites(
 		sites = append(sites, *site)
 	}
 
+	// Remove the sites of hostnames the project no longer has. Sites that fail
+	// to be removed are kept in status so their removal is retried.
+	if project.Status.WebAnalytics != nil {
+		for _, site := range project.Status.WebAnalytics.Sites {
+			if slices.Contains(hostnames, site.Hostname) {
+				continue
+			}
+			if err := r.removeSite(ctx, project, apiClient, site); err != nil {
+				errs = append(errs, fmt.Errorf("remove site %s: %w", site.Hostname, err))
+				sites = append(sites, site)
+			}
+		}
+	}
+
 	// Update status with all sites
 	if updateErr := r.updateMultiSiteStatus(ctx, project, sites); updateErr != nil {
 		errs = append(errs, fmt.Errorf("update status: %w", updateErr))
@@ -114,32 +168,22 @@ func (r *WebAnalyticsReconciler) enableAllSites(
 	return nil
 }
 
-// enableSite enables Web Analytics for a single hostname.
-//
-//nolint:revive // cognitive complexity acceptable for idempotent enable logic
+// enableSite enables Web Analytics for a single hostname. existingSite is the
+// site of the hostname in Cloudflare, or nil if there is none.
 func (r *WebAnalyticsReconciler) enableSite(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
-	apiClient *cf.API,
+	apiClient cf.CloudflareClient,
 	hostname string,
+	existingSite *cf.RUMSite,
 ) (*networkingv1alpha2.WebAnalyticsSiteStatus, error) {
 	log := r.Log.WithValues("hostname", hostname)
+	desiredAutoInstall := cf.WebAnalyticsAutoInstall(hostname)
 
-	// Check if site already exists
-	existingSite, err := apiClient.GetWebAnalyticsSite(ctx, hostname)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check existing site: %w", err)
-	}
-
-	// auto_install is only valid for custom domains with Cloudflare proxy.
-	// For *.pages.dev domains, auto_install must be false (error 10022 otherwise).
-	isPagesDevDomain := strings.HasSuffix(hostname, ".pages.dev")
-	desiredAutoInstall := !isPagesDevDomain
-
-	var site *cf.RUMSite
+	site := existingSite
+	var err error
 	if existingSite != nil {
 		log.V(1).Info("Web Analytics site already exists", "siteTag", existingSite.SiteTag)
-		site = existingSite
 
 		// Update auto_install only if needed and valid for this domain type
 		if existingSite.AutoInstall != desiredAutoInstall {
@@ -153,7 +197,7 @@ func (r *WebAnalyticsReconciler) enableSite(
 	} else {
 		// Create new Web Analytics site
 		log.Info("Enabling Web Analytics", "hostname", hostname, "autoInstall", desiredAutoInstall)
-		site, err = apiClient.EnableWebAnalytics(ctx, hostname)
+		site, err = apiClient.CreateWebAnalyticsSite(ctx, hostname, desiredAutoInstall)
 		if err != nil {
 			r.Recorder.Event(project, corev1.EventTypeWarning, "WebAnalyticsFailed",
 				fmt.Sprintf("Failed to enable Web Analytics for %s: %s", hostname, cf.SanitizeErrorMessage(err)))
@@ -172,13 +216,33 @@ func (r *WebAnalyticsReconciler) enableSite(
 	}, nil
 }
 
+// removeSite deletes the Web Analytics site of a hostname the project no longer has.
+func (r *WebAnalyticsReconciler) removeSite(
+	ctx context.Context,
+	project *networkingv1alpha2.PagesProject,
+	apiClient cf.CloudflareClient,
+	site networkingv1alpha2.WebAnalyticsSiteStatus,
+) error {
+	if site.SiteTag == "" {
+		return nil
+	}
+
+	r.Log.Info("Removing Web Analytics site", "hostname", site.Hostname, "siteTag", site.SiteTag)
+	if err := apiClient.DeleteWebAnalyticsSite(ctx, site.SiteTag); err != nil {
+		r.Recorder.Event(project, corev1.EventTypeWarning, "WebAnalyticsRemoveFailed",
+			fmt.Sprintf("Failed to remove Web Analytics for %s: %s", site.Hostname, cf.SanitizeErrorMessage(err)))
+		return err
+	}
+	r.Recorder.Event(project, corev1.EventTypeNormal, "WebAnalyticsRemoved",
+		fmt.Sprintf("Web Analytics removed for %s", site.Hostname))
+	return nil
+}
+
 // disableAllSites disables Web Analytics for the project.
-//
-//nolint:revive // cognitive complexity acceptable for multi-site disable with backward compatibility
 func (r *WebAnalyticsReconciler) disableAllSites(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
-	apiClient *cf.API,
+	apiClient cf.CloudflareClient,
 ) error {
 	log := r.Log
 
@@ -196,29 +260,11 @@ func (r *WebAnalyticsReconciler) disableAllSites(
 			continue
 		}
 		log.Info("Disabling Web Analytics", "hostname", site.Hostname, "siteTag", site.SiteTag)
-		if err := apiClient.DisableWebAnalytics(ctx, site.SiteTag); err != nil {
+		if err := apiClient.DeleteWebAnalyticsSite(ctx, site.SiteTag); err != nil {
 			errs = append(errs, fmt.Errorf("disable site %s: %w", site.Hostname, err))
 		}
 	}
 
-	// Also clean up legacy single site if present (backward compatibility)
-	if project.Status.WebAnalytics.SiteTag != "" {
-		// Check if it's not already in the Sites list
-		found := false
-		for _, site := range project.Status.WebAnalytics.Sites {
-			if site.SiteTag == project.Status.WebAnalytics.SiteTag {
-				found = true
-				break
-			}
-		}
-		if !found {
-			log.Info("Disabling legacy Web Analytics site", "siteTag", project.Status.WebAnalytics.SiteTag)
-			if err := apiClient.DisableWebAnalytics(ctx, project.Status.WebAnalytics.SiteTag); err != nil {
-				errs = append(errs, fmt.Errorf("disable legacy site: %w", err))
-			}
-		}
-	}
-
 	if len(errs) > 0 {
 		r.Recorder.Event(project, corev1.EventTypeWarning, "WebAnalyticsDisableFailed",
 			fmt.Sprintf("Failed to disable some Web Analytics sites: %v", errs))
@@ -243,7 +289,7 @@ func (r *WebAnalyticsReconciler) disableAllSites(
 func (r *WebAnalyticsReconciler) Cleanup(
 	ctx context.Context,
 	project *networkingv1alpha2.PagesProject,
-	apiClient *cf.API,
+	apiClient cf.CloudflareClient,
 ) error {
 	log := r.Log.WithValues("project", project.Name)
 
@@ -251,6 +297,7 @@ func (r *WebAnalyticsReconciler) Cleanup(
 		log.V(1).Info("No Web Analytics to clean up")
 		return nil
 	}
+	migrateLegacyStatus(project.Status.WebAnalytics)
 
 	var errs []error
 
@@ -260,31 +307,13 @@ func (r *WebAnalyticsReconciler) Cleanup(
 			continue
 		}
 		log.Info("Cleaning up Web Analytics site", "hostname", site.Hostname, "siteTag", site.SiteTag)
-		if err := apiClient.DisableWebAnalytics(ctx, site.SiteTag); err != nil {
+		if err := apiClient.DeleteWebAnalyticsSite(ctx, site.SiteTag); err != nil {
 			// Log but don't fail deletion
 			log.Error(err, "Failed to clean up Web Analytics site", "hostname", site.Hostname)
 			errs = append(errs, fmt.Errorf("cleanup site %s: %w", site.Hostname, err))
 		}
 	}
 
-	// Also clean up legacy single site if present (backward compatibility)
-	if project.Status.WebAnalytics.SiteTag != "" {
-		// Check if it's not already in the Sites list
-		found := false
-		for _, site := range project.Status.WebAnalytics.Sites {
-			if site.SiteTag == project.Status.WebAnalytics.SiteTag {
-				found = true
-				break
-			}
-		}
-		if !found {
-			log.Info("Cleaning up legacy Web Analytics site", "siteTag", project.Status.WebAnalytics.SiteTag)
-			if err := apiClient.DisableWebAnalytics(ctx, project.Status.WebAnalytics.SiteTag); err != nil {
-				log.Error(err, "Failed to clean up legacy Web Analytics site")
-			}
-		}
-	}
-
 	// Log errors but don't fail deletion
 	if len(errs) > 0 {
 		log.Error(errors.Join(errs...), "Some Web Analytics sites failed to clean up, continuing with deletion")
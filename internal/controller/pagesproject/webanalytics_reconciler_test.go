@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package pagesproject
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf/mock"
+)
+
+func newWebAnalyticsTestProject(domains ...string) *networkingv1alpha2.PagesProject {
+	return &networkingv1alpha2.PagesProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-site",
+			Namespace: "default",
+		},
+		Spec: networkingv1alpha2.PagesProjectSpec{
+			ProductionBranch: "main",
+		},
+		Status: networkingv1alpha2.PagesProjectStatus{
+			Subdomain: "my-site.pages.dev",
+			Domains:   domains,
+		},
+	}
+}
+
+func newWebAnalyticsTestReconciler(objs ...client.Object) (*WebAnalyticsReconciler, client.Client) {
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.PagesProject{}).
+		Build()
+	return NewWebAnalyticsReconciler(fakeClient, record.NewFakeRecorder(10), logr.Discard()), fakeClient
+}
+
+func getWebAnalyticsStatus(t *testing.T, c client.Client, project *networkingv1alpha2.PagesProject) *networkingv1alpha2.WebAnalyticsStatus {
+	t.Helper()
+	updated := &networkingv1alpha2.PagesProject{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(project), updated))
+	require.NotNil(t, updated.Status.WebAnalytics)
+	return updated.Status.WebAnalytics
+}
+
+func siteHostnames(sites []networkingv1alpha2.WebAnalyticsSiteStatus) []string {
+	hostnames := make([]string, 0, len(sites))
+	for _, site := range sites {
+		hostnames = append(hostnames, site.Hostname)
+	}
+	return hostnames
+}
+
+func TestWebAnalyticsReconciler_AddsSites(t *testing.T) {
+	project := newWebAnalyticsTestProject("www.example.com")
+	r, fakeClient := newWebAnalyticsTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().ListWebAnalyticsSites(gomock.Any()).Return([]cf.RUMSite{
+		{SiteTag: "tag-dev", SiteToken: "token-dev", Host: "my-site.pages.dev"},
+	}, nil)
+	api.EXPECT().CreateWebAnalyticsSite(gomock.Any(), "www.example.com", true).
+		Return(&cf.RUMSite{SiteTag: "tag-www", SiteToken: "token-www", Host: "www.example.com", AutoInstall: true}, nil)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, api))
+
+	status := getWebAnalyticsStatus(t, fakeClient, project)
+	assert.True(t, status.Enabled)
+	require.Len(t, status.Sites, 2)
+	assert.Equal(t, networkingv1alpha2.WebAnalyticsSiteStatus{
+		Hostname: "my-site.pages.dev", SiteTag: "tag-dev", SiteToken: "token-dev", Enabled: true,
+	}, status.Sites[0])
+	assert.Equal(t, networkingv1alpha2.WebAnalyticsSiteStatus{
+		Hostname: "www.example.com", SiteTag: "tag-www", SiteToken: "token-www", AutoInstall: true, Enabled: true,
+	}, status.Sites[1])
+	assert.Equal(t, "tag-dev", status.SiteTag, "deprecated fields mirror the first site")
+}
+
+func TestWebAnalyticsReconciler_RemovesUnreferencedSites(t *testing.T) {
+	project := newWebAnalyticsTestProject()
+	project.Status.WebAnalytics = &networkingv1alpha2.WebAnalyticsStatus{
+		Enabled: true,
+		Sites: []networkingv1alpha2.WebAnalyticsSiteStatus{
+			{Hostname: "my-site.pages.dev", SiteTag: "tag-dev", Enabled: true},
+			{Hostname: "old.example.com", SiteTag: "tag-old", AutoInstall: true, Enabled: true},
+		},
+	}
+	r, fakeClient := newWebAnalyticsTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().ListWebAnalyticsSites(gomock.Any()).Return([]cf.RUMSite{
+		{SiteTag: "tag-dev", Host: "my-site.pages.dev"},
+		{SiteTag: "tag-old", Host: "old.example.com", AutoInstall: true},
+	}, nil)
+	api.EXPECT().DeleteWebAnalyticsSite(gomock.Any(), "tag-old").Return(nil)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, api))
+
+	status := getWebAnalyticsStatus(t, fakeClient, project)
+	assert.Equal(t, []string{"my-site.pages.dev"}, siteHostnames(status.Sites))
+}
+
+func TestWebAnalyticsReconciler_KeepsSiteWhenRemovalFails(t *testing.T) {
+	project := newWebAnalyticsTestProject()
+	project.Status.WebAnalytics = &networkingv1alpha2.WebAnalyticsStatus{
+		Enabled: true,
+		Sites: []networkingv1alpha2.WebAnalyticsSiteStatus{
+			{Hostname: "my-site.pages.dev", SiteTag: "tag-dev", Enabled: true},
+			{Hostname: "old.example.com", SiteTag: "tag-old", Enabled: true},
+		},
+	}
+	r, fakeClient := newWebAnalyticsTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().ListWebAnalyticsSites(gomock.Any()).Return([]cf.RUMSite{
+		{SiteTag: "tag-dev", Host: "my-site.pages.dev"},
+	}, nil)
+	api.EXPECT().DeleteWebAnalyticsSite(gomock.Any(), "tag-old").Return(errors.New("service unavailable"))
+
+	require.Error(t, r.Reconcile(context.Background(), project, api))
+
+	status := getWebAnalyticsStatus(t, fakeClient, project)
+	assert.Equal(t, []string{"my-site.pages.dev", "old.example.com"}, siteHostnames(status.Sites),
+		"a site that failed to be removed is kept so removal is retried")
+}
+
+func TestWebAnalyticsReconciler_MigratesLegacyStatus(t *testing.T) {
+	project := newWebAnalyticsTestProject("www.example.com")
+	project.Status.WebAnalytics = &networkingv1alpha2.WebAnalyticsStatus{
+		Enabled:   true,
+		SiteTag:   "tag-legacy",
+		SiteToken: "token-legacy",
+		Hostname:  "legacy.example.com",
+	}
+	r, fakeClient := newWebAnalyticsTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().ListWebAnalyticsSites(gomock.Any()).Return([]cf.RUMSite{
+		{SiteTag: "tag-dev", Host: "my-site.pages.dev"},
+		{SiteTag: "tag-www", Host: "www.example.com", AutoInstall: true},
+		{SiteTag: "tag-legacy", Host: "legacy.example.com", AutoInstall: true},
+	}, nil)
+	api.EXPECT().DeleteWebAnalyticsSite(gomock.Any(), "tag-legacy").Return(nil)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, api))
+
+	status := getWebAnalyticsStatus(t, fakeClient, project)
+	assert.Equal(t, []string{"my-site.pages.dev", "www.example.com"}, siteHostnames(status.Sites))
+	assert.Equal(t, "tag-dev", status.SiteTag)
+	assert.Equal(t, "my-site.pages.dev", status.Hostname)
+}
+
+func TestWebAnalyticsReconciler_MigratesLegacyStatusOfCurrentHostname(t *testing.T) {
+	project := newWebAnalyticsTestProject()
+	project.Status.WebAnalytics = &networkingv1alpha2.WebAnalyticsStatus{
+		Enabled:   true,
+		SiteTag:   "tag-dev",
+		SiteToken: "token-dev",
+		Hostname:  "my-site.pages.dev",
+	}
+	r, fakeClient := newWebAnalyticsTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().ListWebAnalyticsSites(gomock.Any()).Return([]cf.RUMSite{
+		{SiteTag: "tag-dev", SiteToken: "token-dev", Host: "my-site.pages.dev"},
+	}, nil)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, api))
+
+	status := getWebAnalyticsStatus(t, fakeClient, project)
+	assert.Equal(t, []networkingv1alpha2.WebAnalyticsSiteStatus{
+		{Hostname: "my-site.pages.dev", SiteTag: "tag-dev", SiteToken: "token-dev", Enabled: true},
+	}, status.Sites)
+}
+
+func TestWebAnalyticsReconciler_DisableRemovesMigratedSites(t *testing.T) {
+	project := newWebAnalyticsTestProject()
+	project.Spec.EnableWebAnalytics = new(bool)
+	project.Status.WebAnalytics = &networkingv1alpha2.WebAnalyticsStatus{
+		Enabled:  true,
+		SiteTag:  "tag-legacy",
+		Hostname: "my-site.pages.dev",
+	}
+	r, fakeClient := newWebAnalyticsTestReconciler(project)
+
+	ctrl := gomock.NewController(t)
+	api := mock.NewMockCloudflareClient(ctrl)
+	api.EXPECT().DeleteWebAnalyticsSite(gomock.Any(), "tag-legacy").Return(nil)
+
+	require.NoError(t, r.Reconcile(context.Background(), project, api))
+
+	status := getWebAnalyticsStatus(t, fakeClient, project)
+	assert.False(t, status.Enabled)
+	assert.Empty(t, status.Sites)
+}
@@ -426,7 +426,7 @@ func (r *PagesPromotionReconciler) setWaitingStatus(
 	logger.Info(message)
 	r.Recorder.Event(promotion, corev1.EventTypeNormal, EventReasonWaiting, message)
 
-	return ctrl.Result{RequeueAfter: WaitingInterval}, nil
+	return common.RequeueAfterOrDeadline(ctx, WaitingInterval), nil
 }
 
 // setPromotingStatus updates the promotion status to promoting.
@@ -623,7 +623,7 @@ func (r *PagesPromotionReconciler) findPromotionsForProject(ctx context.Context,
 }
 
 func (r *PagesPromotionReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.Recorder = mgr.GetEventRecorderFor("pagespromotion-controller")
+	r.Recorder = common.NewDedupRecorder(mgr.GetEventRecorderFor("pagespromotion-controller"), common.DefaultEventDedupWindow)
 	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("pagespromotion"))
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -632,5 +632,5 @@ func (r *PagesPromotionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findPromotionsForDeployment)).
 		Watches(&networkingv1alpha2.PagesProject{},
 			handler.EnqueueRequestsFromMapFunc(r.findPromotionsForProject)).
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.PagesPromotion{}, r))
 }
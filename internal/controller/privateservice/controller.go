@@ -579,5 +579,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findPrivateServicesForService),
 		).
 		Named("privateservice").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.PrivateService{}, r))
 }
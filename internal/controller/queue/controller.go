@@ -0,0 +1,609 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package queue provides a controller for managing Cloudflare Queues and their consumers.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	finalizerName = "cloudflare.com/queue-finalizer"
+)
+
+// Reconciler reconciles a Queue object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=queues,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=queues/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=queues/finalizers,verbs=update
+
+// Reconcile handles Queue reconciliation
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Get the Queue resource
+	queue := &networkingv1alpha2.Queue{}
+	if err := r.Get(ctx, req.NamespacedName, queue); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch Queue")
+		return common.NoRequeue(), err
+	}
+
+	// Handle deletion
+	if !queue.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, queue)
+	}
+
+	// Ensure finalizer
+	if added, err := controller.EnsureFinalizer(ctx, r.Client, queue, finalizerName); err != nil {
+		return common.NoRequeue(), err
+	} else if added {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := validateConsumers(queue.Spec.Consumers); err != nil {
+		return r.updateStatusInvalid(ctx, queue, err)
+	}
+
+	// Get API client
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CredentialsRef: queue.Spec.CredentialsRef,
+		Namespace:      queue.Namespace,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, queue, err)
+	}
+
+	// Sync queue to Cloudflare
+	return r.syncQueue(ctx, queue, apiResult)
+}
+
+// handleDeletion handles the deletion of Queue.
+func (r *Reconciler) handleDeletion(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(queue, finalizerName) {
+		return common.NoRequeue(), nil
+	}
+
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, queue, queue.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CredentialsRef: queue.Spec.CredentialsRef,
+			Namespace:      queue.Namespace,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if queue.Status.QueueID != "" {
+			// Delete queue from Cloudflare. Its consumers are deleted with it.
+			logger.Info("Deleting queue from Cloudflare",
+				"queueName", queue.Status.QueueName,
+				"queueId", queue.Status.QueueID)
+
+			if err := apiResult.API.DeleteQueue(ctx, queue.Status.QueueID); err != nil {
+				logger.Error(err, "Failed to delete queue from Cloudflare")
+				if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, queue, queue.Spec.DeletionTimeout, err); retry {
+					return result, nil
+				}
+			} else {
+				r.Recorder.Event(queue, corev1.EventTypeNormal, "Deleted",
+					"Queue deleted from Cloudflare")
+			}
+		}
+	}
+
+	// Remove finalizer
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, queue, func() {
+		controllerutil.RemoveFinalizer(queue, finalizerName)
+	}); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return common.NoRequeue(), err
+	}
+	r.Recorder.Event(queue, corev1.EventTypeNormal, controller.EventReasonFinalizerRemoved, "Finalizer removed")
+
+	return common.NoRequeue(), nil
+}
+
+// syncQueue syncs the queue and its consumers to Cloudflare.
+func (r *Reconciler) syncQueue(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	apiResult *common.APIClientResult,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	queueName := queueName(queue)
+	existing, err := r.findQueue(ctx, queue, apiResult.API, queueName)
+	if err != nil {
+		logger.Error(err, "Failed to get queue from Cloudflare")
+		return r.updateStatusError(ctx, queue, err)
+	}
+
+	params := cf.QueueParams{Name: queueName, Settings: queueSettings(queue.Spec.Settings)}
+	if existing == nil {
+		existing, err = r.adoptOrCreateQueue(ctx, queue, apiResult.API, params)
+		if err != nil {
+			logger.Error(err, "Failed to adopt or create queue", "queueName", queueName)
+			return r.updateStatusError(ctx, queue, err)
+		}
+	}
+
+	if !settingsMatch(params.Settings, existing.Settings) {
+		logger.Info("Updating queue settings in Cloudflare", "queueName", queueName)
+		updated, err := apiResult.API.UpdateQueue(ctx, existing.ID, params)
+		if err != nil {
+			logger.Error(err, "Failed to update queue")
+			return r.updateStatusError(ctx, queue, err)
+		}
+		existing = updated
+		r.Recorder.Event(queue, corev1.EventTypeNormal, "Updated",
+			fmt.Sprintf("Queue '%s' settings updated in Cloudflare", queueName))
+	}
+
+	consumers, err := r.syncConsumers(ctx, queue, apiResult.API, existing.ID)
+	if err != nil {
+		logger.Error(err, "Failed to sync queue consumers")
+		return r.updateStatusError(ctx, queue, err)
+	}
+
+	return r.updateStatus(ctx, queue, existing, consumers)
+}
+
+// findQueue returns the queue with the ID in status, or nil if it was deleted
+// outside the operator or spec.name changed.
+func (r *Reconciler) findQueue(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	api *cf.API,
+	queueName string,
+) (*cf.Queue, error) {
+	if queue.Status.QueueID == "" {
+		return nil, nil
+	}
+	existing, err := api.GetQueue(ctx, queue.Status.QueueID)
+	if err != nil {
+		if cf.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if existing.Name != queueName {
+		return nil, nil
+	}
+	return existing, nil
+}
+
+// adoptOrCreateQueue returns the queue named in params. A queue with the name is
+// only taken over as allowed by the adoption policy, otherwise the queue is created.
+func (r *Reconciler) adoptOrCreateQueue(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	api *cf.API,
+	params cf.QueueParams,
+) (*cf.Queue, error) {
+	getQueue := func(ctx context.Context) (*cf.Queue, error) {
+		return api.GetQueueByName(ctx, params.Name)
+	}
+	createQueue := func(ctx context.Context) (*cf.Queue, error) {
+		log.FromContext(ctx).Info("Creating queue in Cloudflare", "queueName", params.Name)
+		return api.CreateQueue(ctx, params)
+	}
+
+	adoption, err := common.Adopt(ctx, queue.Spec.AdoptionPolicy, getQueue, createQueue)
+	if err != nil {
+		return nil, err
+	}
+	if adoption.Adopted {
+		r.Recorder.Event(queue, corev1.EventTypeNormal, "Adopted",
+			fmt.Sprintf("Adopted existing queue '%s'", params.Name))
+	} else {
+		r.Recorder.Event(queue, corev1.EventTypeNormal, "Created",
+			fmt.Sprintf("Queue '%s' created in Cloudflare", params.Name))
+	}
+	return adoption.Resource, nil
+}
+
+// syncConsumers creates and updates the consumers of the spec, and deletes the
+// consumers the operator created that were removed from the spec. Consumers
+// are matched by type and script name. It returns the status of the consumers
+// of the spec; a consumer that failed to sync is not ready.
+func (r *Reconciler) syncConsumers(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	api *cf.API,
+	queueID string,
+) ([]networkingv1alpha2.QueueConsumerStatus, error) {
+	logger := log.FromContext(ctx)
+
+	actual, err := api.ListQueueConsumers(ctx, queueID)
+	if err != nil {
+		return nil, err
+	}
+	actualByKey := make(map[string]cf.QueueConsumer, len(actual))
+	for _, consumer := range actual {
+		actualByKey[cfConsumerKey(consumer)] = consumer
+	}
+
+	desiredKeys := make(map[string]bool, len(queue.Spec.Consumers))
+	statuses := make([]networkingv1alpha2.QueueConsumerStatus, 0, len(queue.Spec.Consumers))
+	for i := range queue.Spec.Consumers {
+		desired := toCFConsumer(&queue.Spec.Consumers[i])
+		key := cfConsumerKey(desired)
+		desiredKeys[key] = true
+
+		status := networkingv1alpha2.QueueConsumerStatus{
+			Type:       networkingv1alpha2.QueueConsumerType(desired.Type),
+			ScriptName: desired.ScriptName,
+		}
+		current, exists := actualByKey[key]
+		switch {
+		case !exists:
+			logger.Info("Creating queue consumer", "type", desired.Type, "scriptName", desired.ScriptName)
+			created, createErr := api.CreateQueueConsumer(ctx, queueID, desired)
+			if createErr != nil {
+				status.Message = cf.SanitizeErrorMessage(createErr)
+				break
+			}
+			current = *created
+			r.Recorder.Event(queue, corev1.EventTypeNormal, "ConsumerCreated",
+				fmt.Sprintf("Queue consumer '%s' created", consumerName(desired)))
+		case !consumerMatches(desired, current):
+			logger.Info("Updating queue consumer", "type", desired.Type, "scriptName", desired.ScriptName)
+			desired.ID = current.ID
+			updated, updateErr := api.UpdateQueueConsumer(ctx, queueID, desired)
+			if updateErr != nil {
+				status.ConsumerID = current.ID
+				status.Message = cf.SanitizeErrorMessage(updateErr)
+				break
+			}
+			current = *updated
+			r.Recorder.Event(queue, corev1.EventTypeNormal, "ConsumerUpdated",
+				fmt.Sprintf("Queue consumer '%s' updated", consumerName(desired)))
+		}
+		if status.Message == "" {
+			status.ConsumerID = current.ID
+			status.Ready = true
+		}
+		statuses = append(statuses, status)
+	}
+
+	// Delete the consumers created by the operator that were removed from the spec
+	var errs []error
+	for _, previous := range queue.Status.Consumers {
+		key := consumerKey(string(previous.Type), previous.ScriptName)
+		current, exists := actualByKey[key]
+		if desiredKeys[key] || !exists || current.ID != previous.ConsumerID {
+			continue
+		}
+		logger.Info("Deleting queue consumer", "type", previous.Type, "scriptName", previous.ScriptName)
+		if err := api.DeleteQueueConsumer(ctx, queueID, previous.ConsumerID); err != nil {
+			errs = append(errs, err)
+			// Keep tracking the consumer so its deletion is retried
+			statuses = append(statuses, networkingv1alpha2.QueueConsumerStatus{
+				ConsumerID: previous.ConsumerID,
+				Type:       previous.Type,
+				ScriptName: previous.ScriptName,
+				Message:    "removed from spec, deletion failed: " + cf.SanitizeErrorMessage(err),
+			})
+			continue
+		}
+		r.Recorder.Event(queue, corev1.EventTypeNormal, "ConsumerDeleted",
+			fmt.Sprintf("Queue consumer '%s' deleted", consumerName(current)))
+	}
+
+	return statuses, errors.Join(errs...)
+}
+
+// validateConsumers checks that every worker consumer names a script and that
+// no two consumers have the same type and script name.
+func validateConsumers(consumers []networkingv1alpha2.QueueConsumer) error {
+	seen := make(map[string]bool, len(consumers))
+	for i := range consumers {
+		consumer := toCFConsumer(&consumers[i])
+		if consumer.Type == cf.QueueConsumerTypeWorker && consumer.ScriptName == "" {
+			return fmt.Errorf("spec.consumers[%d]: worker consumers require scriptName", i)
+		}
+		key := cfConsumerKey(consumer)
+		if seen[key] {
+			return fmt.Errorf("spec.consumers[%d]: duplicate consumer '%s'", i, consumerName(consumer))
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func queueName(queue *networkingv1alpha2.Queue) string {
+	if queue.Spec.Name != "" {
+		return queue.Spec.Name
+	}
+	return queue.Name
+}
+
+func queueSettings(settings *networkingv1alpha2.QueueSettings) *cf.QueueSettings {
+	if settings == nil {
+		return nil
+	}
+	return &cf.QueueSettings{
+		DeliveryDelay:          settings.DeliveryDelay,
+		MessageRetentionPeriod: settings.MessageRetentionPeriod,
+	}
+}
+
+// settingsMatch reports whether the actual settings have the desired values.
+// Settings that are not desired keep the value Cloudflare defaulted them to.
+func settingsMatch(desired, actual *cf.QueueSettings) bool {
+	if desired == nil {
+		return true
+	}
+	if actual == nil {
+		actual = &cf.QueueSettings{}
+	}
+	return intMatches(desired.DeliveryDelay, actual.DeliveryDelay) &&
+		intMatches(desired.MessageRetentionPeriod, actual.MessageRetentionPeriod)
+}
+
+func toCFConsumer(consumer *networkingv1alpha2.QueueConsumer) cf.QueueConsumer {
+	result := cf.QueueConsumer{
+		Type:            string(consumer.Type),
+		ScriptName:      consumer.ScriptName,
+		DeadLetterQueue: consumer.DeadLetterQueue,
+	}
+	if result.Type == "" {
+		result.Type = cf.QueueConsumerTypeWorker
+	}
+	if s := consumer.Settings; s != nil {
+		result.Settings = &cf.QueueConsumerSettings{
+			BatchSize:           s.BatchSize,
+			MaxRetries:          s.MaxRetries,
+			MaxWaitTimeMs:       s.MaxWaitTimeMs,
+			MaxConcurrency:      s.MaxConcurrency,
+			VisibilityTimeoutMs: s.VisibilityTimeoutMs,
+			RetryDelay:          s.RetryDelay,
+		}
+	}
+	return result
+}
+
+// consumerMatches reports whether the actual consumer has the desired dead
+// letter queue and settings. Settings that are not desired are not compared.
+func consumerMatches(desired, actual cf.QueueConsumer) bool {
+	if desired.DeadLetterQueue != actual.DeadLetterQueue {
+		return false
+	}
+	if desired.Settings == nil {
+		return true
+	}
+	a := actual.Settings
+	if a == nil {
+		a = &cf.QueueConsumerSettings{}
+	}
+	d := desired.Settings
+	return intMatches(d.BatchSize, a.BatchSize) &&
+		intMatches(d.MaxRetries, a.MaxRetries) &&
+		intMatches(d.MaxWaitTimeMs, a.MaxWaitTimeMs) &&
+		intMatches(d.MaxConcurrency, a.MaxConcurrency) &&
+		intMatches(d.VisibilityTimeoutMs, a.VisibilityTimeoutMs) &&
+		intMatches(d.RetryDelay, a.RetryDelay)
+}
+
+// intMatches reports whether actual has the desired value; a nil desired value matches anything.
+func intMatches(desired, actual *int) bool {
+	return desired == nil || (actual != nil && *desired == *actual)
+}
+
+func consumerKey(consumerType, scriptName string) string {
+	if consumerType == cf.QueueConsumerTypeHTTPPull {
+		return consumerType
+	}
+	return consumerType + "/" + scriptName
+}
+
+func cfConsumerKey(consumer cf.QueueConsumer) string {
+	return consumerKey(consumer.Type, consumer.ScriptName)
+}
+
+func consumerName(consumer cf.QueueConsumer) string {
+	if consumer.Type == cf.QueueConsumerTypeHTTPPull {
+		return consumer.Type
+	}
+	return consumer.ScriptName
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	err error,
+) (ctrl.Result, error) {
+	reason := "Error"
+	var adoptionErr *common.AdoptionError
+	if errors.As(err, &adoptionErr) {
+		reason = common.ReasonAdoptionFailed
+		r.Recorder.Event(queue, corev1.EventTypeWarning, common.ReasonAdoptionFailed, adoptionErr.Error())
+	}
+
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, queue, func() {
+		queue.Status.State = networkingv1alpha2.QueueStateError
+		queue.Status.Message = cf.SanitizeErrorMessage(err)
+		meta.SetStatusCondition(&queue.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: queue.Generation,
+			Reason:             reason,
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		queue.Status.ObservedGeneration = queue.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+// updateStatusInvalid records an invalid spec. It is not requeued, as only a
+// change of the spec can fix it.
+func (r *Reconciler) updateStatusInvalid(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(queue, corev1.EventTypeWarning, "InvalidSpec", err.Error())
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, queue, func() {
+		queue.Status.State = networkingv1alpha2.QueueStateError
+		queue.Status.Message = err.Error()
+		meta.SetStatusCondition(&queue.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: queue.Generation,
+			Reason:             "InvalidSpec",
+			Message:            err.Error(),
+			LastTransitionTime: metav1.Now(),
+		})
+		queue.Status.ObservedGeneration = queue.Generation
+	})
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+	return common.NoRequeue(), nil
+}
+
+// updateStatus records the synced queue and its consumers. The queue is ready
+// once all consumers are.
+func (r *Reconciler) updateStatus(
+	ctx context.Context,
+	queue *networkingv1alpha2.Queue,
+	result *cf.Queue,
+	consumers []networkingv1alpha2.QueueConsumerStatus,
+) (ctrl.Result, error) {
+	ready := 0
+	var failed []string
+	for _, consumer := range consumers {
+		if consumer.Ready {
+			ready++
+		} else {
+			failed = append(failed, fmt.Sprintf("consumer %s: %s",
+				consumerName(cf.QueueConsumer{Type: string(consumer.Type), ScriptName: consumer.ScriptName}),
+				consumer.Message))
+		}
+	}
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, queue, func() {
+		queue.Status.QueueID = result.ID
+		queue.Status.QueueName = result.Name
+		queue.Status.Consumers = consumers
+		queue.Status.ConsumerCount = ready
+		condition := metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: queue.Generation,
+			Reason:             "Synced",
+			Message:            "Queue synced to Cloudflare",
+			LastTransitionTime: metav1.Now(),
+		}
+		queue.Status.State = networkingv1alpha2.QueueStateReady
+		queue.Status.Message = ""
+		if len(failed) > 0 {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "ConsumerError"
+			condition.Message = failed[0]
+			queue.Status.State = networkingv1alpha2.QueueStateError
+			queue.Status.Message = failed[0]
+		}
+		meta.SetStatusCondition(&queue.Status.Conditions, condition)
+		queue.Status.ObservedGeneration = queue.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	if len(failed) > 0 {
+		return common.RequeueShort(), nil
+	}
+	return common.NoRequeue(), nil
+}
+
+// findQueuesForCredentials returns Queues that reference the given credentials
+func (r *Reconciler) findQueuesForCredentials(ctx context.Context, obj client.Object) []reconcile.Request {
+	creds, ok := obj.(*networkingv1alpha2.CloudflareCredentials)
+	if !ok {
+		return nil
+	}
+
+	queueList := &networkingv1alpha2.QueueList{}
+	if err := r.List(ctx, queueList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, queue := range queueList.Items {
+		if (queue.Spec.CredentialsRef != nil && queue.Spec.CredentialsRef.Name == creds.Name) ||
+			(creds.Spec.IsDefault && queue.Spec.CredentialsRef == nil) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      queue.Name,
+					Namespace: queue.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("queue-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("queue"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.Queue{}).
+		Watches(&networkingv1alpha2.CloudflareCredentials{},
+			handler.EnqueueRequestsFromMapFunc(r.findQueuesForCredentials)).
+		Named("queue").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.Queue{}, r))
+}
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package queue
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.Queue{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newQueue(spec networkingv1alpha2.QueueSpec) *networkingv1alpha2.Queue {
+	return &networkingv1alpha2.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "events",
+			Namespace:  "default",
+			Finalizers: []string{finalizerName},
+		},
+		Spec: spec,
+	}
+}
+
+// reconcileQueue reconciles the "events" queue and returns the result and the updated queue.
+func reconcileQueue(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.Queue) {
+	t.Helper()
+	key := types.NamespacedName{Name: "events", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	queue := &networkingv1alpha2.Queue{}
+	require.NoError(t, c.Get(context.Background(), key, queue))
+	return result, queue
+}
+
+func TestReconcile_CreatesQueueWithConsumers(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{
+		Settings: &networkingv1alpha2.QueueSettings{DeliveryDelay: ptr.To(10)},
+		Consumers: []networkingv1alpha2.QueueConsumer{
+			{ScriptName: "processor", Settings: &networkingv1alpha2.QueueConsumerSettings{BatchSize: ptr.To(25)}},
+			{Type: networkingv1alpha2.QueueConsumerTypeHTTPPull},
+		},
+	}))
+
+	result, queue := reconcileQueue(t, r, c)
+
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.QueueStateReady, queue.Status.State)
+	assert.Equal(t, "events", queue.Status.QueueName)
+	require.NotEmpty(t, queue.Status.QueueID)
+	assert.Equal(t, 2, queue.Status.ConsumerCount)
+	require.Len(t, queue.Status.Consumers, 2)
+	assert.Equal(t, "processor", queue.Status.Consumers[0].ScriptName)
+	assert.True(t, queue.Status.Consumers[0].Ready)
+	assert.Equal(t, networkingv1alpha2.QueueConsumerTypeHTTPPull, queue.Status.Consumers[1].Type)
+	assert.True(t, meta.IsStatusConditionTrue(queue.Status.Conditions, "Ready"))
+
+	cfQueue, ok := mock.Store().GetQueue(queue.Status.QueueID)
+	require.True(t, ok)
+	assert.Equal(t, ptr.To(10), cfQueue.Settings.DeliveryDelay)
+	require.Len(t, cfQueue.Consumers, 2)
+	assert.Equal(t, ptr.To(25), cfQueue.Consumers[0].Settings.BatchSize)
+
+	// A second reconcile changes nothing
+	_, again := reconcileQueue(t, r, c)
+	assert.Equal(t, queue.Status.QueueID, again.Status.QueueID)
+	assert.Equal(t, queue.Status.Consumers, again.Status.Consumers)
+}
+
+func TestReconcile_UpdatesSettingsAndConsumers(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{
+		Consumers: []networkingv1alpha2.QueueConsumer{
+			{ScriptName: "processor"},
+			{ScriptName: "auditor"},
+		},
+	}))
+	_, queue := reconcileQueue(t, r, c)
+	require.Equal(t, 2, queue.Status.ConsumerCount)
+	processorID := queue.Status.Consumers[0].ConsumerID
+
+	queue.Spec.Settings = &networkingv1alpha2.QueueSettings{MessageRetentionPeriod: ptr.To(3600)}
+	queue.Spec.Consumers = []networkingv1alpha2.QueueConsumer{
+		{ScriptName: "processor", DeadLetterQueue: "events-dlq"},
+	}
+	require.NoError(t, c.Update(context.Background(), queue))
+	_, queue = reconcileQueue(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.QueueStateReady, queue.Status.State)
+	require.Len(t, queue.Status.Consumers, 1)
+	assert.Equal(t, processorID, queue.Status.Consumers[0].ConsumerID, "the consumer is updated in place")
+
+	cfQueue, ok := mock.Store().GetQueue(queue.Status.QueueID)
+	require.True(t, ok)
+	assert.Equal(t, ptr.To(3600), cfQueue.Settings.MessageRetentionPeriod)
+	require.Len(t, cfQueue.Consumers, 1, "the removed consumer is deleted")
+	assert.Equal(t, "events-dlq", cfQueue.Consumers[0].DeadLetterQueue)
+}
+
+func TestReconcile_KeepsUnmanagedConsumers(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateQueue(&models.Queue{
+		ID:        "queue-1",
+		Name:      "events",
+		CreatedOn: time.Now(),
+		Consumers: []models.QueueConsumer{{ID: "consumer-1", Type: "worker", Script: "legacy"}},
+	})
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{AdoptionPolicy: common.AdoptionPolicyIfExists}))
+
+	_, queue := reconcileQueue(t, r, c)
+
+	assert.Equal(t, "queue-1", queue.Status.QueueID, "the existing queue is adopted")
+	assert.Empty(t, queue.Status.Consumers)
+	cfQueue, _ := mock.Store().GetQueue("queue-1")
+	assert.Len(t, cfQueue.Consumers, 1)
+}
+
+func TestReconcile_ExistingQueueIsNotAdoptedByDefault(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateQueue(&models.Queue{ID: "queue-1", Name: "events", CreatedOn: time.Now()})
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{}))
+
+	_, queue := reconcileQueue(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.QueueStateError, queue.Status.State)
+	assert.Empty(t, queue.Status.QueueID)
+	cond := meta.FindStatusCondition(queue.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, common.ReasonAdoptionFailed, cond.Reason)
+}
+
+func TestReconcile_RecreatesDeletedQueue(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{}))
+	_, queue := reconcileQueue(t, r, c)
+	oldID := queue.Status.QueueID
+	require.True(t, mock.Store().DeleteQueue(oldID))
+
+	_, queue = reconcileQueue(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.QueueStateReady, queue.Status.State)
+	assert.NotEqual(t, oldID, queue.Status.QueueID)
+	_, ok := mock.Store().GetQueueByName("events")
+	assert.True(t, ok)
+}
+
+func TestReconcile_InvalidConsumers(t *testing.T) {
+	tests := []struct {
+		name      string
+		consumers []networkingv1alpha2.QueueConsumer
+		message   string
+	}{
+		{
+			name:      "worker without script",
+			consumers: []networkingv1alpha2.QueueConsumer{{Type: networkingv1alpha2.QueueConsumerTypeWorker}},
+			message:   "spec.consumers[0]: worker consumers require scriptName",
+		},
+		{
+			name:      "duplicate consumer",
+			consumers: []networkingv1alpha2.QueueConsumer{{ScriptName: "processor"}, {ScriptName: "processor"}},
+			message:   "spec.consumers[1]: duplicate consumer 'processor'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockServer(t)
+			r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{Consumers: tt.consumers}))
+
+			result, queue := reconcileQueue(t, r, c)
+
+			assert.Zero(t, result.RequeueAfter)
+			assert.Equal(t, networkingv1alpha2.QueueStateError, queue.Status.State)
+			assert.Equal(t, tt.message, queue.Status.Message)
+			assert.Empty(t, mock.Store().ListQueues())
+		})
+	}
+}
+
+func TestReconcile_DeleteQueue(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{}))
+	_, queue := reconcileQueue(t, r, c)
+	require.NotEmpty(t, queue.Status.QueueID)
+
+	require.NoError(t, c.Delete(context.Background(), queue))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "events", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, mock.Store().ListQueues())
+	err = c.Get(context.Background(), types.NamespacedName{Name: "events", Namespace: "default"}, queue)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
+
+func TestReconcile_DeleteAlreadyDeletedQueue(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newQueue(networkingv1alpha2.QueueSpec{}))
+	_, queue := reconcileQueue(t, r, c)
+	require.True(t, mock.Store().DeleteQueue(queue.Status.QueueID))
+
+	require.NoError(t, c.Delete(context.Background(), queue))
+	_, err := r.Reconcile(context.Background(), ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: "events", Namespace: "default"},
+	})
+	require.NoError(t, err)
+
+	err = c.Get(context.Background(), types.NamespacedName{Name: "events", Namespace: "default"}, queue)
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed")
+}
@@ -98,7 +98,7 @@ func (r *Reconciler) handleDeletion(
 	}
 
 	// Check deletion policy
-	if bucket.Spec.DeletionPolicy == "Orphan" {
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, bucket, bucket.Spec.DeletionPolicy) {
 		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
 	} else {
 		// Get API client
@@ -116,10 +116,10 @@ func (r *Reconciler) handleDeletion(
 
 			if err := apiResult.API.DeleteR2Bucket(ctx, bucket.Status.BucketName); err != nil {
 				if !cf.IsNotFoundError(err) {
-					logger.Error(err, "Failed to delete R2 bucket from Cloudflare, continuing with finalizer removal")
-					r.Recorder.Event(bucket, corev1.EventTypeWarning, "DeleteFailed",
-						fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-					// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+					logger.Error(err, "Failed to delete R2 bucket from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, bucket, bucket.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
 				} else {
 					logger.Info("R2 bucket not found in Cloudflare, may have been already deleted")
 				}
@@ -312,5 +312,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findBucketsForCredentials)).
 		Named("r2bucket").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.R2Bucket{}, r))
 }
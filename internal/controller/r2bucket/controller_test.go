@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package r2bucket
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.R2Bucket{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+// newDeletingBucket returns an R2Bucket synced to the "assets" bucket whose deletion has started.
+func newDeletingBucket(mock *mockserver.Server, policy string) *networkingv1alpha2.R2Bucket {
+	mock.Store().CreateR2Bucket(&models.R2Bucket{Name: "assets", CreationDate: time.Now()})
+	return &networkingv1alpha2.R2Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "assets",
+			Namespace:         "default",
+			Finalizers:        []string{finalizerName},
+			DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		},
+		Spec:   networkingv1alpha2.R2BucketSpec{Name: "assets", DeletionPolicy: policy},
+		Status: networkingv1alpha2.R2BucketStatus{BucketName: "assets", State: "Ready"},
+	}
+}
+
+func reconcileDeletion(t *testing.T, r *Reconciler, c client.Client) string {
+	t.Helper()
+	key := types.NamespacedName{Name: "assets", Namespace: "default"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	err = c.Get(context.Background(), key, &networkingv1alpha2.R2Bucket{})
+	assert.True(t, apierrors.IsNotFound(err), "finalizer should be removed, got %v", err)
+	return strings.Join(drainEvents(r.Recorder.(*record.FakeRecorder)), "\n")
+}
+
+func TestReconcile_DeletePolicyDeletesBucket(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDeletingBucket(mock, common.DeletionPolicyDelete))
+
+	events := reconcileDeletion(t, r, c)
+
+	_, exists := mock.Store().GetR2Bucket("assets")
+	assert.False(t, exists)
+	assert.Contains(t, events, "Deletion policy is Delete")
+}
+
+func TestReconcile_OrphanPolicyKeepsBucket(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDeletingBucket(mock, common.DeletionPolicyOrphan))
+
+	events := reconcileDeletion(t, r, c)
+
+	_, exists := mock.Store().GetR2Bucket("assets")
+	assert.True(t, exists)
+	assert.Contains(t, events, "Deletion policy is Orphan")
+	assert.Zero(t, mock.GetRequestCount("/client/v4/accounts/test-account-id/r2/buckets"))
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
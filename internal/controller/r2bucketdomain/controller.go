@@ -8,6 +8,7 @@ package r2bucketdomain
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +32,11 @@ import (
 
 const (
 	finalizerName = "cloudflare.com/r2-bucket-domain-finalizer"
+
+	// ConditionTypeOwnershipVerified is True once Cloudflare verified ownership of the domain.
+	ConditionTypeOwnershipVerified = "OwnershipVerified"
+	// ConditionTypeCertificateIssued is True once the SSL certificate of the domain is active.
+	ConditionTypeCertificateIssued = "CertificateIssued"
 )
 
 // Reconciler reconciles an R2BucketDomain object.
@@ -97,32 +103,37 @@ func (r *Reconciler) handleDeletion(
 		return common.NoRequeue(), nil
 	}
 
-	// Get API client and delete from Cloudflare
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CredentialsRef: domain.Spec.CredentialsRef,
-		Namespace:      domain.Namespace,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if domain.Spec.Domain != "" && domain.Spec.BucketName != "" {
-		// Delete custom domain from Cloudflare
-		logger.Info("Deleting R2 custom domain from Cloudflare",
-			"bucketName", domain.Spec.BucketName,
-			"domain", domain.Spec.Domain)
-
-		if err := apiResult.API.DeleteR2CustomDomain(ctx, domain.Spec.BucketName, domain.Spec.Domain); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete R2 custom domain from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(domain, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, domain, domain.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client and delete from Cloudflare
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CredentialsRef: domain.Spec.CredentialsRef,
+			Namespace:      domain.Namespace,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if domain.Spec.Domain != "" && domain.Spec.BucketName != "" {
+			// Delete custom domain from Cloudflare
+			logger.Info("Deleting R2 custom domain from Cloudflare",
+				"bucketName", domain.Spec.BucketName,
+				"domain", domain.Spec.Domain)
+
+			if err := apiResult.API.DeleteR2CustomDomain(ctx, domain.Spec.BucketName, domain.Spec.Domain); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete R2 custom domain from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, domain, domain.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("R2 custom domain not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("R2 custom domain not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(domain, corev1.EventTypeNormal, "Deleted",
+					"R2 custom domain deleted from Cloudflare")
 			}
-		} else {
-			r.Recorder.Event(domain, corev1.EventTypeNormal, "Deleted",
-				"R2 custom domain deleted from Cloudflare")
 		}
 	}
 
@@ -171,7 +182,7 @@ func (r *Reconciler) syncDomain(
 	if existing != nil {
 		// Domain exists, check if update is needed
 		needsUpdate := existing.MinTLS != string(domain.Spec.MinTLS) ||
-			existing.ZoneID != domain.Spec.ZoneID ||
+			(domain.Spec.ZoneID != "" && existing.ZoneID != domain.Spec.ZoneID) ||
 			!existing.Enabled
 
 		if needsUpdate {
@@ -268,40 +279,37 @@ func (r *Reconciler) updateStatusFromResult(
 	_ string, // accountID - not stored in status
 	result *cf.R2CustomDomain,
 ) (ctrl.Result, error) {
-	// Check if domain is still pending
-	isPending := result.Status.SSL == "pending" || result.Status.Ownership == "pending"
+	status := result.Status
+	ready := status.OwnershipVerified() && status.CertificateIssued()
 
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, domain, func() {
 		domain.Status.DomainID = result.Domain // Use domain name as ID
 		domain.Status.ZoneID = result.ZoneID
+		domain.Status.ZoneName = result.ZoneName
 		domain.Status.Enabled = result.Enabled
 		domain.Status.MinTLS = result.MinTLS
 		domain.Status.PublicAccessEnabled = domain.Spec.EnablePublicAccess
 		domain.Status.URL = fmt.Sprintf("https://%s", result.Domain)
+		domain.Status.OwnershipStatus = status.Ownership
+		domain.Status.SSLStatus = status.SSL
 
-		if isPending {
-			domain.Status.State = networkingv1alpha2.R2BucketDomainStatePending
-			domain.Status.Message = fmt.Sprintf("Domain is pending verification (SSL: %s, Ownership: %s)",
-				result.Status.SSL, result.Status.Ownership)
-			meta.SetStatusCondition(&domain.Status.Conditions, metav1.Condition{
-				Type:               "Ready",
-				Status:             metav1.ConditionFalse,
-				ObservedGeneration: domain.Generation,
-				Reason:             "Pending",
-				Message:            "Domain is pending verification",
-				LastTransitionTime: metav1.Now(),
-			})
-		} else {
+		setDomainConditions(domain, result)
+
+		switch {
+		case ready:
 			domain.Status.State = networkingv1alpha2.R2BucketDomainStateActive
 			domain.Status.Message = ""
-			meta.SetStatusCondition(&domain.Status.Conditions, metav1.Condition{
-				Type:               "Ready",
-				Status:             metav1.ConditionTrue,
-				ObservedGeneration: domain.Generation,
-				Reason:             "Synced",
-				Message:            "R2 custom domain synced to Cloudflare",
-				LastTransitionTime: metav1.Now(),
-			})
+		case status.Failed():
+			domain.Status.State = networkingv1alpha2.R2BucketDomainStateError
+			domain.Status.Message = fmt.Sprintf("Domain verification failed (SSL: %s, Ownership: %s)",
+				status.SSL, status.Ownership)
+		case !status.OwnershipVerified():
+			domain.Status.State = networkingv1alpha2.R2BucketDomainStatePending
+			domain.Status.Message = fmt.Sprintf("Domain is pending verification (SSL: %s, Ownership: %s)",
+				status.SSL, status.Ownership)
+		default:
+			domain.Status.State = networkingv1alpha2.R2BucketDomainStateInitializing
+			domain.Status.Message = fmt.Sprintf("Waiting for the SSL certificate (SSL: %s)", status.SSL)
 		}
 		domain.Status.ObservedGeneration = domain.Generation
 	})
@@ -310,12 +318,97 @@ func (r *Reconciler) updateStatusFromResult(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
-	// Continue polling if pending
-	if isPending {
+	switch {
+	case ready:
+		return common.NoRequeue(), nil
+	case status.Failed():
+		// Failures such as a conflicting DNS record need user action; keep checking
+		return common.RequeueLong(), nil
+	default:
+		// Continue polling while ownership or SSL is pending
 		return common.RequeueMedium(), nil
 	}
+}
 
-	return common.NoRequeue(), nil
+// setDomainConditions sets the OwnershipVerified, CertificateIssued and Ready
+// conditions from the statuses Cloudflare reports for the custom domain.
+func setDomainConditions(domain *networkingv1alpha2.R2BucketDomain, result *cf.R2CustomDomain) {
+	status := result.Status
+
+	ownership := metav1.Condition{
+		Type:               ConditionTypeOwnershipVerified,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: domain.Generation,
+		Reason:             "Active",
+		Message:            "Cloudflare verified ownership of the domain",
+	}
+	if !status.OwnershipVerified() {
+		ownership.Status = metav1.ConditionFalse
+		ownership.Reason = statusReason(status.Ownership)
+		ownership.Message = ownershipMessage(result)
+	}
+	meta.SetStatusCondition(&domain.Status.Conditions, ownership)
+
+	certificate := metav1.Condition{
+		Type:               ConditionTypeCertificateIssued,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: domain.Generation,
+		Reason:             "Active",
+		Message:            "The SSL certificate of the domain is active",
+	}
+	if !status.CertificateIssued() {
+		certificate.Status = metav1.ConditionFalse
+		certificate.Reason = statusReason(status.SSL)
+		certificate.Message = fmt.Sprintf("The SSL certificate of %s is %s", result.Domain, status.SSL)
+	}
+	meta.SetStatusCondition(&domain.Status.Conditions, certificate)
+
+	ready := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: domain.Generation,
+		Reason:             "Synced",
+		Message:            "R2 custom domain synced to Cloudflare",
+	}
+	switch {
+	case status.OwnershipVerified() && status.CertificateIssued():
+	case status.Failed():
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "VerificationFailed"
+		ready.Message = fmt.Sprintf("Domain verification failed (SSL: %s, Ownership: %s)", status.SSL, status.Ownership)
+	default:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = "Pending"
+		ready.Message = "Domain is pending verification"
+	}
+	meta.SetStatusCondition(&domain.Status.Conditions, ready)
+}
+
+// ownershipMessage explains what ownership verification of the domain needs.
+// Cloudflare creates the DNS record of an R2 custom domain itself, so the
+// user has to make sure the record can be added to the zone.
+func ownershipMessage(result *cf.R2CustomDomain) string {
+	zone := result.ZoneName
+	if zone == "" {
+		zone = "the zone of the domain"
+	}
+	if result.Status.Ownership == cf.R2DomainStatusPending {
+		return fmt.Sprintf("Ownership of %s is pending: Cloudflare adds a DNS record for %s to %s, "+
+			"which must be an active zone of the account without another DNS record for %s",
+			result.Domain, result.Domain, zone, result.Domain)
+	}
+	return fmt.Sprintf("Ownership of %s is %s: check that %s is an active zone of the account "+
+		"and that no other DNS record exists for %s",
+		result.Domain, result.Status.Ownership, zone, result.Domain)
+}
+
+// statusReason converts a Cloudflare status such as "pending" into a
+// condition reason such as "Pending".
+func statusReason(status string) string {
+	if status == "" {
+		return "Unknown"
+	}
+	return strings.ToUpper(status[:1]) + status[1:]
 }
 
 // findDomainsForCredentials returns R2BucketDomains that reference the given credentials
@@ -400,5 +493,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.R2Bucket{},
 			handler.EnqueueRequestsFromMapFunc(r.findDomainsForBucket)).
 		Named("r2bucketdomain").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.R2BucketDomain{}, r))
 }
@@ -4,9 +4,30 @@
 package r2bucketdomain
 
 import (
+	"context"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
 )
 
 func TestFinalizerName(t *testing.T) {
@@ -23,3 +44,150 @@ func TestReconcilerFields(t *testing.T) {
 	assert.Nil(t, r.Scheme)
 	assert.Nil(t, r.Recorder)
 }
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	mock.Store().CreateR2Bucket(&models.R2Bucket{Name: "assets", CreationDate: time.Now()})
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.R2BucketDomain{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newDomain() *networkingv1alpha2.R2BucketDomain {
+	return &networkingv1alpha2.R2BucketDomain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "cdn",
+			Namespace:  "default",
+			Finalizers: []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.R2BucketDomainSpec{
+			BucketName: "assets",
+			Domain:     "cdn.example.com",
+			MinTLS:     networkingv1alpha2.R2BucketDomainMinTLS12,
+		},
+	}
+}
+
+// reconcileDomain reconciles the "cdn" domain and returns the result and the updated domain.
+func reconcileDomain(t *testing.T, r *Reconciler, c client.Client) (ctrl.Result, *networkingv1alpha2.R2BucketDomain) {
+	t.Helper()
+	key := types.NamespacedName{Name: "cdn", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	domain := &networkingv1alpha2.R2BucketDomain{}
+	require.NoError(t, c.Get(context.Background(), key, domain))
+	return result, domain
+}
+
+func assertCondition(t *testing.T, domain *networkingv1alpha2.R2BucketDomain, conditionType string,
+	status metav1.ConditionStatus, reason string) *metav1.Condition {
+	t.Helper()
+	condition := meta.FindStatusCondition(domain.Status.Conditions, conditionType)
+	require.NotNil(t, condition, "condition %s", conditionType)
+	assert.Equal(t, status, condition.Status, "condition %s", conditionType)
+	assert.Equal(t, reason, condition.Reason, "condition %s", conditionType)
+	return condition
+}
+
+func TestReconcile_OwnershipAndSSLProgressToReady(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain())
+
+	// Attached: ownership pending, certificate initializing
+	result, domain := reconcileDomain(t, r, c)
+	assert.Positive(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.R2BucketDomainStatePending, domain.Status.State)
+	assert.Equal(t, "pending", domain.Status.OwnershipStatus)
+	assert.Equal(t, "initializing", domain.Status.SSLStatus)
+	assert.Equal(t, "example.com", domain.Status.ZoneName)
+	ownership := assertCondition(t, domain, ConditionTypeOwnershipVerified, metav1.ConditionFalse, "Pending")
+	assert.Contains(t, ownership.Message, "adds a DNS record for cdn.example.com to example.com")
+	assertCondition(t, domain, ConditionTypeCertificateIssued, metav1.ConditionFalse, "Initializing")
+	assertCondition(t, domain, "Ready", metav1.ConditionFalse, "Pending")
+
+	// Ownership verified, certificate pending
+	require.True(t, mock.Store().SetR2CustomDomainStatus("assets", "cdn.example.com", "active", "pending"))
+	result, domain = reconcileDomain(t, r, c)
+	assert.Positive(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.R2BucketDomainStateInitializing, domain.Status.State)
+	assertCondition(t, domain, ConditionTypeOwnershipVerified, metav1.ConditionTrue, "Active")
+	assertCondition(t, domain, ConditionTypeCertificateIssued, metav1.ConditionFalse, "Pending")
+	assertCondition(t, domain, "Ready", metav1.ConditionFalse, "Pending")
+
+	// Both active
+	require.True(t, mock.Store().SetR2CustomDomainStatus("assets", "cdn.example.com", "active", "active"))
+	result, domain = reconcileDomain(t, r, c)
+	assert.Zero(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.R2BucketDomainStateActive, domain.Status.State)
+	assert.Empty(t, domain.Status.Message)
+	assertCondition(t, domain, ConditionTypeOwnershipVerified, metav1.ConditionTrue, "Active")
+	assertCondition(t, domain, ConditionTypeCertificateIssued, metav1.ConditionTrue, "Active")
+	assertCondition(t, domain, "Ready", metav1.ConditionTrue, "Synced")
+}
+
+func TestReconcile_BlockedOwnershipIsAnError(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain())
+	reconcileDomain(t, r, c)
+
+	require.True(t, mock.Store().SetR2CustomDomainStatus("assets", "cdn.example.com", "blocked", "pending"))
+	result, domain := reconcileDomain(t, r, c)
+
+	assert.Positive(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.R2BucketDomainStateError, domain.Status.State)
+	ownership := assertCondition(t, domain, ConditionTypeOwnershipVerified, metav1.ConditionFalse, "Blocked")
+	assert.Contains(t, ownership.Message, "no other DNS record exists for cdn.example.com")
+	assertCondition(t, domain, "Ready", metav1.ConditionFalse, "VerificationFailed")
+}
+
+func TestReconcile_NormalizesStatus(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newDomain())
+	reconcileDomain(t, r, c)
+
+	require.True(t, mock.Store().SetR2CustomDomainStatus("assets", "cdn.example.com", "Active", ""))
+	_, domain := reconcileDomain(t, r, c)
+
+	assert.Equal(t, "active", domain.Status.OwnershipStatus)
+	assert.Equal(t, "unknown", domain.Status.SSLStatus)
+	assertCondition(t, domain, ConditionTypeCertificateIssued, metav1.ConditionFalse, "Unknown")
+}
@@ -7,6 +7,7 @@ package r2bucketnotification
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -97,32 +98,37 @@ func (r *Reconciler) handleDeletion(
 		return common.NoRequeue(), nil
 	}
 
-	// Get API client and delete from Cloudflare
-	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
-		CredentialsRef: notification.Spec.CredentialsRef,
-		Namespace:      notification.Namespace,
-	})
-	if err != nil {
-		logger.Error(err, "Failed to get API client for deletion")
-		// Continue with finalizer removal
-	} else if notification.Status.QueueID != "" && notification.Spec.BucketName != "" {
-		// Delete notification from Cloudflare
-		logger.Info("Deleting R2 notification from Cloudflare",
-			"bucketName", notification.Spec.BucketName,
-			"queueId", notification.Status.QueueID)
-
-		if err := apiResult.API.DeleteR2Notification(ctx, notification.Spec.BucketName, notification.Status.QueueID); err != nil {
-			if !cf.IsNotFoundError(err) {
-				logger.Error(err, "Failed to delete R2 notification from Cloudflare, continuing with finalizer removal")
-				r.Recorder.Event(notification, corev1.EventTypeWarning, "DeleteFailed",
-					fmt.Sprintf("Failed to delete from Cloudflare (will remove finalizer anyway): %s", cf.SanitizeErrorMessage(err)))
-				// Don't block finalizer removal - resource may need manual cleanup in Cloudflare
+	// Check deletion policy
+	if common.RecordDeletionPolicy(ctx, r.Client, r.Recorder, notification, notification.Spec.DeletionPolicy) {
+		logger.Info("Orphan deletion policy, skipping Cloudflare deletion")
+	} else {
+		// Get API client and delete from Cloudflare
+		apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+			CredentialsRef: notification.Spec.CredentialsRef,
+			Namespace:      notification.Namespace,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to get API client for deletion")
+			// Continue with finalizer removal
+		} else if notification.Status.QueueID != "" && notification.Spec.BucketName != "" {
+			// Delete notification from Cloudflare
+			logger.Info("Deleting R2 notification from Cloudflare",
+				"bucketName", notification.Spec.BucketName,
+				"queueId", notification.Status.QueueID)
+
+			if err := apiResult.API.DeleteR2Notification(ctx, notification.Spec.BucketName, notification.Status.QueueID); err != nil {
+				if !cf.IsNotFoundError(err) {
+					logger.Error(err, "Failed to delete R2 notification from Cloudflare")
+					if result, retry := controller.HandleDeletionFailure(ctx, r.Recorder, notification, notification.Spec.DeletionTimeout, err); retry {
+						return result, nil
+					}
+				} else {
+					logger.Info("R2 notification not found in Cloudflare, may have been already deleted")
+				}
 			} else {
-				logger.Info("R2 notification not found in Cloudflare, may have been already deleted")
+				r.Recorder.Event(notification, corev1.EventTypeNormal, "Deleted",
+					"R2 notification deleted from Cloudflare")
 			}
-		} else {
-			r.Recorder.Event(notification, corev1.EventTypeNormal, "Deleted",
-				"R2 notification deleted from Cloudflare")
 		}
 	}
 
@@ -147,28 +153,30 @@ func (r *Reconciler) syncNotification(
 	logger := log.FromContext(ctx)
 
 	bucketName := notification.Spec.BucketName
-	queueName := notification.Spec.QueueName
-
-	// Build notification rules (convert R2EventType to string)
-	rules := make([]cf.R2NotificationRule, 0, len(notification.Spec.Rules))
-	for _, rule := range notification.Spec.Rules {
-		eventTypes := make([]string, 0, len(rule.EventTypes))
-		for _, et := range rule.EventTypes {
-			eventTypes = append(eventTypes, string(et))
-		}
-		rules = append(rules, cf.R2NotificationRule{
-			Prefix:      rule.Prefix,
-			Suffix:      rule.Suffix,
-			EventTypes:  eventTypes,
-			Description: rule.Description,
-		})
+
+	rules, err := desiredRules(&notification.Spec)
+	if err != nil {
+		return r.updateStatusInvalid(ctx, notification, err)
 	}
 
-	// Determine queue ID
-	// If we have a queue ID in status, use it. Otherwise, use the queue name as ID.
-	queueID := notification.Status.QueueID
-	if queueID == "" {
-		queueID = queueName
+	queueID, err := resolveQueueID(ctx, apiResult.API, notification.Spec.QueueName)
+	if err != nil {
+		logger.Error(err, "Failed to resolve queue", "queueName", notification.Spec.QueueName)
+		return r.updateStatusError(ctx, notification, err)
+	}
+
+	// The queue target changed: remove the rules sending events to the old queue
+	// first, as Cloudflare rejects rules that overlap the rules of another queue.
+	if oldQueueID := notification.Status.QueueID; oldQueueID != "" && oldQueueID != queueID {
+		logger.Info("Removing R2 notification rules for previous queue",
+			"bucketName", bucketName,
+			"queueId", oldQueueID)
+		if err := apiResult.API.DeleteR2Notification(ctx, bucketName, oldQueueID); err != nil {
+			logger.Error(err, "Failed to delete R2 notification for previous queue")
+			return r.updateStatusError(ctx, notification, err)
+		}
+		r.Recorder.Event(notification, corev1.EventTypeNormal, "QueueChanged",
+			fmt.Sprintf("R2 notification rules removed from previous queue '%s'", oldQueueID))
 	}
 
 	// Set notification rules
@@ -185,7 +193,90 @@ func (r *Reconciler) syncNotification(
 	r.Recorder.Event(notification, corev1.EventTypeNormal, "Synced",
 		fmt.Sprintf("R2 notification rules set for bucket '%s' with queue '%s'", bucketName, queueID))
 
-	return r.updateStatusReady(ctx, notification, apiResult.AccountID, queueID)
+	return r.updateStatusReady(ctx, notification, queueID, len(rules))
+}
+
+// desiredRules returns the notification rules of spec: the rule of the
+// top-level EventTypes, Prefix and Suffix, if set, followed by Rules.
+// Every rule must have at least one event type.
+func desiredRules(spec *networkingv1alpha2.R2BucketNotificationSpec) ([]cf.R2NotificationRule, error) {
+	rules := make([]cf.R2NotificationRule, 0, len(spec.Rules)+1)
+	if len(spec.EventTypes) > 0 {
+		rules = append(rules, cf.R2NotificationRule{
+			Prefix:     spec.Prefix,
+			Suffix:     spec.Suffix,
+			EventTypes: eventTypeStrings(spec.EventTypes),
+		})
+	} else if spec.Prefix != "" || spec.Suffix != "" {
+		return nil, errors.New("spec.prefix and spec.suffix require spec.eventTypes")
+	}
+
+	for i, rule := range spec.Rules {
+		if len(rule.EventTypes) == 0 {
+			return nil, fmt.Errorf("spec.rules[%d] must have at least one event type", i)
+		}
+		rules = append(rules, cf.R2NotificationRule{
+			Prefix:      rule.Prefix,
+			Suffix:      rule.Suffix,
+			EventTypes:  eventTypeStrings(rule.EventTypes),
+			Description: rule.Description,
+		})
+	}
+
+	if len(rules) == 0 {
+		return nil, errors.New("at least one event type must be set in spec.eventTypes or spec.rules")
+	}
+	return rules, nil
+}
+
+func eventTypeStrings(eventTypes []networkingv1alpha2.R2EventType) []string {
+	result := make([]string, 0, len(eventTypes))
+	for _, et := range eventTypes {
+		result = append(result, string(et))
+	}
+	return result
+}
+
+// resolveQueueID returns the ID of the queue named queueName. queueName may
+// also be the ID of the queue.
+func resolveQueueID(ctx context.Context, api *cf.API, queueName string) (string, error) {
+	queues, err := api.ListQueues(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, q := range queues {
+		if q.Name == queueName || q.ID == queueName {
+			return q.ID, nil
+		}
+	}
+	return "", fmt.Errorf("queue not found: %s", queueName)
+}
+
+// updateStatusInvalid records an invalid spec. It is not requeued, as only a
+// change of the spec can fix it.
+func (r *Reconciler) updateStatusInvalid(
+	ctx context.Context,
+	notification *networkingv1alpha2.R2BucketNotification,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(notification, corev1.EventTypeWarning, "InvalidSpec", err.Error())
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, notification, func() {
+		notification.Status.State = networkingv1alpha2.R2NotificationStateError
+		notification.Status.Message = err.Error()
+		meta.SetStatusCondition(&notification.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: notification.Generation,
+			Reason:             "InvalidSpec",
+			Message:            err.Error(),
+			LastTransitionTime: metav1.Now(),
+		})
+		notification.Status.ObservedGeneration = notification.Generation
+	})
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+	return common.NoRequeue(), nil
 }
 
 func (r *Reconciler) updateStatusError(
@@ -217,11 +308,12 @@ func (r *Reconciler) updateStatusError(
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	notification *networkingv1alpha2.R2BucketNotification,
-	_, queueID string, // accountID not stored in status
+	queueID string,
+	ruleCount int,
 ) (ctrl.Result, error) {
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, notification, func() {
 		notification.Status.QueueID = queueID
-		notification.Status.RuleCount = len(notification.Spec.Rules)
+		notification.Status.RuleCount = ruleCount
 		notification.Status.State = networkingv1alpha2.R2NotificationStateActive
 		notification.Status.Message = ""
 		meta.SetStatusCondition(&notification.Status.Conditions, metav1.Condition{
@@ -311,6 +403,39 @@ func (r *Reconciler) findNotificationsForBucket(ctx context.Context, obj client.
 	return requests
 }
 
+// findNotificationsForQueue returns R2BucketNotifications that send events to the given Queue
+func (r *Reconciler) findNotificationsForQueue(ctx context.Context, obj client.Object) []reconcile.Request {
+	queue, ok := obj.(*networkingv1alpha2.Queue)
+	if !ok {
+		return nil
+	}
+
+	notificationList := &networkingv1alpha2.R2BucketNotificationList{}
+	if err := r.List(ctx, notificationList, client.InNamespace(queue.Namespace)); err != nil {
+		return nil
+	}
+
+	queueName := queue.Spec.Name
+	if queueName == "" {
+		queueName = queue.Name
+	}
+
+	var requests []reconcile.Request
+	for _, notification := range notificationList.Items {
+		if notification.Spec.QueueName == queueName ||
+			(queue.Status.QueueID != "" && notification.Spec.QueueName == queue.Status.QueueID) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      notification.Name,
+					Namespace: notification.Namespace,
+				},
+			})
+		}
+	}
+
+	return requests
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Recorder = mgr.GetEventRecorderFor("r2bucketnotification-controller")
@@ -324,6 +449,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findNotificationsForCredentials)).
 		Watches(&networkingv1alpha2.R2Bucket{},
 			handler.EnqueueRequestsFromMapFunc(r.findNotificationsForBucket)).
+		Watches(&networkingv1alpha2.Queue{},
+			handler.EnqueueRequestsFromMapFunc(r.findNotificationsForQueue)).
 		Named("r2bucketnotification").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.R2BucketNotification{}, r))
 }
@@ -4,9 +4,30 @@
 package r2bucketnotification
 
 import (
+	"context"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
 )
 
 func TestFinalizerName(t *testing.T) {
@@ -23,3 +44,184 @@ func TestReconcilerFields(t *testing.T) {
 	assert.Nil(t, r.Scheme)
 	assert.Nil(t, r.Recorder)
 }
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	mock.Store().CreateR2Bucket(&models.R2Bucket{Name: "uploads", CreationDate: time.Now()})
+	mock.Store().CreateQueue(&models.Queue{ID: "queue-events", Name: "events", CreatedOn: time.Now()})
+	mock.Store().CreateQueue(&models.Queue{ID: "queue-audit", Name: "audit", CreatedOn: time.Now()})
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.R2BucketNotification{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newNotification(spec networkingv1alpha2.R2BucketNotificationSpec) *networkingv1alpha2.R2BucketNotification {
+	spec.BucketName = "uploads"
+	if spec.QueueName == "" {
+		spec.QueueName = "events"
+	}
+	return &networkingv1alpha2.R2BucketNotification{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "uploads",
+			Namespace:  "default",
+			Finalizers: []string{finalizerName},
+		},
+		Spec: spec,
+	}
+}
+
+// reconcileNotification reconciles the "uploads" notification and returns the result and the updated notification.
+func reconcileNotification(
+	t *testing.T, r *Reconciler, c client.Client,
+) (ctrl.Result, *networkingv1alpha2.R2BucketNotification) {
+	t.Helper()
+	key := types.NamespacedName{Name: "uploads", Namespace: "default"}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	notification := &networkingv1alpha2.R2BucketNotification{}
+	require.NoError(t, c.Get(context.Background(), key, notification))
+	return result, notification
+}
+
+func TestReconcile_FilteredNotification(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNotification(networkingv1alpha2.R2BucketNotificationSpec{
+		EventTypes: []networkingv1alpha2.R2EventType{networkingv1alpha2.R2EventTypeObjectCreate},
+		Prefix:     "images/",
+		Suffix:     ".png",
+		Rules: []networkingv1alpha2.R2NotificationRule{{
+			EventTypes: []networkingv1alpha2.R2EventType{networkingv1alpha2.R2EventTypeObjectDelete},
+			Prefix:     "tmp/",
+		}},
+	}))
+
+	_, notification := reconcileNotification(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.R2NotificationStateActive, notification.Status.State)
+	assert.Equal(t, "queue-events", notification.Status.QueueID)
+	assert.Equal(t, 2, notification.Status.RuleCount)
+
+	rules := mock.Store().GetR2Notifications("uploads")["queue-events"]
+	require.Len(t, rules, 2)
+	assert.Equal(t, "images/", rules[0].Prefix)
+	assert.Equal(t, ".png", rules[0].Suffix)
+	assert.Equal(t, []string{"object-create"}, rules[0].EventTypes)
+	assert.Equal(t, "tmp/", rules[1].Prefix)
+	assert.Equal(t, []string{"object-delete"}, rules[1].EventTypes)
+}
+
+func TestReconcile_QueueTargetChange(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNotification(networkingv1alpha2.R2BucketNotificationSpec{
+		EventTypes: []networkingv1alpha2.R2EventType{networkingv1alpha2.R2EventTypeObjectCreate},
+	}))
+	_, notification := reconcileNotification(t, r, c)
+	require.Equal(t, "queue-events", notification.Status.QueueID)
+
+	notification.Spec.QueueName = "audit"
+	require.NoError(t, c.Update(context.Background(), notification))
+	_, notification = reconcileNotification(t, r, c)
+
+	assert.Equal(t, networkingv1alpha2.R2NotificationStateActive, notification.Status.State)
+	assert.Equal(t, "queue-audit", notification.Status.QueueID)
+	queues := mock.Store().GetR2Notifications("uploads")
+	assert.NotContains(t, queues, "queue-events")
+	require.Len(t, queues["queue-audit"], 1)
+	assert.Equal(t, []string{"object-create"}, queues["queue-audit"][0].EventTypes)
+}
+
+func TestReconcile_UnknownQueue(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newNotification(networkingv1alpha2.R2BucketNotificationSpec{
+		QueueName:  "missing",
+		EventTypes: []networkingv1alpha2.R2EventType{networkingv1alpha2.R2EventTypeObjectCreate},
+	}))
+
+	result, notification := reconcileNotification(t, r, c)
+
+	assert.Positive(t, result.RequeueAfter)
+	assert.Equal(t, networkingv1alpha2.R2NotificationStateError, notification.Status.State)
+	assert.Contains(t, notification.Status.Message, "queue not found: missing")
+	assert.Empty(t, mock.Store().GetR2Notifications("uploads"))
+}
+
+func TestReconcile_InvalidSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    networkingv1alpha2.R2BucketNotificationSpec
+		message string
+	}{
+		{
+			name:    "no event types",
+			message: "at least one event type",
+		},
+		{
+			name:    "filter without event types",
+			spec:    networkingv1alpha2.R2BucketNotificationSpec{Prefix: "images/"},
+			message: "spec.prefix and spec.suffix require spec.eventTypes",
+		},
+		{
+			name: "rule without event types",
+			spec: networkingv1alpha2.R2BucketNotificationSpec{
+				Rules: []networkingv1alpha2.R2NotificationRule{{Prefix: "images/"}},
+			},
+			message: "spec.rules[0] must have at least one event type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockServer(t)
+			r, c := newTestReconciler(t, newNotification(tt.spec))
+
+			result, notification := reconcileNotification(t, r, c)
+
+			assert.Zero(t, result.RequeueAfter)
+			assert.Equal(t, networkingv1alpha2.R2NotificationStateError, notification.Status.State)
+			assert.Contains(t, notification.Status.Message, tt.message)
+			ready := meta.FindStatusCondition(notification.Status.Conditions, "Ready")
+			require.NotNil(t, ready)
+			assert.Equal(t, "InvalidSpec", ready.Reason)
+			assert.Empty(t, mock.Store().GetR2Notifications("uploads"))
+		})
+	}
+}
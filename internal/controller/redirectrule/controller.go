@@ -181,16 +181,33 @@ func (r *Reconciler) syncRedirectRule(
 		"phase", redirectPhase,
 		"rulesCount", len(rules))
 
-	result, err := apiResult.API.UpdateEntrypointRuleset(ctx, zoneID, redirectPhase, description, rules)
+	result, rejected, err := common.UpdateEntrypointRules(
+		ctx, apiResult.API, zoneID, redirectPhase, description, rules, rule.Status.Rules)
+	statuses := common.RuleStatuses(ruleNames(rule), rules, rejected)
 	if err != nil {
 		logger.Error(err, "Failed to update redirect ruleset")
+		if len(rejected) > 0 {
+			return common.UpdateStatusRulesRejected(ctx, r.Client, r.Recorder, rule, statuses,
+				func(msg string, ready metav1.Condition) {
+					rule.Status.Rules = statuses
+					rule.Status.State = networkingv1alpha2.RedirectRuleStateError
+					rule.Status.Message = msg
+					meta.SetStatusCondition(&rule.Status.Conditions, ready)
+					rule.Status.ObservedGeneration = rule.Generation
+				})
+		}
 		return r.updateStatusError(ctx, rule, err)
 	}
 
+	if len(rejected) > 0 {
+		r.Recorder.Event(rule, corev1.EventTypeWarning, common.ReasonRulesRejected,
+			common.RejectedRulesMessage(statuses))
+	}
+
 	r.Recorder.Event(rule, corev1.EventTypeNormal, "Updated",
 		fmt.Sprintf("RedirectRule for zone '%s' updated in Cloudflare", zoneName))
 
-	return r.updateStatusReady(ctx, rule, zoneID, result.ID, len(rules))
+	return r.updateStatusReady(ctx, rule, zoneID, result, statuses)
 }
 
 // buildRules builds Cloudflare ruleset rules from the spec.
@@ -206,28 +223,14 @@ func (r *Reconciler) buildRules(rule *networkingv1alpha2.RedirectRule) []cloudfl
 			Expression:  ruleSpec.Expression,
 			Description: ruleSpec.Name,
 			Enabled:     &ruleSpec.Enabled,
+			ActionParameters: cf.RedirectAction{
+				TargetURL:           ruleSpec.Target.URL,
+				TargetExpression:    ruleSpec.Target.Expression,
+				StatusCode:          int(ruleSpec.StatusCode),
+				PreserveQueryString: ruleSpec.PreserveQueryString,
+				PreservePathSuffix:  ruleSpec.PreservePathSuffix,
+			}.ActionParameters(),
 		}
-
-		// Build action parameters for redirect
-		params := &cloudflare.RulesetRuleActionParameters{
-			FromValue: &cloudflare.RulesetRuleActionParametersFromValue{
-				PreserveQueryString: &ruleSpec.PreserveQueryString,
-				StatusCode:          uint16(ruleSpec.StatusCode),
-			},
-		}
-
-		// Set target URL (static or expression)
-		if ruleSpec.Target.URL != "" {
-			params.FromValue.TargetURL = cloudflare.RulesetRuleActionParametersTargetURL{
-				Value: ruleSpec.Target.URL,
-			}
-		} else if ruleSpec.Target.Expression != "" {
-			params.FromValue.TargetURL = cloudflare.RulesetRuleActionParametersTargetURL{
-				Expression: ruleSpec.Target.Expression,
-			}
-		}
-
-		cfRule.ActionParameters = params
 		rules = append(rules, cfRule)
 	}
 
@@ -243,26 +246,30 @@ func (r *Reconciler) buildRules(rule *networkingv1alpha2.RedirectRule) []cloudfl
 		expression := r.buildWildcardExpression(wildcardSpec)
 		cfRule.Expression = expression
 
-		// Build action parameters
-		params := &cloudflare.RulesetRuleActionParameters{
-			FromValue: &cloudflare.RulesetRuleActionParametersFromValue{
-				PreserveQueryString: &wildcardSpec.PreserveQueryString,
-				StatusCode:          uint16(wildcardSpec.StatusCode),
-			},
-		}
-
 		// Set target URL with dynamic replacement
-		params.FromValue.TargetURL = cloudflare.RulesetRuleActionParametersTargetURL{
-			Expression: r.buildWildcardTargetExpression(wildcardSpec),
-		}
-
-		cfRule.ActionParameters = params
+		cfRule.ActionParameters = cf.RedirectAction{
+			TargetExpression:    r.buildWildcardTargetExpression(wildcardSpec),
+			StatusCode:          int(wildcardSpec.StatusCode),
+			PreserveQueryString: wildcardSpec.PreserveQueryString,
+		}.ActionParameters()
 		rules = append(rules, cfRule)
 	}
 
 	return rules
 }
 
+// ruleNames returns the name of each rule, in ruleset order: expression rules, then wildcard rules.
+func ruleNames(rule *networkingv1alpha2.RedirectRule) []string {
+	names := make([]string, 0, len(rule.Spec.Rules)+len(rule.Spec.WildcardRules))
+	for _, ruleSpec := range rule.Spec.Rules {
+		names = append(names, ruleSpec.Name)
+	}
+	for _, wildcardSpec := range rule.Spec.WildcardRules {
+		names = append(names, wildcardSpec.Name)
+	}
+	return names
+}
+
 // buildWildcardExpression builds a filter expression from a wildcard redirect rule
 func (*Reconciler) buildWildcardExpression(spec networkingv1alpha2.WildcardRedirectRule) string {
 	// Basic expression for matching the source URL
@@ -315,23 +322,34 @@ func (r *Reconciler) updateStatusError(
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	rule *networkingv1alpha2.RedirectRule,
-	zoneID, rulesetID string,
-	rulesCount int,
+	zoneID string,
+	result *cf.RulesetResult,
+	statuses []networkingv1alpha2.RuleStatus,
 ) (ctrl.Result, error) {
+	ready := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: rule.Generation,
+		Reason:             "Synced",
+		Message:            "RedirectRule synced to Cloudflare",
+		LastTransitionTime: metav1.Now(),
+	}
+	state := networkingv1alpha2.RedirectRuleStateReady
+	if msg := common.RejectedRulesMessage(statuses); msg != "" {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = common.ReasonRulesRejected
+		ready.Message = msg
+		state = networkingv1alpha2.RedirectRuleStateError
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, rule, func() {
 		rule.Status.ZoneID = zoneID
-		rule.Status.RulesetID = rulesetID
-		rule.Status.RuleCount = rulesCount
-		rule.Status.State = networkingv1alpha2.RedirectRuleStateReady
-		rule.Status.Message = "RedirectRule synced to Cloudflare"
-		meta.SetStatusCondition(&rule.Status.Conditions, metav1.Condition{
-			Type:               "Ready",
-			Status:             metav1.ConditionTrue,
-			ObservedGeneration: rule.Generation,
-			Reason:             "Synced",
-			Message:            "RedirectRule synced to Cloudflare",
-			LastTransitionTime: metav1.Now(),
-		})
+		rule.Status.RulesetID = result.ID
+		rule.Status.RuleCount = len(result.Rules)
+		rule.Status.Rules = statuses
+		rule.Status.State = state
+		rule.Status.Message = ready.Message
+		meta.SetStatusCondition(&rule.Status.Conditions, ready)
 		rule.Status.ObservedGeneration = rule.Generation
 	})
 
@@ -394,5 +412,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findRulesForCredentials)).
 		Named("redirectrule").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.RedirectRule{}, r))
 }
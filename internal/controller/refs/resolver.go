@@ -158,6 +158,47 @@ func (r *Resolver) ResolveVirtualNetwork(ctx context.Context, ref *networkingv1a
 	return "", errors.New("invalid virtual network ref: must specify name, cloudflareId, or cloudflareName")
 }
 
+// ResolveCustomPage resolves an AccessCustomPageRef to a Cloudflare custom page ID.
+// Resolution priority: cloudflareId > name > cloudflareName
+//
+//nolint:revive // cognitive complexity is acceptable for this linear resolution logic
+func (r *Resolver) ResolveCustomPage(ctx context.Context, ref *networkingv1alpha2.AccessCustomPageRef) (string, error) {
+	if ref == nil {
+		return "", errors.New("nil custom page reference")
+	}
+
+	// Priority 1: Direct Cloudflare ID
+	if ref.CloudflareID != "" {
+		return ref.CloudflareID, nil
+	}
+
+	// Priority 2: K8s AccessCustomPage name
+	if ref.Name != "" {
+		page := &networkingv1alpha2.AccessCustomPage{}
+		if err := r.client.Get(ctx, apitypes.NamespacedName{Name: ref.Name}, page); err != nil {
+			return "", fmt.Errorf("AccessCustomPage %q not found: %w", ref.Name, err)
+		}
+		if page.Status.PageID == "" {
+			return "", fmt.Errorf("AccessCustomPage %q not ready (no PageID in status)", ref.Name)
+		}
+		return page.Status.PageID, nil
+	}
+
+	// Priority 3: Cloudflare display name lookup
+	if ref.CloudflareName != "" {
+		result, err := r.api.GetAccessCustomPageByName(ctx, ref.CloudflareName)
+		if err != nil {
+			return "", fmt.Errorf("failed to find custom page by name %q: %w", ref.CloudflareName, err)
+		}
+		if result == nil {
+			return "", fmt.Errorf("custom page %q not found in Cloudflare", ref.CloudflareName)
+		}
+		return result.ID, nil
+	}
+
+	return "", errors.New("invalid custom page ref: must specify name, cloudflareId, or cloudflareName")
+}
+
 // ResolveAllIdentityProviders resolves all IdP references to Cloudflare IdP IDs.
 // It handles deduplication automatically.
 //
@@ -216,3 +257,40 @@ func (r *Resolver) ResolveAllGroups(
 
 	return result, errs
 }
+
+// ResolveAllCustomPages resolves all custom page references to Cloudflare custom page IDs.
+// It handles deduplication automatically.
+//
+//nolint:revive,prealloc // cognitive complexity is acceptable for this aggregation logic
+func (r *Resolver) ResolveAllCustomPages(
+	ctx context.Context,
+	directIDs []string,
+	refs []networkingv1alpha2.AccessCustomPageRef,
+) ([]string, []error) {
+	seen := make(map[string]bool)
+	var result []string
+	var errs []error
+
+	// Add direct IDs first
+	for _, id := range directIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+
+	// Resolve refs
+	for i, ref := range refs {
+		id, err := r.ResolveCustomPage(ctx, &ref)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("custom page ref at index %d: %w", i, err))
+			continue
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+
+	return result, errs
+}
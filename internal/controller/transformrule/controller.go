@@ -176,16 +176,33 @@ func (r *Reconciler) syncTransformRule(
 		"type", rule.Spec.Type,
 		"rulesCount", len(rules))
 
-	result, err := apiResult.API.UpdateEntrypointRuleset(ctx, zoneID, phase, description, rules)
+	result, rejected, err := common.UpdateEntrypointRules(
+		ctx, apiResult.API, zoneID, phase, description, rules, rule.Status.Rules)
+	statuses := common.RuleStatuses(ruleNames(rule), rules, rejected)
 	if err != nil {
 		logger.Error(err, "Failed to update transform ruleset")
+		if len(rejected) > 0 {
+			return common.UpdateStatusRulesRejected(ctx, r.Client, r.Recorder, rule, statuses,
+				func(msg string, ready metav1.Condition) {
+					rule.Status.Rules = statuses
+					rule.Status.State = networkingv1alpha2.TransformRuleStateError
+					rule.Status.Message = msg
+					meta.SetStatusCondition(&rule.Status.Conditions, ready)
+					rule.Status.ObservedGeneration = rule.Generation
+				})
+		}
 		return r.updateStatusError(ctx, rule, err)
 	}
 
+	if len(rejected) > 0 {
+		r.Recorder.Event(rule, corev1.EventTypeWarning, common.ReasonRulesRejected,
+			common.RejectedRulesMessage(statuses))
+	}
+
 	r.Recorder.Event(rule, corev1.EventTypeNormal, "Updated",
 		fmt.Sprintf("TransformRule for zone '%s' type '%s' updated in Cloudflare", zoneName, rule.Spec.Type))
 
-	return r.updateStatusReady(ctx, rule, zoneID, result.ID, len(rules))
+	return r.updateStatusReady(ctx, rule, zoneID, result, statuses)
 }
 
 // getPhase returns the Cloudflare ruleset phase based on rule type
@@ -238,6 +255,15 @@ func (*Reconciler) buildRules(rule *networkingv1alpha2.TransformRule) []cloudfla
 	return rules
 }
 
+// ruleNames returns the name of each rule, in ruleset order.
+func ruleNames(rule *networkingv1alpha2.TransformRule) []string {
+	names := make([]string, len(rule.Spec.Rules))
+	for i, ruleSpec := range rule.Spec.Rules {
+		names[i] = ruleSpec.Name
+	}
+	return names
+}
+
 // buildURLRewriteParams builds action parameters for URL rewrite.
 func buildURLRewriteParams(rewrite *networkingv1alpha2.URLRewriteConfig) *cloudflare.RulesetRuleActionParameters {
 	params := &cloudflare.RulesetRuleActionParameters{}
@@ -318,23 +344,34 @@ func (r *Reconciler) updateStatusError(
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	rule *networkingv1alpha2.TransformRule,
-	zoneID, rulesetID string,
-	rulesCount int,
+	zoneID string,
+	result *cf.RulesetResult,
+	statuses []networkingv1alpha2.RuleStatus,
 ) (ctrl.Result, error) {
+	ready := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: rule.Generation,
+		Reason:             "Synced",
+		Message:            "TransformRule synced to Cloudflare",
+		LastTransitionTime: metav1.Now(),
+	}
+	state := networkingv1alpha2.TransformRuleStateReady
+	if msg := common.RejectedRulesMessage(statuses); msg != "" {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = common.ReasonRulesRejected
+		ready.Message = msg
+		state = networkingv1alpha2.TransformRuleStateError
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, rule, func() {
 		rule.Status.ZoneID = zoneID
-		rule.Status.RulesetID = rulesetID
-		rule.Status.RuleCount = rulesCount
-		rule.Status.State = networkingv1alpha2.TransformRuleStateReady
-		rule.Status.Message = "TransformRule synced to Cloudflare"
-		meta.SetStatusCondition(&rule.Status.Conditions, metav1.Condition{
-			Type:               "Ready",
-			Status:             metav1.ConditionTrue,
-			ObservedGeneration: rule.Generation,
-			Reason:             "Synced",
-			Message:            "TransformRule synced to Cloudflare",
-			LastTransitionTime: metav1.Now(),
-		})
+		rule.Status.RulesetID = result.ID
+		rule.Status.RuleCount = len(result.Rules)
+		rule.Status.Rules = statuses
+		rule.Status.State = state
+		rule.Status.Message = ready.Message
+		meta.SetStatusCondition(&rule.Status.Conditions, ready)
 		rule.Status.ObservedGeneration = rule.Generation
 	})
 
@@ -397,5 +434,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findRulesForCredentials)).
 		Named("transformrule").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.TransformRule{}, r))
 }
@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnel
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// AccessOptions describes how the hostnames of a TunnelBinding or Ingress are
+// protected with Cloudflare Access.
+type AccessOptions struct {
+	// ApplicationRef names an existing AccessApplication that protects the
+	// hostnames. No AccessApplication is created when it is set.
+	ApplicationRef string
+	// Policies names the AccessPolicy resources attached to the self-hosted
+	// AccessApplication created for each hostname.
+	Policies []string
+	// DeletionPolicy is the deletion policy of the created AccessApplications.
+	DeletionPolicy string
+}
+
+// AccessApplicationName returns the name of the AccessApplication created for
+// hostname of the object named ownerName. A name longer than 63 characters is
+// truncated and suffixed with a hash of the hostname, so long hostnames of the
+// same owner do not share an AccessApplication.
+func AccessApplicationName(ownerName, hostname string) string {
+	name := strings.Trim(ownerName+"-"+SanitizeHostname(hostname), "-")
+	if len(name) <= 63 {
+		return name
+	}
+	sum := sha256.Sum256([]byte(hostname))
+	suffix := fmt.Sprintf("%x", sum[:4])
+	return strings.Trim(name[:63-len(suffix)-1], "-") + "-" + suffix
+}
+
+// AccessApplicationFor returns the self-hosted AccessApplication that protects
+// hostname with the policies of opts. The caller sets its labels and owner.
+func AccessApplicationFor(
+	name, namespace, hostname string,
+	opts AccessOptions,
+	cloudflare networkingv1alpha2.CloudflareDetails,
+) *networkingv1alpha2.AccessApplication {
+	refs := make([]networkingv1alpha2.ReusablePolicyRef, 0, len(opts.Policies))
+	for _, policy := range opts.Policies {
+		refs = append(refs, networkingv1alpha2.ReusablePolicyRef{Name: policy})
+	}
+	return &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: networkingv1alpha2.AccessApplicationSpec{
+			Name:               hostname,
+			Domain:             hostname,
+			Type:               "self_hosted",
+			ReusablePolicyRefs: refs,
+			Cloudflare:         cloudflare,
+			DeletionPolicy:     opts.DeletionPolicy,
+		},
+	}
+}
+
+// EnsureAccessApplication creates app, or updates the fields set by
+// AccessApplicationFor on the existing AccessApplication. Other fields, such as
+// the session duration, may be tuned on the AccessApplication directly. An
+// existing AccessApplication that is not controlled by owner is left alone.
+func EnsureAccessApplication(
+	ctx context.Context,
+	c client.Client,
+	owner metav1.Object,
+	app *networkingv1alpha2.AccessApplication,
+) error {
+	existing := &networkingv1alpha2.AccessApplication{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(app), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err := c.Create(ctx, app); err != nil {
+			return fmt.Errorf("create AccessApplication %s: %w", app.Name, err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(existing, owner) {
+		return fmt.Errorf("AccessApplication %s already exists and is not managed by %s", app.Name, owner.GetName())
+	}
+
+	desired := existing.DeepCopy()
+	desired.Spec.Name = app.Spec.Name
+	desired.Spec.Domain = app.Spec.Domain
+	desired.Spec.Type = app.Spec.Type
+	desired.Spec.ReusablePolicyRefs = app.Spec.ReusablePolicyRefs
+	desired.Spec.Cloudflare = app.Spec.Cloudflare
+	if app.Spec.DeletionPolicy != "" {
+		desired.Spec.DeletionPolicy = app.Spec.DeletionPolicy
+	}
+	if desired.Labels == nil {
+		desired.Labels = map[string]string{}
+	}
+	maps.Copy(desired.Labels, app.Labels)
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) &&
+		equality.Semantic.DeepEqual(existing.Labels, desired.Labels) {
+		return nil
+	}
+	if err := c.Update(ctx, desired); err != nil {
+		return fmt.Errorf("update AccessApplication %s: %w", app.Name, err)
+	}
+	return nil
+}
+
+// CheckAccessApplication returns an error unless the AccessApplication named
+// name in namespace protects hostname.
+func CheckAccessApplication(ctx context.Context, c client.Client, namespace, name, hostname string) error {
+	app := &networkingv1alpha2.AccessApplication{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, app); err != nil {
+		return fmt.Errorf("get AccessApplication %s: %w", name, err)
+	}
+	if !AccessApplicationCovers(app, hostname) {
+		return fmt.Errorf("AccessApplication %s does not protect %s", name, hostname)
+	}
+	return nil
+}
+
+// AccessApplicationCovers returns whether one of the domains of app matches
+// hostname. A path on the domain is ignored and a leading "*." matches any
+// subdomain.
+func AccessApplicationCovers(app *networkingv1alpha2.AccessApplication, hostname string) bool {
+	domains := append([]string{app.Spec.Domain}, app.Spec.SelfHostedDomains...)
+	for _, destination := range app.Spec.Destinations {
+		if destination.Type == "public" {
+			domains = append(domains, destination.URI)
+		}
+	}
+
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	for _, domain := range domains {
+		host, _, _ := strings.Cut(strings.ToLower(domain), "/")
+		if host == "" {
+			continue
+		}
+		if host == hostname {
+			return true
+		}
+		if base, ok := strings.CutPrefix(host, "*."); ok && strings.HasSuffix(hostname, "."+base) {
+			return true
+		}
+	}
+	return false
+}
+
+// PruneAccessApplications deletes the AccessApplications in namespace that match
+// labels and are controlled by owner, except those named in keep. Their
+// finalizer removes the applications from Cloudflare unless their deletion
+// policy is Orphan. With an empty keep it removes them all.
+func PruneAccessApplications(
+	ctx context.Context,
+	c client.Client,
+	owner metav1.Object,
+	labels client.MatchingLabels,
+	keep map[string]bool,
+) ([]string, error) {
+	list := &networkingv1alpha2.AccessApplicationList{}
+	if err := c.List(ctx, list, client.InNamespace(owner.GetNamespace()), labels); err != nil {
+		return nil, fmt.Errorf("list AccessApplications: %w", err)
+	}
+
+	var pruned []string
+	var errs []error
+	for i := range list.Items {
+		app := &list.Items[i]
+		if keep[app.Name] || !metav1.IsControlledBy(app, owner) {
+			continue
+		}
+		if err := c.Delete(ctx, app); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete AccessApplication %s: %w", app.Name, err))
+			continue
+		}
+		pruned = append(pruned, app.Spec.Domain)
+	}
+	return pruned, errors.Join(errs...)
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// AutoDNSAnnotation enables ("true") or disables ("false") automatic DNS records
+// for the hostnames of an Ingress or Gateway, overriding the class's dnsManagement.
+const AutoDNSAnnotation = "cloudflare-operator.io/auto-dns"
+
+// managedTXTSuffix is appended to a CNAME DNSRecord name to name its TXT marker.
+const managedTXTSuffix = "-txt"
+
+// AutoDNSEnabled returns whether DNS records are managed for an object, given
+// the dnsManagement mode of its class. The AutoDNSAnnotation takes precedence.
+func AutoDNSEnabled(obj metav1.Object, mode networkingv1alpha2.DNSManagementMode) bool {
+	switch obj.GetAnnotations()[AutoDNSAnnotation] {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return mode != networkingv1alpha2.DNSManagementManual
+}
+
+// SanitizeHostname converts a hostname to a Kubernetes resource name fragment.
+// A leading wildcard becomes "wildcard" so *.example.com and example.com map to
+// different names.
+func SanitizeHostname(hostname string) string {
+	name := strings.ToLower(strings.TrimSuffix(hostname, "."))
+	if rest, ok := strings.CutPrefix(name, "*."); ok {
+		name = "wildcard." + rest
+	}
+	name = strings.ReplaceAll(name, ".", "-")
+
+	var b strings.Builder
+	for _, r := range name {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			b.WriteRune(r)
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+// ManagedTXTName returns the name of the TXT record that marks the DNS record of
+// hostname as managed by the operator. Wildcard hostnames have no marker, as a
+// wildcard may only be the leftmost label of a record name.
+func ManagedTXTName(hostname string) string {
+	if strings.HasPrefix(hostname, "*") {
+		return ""
+	}
+	return cf.TXT_PREFIX + strings.TrimSuffix(hostname, ".")
+}
+
+// ManagedTXTRecord returns the DNSRecord of the TXT marker for a CNAME DNSRecord
+// pointing at the tunnel. The marker uses the same format as TunnelBinding, so
+// both recognize the record as belonging to the tunnel. Callers only write the
+// marker once dnsID, the CNAME's record ID, is known, and keep an existing marker
+// until then. Nil is returned for wildcard hostnames.
+func ManagedTXTRecord(cname *networkingv1alpha2.DNSRecord, tunnel Interface, dnsID string) *networkingv1alpha2.DNSRecord {
+	txtName := ManagedTXTName(cname.Spec.Name)
+	if txtName == "" {
+		return nil
+	}
+
+	content, _ := json.Marshal(cf.DnsManagedRecordTxt{
+		DnsId:      dnsID,
+		TunnelName: tunnel.GetStatus().TunnelName,
+		TunnelId:   tunnel.GetStatus().TunnelId,
+	})
+
+	name := cname.Name
+	if maxLen := 63 - len(managedTXTSuffix); len(name) > maxLen {
+		name = strings.TrimRight(name[:maxLen], "-")
+	}
+
+	return &networkingv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name + managedTXTSuffix,
+			Namespace:       cname.Namespace,
+			Labels:          maps.Clone(cname.Labels),
+			OwnerReferences: slices.Clone(cname.OwnerReferences),
+		},
+		Spec: networkingv1alpha2.DNSRecordSpec{
+			Name:       txtName,
+			Type:       "TXT",
+			Content:    string(content),
+			TTL:        1,
+			Comment:    cname.Spec.Comment,
+			Cloudflare: cname.Spec.Cloudflare,
+		},
+	}
+}
+
+// PruneDNSRecords deletes the DNSRecords in namespace that match labels and are
+// controlled by owner, except those named in keep. It removes the records of
+// hostnames an object no longer uses; with an empty keep it removes them all.
+func PruneDNSRecords(
+	ctx context.Context,
+	c client.Client,
+	owner metav1.Object,
+	labels client.MatchingLabels,
+	keep map[string]bool,
+) ([]string, error) {
+	list := &networkingv1alpha2.DNSRecordList{}
+	if err := c.List(ctx, list, client.InNamespace(owner.GetNamespace()), labels); err != nil {
+		return nil, fmt.Errorf("list DNSRecords: %w", err)
+	}
+
+	var pruned []string
+	var errs []error
+	for i := range list.Items {
+		record := &list.Items[i]
+		if keep[record.Name] || !metav1.IsControlledBy(record, owner) {
+			continue
+		}
+		if err := c.Delete(ctx, record); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete DNSRecord %s: %w", record.Name, err))
+			continue
+		}
+		pruned = append(pruned, record.Spec.Name)
+	}
+	return pruned, errors.Join(errs...)
+}
+
+// DNSRecordChangedPredicate passes DNSRecord updates that change the spec or the
+// Cloudflare record ID. Owners of DNSRecords need the record ID to write the TXT
+// markers; other status updates, such as the periodic sync time, are ignored.
+func DNSRecordChangedPredicate() predicate.Predicate {
+	return predicate.Or(predicate.GenerationChangedPredicate{}, predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRecord, oldOK := e.ObjectOld.(*networkingv1alpha2.DNSRecord)
+			newRecord, newOK := e.ObjectNew.(*networkingv1alpha2.DNSRecord)
+			return oldOK && newOK && oldRecord.Status.RecordID != newRecord.Status.RecordID
+		},
+	})
+}
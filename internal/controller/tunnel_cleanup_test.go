@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/service"
+	tunnelsvc "github.com/StringKe/cloudflare-operator/internal/service/tunnel"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// newRouteCleanupTest serves the mock API, answering the first ignoredDeletes route
+// deletions with success without deleting anything, as an eventually consistent API would.
+func newRouteCleanupTest(t *testing.T, ignoredDeletes int32) (*TunnelReconciler, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	var deletes atomic.Int32
+	handler := mock.Handler()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodDelete && strings.Contains(req.URL.Path, "/teamnet/routes/") &&
+			deletes.Add(1) <= ignoredDeletes {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{}}`))
+			return
+		}
+		handler.ServeHTTP(w, req)
+	}))
+	t.Cleanup(server.Close)
+
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{Network: "10.0.0.0/8", TunnelID: "tunnel-a"})
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{Network: "192.168.0.0/16", TunnelID: "tunnel-a"})
+	mock.Store().CreateTunnelRoute(&models.TunnelRoute{Network: "172.16.0.0/12", TunnelID: "tunnel-b"})
+	return newTunnelAPITest(t, server.URL, "tunnel-a"), mock
+}
+
+// markDeleted sets the deletion timestamp of the tunnel under test to deletedAgo in the past.
+func markDeleted(r *TunnelReconciler, deletedAgo time.Duration) {
+	r.tunnel.GetObject().SetDeletionTimestamp(&metav1.Time{Time: time.Now().Add(-deletedAgo)})
+}
+
+func TestVerifyTunnelRoutesRemoved_NoRoutesLeft(t *testing.T) {
+	r, mock := newRouteCleanupTest(t, 0)
+	markDeleted(r, time.Minute)
+	mock.Store().DeleteTunnelRoute("10.0.0.0/8", "")
+	mock.Store().DeleteTunnelRoute("192.168.0.0/16", "")
+
+	remaining, retry := verifyTunnelRoutesRemoved(r, "tunnel-a")
+	assert.Empty(t, remaining)
+	assert.False(t, retry)
+	assert.Empty(t, r.Recorder.(*record.FakeRecorder).Events)
+	assert.Len(t, mock.Store().ListTunnelRoutes("tunnel-b", ""), 1, "routes of other tunnels are kept")
+}
+
+func TestVerifyTunnelRoutesRemoved_RetriesRemainingRoutes(t *testing.T) {
+	// The first cleanup is ignored, the second one removes the routes
+	r, mock := newRouteCleanupTest(t, 2)
+	markDeleted(r, time.Minute)
+
+	remaining, retry := verifyTunnelRoutesRemoved(r, "tunnel-a")
+	assert.ElementsMatch(t, []string{"10.0.0.0/8", "192.168.0.0/16"}, remaining)
+	assert.True(t, retry)
+	assert.Len(t, mock.Store().ListTunnelRoutes("tunnel-a", ""), 2)
+
+	_, retry = verifyTunnelRoutesRemoved(r, "tunnel-a")
+	assert.True(t, retry)
+	assert.Empty(t, mock.Store().ListTunnelRoutes("tunnel-a", ""))
+
+	remaining, retry = verifyTunnelRoutesRemoved(r, "tunnel-a")
+	assert.Empty(t, remaining)
+	assert.False(t, retry)
+	assert.Len(t, mock.Store().ListTunnelRoutes("tunnel-b", ""), 1, "routes of other tunnels are kept")
+	assert.Empty(t, r.Recorder.(*record.FakeRecorder).Events)
+}
+
+func TestVerifyTunnelRoutesRemoved_ReportsOrphanedRoutes(t *testing.T) {
+	r, mock := newRouteCleanupTest(t, 0)
+	markDeleted(r, DefaultDeletionTimeout+time.Minute)
+
+	remaining, retry := verifyTunnelRoutesRemoved(r, "tunnel-a")
+
+	assert.ElementsMatch(t, []string{"10.0.0.0/8", "192.168.0.0/16"}, remaining)
+	assert.False(t, retry)
+	assert.Len(t, mock.Store().ListTunnelRoutes("tunnel-a", ""), 2, "no cleanup after the deletion timeout")
+	event := <-r.Recorder.(*record.FakeRecorder).Events
+	assert.Contains(t, event, "OrphanedRoutes")
+	assert.Contains(t, event, "10.0.0.0/8")
+	assert.Contains(t, event, "192.168.0.0/16")
+}
+
+// newLifecycleFailureTest returns a deleted tunnel whose lifecycle SyncState reports a failed deletion.
+func newLifecycleFailureTest(t *testing.T) *TunnelReconciler {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+
+	tunnel := &networkingv1alpha2.Tunnel{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "tunnel",
+			Namespace:  "default",
+			Finalizers: []string{tunnelFinalizer},
+		},
+		Spec:   networkingv1alpha2.TunnelSpec{NewTunnel: &networkingv1alpha2.NewTunnel{Name: "tunnel-a"}},
+		Status: networkingv1alpha2.TunnelStatus{TunnelId: "tunnel-a"},
+	}
+	syncState := &networkingv1alpha2.CloudflareSyncState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: service.SyncStateName(tunnelsvc.LifecycleResourceType, tunnelsvc.GetSyncStateName("tunnel-a")),
+		},
+		Status: networkingv1alpha2.CloudflareSyncStateStatus{
+			SyncStatus: networkingv1alpha2.SyncStatusError,
+			Error:      "tunnel has active connections",
+		},
+	}
+	r := newTunnelAPITest(t, server.URL, "tunnel-a", syncState)
+	require.NoError(t, r.Client.Delete(r.ctx, r.tunnel.GetObject()))
+	require.NoError(t, r.Client.Create(r.ctx, tunnel))
+	require.NoError(t, r.Client.Delete(r.ctx, tunnel))
+	require.NoError(t, r.Client.Get(r.ctx, client.ObjectKeyFromObject(tunnel), tunnel))
+	r.tunnel = TunnelAdapter{Tunnel: tunnel}
+	return r
+}
+
+func TestCleanupTunnel_RetriesFailedDeletion(t *testing.T) {
+	r := newLifecycleFailureTest(t)
+
+	result, done, err := cleanupTunnel(r)
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.NotZero(t, result.RequeueAfter)
+	assert.Contains(t, r.tunnel.GetObject().GetFinalizers(), tunnelFinalizer)
+
+	// The failed request is removed, so the deletion is requested again
+	syncStates := &networkingv1alpha2.CloudflareSyncStateList{}
+	require.NoError(t, r.Client.List(r.ctx, syncStates))
+	assert.Empty(t, syncStates.Items)
+	assert.Contains(t, tunnelEvents(r), EventReasonDeleteFailed)
+}
+
+func TestCleanupTunnel_ForcesDeletionAfterTimeout(t *testing.T) {
+	timeout := DefaultDeletionTimeout
+	DefaultDeletionTimeout = 0
+	t.Cleanup(func() { DefaultDeletionTimeout = timeout })
+	r := newLifecycleFailureTest(t)
+
+	_, done, err := cleanupTunnel(r)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.NotContains(t, r.tunnel.GetObject().GetFinalizers(), tunnelFinalizer)
+	assert.Contains(t, tunnelEvents(r), EventReasonForcedDeletion)
+}
+
+// tunnelEvents returns the events recorded so far, joined by newlines.
+func tunnelEvents(r *TunnelReconciler) string {
+	var events []string
+	for {
+		select {
+		case event := <-r.Recorder.(*record.FakeRecorder).Events:
+			events = append(events, event)
+		default:
+			return strings.Join(events, "\n")
+		}
+	}
+}
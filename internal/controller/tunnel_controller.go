@@ -7,9 +7,11 @@ import (
 	"context"
 
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -101,6 +103,8 @@ func (r *TunnelReconciler) initStruct(ctx context.Context, tunnel Tunnel) error
 	if r.cfAPI, r.cfSecret, err = getAPIDetails(r.ctx, r.Client, r.log, r.tunnel.GetSpec(), r.tunnel.GetStatus(), r.tunnel.GetNamespace()); err != nil {
 		r.log.Error(err, "unable to get API details")
 		r.Recorder.Event(r.tunnel.GetObject(), corev1.EventTypeWarning, "ErrSpecSecret", "Error reading Secret to configure API")
+		setTunnelState(r, "error", metav1.ConditionFalse, common.ReasonCredentialsInvalid,
+			"Error reading Secret to configure API: "+cf.SanitizeErrorMessage(err))
 		return err
 	}
 
@@ -160,11 +164,11 @@ func (r *TunnelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *TunnelReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.Recorder = mgr.GetEventRecorderFor("cloudflare-operator")
+	r.Recorder = common.NewDedupRecorder(mgr.GetEventRecorderFor("cloudflare-operator"), common.DefaultEventDedupWindow)
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.Tunnel{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&corev1.Secret{}).
 		Owns(&appsv1.Deployment{}).
-		Complete(r)
+		Complete(ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.Tunnel{}, r))
 }
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
+	"github.com/StringKe/cloudflare-operator/internal/credentials"
+)
+
+func TestTunnelConfigNamespace_UsesConfiguredOperatorNamespace(t *testing.T) {
+	previous, previousSecretNamespace := common.OperatorNamespace, credentials.DefaultSecretNamespace
+	t.Cleanup(func() {
+		common.OperatorNamespace = previous
+		credentials.DefaultSecretNamespace = previousSecretNamespace
+	})
+	common.SetOperatorNamespace("custom-system")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterTunnel := &networkingv1alpha2.ClusterTunnel{ObjectMeta: metav1.ObjectMeta{Name: "cluster-tunnel", UID: "uid"}}
+	r := &ClusterTunnelReconciler{
+		Client: c,
+		Scheme: scheme,
+		ctx:    context.Background(),
+		log:    logr.Discard(),
+		tunnel: ClusterTunnelAdapter{Tunnel: clusterTunnel},
+		cfAPI:  &cf.API{ValidAccountId: "account"},
+	}
+
+	require.NoError(t, writeTunnelSettingsToConfigMap(r, "tunnel-id", kindClusterTunnel, true, "", networkingv1alpha2.CredentialsReference{}))
+
+	cm := &corev1.ConfigMap{}
+	assert.NoError(t, c.Get(context.Background(),
+		types.NamespacedName{Namespace: "custom-system", Name: tunnelconfig.ConfigMapName("tunnel-id")}, cm))
+
+	// A namespaced Tunnel keeps its configuration in its own namespace
+	tunnel := &networkingv1alpha2.Tunnel{ObjectMeta: metav1.ObjectMeta{Name: "tunnel", Namespace: "apps"}}
+	assert.Equal(t, "apps", tunnelConfigNamespace(TunnelAdapter{Tunnel: tunnel}))
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+func newTunnelStatusTest(t *testing.T) (*TunnelReconciler, client.Client, *networkingv1alpha2.Tunnel) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	tunnel := &networkingv1alpha2.Tunnel{
+		ObjectMeta: metav1.ObjectMeta{Name: "tunnel", Namespace: "default", Generation: 1},
+		Spec: networkingv1alpha2.TunnelSpec{
+			Cloudflare: networkingv1alpha2.CloudflareDetails{
+				CredentialsRef: &networkingv1alpha2.CloudflareCredentialsRef{Name: "missing"},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tunnel).WithStatusSubresource(tunnel).Build()
+
+	return &TunnelReconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(20),
+		ctx:      context.Background(),
+		log:      logr.Discard(),
+		cfAPI:    &cf.API{ValidAccountId: "account", ValidTunnelId: "tunnel-id", ValidTunnelName: "tunnel"},
+	}, c, tunnel
+}
+
+func readyCondition(t *testing.T, status networkingv1alpha2.TunnelStatus) *metav1.Condition {
+	t.Helper()
+	cond := meta.FindStatusCondition(status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	return cond
+}
+
+func TestTunnelStatus_ConditionReasons(t *testing.T) {
+	r, _, tunnel := newTunnelStatusTest(t)
+	r.tunnel = TunnelAdapter{Tunnel: tunnel}
+
+	applyTunnelStatusCreating(r)
+	cond := readyCondition(t, tunnel.Status)
+	assert.Equal(t, "creating", tunnel.Status.State)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, common.ReasonCreating, cond.Reason)
+
+	applyTunnelStatusActive(r)
+	cond = readyCondition(t, tunnel.Status)
+	assert.Equal(t, "active", tunnel.Status.State)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, common.ReasonReady, cond.Reason)
+}
+
+func TestTunnelInitStruct_CredentialsInvalid(t *testing.T) {
+	r, c, tunnel := newTunnelStatusTest(t)
+
+	require.Error(t, r.initStruct(context.Background(), TunnelAdapter{Tunnel: tunnel}))
+
+	updated := &networkingv1alpha2.Tunnel{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(tunnel), updated))
+	cond := readyCondition(t, updated.Status)
+	assert.Equal(t, "error", updated.Status.State)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, common.ReasonCredentialsInvalid, cond.Reason)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newTunnelTokenTest(t *testing.T, tunnelID string, objs ...client.Object) *TunnelReconciler {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	mock.Store().CreateTunnel(&models.Tunnel{ID: "tunnel-a", Name: "a", AccountTag: "test-account-id", TunnelSecret: "secret"})
+	return newTunnelAPITest(t, server.URL, tunnelID, objs...)
+}
+
+// newTunnelAPITest returns a TunnelReconciler whose Cloudflare API client talks to serverURL.
+func newTunnelAPITest(t *testing.T, serverURL, tunnelID string, objs ...client.Object) *TunnelReconciler {
+	t.Helper()
+	cfClient, err := cloudflare.NewWithAPIToken("test-token", cloudflare.BaseURL(serverURL+"/client/v4"))
+	require.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	tunnel := &networkingv1alpha2.Tunnel{
+		ObjectMeta: metav1.ObjectMeta{Name: "tunnel", Namespace: "default"},
+		Status:     networkingv1alpha2.TunnelStatus{TunnelId: tunnelID},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, tunnel)...).Build()
+
+	return &TunnelReconciler{
+		Client:   c,
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(20),
+		ctx:      context.Background(),
+		log:      logr.Discard(),
+		tunnel:   TunnelAdapter{Tunnel: tunnel},
+		cfAPI:    &cf.API{Log: logr.Discard(), CloudflareClient: cfClient, ValidAccountId: "test-account-id"},
+	}
+}
+
+func TestGetTunnelToken_RecoversLostSecret(t *testing.T) {
+	r := newTunnelTokenTest(t, "tunnel-a")
+
+	token, err := getTunnelToken(r)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Contains(t, <-r.Recorder.(*record.FakeRecorder).Events, "RecoveredToken")
+}
+
+func TestGetTunnelToken_PrefersExistingSecret(t *testing.T) {
+	r := newTunnelTokenTest(t, "unknown-tunnel", &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "tunnel-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("stored-token")},
+	})
+
+	// The tunnel is unknown to Cloudflare, so any API call would fail
+	token, err := getTunnelToken(r)
+	require.NoError(t, err)
+	assert.Equal(t, "stored-token", token)
+	assert.Empty(t, r.Recorder.(*record.FakeRecorder).Events)
+}
+
+func TestGetTunnelToken_NoTunnelID(t *testing.T) {
+	r := newTunnelTokenTest(t, "")
+
+	_, err := getTunnelToken(r)
+	assert.ErrorContains(t, err, "tunnel token not found")
+}
+
+func TestGetTunnelToken_RecoveryFails(t *testing.T) {
+	r := newTunnelTokenTest(t, "unknown-tunnel")
+
+	_, err := getTunnelToken(r)
+	assert.ErrorContains(t, err, "re-fetching it failed")
+}
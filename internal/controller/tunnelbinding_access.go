@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
+	tunnelpkg "github.com/StringKe/cloudflare-operator/internal/controller/tunnel"
+)
+
+// tunnelBindingNameLabel records the TunnelBinding an AccessApplication was created for.
+const tunnelBindingNameLabel = "cloudflare-operator.io/tunnelbinding-name"
+
+// accessApplicationLabels returns the labels of the AccessApplications created for the binding.
+func (r *TunnelBindingReconciler) accessApplicationLabels() client.MatchingLabels {
+	return client.MatchingLabels{tunnelBindingNameLabel: r.binding.Name}
+}
+
+// reconcileAccess protects the hostnames of the subjects with an access setting with
+// Cloudflare Access. It runs once the subjects are configured on the tunnel and their
+// DNS records exist, so a hostname is routable before Access is attached to it.
+// AccessApplications of hostnames that are no longer protected are deleted.
+func (r *TunnelBindingReconciler) reconcileAccess() error {
+	keep := make(map[string]bool)
+	var errs []error
+	for i, subject := range r.binding.Subjects {
+		access := subject.Spec.Access
+		if access == nil || i >= len(r.binding.Status.Subjects) {
+			continue
+		}
+		status := r.binding.Status.Subjects[i]
+
+		if access.ApplicationRef != "" {
+			if err := tunnelpkg.CheckAccessApplication(r.ctx, r.Client, r.binding.Namespace,
+				access.ApplicationRef, status.Hostname); err != nil {
+				errs = append(errs, fmt.Errorf("subject %s: %w", subject.Name, err))
+			}
+			continue
+		}
+
+		name := tunnelpkg.AccessApplicationName(r.binding.Name, status.Hostname)
+		keep[name] = true
+		if !status.Configured {
+			// Access is attached once the hostname is routed; an existing application is kept
+			continue
+		}
+
+		app := tunnelpkg.AccessApplicationFor(name, r.binding.Namespace, status.Hostname, tunnelpkg.AccessOptions{
+			Policies:       access.Policies,
+			DeletionPolicy: access.DeletionPolicy,
+		}, r.cloudflareConfig)
+		app.Labels = r.accessApplicationLabels()
+		if err := ctrl.SetControllerReference(r.binding, app, r.Scheme); err != nil {
+			errs = append(errs, fmt.Errorf("set owner ref for %s: %w", name, err))
+			continue
+		}
+		if err := tunnelpkg.EnsureAccessApplication(r.ctx, r.Client, r.binding, app); err != nil {
+			errs = append(errs, fmt.Errorf("subject %s: %w", subject.Name, err))
+		}
+	}
+
+	pruned, err := tunnelpkg.PruneAccessApplications(r.ctx, r.Client, r.binding, r.accessApplicationLabels(), keep)
+	if len(pruned) > 0 {
+		r.log.Info("Removed AccessApplications of hostnames no longer protected", "hostnames", pruned)
+	}
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedAccess",
+			fmt.Sprintf("Failed to protect %d hostnames with Cloudflare Access", len(errs)))
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// cleanupAccess deletes the AccessApplications created for the binding. Their finalizer
+// removes the applications from Cloudflare unless their deletion policy is Orphan.
+func (r *TunnelBindingReconciler) cleanupAccess() error {
+	pruned, err := tunnelpkg.PruneAccessApplications(r.ctx, r.Client, r.binding, r.accessApplicationLabels(), nil)
+	if len(pruned) > 0 {
+		r.log.Info("Deleted AccessApplications of the TunnelBinding", "hostnames", pruned)
+	}
+	return err
+}
+
+// findTunnelBindingsForAccessApplication returns the TunnelBindings in the namespace of an
+// AccessApplication whose subjects reference it with access.applicationRef, so a change
+// to the application is checked against their hostnames.
+func (r *TunnelBindingReconciler) findTunnelBindingsForAccessApplication(
+	ctx context.Context,
+	obj client.Object,
+) []reconcile.Request {
+	bindings := &networkingv1alpha1.TunnelBindingList{}
+	if err := r.List(ctx, bindings, client.InNamespace(obj.GetNamespace())); err != nil {
+		ctrllog.FromContext(ctx).Error(err, "Failed to list TunnelBindings for AccessApplication watch")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, binding := range bindings.Items {
+		for _, subject := range binding.Subjects {
+			if subject.Spec.Access != nil && subject.Spec.Access.ApplicationRef == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&binding)})
+				break
+			}
+		}
+	}
+	return requests
+}
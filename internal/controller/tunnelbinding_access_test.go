@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// setSubjectAccess sets the access setting of the binding's subject i.
+func setSubjectAccess(t *testing.T, r *TunnelBindingReconciler, c client.Client, i int, access *networkingv1alpha1.TunnelBindingAccess) {
+	t.Helper()
+	r.binding.Subjects[i].Spec.Access = access
+	require.NoError(t, c.Update(context.Background(), r.binding))
+}
+
+// bindingAccessApplications returns the AccessApplications of the binding's namespace by name.
+func bindingAccessApplications(t *testing.T, c client.Client) map[string]*networkingv1alpha2.AccessApplication {
+	t.Helper()
+	list := &networkingv1alpha2.AccessApplicationList{}
+	require.NoError(t, c.List(context.Background(), list, client.InNamespace("default")))
+	apps := make(map[string]*networkingv1alpha2.AccessApplication, len(list.Items))
+	for i := range list.Items {
+		apps[list.Items[i].Name] = &list.Items[i]
+	}
+	return apps
+}
+
+func TestTunnelBindingAccess_CreatedOnceRoutable(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	setBindingSubjects(t, r, c, "web", "api")
+	policies := &networkingv1alpha1.TunnelBindingAccess{Policies: []string{"employees"}, DeletionPolicy: "Orphan"}
+	setSubjectAccess(t, r, c, 0, policies)
+	setSubjectAccess(t, r, c, 1, policies)
+
+	// Nothing is routed yet
+	require.NoError(t, r.reconcileAccess())
+	assert.Empty(t, bindingAccessApplications(t, c))
+
+	syncBindingSubjects(t, r, c)
+	require.NoError(t, r.creationLogic())
+	require.NoError(t, r.reconcileAccess())
+
+	// Only the configured subject is protected; the api Service does not exist
+	apps := bindingAccessApplications(t, c)
+	require.Len(t, apps, 1)
+	app := apps["app-web-example-com"]
+	require.NotNil(t, app)
+	assert.Equal(t, "web.example.com", app.Spec.Domain)
+	assert.Equal(t, "self_hosted", app.Spec.Type)
+	assert.Equal(t, "Orphan", app.Spec.DeletionPolicy)
+	assert.Equal(t, []networkingv1alpha2.ReusablePolicyRef{{Name: "employees"}}, app.Spec.ReusablePolicyRefs)
+	assert.Equal(t, "app", app.Labels[tunnelBindingNameLabel])
+	assert.True(t, metav1.IsControlledBy(app, r.binding))
+
+	// Once the api Service exists, its hostname is protected too
+	require.NoError(t, c.Create(ctx, bindingService("api", corev1.ServicePort{Port: 8080, Protocol: corev1.ProtocolTCP})))
+	syncBindingSubjects(t, r, c)
+	require.NoError(t, r.reconcileAccess())
+	assert.Contains(t, bindingAccessApplications(t, c), "app-api-example-com")
+}
+
+func TestTunnelBindingAccess_PrunesUnprotectedHostnames(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	require.NoError(t, c.Create(ctx, bindingService("api", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	setBindingSubjects(t, r, c, "web", "api")
+	setSubjectAccess(t, r, c, 0, &networkingv1alpha1.TunnelBindingAccess{Policies: []string{"employees"}})
+	setSubjectAccess(t, r, c, 1, &networkingv1alpha1.TunnelBindingAccess{Policies: []string{"employees"}})
+	syncBindingSubjects(t, r, c)
+	require.NoError(t, r.reconcileAccess())
+	require.Len(t, bindingAccessApplications(t, c), 2)
+
+	// An AccessApplication of another binding is never pruned
+	other := &networkingv1alpha2.AccessApplication{ObjectMeta: metav1.ObjectMeta{
+		Name: "other", Namespace: "default", Labels: map[string]string{tunnelBindingNameLabel: "app"},
+	}}
+	require.NoError(t, c.Create(ctx, other))
+
+	setSubjectAccess(t, r, c, 1, nil)
+	syncBindingSubjects(t, r, c)
+	require.NoError(t, r.reconcileAccess())
+
+	apps := bindingAccessApplications(t, c)
+	assert.Contains(t, apps, "app-web-example-com")
+	assert.NotContains(t, apps, "app-api-example-com")
+	assert.Contains(t, apps, "other")
+}
+
+func TestTunnelBindingAccess_ApplicationRef(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	require.NoError(t, c.Create(ctx, &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"},
+		Spec:       networkingv1alpha2.AccessApplicationSpec{Domain: "*.example.com", Type: "self_hosted"},
+	}))
+	require.NoError(t, c.Create(ctx, &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "elsewhere", Namespace: "default"},
+		Spec:       networkingv1alpha2.AccessApplicationSpec{Domain: "web.example.org", Type: "self_hosted"},
+	}))
+	setBindingSubjects(t, r, c, "web")
+	setSubjectAccess(t, r, c, 0, &networkingv1alpha1.TunnelBindingAccess{ApplicationRef: "internal"})
+	syncBindingSubjects(t, r, c)
+
+	require.NoError(t, r.reconcileAccess())
+	assert.Len(t, bindingAccessApplications(t, c), 2, "no AccessApplication is created for a reference")
+
+	setSubjectAccess(t, r, c, 0, &networkingv1alpha1.TunnelBindingAccess{ApplicationRef: "elsewhere"})
+	err := r.reconcileAccess()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessApplication elsewhere does not protect web.example.com")
+
+	setSubjectAccess(t, r, c, 0, &networkingv1alpha1.TunnelBindingAccess{ApplicationRef: "missing"})
+	assert.Error(t, r.reconcileAccess())
+}
+
+func TestTunnelBindingAccess_UnmanagedApplicationIsNotTakenOver(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	require.NoError(t, c.Create(ctx, &networkingv1alpha2.AccessApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-web-example-com", Namespace: "default"},
+		Spec:       networkingv1alpha2.AccessApplicationSpec{Domain: "web.example.com", Type: "self_hosted"},
+	}))
+	setBindingSubjects(t, r, c, "web")
+	setSubjectAccess(t, r, c, 0, &networkingv1alpha1.TunnelBindingAccess{Policies: []string{"employees"}})
+	syncBindingSubjects(t, r, c)
+
+	err := r.reconcileAccess()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not managed by app")
+
+	app := bindingAccessApplications(t, c)["app-web-example-com"]
+	assert.Empty(t, app.Spec.ReusablePolicyRefs)
+}
+
+func TestTunnelBindingAccess_DeletedWithBinding(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	setBindingSubjects(t, r, c, "web")
+	setSubjectAccess(t, r, c, 0, &networkingv1alpha1.TunnelBindingAccess{Policies: []string{"employees"}})
+	syncBindingSubjects(t, r, c)
+	require.NoError(t, r.creationLogic())
+	require.NoError(t, r.reconcileAccess())
+	require.Len(t, bindingAccessApplications(t, c), 1)
+
+	require.NoError(t, c.Delete(ctx, r.binding))
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(r.binding), r.binding))
+	require.NoError(t, r.deletionLogic())
+
+	assert.Empty(t, bindingAccessApplications(t, c))
+	err := c.Get(ctx, client.ObjectKeyFromObject(r.binding), &networkingv1alpha1.TunnelBinding{})
+	assert.True(t, apierrors.IsNotFound(err), "the finalizer is removed once Access is cleaned up")
+}
+
+func TestFindTunnelBindingsForAccessApplication(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	setBindingSubjects(t, r, c, "web", "api")
+	app := &networkingv1alpha2.AccessApplication{ObjectMeta: metav1.ObjectMeta{Name: "internal", Namespace: "default"}}
+
+	assert.Empty(t, r.findTunnelBindingsForAccessApplication(ctx, app))
+
+	setSubjectAccess(t, r, c, 1, &networkingv1alpha1.TunnelBindingAccess{ApplicationRef: "internal"})
+	requests := r.findTunnelBindingsForAccessApplication(ctx, app)
+	require.Len(t, requests, 1)
+	assert.Equal(t, client.ObjectKeyFromObject(r.binding), requests[0].NamespacedName)
+}
@@ -16,10 +16,15 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -167,9 +172,11 @@ func (r *TunnelBindingReconciler) createTemporaryAPIClient() (*cf.API, error) {
 		return nil, fmt.Errorf("failed to create API client: %w", err)
 	}
 
-	// Set the accountID and domain from resolved values
+	// Set the accountID, domain and tunnel from resolved values.
+	// The tunnel ID is compared with the managed TXT marker to decide DNS ownership.
 	api.ValidAccountId = r.accountID
 	api.Domain = r.domain
+	api.ValidTunnelId = r.tunnelID
 
 	return api, nil
 }
@@ -182,6 +189,8 @@ func (r *TunnelBindingReconciler) createTemporaryAPIClient() (*cf.API, error) {
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=clustertunnels,verbs=get
 // +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=clustertunnels/status,verbs=get
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=accessapplications,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -241,19 +250,35 @@ func (r *TunnelBindingReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
+	// The labels select this binding when its tunnel's configuration is built
+	if err := r.ensureLabels(); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Sync configuration to Cloudflare API
 	// In token mode, cloudflared pulls configuration from cloud automatically
 	r.Recorder.Event(tunnelBinding, corev1.EventTypeNormal, "Configuring", "Syncing configuration to Cloudflare API")
 	if err := r.configureCloudflareDaemon(); err != nil {
 		r.log.Error(err, "unable to sync tunnel configuration to API")
 		r.Recorder.Event(tunnelBinding, corev1.EventTypeWarning, "FailedConfigure", "Failed to sync configuration to Cloudflare API")
+		if statusErr := r.setReadyCondition(err); statusErr != nil {
+			r.log.Error(statusErr, "Failed to update TunnelBinding ready condition")
+		}
 		return ctrl.Result{}, err
 	}
 	r.Recorder.Event(tunnelBinding, corev1.EventTypeNormal, "Configured", "Synced Cloudflare Tunnel configuration")
+	if err := r.setReadyCondition(nil); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	if err := r.creationLogic(); err != nil {
 		return ctrl.Result{}, err
 	}
+
+	// Attach Access only once the hostnames are routable
+	if err := r.reconcileAccess(); err != nil {
+		return ctrl.Result{}, err
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -261,16 +286,28 @@ func (r *TunnelBindingReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 // This implements the PR #166 fix using annotations instead of struct fields for concurrency safety.
 func (r *TunnelBindingReconciler) setStatus() ([]string, error) {
 	status := make([]networkingv1alpha1.ServiceInfo, 0, len(r.binding.Subjects))
+	subjects := make([]networkingv1alpha1.TunnelBindingSubjectStatus, 0, len(r.binding.Subjects))
 	currentHostnames := make(map[string]struct{})
 	var hostnamesStr string
 
-	for _, sub := range r.binding.Subjects {
+	for i, sub := range r.binding.Subjects {
 		hostname, target, err := r.getConfigForSubject(sub)
+		subject := networkingv1alpha1.TunnelBindingSubjectStatus{Name: sub.Name, Hostname: hostname, Resolved: err == nil}
 		if err != nil {
 			r.log.Error(err, "error getting config for service", "svc", sub.Name)
 			r.Recorder.Event(r.binding, corev1.EventTypeWarning, "ErrBuildConfig",
 				fmt.Sprintf("Error building TunnelBinding configuration, svc: %s", sub.Name))
+			subject.Message = err.Error()
+		} else {
+			subject.Service = target
+			// Keep the result of the last configuration write until the next one
+			if i < len(r.binding.Status.Subjects) {
+				previous := r.binding.Status.Subjects[i]
+				subject.Configured = previous.Configured && previous.Name == sub.Name &&
+					previous.Hostname == hostname && previous.Service == target
+			}
 		}
+		subjects = append(subjects, subject)
 		status = append(status, networkingv1alpha1.ServiceInfo{Hostname: hostname, Target: target})
 		currentHostnames[hostname] = struct{}{}
 		hostnamesStr += hostname + ","
@@ -296,11 +333,13 @@ func (r *TunnelBindingReconciler) setStatus() ([]string, error) {
 	}
 
 	r.binding.Status.Services = status
+	r.binding.Status.Subjects = subjects
 	r.binding.Status.Hostnames = strings.TrimSuffix(hostnamesStr, ",")
 
 	// P0 FIX: Use retry logic for status update to handle conflicts
 	if err := UpdateStatusWithConflictRetry(r.ctx, r.Client, r.binding, func() {
 		r.binding.Status.Services = status
+		r.binding.Status.Subjects = subjects
 		r.binding.Status.Hostnames = strings.TrimSuffix(hostnamesStr, ",")
 	}); err != nil {
 		r.log.Error(err, "Failed to update TunnelBinding status", "TunnelBinding.Namespace", r.binding.Namespace, "TunnelBinding.Name", r.binding.Name)
@@ -348,6 +387,10 @@ func (r *TunnelBindingReconciler) deletionLogic() error {
 				errs = append(errs, fmt.Errorf("delete DNS %s: %w", info.Hostname, err))
 			}
 		}
+		// Access is removed last, once the hostnames are no longer routed
+		if err := r.cleanupAccess(); err != nil {
+			errs = append(errs, err)
+		}
 		if len(errs) > 0 {
 			aggregatedErr := errors.Join(errs...)
 			r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FinalizerNotUnset",
@@ -370,13 +413,23 @@ func (r *TunnelBindingReconciler) deletionLogic() error {
 	return nil
 }
 
-func (r *TunnelBindingReconciler) creationLogic() error {
+// ensureLabels adds the labels that select the binding for its tunnel's configuration.
+func (r *TunnelBindingReconciler) ensureLabels() error {
+	labels := labelsForBinding(*r.binding)
+	missing := false
+	for k, v := range labels {
+		if r.binding.Labels[k] != v {
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
 
-	// Add labels for TunnelBinding
 	if r.binding.Labels == nil {
 		r.binding.Labels = make(map[string]string)
 	}
-	for k, v := range labelsForBinding(*r.binding) {
+	for k, v := range labels {
 		r.binding.Labels[k] = v
 	}
 
@@ -385,6 +438,15 @@ func (r *TunnelBindingReconciler) creationLogic() error {
 		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedMetaSet", "Failed to set Labels")
 		return err
 	}
+	return nil
+}
+
+func (r *TunnelBindingReconciler) creationLogic() error {
+
+	// Add labels for TunnelBinding
+	if err := r.ensureLabels(); err != nil {
+		return err
+	}
 
 	// Add finalizer for TunnelBinding if DNS updates are not disabled
 	if r.binding.TunnelRef.DisableDNSUpdates {
@@ -407,12 +469,24 @@ func (r *TunnelBindingReconciler) creationLogic() error {
 
 	// P1 FIX: Use errors.Join for proper error aggregation (fixes variable name conflict with errors package)
 	var errs []error
+	var conflicts []*dnsConflictError
 	// Create DNS entries
-	for _, info := range r.binding.Status.Services {
+	for i, info := range r.binding.Status.Services {
+		if !subjectResolved(r.binding, i) {
+			// Unresolved subjects are not served by the tunnel
+			continue
+		}
 		if err := r.createDNSLogic(info.Hostname); err != nil {
+			var conflict *dnsConflictError
+			if errors.As(err, &conflict) {
+				conflicts = append(conflicts, conflict)
+			}
 			errs = append(errs, fmt.Errorf("create DNS %s: %w", info.Hostname, err))
 		}
 	}
+	if err := r.setConflictCondition(conflicts); err != nil {
+		errs = append(errs, err)
+	}
 	if len(errs) > 0 {
 		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedDNSCreatePartial",
 			fmt.Sprintf("Some DNS entries failed to create (%d errors)", len(errs)))
@@ -421,6 +495,114 @@ func (r *TunnelBindingReconciler) creationLogic() error {
 	return nil
 }
 
+// dnsConflictError reports a hostname whose DNS record the binding refuses to overwrite.
+type dnsConflictError struct {
+	hostname string
+	reason   string
+	detail   string
+}
+
+func (e *dnsConflictError) Error() string {
+	return fmt.Sprintf("FQDN %s already present, %s", e.hostname, e.detail)
+}
+
+// setConflictCondition records the DNS conflicts of the last sync in the Conflict condition.
+func (r *TunnelBindingReconciler) setConflictCondition(conflicts []*dnsConflictError) error {
+	condition := metav1.Condition{
+		Type:               networkingv1alpha1.TunnelBindingConditionConflict,
+		Status:             metav1.ConditionFalse,
+		Reason:             networkingv1alpha1.TunnelBindingReasonNoConflict,
+		Message:            "All DNS records are managed by this tunnel",
+		ObservedGeneration: r.binding.Generation,
+	}
+	if len(conflicts) > 0 {
+		messages := make([]string, 0, len(conflicts))
+		for _, conflict := range conflicts {
+			messages = append(messages, conflict.Error())
+		}
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = conflicts[0].reason
+		condition.Message = strings.Join(messages, "; ")
+	}
+
+	if conditionUnchanged(r.binding.Status.Conditions, condition) {
+		return nil
+	}
+
+	if err := UpdateStatusWithConflictRetry(r.ctx, r.Client, r.binding, func() {
+		meta.SetStatusCondition(&r.binding.Status.Conditions, condition)
+	}); err != nil {
+		r.log.Error(err, "Failed to update TunnelBinding conflict condition")
+		return err
+	}
+	return nil
+}
+
+// setReadyCondition records which subjects were written to the tunnel configuration
+// and sets the Ready condition. configErr is the error of the configuration write.
+func (r *TunnelBindingReconciler) setReadyCondition(configErr error) error {
+	subjects := make([]networkingv1alpha1.TunnelBindingSubjectStatus, len(r.binding.Status.Subjects))
+	var unresolved []string
+	for i, subject := range r.binding.Status.Subjects {
+		subject.Configured = configErr == nil && subject.Resolved
+		if !subject.Resolved {
+			unresolved = append(unresolved, fmt.Sprintf("%s: %s", subject.Name, subject.Message))
+		}
+		subjects[i] = subject
+	}
+
+	condition := metav1.Condition{
+		Type:               networkingv1alpha1.TunnelBindingConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             networkingv1alpha1.TunnelBindingReasonSubjectsConfigured,
+		Message:            fmt.Sprintf("All %d subjects are configured on the tunnel", len(subjects)),
+		ObservedGeneration: r.binding.Generation,
+	}
+	switch {
+	case configErr != nil:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = networkingv1alpha1.TunnelBindingReasonConfigurationFailed
+		condition.Message = fmt.Sprintf("Failed to write the tunnel configuration: %s", configErr)
+	case len(unresolved) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = networkingv1alpha1.TunnelBindingReasonUnresolvedSubjects
+		condition.Message = fmt.Sprintf("%d of %d subjects could not be resolved and are not configured: %s",
+			len(unresolved), len(subjects), strings.Join(unresolved, "; "))
+	}
+
+	if equality.Semantic.DeepEqual(subjects, r.binding.Status.Subjects) &&
+		conditionUnchanged(r.binding.Status.Conditions, condition) {
+		return nil
+	}
+
+	if err := UpdateStatusWithConflictRetry(r.ctx, r.Client, r.binding, func() {
+		r.binding.Status.Subjects = subjects
+		meta.SetStatusCondition(&r.binding.Status.Conditions, condition)
+	}); err != nil {
+		r.log.Error(err, "Failed to update TunnelBinding ready condition")
+		return err
+	}
+	return nil
+}
+
+// conditionUnchanged reports whether conditions already contain condition,
+// ignoring its transition time.
+func conditionUnchanged(conditions []metav1.Condition, condition metav1.Condition) bool {
+	existing := meta.FindStatusCondition(conditions, condition.Type)
+	return existing != nil &&
+		existing.Status == condition.Status && existing.Reason == condition.Reason &&
+		existing.Message == condition.Message && existing.ObservedGeneration == condition.ObservedGeneration
+}
+
+// subjectResolved reports whether subject i of the binding was resolved.
+// Bindings without subject status, last reconciled before it was recorded, count as resolved.
+func subjectResolved(binding *networkingv1alpha1.TunnelBinding, i int) bool {
+	if i >= len(binding.Status.Subjects) {
+		return true
+	}
+	return binding.Status.Subjects[i].Resolved
+}
+
 func (r *TunnelBindingReconciler) createDNSLogic(hostname string) error {
 	// Create temporary API client for DNS operations (Unified Sync Architecture pattern)
 	cfAPI, err := r.createTemporaryAPIClient()
@@ -430,39 +612,75 @@ func (r *TunnelBindingReconciler) createDNSLogic(hostname string) error {
 		return err
 	}
 
-	txtID, dnsTxtResponse, canUseDNS, err := cfAPI.GetManagedDnsTxt(r.ctx, hostname)
+	ownership, err := cfAPI.CheckDNSOwnership(r.ctx, hostname)
 	if err != nil {
 		// We should not use this entry
-		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedReadingTxt", "Failed to read existing TXT DNS entry")
-		return err
-	}
-	if !canUseDNS {
-		// We cannot use this entry
+		r.log.Error(err, "Failed to check DNS ownership", "hostname", hostname)
 		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedReadingTxt",
-			fmt.Sprintf("FQDN already managed by Tunnel Name: %s, Id: %s", dnsTxtResponse.TunnelName, dnsTxtResponse.TunnelId))
-		return err
-	}
-	existingID, err := cfAPI.GetDNSCNameId(r.ctx, hostname)
-	if err != nil {
-		// Real API error (not "record not found" which now returns "", nil)
-		r.log.Error(err, "Failed to check existing DNS record", "hostname", hostname)
-		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedCheckingDns",
-			fmt.Sprintf("Failed to check existing DNS record: %s", cf.SanitizeErrorMessage(err)))
+			fmt.Sprintf("Failed to read existing DNS entries: %s", cf.SanitizeErrorMessage(err)))
 		return err
 	}
 
-	// Check if a DNS record exists (existingID != "" means record found)
-	if existingID != "" {
-		// without a managed TXT record when we are not supposed to overwrite it
-		if !r.OverwriteUnmanaged && txtID == "" {
-			err := fmt.Errorf("unmanaged FQDN present")
-			r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedReadingTxt", "FQDN present but unmanaged by Tunnel")
+	if ownership.Ownership == cf.DNSOwnershipOtherTunnel {
+		conflict := &dnsConflictError{
+			hostname: hostname,
+			reason:   networkingv1alpha1.TunnelBindingReasonOwnedByOtherTunnel,
+			detail: fmt.Sprintf("managed by Tunnel Name: %s, Id: %s",
+				ownership.Marker.TunnelName, ownership.Marker.TunnelId),
+		}
+		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedReadingTxt", conflict.Error())
+		return conflict
+	}
+
+	unmanaged := ownership.UnmanagedRecords()
+	if len(unmanaged) > 0 && !r.OverwriteUnmanaged {
+		// Without a managed TXT record we are not supposed to overwrite it
+		details := make([]string, 0, len(unmanaged))
+		for _, record := range unmanaged {
+			details = append(details, fmt.Sprintf("unmanaged record %s (id %s)", record, record.ID))
+			r.log.Info("Not overwriting unmanaged DNS record", "hostname", hostname,
+				"record", record.String(), "recordId", record.ID)
+		}
+		conflict := &dnsConflictError{
+			hostname: hostname,
+			reason:   networkingv1alpha1.TunnelBindingReasonUnmanagedRecord,
+			detail:   strings.Join(details, ", "),
+		}
+		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedReadingTxt", conflict.Error())
+		return conflict
+	}
+
+	// Records of other types cannot coexist with the CNAME, delete them first
+	for _, record := range ownership.Conflicting {
+		msg := fmt.Sprintf("Overwriting DNS record %s (id %s) with CNAME %s",
+			record, record.ID, cfAPI.TunnelCNameTarget())
+		r.log.Info("Deleting DNS record conflicting with the tunnel CNAME", "hostname", hostname,
+			"record", record.String(), "recordId", record.ID)
+		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "OverwritingDns", msg)
+		if err := cfAPI.DeleteDNSId(r.ctx, hostname, record.ID, true); err != nil {
+			r.Recorder.Event(r.binding, corev1.EventTypeWarning, "FailedDeletingDns",
+				fmt.Sprintf("Failed to delete conflicting DNS record: %s", cf.SanitizeErrorMessage(err)))
 			return err
 		}
-		// To overwrite
-		dnsTxtResponse.DnsId = existingID
 	}
 
+	// To overwrite, target the record that actually exists
+	dnsTxtResponse := ownership.Marker
+	if ownership.Existing != nil {
+		if ownership.Ownership == cf.DNSOwnershipUnmanaged || ownership.Existing.Content != cfAPI.TunnelCNameTarget() {
+			msg := fmt.Sprintf("Overwriting DNS record %s (id %s, %s) with %s",
+				ownership.Existing, ownership.Existing.ID, ownership.Ownership, cfAPI.TunnelCNameTarget())
+			r.log.Info("Overwriting existing DNS record", "hostname", hostname,
+				"record", ownership.Existing.String(), "recordId", ownership.Existing.ID, "ownership", ownership.Ownership)
+			r.Recorder.Event(r.binding, corev1.EventTypeWarning, "OverwritingDns", msg)
+		}
+		dnsTxtResponse.DnsId = ownership.Existing.ID
+	} else {
+		// The record recorded in the marker is gone, create a new one
+		dnsTxtResponse.DnsId = ""
+	}
+	txtID := ownership.TxtId
+
 	newDNSID, err := cfAPI.InsertOrUpdateCName(r.ctx, hostname, dnsTxtResponse.DnsId)
 	if err != nil {
 		r.log.Error(err, "Failed to insert/update DNS entry", "Hostname", hostname)
@@ -600,6 +818,15 @@ func (r TunnelBindingReconciler) getConfigForSubject(subject networkingv1alpha1.
 		r.log.Info("using default domain value", "domain", r.domain)
 	}
 
+	if err := tunnelconfig.ValidateOriginRequest(subjectOriginRequest(subject.Spec)); err != nil {
+		return hostname, target, fmt.Errorf("invalid originRequest: %w", err)
+	}
+
+	// An explicit target does not depend on the Service
+	if subject.Spec.Target != "" {
+		return hostname, subject.Spec.Target, nil
+	}
+
 	service := &corev1.Service{}
 	if err := r.Get(r.ctx, apitypes.NamespacedName{Name: subject.Name, Namespace: r.binding.Namespace}, service); err != nil {
 		r.log.Error(err, "Error getting referenced service")
@@ -607,29 +834,114 @@ func (r TunnelBindingReconciler) getConfigForSubject(subject networkingv1alpha1.
 		return hostname, target, err
 	}
 
-	if len(service.Spec.Ports) == 0 {
-		err := fmt.Errorf("no ports found in service spec, cannot proceed")
+	if len(service.Spec.Ports) > 1 && subject.Spec.Port == (intstr.IntOrString{}) {
+		r.log.Info("Multiple ports definition found, picking the first in the list", "svc", service.Name)
+	}
+	servicePort, err := selectServicePort(service, subject.Spec.Port)
+	if err != nil {
 		r.log.Error(err, "unable to read service ports", "svc", service.Name)
 		return hostname, target, err
-	} else if len(service.Spec.Ports) > 1 {
-		r.log.Info("Multiple ports definition found, picking the first in the list", "svc", service.Name)
 	}
-
-	servicePort := service.Spec.Ports[0]
+	port, err := r.servicePortTarget(service, servicePort)
+	if err != nil {
+		r.log.Error(err, "unable to resolve service port", "svc", service.Name)
+		return hostname, target, err
+	}
 	tunnelProto := subject.Spec.Protocol
 	validProto := tunnelValidProtoMap[tunnelProto]
 
 	serviceProto := r.getServiceProto(tunnelProto, validProto, servicePort)
+	if serviceProto == "" {
+		return hostname, target, fmt.Errorf("unsupported protocol %s of port %d", servicePort.Protocol, servicePort.Port)
+	}
 
 	r.log.Info("Selected protocol", "protocol", serviceProto)
 
-	target = fmt.Sprintf("%s://%s.%s.svc:%d", serviceProto, service.Name, service.Namespace, servicePort.Port)
+	target = fmt.Sprintf("%s://%s.%s.svc:%d", serviceProto, service.Name, service.Namespace, port)
 
 	r.log.Info("generated cloudflare config", "hostname", hostname, "target", target)
 
 	return hostname, target, nil
 }
 
+// selectServicePort returns the port of the Service selected by name or number,
+// or its first port when none is selected.
+func selectServicePort(service *corev1.Service, selector intstr.IntOrString) (corev1.ServicePort, error) {
+	if len(service.Spec.Ports) == 0 {
+		return corev1.ServicePort{}, fmt.Errorf("no ports found in service spec, cannot proceed")
+	}
+
+	switch {
+	case selector.Type == intstr.String && selector.StrVal != "":
+		for _, port := range service.Spec.Ports {
+			if port.Name == selector.StrVal {
+				return port, nil
+			}
+		}
+		return corev1.ServicePort{}, fmt.Errorf("service %s has no port named %q", service.Name, selector.StrVal)
+	case selector.Type == intstr.Int && selector.IntVal != 0:
+		for _, port := range service.Spec.Ports {
+			if port.Port == selector.IntVal {
+				return port, nil
+			}
+		}
+		return corev1.ServicePort{}, fmt.Errorf("service %s has no port %d", service.Name, selector.IntVal)
+	default:
+		return service.Spec.Ports[0], nil
+	}
+}
+
+// servicePortTarget returns the port cloudflared connects to for the Service port.
+// A headless Service has no virtual IP, its DNS name resolves to the pods, so the
+// target port is used instead of the Service port. A named target port is looked up
+// in the EndpointSlices of the Service; while none of them carries it, the Service
+// port is used and a warning is emitted.
+func (r *TunnelBindingReconciler) servicePortTarget(service *corev1.Service, port corev1.ServicePort) (int32, error) {
+	if service.Spec.ClusterIP != corev1.ClusterIPNone {
+		return port.Port, nil
+	}
+
+	switch {
+	case port.TargetPort.Type == intstr.String && port.TargetPort.StrVal != "":
+		target, err := r.endpointSlicePort(service, port.Name)
+		if err != nil {
+			return 0, err
+		}
+		if target != 0 {
+			return target, nil
+		}
+		r.log.Info("Named target port of headless service not found in its EndpointSlices, using the service port",
+			"svc", service.Name, "targetPort", port.TargetPort.StrVal, "port", port.Port)
+		r.Recorder.Event(r.binding, corev1.EventTypeWarning, "TargetPortUnresolved",
+			fmt.Sprintf("Named target port %q of headless Service %s has no endpoints, using port %d",
+				port.TargetPort.StrVal, service.Name, port.Port))
+		return port.Port, nil
+	case port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal != 0:
+		return port.TargetPort.IntVal, nil
+	default:
+		// An unset target port defaults to the port
+		return port.Port, nil
+	}
+}
+
+// endpointSlicePort returns the container port the EndpointSlices of the Service
+// publish for the named Service port, or 0 when none of them lists it.
+func (r *TunnelBindingReconciler) endpointSlicePort(service *corev1.Service, portName string) (int32, error) {
+	slices := &discoveryv1.EndpointSliceList{}
+	if err := r.List(r.ctx, slices, client.InNamespace(service.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: service.Name}); err != nil {
+		return 0, fmt.Errorf("list EndpointSlices of service %s: %w", service.Name, err)
+	}
+	for _, slice := range slices.Items {
+		for _, port := range slice.Ports {
+			if ptr.Deref(port.Name, "") == portName && port.Port != nil {
+				return *port.Port, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
 // getServiceProto returns the service protocol to be used
 func (r *TunnelBindingReconciler) getServiceProto(tunnelProto string, validProto bool, servicePort corev1.ServicePort) string {
 	var serviceProto string
@@ -662,6 +974,32 @@ func (r *TunnelBindingReconciler) getServiceProto(tunnelProto string, validProto
 	return serviceProto
 }
 
+// subjectOriginRequest converts the origin settings of a subject, or returns nil if none are set.
+func subjectOriginRequest(spec networkingv1alpha1.TunnelBindingSubjectSpec) *tunnelconfig.OriginRequestConfig {
+	originReq := &tunnelconfig.OriginRequestConfig{
+		NoTLSVerify:  spec.NoTlsVerify,
+		HTTP2Origin:  spec.HTTP2Origin,
+		ProxyAddress: spec.ProxyAddress,
+		ProxyPort:    int(spec.ProxyPort),
+		ProxyType:    spec.ProxyType,
+	}
+	if origin := spec.OriginRequest; origin != nil {
+		originReq.ConnectTimeout = origin.ConnectTimeout
+		originReq.TLSTimeout = origin.TLSTimeout
+		originReq.TCPKeepAlive = origin.TCPKeepAlive
+		originReq.KeepAliveTimeout = origin.KeepAliveTimeout
+		originReq.NoHappyEyeballs = origin.NoHappyEyeballs
+		if origin.KeepAliveConnections != nil {
+			originReq.KeepAliveConnections = *origin.KeepAliveConnections
+		}
+	}
+
+	if equality.Semantic.DeepEqual(*originReq, tunnelconfig.OriginRequestConfig{}) {
+		return nil
+	}
+	return originReq
+}
+
 // configureCloudflareDaemon registers ingress rules to ConfigMap.
 // The TunnelConfig controller watches ConfigMaps and syncs to Cloudflare API.
 func (r *TunnelBindingReconciler) configureCloudflareDaemon() error {
@@ -674,7 +1012,15 @@ func (r *TunnelBindingReconciler) configureCloudflareDaemon() error {
 	// Build ingress rules from all bindings
 	rules := make([]tunnelconfig.IngressRule, 0, 16)
 	for _, binding := range bindings {
+		if r.binding != nil && binding.Namespace == r.binding.Namespace && binding.Name == r.binding.Name {
+			// Use the status resolved by this reconcile, the listed one may be stale
+			binding = *r.binding
+		}
 		for i, subject := range binding.Subjects {
+			if i >= len(binding.Status.Services) || !subjectResolved(&binding, i) {
+				// Not resolved yet, or the Service could not be resolved
+				continue
+			}
 			targetService := ""
 			if subject.Spec.Target != "" {
 				targetService = subject.Spec.Target
@@ -689,34 +1035,7 @@ func (r *TunnelBindingReconciler) configureCloudflareDaemon() error {
 				Priority: tunnelconfig.PriorityBinding,
 			}
 
-			// Convert origin request config
-			originReq := &tunnelconfig.OriginRequestConfig{}
-			hasOriginConfig := false
-
-			if subject.Spec.NoTlsVerify {
-				originReq.NoTLSVerify = subject.Spec.NoTlsVerify
-				hasOriginConfig = true
-			}
-			if subject.Spec.HTTP2Origin {
-				originReq.HTTP2Origin = subject.Spec.HTTP2Origin
-				hasOriginConfig = true
-			}
-			if subject.Spec.ProxyAddress != "" {
-				originReq.ProxyAddress = subject.Spec.ProxyAddress
-				hasOriginConfig = true
-			}
-			if subject.Spec.ProxyPort != 0 {
-				originReq.ProxyPort = int(subject.Spec.ProxyPort)
-				hasOriginConfig = true
-			}
-			if subject.Spec.ProxyType != "" {
-				originReq.ProxyType = subject.Spec.ProxyType
-				hasOriginConfig = true
-			}
-
-			if hasOriginConfig {
-				rule.OriginRequest = originReq
-			}
+			rule.OriginRequest = subjectOriginRequest(subject.Spec)
 
 			rules = append(rules, rule)
 		}
@@ -763,6 +1082,10 @@ func (r *TunnelBindingReconciler) configureCloudflareDaemon() error {
 	writer := tunnelconfig.NewWriter(r.Client, r.Namespace)
 	if err := writer.WriteSourceConfig(r.ctx, r.tunnelID, r.accountID, source, owner, ownerGVK); err != nil {
 		r.log.Error(err, "failed to write to ConfigMap", "tunnelID", r.tunnelID)
+		var ruleErr *tunnelconfig.RuleValidationError
+		if r.binding != nil && errors.As(err, &ruleErr) {
+			r.Recorder.Event(r.binding, corev1.EventTypeWarning, tunnelconfig.ReasonInvalidIngressRules, ruleErr.Error())
+		}
 		return fmt.Errorf("write to ConfigMap: %w", err)
 	}
 
@@ -940,5 +1263,12 @@ func (r *TunnelBindingReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			&corev1.Service{},
 			handler.EnqueueRequestsFromMapFunc(r.findTunnelBindingsForService),
 		).
-		Complete(r)
+		// Restore generated AccessApplications that are edited or deleted
+		Owns(&networkingv1alpha2.AccessApplication{}).
+		// Check hostnames again when a referenced AccessApplication changes
+		Watches(
+			&networkingv1alpha2.AccessApplication{},
+			handler.EnqueueRequestsFromMapFunc(r.findTunnelBindingsForAccessApplication),
+		).
+		Complete(ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha1.TunnelBinding{}, r))
 }
@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+const testBindingHostname = "app.example.com"
+
+func newBindingDNSTest(t *testing.T, overwrite bool) (*TunnelBindingReconciler, client.Client, *mockserver.Server) {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha1.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	binding := &networkingv1alpha1.TunnelBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 1},
+		TunnelRef:  networkingv1alpha1.TunnelRef{Kind: "Tunnel", Name: "tunnel-a"},
+		Status: networkingv1alpha1.TunnelBindingStatus{
+			Services: []networkingv1alpha1.ServiceInfo{{Hostname: testBindingHostname, Target: "http://app.default.svc:80"}},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "test-account-id",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			binding,
+		).
+		WithStatusSubresource(binding).
+		Build()
+
+	return &TunnelBindingReconciler{
+		Client:             c,
+		Scheme:             scheme,
+		Recorder:           record.NewFakeRecorder(20),
+		Namespace:          "cloudflare-operator-system",
+		OverwriteUnmanaged: overwrite,
+		ctx:                context.Background(),
+		log:                logr.Discard(),
+		binding:            binding,
+		tunnelID:           "tunnel-a",
+		accountID:          "test-account-id",
+		domain:             "example.com",
+	}, c, mock
+}
+
+func addBindingDNSRecord(mock *mockserver.Server, id, recordType, name, content string) {
+	mock.Store().CreateDNSRecord(&models.DNSRecord{
+		ID: id, ZoneID: "test-zone-id", ZoneName: "example.com",
+		Type: recordType, Name: name, Content: content, Proxied: ptr.To(recordType == "CNAME"),
+	})
+}
+
+func drainEvents(r *TunnelBindingReconciler) string {
+	recorder := r.Recorder.(*record.FakeRecorder)
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return strings.Join(events, "\n")
+		}
+	}
+}
+
+func conflictCondition(t *testing.T, c client.Client, r *TunnelBindingReconciler) *metav1.Condition {
+	t.Helper()
+	updated := &networkingv1alpha1.TunnelBinding{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(r.binding), updated))
+	cond := meta.FindStatusCondition(updated.Status.Conditions, networkingv1alpha1.TunnelBindingConditionConflict)
+	require.NotNil(t, cond)
+	return cond
+}
+
+func TestCreationLogic_OwnedRecordIsUpdated(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, false)
+	addBindingDNSRecord(mock, "cname-1", "CNAME", testBindingHostname, "tunnel-a.cfargotunnel.com")
+	addBindingDNSRecord(mock, "txt-1", "TXT", "_managed."+testBindingHostname,
+		`{"DnsId":"cname-1","TunnelName":"a","TunnelId":"tunnel-a"}`)
+
+	require.NoError(t, r.creationLogic())
+
+	records := mock.Store().ListDNSRecords("test-zone-id", "CNAME", testBindingHostname)
+	require.Len(t, records, 1)
+	assert.Equal(t, "cname-1", records[0].ID)
+	assert.Equal(t, "tunnel-a.cfargotunnel.com", records[0].Content)
+	assert.Len(t, mock.Store().ListDNSRecords("test-zone-id", "TXT", ""), 1)
+
+	events := drainEvents(r)
+	assert.Contains(t, events, "CreatedDns")
+	assert.NotContains(t, events, "OverwritingDns", "an unchanged owned record is not reported")
+
+	cond := conflictCondition(t, c, r)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonNoConflict, cond.Reason)
+}
+
+func TestCreationLogic_UnmanagedRecordOverwriteAllowed(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, true)
+	addBindingDNSRecord(mock, "cname-1", "CNAME", testBindingHostname, "origin.example.net")
+
+	require.NoError(t, r.creationLogic())
+
+	records := mock.Store().ListDNSRecords("test-zone-id", "CNAME", testBindingHostname)
+	require.Len(t, records, 1)
+	assert.Equal(t, "cname-1", records[0].ID)
+	assert.Equal(t, "tunnel-a.cfargotunnel.com", records[0].Content)
+	txt := mock.Store().ListDNSRecords("test-zone-id", "TXT", "_managed."+testBindingHostname)
+	require.Len(t, txt, 1)
+	assert.Contains(t, txt[0].Content, `"TunnelId":"tunnel-a"`)
+
+	events := drainEvents(r)
+	assert.Contains(t, events, "OverwritingDns")
+	assert.Contains(t, events, "CNAME app.example.com -> origin.example.net (proxied)")
+
+	assert.Equal(t, metav1.ConditionFalse, conflictCondition(t, c, r).Status)
+}
+
+func TestCreationLogic_UnmanagedRecordOverwriteDenied(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, false)
+	addBindingDNSRecord(mock, "cname-1", "CNAME", testBindingHostname, "origin.example.net")
+
+	err := r.creationLogic()
+	require.Error(t, err)
+	var conflict *dnsConflictError
+	assert.ErrorAs(t, err, &conflict)
+
+	records := mock.Store().ListDNSRecords("test-zone-id", "CNAME", testBindingHostname)
+	require.Len(t, records, 1)
+	assert.Equal(t, "origin.example.net", records[0].Content, "the unmanaged record is left untouched")
+	assert.Empty(t, mock.Store().ListDNSRecords("test-zone-id", "TXT", ""))
+	assert.NotContains(t, drainEvents(r), "OverwritingDns")
+
+	cond := conflictCondition(t, c, r)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonUnmanagedRecord, cond.Reason)
+	assert.Contains(t, cond.Message, "CNAME app.example.com -> origin.example.net")
+	assert.Contains(t, cond.Message, "cname-1")
+}
+
+func TestCreationLogic_RecordOwnedByOtherTunnel(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, true)
+	addBindingDNSRecord(mock, "cname-1", "CNAME", testBindingHostname, "tunnel-b.cfargotunnel.com")
+	addBindingDNSRecord(mock, "txt-1", "TXT", "_managed."+testBindingHostname,
+		`{"DnsId":"cname-1","TunnelName":"b","TunnelId":"tunnel-b"}`)
+
+	require.Error(t, r.creationLogic())
+
+	records := mock.Store().ListDNSRecords("test-zone-id", "CNAME", testBindingHostname)
+	require.Len(t, records, 1)
+	assert.Equal(t, "tunnel-b.cfargotunnel.com", records[0].Content)
+
+	cond := conflictCondition(t, c, r)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonOwnedByOtherTunnel, cond.Reason)
+	assert.Contains(t, cond.Message, "tunnel-b")
+}
+
+func TestCreationLogic_RecordOfOtherTypeOverwriteDenied(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, false)
+	addBindingDNSRecord(mock, "a-1", "A", testBindingHostname, "192.0.2.1")
+
+	err := r.creationLogic()
+	require.Error(t, err)
+	var conflict *dnsConflictError
+	assert.ErrorAs(t, err, &conflict)
+
+	assert.Len(t, mock.Store().ListDNSRecords("test-zone-id", "A", testBindingHostname), 1,
+		"the unmanaged record is left untouched")
+	assert.Empty(t, mock.Store().ListDNSRecords("test-zone-id", "CNAME", testBindingHostname))
+
+	cond := conflictCondition(t, c, r)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonUnmanagedRecord, cond.Reason)
+	assert.Contains(t, cond.Message, "A app.example.com -> 192.0.2.1")
+	assert.Contains(t, cond.Message, "a-1")
+}
+
+func TestCreationLogic_RecordOfOtherTypeOverwriteAllowed(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, true)
+	addBindingDNSRecord(mock, "a-1", "A", testBindingHostname, "192.0.2.1")
+	addBindingDNSRecord(mock, "txt-2", "TXT", testBindingHostname, "v=spf1 -all")
+
+	require.NoError(t, r.creationLogic())
+
+	assert.Empty(t, mock.Store().ListDNSRecords("test-zone-id", "A", testBindingHostname))
+	assert.Empty(t, mock.Store().ListDNSRecords("test-zone-id", "TXT", testBindingHostname))
+	records := mock.Store().ListDNSRecords("test-zone-id", "CNAME", testBindingHostname)
+	require.Len(t, records, 1)
+	assert.Equal(t, "tunnel-a.cfargotunnel.com", records[0].Content)
+
+	events := drainEvents(r)
+	assert.Contains(t, events, "OverwritingDns")
+	assert.Contains(t, events, "A app.example.com -> 192.0.2.1")
+	assert.Contains(t, events, "TXT app.example.com -> v=spf1 -all")
+
+	assert.Equal(t, metav1.ConditionFalse, conflictCondition(t, c, r).Status)
+}
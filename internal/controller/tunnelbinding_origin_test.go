@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
+)
+
+func TestSubjectOriginRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		spec networkingv1alpha1.TunnelBindingSubjectSpec
+		want *tunnelconfig.OriginRequestConfig
+	}{
+		{name: "no settings"},
+		{name: "empty origin request", spec: networkingv1alpha1.TunnelBindingSubjectSpec{
+			OriginRequest: &networkingv1alpha1.TunnelBindingOriginRequest{},
+		}},
+		{
+			name: "timeouts and keep-alive",
+			spec: networkingv1alpha1.TunnelBindingSubjectSpec{
+				NoTlsVerify: true,
+				OriginRequest: &networkingv1alpha1.TunnelBindingOriginRequest{
+					ConnectTimeout:       "5s",
+					TLSTimeout:           "3s",
+					TCPKeepAlive:         "15s",
+					KeepAliveTimeout:     "2m",
+					KeepAliveConnections: ptr.To(50),
+					NoHappyEyeballs:      true,
+				},
+			},
+			want: &tunnelconfig.OriginRequestConfig{
+				NoTLSVerify:          true,
+				ConnectTimeout:       "5s",
+				TLSTimeout:           "3s",
+				TCPKeepAlive:         "15s",
+				KeepAliveTimeout:     "2m",
+				KeepAliveConnections: 50,
+				NoHappyEyeballs:      true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, subjectOriginRequest(tt.spec))
+		})
+	}
+}
+
+func TestTunnelBindingStatus_OriginRequest(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	require.NoError(t, c.Create(ctx, bindingService("api", corev1.ServicePort{Port: 8080, Protocol: corev1.ProtocolTCP})))
+	setBindingSubjects(t, r, c, "web", "api")
+	r.binding.Subjects[0].Spec.OriginRequest = &networkingv1alpha1.TunnelBindingOriginRequest{
+		ConnectTimeout:       "10s",
+		KeepAliveTimeout:     "90s",
+		KeepAliveConnections: ptr.To(200),
+	}
+	// Out of range values rejected by the CRD schema are still checked by the controller
+	r.binding.Subjects[1].Spec.OriginRequest = &networkingv1alpha1.TunnelBindingOriginRequest{
+		TLSTimeout:           "0s",
+		KeepAliveConnections: ptr.To(5000),
+	}
+	require.NoError(t, c.Update(ctx, r.binding))
+
+	updated := syncBindingSubjects(t, r, c)
+
+	require.Len(t, updated.Status.Subjects, 2)
+	assert.True(t, updated.Status.Subjects[0].Configured)
+	api := updated.Status.Subjects[1]
+	assert.False(t, api.Resolved)
+	assert.Contains(t, api.Message, "invalid originRequest")
+	assert.Contains(t, api.Message, "tlsTimeout 0s must be greater than 0s")
+	assert.Contains(t, api.Message, "keepAliveConnections 5000 must be between 1 and 1000")
+
+	rules := configuredRules(t, r, c)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "web.example.com", rules[0].Hostname)
+	assert.Equal(t, &tunnelconfig.OriginRequestConfig{
+		ConnectTimeout:       "10s",
+		KeepAliveTimeout:     "90s",
+		KeepAliveConnections: 200,
+	}, rules[0].OriginRequest)
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestSelectServicePort(t *testing.T) {
+	service := bindingService("web",
+		corev1.ServicePort{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+		corev1.ServicePort{Name: "metrics", Port: 9090, Protocol: corev1.ProtocolTCP},
+	)
+
+	tests := []struct {
+		name     string
+		selector intstr.IntOrString
+		want     int32
+		wantErr  string
+	}{
+		{name: "default is the first port", want: 80},
+		{name: "by name", selector: intstr.FromString("metrics"), want: 9090},
+		{name: "by number", selector: intstr.FromInt32(9090), want: 9090},
+		{name: "unknown name", selector: intstr.FromString("grpc"), wantErr: `service web has no port named "grpc"`},
+		{name: "unknown number", selector: intstr.FromInt32(8080), wantErr: "service web has no port 8080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, err := selectServicePort(service, tt.selector)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, port.Port)
+		})
+	}
+}
+
+func TestServicePortTarget(t *testing.T) {
+	headless := func() *corev1.Service {
+		service := bindingService("db")
+		service.Spec.ClusterIP = corev1.ClusterIPNone
+		return service
+	}
+
+	tests := []struct {
+		name      string
+		service   *corev1.Service
+		port      corev1.ServicePort
+		slice     *discoveryv1.EndpointSlice
+		want      int32
+		wantEvent string
+	}{
+		{
+			name:    "cluster IP uses the service port",
+			service: bindingService("web"),
+			port:    corev1.ServicePort{Port: 80, TargetPort: intstr.FromInt32(8080)},
+			want:    80,
+		},
+		{
+			name:    "headless uses the target port",
+			service: headless(),
+			port:    corev1.ServicePort{Port: 80, TargetPort: intstr.FromInt32(8080)},
+			want:    8080,
+		},
+		{
+			name:    "headless without target port uses the port",
+			service: headless(),
+			port:    corev1.ServicePort{Port: 5432},
+			want:    5432,
+		},
+		{
+			name:    "headless with named target port resolved from endpoint slices",
+			service: headless(),
+			port:    corev1.ServicePort{Name: "sql", Port: 5432, TargetPort: intstr.FromString("postgres")},
+			slice: &discoveryv1.EndpointSlice{
+				ObjectMeta:  metav1.ObjectMeta{Name: "db-abc", Namespace: "default", Labels: map[string]string{discoveryv1.LabelServiceName: "db"}},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Ports:       []discoveryv1.EndpointPort{{Name: ptr.To("sql"), Port: ptr.To[int32](15432)}},
+			},
+			want: 15432,
+		},
+		{
+			name:    "headless with named target port ignores slices of other services",
+			service: headless(),
+			port:    corev1.ServicePort{Name: "sql", Port: 5432, TargetPort: intstr.FromString("postgres")},
+			slice: &discoveryv1.EndpointSlice{
+				ObjectMeta:  metav1.ObjectMeta{Name: "cache-abc", Namespace: "default", Labels: map[string]string{discoveryv1.LabelServiceName: "cache"}},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Ports:       []discoveryv1.EndpointPort{{Name: ptr.To("sql"), Port: ptr.To[int32](16379)}},
+			},
+			want:      5432,
+			wantEvent: "TargetPortUnresolved",
+		},
+		{
+			name:      "headless with unresolved named target port falls back to the port",
+			service:   headless(),
+			port:      corev1.ServicePort{Name: "sql", Port: 5432, TargetPort: intstr.FromString("postgres")},
+			want:      5432,
+			wantEvent: "TargetPortUnresolved",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, c, _ := newBindingDNSTest(t, false)
+			if tt.slice != nil {
+				require.NoError(t, c.Create(context.Background(), tt.slice))
+			}
+			port, err := r.servicePortTarget(tt.service, tt.port)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, port)
+			events := drainEvents(r)
+			if tt.wantEvent == "" {
+				assert.Empty(t, events)
+			} else {
+				assert.Contains(t, events, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestTunnelBindingStatus_NamedPorts(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web",
+		corev1.ServicePort{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+		corev1.ServicePort{Name: "admin", Port: 8443, Protocol: corev1.ProtocolTCP},
+	)))
+	db := bindingService("db", corev1.ServicePort{Name: "sql", Port: 5432, TargetPort: intstr.FromInt32(15432), Protocol: corev1.ProtocolTCP})
+	db.Spec.ClusterIP = corev1.ClusterIPNone
+	require.NoError(t, c.Create(ctx, db))
+	setBindingSubjects(t, r, c, "web", "db", "web")
+	r.binding.Subjects[0].Spec.Port = intstr.FromString("admin")
+	r.binding.Subjects[1].Spec.Port = intstr.FromString("sql")
+	r.binding.Subjects[1].Spec.Protocol = tunnelProtoTCP
+	r.binding.Subjects[2].Spec.Port = intstr.FromString("grpc")
+	r.binding.Subjects[2].Spec.Fqdn = "grpc.example.com"
+
+	updated := syncBindingSubjects(t, r, c)
+
+	require.Len(t, updated.Status.Subjects, 3)
+	assert.Equal(t, "http://web.default.svc:8443", updated.Status.Subjects[0].Service)
+	assert.Equal(t, "tcp://db.default.svc:15432", updated.Status.Subjects[1].Service)
+	assert.False(t, updated.Status.Subjects[2].Resolved)
+	assert.Contains(t, updated.Status.Subjects[2].Message, `no port named "grpc"`)
+	assert.ElementsMatch(t, []string{"web.example.com", "db.example.com"}, configuredHostnames(t, r, c))
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	networkingv1alpha1 "github.com/StringKe/cloudflare-operator/api/v1alpha1"
+	"github.com/StringKe/cloudflare-operator/internal/controller/tunnelconfig"
+)
+
+func bindingService(name string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: ports},
+	}
+}
+
+// setBindingSubjects replaces the subjects and status of the binding under test.
+func setBindingSubjects(t *testing.T, r *TunnelBindingReconciler, c client.Client, names ...string) {
+	t.Helper()
+	binding := &networkingv1alpha1.TunnelBinding{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(r.binding), binding))
+	binding.Subjects = nil
+	for _, name := range names {
+		binding.Subjects = append(binding.Subjects, networkingv1alpha1.TunnelBindingSubject{Kind: "Service", Name: name})
+	}
+	require.NoError(t, c.Update(context.Background(), binding))
+	binding.Status = networkingv1alpha1.TunnelBindingStatus{}
+	require.NoError(t, c.Status().Update(context.Background(), binding))
+	r.binding = binding
+}
+
+// syncBindingSubjects runs the status and configuration steps of a reconcile.
+func syncBindingSubjects(t *testing.T, r *TunnelBindingReconciler, c client.Client) *networkingv1alpha1.TunnelBinding {
+	t.Helper()
+	_, err := r.setStatus()
+	require.NoError(t, err)
+	require.NoError(t, r.ensureLabels())
+	require.NoError(t, r.configureCloudflareDaemon())
+	require.NoError(t, r.setReadyCondition(nil))
+
+	updated := &networkingv1alpha1.TunnelBinding{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(r.binding), updated))
+	return updated
+}
+
+// configuredRules returns the binding's rules in the tunnel configuration.
+func configuredRules(t *testing.T, r *TunnelBindingReconciler, c client.Client) []tunnelconfig.IngressRule {
+	t.Helper()
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.Background(),
+		types.NamespacedName{Name: tunnelconfig.ConfigMapName(r.tunnelID), Namespace: r.Namespace}, cm))
+	config, err := tunnelconfig.LoadConfig(context.Background(), c, cm)
+	require.NoError(t, err)
+
+	source := config.Sources[tunnelconfig.SourceKey(tunnelconfig.SourceKindTunnelBinding, "default", "app")]
+	require.NotNil(t, source)
+	return source.Rules
+}
+
+// configuredHostnames returns the hostnames of the binding's rules in the tunnel configuration.
+func configuredHostnames(t *testing.T, r *TunnelBindingReconciler, c client.Client) []string {
+	t.Helper()
+	rules := configuredRules(t, r, c)
+	hostnames := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		hostnames = append(hostnames, rule.Hostname)
+	}
+	return hostnames
+}
+
+func TestTunnelBindingStatus_MixedSubjects(t *testing.T) {
+	r, c, mock := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	require.NoError(t, c.Create(ctx, bindingService("noports")))
+	setBindingSubjects(t, r, c, "web", "missing", "noports")
+
+	updated := syncBindingSubjects(t, r, c)
+
+	require.Len(t, updated.Status.Subjects, 3)
+	web := updated.Status.Subjects[0]
+	assert.Equal(t, "web", web.Name)
+	assert.Equal(t, "web.example.com", web.Hostname)
+	assert.Equal(t, "http://web.default.svc:80", web.Service)
+	assert.True(t, web.Resolved)
+	assert.True(t, web.Configured)
+	assert.Empty(t, web.Message)
+
+	missing := updated.Status.Subjects[1]
+	assert.False(t, missing.Resolved)
+	assert.False(t, missing.Configured)
+	assert.Empty(t, missing.Service)
+	assert.Contains(t, missing.Message, "not found")
+
+	noPorts := updated.Status.Subjects[2]
+	assert.False(t, noPorts.Resolved)
+	assert.False(t, noPorts.Configured)
+	assert.Contains(t, noPorts.Message, "no ports")
+
+	ready := meta.FindStatusCondition(updated.Status.Conditions, networkingv1alpha1.TunnelBindingConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonUnresolvedSubjects, ready.Reason)
+	assert.Contains(t, ready.Message, "2 of 3 subjects")
+	assert.Contains(t, ready.Message, "missing:")
+	assert.Contains(t, ready.Message, "noports:")
+
+	// Only the resolved subject is written to the tunnel configuration and DNS
+	assert.Equal(t, []string{"web.example.com"}, configuredHostnames(t, r, c))
+	require.NoError(t, r.creationLogic())
+	assert.Len(t, mock.Store().ListDNSRecords("test-zone-id", "CNAME", "web.example.com"), 1)
+	assert.Empty(t, mock.Store().ListDNSRecords("test-zone-id", "CNAME", "missing.example.com"))
+	assert.Empty(t, mock.Store().ListDNSRecords("test-zone-id", "CNAME", "noports.example.com"))
+}
+
+func TestTunnelBindingStatus_AllSubjectsConfigured(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	ctx := context.Background()
+	require.NoError(t, c.Create(ctx, bindingService("web", corev1.ServicePort{Port: 443, Protocol: corev1.ProtocolTCP})))
+	setBindingSubjects(t, r, c, "web", "api")
+
+	updated := syncBindingSubjects(t, r, c)
+	ready := meta.FindStatusCondition(updated.Status.Conditions, networkingv1alpha1.TunnelBindingConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonUnresolvedSubjects, ready.Reason)
+
+	// Once the missing Service exists, every subject is configured
+	require.NoError(t, c.Create(ctx, bindingService("api", corev1.ServicePort{Port: 8080, Protocol: corev1.ProtocolTCP})))
+	updated = syncBindingSubjects(t, r, c)
+
+	for _, subject := range updated.Status.Subjects {
+		assert.True(t, subject.Resolved, subject.Name)
+		assert.True(t, subject.Configured, subject.Name)
+	}
+	assert.Equal(t, "https://web.default.svc:443", updated.Status.Subjects[0].Service)
+	assert.Equal(t, "http://api.default.svc:8080", updated.Status.Subjects[1].Service)
+	ready = meta.FindStatusCondition(updated.Status.Conditions, networkingv1alpha1.TunnelBindingConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonSubjectsConfigured, ready.Reason)
+	assert.ElementsMatch(t, []string{"web.example.com", "api.example.com"}, configuredHostnames(t, r, c))
+}
+
+func TestTunnelBindingStatus_ConfigurationFailed(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	require.NoError(t, c.Create(context.Background(),
+		bindingService("web", corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP})))
+	setBindingSubjects(t, r, c, "web")
+	_, err := r.setStatus()
+	require.NoError(t, err)
+
+	require.NoError(t, r.setReadyCondition(errors.New("configmap unavailable")))
+
+	updated := &networkingv1alpha1.TunnelBinding{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(r.binding), updated))
+	require.Len(t, updated.Status.Subjects, 1)
+	assert.True(t, updated.Status.Subjects[0].Resolved)
+	assert.False(t, updated.Status.Subjects[0].Configured)
+	ready := meta.FindStatusCondition(updated.Status.Conditions, networkingv1alpha1.TunnelBindingConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, networkingv1alpha1.TunnelBindingReasonConfigurationFailed, ready.Reason)
+	assert.Contains(t, ready.Message, "configmap unavailable")
+}
+
+func TestTunnelBindingStatus_ExplicitTargetNeedsNoService(t *testing.T) {
+	r, c, _ := newBindingDNSTest(t, false)
+	setBindingSubjects(t, r, c, "external")
+	r.binding.Subjects[0].Spec.Target = "http://10.0.0.1:8080"
+
+	updated := syncBindingSubjects(t, r, c)
+
+	require.Len(t, updated.Status.Subjects, 1)
+	assert.True(t, updated.Status.Subjects[0].Configured)
+	assert.Equal(t, "http://10.0.0.1:8080", updated.Status.Subjects[0].Service)
+}
@@ -71,7 +71,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Parse the configuration
-	config, err := ParseConfig(cm)
+	config, err := LoadConfig(ctx, r.Client, cm)
 	if err != nil {
 		logger.Error(err, "Failed to parse tunnel config")
 		r.Recorder.Event(cm, corev1.EventTypeWarning, "ParseError",
@@ -79,9 +79,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil // Don't retry parse errors
 	}
 
-	// Check if configuration has changed
+	// Check if configuration has changed, unless a resync is forced
 	newHash := config.ComputeHash()
-	if config.LastHash == newHash && config.SyncStatus == "Synced" {
+	forceSync := common.ForceSyncRequested(cm)
+	if config.LastHash == newHash && config.SyncStatus == "Synced" && !forceSync {
 		logger.V(1).Info("Configuration unchanged, skipping sync",
 			"tunnelId", tunnelID, "hash", newHash)
 		return ctrl.Result{}, nil
@@ -91,13 +92,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		"tunnelId", tunnelID,
 		"sources", len(config.Sources),
 		"oldHash", config.LastHash,
-		"newHash", newHash)
+		"newHash", newHash,
+		"forceSync", forceSync)
 
 	// Get API client
 	apiResult, err := r.getAPIClient(ctx, config)
 	if err != nil {
 		logger.Error(err, "Failed to get API client")
-		r.updateConfigStatus(ctx, cm, config, "Error", fmt.Sprintf("API client error: %v", err))
+		r.updateConfigStatus(ctx, cm, config, "Error", fmt.Sprintf("API client error: %s", cf.SanitizeErrorMessage(err)))
 		return common.RequeueShort(), nil
 	}
 
@@ -120,6 +122,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		logger.Error(err, "Failed to update ConfigMap status")
 		return ctrl.Result{}, err
 	}
+	if err := common.ConsumeForceSync(ctx, r.Client, cm); err != nil {
+		logger.Error(err, "Failed to consume force-sync annotation")
+		return ctrl.Result{}, err
+	}
 
 	r.Recorder.Event(cm, corev1.EventTypeNormal, "Synced",
 		fmt.Sprintf("Tunnel configuration synced (rules=%d, warp=%v)",
@@ -179,9 +185,14 @@ func (*Reconciler) buildCloudflareConfig(config *TunnelConfig) cloudflare.Tunnel
 		}
 	}
 
-	// Add all ingress rules
+	// Add all ingress rules. Catch-all rules from sources are replaced by the
+	// tunnel's fallback, which must be the only catch-all and come last.
 	rules := config.AggregateRules()
 	for _, rule := range rules {
+		if rule.IsCatchAll() {
+			continue
+		}
+
 		cfRule := cloudflare.UnvalidatedIngressRule{
 			Hostname: rule.Hostname,
 			Path:     rule.Path,
@@ -198,7 +209,7 @@ func (*Reconciler) buildCloudflareConfig(config *TunnelConfig) cloudflare.Tunnel
 	// Add catch-all rule if we have any rules
 	if len(cfConfig.Ingress) > 0 {
 		cfConfig.Ingress = append(cfConfig.Ingress, cloudflare.UnvalidatedIngressRule{
-			Service: "http_status:404",
+			Service: config.GetFallbackTarget(),
 		})
 	}
 
@@ -222,6 +233,9 @@ func convertOriginRequest(req *OriginRequestConfig) *cloudflare.OriginRequestCon
 	if req.OriginServerName != "" {
 		cfReq.OriginServerName = &req.OriginServerName
 	}
+	if req.CAPool != "" {
+		cfReq.CAPool = &req.CAPool
+	}
 	if req.ProxyAddress != "" {
 		cfReq.ProxyAddress = &req.ProxyAddress
 	}
@@ -301,7 +315,9 @@ func (r *Reconciler) updateConfigStatus(ctx context.Context, cm *corev1.ConfigMa
 	}
 }
 
-// updateConfigMap updates the ConfigMap with new configuration.
+// updateConfigMap records the sync status of config in the ConfigMap. Sources changed
+// by a writer in the meantime are kept, and are synced on the next reconcile as
+// they no longer match the recorded hash.
 func (r *Reconciler) updateConfigMap(ctx context.Context, cm *corev1.ConfigMap, config *TunnelConfig) error {
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		// Get fresh copy
@@ -309,15 +325,17 @@ func (r *Reconciler) updateConfigMap(ctx context.Context, cm *corev1.ConfigMap,
 		if err := r.Get(ctx, client.ObjectKeyFromObject(cm), fresh); err != nil {
 			return err
 		}
-
-		// Update data
-		data, err := config.ToConfigMapData()
+		freshConfig, err := LoadConfig(ctx, r.Client, fresh)
 		if err != nil {
 			return err
 		}
-		fresh.Data = data
 
-		return r.Update(ctx, fresh)
+		// Update status
+		freshConfig.LastHash = config.LastHash
+		freshConfig.SyncStatus = config.SyncStatus
+		freshConfig.LastSyncTime = config.LastSyncTime
+
+		return StoreConfig(ctx, r.Client, fresh, freshConfig)
 	})
 }
 
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnelconfig
+
+import (
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+)
+
+// NewOriginRequestConfig converts the origin request settings of a rule built by
+// the Ingress and Gateway controllers into the form stored in the ConfigMap.
+//
+//nolint:revive // cognitive complexity is acceptable for field mapping
+func NewOriginRequestConfig(req *cf.OriginRequestConfig) *OriginRequestConfig {
+	if req == nil {
+		return nil
+	}
+
+	result := &OriginRequestConfig{}
+
+	if req.ConnectTimeout != nil {
+		result.ConnectTimeout = req.ConnectTimeout.String()
+	}
+	if req.TLSTimeout != nil {
+		result.TLSTimeout = req.TLSTimeout.String()
+	}
+	if req.TCPKeepAlive != nil {
+		result.TCPKeepAlive = req.TCPKeepAlive.String()
+	}
+	if req.NoHappyEyeballs != nil {
+		result.NoHappyEyeballs = *req.NoHappyEyeballs
+	}
+	if req.KeepAliveConnections != nil {
+		result.KeepAliveConnections = *req.KeepAliveConnections
+	}
+	if req.KeepAliveTimeout != nil {
+		result.KeepAliveTimeout = req.KeepAliveTimeout.String()
+	}
+	if req.HTTPHostHeader != nil {
+		result.HTTPHostHeader = *req.HTTPHostHeader
+	}
+	if req.OriginServerName != nil {
+		result.OriginServerName = *req.OriginServerName
+	}
+	if req.CAPool != nil {
+		result.CAPool = *req.CAPool
+	}
+	if req.NoTLSVerify != nil {
+		result.NoTLSVerify = *req.NoTLSVerify
+	}
+	if req.DisableChunkedEncoding != nil {
+		result.DisableChunkedEncoding = *req.DisableChunkedEncoding
+	}
+	if req.BastionMode != nil {
+		result.BastionMode = *req.BastionMode
+	}
+	if req.ProxyAddress != nil {
+		result.ProxyAddress = *req.ProxyAddress
+	}
+	if req.ProxyPort != nil {
+		result.ProxyPort = int(*req.ProxyPort)
+	}
+	if req.ProxyType != nil {
+		result.ProxyType = *req.ProxyType
+	}
+	if req.HTTP2Origin != nil {
+		result.HTTP2Origin = *req.HTTP2Origin
+	}
+	for _, rule := range req.IPRules {
+		ipRule := IPRule{Allow: rule.Allow, Ports: rule.Ports}
+		if rule.Prefix != nil {
+			ipRule.Prefix = *rule.Prefix
+		}
+		result.IPRules = append(result.IPRules, ipRule)
+	}
+
+	return result
+}
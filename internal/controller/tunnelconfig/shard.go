@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnelconfig
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ConfigMapShardTypeValue is the label value for ConfigMaps holding sources
+	// that do not fit in the tunnel config ConfigMap.
+	ConfigMapShardTypeValue = "tunnel-config-shard"
+	// SourcesDataKey is the key in a shard's ConfigMap.Data for the sources JSON.
+	SourcesDataKey = "sources.json"
+
+	// MaxConfigDataSize is the size in bytes above which sources are moved to shard
+	// ConfigMaps. Kubernetes limits a ConfigMap to 1 MiB; the margin leaves room for
+	// metadata.
+	MaxConfigDataSize = 900 * 1024
+)
+
+// ShardRef references a shard ConfigMap of a tunnel configuration.
+type ShardRef struct {
+	// Name is the shard ConfigMap name.
+	Name string `json:"name"`
+
+	// Hash is the hash of the shard's sources. It changes the tunnel config
+	// ConfigMap whenever a shard changes, so the change is synced.
+	Hash string `json:"hash"`
+}
+
+// ShardConfigMapName returns the name of a shard ConfigMap for a tunnel.
+// Shards are numbered from 1.
+func ShardConfigMapName(tunnelID string, index int) string {
+	return fmt.Sprintf("%s-shard-%d", ConfigMapName(tunnelID), index)
+}
+
+// LoadConfig parses the TunnelConfig from a tunnel config ConfigMap and merges
+// the sources stored in its shard ConfigMaps.
+func LoadConfig(ctx context.Context, c client.Reader, cm *corev1.ConfigMap) (*TunnelConfig, error) {
+	config, err := ParseConfig(cm)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range config.Shards {
+		shard := &corev1.ConfigMap{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: cm.Namespace}, shard); err != nil {
+			return nil, fmt.Errorf("failed to get config shard %s: %w", ref.Name, err)
+		}
+
+		var sources map[string]*SourceConfig
+		if err := json.Unmarshal([]byte(shard.Data[SourcesDataKey]), &sources); err != nil {
+			return nil, fmt.Errorf("failed to parse config shard %s: %w", ref.Name, err)
+		}
+		for key, source := range sources {
+			config.Sources[key] = source
+		}
+		config.shardConfigMaps = append(config.shardConfigMaps, shard)
+	}
+
+	return config, nil
+}
+
+// StoreConfig writes the configuration to the tunnel config ConfigMap, creating it
+// when it has no ResourceVersion yet. When the configuration exceeds MaxConfigDataSize,
+// its sources are moved to shard ConfigMaps, and shards no longer needed are deleted.
+// The config must have been read with LoadConfig.
+func StoreConfig(ctx context.Context, c client.Client, cm *corev1.ConfigMap, config *TunnelConfig) error {
+	config.Shards = nil
+	data, err := config.ToConfigMapData()
+	if err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+
+	var shards []map[string]*SourceConfig
+	if size := len(data[ConfigDataKey]); size > MaxConfigDataSize {
+		if shards, err = splitSources(config.Sources); err != nil {
+			return err
+		}
+		log.FromContext(ctx).Info("Tunnel config exceeds the ConfigMap size limit, storing sources in shards",
+			"configMap", cm.Name, "size", size, "limit", MaxConfigDataSize, "shards", len(shards))
+
+		header := *config
+		header.Sources = map[string]*SourceConfig{}
+		for i, sources := range shards {
+			ref, err := writeShard(ctx, c, cm, config, i+1, sources)
+			if err != nil {
+				return err
+			}
+			header.Shards = append(header.Shards, ref)
+		}
+		if data, err = header.ToConfigMapData(); err != nil {
+			return fmt.Errorf("failed to serialize config: %w", err)
+		}
+		config.Shards = header.Shards
+	}
+
+	cm.Data = data
+	if cm.ResourceVersion == "" {
+		err = c.Create(ctx, cm)
+	} else {
+		err = c.Update(ctx, cm)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Delete shards that are no longer needed
+	for _, shard := range config.shardConfigMaps {
+		if shardIndex(config.TunnelID, shard.Name) <= len(shards) {
+			continue
+		}
+		if err := c.Delete(ctx, shard); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete config shard %s: %w", shard.Name, err)
+		}
+	}
+	return nil
+}
+
+// splitSources packs the sources, in key order, into groups that each fit in a ConfigMap.
+func splitSources(sources map[string]*SourceConfig) ([]map[string]*SourceConfig, error) {
+	keys := make([]string, 0, len(sources))
+	for key := range sources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var shards []map[string]*SourceConfig
+	current := map[string]*SourceConfig{}
+	size := 2 // {}
+	for _, key := range keys {
+		entry, err := json.Marshal(map[string]*SourceConfig{key: sources[key]})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal source %s: %w", key, err)
+		}
+		entrySize := len(entry) - 1 // without braces, with a separating comma
+		if entrySize+2 > MaxConfigDataSize {
+			return nil, fmt.Errorf("source %s is %d bytes, which exceeds the ConfigMap size limit of %d bytes",
+				key, entrySize, MaxConfigDataSize)
+		}
+		if size+entrySize > MaxConfigDataSize {
+			shards = append(shards, current)
+			current = map[string]*SourceConfig{}
+			size = 2
+		}
+		current[key] = sources[key]
+		size += entrySize
+	}
+	if len(current) > 0 {
+		shards = append(shards, current)
+	}
+	return shards, nil
+}
+
+// writeShard creates or updates a shard ConfigMap. A shard read by LoadConfig is
+// updated from that copy, so a concurrent change fails with a conflict.
+func writeShard(
+	ctx context.Context,
+	c client.Client,
+	cm *corev1.ConfigMap,
+	config *TunnelConfig,
+	index int,
+	sources map[string]*SourceConfig,
+) (ShardRef, error) {
+	raw, err := json.Marshal(sources)
+	if err != nil {
+		return ShardRef{}, fmt.Errorf("failed to marshal config shard: %w", err)
+	}
+	hash := sha256.Sum256(raw)
+	ref := ShardRef{Name: ShardConfigMapName(config.TunnelID, index), Hash: fmt.Sprintf("%x", hash[:8])}
+	data := map[string]string{SourcesDataKey: string(raw)}
+
+	for _, existing := range config.shardConfigMaps {
+		if existing.Name != ref.Name {
+			continue
+		}
+		if existing.Data[SourcesDataKey] == data[SourcesDataKey] {
+			return ref, nil
+		}
+		existing.Data = data
+		if err := c.Update(ctx, existing); err != nil {
+			return ShardRef{}, fmt.Errorf("failed to update config shard %s: %w", ref.Name, err)
+		}
+		return ref, nil
+	}
+
+	shard := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ref.Name,
+			Namespace: cm.Namespace,
+			Labels: map[string]string{
+				ConfigMapLabelTunnelID: config.TunnelID,
+				ConfigMapLabelType:     ConfigMapShardTypeValue,
+			},
+			OwnerReferences: cm.OwnerReferences,
+		},
+		Data: data,
+	}
+	if err := c.Create(ctx, shard); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// Created by a concurrent writer, retry from a fresh read
+			return ShardRef{}, apierrors.NewConflict(
+				schema.GroupResource{Resource: "configmaps"}, ref.Name, err)
+		}
+		return ShardRef{}, fmt.Errorf("failed to create config shard %s: %w", ref.Name, err)
+	}
+	config.shardConfigMaps = append(config.shardConfigMaps, shard)
+	return ref, nil
+}
+
+// shardIndex returns the index of a shard ConfigMap of the tunnel, or 0 if the name is not a shard name.
+func shardIndex(tunnelID, name string) int {
+	var index int
+	if _, err := fmt.Sscanf(name, ConfigMapName(tunnelID)+"-shard-%d", &index); err != nil {
+		return 0
+	}
+	return index
+}
+
+// deleteShards deletes all shard ConfigMaps of a tunnel.
+func deleteShards(ctx context.Context, c client.Client, namespace, tunnelID string) error {
+	shards := &corev1.ConfigMapList{}
+	if err := c.List(ctx, shards,
+		client.InNamespace(namespace),
+		client.MatchingLabels{
+			ConfigMapLabelTunnelID: tunnelID,
+			ConfigMapLabelType:     ConfigMapShardTypeValue,
+		},
+	); err != nil {
+		return fmt.Errorf("failed to list config shards: %w", err)
+	}
+	for i := range shards.Items {
+		if err := c.Delete(ctx, &shards.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete config shard %s: %w", shards.Items[i].Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnelconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace = "cloudflare-operator-system"
+	testTunnelID  = "tunnel-1"
+)
+
+// largeSources returns count Ingress sources of about 1 KiB each.
+func largeSources(count int) map[string]*SourceConfig {
+	sources := make(map[string]*SourceConfig, count)
+	for i := range count {
+		source := &SourceConfig{
+			Kind:      SourceKindIngress,
+			Namespace: "default",
+			Name:      fmt.Sprintf("app-%04d", i),
+			Rules: []IngressRule{{
+				Hostname: fmt.Sprintf("app-%04d.example.com", i),
+				Path:     "/" + strings.Repeat("p", 900),
+				Service:  fmt.Sprintf("http://app-%04d.default.svc:80", i),
+			}},
+		}
+		sources[source.GetSourceKey()] = source
+	}
+	return sources
+}
+
+func storeLargeConfig(t *testing.T, c client.Client, count int) *TunnelConfig {
+	t.Helper()
+	config := &TunnelConfig{TunnelID: testTunnelID, AccountID: "account", Sources: largeSources(count)}
+	require.NoError(t, StoreConfig(context.Background(), c, NewConfigMap(testNamespace, testTunnelID, nil, metav1.GroupVersionKind{}), config))
+	return config
+}
+
+func listShards(t *testing.T, c client.Client) []corev1.ConfigMap {
+	t.Helper()
+	shards := &corev1.ConfigMapList{}
+	require.NoError(t, c.List(context.Background(), shards,
+		client.InNamespace(testNamespace),
+		client.MatchingLabels{ConfigMapLabelType: ConfigMapShardTypeValue}))
+	return shards.Items
+}
+
+func getPrimary(t *testing.T, c client.Client) *corev1.ConfigMap {
+	t.Helper()
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: ConfigMapName(testTunnelID)}, cm))
+	return cm
+}
+
+func TestStoreConfig_SmallConfigIsNotSharded(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	storeLargeConfig(t, c, 10)
+
+	assert.Empty(t, listShards(t, c))
+	config, err := ParseConfig(getPrimary(t, c))
+	require.NoError(t, err)
+	assert.Len(t, config.Sources, 10)
+	assert.Empty(t, config.Shards)
+}
+
+func TestStoreConfig_ShardsConfigPastTheLimit(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	original := storeLargeConfig(t, c, 2000)
+
+	primary := getPrimary(t, c)
+	assert.LessOrEqual(t, len(primary.Data[ConfigDataKey]), MaxConfigDataSize)
+	shards := listShards(t, c)
+	require.GreaterOrEqual(t, len(shards), 2)
+	for _, shard := range shards {
+		assert.LessOrEqual(t, len(shard.Data[SourcesDataKey]), MaxConfigDataSize, shard.Name)
+		assert.Equal(t, testTunnelID, shard.Labels[ConfigMapLabelTunnelID])
+	}
+	assert.Equal(t, ShardConfigMapName(testTunnelID, 1), "tunnel-config-tunnel-1-shard-1")
+
+	// The reader merges the shards back into the full configuration
+	writer := NewWriter(c, testNamespace)
+	config, err := writer.GetTunnelConfig(context.Background(), testTunnelID)
+	require.NoError(t, err)
+	assert.Len(t, config.Sources, 2000)
+	assert.Len(t, config.Shards, len(shards))
+	assert.Equal(t, original.ComputeHash(), config.ComputeHash())
+	assert.Len(t, config.AggregateRules(), 2000)
+}
+
+func TestWriter_UpdatesShardedConfig(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	storeLargeConfig(t, c, 2000)
+	writer := NewWriter(c, testNamespace)
+	ctx := context.Background()
+	before, err := ParseConfig(getPrimary(t, c))
+	require.NoError(t, err)
+
+	// Adding a source changes a shard and the shard hashes recorded in the primary
+	source := &SourceConfig{
+		Kind: SourceKindIngress, Namespace: "default", Name: "new",
+		Rules: []IngressRule{{Hostname: "new.example.com", Service: "http://new.default.svc:80"}},
+	}
+	require.NoError(t, writer.WriteSourceConfig(ctx, testTunnelID, "account", source, nil, metav1.GroupVersionKind{}))
+
+	after, err := ParseConfig(getPrimary(t, c))
+	require.NoError(t, err)
+	assert.NotEqual(t, before.Shards, after.Shards, "the primary changes so the controller syncs the change")
+	config, err := writer.GetTunnelConfig(ctx, testTunnelID)
+	require.NoError(t, err)
+	assert.Len(t, config.Sources, 2001)
+	assert.Contains(t, config.Sources, source.GetSourceKey())
+
+	// Removing a source stored in a shard
+	require.NoError(t, writer.RemoveSourceConfig(ctx, testTunnelID, SourceKey(SourceKindIngress, "default", "app-1999")))
+	config, err = writer.GetTunnelConfig(ctx, testTunnelID)
+	require.NoError(t, err)
+	assert.Len(t, config.Sources, 2000)
+	assert.NotContains(t, config.Sources, SourceKey(SourceKindIngress, "default", "app-1999"))
+	ids, err := writer.SourceTunnelIDs(ctx, SourceKey(SourceKindIngress, "default", "app-0500"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{testTunnelID}, ids)
+}
+
+func TestStoreConfig_RemovesShardsWhenConfigShrinks(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	storeLargeConfig(t, c, 2000)
+	require.NotEmpty(t, listShards(t, c))
+
+	ctx := context.Background()
+	primary := getPrimary(t, c)
+	config, err := LoadConfig(ctx, c, primary)
+	require.NoError(t, err)
+	config.Sources = largeSources(10)
+	require.NoError(t, StoreConfig(ctx, c, primary, config))
+
+	assert.Empty(t, listShards(t, c))
+	stored, err := ParseConfig(getPrimary(t, c))
+	require.NoError(t, err)
+	assert.Len(t, stored.Sources, 10)
+	assert.Empty(t, stored.Shards)
+}
+
+func TestStoreConfig_SourceTooLarge(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	source := &SourceConfig{
+		Kind: SourceKindIngress, Namespace: "default", Name: "huge",
+		Rules: []IngressRule{{Hostname: "huge.example.com", Path: "/" + strings.Repeat("p", MaxConfigDataSize), Service: "http://huge"}},
+	}
+	config := &TunnelConfig{TunnelID: testTunnelID, Sources: map[string]*SourceConfig{source.GetSourceKey(): source}}
+
+	err := StoreConfig(context.Background(), c, NewConfigMap(testNamespace, testTunnelID, nil, metav1.GroupVersionKind{}), config)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Ingress/default/huge")
+	assert.Contains(t, err.Error(), "exceeds the ConfigMap size limit")
+	assert.Empty(t, listShards(t, c))
+}
+
+func TestWriter_DeleteConfigMapDeletesShards(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	storeLargeConfig(t, c, 2000)
+
+	require.NoError(t, NewWriter(c, testNamespace).DeleteConfigMap(context.Background(), testTunnelID))
+
+	assert.Empty(t, listShards(t, c))
+}
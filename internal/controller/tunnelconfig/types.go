@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,10 @@ const (
 	SourceKindTunnelBinding = "TunnelBinding"
 	// SourceKindHTTPRoute represents an HTTPRoute source.
 	SourceKindHTTPRoute = "HTTPRoute"
+	// SourceKindTCPRoute represents a TCPRoute source.
+	SourceKindTCPRoute = "TCPRoute"
+	// SourceKindTLSRoute represents a TLSRoute source.
+	SourceKindTLSRoute = "TLSRoute"
 
 	// PriorityTunnelSettings is the priority for Tunnel/ClusterTunnel settings (highest).
 	PriorityTunnelSettings = 10
@@ -45,6 +50,10 @@ const (
 	PriorityIngress = 100
 	// PriorityGateway is the priority for Gateway API rules.
 	PriorityGateway = 100
+
+	// DefaultFallbackTarget is the service of the catch-all rule when the tunnel
+	// does not set a FallbackTarget.
+	DefaultFallbackTarget = "http_status:404"
 )
 
 // TunnelConfig represents the aggregated tunnel configuration stored in a ConfigMap.
@@ -75,6 +84,13 @@ type TunnelConfig struct {
 
 	// CredentialsRef references the credentials to use for this tunnel.
 	CredentialsRef *CredentialsRef `json:"credentialsRef,omitempty"`
+
+	// Shards references the shard ConfigMaps holding the sources when the
+	// configuration is too large for a single ConfigMap.
+	Shards []ShardRef `json:"shards,omitempty"`
+
+	// shardConfigMaps are the shard ConfigMaps read by LoadConfig.
+	shardConfigMaps []*corev1.ConfigMap
 }
 
 // CredentialsRef references Cloudflare credentials.
@@ -118,6 +134,9 @@ type TunnelSettings struct {
 	// WARPRouting indicates if WARP routing should be enabled.
 	WARPRouting bool `json:"warpRouting,omitempty"`
 
+	// FallbackTarget is the service for requests that match no rule.
+	FallbackTarget string `json:"fallbackTarget,omitempty"`
+
 	// OriginRequest contains default origin request settings.
 	OriginRequest *OriginRequestConfig `json:"originRequest,omitempty"`
 }
@@ -148,6 +167,9 @@ type OriginRequestConfig struct {
 	// OriginServerName is the TLS server name for the origin.
 	OriginServerName string `json:"originServerName,omitempty"`
 
+	// CAPool is the path to the CA certificate used to verify the origin.
+	CAPool string `json:"caPool,omitempty"`
+
 	// NoTLSVerify disables TLS verification.
 	NoTLSVerify bool `json:"noTLSVerify,omitempty"`
 
@@ -248,26 +270,89 @@ func (c *TunnelConfig) ComputeHash() string {
 	return fmt.Sprintf("%x", hash[:8])
 }
 
-// AggregateRules aggregates all rules from all sources into a single list.
-// Rules are sorted by priority and then by hostname.
+// AggregateRules aggregates all rules from all sources into a single list in the
+// order cloudflared should evaluate them. cloudflared uses the first matching rule,
+// so the most specific rules come first: exact hostnames before wildcards before
+// rules for any host, and for the same hostname, longer paths before shorter ones.
+// Ties are broken by priority (lower first) and then by source key, so the order
+// is the same on every reconcile. Catch-all rules come last.
 func (c *TunnelConfig) AggregateRules() []IngressRule {
-	var allRules []IngressRule
+	keys := make([]string, 0, len(c.Sources))
+	for key := range c.Sources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
 
-	for _, source := range c.Sources {
-		allRules = append(allRules, source.Rules...)
+	var allRules []IngressRule
+	for _, key := range keys {
+		allRules = append(allRules, c.Sources[key].Rules...)
 	}
 
-	// Sort by priority (lower first), then by hostname
-	sort.Slice(allRules, func(i, j int) bool {
-		if allRules[i].Priority != allRules[j].Priority {
-			return allRules[i].Priority < allRules[j].Priority
-		}
-		return allRules[i].Hostname < allRules[j].Hostname
+	sort.SliceStable(allRules, func(i, j int) bool {
+		return ruleLess(allRules[i], allRules[j])
 	})
 
 	return allRules
 }
 
+// IsCatchAll reports whether the rule matches every request.
+func (r IngressRule) IsCatchAll() bool {
+	return (r.Hostname == "" || r.Hostname == "*") && (r.Path == "" || r.Path == "*")
+}
+
+// ruleLess reports whether rule a is more specific than rule b.
+func ruleLess(a, b IngressRule) bool {
+	if a.IsCatchAll() != b.IsCatchAll() {
+		return b.IsCatchAll()
+	}
+
+	hostA, hostB := strings.ToLower(a.Hostname), strings.ToLower(b.Hostname)
+	if rankA, rankB := hostnameRank(hostA), hostnameRank(hostB); rankA != rankB {
+		return rankA < rankB
+	}
+	if labelsA, labelsB := strings.Count(hostA, "."), strings.Count(hostB, "."); labelsA != labelsB {
+		return labelsA > labelsB
+	}
+	if hostA != hostB {
+		return hostA < hostB
+	}
+
+	if len(a.Path) != len(b.Path) {
+		return len(a.Path) > len(b.Path)
+	}
+	if a.Path != b.Path {
+		return a.Path < b.Path
+	}
+
+	return a.Priority < b.Priority
+}
+
+// hostnameRank orders hostnames by how many hosts they match: exact hostnames,
+// then wildcards, then rules for any host.
+func hostnameRank(hostname string) int {
+	switch {
+	case hostname == "" || hostname == "*":
+		return 2
+	case strings.HasPrefix(hostname, "*"):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// GetFallbackTarget returns the service of the catch-all rule that ends the
+// configuration: the FallbackTarget of the Tunnel or ClusterTunnel source, or
+// DefaultFallbackTarget.
+func (c *TunnelConfig) GetFallbackTarget() string {
+	for _, source := range c.Sources {
+		if (source.Kind == SourceKindTunnel || source.Kind == SourceKindClusterTunnel) &&
+			source.Settings != nil && source.Settings.FallbackTarget != "" {
+			return source.Settings.FallbackTarget
+		}
+	}
+	return DefaultFallbackTarget
+}
+
 // IsWARPRoutingEnabled checks if any source has WARP routing enabled.
 func (c *TunnelConfig) IsWARPRoutingEnabled() bool {
 	if c.WARPRouting != nil && c.WARPRouting.Enabled {
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnelconfig
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orderingConfig returns a configuration whose rules come from several sources
+// in an order unrelated to their specificity.
+func orderingConfig() *TunnelConfig {
+	sources := []*SourceConfig{
+		{Kind: SourceKindTunnel, Namespace: "default", Name: "tunnel",
+			Settings: &TunnelSettings{FallbackTarget: "http_status:503"}},
+		ingressSource("catch-all", IngressRule{Service: "http_status:404", Priority: PriorityIngress}),
+		ingressSource("wildcard",
+			IngressRule{Hostname: "*.example.com", Service: "http://wildcard:80", Priority: PriorityIngress}),
+		ingressSource("app",
+			IngressRule{Hostname: "app.example.com", Service: "http://app:80", Priority: PriorityIngress},
+			IngressRule{Hostname: "app.example.com", Path: "/api", Service: "http://api:80", Priority: PriorityIngress}),
+		{Kind: SourceKindTunnelBinding, Namespace: "default", Name: "binding", Rules: []IngressRule{
+			{Hostname: "app.example.com", Path: "/api/v2", Service: "http://api-v2:80", Priority: PriorityBinding},
+			{Hostname: "*.dev.example.com", Service: "http://dev:80", Priority: PriorityBinding},
+			{Path: "/health", Service: "http://health:80", Priority: PriorityBinding},
+		}},
+		ingressSource("blog",
+			IngressRule{Hostname: "blog.example.com", Service: "http://blog:80", Priority: PriorityIngress}),
+	}
+
+	config := &TunnelConfig{TunnelID: testTunnelID, Sources: map[string]*SourceConfig{}}
+	for _, source := range sources {
+		config.Sources[source.GetSourceKey()] = source
+	}
+	return config
+}
+
+func services(rules []IngressRule) []string {
+	result := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, rule.Service)
+	}
+	return result
+}
+
+func TestAggregateRules_MostSpecificFirst(t *testing.T) {
+	assert.Equal(t, []string{
+		"http://api-v2:80",
+		"http://api:80",
+		"http://app:80",
+		"http://blog:80",
+		"http://dev:80",
+		"http://wildcard:80",
+		"http://health:80",
+		"http_status:404",
+	}, services(orderingConfig().AggregateRules()))
+}
+
+func TestAggregateRules_StableAcrossReconciles(t *testing.T) {
+	config := orderingConfig()
+	// Identical hostname and path from two sources: the source key decides
+	config.Sources["Ingress/default/same-a"] = ingressSource("same-a",
+		IngressRule{Hostname: "same.example.com", Service: "http://a:80", Priority: PriorityIngress})
+	config.Sources["Ingress/default/same-b"] = ingressSource("same-b",
+		IngressRule{Hostname: "same.example.com", Service: "http://b:80", Priority: PriorityIngress})
+
+	want := config.AggregateRules()
+	for range 50 {
+		require.Equal(t, want, config.AggregateRules())
+	}
+	assert.Less(t, slices.Index(services(want), "http://a:80"), slices.Index(services(want), "http://b:80"))
+}
+
+func TestBuildCloudflareConfig_FallbackLastAndOnce(t *testing.T) {
+	config := orderingConfig()
+	cfConfig := (&Reconciler{}).buildCloudflareConfig(config)
+
+	require.NotEmpty(t, cfConfig.Ingress)
+	assert.Equal(t, cloudflare.UnvalidatedIngressRule{Service: "http_status:503"},
+		cfConfig.Ingress[len(cfConfig.Ingress)-1], "the tunnel's fallback ends the configuration")
+	catchAlls := 0
+	for _, rule := range cfConfig.Ingress {
+		if rule.Hostname == "" && rule.Path == "" {
+			catchAlls++
+		}
+	}
+	assert.Equal(t, 1, catchAlls, "source catch-all rules are replaced by the fallback")
+
+	for range 20 {
+		assert.Equal(t, cfConfig, (&Reconciler{}).buildCloudflareConfig(config))
+	}
+
+	// Without a tunnel source the default fallback is used
+	delete(config.Sources, "Tunnel/default/tunnel")
+	cfConfig = (&Reconciler{}).buildCloudflareConfig(config)
+	assert.Equal(t, DefaultFallbackTarget, cfConfig.Ingress[len(cfConfig.Ingress)-1].Service)
+}
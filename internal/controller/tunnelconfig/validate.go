@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnelconfig
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ReasonInvalidIngressRules is the event and condition reason used when the
+// ingress rules of a source are rejected by the Writer.
+const ReasonInvalidIngressRules = "InvalidIngressRules"
+
+// Limits of the origin request settings accepted in a rule.
+const (
+	// MaxOriginTimeout is the longest timeout or keep-alive interval of an origin request.
+	MaxOriginTimeout = time.Hour
+
+	// MaxKeepAliveConnections is the largest number of idle connections kept open to an origin.
+	MaxKeepAliveConnections = 1000
+)
+
+// serviceSchemes are the URL schemes cloudflared accepts for an origin service.
+var serviceSchemes = map[string]bool{
+	"http": true, "https": true, "ws": true, "wss": true,
+	"tcp": true, "udp": true, "ssh": true, "rdp": true, "smb": true,
+	"unix": true, "unix+tls": true,
+}
+
+// RuleValidationError reports the ingress rules of a source that cannot be merged
+// into the tunnel configuration. The source's previous contribution is kept.
+type RuleValidationError struct {
+	// Source is the key of the rejected source.
+	Source string
+
+	// Problems describes each invalid rule.
+	Problems []string
+}
+
+func (e *RuleValidationError) Error() string {
+	return fmt.Sprintf("ingress rules of %s rejected: %s", e.Source, strings.Join(e.Problems, "; "))
+}
+
+// ValidateSourceRules checks the rules of a source before they are merged into
+// the configuration: hostnames must be valid DNS names, optionally with a leading
+// wildcard, services must be valid cloudflared origins, and paths valid regular
+// expressions. A hostname and path already claimed by another source is a
+// conflict; the source that claimed it first keeps it. Catch-all rules never
+// conflict, as the tunnel's fallback replaces them.
+func ValidateSourceRules(config *TunnelConfig, source *SourceConfig) error {
+	sourceKey := source.GetSourceKey()
+
+	// Rules the source already had are kept even if another source now
+	// claims them, so two sources conflicting before validation existed
+	// do not lock each other out.
+	owned := make(map[string]bool)
+	if previous, ok := config.Sources[sourceKey]; ok {
+		for _, rule := range previous.Rules {
+			owned[ruleKey(rule)] = true
+		}
+	}
+
+	claimed := make(map[string]string)
+	for key, other := range config.Sources {
+		if key == sourceKey {
+			continue
+		}
+		for _, rule := range other.Rules {
+			claimed[ruleKey(rule)] = key
+		}
+	}
+
+	var problems []string
+	for _, rule := range source.Rules {
+		if err := validateHostname(rule.Hostname); err != nil {
+			problems = append(problems, err.Error())
+		}
+		if err := validateService(rule.Service); err != nil {
+			problems = append(problems, fmt.Sprintf("rule for %q: %v", rule.Hostname, err))
+		}
+		if _, err := regexp.Compile(rule.Path); err != nil {
+			problems = append(problems, fmt.Sprintf("rule for %q: invalid path %q: %v", rule.Hostname, rule.Path, err))
+		}
+		if err := ValidateOriginRequest(rule.OriginRequest); err != nil {
+			problems = append(problems, fmt.Sprintf("rule for %q: %v", rule.Hostname, err))
+		}
+		if other, ok := claimed[ruleKey(rule)]; ok && !owned[ruleKey(rule)] && !rule.IsCatchAll() {
+			problems = append(problems, fmt.Sprintf("hostname %q with path %q is already used by %s",
+				rule.Hostname, rule.Path, other))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return &RuleValidationError{Source: sourceKey, Problems: problems}
+}
+
+// ruleKey identifies the requests a rule matches.
+func ruleKey(rule IngressRule) string {
+	return strings.ToLower(rule.Hostname) + "\x00" + rule.Path
+}
+
+// validateHostname checks a rule hostname. An empty hostname or "*" matches every host.
+func validateHostname(hostname string) error {
+	if hostname == "" || hostname == "*" {
+		return nil
+	}
+	name := strings.TrimPrefix(strings.ToLower(hostname), "*.")
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("hostname %q is not valid: %s", hostname, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// validateService checks a rule service: a URL with a supported scheme, or one
+// of cloudflared's built-in services.
+func validateService(service string) error {
+	switch {
+	case service == "":
+		return fmt.Errorf("service is empty")
+	case service == "hello_world" || service == "bastion" || service == "socks5":
+		return nil
+	case strings.HasPrefix(service, "http_status:"):
+		code, err := strconv.Atoi(strings.TrimPrefix(service, "http_status:"))
+		if err != nil || code < 100 || code > 599 {
+			return fmt.Errorf("service %q has an invalid HTTP status code", service)
+		}
+		return nil
+	}
+
+	u, err := url.Parse(service)
+	if err != nil {
+		return fmt.Errorf("service %q is not a valid URL: %w", service, err)
+	}
+	if !serviceSchemes[u.Scheme] {
+		return fmt.Errorf("service %q has unsupported scheme %q", service, u.Scheme)
+	}
+	if strings.HasPrefix(u.Scheme, "unix") {
+		if u.Path == "" && u.Opaque == "" {
+			return fmt.Errorf("service %q has no socket path", service)
+		}
+		return nil
+	}
+	if u.Host == "" {
+		return fmt.Errorf("service %q has no host", service)
+	}
+	return nil
+}
+
+// ValidateOriginRequest checks the timeouts and keep-alive settings of a rule's
+// origin request. Unset settings are left to cloudflared's defaults.
+func ValidateOriginRequest(req *OriginRequestConfig) error {
+	if req == nil {
+		return nil
+	}
+
+	var errs []error
+	timeouts := []struct{ name, value string }{
+		{"connectTimeout", req.ConnectTimeout},
+		{"tlsTimeout", req.TLSTimeout},
+		{"tcpKeepAlive", req.TCPKeepAlive},
+		{"keepAliveTimeout", req.KeepAliveTimeout},
+	}
+	for _, timeout := range timeouts {
+		if timeout.value == "" {
+			continue
+		}
+		if err := ValidateOriginTimeout(timeout.name, timeout.value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if req.KeepAliveConnections != 0 {
+		if err := ValidateKeepAliveConnections("keepAliveConnections", req.KeepAliveConnections); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateOriginTimeout checks that value is a duration greater than 0 and at most MaxOriginTimeout.
+func ValidateOriginTimeout(name, value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("%s %q is not a valid duration", name, value)
+	}
+	if d <= 0 || d > MaxOriginTimeout {
+		return fmt.Errorf("%s %s must be greater than 0s and at most %s", name, value, MaxOriginTimeout)
+	}
+	return nil
+}
+
+// ValidateKeepAliveConnections checks that n is between 1 and MaxKeepAliveConnections.
+func ValidateKeepAliveConnections(name string, n int) error {
+	if n < 1 || n > MaxKeepAliveConnections {
+		return fmt.Errorf("%s %d must be between 1 and %d", name, n, MaxKeepAliveConnections)
+	}
+	return nil
+}
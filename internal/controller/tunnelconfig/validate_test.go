@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnelconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func ingressSource(name string, rules ...IngressRule) *SourceConfig {
+	return &SourceConfig{Kind: SourceKindIngress, Namespace: "default", Name: name, Rules: rules}
+}
+
+func TestValidateSourceRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    IngressRule
+		wantErr string
+	}{
+		{name: "valid http", rule: IngressRule{Hostname: "app.example.com", Service: "http://app.default.svc:80"}},
+		{name: "wildcard hostname", rule: IngressRule{Hostname: "*.example.com", Service: "https://app:443"}},
+		{name: "catch-all hostname", rule: IngressRule{Service: "http_status:404"}},
+		{name: "tcp service", rule: IngressRule{Hostname: "db.example.com", Service: "tcp://db.default.svc:5432"}},
+		{name: "unix socket", rule: IngressRule{Hostname: "app.example.com", Service: "unix:/run/app.sock"}},
+		{name: "built-in service", rule: IngressRule{Hostname: "app.example.com", Service: "hello_world"}},
+		{
+			name:    "invalid hostname",
+			rule:    IngressRule{Hostname: "app_1.example.com", Service: "http://app:80"},
+			wantErr: `hostname "app_1.example.com" is not valid`,
+		},
+		{
+			name:    "empty service",
+			rule:    IngressRule{Hostname: "app.example.com"},
+			wantErr: "service is empty",
+		},
+		{
+			name:    "unsupported scheme",
+			rule:    IngressRule{Hostname: "app.example.com", Service: "ftp://app:21"},
+			wantErr: `unsupported scheme "ftp"`,
+		},
+		{
+			name:    "service without host",
+			rule:    IngressRule{Hostname: "app.example.com", Service: "app.default.svc:80"},
+			wantErr: "unsupported scheme",
+		},
+		{
+			name:    "invalid status code",
+			rule:    IngressRule{Hostname: "app.example.com", Service: "http_status:999"},
+			wantErr: "invalid HTTP status code",
+		},
+		{
+			name:    "invalid path",
+			rule:    IngressRule{Hostname: "app.example.com", Path: "/(api", Service: "http://app:80"},
+			wantErr: `invalid path "/(api"`,
+		},
+		{
+			name: "origin request settings",
+			rule: IngressRule{Hostname: "app.example.com", Service: "http://app:80", OriginRequest: &OriginRequestConfig{
+				ConnectTimeout: "30s", TLSTimeout: "10s", TCPKeepAlive: "15s", KeepAliveTimeout: "1m30s",
+				KeepAliveConnections: 100,
+			}},
+		},
+		{
+			name: "zero timeout",
+			rule: IngressRule{Hostname: "app.example.com", Service: "http://app:80",
+				OriginRequest: &OriginRequestConfig{ConnectTimeout: "0s"}},
+			wantErr: "connectTimeout 0s must be greater than 0s and at most 1h0m0s",
+		},
+		{
+			name: "timeout too long",
+			rule: IngressRule{Hostname: "app.example.com", Service: "http://app:80",
+				OriginRequest: &OriginRequestConfig{KeepAliveTimeout: "2h"}},
+			wantErr: "keepAliveTimeout 2h must be greater than 0s and at most 1h0m0s",
+		},
+		{
+			name: "invalid timeout",
+			rule: IngressRule{Hostname: "app.example.com", Service: "http://app:80",
+				OriginRequest: &OriginRequestConfig{TLSTimeout: "soon"}},
+			wantErr: `tlsTimeout "soon" is not a valid duration`,
+		},
+		{
+			name: "too many keep-alive connections",
+			rule: IngressRule{Hostname: "app.example.com", Service: "http://app:80",
+				OriginRequest: &OriginRequestConfig{KeepAliveConnections: 5000}},
+			wantErr: "keepAliveConnections 5000 must be between 1 and 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &TunnelConfig{Sources: map[string]*SourceConfig{}}
+			err := ValidateSourceRules(config, ingressSource("app", tt.rule))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var ruleErr *RuleValidationError
+			require.True(t, errors.As(err, &ruleErr))
+			assert.Equal(t, "Ingress/default/app", ruleErr.Source)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateSourceRules_KeepsRulesTheSourceAlreadyOwns(t *testing.T) {
+	rule := IngressRule{Hostname: "app.example.com", Service: "http://app:80"}
+	first, second := ingressSource("first", rule), ingressSource("second", rule)
+	config := &TunnelConfig{Sources: map[string]*SourceConfig{
+		first.GetSourceKey():  first,
+		second.GetSourceKey(): second,
+	}}
+
+	// Both sources had the rule before validation existed; neither is locked out
+	assert.NoError(t, ValidateSourceRules(config, first))
+	assert.NoError(t, ValidateSourceRules(config, second))
+}
+
+func TestWriteSourceConfig_RejectsDuplicateHostnameFromAnotherSource(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	w := NewWriter(c, testNamespace)
+
+	first := ingressSource("first",
+		IngressRule{Hostname: "app.example.com", Path: "/api", Service: "http://api:80"})
+	require.NoError(t, w.WriteSourceConfig(ctx, testTunnelID, "account", first, nil, metav1.GroupVersionKind{}))
+
+	second := &SourceConfig{
+		Kind:      SourceKindTunnelBinding,
+		Namespace: "default",
+		Name:      "second",
+		Rules: []IngressRule{
+			{Hostname: "APP.example.com", Path: "/api", Service: "http://other:80"},
+			{Hostname: "other.example.com", Service: "http://other:80"},
+		},
+	}
+	err := w.WriteSourceConfig(ctx, testTunnelID, "account", second, nil, metav1.GroupVersionKind{})
+	var ruleErr *RuleValidationError
+	require.True(t, errors.As(err, &ruleErr))
+	assert.Equal(t, "TunnelBinding/default/second", ruleErr.Source)
+	assert.Equal(t, []string{`hostname "APP.example.com" with path "/api" is already used by Ingress/default/first`},
+		ruleErr.Problems)
+
+	// Only the offending source is rejected; the first source's rules are intact
+	config, err := w.GetTunnelConfig(ctx, testTunnelID)
+	require.NoError(t, err)
+	assert.Len(t, config.Sources, 1)
+	assert.Equal(t, first.Rules, config.Sources[first.GetSourceKey()].Rules)
+
+	// A different path on the same hostname does not conflict
+	second.Rules[0].Path = "/web"
+	require.NoError(t, w.WriteSourceConfig(ctx, testTunnelID, "account", second, nil, metav1.GroupVersionKind{}))
+	config, err = w.GetTunnelConfig(ctx, testTunnelID)
+	require.NoError(t, err)
+	assert.Len(t, config.Sources, 2)
+	assert.Len(t, config.AggregateRules(), 3)
+}
@@ -31,7 +31,9 @@ func NewWriter(c client.Client, operatorNamespace string) *Writer {
 }
 
 // WriteSourceConfig writes a source configuration to the tunnel's ConfigMap.
-// If the ConfigMap doesn't exist, it will be created.
+// If the ConfigMap doesn't exist, it will be created. Rules that fail
+// ValidateSourceRules are rejected with a *RuleValidationError, leaving the
+// configuration, including the source's previous rules, unchanged.
 func (w *Writer) WriteSourceConfig(
 	ctx context.Context,
 	tunnelID string,
@@ -50,6 +52,16 @@ func (w *Writer) WriteSourceConfig(
 			return err
 		}
 
+		// Reject invalid rules before they reach the merged configuration
+		if err := ValidateSourceRules(config, source); err != nil {
+			logger.Info("Rejected ingress rules of source",
+				"configMap", cm.Name,
+				"tunnelId", tunnelID,
+				"source", sourceKey,
+				"error", err.Error())
+			return err
+		}
+
 		// Update source
 		now := metav1.Now()
 		source.UpdatedAt = &now
@@ -60,29 +72,18 @@ func (w *Writer) WriteSourceConfig(
 			config.AccountID = accountID
 		}
 
-		// Serialize and update
-		data, err := config.ToConfigMapData()
-		if err != nil {
-			return fmt.Errorf("failed to serialize config: %w", err)
-		}
-
-		cm.Data = data
-
 		if cm.ResourceVersion == "" {
-			// Create new ConfigMap
 			logger.Info("Creating tunnel config ConfigMap",
 				"configMap", cm.Name,
 				"tunnelId", tunnelID,
 				"source", sourceKey)
-			return w.client.Create(ctx, cm)
+		} else {
+			logger.V(1).Info("Updating tunnel config ConfigMap",
+				"configMap", cm.Name,
+				"tunnelId", tunnelID,
+				"source", sourceKey)
 		}
-
-		// Update existing ConfigMap
-		logger.V(1).Info("Updating tunnel config ConfigMap",
-			"configMap", cm.Name,
-			"tunnelId", tunnelID,
-			"source", sourceKey)
-		return w.client.Update(ctx, cm)
+		return StoreConfig(ctx, w.client, cm, config)
 	})
 }
 
@@ -110,7 +111,7 @@ func (w *Writer) RemoveSourceConfig(
 		}
 
 		// Parse config
-		config, err := ParseConfig(cm)
+		config, err := LoadConfig(ctx, w.client, cm)
 		if err != nil {
 			return err
 		}
@@ -129,15 +130,36 @@ func (w *Writer) RemoveSourceConfig(
 			"source", sourceKey,
 			"remainingSources", len(config.Sources))
 
-		// Serialize and update
-		data, err := config.ToConfigMapData()
+		return StoreConfig(ctx, w.client, cm, config)
+	})
+}
+
+// SourceTunnelIDs returns the IDs of the tunnels whose ConfigMap contains the source.
+func (w *Writer) SourceTunnelIDs(ctx context.Context, sourceKey string) ([]string, error) {
+	cmList := &corev1.ConfigMapList{}
+	if err := w.client.List(ctx, cmList,
+		client.InNamespace(w.operatorNamespace),
+		client.MatchingLabels{ConfigMapLabelType: ConfigMapTypeValue},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list tunnel config ConfigMaps: %w", err)
+	}
+
+	var tunnelIDs []string
+	for i := range cmList.Items {
+		config, err := LoadConfig(ctx, w.client, &cmList.Items[i])
 		if err != nil {
-			return fmt.Errorf("failed to serialize config: %w", err)
+			continue
 		}
-
-		cm.Data = data
-		return w.client.Update(ctx, cm)
-	})
+		if _, exists := config.Sources[sourceKey]; !exists {
+			continue
+		}
+		tunnelID := config.TunnelID
+		if tunnelID == "" {
+			tunnelID = cmList.Items[i].Labels[ConfigMapLabelTunnelID]
+		}
+		tunnelIDs = append(tunnelIDs, tunnelID)
+	}
+	return tunnelIDs, nil
 }
 
 // SetTunnelSettings sets tunnel-level settings (from Tunnel/ClusterTunnel).
@@ -178,18 +200,7 @@ func (w *Writer) SetTunnelSettings(
 			config.Sources[source.GetSourceKey()] = source
 		}
 
-		// Serialize and update
-		data, err := config.ToConfigMapData()
-		if err != nil {
-			return fmt.Errorf("failed to serialize config: %w", err)
-		}
-
-		cm.Data = data
-
-		if cm.ResourceVersion == "" {
-			return w.client.Create(ctx, cm)
-		}
-		return w.client.Update(ctx, cm)
+		return StoreConfig(ctx, w.client, cm, config)
 	})
 }
 
@@ -212,7 +223,7 @@ func (w *Writer) getOrCreateConfigMap(
 
 	if err == nil {
 		// Parse existing config
-		config, parseErr := ParseConfig(cm)
+		config, parseErr := LoadConfig(ctx, w.client, cm)
 		if parseErr != nil {
 			return nil, nil, parseErr
 		}
@@ -250,11 +261,15 @@ func (w *Writer) GetTunnelConfig(ctx context.Context, tunnelID string) (*TunnelC
 		return nil, err
 	}
 
-	return ParseConfig(cm)
+	return LoadConfig(ctx, w.client, cm)
 }
 
-// DeleteConfigMap deletes the tunnel configuration ConfigMap.
+// DeleteConfigMap deletes the tunnel configuration ConfigMap and its shards.
 func (w *Writer) DeleteConfigMap(ctx context.Context, tunnelID string) error {
+	if err := deleteShards(ctx, w.client, w.operatorNamespace, tunnelID); err != nil {
+		return err
+	}
+
 	cm := &corev1.ConfigMap{}
 	err := w.client.Get(ctx, types.NamespacedName{
 		Name:      ConfigMapName(tunnelID),
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
 	"github.com/StringKe/cloudflare-operator/internal/credentials"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -77,7 +78,7 @@ func getAPIDetails(
 	// For cluster-scoped resources (empty namespace), use operator namespace for legacy secrets
 	secretNamespace := namespace
 	if secretNamespace == "" {
-		secretNamespace = OperatorNamespace
+		secretNamespace = common.OperatorNamespace
 	}
 
 	// Create credentials loader
@@ -134,7 +135,7 @@ func getAPIDetails(
 		if err := c.Get(ctx, apitypes.NamespacedName{Name: tunnelSpec.Cloudflare.CredentialsRef.Name}, credsResource); err == nil {
 			credsSecretNamespace := credsResource.Spec.SecretRef.Namespace
 			if credsSecretNamespace == "" {
-				credsSecretNamespace = OperatorNamespace
+				credsSecretNamespace = common.OperatorNamespace
 			}
 			if err := c.Get(ctx, apitypes.NamespacedName{
 				Name:      credsResource.Spec.SecretRef.Name,
@@ -151,11 +152,7 @@ func getAPIDetails(
 // CreateCloudflareClientFromCreds creates a Cloudflare API client from loaded credentials.
 // If CLOUDFLARE_API_BASE_URL environment variable is set, it uses that as the API base URL.
 func CreateCloudflareClientFromCreds(creds *credentials.Credentials) (*cloudflare.API, error) {
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := cf.GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
+	opts := cf.ClientOptions()
 
 	switch creds.AuthType {
 	case networkingv1alpha2.AuthTypeAPIToken:
@@ -180,11 +177,7 @@ func CreateCloudflareClientFromCreds(creds *credentials.Credentials) (*cloudflar
 //
 //nolint:unused // kept for backward compatibility
 func getCloudflareClient(apiKey, apiEmail, apiToken string) (*cloudflare.API, error) {
-	// Build options list - add custom base URL if configured
-	var opts []cloudflare.Option
-	if baseURL := cf.GetAPIBaseURL(); baseURL != "" {
-		opts = append(opts, cloudflare.BaseURL(baseURL))
-	}
+	opts := cf.ClientOptions()
 
 	if apiToken != "" {
 		return cloudflare.NewWithAPIToken(apiToken, opts...)
@@ -231,7 +224,7 @@ func ResolveCredentialsForService(
 	// For cluster-scoped resources (empty namespace), use operator namespace for legacy secrets
 	secretNamespace := namespace
 	if secretNamespace == "" {
-		secretNamespace = OperatorNamespace
+		secretNamespace = common.OperatorNamespace
 	}
 
 	// Create credentials loader
@@ -14,11 +14,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
@@ -28,6 +31,18 @@ import (
 
 const (
 	finalizerName = "virtualnetwork.networking.cloudflare-operator.io/finalizer"
+
+	// ConditionTypeDefaultNetwork reports whether the virtual network is the account's default.
+	ConditionTypeDefaultNetwork = "DefaultNetwork"
+
+	// Reasons for the DefaultNetwork condition
+	ReasonDefault         = "Default"
+	ReasonNotDefault      = "NotDefault"
+	ReasonDefaultConflict = "DefaultConflict"
+	ReasonAccountDefault  = "AccountDefault"
+
+	// ReasonDeleteBlocked is used when the default virtual network cannot be deleted yet.
+	ReasonDeleteBlocked = "DeleteBlocked"
 )
 
 // Reconciler reconciles a VirtualNetwork object.
@@ -108,6 +123,12 @@ func (r *Reconciler) handleDeletion(
 		logger.Error(err, "Failed to get API client for deletion")
 		// Continue with finalizer removal
 	} else if vnet.Status.VirtualNetworkId != "" {
+		// Cloudflare keeps exactly one default virtual network, which cannot be deleted
+		existing, err := apiResult.API.GetVirtualNetwork(ctx, vnet.Status.VirtualNetworkId)
+		if err == nil && existing.IsDefaultNetwork && existing.DeletedAt == nil {
+			return r.blockDefaultDeletion(ctx, vnet)
+		}
+
 		// Delete virtual network from Cloudflare
 		logger.Info("Deleting VirtualNetwork from Cloudflare",
 			"virtualNetworkId", vnet.Status.VirtualNetworkId)
@@ -150,11 +171,21 @@ func (r *Reconciler) syncVirtualNetwork(
 	// Determine virtual network name
 	vnetName := vnet.GetVirtualNetworkName()
 
+	// Only one VirtualNetwork can claim the account default
+	conflict, err := r.findDefaultConflict(ctx, vnet)
+	if err != nil {
+		return r.updateStatusError(ctx, vnet, err)
+	}
+	if conflict != "" {
+		r.Recorder.Event(vnet, corev1.EventTypeWarning, ReasonDefaultConflict,
+			fmt.Sprintf("VirtualNetwork '%s' already claims the account default", conflict))
+	}
+
 	// Build params
 	params := cf.VirtualNetworkParams{
 		Name:             vnetName,
 		Comment:          r.buildManagedComment(vnet),
-		IsDefaultNetwork: vnet.Spec.IsDefaultNetwork,
+		IsDefaultNetwork: vnet.Spec.IsDefaultNetwork && conflict == "",
 	}
 
 	// Check if virtual network already exists by ID
@@ -174,7 +205,7 @@ func (r *Reconciler) syncVirtualNetwork(
 				"virtualNetworkId", existing.ID,
 				"name", vnetName)
 
-			result, err := apiResult.API.UpdateVirtualNetwork(ctx, existing.ID, params)
+			result, err := apiResult.API.UpdateVirtualNetwork(ctx, existing.ID, keepDefault(params, existing))
 			if err != nil {
 				logger.Error(err, "Failed to update VirtualNetwork")
 				return r.updateStatusError(ctx, vnet, err)
@@ -183,7 +214,7 @@ func (r *Reconciler) syncVirtualNetwork(
 			r.Recorder.Event(vnet, corev1.EventTypeNormal, "Updated",
 				fmt.Sprintf("VirtualNetwork '%s' updated in Cloudflare", vnetName))
 
-			return r.updateStatusReady(ctx, vnet, apiResult.AccountID, result)
+			return r.updateStatusReady(ctx, vnet, apiResult.AccountID, result, conflict)
 		}
 	}
 
@@ -201,7 +232,7 @@ func (r *Reconciler) syncVirtualNetwork(
 			"name", vnetName)
 
 		// Update the existing virtual network
-		result, err := apiResult.API.UpdateVirtualNetwork(ctx, existingByName.ID, params)
+		result, err := apiResult.API.UpdateVirtualNetwork(ctx, existingByName.ID, keepDefault(params, existingByName))
 		if err != nil {
 			logger.Error(err, "Failed to update existing VirtualNetwork")
 			return r.updateStatusError(ctx, vnet, err)
@@ -210,7 +241,7 @@ func (r *Reconciler) syncVirtualNetwork(
 		r.Recorder.Event(vnet, corev1.EventTypeNormal, "Adopted",
 			fmt.Sprintf("Adopted existing VirtualNetwork '%s'", vnetName))
 
-		return r.updateStatusReady(ctx, vnet, apiResult.AccountID, result)
+		return r.updateStatusReady(ctx, vnet, apiResult.AccountID, result, conflict)
 	}
 
 	// Create new virtual network
@@ -226,7 +257,79 @@ func (r *Reconciler) syncVirtualNetwork(
 	r.Recorder.Event(vnet, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("VirtualNetwork '%s' created in Cloudflare", vnetName))
 
-	return r.updateStatusReady(ctx, vnet, apiResult.AccountID, result)
+	return r.updateStatusReady(ctx, vnet, apiResult.AccountID, result, conflict)
+}
+
+// keepDefault keeps the default flag of a virtual network that is currently the default.
+// Cloudflare always keeps one default virtual network: it is moved by making another
+// virtual network the default, not by unsetting it.
+func keepDefault(params cf.VirtualNetworkParams, existing *cf.VirtualNetworkResult) cf.VirtualNetworkParams {
+	if existing.IsDefaultNetwork {
+		params.IsDefaultNetwork = true
+	}
+	return params
+}
+
+// findDefaultConflict returns the name of another VirtualNetwork that claims the
+// account default before this one, or "" if this virtual network may claim it. The
+// oldest claiming resource wins, with the name as tie-breaker, so the outcome is stable.
+func (r *Reconciler) findDefaultConflict(
+	ctx context.Context,
+	vnet *networkingv1alpha2.VirtualNetwork,
+) (string, error) {
+	if !vnet.Spec.IsDefaultNetwork {
+		return "", nil
+	}
+
+	vnets := &networkingv1alpha2.VirtualNetworkList{}
+	if err := r.List(ctx, vnets); err != nil {
+		return "", fmt.Errorf("failed to list VirtualNetworks: %w", err)
+	}
+
+	winner := vnet
+	for i := range vnets.Items {
+		other := &vnets.Items[i]
+		if !other.Spec.IsDefaultNetwork || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if other.CreationTimestamp.Before(&winner.CreationTimestamp) ||
+			(other.CreationTimestamp.Equal(&winner.CreationTimestamp) && other.Name < winner.Name) {
+			winner = other
+		}
+	}
+
+	if winner.Name == vnet.Name {
+		return "", nil
+	}
+	return winner.Name, nil
+}
+
+// blockDefaultDeletion keeps the finalizer of the default virtual network until another
+// virtual network becomes the default, since Cloudflare refuses to delete it.
+func (r *Reconciler) blockDefaultDeletion(
+	ctx context.Context,
+	vnet *networkingv1alpha2.VirtualNetwork,
+) (ctrl.Result, error) {
+	message := "VirtualNetwork is the account default and cannot be deleted; make another virtual network the default first"
+	log.FromContext(ctx).Info("Deletion of default VirtualNetwork blocked",
+		"virtualNetworkId", vnet.Status.VirtualNetworkId)
+	r.Recorder.Event(vnet, corev1.EventTypeWarning, ReasonDeleteBlocked, message)
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, vnet, func() {
+		meta.SetStatusCondition(&vnet.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: vnet.Generation,
+			Reason:             ReasonDeleteBlocked,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		})
+	})
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	return common.RequeueLong(), nil
 }
 
 // buildManagedComment builds a comment with management marker.
@@ -246,7 +349,7 @@ func (r *Reconciler) updateStatusError(
 			Type:               "Ready",
 			Status:             metav1.ConditionFalse,
 			ObservedGeneration: vnet.Generation,
-			Reason:             "Error",
+			Reason:             common.ErrorReason(err),
 			Message:            cf.SanitizeErrorMessage(err),
 			LastTransitionTime: metav1.Now(),
 		})
@@ -265,7 +368,10 @@ func (r *Reconciler) updateStatusReady(
 	vnet *networkingv1alpha2.VirtualNetwork,
 	accountID string,
 	result *cf.VirtualNetworkResult,
+	conflict string,
 ) (ctrl.Result, error) {
+	defaultCondition := defaultNetworkCondition(vnet, result.IsDefaultNetwork, conflict)
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, vnet, func() {
 		vnet.Status.AccountId = accountID
 		vnet.Status.VirtualNetworkId = result.ID
@@ -275,10 +381,11 @@ func (r *Reconciler) updateStatusReady(
 			Type:               "Ready",
 			Status:             metav1.ConditionTrue,
 			ObservedGeneration: vnet.Generation,
-			Reason:             "Synced",
+			Reason:             common.ReasonReady,
 			Message:            "VirtualNetwork synced to Cloudflare",
 			LastTransitionTime: metav1.Now(),
 		})
+		meta.SetStatusCondition(&vnet.Status.Conditions, defaultCondition)
 		vnet.Status.ObservedGeneration = vnet.Generation
 	})
 
@@ -289,6 +396,68 @@ func (r *Reconciler) updateStatusReady(
 	return common.NoRequeue(), nil
 }
 
+// defaultNetworkCondition describes whether the virtual network is the account default and why.
+func defaultNetworkCondition(
+	vnet *networkingv1alpha2.VirtualNetwork,
+	isDefault bool,
+	conflict string,
+) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               ConditionTypeDefaultNetwork,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: vnet.Generation,
+		Reason:             ReasonNotDefault,
+		Message:            "Virtual network is not the account default",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	switch {
+	case conflict != "":
+		condition.Reason = ReasonDefaultConflict
+		condition.Message = fmt.Sprintf("VirtualNetwork '%s' already claims the account default", conflict)
+		if isDefault {
+			condition.Status = metav1.ConditionTrue
+		}
+	case isDefault && vnet.Spec.IsDefaultNetwork:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonDefault
+		condition.Message = "Virtual network is the account default"
+	case isDefault:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonAccountDefault
+		condition.Message = "Virtual network remains the account default until another virtual network is made the default"
+	}
+	return condition
+}
+
+// findVirtualNetworksForDefaultChange returns the other VirtualNetworks involved in the
+// account default, so that they re-evaluate the default when a virtual network changes or is deleted.
+func (r *Reconciler) findVirtualNetworksForDefaultChange(ctx context.Context, obj client.Object) []reconcile.Request {
+	changed, ok := obj.(*networkingv1alpha2.VirtualNetwork)
+	if !ok {
+		return nil
+	}
+
+	vnets := &networkingv1alpha2.VirtualNetworkList{}
+	if err := r.List(ctx, vnets); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list VirtualNetworks for default change")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, vnet := range vnets.Items {
+		if vnet.Name == changed.Name {
+			continue
+		}
+		if vnet.Spec.IsDefaultNetwork || vnet.Status.IsDefault {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: vnet.Name},
+			})
+		}
+	}
+	return requests
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Recorder = mgr.GetEventRecorderFor("virtualnetwork-controller")
@@ -298,6 +467,10 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&networkingv1alpha2.VirtualNetwork{}).
+		Watches(
+			&networkingv1alpha2.VirtualNetwork{},
+			handler.EnqueueRequestsFromMapFunc(r.findVirtualNetworksForDefaultChange),
+		).
 		Named("virtualnetwork").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.VirtualNetwork{}, r))
 }
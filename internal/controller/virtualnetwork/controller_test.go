@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package virtualnetwork
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.VirtualNetwork{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestVirtualNetwork(name string, created time.Time, isDefault bool) *networkingv1alpha2.VirtualNetwork {
+	return &networkingv1alpha2.VirtualNetwork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Generation:        1,
+			CreationTimestamp: metav1.NewTime(created),
+			Finalizers:        []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.VirtualNetworkSpec{
+			Comment:          "managed by tests",
+			IsDefaultNetwork: isDefault,
+		},
+	}
+}
+
+func reconcileVirtualNetwork(
+	t *testing.T, r *Reconciler, c client.Client, name string,
+) (ctrl.Result, *networkingv1alpha2.VirtualNetwork) {
+	t.Helper()
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.VirtualNetwork{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: name}, updated))
+	return result, updated
+}
+
+func TestReconcile_SetsDefaultAndDemotesPrevious(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateVirtualNetwork(&models.VirtualNetwork{ID: "vnet-old", Name: "old", IsDefaultNetwork: true})
+	r, c := newTestReconciler(t, newTestVirtualNetwork("production", time.Now(), true))
+
+	_, updated := reconcileVirtualNetwork(t, r, c, "production")
+
+	require.Equal(t, "active", updated.Status.State)
+	assert.True(t, updated.Status.IsDefault)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDefaultNetwork)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, ReasonDefault, cond.Reason)
+
+	remote, ok := mock.Store().GetVirtualNetwork(updated.Status.VirtualNetworkId)
+	require.True(t, ok)
+	assert.True(t, remote.IsDefaultNetwork)
+	assert.Contains(t, remote.Comment, "managed by tests")
+	previous, _ := mock.Store().GetVirtualNetwork("vnet-old")
+	assert.False(t, previous.IsDefaultNetwork)
+}
+
+func TestReconcile_UnsetDefaultKeepsAccountDefault(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateVirtualNetwork(&models.VirtualNetwork{ID: "vnet-1", Name: "production", IsDefaultNetwork: true})
+	vnet := newTestVirtualNetwork("production", time.Now(), false)
+	vnet.Status.VirtualNetworkId = "vnet-1"
+	r, c := newTestReconciler(t, vnet)
+
+	_, updated := reconcileVirtualNetwork(t, r, c, "production")
+
+	// Cloudflare cannot unset the default, so the network stays the default
+	require.Equal(t, "active", updated.Status.State)
+	assert.True(t, updated.Status.IsDefault)
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDefaultNetwork)
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonAccountDefault, cond.Reason)
+
+	remote, _ := mock.Store().GetVirtualNetwork("vnet-1")
+	assert.True(t, remote.IsDefaultNetwork)
+}
+
+func TestReconcile_OldestVirtualNetworkWinsDefault(t *testing.T) {
+	mock := newMockServer(t)
+	now := time.Now()
+	r, c := newTestReconciler(t,
+		newTestVirtualNetwork("older", now.Add(-time.Hour), true),
+		newTestVirtualNetwork("newer", now, true),
+	)
+
+	_, older := reconcileVirtualNetwork(t, r, c, "older")
+	_, newer := reconcileVirtualNetwork(t, r, c, "newer")
+
+	assert.True(t, older.Status.IsDefault)
+	require.Equal(t, "active", newer.Status.State)
+	assert.False(t, newer.Status.IsDefault)
+	cond := meta.FindStatusCondition(newer.Status.Conditions, ConditionTypeDefaultNetwork)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonDefaultConflict, cond.Reason)
+	assert.Contains(t, cond.Message, "older")
+
+	remote, _ := mock.Store().GetVirtualNetwork(older.Status.VirtualNetworkId)
+	assert.True(t, remote.IsDefaultNetwork)
+}
+
+func TestReconcile_DeletingDefaultIsBlocked(t *testing.T) {
+	mock := newMockServer(t)
+	mock.Store().CreateVirtualNetwork(&models.VirtualNetwork{ID: "vnet-1", Name: "production", IsDefaultNetwork: true})
+	mock.Store().CreateVirtualNetwork(&models.VirtualNetwork{ID: "vnet-2", Name: "staging"})
+	vnet := newTestVirtualNetwork("production", time.Now(), true)
+	vnet.Status.VirtualNetworkId = "vnet-1"
+	vnet.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	r, c := newTestReconciler(t, vnet)
+
+	result, blocked := reconcileVirtualNetwork(t, r, c, "production")
+
+	assert.Equal(t, common.RequeueLong(), result)
+	assert.Contains(t, blocked.Finalizers, finalizerName)
+	cond := meta.FindStatusCondition(blocked.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, ReasonDeleteBlocked, cond.Reason)
+	remote, _ := mock.Store().GetVirtualNetwork("vnet-1")
+	assert.Nil(t, remote.DeletedAt)
+
+	// Once another virtual network is the default, the deletion proceeds
+	mock.Store().UpdateVirtualNetwork("vnet-2", func(v *models.VirtualNetwork) { v.IsDefaultNetwork = true })
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "production"}})
+	require.NoError(t, err)
+
+	remote, _ = mock.Store().GetVirtualNetwork("vnet-1")
+	assert.NotNil(t, remote.DeletedAt)
+	err = c.Get(context.Background(), types.NamespacedName{Name: "production"}, &networkingv1alpha2.VirtualNetwork{})
+	assert.True(t, apierrors.IsNotFound(err), "finalizer removed and object deleted")
+}
+
+func TestReconcile_ConditionReasons(t *testing.T) {
+	newMockServer(t)
+	vnet := newTestVirtualNetwork("production", time.Now(), false)
+	r, c := newTestReconciler(t, vnet)
+
+	_, updated := reconcileVirtualNetwork(t, r, c, "production")
+
+	cond := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, common.ReasonReady, cond.Reason)
+
+	// Credentials that cannot be loaded are reported as such
+	updated.Spec.Cloudflare.CredentialsRef = &networkingv1alpha2.CloudflareCredentialsRef{Name: "missing"}
+	require.NoError(t, c.Update(context.Background(), updated))
+
+	_, updated = reconcileVirtualNetwork(t, r, c, "production")
+
+	assert.Equal(t, "error", updated.Status.State)
+	cond = meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, common.ReasonCredentialsInvalid, cond.Reason)
+}
@@ -7,6 +7,8 @@ package warpconnector
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -34,8 +36,40 @@ import (
 
 const (
 	finalizerName = "warpconnector.networking.cloudflare-operator.io/finalizer"
+
+	// AnnotationRotateToken is the annotation key to rotate the connector token.
+	// When this annotation value changes, the tunnel secret is replaced, the token
+	// Secret is updated and the connector pods are restarted with the new token.
+	AnnotationRotateToken = "cloudflare-operator.io/rotate-token"
+
+	// AnnotationLastRotateToken stores the last processed rotate-token value
+	AnnotationLastRotateToken = "cloudflare-operator.io/last-rotate-token"
+
+	// AnnotationTokenHash is set on the connector pod template so that a token
+	// change rolls out the Deployment.
+	AnnotationTokenHash = "cloudflare-operator.io/token-hash"
+
+	tokenSecretKey = "TUNNEL_TOKEN"
 )
 
+// tokenSecretName returns the name of the Secret holding the connector token.
+func tokenSecretName(connector *networkingv1alpha2.WARPConnector) string {
+	return connector.Name + "-token"
+}
+
+// tokenHash returns a short digest of a token for the pod template annotation.
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// rotateTokenRequested reports whether the rotate-token annotation has a value
+// that was not processed yet.
+func rotateTokenRequested(connector *networkingv1alpha2.WARPConnector) bool {
+	requested := connector.Annotations[AnnotationRotateToken]
+	return requested != "" && requested != connector.Annotations[AnnotationLastRotateToken]
+}
+
 // Reconciler reconciles a WARPConnector object.
 // It directly calls Cloudflare API and writes status back to the CRD.
 type Reconciler struct {
@@ -104,7 +138,7 @@ func (r *Reconciler) handleDeletion(
 
 	// Delete secret
 	secret := &corev1.Secret{}
-	secretName := connector.Name + "-token"
+	secretName := tokenSecretName(connector)
 	if err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: connector.Namespace}, secret); err == nil {
 		if err := r.Delete(ctx, secret); err != nil && !errors.IsNotFound(err) {
 			logger.Error(err, "Failed to delete Secret")
@@ -220,14 +254,24 @@ func (r *Reconciler) createWARPConnector(
 	r.Recorder.Event(connector, corev1.EventTypeNormal, "Created",
 		fmt.Sprintf("WARP Connector '%s' created in Cloudflare", connectorName))
 
-	// Create tunnel token secret
+	// Record the connector right away so a failure below never creates a duplicate
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, connector, func() {
+		connector.Status.ConnectorID = result.ID
+		connector.Status.TunnelID = result.TunnelID
+		connector.Status.AccountID = apiResult.AccountID
+	}); err != nil {
+		logger.Error(err, "Failed to record WARP Connector ID", "connectorId", result.ID)
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	// Persist the token returned with the connector
 	if err := r.reconcileSecret(ctx, connector, result.TunnelToken); err != nil {
 		logger.Error(err, "Failed to create tunnel token secret")
 		return r.updateStatusError(ctx, connector, err)
 	}
 
 	// Create deployment
-	if err := r.reconcileDeployment(ctx, connector); err != nil {
+	if err := r.reconcileDeployment(ctx, connector, tokenHash(result.TunnelToken)); err != nil {
 		logger.Error(err, "Failed to create deployment")
 		return r.updateStatusError(ctx, connector, err)
 	}
@@ -250,7 +294,12 @@ func (r *Reconciler) updateWARPConnector(
 ) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
+	if rotateTokenRequested(connector) {
+		return r.rotateToken(ctx, connector, apiResult, vnetID)
+	}
+
 	// Get existing tunnel token for secret reconciliation
+	hash := ""
 	tokenResult, err := apiResult.API.GetWARPConnectorToken(ctx, connector.Status.ConnectorID)
 	if err != nil {
 		logger.Error(err, "Failed to get tunnel token")
@@ -261,10 +310,63 @@ func (r *Reconciler) updateWARPConnector(
 			logger.Error(err, "Failed to update tunnel token secret")
 			return r.updateStatusError(ctx, connector, err)
 		}
+		hash = tokenHash(tokenResult.Token)
 	}
 
+	return r.syncDeployment(ctx, connector, apiResult, vnetID, hash)
+}
+
+// rotateToken replaces the connector token, persists it and rolls the Deployment.
+// The previous token stops working immediately.
+func (r *Reconciler) rotateToken(
+	ctx context.Context,
+	connector *networkingv1alpha2.WARPConnector,
+	apiResult *common.APIClientResult,
+	vnetID string,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	tokenResult, err := apiResult.API.RotateWARPConnectorToken(ctx, connector.Status.ConnectorID)
+	if err != nil {
+		logger.Error(err, "Failed to rotate tunnel token")
+		r.Recorder.Event(connector, corev1.EventTypeWarning, "TokenRotationFailed", cf.SanitizeErrorMessage(err))
+		return r.updateStatusError(ctx, connector, err)
+	}
+
+	if err := r.reconcileSecret(ctx, connector, tokenResult.Token); err != nil {
+		logger.Error(err, "Failed to update tunnel token secret")
+		return r.updateStatusError(ctx, connector, err)
+	}
+
+	if err := controller.UpdateWithConflictRetry(ctx, r.Client, connector, func() {
+		connector.Annotations[AnnotationLastRotateToken] = connector.Annotations[AnnotationRotateToken]
+	}); err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to record token rotation: %w", err)
+	}
+	if err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, connector, func() {
+		connector.Status.TokenRotatedAt = &metav1.Time{Time: time.Now()}
+	}); err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	logger.Info("WARP Connector token rotated", "connectorId", connector.Status.ConnectorID)
+	r.Recorder.Event(connector, corev1.EventTypeNormal, "TokenRotated",
+		fmt.Sprintf("Token rotated and written to Secret '%s'", tokenSecretName(connector)))
+
+	return r.syncDeployment(ctx, connector, apiResult, vnetID, tokenHash(tokenResult.Token))
+}
+
+// syncDeployment updates the connector Deployment and reports its status.
+func (r *Reconciler) syncDeployment(
+	ctx context.Context,
+	connector *networkingv1alpha2.WARPConnector,
+	apiResult *common.APIClientResult,
+	vnetID, hash string,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
 	// Update deployment
-	if err := r.reconcileDeployment(ctx, connector); err != nil {
+	if err := r.reconcileDeployment(ctx, connector, hash); err != nil {
 		logger.Error(err, "Failed to update deployment")
 		return r.updateStatusError(ctx, connector, err)
 	}
@@ -286,14 +388,14 @@ func (r *Reconciler) reconcileSecret(
 ) error {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      connector.Name + "-token",
+			Name:      tokenSecretName(connector),
 			Namespace: connector.Namespace,
 		},
 	}
 
 	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
 		secret.Data = map[string][]byte{
-			"TUNNEL_TOKEN": []byte(token),
+			tokenSecretKey: []byte(token),
 		}
 		return controllerutil.SetControllerReference(connector, secret, r.Scheme)
 	})
@@ -302,9 +404,11 @@ func (r *Reconciler) reconcileSecret(
 }
 
 // reconcileDeployment creates or updates the cloudflared deployment.
+// An empty hash keeps the token hash of the current pod template.
 func (r *Reconciler) reconcileDeployment(
 	ctx context.Context,
 	connector *networkingv1alpha2.WARPConnector,
+	hash string,
 ) error {
 	// Validate resource requirements
 	resources, err := r.buildResources(connector.Spec.Resources)
@@ -330,6 +434,10 @@ func (r *Reconciler) reconcileDeployment(
 			image = "cloudflare/cloudflared:latest"
 		}
 
+		if hash == "" {
+			hash = deployment.Spec.Template.Annotations[AnnotationTokenHash]
+		}
+
 		labels := map[string]string{
 			"app.kubernetes.io/name":       "warp-connector",
 			"app.kubernetes.io/instance":   connector.Name,
@@ -344,6 +452,9 @@ func (r *Reconciler) reconcileDeployment(
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: labels,
+					Annotations: map[string]string{
+						AnnotationTokenHash: hash,
+					},
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: connector.Spec.ServiceAccountName,
@@ -360,9 +471,9 @@ func (r *Reconciler) reconcileDeployment(
 									ValueFrom: &corev1.EnvVarSource{
 										SecretKeyRef: &corev1.SecretKeySelector{
 											LocalObjectReference: corev1.LocalObjectReference{
-												Name: connector.Name + "-token",
+												Name: tokenSecretName(connector),
 											},
-											Key: "TUNNEL_TOKEN",
+											Key: tokenSecretKey,
 										},
 									},
 								},
@@ -475,6 +586,7 @@ func (r *Reconciler) updateStatusSuccess(
 		connector.Status.TunnelID = tunnelID
 		connector.Status.VirtualNetworkID = virtualNetworkID
 		connector.Status.AccountID = accountID
+		connector.Status.TokenSecretName = tokenSecretName(connector)
 		connector.Status.ReadyReplicas = readyReplicas
 		connector.Status.RoutesConfigured = routesConfigured
 		connector.Status.State = "Ready"
@@ -551,5 +663,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.findWARPConnectorsForVirtualNetwork),
 		).
 		Named("warpconnector").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.WARPConnector{}, r))
 }
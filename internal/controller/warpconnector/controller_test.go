@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package warpconnector
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+)
+
+func newMockServer(t *testing.T) *mockserver.Server {
+	t.Helper()
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	return mock
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	objs = append(objs,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+			Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+		},
+		&networkingv1alpha2.CloudflareCredentials{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+				AccountID: "test-account-id",
+				AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+				SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+				IsDefault: true,
+			},
+		},
+	)
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&networkingv1alpha2.WARPConnector{}).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(20),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestConnector() *networkingv1alpha2.WARPConnector {
+	return &networkingv1alpha2.WARPConnector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "office",
+			Namespace:  "default",
+			Generation: 1,
+			Finalizers: []string{finalizerName},
+		},
+	}
+}
+
+func reconcileConnector(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.WARPConnector {
+	t.Helper()
+	key := types.NamespacedName{Name: "office", Namespace: "default"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.WARPConnector{}
+	require.NoError(t, c.Get(context.Background(), key, updated))
+	return updated
+}
+
+func getToken(t *testing.T, c client.Client) string {
+	t.Helper()
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "office-token", Namespace: "default"}, secret))
+	return string(secret.Data[tokenSecretKey])
+}
+
+func getDeployment(t *testing.T, c client.Client) *appsv1.Deployment {
+	t.Helper()
+	deployment := &appsv1.Deployment{}
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "office", Namespace: "default"}, deployment))
+	return deployment
+}
+
+func TestReconcile_CreatePersistsToken(t *testing.T) {
+	mock := newMockServer(t)
+	r, c := newTestReconciler(t, newTestConnector())
+
+	updated := reconcileConnector(t, r, c)
+
+	require.NotEmpty(t, updated.Status.ConnectorID)
+	assert.Equal(t, "Ready", updated.Status.State)
+	assert.Equal(t, "office-token", updated.Status.TokenSecretName)
+	_, ok := mock.Store().GetTunnel(updated.Status.ConnectorID)
+	assert.True(t, ok)
+
+	token := getToken(t, c)
+	require.NotEmpty(t, token)
+
+	deployment := getDeployment(t, c)
+	assert.Equal(t, tokenHash(token), deployment.Spec.Template.Annotations[AnnotationTokenHash])
+	container := deployment.Spec.Template.Spec.Containers[0]
+	require.Len(t, container.Env, 1)
+	assert.Equal(t, "office-token", container.Env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, tokenSecretKey, container.Env[0].ValueFrom.SecretKeyRef.Key)
+
+	// Later reconciles keep the same connector and token
+	again := reconcileConnector(t, r, c)
+	assert.Equal(t, updated.Status.ConnectorID, again.Status.ConnectorID)
+	assert.Equal(t, token, getToken(t, c))
+	assert.Len(t, mock.Store().ListTunnels("test-account-id"), 1)
+}
+
+func TestReconcile_RotateTokenOnDemand(t *testing.T) {
+	newMockServer(t)
+	r, c := newTestReconciler(t, newTestConnector())
+
+	created := reconcileConnector(t, r, c)
+	token := getToken(t, c)
+
+	created.Annotations = map[string]string{AnnotationRotateToken: "2026-10-16"}
+	require.NoError(t, c.Update(context.Background(), created))
+
+	rotated := reconcileConnector(t, r, c)
+
+	assert.Equal(t, created.Status.ConnectorID, rotated.Status.ConnectorID)
+	assert.Equal(t, "2026-10-16", rotated.Annotations[AnnotationLastRotateToken])
+	assert.NotNil(t, rotated.Status.TokenRotatedAt)
+	newToken := getToken(t, c)
+	assert.NotEqual(t, token, newToken)
+	assert.Equal(t, tokenHash(newToken), getDeployment(t, c).Spec.Template.Annotations[AnnotationTokenHash])
+
+	// The processed annotation does not rotate again
+	reconcileConnector(t, r, c)
+	assert.Equal(t, newToken, getToken(t, c))
+}
@@ -8,6 +8,8 @@ package zoneruleset
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,17 @@ import (
 
 const (
 	finalizerName = "cloudflare.com/zone-ruleset-finalizer"
+
+	// ConditionTypeDriftDetected reports whether the last sync found the Cloudflare ruleset
+	// different from the spec and updated it.
+	ConditionTypeDriftDetected = "DriftDetected"
+
+	// Reasons for the DriftDetected condition
+	ReasonInSync         = "InSync"
+	ReasonDriftCorrected = "DriftCorrected"
+
+	// driftCheckInterval is how often the ruleset is compared with Cloudflare.
+	driftCheckInterval = 30 * time.Minute
 )
 
 // Reconciler reconciles a ZoneRuleset object.
@@ -149,6 +162,8 @@ func (r *Reconciler) handleDeletion(
 }
 
 // syncZoneRuleset syncs the ZoneRuleset to Cloudflare.
+// The entrypoint ruleset is only updated when it differs from the spec, so that an unchanged
+// spec neither costs an update call nor bumps the ruleset version.
 func (r *Reconciler) syncZoneRuleset(
 	ctx context.Context,
 	ruleset *networkingv1alpha2.ZoneRuleset,
@@ -167,22 +182,83 @@ func (r *Reconciler) syncZoneRuleset(
 		description = fmt.Sprintf("Managed by cloudflare-operator: %s/%s", ruleset.Namespace, ruleset.Name)
 	}
 
+	current, err := apiResult.API.GetEntrypointRuleset(ctx, zoneID, phase)
+	if err != nil && !cf.IsNotFoundError(err) {
+		logger.Error(err, "Failed to get entrypoint ruleset")
+		return r.updateStatusError(ctx, ruleset, err)
+	}
+
+	// Rules Cloudflare already rejected are not part of the ruleset, so they are not drift
+	remaining, _, known := common.SkipRejectedRules(rules, ruleset.Status.Rules)
+	diffs := diffRuleset(current, description, remaining)
+	if len(diffs) == 0 && (len(remaining) > 0 || len(rules) == 0) {
+		logger.V(1).Info("Entrypoint ruleset is in sync, skipping update",
+			"zoneId", zoneID,
+			"phase", phase,
+			"rulesetId", current.ID)
+		statuses := common.RuleStatuses(ruleNames(ruleset), rules, known)
+		return r.updateStatusReady(ctx, ruleset, zoneID, current, statuses, nil)
+	}
+
 	// Update the entrypoint ruleset
 	logger.V(1).Info("Updating entrypoint ruleset in Cloudflare",
 		"zoneId", zoneID,
 		"phase", phase,
-		"rulesCount", len(rules))
+		"rulesCount", len(rules),
+		"drift", diffs)
 
-	result, err := apiResult.API.UpdateEntrypointRuleset(ctx, zoneID, phase, description, rules)
+	result, rejected, err := common.UpdateEntrypointRules(
+		ctx, apiResult.API, zoneID, phase, description, rules, ruleset.Status.Rules)
+	statuses := common.RuleStatuses(ruleNames(ruleset), rules, rejected)
 	if err != nil {
 		logger.Error(err, "Failed to update entrypoint ruleset")
+		if len(rejected) > 0 {
+			return common.UpdateStatusRulesRejected(ctx, r.Client, r.Recorder, ruleset, statuses,
+				func(msg string, ready metav1.Condition) {
+					ruleset.Status.Rules = statuses
+					ruleset.Status.State = networkingv1alpha2.ZoneRulesetStateError
+					ruleset.Status.Message = msg
+					meta.SetStatusCondition(&ruleset.Status.Conditions, ready)
+					ruleset.Status.ObservedGeneration = ruleset.Generation
+				})
+		}
 		return r.updateStatusError(ctx, ruleset, err)
 	}
 
+	if len(rejected) > 0 {
+		r.Recorder.Event(ruleset, corev1.EventTypeWarning, common.ReasonRulesRejected,
+			common.RejectedRulesMessage(statuses))
+	}
 	r.Recorder.Event(ruleset, corev1.EventTypeNormal, "Updated",
 		fmt.Sprintf("ZoneRuleset for zone '%s' phase '%s' updated in Cloudflare", zoneName, phase))
 
-	return r.updateStatusReady(ctx, ruleset, zoneID, result.ID, len(rules))
+	return r.updateStatusReady(ctx, ruleset, zoneID, result, statuses, diffs)
+}
+
+// ruleNames returns the name reported in the rule status of each rule: its ref, or its description.
+func ruleNames(ruleset *networkingv1alpha2.ZoneRuleset) []string {
+	names := make([]string, len(ruleset.Spec.Rules))
+	for i, rule := range ruleset.Spec.Rules {
+		names[i] = rule.Ref
+		if names[i] == "" {
+			names[i] = rule.Description
+		}
+	}
+	return names
+}
+
+// diffRuleset returns the differences between the current entrypoint ruleset and the desired
+// description and rules. A missing ruleset is reported as a single difference.
+func diffRuleset(current *cf.RulesetResult, description string, rules []cloudflare.RulesetRule) []string {
+	if current == nil {
+		return []string{"entrypoint ruleset does not exist"}
+	}
+
+	var diffs []string
+	if current.Description != description {
+		diffs = append(diffs, "description changed")
+	}
+	return append(diffs, cf.DiffRulesetRules(rules, current.Rules)...)
 }
 
 // buildRules builds Cloudflare ruleset rules from the spec.
@@ -308,23 +384,54 @@ func (r *Reconciler) updateStatusError(
 func (r *Reconciler) updateStatusReady(
 	ctx context.Context,
 	ruleset *networkingv1alpha2.ZoneRuleset,
-	zoneID, rulesetID string,
-	rulesCount int,
+	zoneID string,
+	result *cf.RulesetResult,
+	statuses []networkingv1alpha2.RuleStatus,
+	diffs []string,
 ) (ctrl.Result, error) {
+	ready := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: ruleset.Generation,
+		Reason:             "Synced",
+		Message:            "ZoneRuleset synced to Cloudflare",
+		LastTransitionTime: metav1.Now(),
+	}
+	state := networkingv1alpha2.ZoneRulesetStateReady
+	if msg := common.RejectedRulesMessage(statuses); msg != "" {
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = common.ReasonRulesRejected
+		ready.Message = msg
+		state = networkingv1alpha2.ZoneRulesetStateError
+	}
+
+	drift := metav1.Condition{
+		Type:               ConditionTypeDriftDetected,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: ruleset.Generation,
+		Reason:             ReasonInSync,
+		Message:            "Cloudflare ruleset matches the spec",
+		LastTransitionTime: metav1.Now(),
+	}
+	if len(diffs) > 0 {
+		drift.Status = metav1.ConditionTrue
+		drift.Reason = ReasonDriftCorrected
+		drift.Message = fmt.Sprintf("Cloudflare ruleset was updated: %s", strings.Join(diffs, "; "))
+	}
+
 	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, ruleset, func() {
 		ruleset.Status.ZoneID = zoneID
-		ruleset.Status.RulesetID = rulesetID
-		ruleset.Status.RuleCount = rulesCount
-		ruleset.Status.State = networkingv1alpha2.ZoneRulesetStateReady
-		ruleset.Status.Message = "ZoneRuleset synced to Cloudflare"
-		meta.SetStatusCondition(&ruleset.Status.Conditions, metav1.Condition{
-			Type:               "Ready",
-			Status:             metav1.ConditionTrue,
-			ObservedGeneration: ruleset.Generation,
-			Reason:             "Synced",
-			Message:            "ZoneRuleset synced to Cloudflare",
-			LastTransitionTime: metav1.Now(),
-		})
+		ruleset.Status.RulesetID = result.ID
+		ruleset.Status.RulesetVersion = result.Version
+		if !result.LastUpdated.IsZero() {
+			ruleset.Status.LastUpdated = &metav1.Time{Time: result.LastUpdated}
+		}
+		ruleset.Status.RuleCount = len(result.Rules)
+		ruleset.Status.Rules = statuses
+		ruleset.Status.State = state
+		ruleset.Status.Message = ready.Message
+		meta.SetStatusCondition(&ruleset.Status.Conditions, ready)
+		meta.SetStatusCondition(&ruleset.Status.Conditions, drift)
 		ruleset.Status.ObservedGeneration = ruleset.Generation
 	})
 
@@ -332,7 +439,8 @@ func (r *Reconciler) updateStatusReady(
 		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
 	}
 
-	return common.NoRequeue(), nil
+	// Requeue to detect changes made outside of the operator
+	return common.RequeueResult(driftCheckInterval), nil
 }
 
 // findRulesetsForCredentials returns ZoneRulesets that reference the given credentials
@@ -385,5 +493,5 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(&networkingv1alpha2.CloudflareCredentials{},
 			handler.EnqueueRequestsFromMapFunc(r.findRulesetsForCredentials)).
 		Named("zoneruleset").
-		Complete(r)
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.ZoneRuleset{}, r))
 }
@@ -0,0 +1,318 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package zoneruleset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const testPhase = "http_request_firewall_custom"
+
+// rulesetStub serves zone lookup and the entrypoint ruleset of testPhase, counting updates.
+type rulesetStub struct {
+	mu       sync.Mutex
+	ruleset  *cloudflare.Ruleset
+	updates  int
+	attempts int
+}
+
+func newRulesetStub(t *testing.T, ruleset *cloudflare.Ruleset) *rulesetStub {
+	t.Helper()
+	stub := &rulesetStub{ruleset: ruleset}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],` +
+			`"result":[{"id":"zone-123","name":"example.com","status":"active"}],` +
+			`"result_info":{"page":1,"per_page":50,"total_pages":1,"count":1,"total_count":1}}`))
+	})
+	mux.HandleFunc("/zones/zone-123/rulesets/phases/"+testPhase+"/entrypoint", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		if req.Method == http.MethodPut {
+			var body cloudflare.Ruleset
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			stub.attempts++
+			// Reject rules with unknown fields like the Cloudflare expression validator
+			for _, rule := range body.Rules {
+				if strings.Contains(rule.Expression, "http.hots") {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = fmt.Fprintf(w, `{"success":false,"errors":[{"code":20021,"message":%q}],"messages":[],"result":null}`,
+						fmt.Sprintf("'%s' is not a valid value for expression because the expression is invalid: "+
+							"Filter parsing error (1:2): unknown identifier", rule.Expression))
+					return
+				}
+			}
+			stub.updates++
+			body.ID = "ruleset-123"
+			body.Phase = testPhase
+			body.Version = ptr.To(fmt.Sprint(stub.updates + 1))
+			stub.ruleset = &body
+		}
+
+		if stub.ruleset == nil {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":10003,"message":"not found"}],"messages":[],"result":null}`))
+			return
+		}
+		result, _ := json.Marshal(stub.ruleset)
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, result)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL)
+	return stub
+}
+
+func newTestReconciler(t *testing.T, ruleset *networkingv1alpha2.ZoneRuleset) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "account-123",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			ruleset,
+		).
+		WithStatusSubresource(ruleset).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(10),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func newTestRuleset() *networkingv1alpha2.ZoneRuleset {
+	return &networkingv1alpha2.ZoneRuleset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "waf",
+			Namespace:  "default",
+			Generation: 1,
+			Finalizers: []string{finalizerName},
+		},
+		Spec: networkingv1alpha2.ZoneRulesetSpec{
+			Zone:        "example.com",
+			Phase:       networkingv1alpha2.RulesetPhaseHTTPRequestFirewallCustom,
+			Description: "WAF rules",
+			Rules: []networkingv1alpha2.RulesetRule{
+				{
+					Description: "Block bad IP",
+					Expression:  `(ip.src eq 192.0.2.1)`,
+					Action:      networkingv1alpha2.RulesetRuleActionBlock,
+					Enabled:     true,
+				},
+			},
+		},
+	}
+}
+
+func reconcileRuleset(t *testing.T, r *Reconciler, c client.Client) *networkingv1alpha2.ZoneRuleset {
+	t.Helper()
+	ctx := context.Background()
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "waf", Namespace: "default"}})
+	require.NoError(t, err)
+
+	updated := &networkingv1alpha2.ZoneRuleset{}
+	require.NoError(t, c.Get(ctx, client.ObjectKey{Name: "waf", Namespace: "default"}, updated))
+	return updated
+}
+
+func TestReconcile_SkipsUpdateWhenRulesetUnchanged(t *testing.T) {
+	stub := newRulesetStub(t, &cloudflare.Ruleset{
+		ID:          "ruleset-123",
+		Phase:       testPhase,
+		Description: "WAF rules",
+		Version:     ptr.To("7"),
+		Rules: []cloudflare.RulesetRule{
+			{
+				ID:          "rule-1",
+				Ref:         "rule-1",
+				Description: "Block bad IP",
+				// Formatting differences are not drift
+				Expression: "(ip.src eq 192.0.2.1)\n",
+				Action:     "block",
+				Enabled:    ptr.To(true),
+			},
+		},
+	})
+	r, c := newTestReconciler(t, newTestRuleset())
+
+	updated := reconcileRuleset(t, r, c)
+
+	assert.Zero(t, stub.updates, "unchanged ruleset must not be updated")
+	assert.Equal(t, networkingv1alpha2.ZoneRulesetStateReady, updated.Status.State)
+	assert.Equal(t, "ruleset-123", updated.Status.RulesetID)
+	assert.Equal(t, "7", updated.Status.RulesetVersion)
+	drift := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDriftDetected)
+	require.NotNil(t, drift)
+	assert.Equal(t, metav1.ConditionFalse, drift.Status)
+	assert.Equal(t, ReasonInSync, drift.Reason)
+}
+
+func TestReconcile_UpdatesDriftedRuleset(t *testing.T) {
+	stub := newRulesetStub(t, &cloudflare.Ruleset{
+		ID:          "ruleset-123",
+		Phase:       testPhase,
+		Description: "WAF rules",
+		Version:     ptr.To("1"),
+		Rules: []cloudflare.RulesetRule{
+			{ID: "rule-1", Ref: "rule-1", Description: "Block bad IP", Expression: `(ip.src eq 192.0.2.9)`, Action: "block"},
+		},
+	})
+	r, c := newTestReconciler(t, newTestRuleset())
+
+	updated := reconcileRuleset(t, r, c)
+
+	assert.Equal(t, 1, stub.updates)
+	drift := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDriftDetected)
+	require.NotNil(t, drift)
+	assert.Equal(t, metav1.ConditionTrue, drift.Status)
+	assert.Equal(t, ReasonDriftCorrected, drift.Reason)
+	assert.Contains(t, drift.Message, "rule 0: expression changed")
+
+	// The next reconcile finds the ruleset in sync
+	updated = reconcileRuleset(t, r, c)
+	assert.Equal(t, 1, stub.updates)
+	assert.True(t, meta.IsStatusConditionFalse(updated.Status.Conditions, ConditionTypeDriftDetected))
+}
+
+func TestReconcile_CreatesMissingRuleset(t *testing.T) {
+	stub := newRulesetStub(t, nil)
+	r, c := newTestReconciler(t, newTestRuleset())
+
+	updated := reconcileRuleset(t, r, c)
+
+	assert.Equal(t, 1, stub.updates)
+	assert.Equal(t, networkingv1alpha2.ZoneRulesetStateReady, updated.Status.State)
+	assert.Equal(t, "ruleset-123", updated.Status.RulesetID)
+	assert.Equal(t, 1, updated.Status.RuleCount)
+}
+
+func TestReconcile_AppliesValidRulesWhenOneIsRejected(t *testing.T) {
+	stub := newRulesetStub(t, nil)
+	ruleset := newTestRuleset()
+	ruleset.Spec.Rules = []networkingv1alpha2.RulesetRule{
+		{Ref: "block-ip", Expression: `(ip.src eq 192.0.2.1)`, Action: networkingv1alpha2.RulesetRuleActionBlock, Enabled: true},
+		{Ref: "typo", Expression: `(http.hots eq "example.com")`, Action: networkingv1alpha2.RulesetRuleActionBlock, Enabled: true},
+		{Description: "Log API", Expression: `(http.request.uri.path eq "/api")`, Action: networkingv1alpha2.RulesetRuleActionLog, Enabled: true},
+	}
+	r, c := newTestReconciler(t, ruleset)
+
+	updated := reconcileRuleset(t, r, c)
+
+	assert.Equal(t, 2, stub.attempts)
+	require.NotNil(t, stub.ruleset)
+	require.Len(t, stub.ruleset.Rules, 2)
+	assert.Equal(t, "block-ip", stub.ruleset.Rules[0].Ref)
+	assert.Equal(t, "Log API", stub.ruleset.Rules[1].Description)
+
+	assert.Equal(t, networkingv1alpha2.ZoneRulesetStateError, updated.Status.State)
+	assert.Equal(t, 2, updated.Status.RuleCount)
+	require.Len(t, updated.Status.Rules, 3)
+	assert.Equal(t, networkingv1alpha2.RuleStatus{Index: 0, Name: "block-ip", Applied: true}, updated.Status.Rules[0])
+	assert.Equal(t, 1, updated.Status.Rules[1].Index)
+	assert.Equal(t, "typo", updated.Status.Rules[1].Name)
+	assert.False(t, updated.Status.Rules[1].Applied)
+	assert.Contains(t, updated.Status.Rules[1].Error, "unknown identifier")
+	assert.Equal(t, networkingv1alpha2.RuleStatus{Index: 2, Name: "Log API", Applied: true}, updated.Status.Rules[2])
+
+	ready := meta.FindStatusCondition(updated.Status.Conditions, "Ready")
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, common.ReasonRulesRejected, ready.Reason)
+	assert.Contains(t, ready.Message, "1 of 3 rules rejected")
+}
+
+func TestReconcile_SkipsRejectedRuleUntilChanged(t *testing.T) {
+	stub := newRulesetStub(t, nil)
+	ruleset := newTestRuleset()
+	ruleset.Spec.Rules = append(ruleset.Spec.Rules, networkingv1alpha2.RulesetRule{
+		Ref: "typo", Expression: `(http.hots eq "example.com")`, Action: networkingv1alpha2.RulesetRuleActionBlock, Enabled: true,
+	})
+	r, c := newTestReconciler(t, ruleset)
+
+	updated := reconcileRuleset(t, r, c)
+	require.Equal(t, 2, stub.attempts)
+	require.False(t, updated.Status.Rules[1].Applied)
+	assert.NotEmpty(t, updated.Status.Rules[1].Hash)
+
+	// The drift check does not send the rejected rule again
+	updated = reconcileRuleset(t, r, c)
+	assert.Equal(t, 2, stub.attempts)
+	assert.Equal(t, 1, stub.updates)
+	assert.False(t, updated.Status.Rules[1].Applied)
+	assert.Contains(t, updated.Status.Rules[1].Error, "unknown identifier")
+	assert.True(t, meta.IsStatusConditionFalse(updated.Status.Conditions, "Ready"))
+
+	// A fixed rule is sent again
+	updated.Spec.Rules[1].Expression = `(http.host eq "example.com")`
+	require.NoError(t, c.Update(context.Background(), updated))
+	updated = reconcileRuleset(t, r, c)
+	assert.Equal(t, 3, stub.attempts)
+	assert.Equal(t, 2, stub.updates)
+	assert.True(t, updated.Status.Rules[1].Applied)
+	assert.Empty(t, updated.Status.Rules[1].Hash)
+	assert.Equal(t, networkingv1alpha2.ZoneRulesetStateReady, updated.Status.State)
+}
+
+func TestReconcile_AllRulesRejected(t *testing.T) {
+	stub := newRulesetStub(t, nil)
+	ruleset := newTestRuleset()
+	ruleset.Spec.Rules[0].Expression = `(http.hots eq "example.com")`
+	r, c := newTestReconciler(t, ruleset)
+
+	updated := reconcileRuleset(t, r, c)
+
+	assert.Equal(t, 1, stub.attempts)
+	assert.Zero(t, stub.updates)
+	assert.Equal(t, networkingv1alpha2.ZoneRulesetStateError, updated.Status.State)
+	require.Len(t, updated.Status.Rules, 1)
+	assert.False(t, updated.Status.Rules[0].Applied)
+	assert.True(t, meta.IsStatusConditionFalse(updated.Status.Conditions, "Ready"))
+}
@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+// Package zonesettings provides a controller for managing Cloudflare zone settings.
+// It directly calls Cloudflare API and writes status back to the CRD.
+package zonesettings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+const (
+	// ConditionTypeSettingsApplied reports whether every managed setting has the desired value.
+	// It is False when a setting differs but cannot be changed on the zone's plan.
+	ConditionTypeSettingsApplied = "SettingsApplied"
+
+	// Reasons for the SettingsApplied condition
+	ReasonApplied  = "Applied"
+	ReasonReadOnly = "ReadOnly"
+
+	// driftCheckInterval is how often the settings are compared with Cloudflare.
+	driftCheckInterval = 30 * time.Minute
+)
+
+// setting is the desired value of a single zone setting.
+type setting struct {
+	ID    string
+	Value string
+}
+
+// Reconciler reconciles a ZoneSettings object.
+// It directly calls Cloudflare API and writes status back to the CRD.
+type Reconciler struct {
+	client.Client
+	Scheme     *runtime.Scheme
+	Recorder   record.EventRecorder
+	APIFactory *common.APIClientFactory
+}
+
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=zonesettings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=networking.cloudflare-operator.io,resources=zonesettings/status,verbs=get;update;patch
+
+// Reconcile handles ZoneSettings reconciliation.
+// Settings are not reverted on deletion, so no finalizer is used.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	zs := &networkingv1alpha2.ZoneSettings{}
+	if err := r.Get(ctx, req.NamespacedName, zs); err != nil {
+		if apierrors.IsNotFound(err) {
+			return common.NoRequeue(), nil
+		}
+		logger.Error(err, "Unable to fetch ZoneSettings")
+		return common.NoRequeue(), err
+	}
+
+	if !zs.DeletionTimestamp.IsZero() {
+		return common.NoRequeue(), nil
+	}
+
+	apiResult, err := r.APIFactory.GetClient(ctx, common.APIClientOptions{
+		CredentialsRef: zs.Spec.CredentialsRef,
+		Namespace:      zs.Namespace,
+		StatusZoneID:   zs.Status.ZoneID,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to get API client")
+		return r.updateStatusError(ctx, zs, err)
+	}
+
+	zoneID, _, err := apiResult.API.GetZoneIDForDomain(ctx, zs.Spec.Zone)
+	if err != nil {
+		logger.Error(err, "Failed to resolve zone ID", "zone", zs.Spec.Zone)
+		return r.updateStatusError(ctx, zs, fmt.Errorf("failed to resolve zone '%s': %w", zs.Spec.Zone, err))
+	}
+
+	return r.syncSettings(ctx, zs, apiResult.API, zoneID)
+}
+
+// syncSettings reads the managed settings and updates the ones that differ from the spec.
+func (r *Reconciler) syncSettings(
+	ctx context.Context,
+	zs *networkingv1alpha2.ZoneSettings,
+	api *cf.API,
+	zoneID string,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	desired := desiredSettings(&zs.Spec)
+	current := make(map[string]cloudflare.ZoneSetting, len(desired))
+	for _, s := range desired {
+		value, err := api.GetZoneSetting(ctx, zoneID, s.ID)
+		if err != nil {
+			return r.updateStatusError(ctx, zs, err)
+		}
+		current[s.ID] = value
+	}
+
+	changes, readOnly := diffSettings(desired, current)
+	for _, s := range changes {
+		logger.Info("Updating zone setting", "zoneId", zoneID, "setting", s.ID, "value", s.Value)
+		if err := api.UpdateZoneSetting(ctx, zoneID, s.ID, s.Value); err != nil {
+			return r.updateStatusError(ctx, zs, err)
+		}
+	}
+
+	if len(changes) > 0 {
+		r.Recorder.Event(zs, corev1.EventTypeNormal, "Updated",
+			fmt.Sprintf("Updated zone settings: %s", strings.Join(settingIDs(changes), ", ")))
+	}
+	if len(readOnly) > 0 {
+		r.Recorder.Event(zs, corev1.EventTypeWarning, ReasonReadOnly,
+			fmt.Sprintf("Zone settings cannot be changed on this plan: %s", strings.Join(readOnly, ", ")))
+	}
+
+	return r.updateStatusReady(ctx, zs, zoneID, readOnly, len(changes) > 0)
+}
+
+// desiredSettings returns the settings set in the spec, using the Cloudflare setting IDs and values.
+func desiredSettings(spec *networkingv1alpha2.ZoneSettingsSpec) []setting {
+	var settings []setting
+	if spec.SSL != "" {
+		settings = append(settings, setting{ID: "ssl", Value: string(spec.SSL)})
+	}
+	if spec.AlwaysUseHTTPS != nil {
+		settings = append(settings, setting{ID: "always_use_https", Value: cf.BoolToOnOff(spec.AlwaysUseHTTPS)})
+	}
+	if spec.AutomaticHTTPSRewrites != nil {
+		settings = append(settings, setting{ID: "automatic_https_rewrites", Value: cf.BoolToOnOff(spec.AutomaticHTTPSRewrites)})
+	}
+	if spec.MinTLSVersion != "" {
+		settings = append(settings, setting{ID: "min_tls_version", Value: string(spec.MinTLSVersion)})
+	}
+	if spec.Brotli != nil {
+		settings = append(settings, setting{ID: "brotli", Value: cf.BoolToOnOff(spec.Brotli)})
+	}
+	return settings
+}
+
+// diffSettings returns the desired settings that differ from the current ones and can be changed,
+// and the IDs of the differing settings that are not editable on the zone's plan.
+func diffSettings(desired []setting, current map[string]cloudflare.ZoneSetting) (changes []setting, readOnly []string) {
+	for _, s := range desired {
+		cur, ok := current[s.ID]
+		if ok && fmt.Sprint(cur.Value) == s.Value {
+			continue
+		}
+		if ok && !cur.Editable {
+			readOnly = append(readOnly, s.ID)
+			continue
+		}
+		changes = append(changes, s)
+	}
+	sort.Strings(readOnly)
+	return changes, readOnly
+}
+
+func settingIDs(settings []setting) []string {
+	ids := make([]string, 0, len(settings))
+	for _, s := range settings {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func (r *Reconciler) updateStatusError(
+	ctx context.Context,
+	zs *networkingv1alpha2.ZoneSettings,
+	err error,
+) (ctrl.Result, error) {
+	updateErr := controller.UpdateStatusWithConflictRetry(ctx, r.Client, zs, func() {
+		zs.Status.State = networkingv1alpha2.ZoneSettingsStateError
+		zs.Status.Message = cf.SanitizeErrorMessage(err)
+		meta.SetStatusCondition(&zs.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: zs.Generation,
+			Reason:             "Error",
+			Message:            cf.SanitizeErrorMessage(err),
+			LastTransitionTime: metav1.Now(),
+		})
+		zs.Status.ObservedGeneration = zs.Generation
+	})
+
+	if updateErr != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", updateErr)
+	}
+
+	return common.RequeueShort(), nil
+}
+
+// updateStatusReady records a successful sync. LastSyncTime only moves when settings
+// were updated, so a periodic drift check that finds nothing to change does not
+// write the status.
+func (r *Reconciler) updateStatusReady(
+	ctx context.Context,
+	zs *networkingv1alpha2.ZoneSettings,
+	zoneID string,
+	readOnly []string,
+	changed bool,
+) (ctrl.Result, error) {
+	applied := metav1.Condition{
+		Type:               ConditionTypeSettingsApplied,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: zs.Generation,
+		Reason:             ReasonApplied,
+		Message:            "All managed settings have the desired value",
+		LastTransitionTime: metav1.Now(),
+	}
+	if len(readOnly) > 0 {
+		applied.Status = metav1.ConditionFalse
+		applied.Reason = ReasonReadOnly
+		applied.Message = fmt.Sprintf("Settings not editable on the zone's plan: %s", strings.Join(readOnly, ", "))
+	}
+
+	err := controller.UpdateStatusWithConflictRetry(ctx, r.Client, zs, func() {
+		now := metav1.Now()
+		zs.Status.ZoneID = zoneID
+		zs.Status.ReadOnlySettings = readOnly
+		if changed || zs.Status.LastSyncTime == nil {
+			zs.Status.LastSyncTime = &now
+		}
+		zs.Status.State = networkingv1alpha2.ZoneSettingsStateReady
+		zs.Status.Message = "Zone settings synced to Cloudflare"
+		meta.SetStatusCondition(&zs.Status.Conditions, metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: zs.Generation,
+			Reason:             "Synced",
+			Message:            "Zone settings synced to Cloudflare",
+			LastTransitionTime: now,
+		})
+		meta.SetStatusCondition(&zs.Status.Conditions, applied)
+		zs.Status.ObservedGeneration = zs.Generation
+	})
+
+	if err != nil {
+		return common.NoRequeue(), fmt.Errorf("failed to update status: %w", err)
+	}
+
+	// Requeue to revert changes made outside of the operator
+	return common.RequeueResult(driftCheckInterval), nil
+}
+
+// findSettingsForCredentials returns ZoneSettings that reference the given credentials
+func (r *Reconciler) findSettingsForCredentials(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	creds, ok := obj.(*networkingv1alpha2.CloudflareCredentials)
+	if !ok {
+		return nil
+	}
+
+	list := &networkingv1alpha2.ZoneSettingsList{}
+	if err := r.List(ctx, list); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, zs := range list.Items {
+		if (zs.Spec.CredentialsRef != nil && zs.Spec.CredentialsRef.Name == creds.Name) ||
+			(creds.Spec.IsDefault && zs.Spec.CredentialsRef == nil) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: zs.Name, Namespace: zs.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("zonesettings-controller")
+
+	// Initialize APIClientFactory
+	r.APIFactory = common.NewAPIClientFactory(mgr.GetClient(), ctrl.Log.WithName("zonesettings"))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&networkingv1alpha2.ZoneSettings{}).
+		Watches(&networkingv1alpha2.CloudflareCredentials{},
+			handler.EnqueueRequestsFromMapFunc(r.findSettingsForCredentials)).
+		Named("zonesettings").
+		Complete(controller.ObserveResourceStatus(mgr.GetClient(), &networkingv1alpha2.ZoneSettings{}, r))
+}
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package zonesettings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	cfclient "github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	"github.com/StringKe/cloudflare-operator/internal/controller/common"
+)
+
+func TestDesiredSettings(t *testing.T) {
+	spec := &networkingv1alpha2.ZoneSettingsSpec{
+		Zone:           "example.com",
+		SSL:            "strict",
+		AlwaysUseHTTPS: ptr.To(true),
+		MinTLSVersion:  networkingv1alpha2.TLSVersion12,
+		Brotli:         ptr.To(false),
+	}
+
+	assert.Equal(t, []setting{
+		{ID: "ssl", Value: "strict"},
+		{ID: "always_use_https", Value: "on"},
+		{ID: "min_tls_version", Value: "1.2"},
+		{ID: "brotli", Value: "off"},
+	}, desiredSettings(spec))
+
+	assert.Empty(t, desiredSettings(&networkingv1alpha2.ZoneSettingsSpec{Zone: "example.com"}))
+}
+
+func TestDiffSettings(t *testing.T) {
+	desired := []setting{
+		{ID: "ssl", Value: "strict"},
+		{ID: "always_use_https", Value: "on"},
+		{ID: "min_tls_version", Value: "1.2"},
+		{ID: "brotli", Value: "on"},
+	}
+
+	tests := []struct {
+		name         string
+		current      map[string]cloudflare.ZoneSetting
+		wantChanges  []string
+		wantReadOnly []string
+	}{
+		{
+			name: "in sync",
+			current: map[string]cloudflare.ZoneSetting{
+				"ssl":              {ID: "ssl", Value: "strict", Editable: true},
+				"always_use_https": {ID: "always_use_https", Value: "on", Editable: true},
+				"min_tls_version":  {ID: "min_tls_version", Value: "1.2", Editable: true},
+				"brotli":           {ID: "brotli", Value: "on", Editable: true},
+			},
+		},
+		{
+			name: "drift",
+			current: map[string]cloudflare.ZoneSetting{
+				"ssl":              {ID: "ssl", Value: "flexible", Editable: true},
+				"always_use_https": {ID: "always_use_https", Value: "on", Editable: true},
+				"min_tls_version":  {ID: "min_tls_version", Value: "1.0", Editable: true},
+				"brotli":           {ID: "brotli", Value: "on", Editable: true},
+			},
+			wantChanges: []string{"ssl", "min_tls_version"},
+		},
+		{
+			name: "read-only setting",
+			current: map[string]cloudflare.ZoneSetting{
+				"ssl":              {ID: "ssl", Value: "strict", Editable: true},
+				"always_use_https": {ID: "always_use_https", Value: "off", Editable: true},
+				"min_tls_version":  {ID: "min_tls_version", Value: "1.0", Editable: false},
+				"brotli":           {ID: "brotli", Value: "on", Editable: false},
+			},
+			wantChanges:  []string{"always_use_https"},
+			wantReadOnly: []string{"min_tls_version"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, readOnly := diffSettings(desired, tt.current)
+			if tt.wantChanges == nil {
+				assert.Empty(t, changes)
+			} else {
+				assert.Equal(t, tt.wantChanges, settingIDs(changes))
+			}
+			assert.Equal(t, tt.wantReadOnly, readOnly)
+		})
+	}
+}
+
+// zoneSettingsStub serves zone lookup and zone settings, recording setting updates.
+type zoneSettingsStub struct {
+	mu       sync.Mutex
+	settings map[string]cloudflare.ZoneSetting
+	updated  []string
+}
+
+func newZoneSettingsStub(t *testing.T, settings map[string]cloudflare.ZoneSetting) *zoneSettingsStub {
+	t.Helper()
+	stub := &zoneSettingsStub{settings: settings}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],` +
+			`"result":[{"id":"zone-123","name":"example.com","status":"active"}],` +
+			`"result_info":{"page":1,"per_page":50,"total_pages":1,"count":1,"total_count":1}}`))
+	})
+	mux.HandleFunc("/zones/zone-123/settings/", func(w http.ResponseWriter, req *http.Request) {
+		stub.mu.Lock()
+		defer stub.mu.Unlock()
+		id := strings.TrimPrefix(req.URL.Path, "/zones/zone-123/settings/")
+		current := stub.settings[id]
+		if req.Method == http.MethodPatch {
+			var body struct {
+				Value any `json:"value"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			current.Value = body.Value
+			stub.settings[id] = current
+			stub.updated = append(stub.updated, id)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		result, _ := json.Marshal(current)
+		_, _ = fmt.Fprintf(w, `{"success":true,"errors":[],"messages":[],"result":%s}`, result)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	t.Setenv(cfclient.CloudflareAPIBaseURLEnv, server.URL)
+	return stub
+}
+
+func newTestReconciler(t *testing.T, zs *networkingv1alpha2.ZoneSettings) (*Reconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, networkingv1alpha2.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&networkingv1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+					AccountID: "account-123",
+					AuthType:  networkingv1alpha2.AuthTypeAPIToken,
+					SecretRef: networkingv1alpha2.SecretReference{Name: "cloudflare-secret"},
+					IsDefault: true,
+				},
+			},
+			zs,
+		).
+		WithStatusSubresource(zs).
+		Build()
+
+	return &Reconciler{
+		Client:     c,
+		Scheme:     scheme,
+		Recorder:   record.NewFakeRecorder(10),
+		APIFactory: common.NewAPIClientFactory(c, logr.Discard()),
+	}, c
+}
+
+func TestReconcile_PatchesOnlyDriftedSettings(t *testing.T) {
+	stub := newZoneSettingsStub(t, map[string]cloudflare.ZoneSetting{
+		"ssl":              {ID: "ssl", Value: "flexible", Editable: true},
+		"always_use_https": {ID: "always_use_https", Value: "on", Editable: true},
+		"min_tls_version":  {ID: "min_tls_version", Value: "1.0", Editable: false},
+	})
+	zs := &networkingv1alpha2.ZoneSettings{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default", Generation: 1},
+		Spec: networkingv1alpha2.ZoneSettingsSpec{
+			Zone:           "example.com",
+			SSL:            "strict",
+			AlwaysUseHTTPS: ptr.To(true),
+			MinTLSVersion:  networkingv1alpha2.TLSVersion12,
+		},
+	}
+	r, c := newTestReconciler(t, zs)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(zs)})
+	require.NoError(t, err)
+	assert.Equal(t, driftCheckInterval, result.RequeueAfter)
+	assert.Equal(t, []string{"ssl"}, stub.updated)
+	assert.Equal(t, "strict", stub.settings["ssl"].Value)
+
+	updated := &networkingv1alpha2.ZoneSettings{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(zs), updated))
+	assert.Equal(t, networkingv1alpha2.ZoneSettingsStateReady, updated.Status.State)
+	assert.Equal(t, "zone-123", updated.Status.ZoneID)
+	assert.Equal(t, []string{"min_tls_version"}, updated.Status.ReadOnlySettings)
+	assert.True(t, meta.IsStatusConditionTrue(updated.Status.Conditions, "Ready"))
+	applied := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeSettingsApplied)
+	require.NotNil(t, applied)
+	assert.Equal(t, metav1.ConditionFalse, applied.Status)
+	assert.Equal(t, ReasonReadOnly, applied.Reason)
+
+	require.NotNil(t, updated.Status.LastSyncTime)
+
+	// A second reconcile finds no drift and keeps the last sync time
+	stub.updated = nil
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(zs)})
+	require.NoError(t, err)
+	assert.Empty(t, stub.updated)
+
+	rechecked := &networkingv1alpha2.ZoneSettings{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(zs), rechecked))
+	assert.Equal(t, updated.Status, rechecked.Status)
+}
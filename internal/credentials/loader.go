@@ -43,6 +43,11 @@ type Credentials struct {
 	AuthType networkingv1alpha2.CloudflareAuthType
 }
 
+// DefaultSecretNamespace is the namespace of the secret of a CloudflareCredentials
+// that does not set one. It follows the operator namespace, see
+// common.SetOperatorNamespace.
+var DefaultSecretNamespace = "cloudflare-operator-system"
+
 // Loader loads Cloudflare credentials from various sources
 type Loader struct {
 	client client.Client
@@ -96,18 +101,28 @@ func (l *Loader) LoadFromCloudflareDetails(
 ) (*Credentials, error) {
 	// If credentialsRef is specified, use it
 	if details.CredentialsRef != nil {
-		return l.loadFromCredentialsRefWithDomain(ctx, details.CredentialsRef, details.Domain)
+		creds, err := l.loadFromCredentialsRefWithDomain(ctx, details.CredentialsRef, details.Domain)
+		return withAccountID(creds, details.AccountId), err
 	}
 
 	// Legacy mode: load from inline secret reference
 	if details.Secret == "" {
-		return l.loadDefaultWithDomain(ctx, details.Domain)
+		creds, err := l.loadDefaultWithDomain(ctx, details.Domain)
+		return withAccountID(creds, details.AccountId), err
 	}
 
 	// Load from inline secret (legacy mode)
 	return l.loadFromInlineSecret(ctx, details, namespace)
 }
 
+// withAccountID pins the account of shared credentials to the account set on the resource.
+func withAccountID(creds *Credentials, accountID string) *Credentials {
+	if creds != nil && accountID != "" {
+		creds.AccountID = accountID
+	}
+	return creds
+}
+
 // loadFromCredentialsRefWithDomain loads credentials and overrides domain if specified
 func (l *Loader) loadFromCredentialsRefWithDomain(
 	ctx context.Context, ref *networkingv1alpha2.CloudflareCredentialsRef, domain string,
@@ -141,13 +156,13 @@ func (l *Loader) loadFromCloudflareCredentials(ctx context.Context, creds *netwo
 		return nil, err
 	}
 
-	result := &Credentials{
-		AccountID: creds.Spec.AccountID,
-		Domain:    creds.Spec.DefaultDomain,
-		AuthType:  creds.Spec.AuthType,
+	result, err := ExtractFromSecret(l.log, secret, creds.Spec.SecretRef, creds.Spec.AuthType)
+	if err != nil {
+		return nil, err
 	}
-
-	return l.extractCredentialsFromSecret(secret, creds.Spec.SecretRef, creds.Spec.AuthType, result)
+	result.AccountID = creds.GetAccountID()
+	result.Domain = creds.Spec.DefaultDomain
+	return result, nil
 }
 
 // getCredentialsSecret retrieves the secret for CloudflareCredentials
@@ -155,7 +170,7 @@ func (l *Loader) getCredentialsSecret(ctx context.Context, creds *networkingv1al
 	secret := &corev1.Secret{}
 	secretNamespace := creds.Spec.SecretRef.Namespace
 	if secretNamespace == "" {
-		secretNamespace = "cloudflare-operator-system"
+		secretNamespace = DefaultSecretNamespace
 	}
 
 	if err := l.client.Get(ctx, types.NamespacedName{
@@ -168,54 +183,6 @@ func (l *Loader) getCredentialsSecret(ctx context.Context, creds *networkingv1al
 	return secret, nil
 }
 
-// extractCredentialsFromSecret extracts credentials from secret based on auth type
-func (l *Loader) extractCredentialsFromSecret(secret *corev1.Secret, secretRef networkingv1alpha2.SecretReference, authType networkingv1alpha2.CloudflareAuthType, result *Credentials) (*Credentials, error) {
-	switch authType {
-	case networkingv1alpha2.AuthTypeAPIToken:
-		return l.extractAPIToken(secret, secretRef, result)
-	case networkingv1alpha2.AuthTypeGlobalAPIKey:
-		return l.extractGlobalAPIKey(secret, secretRef, result)
-	default:
-		return nil, fmt.Errorf("unknown auth type: %s", authType)
-	}
-}
-
-// extractAPIToken extracts API token from secret
-func (*Loader) extractAPIToken(secret *corev1.Secret, secretRef networkingv1alpha2.SecretReference, result *Credentials) (*Credentials, error) {
-	tokenKey := secretRef.APITokenKey
-	if tokenKey == "" {
-		tokenKey = "CLOUDFLARE_API_TOKEN"
-	}
-	result.APIToken = string(secret.Data[tokenKey])
-	if result.APIToken == "" {
-		return nil, fmt.Errorf("API token not found in secret (key: %s)", tokenKey)
-	}
-	return result, nil
-}
-
-// extractGlobalAPIKey extracts Global API Key and email from secret
-func (*Loader) extractGlobalAPIKey(secret *corev1.Secret, secretRef networkingv1alpha2.SecretReference, result *Credentials) (*Credentials, error) {
-	keyKey := secretRef.APIKeyKey
-	if keyKey == "" {
-		keyKey = "CLOUDFLARE_API_KEY"
-	}
-	emailKey := secretRef.EmailKey
-	if emailKey == "" {
-		emailKey = "CLOUDFLARE_EMAIL"
-	}
-
-	result.APIKey = string(secret.Data[keyKey])
-	result.Email = string(secret.Data[emailKey])
-
-	if result.APIKey == "" {
-		return nil, fmt.Errorf("API key not found in secret (key: %s)", keyKey)
-	}
-	if result.Email == "" {
-		return nil, fmt.Errorf("email not found in secret (key: %s)", emailKey)
-	}
-	return result, nil
-}
-
 // loadFromInlineSecret loads credentials from an inline secret reference (legacy mode)
 func (l *Loader) loadFromInlineSecret(ctx context.Context, details *networkingv1alpha2.CloudflareDetails, namespace string) (*Credentials, error) {
 	secret := &corev1.Secret{}
@@ -248,7 +215,7 @@ func (l *Loader) loadFromInlineSecret(ctx context.Context, details *networkingv1
 func (*Loader) tryAPIToken(secret *corev1.Secret, details *networkingv1alpha2.CloudflareDetails, result *Credentials) *Credentials {
 	tokenKey := details.CLOUDFLARE_API_TOKEN
 	if tokenKey == "" {
-		tokenKey = "CLOUDFLARE_API_TOKEN"
+		tokenKey = DefaultAPITokenKey
 	}
 	result.APIToken = string(secret.Data[tokenKey])
 
@@ -263,14 +230,14 @@ func (*Loader) tryAPIToken(secret *corev1.Secret, details *networkingv1alpha2.Cl
 func (*Loader) tryGlobalAPIKey(secret *corev1.Secret, details *networkingv1alpha2.CloudflareDetails, result *Credentials) *Credentials {
 	keyKey := details.CLOUDFLARE_API_KEY
 	if keyKey == "" {
-		keyKey = "CLOUDFLARE_API_KEY"
+		keyKey = DefaultAPIKeyKey
 	}
 	result.APIKey = string(secret.Data[keyKey])
 	result.Email = details.Email
 
 	// Try to get email from secret if not in details
 	if result.Email == "" {
-		result.Email = string(secret.Data["CLOUDFLARE_EMAIL"])
+		result.Email = string(secret.Data[DefaultEmailKey])
 	}
 	if result.Email == "" {
 		result.Email = string(secret.Data["CLOUDFLARE_API_EMAIL"])
@@ -487,6 +487,61 @@ func TestLoadFromCloudflareDetails_WithCredentialsRef(t *testing.T) {
 	assert.Equal(t, "ref-token", creds.APIToken)
 }
 
+func TestLoadFromCloudflareDetails_AccountSelection(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = networkingv1alpha2.AddToScheme(scheme)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ref-secret",
+			Namespace: "cloudflare-operator-system",
+		},
+		Data: map[string][]byte{
+			"CLOUDFLARE_API_TOKEN": []byte("ref-token"),
+		},
+	}
+
+	// No accountId in spec: the account detected by the controller is used
+	cfCreds := &networkingv1alpha2.CloudflareCredentials{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "ref-credentials",
+		},
+		Spec: networkingv1alpha2.CloudflareCredentialsSpec{
+			AuthType: networkingv1alpha2.AuthTypeAPIToken,
+			SecretRef: networkingv1alpha2.SecretReference{
+				Name: "ref-secret",
+			},
+		},
+		Status: networkingv1alpha2.CloudflareCredentialsStatus{
+			AccountID: "detected-account",
+		},
+	}
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(secret, cfCreds).
+		Build()
+
+	loader := NewLoader(client, logr.Discard())
+
+	details := &networkingv1alpha2.CloudflareDetails{
+		CredentialsRef: &networkingv1alpha2.CloudflareCredentialsRef{
+			Name: "ref-credentials",
+		},
+	}
+
+	creds, err := loader.LoadFromCloudflareDetails(context.Background(), details, "test-namespace")
+	require.NoError(t, err)
+	assert.Equal(t, "detected-account", creds.AccountID)
+
+	// accountId on the resource selects the account
+	details.AccountId = "selected-account"
+	creds, err = loader.LoadFromCloudflareDetails(context.Background(), details, "test-namespace")
+	require.NoError(t, err)
+	assert.Equal(t, "selected-account", creds.AccountID)
+}
+
 func TestLoadFromCloudflareDetails_LegacyInlineSecret(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package credentials
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// Conventional Secret keys, used when the SecretReference does not override them.
+const (
+	DefaultAPITokenKey = "CLOUDFLARE_API_TOKEN"
+	DefaultAPIKeyKey   = "CLOUDFLARE_API_KEY"
+	DefaultEmailKey    = "CLOUDFLARE_EMAIL"
+)
+
+// ErrSecretKeyMissing is returned when the Secret lacks a key required by the auth type
+var ErrSecretKeyMissing = errors.New("required key not found in secret")
+
+// SecretKeys holds the resolved key names of a SecretReference
+type SecretKeys struct {
+	APIToken string
+	APIKey   string
+	Email    string
+}
+
+// ResolveSecretKeys returns the key names of ref, defaulting unset ones to the conventional names.
+func ResolveSecretKeys(ref networkingv1alpha2.SecretReference) SecretKeys {
+	keys := SecretKeys{
+		APIToken: ref.APITokenKey,
+		APIKey:   ref.APIKeyKey,
+		Email:    ref.EmailKey,
+	}
+	if keys.APIToken == "" {
+		keys.APIToken = DefaultAPITokenKey
+	}
+	if keys.APIKey == "" {
+		keys.APIKey = DefaultAPIKeyKey
+	}
+	if keys.Email == "" {
+		keys.Email = DefaultEmailKey
+	}
+	return keys
+}
+
+// ExtractFromSecret reads the credentials of authType from secret using the key names of ref.
+// Missing keys are reported with ErrSecretKeyMissing. A Secret that holds both an API token
+// and a Global API Key is used with the API token, whatever authType says, and a warning is logged.
+func ExtractFromSecret(
+	log logr.Logger,
+	secret *corev1.Secret,
+	ref networkingv1alpha2.SecretReference,
+	authType networkingv1alpha2.CloudflareAuthType,
+) (*Credentials, error) {
+	keys := ResolveSecretKeys(ref)
+	token := string(secret.Data[keys.APIToken])
+	apiKey := string(secret.Data[keys.APIKey])
+	email := string(secret.Data[keys.Email])
+
+	if authType != networkingv1alpha2.AuthTypeAPIToken && authType != networkingv1alpha2.AuthTypeGlobalAPIKey {
+		return nil, fmt.Errorf("unknown auth type: %s", authType)
+	}
+
+	// The API token wins over a Global API Key in the same Secret
+	if token != "" && apiKey != "" {
+		log.Info("Warning: secret contains both an API token and a Global API Key, using the API token",
+			"secret", secret.Namespace+"/"+secret.Name, "authType", authType,
+			"apiTokenKey", keys.APIToken, "apiKeyKey", keys.APIKey)
+		return &Credentials{AuthType: networkingv1alpha2.AuthTypeAPIToken, APIToken: token}, nil
+	}
+
+	result := &Credentials{AuthType: authType}
+	if authType == networkingv1alpha2.AuthTypeAPIToken {
+		if token == "" {
+			return nil, fmt.Errorf("%w: API token not found in secret (key: %s)", ErrSecretKeyMissing, keys.APIToken)
+		}
+		result.APIToken = token
+		return result, nil
+	}
+
+	if apiKey == "" {
+		return nil, fmt.Errorf("%w: API key not found in secret (key: %s)", ErrSecretKeyMissing, keys.APIKey)
+	}
+	if email == "" {
+		return nil, fmt.Errorf("%w: email not found in secret (key: %s)", ErrSecretKeyMissing, keys.Email)
+	}
+	result.APIKey = apiKey
+	result.Email = email
+	return result, nil
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package credentials
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+func TestResolveSecretKeys(t *testing.T) {
+	keys := ResolveSecretKeys(networkingv1alpha2.SecretReference{Name: "secret"})
+	assert.Equal(t, SecretKeys{APIToken: DefaultAPITokenKey, APIKey: DefaultAPIKeyKey, Email: DefaultEmailKey}, keys)
+
+	keys = ResolveSecretKeys(networkingv1alpha2.SecretReference{Name: "secret", APIKeyKey: "key", EmailKey: "mail"})
+	assert.Equal(t, SecretKeys{APIToken: DefaultAPITokenKey, APIKey: "key", Email: "mail"}, keys)
+}
+
+func TestExtractFromSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]string
+		ref      networkingv1alpha2.SecretReference
+		authType networkingv1alpha2.CloudflareAuthType
+		want     *Credentials
+		wantErr  error
+	}{
+		{
+			name:     "API token with default key",
+			data:     map[string]string{DefaultAPITokenKey: "token"},
+			authType: networkingv1alpha2.AuthTypeAPIToken,
+			want:     &Credentials{AuthType: networkingv1alpha2.AuthTypeAPIToken, APIToken: "token"},
+		},
+		{
+			name:     "API token with custom key",
+			data:     map[string]string{"token": "custom-token", DefaultAPITokenKey: "ignored"},
+			ref:      networkingv1alpha2.SecretReference{APITokenKey: "token"},
+			authType: networkingv1alpha2.AuthTypeAPIToken,
+			want:     &Credentials{AuthType: networkingv1alpha2.AuthTypeAPIToken, APIToken: "custom-token"},
+		},
+		{
+			name:     "Global API Key with custom keys",
+			data:     map[string]string{"global-key": "key", "account-email": "admin@example.com"},
+			ref:      networkingv1alpha2.SecretReference{APIKeyKey: "global-key", EmailKey: "account-email"},
+			authType: networkingv1alpha2.AuthTypeGlobalAPIKey,
+			want: &Credentials{
+				AuthType: networkingv1alpha2.AuthTypeGlobalAPIKey,
+				APIKey:   "key",
+				Email:    "admin@example.com",
+			},
+		},
+		{
+			name:     "custom token key missing",
+			data:     map[string]string{DefaultAPITokenKey: "token"},
+			ref:      networkingv1alpha2.SecretReference{APITokenKey: "token"},
+			authType: networkingv1alpha2.AuthTypeAPIToken,
+			wantErr:  ErrSecretKeyMissing,
+		},
+		{
+			name:     "email missing",
+			data:     map[string]string{DefaultAPIKeyKey: "key"},
+			authType: networkingv1alpha2.AuthTypeGlobalAPIKey,
+			wantErr:  ErrSecretKeyMissing,
+		},
+		{
+			name:     "API token next to a Global API Key",
+			data:     map[string]string{DefaultAPITokenKey: "token", DefaultAPIKeyKey: "key"},
+			authType: networkingv1alpha2.AuthTypeAPIToken,
+			want:     &Credentials{AuthType: networkingv1alpha2.AuthTypeAPIToken, APIToken: "token"},
+		},
+		{
+			name: "Global API Key next to an API token uses the token",
+			data: map[string]string{
+				DefaultAPITokenKey: "token", DefaultAPIKeyKey: "key", DefaultEmailKey: "admin@example.com",
+			},
+			authType: networkingv1alpha2.AuthTypeGlobalAPIKey,
+			want:     &Credentials{AuthType: networkingv1alpha2.AuthTypeAPIToken, APIToken: "token"},
+		},
+		{
+			name:     "conventional key outside the configured keys",
+			data:     map[string]string{DefaultAPITokenKey: "token", DefaultAPIKeyKey: "legacy-key"},
+			ref:      networkingv1alpha2.SecretReference{APIKeyKey: "global-key"},
+			authType: networkingv1alpha2.AuthTypeAPIToken,
+			want:     &Credentials{AuthType: networkingv1alpha2.AuthTypeAPIToken, APIToken: "token"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &corev1.Secret{Data: map[string][]byte{}}
+			for k, v := range tt.data {
+				secret.Data[k] = []byte(v)
+			}
+
+			got, err := ExtractFromSecret(logr.Discard(), secret, tt.ref, tt.authType)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
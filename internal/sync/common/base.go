@@ -11,9 +11,11 @@
 package common
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -26,6 +28,7 @@ import (
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	controllercommon "github.com/StringKe/cloudflare-operator/internal/controller/common"
 )
 
 const (
@@ -33,8 +36,28 @@ const (
 	MaxConflictRetries = 5
 	// ConflictRetryDelay is the delay between retries
 	ConflictRetryDelay = 100 * time.Millisecond
+
+	// ConditionTypeDebouncePending is set on a SyncState while a debounced change is waiting to be synced
+	ConditionTypeDebouncePending = "DebouncePending"
+
+	// AnnotationFlushDebounce flushes a pending debounced change for the SyncState when set.
+	// The controller removes the annotation once the change has been flushed.
+	AnnotationFlushDebounce = "cloudflare-operator.io/flush-debounce"
+
+	// AnnotationDriftResyncInterval overrides DriftResyncInterval for the configuration
+	// of a resource, such as a Tunnel or ClusterTunnel. It is read from the resources
+	// contributing to a SyncState, not from the SyncState itself. The value is a Go
+	// duration such as "10m"; "0" disables periodic resync for it.
+	AnnotationDriftResyncInterval = "cloudflare-operator.io/drift-resync-interval"
 )
 
+// DriftResyncInterval is how often a synced configuration is pushed to Cloudflare
+// again, even when unchanged, to correct out-of-band drift. Zero disables periodic
+// resync, so syncs only happen on changes. It is set from the --drift-resync-interval flag.
+// Only the tunnel configuration sync controller resyncs; tunnel lifecycle operations
+// run once and other resources are not resynced periodically.
+var DriftResyncInterval time.Duration
+
 // SyncResult contains the result of a successful sync operation
 type SyncResult struct {
 	// ConfigVersion is the version returned by Cloudflare after update
@@ -91,10 +114,103 @@ func (c *BaseSyncController) GetSyncState(ctx context.Context, name string) (*v1
 	return syncState, nil
 }
 
+// CheckDebounce reports whether the reconcile should be skipped because a debounced
+// change for the SyncState is still pending. While pending, the DebouncePending
+// condition is set so users can see why a change has not been synced yet.
+// Setting the flush-debounce annotation flushes the pending change immediately,
+// removes the annotation and lets the reconcile continue. A pending force-sync
+// request also flushes the pending change.
+func (c *BaseSyncController) CheckDebounce(ctx context.Context, syncState *v1alpha2.CloudflareSyncState) (bool, error) {
+	logger := log.FromContext(ctx)
+	key := syncState.Name
+
+	if controllercommon.ForceSyncRequested(syncState) && c.Debouncer.Flush(key) {
+		logger.Info("Flushed pending debounced change for force-sync")
+	}
+
+	if _, ok := syncState.Annotations[AnnotationFlushDebounce]; ok {
+		if c.Debouncer.Flush(key) {
+			logger.Info("Flushed pending debounced change on request")
+		}
+		if err := UpdateWithConflictRetry(ctx, c.Client, syncState, func() {
+			delete(syncState.Annotations, AnnotationFlushDebounce)
+		}); err != nil {
+			return false, fmt.Errorf("remove flush annotation: %w", err)
+		}
+	}
+
+	pending := c.Debouncer.IsPending(key)
+	cond := meta.FindStatusCondition(syncState.Status.Conditions, ConditionTypeDebouncePending)
+	if pending && (cond == nil || cond.Status != metav1.ConditionTrue) {
+		err := UpdateStatusWithConflictRetry(ctx, c.Client, syncState, func() {
+			meta.SetStatusCondition(&syncState.Status.Conditions, metav1.Condition{
+				Type:   ConditionTypeDebouncePending,
+				Status: metav1.ConditionTrue,
+				Reason: "Debouncing",
+				Message: fmt.Sprintf("Change is waiting %s for further updates; set annotation %s to sync now",
+					c.Debouncer.GetDelay(), AnnotationFlushDebounce),
+				ObservedGeneration: syncState.Generation,
+				LastTransitionTime: metav1.Now(),
+			})
+		})
+		if err != nil {
+			return true, fmt.Errorf("set debounce pending condition: %w", err)
+		}
+	} else if !pending && cond != nil {
+		err := UpdateStatusWithConflictRetry(ctx, c.Client, syncState, func() {
+			meta.RemoveStatusCondition(&syncState.Status.Conditions, ConditionTypeDebouncePending)
+		})
+		if err != nil {
+			return false, fmt.Errorf("clear debounce pending condition: %w", err)
+		}
+	}
+
+	return pending, nil
+}
+
 // ShouldSync determines if a sync is needed by comparing config hashes.
-// Returns true if the configuration has changed since the last sync.
-func (*BaseSyncController) ShouldSync(syncState *v1alpha2.CloudflareSyncState, newHash string) bool {
-	return HashChanged(syncState.Status.ConfigHash, newHash)
+// Returns true if the configuration has changed since the last sync, if a
+// force-sync annotation is pending on the SyncState, or if a drift resync with
+// the given interval is due.
+func (*BaseSyncController) ShouldSync(
+	syncState *v1alpha2.CloudflareSyncState,
+	newHash string,
+	driftInterval time.Duration,
+) bool {
+	return controllercommon.ForceSyncRequested(syncState) ||
+		HashChanged(syncState.Status.ConfigHash, newHash) ||
+		DriftResyncDue(syncState, driftInterval, time.Now())
+}
+
+// DriftResyncInterval returns the drift resync interval of the SyncState. It is taken
+// from AnnotationDriftResyncInterval on the operator resources contributing to the
+// SyncState, preferring the source with the highest priority (lowest number), and
+// falls back to DriftResyncInterval when none has a valid value.
+func (c *BaseSyncController) DriftResyncInterval(
+	ctx context.Context,
+	syncState *v1alpha2.CloudflareSyncState,
+) time.Duration {
+	sources := slices.Clone(syncState.Spec.Sources)
+	slices.SortStableFunc(sources, func(a, b v1alpha2.ConfigSource) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	for _, source := range sources {
+		gvk := v1alpha2.GroupVersion.WithKind(source.Ref.Kind)
+		if !c.Client.Scheme().Recognizes(gvk) {
+			continue
+		}
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(gvk)
+		key := client.ObjectKey{Namespace: source.Ref.Namespace, Name: source.Ref.Name}
+		if err := c.Client.Get(ctx, key, obj); err != nil {
+			continue
+		}
+		if d, ok := parseDriftResyncInterval(obj.GetAnnotations()); ok {
+			return d
+		}
+	}
+	return max(DriftResyncInterval, 0)
 }
 
 // SetSyncStatus updates the SyncState status to the specified state.
@@ -110,6 +226,8 @@ func (c *BaseSyncController) SetSyncStatus(
 // UpdateSyncStatus updates the SyncState status with the sync result.
 // It handles both success and error cases, setting appropriate conditions.
 // Uses conflict retry to handle concurrent updates safely.
+// A successful sync also consumes a pending force-sync annotation, so the same
+// annotation value does not sync again.
 func (c *BaseSyncController) UpdateSyncStatus(
 	ctx context.Context,
 	syncState *v1alpha2.CloudflareSyncState,
@@ -155,6 +273,10 @@ func (c *BaseSyncController) UpdateSyncStatus(
 		return fmt.Errorf("update status: %w", err)
 	}
 
+	if status == v1alpha2.SyncStatusSynced && syncErr == nil {
+		return controllercommon.ConsumeForceSync(ctx, c.Client, syncState)
+	}
+
 	return nil
 }
 
@@ -277,10 +399,44 @@ func RequeueAfterError(err error) time.Duration {
 	}
 }
 
-// RequeueAfterSuccess returns a requeue duration for periodic refresh.
-// Most syncs don't need periodic refresh (they're event-driven), so this returns 0.
-func RequeueAfterSuccess() time.Duration {
-	return 0
+// RequeueAfterSuccess returns a requeue duration for periodic drift resync: the
+// time left until the drift resync with the given interval is due, or 0 when
+// periodic resync is disabled and syncs are purely event-driven.
+func RequeueAfterSuccess(syncState *v1alpha2.CloudflareSyncState, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+	if syncState.Status.LastSyncTime == nil {
+		return interval
+	}
+	remaining := interval - time.Since(syncState.Status.LastSyncTime.Time)
+	if remaining <= 0 {
+		return interval
+	}
+	return remaining
+}
+
+// DriftResyncDue reports whether the last successful sync of the SyncState is
+// older than the drift resync interval.
+func DriftResyncDue(syncState *v1alpha2.CloudflareSyncState, interval time.Duration, now time.Time) bool {
+	if interval <= 0 || syncState.Status.LastSyncTime == nil {
+		return false
+	}
+	return now.Sub(syncState.Status.LastSyncTime.Time) >= interval
+}
+
+// parseDriftResyncInterval returns the interval from AnnotationDriftResyncInterval.
+// It reports false when the annotation is missing or not a valid duration.
+func parseDriftResyncInterval(annotations map[string]string) (time.Duration, bool) {
+	value, ok := annotations[AnnotationDriftResyncInterval]
+	if !ok {
+		return 0, false
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, false
+	}
+	return d, true
 }
 
 // UpdateWithConflictRetry updates a SyncState with retry on conflict.
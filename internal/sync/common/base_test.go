@@ -6,11 +6,13 @@ package common
 import (
 	"context"
 	"encoding/json"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -18,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	controllercommon "github.com/StringKe/cloudflare-operator/internal/controller/common"
 )
 
 func init() {
@@ -93,7 +96,7 @@ func TestBaseSyncController_ShouldSync_HashChanged(t *testing.T) {
 
 	c := &BaseSyncController{}
 
-	assert.True(t, c.ShouldSync(syncState, "new-hash-xyz789"))
+	assert.True(t, c.ShouldSync(syncState, "new-hash-xyz789", 0))
 }
 
 func TestBaseSyncController_ShouldSync_SameHash(t *testing.T) {
@@ -105,7 +108,7 @@ func TestBaseSyncController_ShouldSync_SameHash(t *testing.T) {
 
 	c := &BaseSyncController{}
 
-	assert.False(t, c.ShouldSync(syncState, "same-hash-123"))
+	assert.False(t, c.ShouldSync(syncState, "same-hash-123", 0))
 }
 
 func TestBaseSyncController_ShouldSync_EmptyPreviousHash(t *testing.T) {
@@ -117,7 +120,34 @@ func TestBaseSyncController_ShouldSync_EmptyPreviousHash(t *testing.T) {
 
 	c := &BaseSyncController{}
 
-	assert.True(t, c.ShouldSync(syncState, "new-hash"))
+	assert.True(t, c.ShouldSync(syncState, "new-hash", 0))
+}
+
+func TestBaseSyncController_ShouldSync_ForceSync(t *testing.T) {
+	syncState := &v1alpha2.CloudflareSyncState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-sync",
+			Annotations: map[string]string{controllercommon.AnnotationForceSync: "2026-10-16T00:00:00Z"},
+		},
+		Status: v1alpha2.CloudflareSyncStateStatus{
+			ConfigHash: "same-hash-123",
+		},
+	}
+	client := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(syncState).
+		WithStatusSubresource(syncState).
+		Build()
+	c := NewBaseSyncController(client)
+
+	// A pending force-sync bypasses the unchanged hash
+	assert.True(t, c.ShouldSync(syncState, "same-hash-123", 0))
+
+	// A successful sync consumes it, so the same value no longer forces a sync
+	result := &SyncResult{ConfigHash: "same-hash-123"}
+	require.NoError(t, c.UpdateSyncStatus(context.Background(), syncState, v1alpha2.SyncStatusSynced, result, nil))
+	assert.Equal(t, "2026-10-16T00:00:00Z", syncState.Annotations[controllercommon.AnnotationLastForceSync])
+	assert.False(t, c.ShouldSync(syncState, "same-hash-123", 0))
 }
 
 func TestSyncError_Error(t *testing.T) {
@@ -272,6 +302,75 @@ func TestRequeueAfterError_PermanentErrors(t *testing.T) {
 	}
 }
 
+func TestBaseSyncController_DriftResyncInterval(t *testing.T) {
+	original := DriftResyncInterval
+	t.Cleanup(func() { DriftResyncInterval = original })
+	DriftResyncInterval = 15 * time.Minute
+
+	tunnel := &v1alpha2.Tunnel{ObjectMeta: metav1.ObjectMeta{Name: "my-tunnel", Namespace: "default"}}
+	syncState := &v1alpha2.CloudflareSyncState{
+		Spec: v1alpha2.CloudflareSyncStateSpec{
+			Sources: []v1alpha2.ConfigSource{
+				{Ref: v1alpha2.SourceReference{Kind: "Ingress", Namespace: "default", Name: "web"}, Priority: 100},
+				{Ref: v1alpha2.SourceReference{Kind: "Tunnel", Namespace: "default", Name: "my-tunnel"}, Priority: 10},
+			},
+		},
+	}
+	c := NewBaseSyncController(fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tunnel).Build())
+	ctx := context.Background()
+
+	assert.Equal(t, 15*time.Minute, c.DriftResyncInterval(ctx, syncState))
+
+	tests := []struct {
+		annotation string
+		want       time.Duration
+	}{
+		{annotation: "2m", want: 2 * time.Minute},
+		{annotation: "0", want: 0},
+		{annotation: "invalid", want: 15 * time.Minute},
+		{annotation: "-1m", want: 15 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.annotation, func(t *testing.T) {
+			tunnel.Annotations = map[string]string{AnnotationDriftResyncInterval: tt.annotation}
+			require.NoError(t, c.Client.Update(ctx, tunnel))
+			assert.Equal(t, tt.want, c.DriftResyncInterval(ctx, syncState))
+		})
+	}
+
+	// An annotation on the SyncState itself is ignored
+	syncState.Annotations = map[string]string{AnnotationDriftResyncInterval: "1m"}
+	assert.Equal(t, 15*time.Minute, c.DriftResyncInterval(ctx, syncState))
+}
+
+func TestRequeueAfterSuccess_RemainingInterval(t *testing.T) {
+	syncState := &v1alpha2.CloudflareSyncState{}
+	assert.Equal(t, time.Duration(0), RequeueAfterSuccess(syncState, 0))
+	assert.Equal(t, 10*time.Minute, RequeueAfterSuccess(syncState, 10*time.Minute))
+
+	lastSync := metav1.NewTime(time.Now().Add(-4 * time.Minute))
+	syncState.Status.LastSyncTime = &lastSync
+	remaining := RequeueAfterSuccess(syncState, 10*time.Minute)
+	assert.LessOrEqual(t, remaining, 6*time.Minute)
+	assert.Greater(t, remaining, 5*time.Minute)
+}
+
+func TestBaseSyncController_ShouldSync_DriftResync(t *testing.T) {
+	lastSync := metav1.NewTime(time.Now().Add(-5 * time.Minute))
+	syncState := &v1alpha2.CloudflareSyncState{
+		Status: v1alpha2.CloudflareSyncStateStatus{
+			ConfigHash:   "same-hash-123",
+			LastSyncTime: &lastSync,
+		},
+	}
+	c := &BaseSyncController{}
+
+	assert.False(t, c.ShouldSync(syncState, "same-hash-123", 10*time.Minute), "resync not due yet")
+	assert.True(t, DriftResyncDue(syncState, 10*time.Minute, lastSync.Add(10*time.Minute)))
+	assert.True(t, c.ShouldSync(syncState, "same-hash-123", time.Minute))
+	assert.False(t, c.ShouldSync(syncState, "same-hash-123", 0))
+}
+
 func TestShouldResetFromFailed(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -499,3 +598,52 @@ func TestConstants(t *testing.T) {
 	assert.Equal(t, 10*time.Second, BaseRetryDelay)
 	assert.Equal(t, 5*time.Minute, MaxRetryDelay)
 }
+
+func TestBaseSyncController_CheckDebounce(t *testing.T) {
+	syncState := &v1alpha2.CloudflareSyncState{
+		ObjectMeta: metav1.ObjectMeta{Name: "tunnel-config-1"},
+		Spec: v1alpha2.CloudflareSyncStateSpec{
+			ResourceType: v1alpha2.SyncResourceTunnelConfiguration,
+			CloudflareID: "tunnel-1",
+			AccountID:    "acc-123",
+		},
+	}
+	client := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(syncState).
+		WithStatusSubresource(syncState).
+		Build()
+	c := NewBaseSyncControllerWithDelay(client, time.Hour)
+	ctx := context.Background()
+
+	// Nothing pending: reconcile proceeds without a condition
+	pending, err := c.CheckDebounce(ctx, syncState)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Nil(t, meta.FindStatusCondition(syncState.Status.Conditions, ConditionTypeDebouncePending))
+
+	// Pending change: reconcile is skipped and the condition is surfaced
+	var flushed int32
+	c.Debouncer.Debounce(syncState.Name, func() { atomic.AddInt32(&flushed, 1) })
+	pending, err = c.CheckDebounce(ctx, syncState)
+	require.NoError(t, err)
+	assert.True(t, pending)
+
+	var updated v1alpha2.CloudflareSyncState
+	require.NoError(t, client.Get(ctx, ctrlclient.ObjectKey{Name: syncState.Name}, &updated))
+	cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDebouncePending)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+
+	// The flush annotation runs the pending change and is removed
+	updated.Annotations = map[string]string{AnnotationFlushDebounce: "true"}
+	require.NoError(t, client.Update(ctx, &updated))
+	pending, err = c.CheckDebounce(ctx, &updated)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&flushed))
+
+	require.NoError(t, client.Get(ctx, ctrlclient.ObjectKey{Name: syncState.Name}, &updated))
+	assert.NotContains(t, updated.Annotations, AnnotationFlushDebounce)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, ConditionTypeDebouncePending))
+}
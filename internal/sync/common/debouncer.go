@@ -4,6 +4,7 @@
 package common
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -32,6 +33,7 @@ type Debouncer struct {
 type debouncedItem struct {
 	timer   *time.Timer
 	request ctrl.Request
+	fn      func()
 }
 
 // NewDebouncer creates a new Debouncer with the specified delay.
@@ -58,14 +60,13 @@ func (d *Debouncer) Debounce(key string, fn func()) {
 	}
 
 	// Schedule new timer
-	d.pending[key] = &debouncedItem{
-		timer: time.AfterFunc(d.delay, func() {
-			d.mu.Lock()
-			delete(d.pending, key)
-			d.mu.Unlock()
+	item := &debouncedItem{fn: fn}
+	item.timer = time.AfterFunc(d.delay, func() {
+		if d.remove(key, item) {
 			fn()
-		}),
-	}
+		}
+	})
+	d.pending[key] = item
 }
 
 // DebounceRequest schedules a reconciliation request after the delay.
@@ -81,19 +82,34 @@ func (d *Debouncer) DebounceRequest(key string, req ctrl.Request, enqueue func(c
 	}
 
 	// Schedule new timer
-	d.pending[key] = &debouncedItem{
-		timer: time.AfterFunc(d.delay, func() {
-			d.mu.Lock()
-			delete(d.pending, key)
-			d.mu.Unlock()
-			enqueue(req)
-		}),
+	item := &debouncedItem{
 		request: req,
+		fn:      func() { enqueue(req) },
 	}
+	item.timer = time.AfterFunc(d.delay, func() {
+		if d.remove(key, item) {
+			item.fn()
+		}
+	})
+	d.pending[key] = item
 
 	return true
 }
 
+// remove deletes the pending entry for key if it is still the given item.
+// Returns false if the item was already cancelled, flushed or replaced,
+// in which case its function must not run.
+func (d *Debouncer) remove(key string, item *debouncedItem) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pending[key] != item {
+		return false
+	}
+	delete(d.pending, key)
+	return true
+}
+
 // Cancel cancels a pending debounced operation.
 // Returns true if an operation was cancelled, false if none was pending.
 func (d *Debouncer) Cancel(key string) bool {
@@ -108,20 +124,36 @@ func (d *Debouncer) Cancel(key string) bool {
 	return false
 }
 
-// Flush immediately executes all pending operations.
-// This is useful during shutdown or testing.
-func (d *Debouncer) Flush() {
+// Flush immediately executes the pending operation for a single key.
+// Returns true if an operation was pending and executed, false otherwise.
+// This is useful to force a debounced change through without waiting.
+func (d *Debouncer) Flush(key string) bool {
 	d.mu.Lock()
-	pending := make(map[string]*debouncedItem)
-	for k, v := range d.pending {
-		pending[k] = v
+	item, ok := d.pending[key]
+	if ok {
+		item.timer.Stop()
+		delete(d.pending, key)
 	}
+	d.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+	item.fn()
+	return true
+}
+
+// FlushAll immediately executes all pending operations.
+// This is useful during shutdown or testing.
+func (d *Debouncer) FlushAll() {
+	d.mu.Lock()
+	pending := d.pending
 	d.pending = make(map[string]*debouncedItem)
 	d.mu.Unlock()
 
-	// Stop all timers (functions may have already been scheduled)
 	for _, item := range pending {
 		item.timer.Stop()
+		item.fn()
 	}
 }
 
@@ -132,6 +164,19 @@ func (d *Debouncer) PendingCount() int {
 	return len(d.pending)
 }
 
+// PendingKeys returns the sorted keys of all pending debounced operations.
+func (d *Debouncer) PendingKeys() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	keys := make([]string, 0, len(d.pending))
+	for k := range d.pending {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // IsPending checks if a specific key has a pending operation.
 func (d *Debouncer) IsPending(key string) bool {
 	d.mu.Lock()
@@ -141,14 +141,16 @@ func TestDebouncer_Cancel_NonExistent(t *testing.T) {
 func TestDebouncer_Flush(t *testing.T) {
 	d := NewDebouncer(1 * time.Second) // Long delay
 
-	d.Debounce("key1", func() {})
-	d.Debounce("key2", func() {})
-	d.Debounce("key3", func() {})
+	var called int32
+	for _, key := range []string{"key1", "key2", "key3"} {
+		d.Debounce(key, func() { atomic.AddInt32(&called, 1) })
+	}
 
 	assert.Equal(t, 3, d.PendingCount())
 
 	// Flush all pending
-	d.Flush()
+	d.FlushAll()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&called))
 
 	assert.Equal(t, 0, d.PendingCount())
 	assert.False(t, d.IsPending("key1"))
@@ -156,6 +158,59 @@ func TestDebouncer_Flush(t *testing.T) {
 	assert.False(t, d.IsPending("key3"))
 }
 
+func TestDebouncer_FlushKey(t *testing.T) {
+	d := NewDebouncer(1 * time.Second) // Long delay
+	var flushed ctrl.Request
+	var other int32
+
+	req := ctrl.Request{}
+	req.Name = "tunnel-a"
+	d.DebounceRequest("tunnel-a", req, func(r ctrl.Request) { flushed = r })
+	d.Debounce("tunnel-b", func() { atomic.AddInt32(&other, 1) })
+
+	// Flushing one key runs it immediately and leaves the others pending
+	assert.True(t, d.Flush("tunnel-a"))
+	assert.Equal(t, "tunnel-a", flushed.Name)
+	assert.False(t, d.IsPending("tunnel-a"))
+	assert.True(t, d.IsPending("tunnel-b"))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&other))
+
+	// Flushing a key that is no longer pending is a no-op
+	assert.False(t, d.Flush("tunnel-a"))
+	assert.False(t, d.Flush("non-existent"))
+}
+
+func TestDebouncer_PendingKeys(t *testing.T) {
+	d := NewDebouncer(50 * time.Millisecond)
+	assert.Empty(t, d.PendingKeys())
+
+	d.Debounce("b", func() {})
+	d.Debounce("a", func() {})
+	d.Debounce("c", func() {})
+	assert.Equal(t, []string{"a", "b", "c"}, d.PendingKeys())
+
+	d.Cancel("b")
+	assert.Equal(t, []string{"a", "c"}, d.PendingKeys())
+
+	// Keys are removed once their operation has run
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, d.PendingKeys())
+}
+
+func TestDebouncer_ReplacedTimerDoesNotRun(t *testing.T) {
+	d := NewDebouncer(1 * time.Second) // Long delay
+	var first, second int32
+
+	d.Debounce("key", func() { atomic.AddInt32(&first, 1) })
+	d.Debounce("key", func() { atomic.AddInt32(&second, 1) })
+
+	// Only the latest function is flushed
+	assert.True(t, d.Flush("key"))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&first))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&second))
+	assert.Equal(t, 0, d.PendingCount())
+}
+
 func TestDebouncer_IsPending_NonExistent(t *testing.T) {
 	d := NewDebouncer(50 * time.Millisecond)
 	assert.False(t, d.IsPending("non-existent"))
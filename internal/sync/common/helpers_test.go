@@ -290,8 +290,8 @@ func TestRequeueAfterError(t *testing.T) {
 }
 
 func TestRequeueAfterSuccess(t *testing.T) {
-	// Success should return 0 (no periodic refresh needed)
-	duration := RequeueAfterSuccess()
+	// Without a drift resync interval, success should return 0 (no periodic refresh)
+	duration := RequeueAfterSuccess(&v1alpha2.CloudflareSyncState{}, 0)
 	assert.Equal(t, time.Duration(0), duration)
 }
 
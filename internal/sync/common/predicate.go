@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	controllercommon "github.com/StringKe/cloudflare-operator/internal/controller/common"
 )
 
 // PredicateForResourceType creates a predicate that filters CloudflareSyncState
@@ -45,3 +46,23 @@ func PredicateForResourceType(resourceType v1alpha2.SyncResourceType) predicate.
 		},
 	}
 }
+
+// SyncStateChangedPredicate passes the SyncState events that need a sync: spec
+// changes, a new force-sync value and a flush-debounce request. Status updates
+// written by the Sync Controllers themselves are filtered out. Combine it with
+// PredicateForResourceType or an equivalent resource type filter.
+func SyncStateChangedPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		controllercommon.ForceSyncPredicate(),
+		predicate.Funcs{
+			CreateFunc: func(event.CreateEvent) bool { return false },
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				_, ok := e.ObjectNew.GetAnnotations()[AnnotationFlushDebounce]
+				return ok
+			},
+			DeleteFunc:  func(event.DeleteEvent) bool { return false },
+			GenericFunc: func(event.GenericEvent) bool { return false },
+		},
+	)
+}
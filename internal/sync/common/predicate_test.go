@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	controllercommon "github.com/StringKe/cloudflare-operator/internal/controller/common"
 )
 
 func createSyncStateWithType(resourceType v1alpha2.SyncResourceType) *v1alpha2.CloudflareSyncState {
@@ -181,3 +182,29 @@ func TestPredicateForResourceType_GenericEvent(t *testing.T) {
 	// since we didn't set GenericFunc
 	assert.True(t, pred.Generic(genericE))
 }
+
+func TestSyncStateChangedPredicate(t *testing.T) {
+	pred := SyncStateChangedPredicate()
+	old := createSyncStateWithType(v1alpha2.SyncResourceTunnelConfiguration)
+	old.Generation = 1
+
+	assert.True(t, pred.Create(event.CreateEvent{Object: old}))
+	assert.True(t, pred.Delete(event.DeleteEvent{Object: old}))
+
+	specChanged := old.DeepCopy()
+	specChanged.Generation = 2
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: specChanged}))
+
+	statusChanged := old.DeepCopy()
+	statusChanged.Status.SyncStatus = v1alpha2.SyncStatusSynced
+	assert.False(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: statusChanged}),
+		"status-only updates are filtered out")
+
+	forceSync := old.DeepCopy()
+	forceSync.Annotations = map[string]string{controllercommon.AnnotationForceSync: "1"}
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: forceSync}))
+
+	flush := old.DeepCopy()
+	flush.Annotations = map[string]string{AnnotationFlushDebounce: "true"}
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: flush}))
+}
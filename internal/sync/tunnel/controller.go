@@ -9,6 +9,7 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
 	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	controllercommon "github.com/StringKe/cloudflare-operator/internal/controller/common"
 	tunnelsvc "github.com/StringKe/cloudflare-operator/internal/service/tunnel"
 	"github.com/StringKe/cloudflare-operator/internal/sync/common"
 )
@@ -100,7 +102,11 @@ func (r *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Skip if there's a pending debounced request (will be reconciled later)
-	if r.Debouncer.IsPending(req.Name) {
+	pending, err := r.CheckDebounce(ctx, syncState)
+	if err != nil {
+		logger.Error(err, "Failed to update debounce state")
+	}
+	if pending {
 		logger.V(1).Info("Skipping reconcile - debounced request pending")
 		return ctrl.Result{}, nil
 	}
@@ -145,7 +151,8 @@ func (r *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Check if configuration has changed
-	if !r.ShouldSync(syncState, configHash) {
+	driftInterval := r.DriftResyncInterval(ctx, syncState)
+	if !r.ShouldSync(syncState, configHash, driftInterval) {
 		logger.V(1).Info("Configuration unchanged, skipping sync", "hash", configHash)
 		// Even if config unchanged, ensure status is Synced (not stuck at Syncing)
 		// and aggregatedConfig is persisted for observability
@@ -159,13 +166,14 @@ func (r *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				return ctrl.Result{}, err
 			}
 		}
-		return ctrl.Result{}, nil
+		return ctrl.Result{RequeueAfter: common.RequeueAfterSuccess(syncState, driftInterval)}, nil
 	}
 
 	logger.Info("Configuration changed, syncing to Cloudflare",
 		"tunnelId", syncState.Spec.CloudflareID,
 		"previousHash", syncState.Status.ConfigHash,
 		"newHash", configHash,
+		"forceSync", controllercommon.ForceSyncRequested(syncState),
 		"sourceCount", len(syncState.Spec.Sources),
 		"ruleCount", len(aggregatedConfig.Ingress))
 
@@ -204,7 +212,7 @@ func (r *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		"version", result.Version,
 		"hostnames", ExtractHostnames(aggregatedConfig))
 
-	return ctrl.Result{RequeueAfter: common.RequeueAfterSuccess()}, nil
+	return ctrl.Result{RequeueAfter: common.RequeueAfterSuccess(syncState, driftInterval)}, nil
 }
 
 // createAPIClient creates a Cloudflare API client from the SyncState credentials
@@ -500,7 +508,7 @@ func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("tunnel-config-sync").
-		For(&v1alpha2.CloudflareSyncState{}).
+		For(&v1alpha2.CloudflareSyncState{}, builder.WithPredicates(common.SyncStateChangedPredicate())).
 		WithEventFilter(tunnelConfigPredicate).
 		Complete(r)
 }
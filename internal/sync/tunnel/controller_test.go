@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+	"github.com/StringKe/cloudflare-operator/internal/clients/cf"
+	controllercommon "github.com/StringKe/cloudflare-operator/internal/controller/common"
+	tunnelsvc "github.com/StringKe/cloudflare-operator/internal/service/tunnel"
+	"github.com/StringKe/cloudflare-operator/internal/sync/common"
+	"github.com/StringKe/cloudflare-operator/test/mockserver"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+const testSyncTunnelID = "tunnel-1"
+
+// newSyncedConfigSyncState returns a TunnelConfiguration SyncState whose status
+// already records the hash of its configuration.
+func newSyncedConfigSyncState(t *testing.T) *v1alpha2.CloudflareSyncState {
+	t.Helper()
+	configBytes, err := json.Marshal(tunnelsvc.TunnelConfig{
+		Rules: []tunnelsvc.IngressRule{{Hostname: "app.example.com", Service: "http://app.default.svc:80"}},
+	})
+	require.NoError(t, err)
+
+	syncState := &v1alpha2.CloudflareSyncState{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "tunnel-config-" + testSyncTunnelID,
+			Finalizers: []string{FinalizerName},
+		},
+		Spec: v1alpha2.CloudflareSyncStateSpec{
+			ResourceType:   v1alpha2.SyncResourceTunnelConfiguration,
+			CloudflareID:   testSyncTunnelID,
+			AccountID:      "test-account-id",
+			CredentialsRef: v1alpha2.CredentialsReference{Name: "test-credentials"},
+			Sources: []v1alpha2.ConfigSource{{
+				Ref:      v1alpha2.SourceReference{Kind: "Ingress", Namespace: "default", Name: "app"},
+				Config:   runtime.RawExtension{Raw: configBytes},
+				Priority: 100,
+			}},
+		},
+	}
+
+	aggregated, err := Aggregate(syncState)
+	require.NoError(t, err)
+	hash, err := common.ComputeConfigHashDeterministic(aggregated)
+	require.NoError(t, err)
+	syncState.Status = v1alpha2.CloudflareSyncStateStatus{
+		SyncStatus:    v1alpha2.SyncStatusSynced,
+		ConfigHash:    hash,
+		ConfigVersion: 1,
+	}
+	return syncState
+}
+
+func TestController_ForceSyncBypassesUnchangedHash(t *testing.T) {
+	mock := mockserver.NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	t.Setenv(cf.CloudflareAPIBaseURLEnv, server.URL+"/client/v4")
+	mock.Store().CreateTunnel(&models.Tunnel{ID: testSyncTunnelID, Name: "tunnel", AccountTag: "test-account-id"})
+
+	syncState := newSyncedConfigSyncState(t)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(
+			syncState,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudflare-secret", Namespace: "cloudflare-operator-system"},
+				Data:       map[string][]byte{"CLOUDFLARE_API_TOKEN": []byte("token")},
+			},
+			&v1alpha2.CloudflareCredentials{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-credentials"},
+				Spec: v1alpha2.CloudflareCredentialsSpec{
+					AccountID: "test-account-id",
+					AuthType:  v1alpha2.AuthTypeAPIToken,
+					SecretRef: v1alpha2.SecretReference{Name: "cloudflare-secret"},
+				},
+			},
+		).
+		WithStatusSubresource(syncState).
+		Build()
+	r := NewController(k8sClient)
+
+	configVersion := func() int {
+		config, ok := mock.Store().GetTunnelConfiguration(testSyncTunnelID)
+		require.True(t, ok)
+		return config.Version
+	}
+	reconcile := func() *v1alpha2.CloudflareSyncState {
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: syncState.Name}})
+		require.NoError(t, err)
+		updated := &v1alpha2.CloudflareSyncState{}
+		require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(syncState), updated))
+		return updated
+	}
+
+	// The hash is unchanged, so nothing is sent to Cloudflare
+	updated := reconcile()
+	assert.Equal(t, 1, configVersion())
+
+	// A force-sync re-applies the configuration once
+	updated.Annotations = map[string]string{controllercommon.AnnotationForceSync: "2026-10-16T00:00:00Z"}
+	require.NoError(t, k8sClient.Update(context.Background(), updated))
+
+	updated = reconcile()
+	assert.Equal(t, 2, configVersion())
+	assert.Equal(t, "2026-10-16T00:00:00Z", updated.Annotations[controllercommon.AnnotationLastForceSync])
+	assert.Equal(t, v1alpha2.SyncStatusSynced, updated.Status.SyncStatus)
+
+	// The consumed value does not trigger another sync
+	reconcile()
+	assert.Equal(t, 2, configVersion())
+}
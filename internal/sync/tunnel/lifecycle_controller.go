@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -95,7 +96,11 @@ func (r *LifecycleController) Reconcile(ctx context.Context, req ctrl.Request) (
 	}
 
 	// Skip if there's a pending debounced request
-	if r.Debouncer.IsPending(req.Name) {
+	pending, err := r.CheckDebounce(ctx, syncState)
+	if err != nil {
+		logger.Error(err, "Failed to update debounce state")
+	}
+	if pending {
 		logger.V(1).Info("Skipping reconcile - debounced request pending")
 		return ctrl.Result{}, nil
 	}
@@ -514,7 +519,7 @@ func (r *LifecycleController) SetupWithManager(mgr ctrl.Manager) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("tunnel-lifecycle-sync").
-		For(&v1alpha2.CloudflareSyncState{}).
+		For(&v1alpha2.CloudflareSyncState{}, builder.WithPredicates(common.SyncStateChangedPredicate())).
 		WithEventFilter(lifecyclePredicate).
 		Complete(r)
 }
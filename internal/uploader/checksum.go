@@ -22,19 +22,31 @@ const (
 	AlgorithmMD5    = "md5"
 )
 
+// ChecksumMismatchError is returned when downloaded content does not match the configured checksum.
+type ChecksumMismatchError struct {
+	// Algorithm is the checksum algorithm used.
+	Algorithm string
+	// Expected is the configured checksum value.
+	Expected string
+	// Actual is the checksum computed from the downloaded content.
+	Actual string
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch (%s): expected %s, got %s", e.Algorithm, e.Expected, e.Actual)
+}
+
 // VerifyChecksum verifies the checksum of data against expected value.
+// Returns a *ChecksumMismatchError if the computed checksum differs.
 func VerifyChecksum(data []byte, cfg *v1alpha2.ChecksumConfig) error {
 	if cfg == nil || cfg.Value == "" {
 		return nil
 	}
 
-	algorithm := cfg.Algorithm
-	if algorithm == "" {
-		algorithm = AlgorithmSHA256
-	}
+	algorithm := checksumAlgorithm(cfg)
 
 	var hasher hash.Hash
-	switch strings.ToLower(algorithm) {
+	switch algorithm {
 	case AlgorithmSHA256:
 		hasher = sha256.New()
 	case AlgorithmSHA512:
@@ -51,12 +63,24 @@ func VerifyChecksum(data []byte, cfg *v1alpha2.ChecksumConfig) error {
 	// Compare checksums (case-insensitive)
 	expected := strings.ToLower(cfg.Value)
 	if computed != expected {
-		return fmt.Errorf("checksum mismatch: expected %s, got %s", expected, computed)
+		return &ChecksumMismatchError{
+			Algorithm: algorithm,
+			Expected:  expected,
+			Actual:    computed,
+		}
 	}
 
 	return nil
 }
 
+// checksumAlgorithm returns the lowercase checksum algorithm, defaulting to sha256.
+func checksumAlgorithm(cfg *v1alpha2.ChecksumConfig) string {
+	if cfg.Algorithm == "" {
+		return AlgorithmSHA256
+	}
+	return strings.ToLower(cfg.Algorithm)
+}
+
 // ComputeChecksum computes a checksum for the given data.
 func ComputeChecksum(data []byte, algorithm string) (string, error) {
 	if algorithm == "" {
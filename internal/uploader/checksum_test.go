@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package uploader
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+const testPage = "<html>hello</html>"
+
+func processTestPage(t *testing.T, checksum *v1alpha2.ChecksumConfig) (*FileManifest, error) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testPage))
+	}))
+	t.Cleanup(server.Close)
+
+	return ProcessSource(context.Background(), fake.NewClientBuilder().Build(), "default",
+		&v1alpha2.DirectUploadSource{HTTP: &v1alpha2.HTTPSource{URL: server.URL}},
+		checksum,
+		&v1alpha2.ArchiveConfig{Type: "none"})
+}
+
+func TestProcessSource_ChecksumMatches(t *testing.T) {
+	expected, err := ComputeChecksum([]byte(testPage), AlgorithmSHA256)
+	require.NoError(t, err)
+
+	manifest, err := processTestPage(t, &v1alpha2.ChecksumConfig{Value: strings.ToUpper(expected)})
+	require.NoError(t, err)
+	assert.Equal(t, AlgorithmSHA256, manifest.ChecksumAlgorithm)
+	assert.Equal(t, expected, manifest.Checksum)
+	assert.Equal(t, expected, manifest.SourceHash)
+}
+
+func TestProcessSource_ChecksumMismatch(t *testing.T) {
+	actual, err := ComputeChecksum([]byte(testPage), AlgorithmMD5)
+	require.NoError(t, err)
+
+	_, err = processTestPage(t, &v1alpha2.ChecksumConfig{Algorithm: "MD5", Value: "deadbeef"})
+	require.Error(t, err)
+
+	var mismatch *ChecksumMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, AlgorithmMD5, mismatch.Algorithm)
+	assert.Equal(t, "deadbeef", mismatch.Expected)
+	assert.Equal(t, actual, mismatch.Actual)
+	assert.Contains(t, err.Error(), "expected deadbeef, got "+actual)
+}
+
+func TestProcessSource_ChecksumMissing(t *testing.T) {
+	manifest, err := processTestPage(t, nil)
+	require.NoError(t, err)
+	assert.Empty(t, manifest.Checksum)
+	assert.Empty(t, manifest.ChecksumAlgorithm)
+	assert.NotEmpty(t, manifest.SourceHash)
+}
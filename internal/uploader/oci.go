@@ -10,11 +10,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
@@ -53,19 +55,22 @@ func NewOCIUploader(ctx context.Context, k8sClient client.Client, namespace stri
 	var remoteOpts []remote.Option
 	remoteOpts = append(remoteOpts, remote.WithContext(ctx))
 
-	// Load credentials from secret if specified
+	// Load credentials from secret if specified, otherwise pull anonymously
 	if cfg.CredentialsSecretRef != nil {
 		secret := &corev1.Secret{}
 		if err := k8sClient.Get(ctx, client.ObjectKey{
 			Namespace: namespace,
 			Name:      cfg.CredentialsSecretRef.Name,
 		}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("credentials secret %q not found in namespace %q", cfg.CredentialsSecretRef.Name, namespace)
+			}
 			return nil, fmt.Errorf("get credentials secret %q: %w", cfg.CredentialsSecretRef.Name, err)
 		}
 
-		auth, err := parseRegistryAuth(secret)
+		auth, err := parseRegistryAuth(secret, ref.Context().RegistryStr())
 		if err != nil {
-			return nil, fmt.Errorf("parse registry auth: %w", err)
+			return nil, fmt.Errorf("parse registry auth from secret %q: %w", secret.Name, err)
 		}
 
 		remoteOpts = append(remoteOpts, remote.WithAuth(auth))
@@ -112,12 +117,28 @@ func (*OCIUploader) GetContentType() string {
 	return ContentTypeOctetStream
 }
 
-// parseRegistryAuth parses registry authentication from a Kubernetes secret.
-// Supports both Docker config format (.dockerconfigjson) and basic auth (username/password).
-func parseRegistryAuth(secret *corev1.Secret) (authn.Authenticator, error) {
+// parseRegistryAuth parses registry authentication for the given registry host from a Kubernetes secret.
+// Supports Docker config secrets (kubernetes.io/dockerconfigjson and the legacy
+// kubernetes.io/dockercfg) and basic auth secrets (username/password).
+// A Docker config without an entry for the registry results in an anonymous pull.
+func parseRegistryAuth(secret *corev1.Secret, registry string) (authn.Authenticator, error) {
 	// Try Docker config format first
-	if dockerConfigJSON, ok := secret.Data[".dockerconfigjson"]; ok {
-		return parseDockerConfig(dockerConfigJSON)
+	if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+		var config dockerConfig
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", corev1.DockerConfigJsonKey, err)
+		}
+		return selectDockerAuth(config.Auths, registry)
+	}
+	if data, ok := secret.Data[corev1.DockerConfigKey]; ok {
+		var auths map[string]dockerAuthEntry
+		if err := json.Unmarshal(data, &auths); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", corev1.DockerConfigKey, err)
+		}
+		return selectDockerAuth(auths, registry)
+	}
+	if secret.Type == corev1.SecretTypeDockerConfigJson || secret.Type == corev1.SecretTypeDockercfg {
+		return nil, fmt.Errorf("secret of type %s has no docker config data", secret.Type)
 	}
 
 	// Fall back to basic auth
@@ -140,49 +161,72 @@ type dockerConfig struct {
 }
 
 type dockerAuthEntry struct {
-	Username string `json:"username,omitempty"`
-	Password string `json:"password,omitempty"`
-	Auth     string `json:"auth,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
 }
 
-// parseDockerConfig parses a Docker config.json and returns an authenticator.
-//
-//nolint:revive // cognitive complexity is acceptable for this parsing function
-func parseDockerConfig(data []byte) (authn.Authenticator, error) {
-	var config dockerConfig
-	if err := json.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("parse docker config: %w", err)
-	}
-
-	// Return the first auth entry found
-	for _, entry := range config.Auths {
-		if entry.Auth != "" {
-			// Decode base64 auth string (username:password)
-			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
-			if err != nil {
-				return nil, fmt.Errorf("decode auth string: %w", err)
-			}
+// dockerHubRegistries are the registry keys Docker Hub credentials may be stored under.
+var dockerHubRegistries = map[string]bool{
+	name.DefaultRegistry:   true,
+	"docker.io":            true,
+	"registry-1.docker.io": true,
+}
 
-			// Split into username:password
-			for i := 0; i < len(decoded); i++ {
-				if decoded[i] == ':' {
-					return &authn.Basic{
-						Username: string(decoded[:i]),
-						Password: string(decoded[i+1:]),
-					}, nil
-				}
-			}
+// selectDockerAuth returns the authenticator for the docker config entry matching the registry host.
+func selectDockerAuth(auths map[string]dockerAuthEntry, registry string) (authn.Authenticator, error) {
+	if len(auths) == 0 {
+		return nil, errors.New("no auth entries found in docker config")
+	}
 
-			return nil, errors.New("invalid auth string format")
+	for key, entry := range auths {
+		host := normalizeRegistryHost(key)
+		if host == registry || (dockerHubRegistries[host] && dockerHubRegistries[registry]) {
+			return dockerEntryAuth(key, entry)
 		}
+	}
 
-		if entry.Username != "" && entry.Password != "" {
-			return &authn.Basic{
-				Username: entry.Username,
-				Password: entry.Password,
-			}, nil
+	// The secret does not cover this registry, pull anonymously
+	return authn.Anonymous, nil
+}
+
+// normalizeRegistryHost strips the scheme and path from a docker config key,
+// e.g. "https://index.docker.io/v1/" becomes "index.docker.io".
+func normalizeRegistryHost(key string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
+
+// dockerEntryAuth converts a docker config entry into an authenticator.
+func dockerEntryAuth(key string, entry dockerAuthEntry) (authn.Authenticator, error) {
+	if entry.Auth != "" {
+		// Decode base64 auth string (username:password)
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("decode auth string for %s: %w", key, err)
 		}
+
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid auth string format for %s", key)
+		}
+		return &authn.Basic{Username: username, Password: password}, nil
+	}
+
+	if entry.IdentityToken != "" {
+		return authn.FromConfig(authn.AuthConfig{IdentityToken: entry.IdentityToken}), nil
+	}
+
+	if entry.Username != "" && entry.Password != "" {
+		return &authn.Basic{
+			Username: entry.Username,
+			Password: entry.Password,
+		}, nil
 	}
 
-	return nil, errors.New("no valid auth entries found in docker config")
+	return nil, fmt.Errorf("docker config entry for %s has no credentials", key)
 }
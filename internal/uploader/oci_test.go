@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package uploader
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+const (
+	testRegistryUser     = "robot"
+	testRegistryPassword = "s3cr3t"
+)
+
+// newTestRegistry starts an in-memory registry holding one image.
+// When requireAuth is set, every request must carry the test basic auth credentials.
+func newTestRegistry(t *testing.T, requireAuth bool) (*httptest.Server, string) {
+	t.Helper()
+	handler := registry.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requireAuth {
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != testRegistryUser || pass != testRegistryPassword {
+				w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	image := fmt.Sprintf("%s/site:v1", host)
+	ref, err := name.ParseReference(image, name.Insecure)
+	require.NoError(t, err)
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img, remote.WithAuth(&authn.Basic{
+		Username: testRegistryUser,
+		Password: testRegistryPassword,
+	})))
+
+	return server, image
+}
+
+func dockerConfigSecret(auths string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-creds", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":` + auths + `}`)},
+	}
+}
+
+func basicAuth(user, pass string) string {
+	return base64.StdEncoding.EncodeToString([]byte(user + ":" + pass))
+}
+
+func downloadOCI(t *testing.T, image string, objs ...client.Object) error {
+	t.Helper()
+	cfg := &v1alpha2.OCISource{Image: image, InsecureRegistry: true}
+	if len(objs) > 0 {
+		cfg.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "registry-creds"}
+	}
+	k8sClient := fake.NewClientBuilder().WithObjects(objs...).Build()
+
+	up, err := NewOCIUploader(context.Background(), k8sClient, "default", cfg)
+	if err != nil {
+		return err
+	}
+	reader, err := up.Download(context.Background())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+	_, err = io.Copy(io.Discard, reader)
+	return err
+}
+
+func TestOCIUploader_AnonymousPull(t *testing.T) {
+	_, image := newTestRegistry(t, false)
+	require.NoError(t, downloadOCI(t, image))
+}
+
+func TestOCIUploader_DockerConfigSecret(t *testing.T) {
+	_, image := newTestRegistry(t, true)
+	host := strings.Split(image, "/")[0]
+
+	// Credentials for another registry are not used for this one
+	secret := dockerConfigSecret(fmt.Sprintf(`{
+		"ghcr.io": {"auth": %q},
+		"https://%s/v2/": {"auth": %q}
+	}`, basicAuth("other", "wrong"), host, basicAuth(testRegistryUser, testRegistryPassword)))
+	require.NoError(t, downloadOCI(t, image, secret))
+
+	// Without a matching entry the pull is anonymous and rejected
+	secret = dockerConfigSecret(fmt.Sprintf(`{"ghcr.io": {"auth": %q}}`,
+		basicAuth(testRegistryUser, testRegistryPassword)))
+	require.Error(t, downloadOCI(t, image, secret))
+}
+
+func TestOCIUploader_BasicAuthSecret(t *testing.T) {
+	_, image := newTestRegistry(t, true)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry-creds", Namespace: "default"},
+		Data: map[string][]byte{
+			"username": []byte(testRegistryUser),
+			"password": []byte(testRegistryPassword),
+		},
+	}
+	require.NoError(t, downloadOCI(t, image, secret))
+}
+
+func TestOCIUploader_MissingSecret(t *testing.T) {
+	cfg := &v1alpha2.OCISource{
+		Image:                "ghcr.io/org/site:v1",
+		CredentialsSecretRef: &corev1.LocalObjectReference{Name: "registry-creds"},
+	}
+	_, err := NewOCIUploader(context.Background(), fake.NewClientBuilder().Build(), "default", cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `credentials secret "registry-creds" not found in namespace "default"`)
+}
+
+func TestParseRegistryAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		secret   *corev1.Secret
+		registry string
+		wantUser string
+		wantAnon bool
+		errMsg   string
+	}{
+		{
+			name:     "docker hub alias",
+			secret:   dockerConfigSecret(fmt.Sprintf(`{"https://index.docker.io/v1/": {"auth": %q}}`, basicAuth("hub", "pw"))),
+			registry: name.DefaultRegistry,
+			wantUser: "hub",
+		},
+		{
+			name:     "username and password entry",
+			secret:   dockerConfigSecret(`{"ghcr.io": {"username": "gh", "password": "pw"}}`),
+			registry: "ghcr.io",
+			wantUser: "gh",
+		},
+		{
+			name: "legacy dockercfg",
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeDockercfg,
+				Data: map[string][]byte{corev1.DockerConfigKey: []byte(`{"quay.io": {"username": "q", "password": "pw"}}`)},
+			},
+			registry: "quay.io",
+			wantUser: "q",
+		},
+		{
+			name:     "no entry for registry",
+			secret:   dockerConfigSecret(`{"ghcr.io": {"username": "gh", "password": "pw"}}`),
+			registry: "quay.io",
+			wantAnon: true,
+		},
+		{
+			name:     "malformed json",
+			secret:   dockerConfigSecret(`{"ghcr.io": `),
+			registry: "ghcr.io",
+			errMsg:   "parse .dockerconfigjson",
+		},
+		{
+			name:     "malformed auth string",
+			secret:   dockerConfigSecret(fmt.Sprintf(`{"ghcr.io": {"auth": %q}}`, base64.StdEncoding.EncodeToString([]byte("nocolon")))),
+			registry: "ghcr.io",
+			errMsg:   "invalid auth string format",
+		},
+		{
+			name:     "dockerconfigjson type without data",
+			secret:   &corev1.Secret{Type: corev1.SecretTypeDockerConfigJson},
+			registry: "ghcr.io",
+			errMsg:   "has no docker config data",
+		},
+		{
+			name:     "opaque secret without credentials",
+			secret:   &corev1.Secret{Data: map[string][]byte{"token": []byte("x")}},
+			registry: "ghcr.io",
+			errMsg:   "must contain .dockerconfigjson or username/password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth, err := parseRegistryAuth(tt.secret, tt.registry)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantAnon {
+				assert.Equal(t, authn.Anonymous, auth)
+				return
+			}
+			cfg, err := auth.Authorization()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, cfg.Username)
+		})
+	}
+}
@@ -8,17 +8,29 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
 )
 
+const (
+	// EnvWebIdentityTokenFile is the environment variable holding the default web identity token path.
+	EnvWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	// S3RoleSessionName is the STS session name used when assuming a role.
+	S3RoleSessionName = "cloudflare-operator"
+)
+
 // S3Uploader downloads files from S3-compatible storage.
 type S3Uploader struct {
 	s3Client *s3.Client
@@ -50,34 +62,20 @@ func NewS3Uploader(ctx context.Context, k8sClient client.Client, namespace strin
 		opts = append(opts, config.WithRegion(cfg.Region))
 	}
 
-	// Load credentials from secret if specified
-	if cfg.CredentialsSecretRef != nil {
-		secret := &corev1.Secret{}
-		if err := k8sClient.Get(ctx, client.ObjectKey{
-			Namespace: namespace,
-			Name:      cfg.CredentialsSecretRef.Name,
-		}, secret); err != nil {
-			return nil, fmt.Errorf("get credentials secret %q: %w", cfg.CredentialsSecretRef.Name, err)
-		}
-
-		accessKeyID := string(secret.Data["accessKeyId"])
-		secretAccessKey := string(secret.Data["secretAccessKey"])
-		sessionToken := string(secret.Data["sessionToken"])
-
-		if accessKeyID == "" || secretAccessKey == "" {
-			return nil, errors.New("credentials secret must contain accessKeyId and secretAccessKey")
-		}
-
-		creds := credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, sessionToken)
-		opts = append(opts, config.WithCredentialsProvider(creds))
-	}
-
 	// Load AWS config
 	awsCfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("load AWS config: %w", err)
 	}
 
+	// Select credentials: secret, then an allowed web identity role, then anonymous
+	// when requested, and otherwise the default credential chain
+	creds, err := resolveS3Credentials(ctx, k8sClient, namespace, cfg, newSTSClient(awsCfg), awsCfg.Credentials)
+	if err != nil {
+		return nil, err
+	}
+	awsCfg.Credentials = creds
+
 	// Build S3 client options
 	var s3Opts []func(*s3.Options)
 
@@ -105,6 +103,72 @@ func NewS3Uploader(ctx context.Context, k8sClient client.Client, namespace strin
 	}, nil
 }
 
+// newSTSClient creates the STS client used for AssumeRoleWithWebIdentity.
+// Overridden in tests.
+var newSTSClient = func(awsCfg aws.Config) stscreds.AssumeRoleWithWebIdentityAPIClient {
+	return sts.NewFromConfig(awsCfg)
+}
+
+// AllowedS3RoleARNs are the IAM roles S3 sources may assume with the operator's web
+// identity token. Set from the --s3-allowed-role-arns flag; empty allows no role.
+var AllowedS3RoleARNs []string
+
+// resolveS3Credentials selects the credentials provider for an S3 source.
+// Static keys from CredentialsSecretRef take precedence. Otherwise, when RoleARN is set
+// and allowed by AllowedS3RoleARNs, the role is assumed with the operator's web identity
+// token. Without either, requests are anonymous when Anonymous is set, and otherwise
+// use defaultCreds from the default AWS credential chain.
+func resolveS3Credentials(
+	ctx context.Context,
+	k8sClient client.Client,
+	namespace string,
+	cfg *v1alpha2.S3Source,
+	stsClient stscreds.AssumeRoleWithWebIdentityAPIClient,
+	defaultCreds aws.CredentialsProvider,
+) (aws.CredentialsProvider, error) {
+	if cfg.CredentialsSecretRef != nil {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{
+			Namespace: namespace,
+			Name:      cfg.CredentialsSecretRef.Name,
+		}, secret); err != nil {
+			return nil, fmt.Errorf("get credentials secret %q: %w", cfg.CredentialsSecretRef.Name, err)
+		}
+
+		accessKeyID := string(secret.Data["accessKeyId"])
+		secretAccessKey := string(secret.Data["secretAccessKey"])
+		sessionToken := string(secret.Data["sessionToken"])
+
+		if accessKeyID == "" || secretAccessKey == "" {
+			return nil, errors.New("credentials secret must contain accessKeyId and secretAccessKey")
+		}
+
+		return credentials.NewStaticCredentialsProvider(accessKeyID, secretAccessKey, sessionToken), nil
+	}
+
+	if cfg.RoleARN != "" {
+		if !slices.Contains(AllowedS3RoleARNs, cfg.RoleARN) {
+			return nil, fmt.Errorf("roleArn %q is not allowed by the operator's --s3-allowed-role-arns", cfg.RoleARN)
+		}
+		tokenFile := os.Getenv(EnvWebIdentityTokenFile)
+		if tokenFile == "" {
+			return nil, fmt.Errorf("roleArn %q requires %s to be set on the operator", cfg.RoleARN, EnvWebIdentityTokenFile)
+		}
+
+		provider := stscreds.NewWebIdentityRoleProvider(stsClient, cfg.RoleARN,
+			stscreds.IdentityTokenFile(tokenFile),
+			func(o *stscreds.WebIdentityRoleOptions) {
+				o.RoleSessionName = S3RoleSessionName
+			})
+		return aws.NewCredentialsCache(provider), nil
+	}
+
+	if cfg.Anonymous {
+		return aws.AnonymousCredentials{}, nil
+	}
+	return defaultCreds, nil
+}
+
 // Download fetches the file from S3.
 func (u *S3Uploader) Download(ctx context.Context) (io.ReadCloser, error) {
 	output, err := u.s3Client.GetObject(ctx, &s3.GetObjectInput{
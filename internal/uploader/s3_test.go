@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package uploader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+const testRoleARN = "arn:aws:iam::123456789012:role/pages-artifacts"
+
+// mockSTS records AssumeRoleWithWebIdentity calls and returns fixed credentials.
+type mockSTS struct {
+	calls []*sts.AssumeRoleWithWebIdentityInput
+}
+
+func (m *mockSTS) AssumeRoleWithWebIdentity(
+	_ context.Context,
+	params *sts.AssumeRoleWithWebIdentityInput,
+	_ ...func(*sts.Options),
+) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+	m.calls = append(m.calls, params)
+	return &sts.AssumeRoleWithWebIdentityOutput{
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String("ASIA-ROLE"),
+			SecretAccessKey: aws.String("role-secret"),
+			SessionToken:    aws.String("role-session"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
+
+func writeTokenFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("web-identity-token"), 0o600))
+	return path
+}
+
+// allowRole allows testRoleARN and sets the operator's web identity token for a test.
+func allowRole(t *testing.T) {
+	t.Helper()
+	previous := AllowedS3RoleARNs
+	AllowedS3RoleARNs = []string{testRoleARN}
+	t.Cleanup(func() { AllowedS3RoleARNs = previous })
+	t.Setenv(EnvWebIdentityTokenFile, writeTokenFile(t))
+}
+
+func TestResolveS3Credentials_WebIdentityRole(t *testing.T) {
+	ctx := context.Background()
+	allowRole(t)
+	stsClient := &mockSTS{}
+	cfg := &v1alpha2.S3Source{Bucket: "artifacts", Key: "site.tar.gz", RoleARN: testRoleARN}
+
+	provider, err := resolveS3Credentials(ctx, fake.NewClientBuilder().Build(), "default", cfg, stsClient, nil)
+	require.NoError(t, err)
+	require.NotNil(t, provider)
+
+	creds, err := provider.Retrieve(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ASIA-ROLE", creds.AccessKeyID)
+	assert.Equal(t, "role-session", creds.SessionToken)
+
+	require.Len(t, stsClient.calls, 1)
+	assert.Equal(t, testRoleARN, aws.ToString(stsClient.calls[0].RoleArn))
+	assert.Equal(t, "web-identity-token", aws.ToString(stsClient.calls[0].WebIdentityToken))
+	assert.Equal(t, S3RoleSessionName, aws.ToString(stsClient.calls[0].RoleSessionName))
+}
+
+func TestResolveS3Credentials_RoleNotAllowed(t *testing.T) {
+	allowRole(t)
+	stsClient := &mockSTS{}
+
+	_, err := resolveS3Credentials(context.Background(), fake.NewClientBuilder().Build(), "default",
+		&v1alpha2.S3Source{Bucket: "artifacts", Key: "site.tar.gz", RoleARN: "arn:aws:iam::123456789012:role/admin"}, stsClient, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not allowed")
+	assert.Empty(t, stsClient.calls)
+}
+
+func TestResolveS3Credentials_MissingTokenFile(t *testing.T) {
+	allowRole(t)
+	t.Setenv(EnvWebIdentityTokenFile, "")
+
+	_, err := resolveS3Credentials(context.Background(), fake.NewClientBuilder().Build(), "default",
+		&v1alpha2.S3Source{Bucket: "artifacts", Key: "site.tar.gz", RoleARN: testRoleARN}, &mockSTS{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires "+EnvWebIdentityTokenFile)
+}
+
+func TestResolveS3Credentials_SecretTakesPrecedence(t *testing.T) {
+	ctx := context.Background()
+	allowRole(t)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-creds", Namespace: "default"},
+		Data: map[string][]byte{
+			"accessKeyId":     []byte("AKIA-STATIC"),
+			"secretAccessKey": []byte("static-secret"),
+		},
+	}
+	stsClient := &mockSTS{}
+	cfg := &v1alpha2.S3Source{
+		Bucket:               "artifacts",
+		Key:                  "site.tar.gz",
+		CredentialsSecretRef: &corev1.LocalObjectReference{Name: "aws-creds"},
+		RoleARN:              testRoleARN,
+	}
+
+	provider, err := resolveS3Credentials(ctx, fake.NewClientBuilder().WithObjects(secret).Build(), "default", cfg, stsClient, nil)
+	require.NoError(t, err)
+	creds, err := provider.Retrieve(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "AKIA-STATIC", creds.AccessKeyID)
+	assert.Empty(t, stsClient.calls)
+}
+
+func TestResolveS3Credentials_DefaultChainWithoutCredentials(t *testing.T) {
+	defaultCreds := credentials.NewStaticCredentialsProvider("AKIA-DEFAULT", "default-secret", "")
+
+	provider, err := resolveS3Credentials(context.Background(), fake.NewClientBuilder().Build(), "default",
+		&v1alpha2.S3Source{Bucket: "artifacts", Key: "site.tar.gz"}, &mockSTS{}, defaultCreds)
+	require.NoError(t, err)
+	assert.Equal(t, defaultCreds, provider)
+}
+
+func TestResolveS3Credentials_AnonymousOptIn(t *testing.T) {
+	defaultCreds := credentials.NewStaticCredentialsProvider("AKIA-DEFAULT", "default-secret", "")
+
+	provider, err := resolveS3Credentials(context.Background(), fake.NewClientBuilder().Build(), "default",
+		&v1alpha2.S3Source{Bucket: "artifacts", Key: "site.tar.gz", Anonymous: true}, &mockSTS{}, defaultCreds)
+	require.NoError(t, err)
+	assert.Equal(t, aws.AnonymousCredentials{}, provider)
+}
@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -60,6 +61,12 @@ type FileManifest struct {
 
 	// SourceURL is the URL where the source was fetched from (if applicable).
 	SourceURL string
+
+	// ChecksumAlgorithm is the algorithm of the verified checksum (empty if none configured).
+	ChecksumAlgorithm string
+
+	// Checksum is the verified checksum value (empty if none configured).
+	Checksum string
 }
 
 // NewUploader creates an Uploader from DirectUploadSource configuration.
@@ -135,6 +142,10 @@ func ProcessSource(
 	// 7. Set source metadata
 	manifest.SourceHash = sourceHash
 	manifest.SourceURL = getSourceURL(source)
+	if checksum != nil && checksum.Value != "" {
+		manifest.ChecksumAlgorithm = checksumAlgorithm(checksum)
+		manifest.Checksum = strings.ToLower(checksum.Value)
+	}
 
 	return manifest, nil
 }
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package v1alpha2
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	networkingv1alpha2 "github.com/StringKe/cloudflare-operator/api/v1alpha2"
+)
+
+// SetupRedirectRuleWebhookWithManager registers the webhook for RedirectRule in the manager.
+func SetupRedirectRuleWebhookWithManager(mgr ctrl.Manager) error {
+	return (&networkingv1alpha2.RedirectRule{}).SetupWebhookWithManager(mgr)
+}
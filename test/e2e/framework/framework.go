@@ -355,6 +355,8 @@ func getConditions(obj client.Object) []metav1.Condition {
 		return typed.Status.Conditions
 	case *v1alpha2.AccessServiceToken:
 		return typed.Status.Conditions
+	case *v1alpha2.AccessCustomPage:
+		return typed.Status.Conditions
 	// Device Layer
 	case *v1alpha2.DevicePostureRule:
 		return typed.Status.Conditions
@@ -374,6 +376,14 @@ func getConditions(obj client.Object) []metav1.Condition {
 		return typed.Status.Conditions
 	case *v1alpha2.R2BucketNotification:
 		return typed.Status.Conditions
+	case *v1alpha2.Queue:
+		return typed.Status.Conditions
+	case *v1alpha2.D1Database:
+		return typed.Status.Conditions
+	case *v1alpha2.KVNamespace:
+		return typed.Status.Conditions
+	case *v1alpha2.HyperdriveConfig:
+		return typed.Status.Conditions
 	// Rules
 	case *v1alpha2.ZoneRuleset:
 		return typed.Status.Conditions
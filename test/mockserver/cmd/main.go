@@ -39,6 +39,7 @@ func main() {
 	log.Printf("Starting mock Cloudflare API server on port %d", *port)
 	log.Printf("Health check: http://localhost:%d/health", *port)
 	log.Printf("Admin reset: POST http://localhost:%d/admin/reset", *port)
+	log.Printf("Admin faults: POST http://localhost:%d/admin/fault, POST http://localhost:%d/admin/clear-faults", *port, *port)
 
 	if err := server.Start(); err != nil {
 		log.Fatalf("Server error: %v", err)
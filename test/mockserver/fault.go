@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package mockserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/handlers"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/injection"
+)
+
+// FaultRequest is the payload of POST /admin/fault. It configures a fault for
+// the API requests matching Path and Method:
+//
+//	{"path": "/dns_records$", "method": "POST", "status": 503, "latency": "200ms", "count": 2}
+//
+// Faults are checked in the order they were added; the first active match applies.
+type FaultRequest struct {
+	// Path is a regular expression matched against the request path.
+	Path string `json:"path"`
+	// Method is a regular expression matched against the HTTP method. Empty matches all methods.
+	Method string `json:"method,omitempty"`
+	// Status is the HTTP status code (400-599) to respond with. Zero serves the
+	// request normally after Latency.
+	Status int `json:"status,omitempty"`
+	// Latency delays matching requests, as a Go duration such as "250ms".
+	Latency string `json:"latency,omitempty"`
+	// Count is the number of matching requests the fault applies to. Zero applies it to every request.
+	Count int `json:"count,omitempty"`
+	// RetryAfter sets the Retry-After header of the response, in seconds.
+	RetryAfter int `json:"retryAfter,omitempty"`
+}
+
+// FaultResponse is the result of POST /admin/fault and POST /admin/clear-faults.
+type FaultResponse struct {
+	// Faults is the number of configured faults.
+	Faults int `json:"faults"`
+}
+
+// toInjection validates the request and converts it to an error injection.
+func (f *FaultRequest) toInjection() (injection.ErrorInjection, error) {
+	if f.Path == "" {
+		return injection.ErrorInjection{}, errors.New("path is required")
+	}
+	if f.Status != 0 && (f.Status < 400 || f.Status > 599) {
+		return injection.ErrorInjection{}, fmt.Errorf("status %d is not an error status (400-599)", f.Status)
+	}
+	if f.Count < 0 {
+		return injection.ErrorInjection{}, fmt.Errorf("count %d must not be negative", f.Count)
+	}
+	var latency time.Duration
+	if f.Latency != "" {
+		var err error
+		if latency, err = time.ParseDuration(f.Latency); err != nil || latency < 0 {
+			return injection.ErrorInjection{}, fmt.Errorf("invalid latency %q", f.Latency)
+		}
+	}
+	if f.Status == 0 && latency == 0 {
+		return injection.ErrorInjection{}, errors.New("status or latency is required")
+	}
+
+	inj := injection.ErrorInjection{
+		PathPattern:   f.Path,
+		MethodPattern: f.Method,
+		ErrorType:     injection.ErrorTypeStatus,
+		TriggerMode:   injection.TriggerModeAlways,
+		StatusCode:    f.Status,
+		Latency:       latency,
+		RetryAfter:    f.RetryAfter,
+	}
+	if f.Status == 0 {
+		inj.ErrorType = injection.ErrorTypeLatency
+	}
+	if f.Count > 0 {
+		inj.TriggerMode = injection.TriggerModeCount
+		inj.CountLimit = f.Count
+	}
+	return inj, nil
+}
+
+// handleAddFault adds a fault from a FaultRequest.
+func (s *Server) handleAddFault(w http.ResponseWriter, r *http.Request) {
+	req, err := handlers.ReadJSON[FaultRequest](r)
+	if err != nil {
+		handlers.BadRequest(w, "Invalid request body: "+err.Error())
+		return
+	}
+	inj, err := req.toInjection()
+	if err != nil {
+		handlers.BadRequest(w, err.Error())
+		return
+	}
+	if err := s.errorInjector.Add(inj); err != nil {
+		handlers.BadRequest(w, "Invalid pattern: "+err.Error())
+		return
+	}
+	writeSuccess(w, FaultResponse{Faults: s.errorInjector.Count()})
+}
+
+// handleClearFaults removes all faults.
+func (s *Server) handleClearFaults(w http.ResponseWriter, _ *http.Request) {
+	s.errorInjector.Clear()
+	writeSuccess(w, FaultResponse{})
+}
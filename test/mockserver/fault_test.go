@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const zonesPath = apiPrefix + "/zones"
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	_, server := newMockAndServer(t)
+	return server
+}
+
+func newMockAndServer(t *testing.T) (*Server, *httptest.Server) {
+	t.Helper()
+	mock := NewServer()
+	server := httptest.NewServer(mock.Handler())
+	t.Cleanup(server.Close)
+	return mock, server
+}
+
+func post(t *testing.T, server *httptest.Server, path, body string) *http.Response {
+	t.Helper()
+	resp, err := http.Post(server.URL+path, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func addFault(t *testing.T, server *httptest.Server, body string) {
+	t.Helper()
+	resp := post(t, server, "/admin/fault", body)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func get(t *testing.T, server *httptest.Server, path string) *http.Response {
+	t.Helper()
+	resp, err := http.Get(server.URL + path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestFault_StatusWithCount(t *testing.T) {
+	server := newTestServer(t)
+	addFault(t, server, `{"path": "/zones$", "status": 503, "count": 2}`)
+
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, server, zonesPath).StatusCode)
+	assert.Equal(t, http.StatusServiceUnavailable, get(t, server, zonesPath).StatusCode)
+	assert.Equal(t, http.StatusOK, get(t, server, zonesPath).StatusCode)
+}
+
+func TestFault_ErrorBody(t *testing.T) {
+	server := newTestServer(t)
+	addFault(t, server, `{"path": "/zones$", "status": 429, "retryAfter": 2}`)
+
+	resp := get(t, server, zonesPath)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+	var body struct {
+		Success bool `json:"success"`
+		Errors  []struct {
+			Code int `json:"code"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.False(t, body.Success)
+	assert.Len(t, body.Errors, 1)
+}
+
+func TestFault_Latency(t *testing.T) {
+	server := newTestServer(t)
+	addFault(t, server, `{"path": "/zones$", "latency": "100ms"}`)
+
+	start := time.Now()
+	resp := get(t, server, zonesPath)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestFault_MethodFilter(t *testing.T) {
+	server := newTestServer(t)
+	addFault(t, server, `{"path": "/zones$", "method": "POST", "status": 500}`)
+
+	assert.Equal(t, http.StatusOK, get(t, server, zonesPath).StatusCode)
+}
+
+func TestFault_ClearFaults(t *testing.T) {
+	server := newTestServer(t)
+	addFault(t, server, `{"path": ".*", "status": 500}`)
+	require.Equal(t, http.StatusInternalServerError, get(t, server, zonesPath).StatusCode)
+
+	// Admin endpoints are never faulted, so the fault can be cleared
+	resp := post(t, server, "/admin/clear-faults", "")
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, http.StatusOK, get(t, server, zonesPath).StatusCode)
+}
+
+func TestFault_InvalidRequests(t *testing.T) {
+	server := newTestServer(t)
+
+	for _, body := range []string{
+		`not json`,
+		`{"status": 500}`,
+		`{"path": "/zones$"}`,
+		`{"path": "/zones$", "status": 200}`,
+		`{"path": "/zones$", "status": 500, "count": -1}`,
+		`{"path": "/zones$", "latency": "soon"}`,
+		`{"path": "(", "status": 500}`,
+	} {
+		t.Run(body, func(t *testing.T) {
+			resp := post(t, server, "/admin/fault", body)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+	assert.Equal(t, http.StatusOK, get(t, server, zonesPath).StatusCode)
+}
@@ -4,7 +4,12 @@
 package handlers
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
@@ -30,6 +35,8 @@ type AccessApplicationCreateRequest struct {
 	AllowedIdps             []string                    `json:"allowed_idps"`
 	SelfHostedDomains       []string                    `json:"self_hosted_domains"`
 	Destinations            []models.AccessDestination  `json:"destinations"`
+	CustomPages             []string                    `json:"custom_pages"`
+	Tags                    []string                    `json:"tags"`
 	Policies                []AccessPolicyCreateRequest `json:"policies"`
 }
 
@@ -47,6 +54,7 @@ func (h *Handlers) CreateAccessApplication(w http.ResponseWriter, r *http.Reques
 		Name:                    req.Name,
 		Domain:                  req.Domain,
 		Type:                    req.Type,
+		AUD:                     GenerateID(),
 		SessionDuration:         req.SessionDuration,
 		AutoRedirectToIdentity:  req.AutoRedirectToIdentity,
 		EnableBindingCookie:     req.EnableBindingCookie,
@@ -60,6 +68,8 @@ func (h *Handlers) CreateAccessApplication(w http.ResponseWriter, r *http.Reques
 		AllowedIdps:             req.AllowedIdps,
 		SelfHostedDomains:       req.SelfHostedDomains,
 		Destinations:            req.Destinations,
+		CustomPages:             req.CustomPages,
+		Tags:                    req.Tags,
 		CreatedAt:               now,
 		UpdatedAt:               now,
 	}
@@ -68,8 +78,12 @@ func (h *Handlers) CreateAccessApplication(w http.ResponseWriter, r *http.Reques
 
 	// Create policies if provided
 	for _, policyReq := range req.Policies {
+		id := policyReq.ID
+		if id == "" {
+			id = GenerateID()
+		}
 		policy := &models.AccessPolicy{
-			ID:         GenerateID(),
+			ID:         id,
 			Name:       policyReq.Name,
 			Precedence: policyReq.Precedence,
 			Decision:   policyReq.Decision,
@@ -159,6 +173,12 @@ func (h *Handlers) UpdateAccessApplication(w http.ResponseWriter, r *http.Reques
 		if req.Destinations != nil {
 			app.Destinations = req.Destinations
 		}
+		if req.CustomPages != nil {
+			app.CustomPages = req.CustomPages
+		}
+		if req.Tags != nil {
+			app.Tags = req.Tags
+		}
 	}) {
 		NotFound(w, "access application")
 		return
@@ -182,19 +202,39 @@ func (h *Handlers) DeleteAccessApplication(w http.ResponseWriter, r *http.Reques
 
 // AccessPolicyCreateRequest represents an access policy creation request.
 type AccessPolicyCreateRequest struct {
+	// ID is set when an application references a reusable policy.
+	ID         string              `json:"id"`
 	Name       string              `json:"name"`
 	Precedence int                 `json:"precedence"`
 	Decision   string              `json:"decision"`
 	Include    []models.AccessRule `json:"include"`
 	Exclude    []models.AccessRule `json:"exclude"`
 	Require    []models.AccessRule `json:"require"`
+	// SessionDuration is set for reusable policies.
+	SessionDuration string `json:"session_duration"`
 }
 
-// CreateAccessPolicy handles POST /accounts/{accountId}/access/apps/{appId}/policies.
+// UnmarshalJSON accepts a policy object or, like the Cloudflare API, the ID of
+// a reusable policy.
+func (p *AccessPolicyCreateRequest) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*p = AccessPolicyCreateRequest{ID: id}
+		return nil
+	}
+	type plain AccessPolicyCreateRequest
+	return json.Unmarshal(data, (*plain)(p))
+}
+
+// defaultPolicySessionDuration is the session duration Cloudflare assigns to a policy without one.
+const defaultPolicySessionDuration = "24h"
+
+// CreateAccessPolicy handles POST /accounts/{accountId}/access/apps/{appId}/policies
+// and POST /accounts/{accountId}/access/policies for reusable policies.
 func (h *Handlers) CreateAccessPolicy(w http.ResponseWriter, r *http.Request) {
 	appID := GetPathParam(r, "appId")
 
-	if _, ok := h.store.GetAccessApplication(appID); !ok {
+	if _, ok := h.store.GetAccessApplication(appID); appID != "" && !ok {
 		NotFound(w, "access application")
 		return
 	}
@@ -217,6 +257,12 @@ func (h *Handlers) CreateAccessPolicy(w http.ResponseWriter, r *http.Request) {
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
+	if appID == "" {
+		policy.SessionDuration = req.SessionDuration
+		if policy.SessionDuration == "" {
+			policy.SessionDuration = defaultPolicySessionDuration
+		}
+	}
 
 	h.store.CreateAccessPolicy(appID, policy)
 	Created(w, policy)
@@ -271,6 +317,9 @@ func (h *Handlers) UpdateAccessPolicy(w http.ResponseWriter, r *http.Request) {
 		if req.Require != nil {
 			policy.Require = req.Require
 		}
+		if req.SessionDuration != "" {
+			policy.SessionDuration = req.SessionDuration
+		}
 	}) {
 		NotFound(w, "access policy")
 		return
@@ -336,8 +385,7 @@ func (h *Handlers) ListAccessGroups(w http.ResponseWriter, r *http.Request) {
 		Success(w, []*models.AccessGroup{group})
 		return
 	}
-	groups := h.store.ListAccessGroups()
-	Success(w, groups)
+	WritePage(w, r, h.store.ListAccessGroups())
 }
 
 // GetAccessGroup handles GET /accounts/{accountId}/access/groups/{groupId}.
@@ -439,8 +487,7 @@ func (h *Handlers) ListAccessServiceTokens(w http.ResponseWriter, r *http.Reques
 		Success(w, []*models.AccessServiceToken{token})
 		return
 	}
-	// Would return all tokens but omit secrets
-	Success(w, []*models.AccessServiceToken{})
+	WritePage(w, r, h.store.ListAccessServiceTokens())
 }
 
 // GetAccessServiceToken handles GET /accounts/{accountId}/access/service_tokens/{tokenId}.
@@ -513,9 +560,43 @@ func (h *Handlers) DeleteAccessServiceToken(w http.ResponseWriter, r *http.Reque
 
 // AccessIdentityProviderCreateRequest represents an IdP creation request.
 type AccessIdentityProviderCreateRequest struct {
-	Name   string                 `json:"name"`
-	Type   string                 `json:"type"`
-	Config map[string]interface{} `json:"config"`
+	Name       string                                   `json:"name"`
+	Type       string                                   `json:"type"`
+	Config     map[string]interface{}                   `json:"config"`
+	ScimConfig *models.AccessIdentityProviderScimConfig `json:"scim_config"`
+}
+
+// applyScimConfig applies the requested SCIM configuration. Like Cloudflare, the
+// secret and endpoint are generated when SCIM is first enabled and kept afterwards.
+func applyScimConfig(idp *models.AccessIdentityProvider, requested *models.AccessIdentityProviderScimConfig) {
+	if requested == nil {
+		return
+	}
+	scim := *requested
+	scim.Secret = ""
+	scim.ScimBaseURL = ""
+	if idp.ScimConfig != nil {
+		scim.Secret = idp.ScimConfig.Secret
+		scim.ScimBaseURL = idp.ScimConfig.ScimBaseURL
+	}
+	if scim.Enabled && scim.Secret == "" {
+		scim.Secret = GenerateToken(32)
+		scim.ScimBaseURL = fmt.Sprintf("https://test.cloudflareaccess.com/populi/user/%s/scim/v2", idp.ID)
+	}
+	idp.ScimConfig = &scim
+}
+
+// redactScimSecret returns a copy of idp whose SCIM secret is redacted, as
+// Cloudflare only returns the secret when it is generated.
+func redactScimSecret(idp *models.AccessIdentityProvider) *models.AccessIdentityProvider {
+	if idp.ScimConfig == nil || idp.ScimConfig.Secret == "" {
+		return idp
+	}
+	redacted := *idp
+	scim := *idp.ScimConfig
+	scim.Secret = "*****"
+	redacted.ScimConfig = &scim
+	return &redacted
 }
 
 // CreateAccessIdentityProvider handles POST /accounts/{accountId}/access/identity_providers.
@@ -535,6 +616,7 @@ func (h *Handlers) CreateAccessIdentityProvider(w http.ResponseWriter, r *http.R
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
+	applyScimConfig(idp, req.ScimConfig)
 
 	h.store.CreateAccessIdentityProvider(idp)
 	Created(w, idp)
@@ -552,8 +634,7 @@ func (h *Handlers) ListAccessIdentityProviders(w http.ResponseWriter, r *http.Re
 		Success(w, []*models.AccessIdentityProvider{idp})
 		return
 	}
-	// Would return all IdPs
-	Success(w, []*models.AccessIdentityProvider{})
+	WritePage(w, r, h.store.ListAccessIdentityProviders())
 }
 
 // GetAccessIdentityProvider handles GET /accounts/{accountId}/access/identity_providers/{idpId}.
@@ -564,7 +645,7 @@ func (h *Handlers) GetAccessIdentityProvider(w http.ResponseWriter, r *http.Requ
 		NotFound(w, "access identity provider")
 		return
 	}
-	Success(w, idp)
+	Success(w, redactScimSecret(idp))
 }
 
 // UpdateAccessIdentityProvider handles PUT /accounts/{accountId}/access/identity_providers/{idpId}.
@@ -587,10 +668,34 @@ func (h *Handlers) UpdateAccessIdentityProvider(w http.ResponseWriter, r *http.R
 		if req.Config != nil {
 			idp.Config = req.Config
 		}
+		applyScimConfig(idp, req.ScimConfig)
+	}) {
+		NotFound(w, "access identity provider")
+		return
+	}
+
+	idp, _ := h.store.GetAccessIdentityProvider(idpID)
+	Success(w, redactScimSecret(idp))
+}
+
+// RefreshAccessIdentityProviderScimSecret handles
+// POST /accounts/{accountId}/access/identity_providers/{idpId}/refresh_scim_secret.
+func (h *Handlers) RefreshAccessIdentityProviderScimSecret(w http.ResponseWriter, r *http.Request) {
+	idpID := GetPathParam(r, "idpId")
+	enabled := false
+	if !h.store.UpdateAccessIdentityProvider(idpID, func(idp *models.AccessIdentityProvider) {
+		if idp.ScimConfig != nil && idp.ScimConfig.Enabled {
+			enabled = true
+			idp.ScimConfig.Secret = GenerateToken(32)
+		}
 	}) {
 		NotFound(w, "access identity provider")
 		return
 	}
+	if !enabled {
+		BadRequest(w, "SCIM is not enabled for this identity provider")
+		return
+	}
 
 	idp, _ := h.store.GetAccessIdentityProvider(idpID)
 	Success(w, idp)
@@ -605,3 +710,234 @@ func (h *Handlers) DeleteAccessIdentityProvider(w http.ResponseWriter, r *http.R
 	}
 	Success(w, struct{}{})
 }
+
+// ---- Access mTLS Certificate Handlers ----
+
+// AccessCertificateRequest represents an Access mTLS certificate create or update request.
+type AccessCertificateRequest struct {
+	Name                string    `json:"name"`
+	Certificate         string    `json:"certificate"`
+	AssociatedHostnames *[]string `json:"associated_hostnames"`
+}
+
+// CreateAccessCertificate handles POST /accounts/{accountId}/access/certificates.
+func (h *Handlers) CreateAccessCertificate(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[AccessCertificateRequest](r)
+	if err != nil || req.Certificate == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	now := time.Now()
+	sum := sha256.Sum256([]byte(req.Certificate))
+	cert := &models.AccessMutualTLSCertificate{
+		ID:                  GenerateID(),
+		Name:                req.Name,
+		Fingerprint:         hex.EncodeToString(sum[:]),
+		AssociatedHostnames: []string{},
+		ExpiresOn:           now.AddDate(1, 0, 0),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	if req.AssociatedHostnames != nil {
+		cert.AssociatedHostnames = *req.AssociatedHostnames
+	}
+	h.store.CreateAccessCertificate(cert)
+	Created(w, cert)
+}
+
+// ListAccessCertificates handles GET /accounts/{accountId}/access/certificates.
+func (h *Handlers) ListAccessCertificates(w http.ResponseWriter, _ *http.Request) {
+	Success(w, h.store.ListAccessCertificates())
+}
+
+// GetAccessCertificate handles GET /accounts/{accountId}/access/certificates/{certificateId}.
+func (h *Handlers) GetAccessCertificate(w http.ResponseWriter, r *http.Request) {
+	cert, ok := h.store.GetAccessCertificate(GetPathParam(r, "certificateId"))
+	if !ok {
+		NotFound(w, "access certificate")
+		return
+	}
+	Success(w, cert)
+}
+
+// UpdateAccessCertificate handles PUT /accounts/{accountId}/access/certificates/{certificateId}.
+func (h *Handlers) UpdateAccessCertificate(w http.ResponseWriter, r *http.Request) {
+	certID := GetPathParam(r, "certificateId")
+	req, err := ReadJSON[AccessCertificateRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	if !h.store.UpdateAccessCertificate(certID, func(cert *models.AccessMutualTLSCertificate) {
+		if req.Name != "" {
+			cert.Name = req.Name
+		}
+		if req.AssociatedHostnames != nil {
+			cert.AssociatedHostnames = *req.AssociatedHostnames
+		}
+	}) {
+		NotFound(w, "access certificate")
+		return
+	}
+
+	cert, _ := h.store.GetAccessCertificate(certID)
+	Success(w, cert)
+}
+
+// DeleteAccessCertificate handles DELETE /accounts/{accountId}/access/certificates/{certificateId}.
+// Like Cloudflare, it rejects deleting a certificate that still has associated hostnames.
+func (h *Handlers) DeleteAccessCertificate(w http.ResponseWriter, r *http.Request) {
+	certID := GetPathParam(r, "certificateId")
+	cert, ok := h.store.GetAccessCertificate(certID)
+	if !ok {
+		NotFound(w, "access certificate")
+		return
+	}
+	if len(cert.AssociatedHostnames) > 0 {
+		BadRequest(w, "certificate has associated hostnames")
+		return
+	}
+	h.store.DeleteAccessCertificate(certID)
+	Success(w, struct{}{})
+}
+
+// ---- Access Custom Page Handlers ----
+
+// AccessCustomPageRequest represents an Access custom page create or update request.
+type AccessCustomPageRequest struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	CustomHTML string `json:"custom_html"`
+}
+
+// CreateAccessCustomPage handles POST /accounts/{accountId}/access/custom_pages.
+func (h *Handlers) CreateAccessCustomPage(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[AccessCustomPageRequest](r)
+	if err != nil || req.Name == "" || req.Type == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	now := time.Now()
+	page := &models.AccessCustomPage{
+		UID:        GenerateID(),
+		Name:       req.Name,
+		Type:       req.Type,
+		CustomHTML: req.CustomHTML,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	h.store.CreateAccessCustomPage(page)
+	Created(w, page)
+}
+
+// ListAccessCustomPages handles GET /accounts/{accountId}/access/custom_pages.
+func (h *Handlers) ListAccessCustomPages(w http.ResponseWriter, _ *http.Request) {
+	Success(w, h.store.ListAccessCustomPages())
+}
+
+// GetAccessCustomPage handles GET /accounts/{accountId}/access/custom_pages/{pageId}.
+func (h *Handlers) GetAccessCustomPage(w http.ResponseWriter, r *http.Request) {
+	page, ok := h.store.GetAccessCustomPage(GetPathParam(r, "pageId"))
+	if !ok {
+		NotFound(w, "access custom page")
+		return
+	}
+	Success(w, page)
+}
+
+// UpdateAccessCustomPage handles PUT /accounts/{accountId}/access/custom_pages/{pageId}.
+func (h *Handlers) UpdateAccessCustomPage(w http.ResponseWriter, r *http.Request) {
+	pageID := GetPathParam(r, "pageId")
+	req, err := ReadJSON[AccessCustomPageRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	if !h.store.UpdateAccessCustomPage(pageID, func(page *models.AccessCustomPage) {
+		if req.Name != "" {
+			page.Name = req.Name
+		}
+		if req.Type != "" {
+			page.Type = req.Type
+		}
+		page.CustomHTML = req.CustomHTML
+	}) {
+		NotFound(w, "access custom page")
+		return
+	}
+
+	page, _ := h.store.GetAccessCustomPage(pageID)
+	Success(w, page)
+}
+
+// DeleteAccessCustomPage handles DELETE /accounts/{accountId}/access/custom_pages/{pageId}.
+func (h *Handlers) DeleteAccessCustomPage(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteAccessCustomPage(GetPathParam(r, "pageId")) {
+		NotFound(w, "access custom page")
+		return
+	}
+	Success(w, struct{}{})
+}
+
+// ---- Access Tag Handlers ----
+
+// AccessTagRequest represents an Access tag create request.
+type AccessTagRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateAccessTag handles POST /accounts/{accountId}/access/tags.
+func (h *Handlers) CreateAccessTag(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[AccessTagRequest](r)
+	if err != nil || req.Name == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	if _, exists := h.store.GetAccessTag(req.Name); exists {
+		Conflict(w, "access tag already exists")
+		return
+	}
+
+	now := time.Now()
+	tag := &models.AccessTag{
+		Name:      req.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	h.store.CreateAccessTag(tag)
+	Created(w, tag)
+}
+
+// ListAccessTags handles GET /accounts/{accountId}/access/tags.
+func (h *Handlers) ListAccessTags(w http.ResponseWriter, r *http.Request) {
+	WritePage(w, r, h.store.ListAccessTags())
+}
+
+// GetAccessTag handles GET /accounts/{accountId}/access/tags/{tagName}.
+func (h *Handlers) GetAccessTag(w http.ResponseWriter, r *http.Request) {
+	tag, ok := h.store.GetAccessTag(GetPathParam(r, "tagName"))
+	if !ok {
+		NotFound(w, "access tag")
+		return
+	}
+	Success(w, tag)
+}
+
+// DeleteAccessTag handles DELETE /accounts/{accountId}/access/tags/{tagName}.
+func (h *Handlers) DeleteAccessTag(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteAccessTag(GetPathParam(r, "tagName")) {
+		NotFound(w, "access tag")
+		return
+	}
+	Success(w, struct{}{})
+}
+
+// ListSCIMUpdateLogs handles GET /accounts/{accountId}/access/logs/scim/updates.
+func (h *Handlers) ListSCIMUpdateLogs(w http.ResponseWriter, r *http.Request) {
+	limit, _ := strconv.Atoi(GetQueryParam(r, "limit"))
+	Success(w, h.store.ListSCIMUpdateLogs(GetQueryParam(r, "idp_id"), limit))
+}
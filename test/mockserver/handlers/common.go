@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
 )
@@ -41,6 +42,31 @@ func ResponseWithResultInfo[T any](w http.ResponseWriter, status int, result T,
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// defaultPageSize is the page size of paginated lists when per_page is not given.
+const defaultPageSize = 25
+
+// WritePage writes the page of items selected by the page and per_page query
+// parameters, with pagination info, like the Cloudflare list endpoints.
+func WritePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	page, err := strconv.Atoi(GetQueryParam(r, "page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(GetQueryParam(r, "per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPageSize
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	ResponseWithResultInfo(w, http.StatusOK, items[start:end], &models.ResultInfo{
+		Page:       page,
+		PerPage:    perPage,
+		Count:      end - start,
+		TotalCount: len(items),
+	})
+}
+
 // Success writes a successful response.
 func Success[T any](w http.ResponseWriter, result T) {
 	Response(w, http.StatusOK, result, nil)
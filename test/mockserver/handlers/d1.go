@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// D1DatabaseCreateRequest represents a D1 database creation request.
+type D1DatabaseCreateRequest struct {
+	Name                string `json:"name"`
+	PrimaryLocationHint string `json:"primary_location_hint,omitempty"`
+}
+
+// CreateD1Database handles POST /accounts/{accountId}/d1/database.
+func (h *Handlers) CreateD1Database(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[D1DatabaseCreateRequest](r)
+	if err != nil || req.Name == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	for _, existing := range h.store.ListD1Databases(req.Name) {
+		if existing.Name == req.Name {
+			Conflict(w, "database already exists")
+			return
+		}
+	}
+
+	db := &models.D1Database{
+		UUID:                GenerateID(),
+		Name:                req.Name,
+		Version:             "production",
+		CreatedAt:           time.Now(),
+		PrimaryLocationHint: req.PrimaryLocationHint,
+	}
+	h.store.CreateD1Database(db)
+	Success(w, db)
+}
+
+// ListD1Databases handles GET /accounts/{accountId}/d1/database.
+// The name query parameter filters databases whose names contain it.
+func (h *Handlers) ListD1Databases(w http.ResponseWriter, r *http.Request) {
+	databases := h.store.ListD1Databases(GetQueryParam(r, "name"))
+	ResponseWithResultInfo(w, http.StatusOK, databases, &models.ResultInfo{
+		Page:       1,
+		PerPage:    len(databases),
+		Count:      len(databases),
+		TotalCount: len(databases),
+	})
+}
+
+// GetD1Database handles GET /accounts/{accountId}/d1/database/{databaseId}.
+func (h *Handlers) GetD1Database(w http.ResponseWriter, r *http.Request) {
+	db, ok := h.store.GetD1Database(GetPathParam(r, "databaseId"))
+	if !ok {
+		NotFound(w, "database")
+		return
+	}
+	Success(w, db)
+}
+
+// DeleteD1Database handles DELETE /accounts/{accountId}/d1/database/{databaseId}.
+func (h *Handlers) DeleteD1Database(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteD1Database(GetPathParam(r, "databaseId")) {
+		NotFound(w, "database")
+		return
+	}
+	Success(w, struct{}{})
+}
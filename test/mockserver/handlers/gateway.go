@@ -239,6 +239,131 @@ func (h *Handlers) DeleteGatewayList(w http.ResponseWriter, r *http.Request) {
 	Success(w, struct{}{})
 }
 
+// ---- Gateway Location Handlers ----
+
+// GatewayLocationRequest represents a gateway location create or update request.
+type GatewayLocationRequest struct {
+	Name                string                          `json:"name"`
+	Networks            []models.GatewayLocationNetwork `json:"networks"`
+	ClientDefault       bool                            `json:"client_default"`
+	ECSSupport          *bool                           `json:"ecs_support"`
+	DNSDestinationIPsID *string                         `json:"dns_destination_ips_id"`
+	PolicyIDs           []string                        `json:"policy_ids"`
+	Endpoints           map[string]interface{}          `json:"endpoints"`
+}
+
+// defaultGatewayLocationEndpoints returns the endpoints Cloudflare enables for a new location.
+func defaultGatewayLocationEndpoints() map[string]interface{} {
+	return map[string]interface{}{
+		"ipv4": map[string]interface{}{"enabled": true},
+		"ipv6": map[string]interface{}{"enabled": true},
+		"dot":  map[string]interface{}{"enabled": true, "require_token": false},
+		"doh":  map[string]interface{}{"enabled": true, "require_token": false},
+	}
+}
+
+// CreateGatewayLocation handles POST /accounts/{accountId}/gateway/locations.
+func (h *Handlers) CreateGatewayLocation(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[GatewayLocationRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	now := time.Now()
+	id := GenerateID()
+	location := &models.GatewayLocation{
+		ID:                  id,
+		Name:                req.Name,
+		Networks:            req.Networks,
+		IP:                  "2a06:98c1:54::1",
+		DoHSubdomain:        id[:8],
+		ClientDefault:       req.ClientDefault,
+		ECSSupport:          req.ECSSupport,
+		DNSDestinationIPsID: req.DNSDestinationIPsID,
+		PolicyIDs:           req.PolicyIDs,
+		Endpoints:           req.Endpoints,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	if location.Endpoints == nil {
+		location.Endpoints = defaultGatewayLocationEndpoints()
+	}
+
+	h.store.CreateGatewayLocation(location)
+	Created(w, location)
+}
+
+// ListGatewayLocations handles GET /accounts/{accountId}/gateway/locations.
+func (h *Handlers) ListGatewayLocations(w http.ResponseWriter, _ *http.Request) {
+	Success(w, h.store.ListGatewayLocations())
+}
+
+// GetGatewayLocation handles GET /accounts/{accountId}/gateway/locations/{locationId}.
+func (h *Handlers) GetGatewayLocation(w http.ResponseWriter, r *http.Request) {
+	locationID := GetPathParam(r, "locationId")
+	location, ok := h.store.GetGatewayLocation(locationID)
+	if !ok {
+		NotFound(w, "gateway location")
+		return
+	}
+	Success(w, location)
+}
+
+// UpdateGatewayLocation handles PUT /accounts/{accountId}/gateway/locations/{locationId}.
+// Like Cloudflare, the client default can only be moved to another location, not unset.
+func (h *Handlers) UpdateGatewayLocation(w http.ResponseWriter, r *http.Request) {
+	locationID := GetPathParam(r, "locationId")
+
+	req, err := ReadJSON[GatewayLocationRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	current, ok := h.store.GetGatewayLocation(locationID)
+	if !ok {
+		NotFound(w, "gateway location")
+		return
+	}
+	if current.ClientDefault && !req.ClientDefault {
+		BadRequest(w, "the default location cannot be unset, make another location the default instead")
+		return
+	}
+
+	h.store.UpdateGatewayLocation(locationID, func(location *models.GatewayLocation) {
+		location.Name = req.Name
+		location.Networks = req.Networks
+		location.ClientDefault = req.ClientDefault
+		location.ECSSupport = req.ECSSupport
+		location.DNSDestinationIPsID = req.DNSDestinationIPsID
+		location.PolicyIDs = req.PolicyIDs
+		if req.Endpoints != nil {
+			location.Endpoints = req.Endpoints
+		}
+	})
+
+	location, _ := h.store.GetGatewayLocation(locationID)
+	Success(w, location)
+}
+
+// DeleteGatewayLocation handles DELETE /accounts/{accountId}/gateway/locations/{locationId}.
+// Like Cloudflare, the client default location cannot be deleted.
+func (h *Handlers) DeleteGatewayLocation(w http.ResponseWriter, r *http.Request) {
+	locationID := GetPathParam(r, "locationId")
+	location, ok := h.store.GetGatewayLocation(locationID)
+	if !ok {
+		NotFound(w, "gateway location")
+		return
+	}
+	if location.ClientDefault {
+		BadRequest(w, "the default location cannot be deleted")
+		return
+	}
+	h.store.DeleteGatewayLocation(locationID)
+	Success(w, struct{}{})
+}
+
 // ---- Gateway Configuration Handlers ----
 
 // GetGatewayConfiguration handles GET /accounts/{accountId}/gateway/configuration.
@@ -310,8 +435,7 @@ func (h *Handlers) ListDevicePostureRules(w http.ResponseWriter, r *http.Request
 		Success(w, []*models.DevicePostureRule{rule})
 		return
 	}
-	// Would return all rules
-	Success(w, []*models.DevicePostureRule{})
+	WritePage(w, r, h.store.ListDevicePostureRules())
 }
 
 // GetDevicePostureRule handles GET /accounts/{accountId}/devices/posture/{ruleId}.
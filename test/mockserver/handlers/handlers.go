@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/StringKe/cloudflare-operator/test/mockserver/internal/store"
@@ -153,6 +155,38 @@ func (h *Handlers) GetTunnel(w http.ResponseWriter, r *http.Request) {
 	Success(w, tunnel)
 }
 
+// TunnelUpdateRequest represents a tunnel update request.
+type TunnelUpdateRequest struct {
+	Name   string `json:"name"`
+	Secret string `json:"tunnel_secret"`
+}
+
+// UpdateTunnel handles PATCH /accounts/{accountId}/cfd_tunnel/{tunnelId}.
+func (h *Handlers) UpdateTunnel(w http.ResponseWriter, r *http.Request) {
+	tunnelID := GetPathParam(r, "tunnelId")
+
+	req, err := ReadJSON[TunnelUpdateRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	if !h.store.UpdateTunnel(tunnelID, func(tunnel *models.Tunnel) {
+		if req.Name != "" {
+			tunnel.Name = req.Name
+		}
+		if req.Secret != "" {
+			tunnel.TunnelSecret = req.Secret
+		}
+	}) {
+		NotFound(w, "tunnel")
+		return
+	}
+
+	tunnel, _ := h.store.GetTunnel(tunnelID)
+	Success(w, tunnel)
+}
+
 // DeleteTunnel handles DELETE /accounts/{accountId}/cfd_tunnel/{tunnelId}.
 func (h *Handlers) DeleteTunnel(w http.ResponseWriter, r *http.Request) {
 	tunnelID := GetPathParam(r, "tunnelId")
@@ -272,6 +306,7 @@ func (h *Handlers) CreateVirtualNetwork(w http.ResponseWriter, r *http.Request)
 func (h *Handlers) ListVirtualNetworks(w http.ResponseWriter, r *http.Request) {
 	id := GetQueryParam(r, "id")
 	name := GetQueryParam(r, "name")
+	isDefault := GetQueryParam(r, "is_default")
 
 	if id != "" {
 		vnet, ok := h.store.GetVirtualNetwork(id)
@@ -294,6 +329,15 @@ func (h *Handlers) ListVirtualNetworks(w http.ResponseWriter, r *http.Request) {
 	}
 
 	vnets := h.store.ListVirtualNetworks()
+	if isDefault != "" {
+		filtered := make([]*models.VirtualNetwork, 0, 1)
+		for _, vnet := range vnets {
+			if strconv.FormatBool(vnet.IsDefaultNetwork) == isDefault {
+				filtered = append(filtered, vnet)
+			}
+		}
+		vnets = filtered
+	}
 	Success(w, vnets)
 }
 
@@ -316,6 +360,7 @@ type VirtualNetworkUpdateRequest struct {
 }
 
 // UpdateVirtualNetwork handles PATCH /accounts/{accountId}/teamnet/virtual_networks/{vnetId}.
+// Like Cloudflare, the default virtual network can only be moved to another network, not unset.
 func (h *Handlers) UpdateVirtualNetwork(w http.ResponseWriter, r *http.Request) {
 	vnetID := GetPathParam(r, "vnetId")
 
@@ -325,6 +370,12 @@ func (h *Handlers) UpdateVirtualNetwork(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if current, ok := h.store.GetVirtualNetwork(vnetID); ok &&
+		current.IsDefaultNetwork && req.IsDefault != nil && !*req.IsDefault {
+		BadRequest(w, "the default virtual network cannot be unset, make another virtual network the default instead")
+		return
+	}
+
 	if !h.store.UpdateVirtualNetwork(vnetID, func(vnet *models.VirtualNetwork) {
 		if req.Name != "" {
 			vnet.Name = req.Name
@@ -345,8 +396,13 @@ func (h *Handlers) UpdateVirtualNetwork(w http.ResponseWriter, r *http.Request)
 }
 
 // DeleteVirtualNetwork handles DELETE /accounts/{accountId}/teamnet/virtual_networks/{vnetId}.
+// Like Cloudflare, the default virtual network cannot be deleted.
 func (h *Handlers) DeleteVirtualNetwork(w http.ResponseWriter, r *http.Request) {
 	vnetID := GetPathParam(r, "vnetId")
+	if vnet, ok := h.store.GetVirtualNetwork(vnetID); ok && vnet.IsDefaultNetwork {
+		BadRequest(w, "the default virtual network cannot be deleted")
+		return
+	}
 	if !h.store.DeleteVirtualNetwork(vnetID) {
 		NotFound(w, "virtual network")
 		return
@@ -402,7 +458,10 @@ func (h *Handlers) ListTunnelRoutes(w http.ResponseWriter, r *http.Request) {
 	vnetID := GetQueryParam(r, "virtual_network_id")
 
 	routes := h.store.ListTunnelRoutes(tunnelID, vnetID)
-	Success(w, routes)
+	if GetQueryParam(r, "is_deleted") == "false" {
+		routes = slices.DeleteFunc(routes, func(route *models.TunnelRoute) bool { return route.DeletedAt != nil })
+	}
+	WritePage(w, r, routes)
 }
 
 // UpdateTunnelRoute handles PATCH /accounts/{accountId}/teamnet/routes/network/{network}.
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// Default caching settings Cloudflare applies to Hyperdrive configurations.
+const (
+	defaultHyperdriveMaxAge               = 60
+	defaultHyperdriveStaleWhileRevalidate = 15
+)
+
+// HyperdriveConfigRequest represents a Hyperdrive config creation or update request.
+type HyperdriveConfigRequest struct {
+	Name   string `json:"name"`
+	Origin struct {
+		models.HyperdriveOrigin
+		Password string `json:"password"`
+	} `json:"origin"`
+	Caching struct {
+		Disabled             *bool `json:"disabled"`
+		MaxAge               int   `json:"max_age"`
+		StaleWhileRevalidate int   `json:"stale_while_revalidate"`
+	} `json:"caching"`
+}
+
+// hyperdriveConfigFromRequest validates a request and converts it to a config.
+func hyperdriveConfigFromRequest(r *http.Request) (*models.HyperdriveConfig, string) {
+	req, err := ReadJSON[HyperdriveConfigRequest](r)
+	if err != nil || req.Name == "" {
+		return nil, "invalid request body"
+	}
+	origin := req.Origin
+	if origin.Scheme == "" || origin.Host == "" || origin.Database == "" || origin.User == "" {
+		return nil, "origin scheme, host, database and user are required"
+	}
+	if origin.Password == "" {
+		return nil, "origin password is required"
+	}
+
+	config := &models.HyperdriveConfig{
+		Name:     req.Name,
+		Origin:   origin.HyperdriveOrigin,
+		Password: origin.Password,
+		Caching: models.HyperdriveCaching{
+			MaxAge:               defaultHyperdriveMaxAge,
+			StaleWhileRevalidate: defaultHyperdriveStaleWhileRevalidate,
+		},
+		ModifiedOn: time.Now(),
+	}
+	if req.Caching.Disabled != nil {
+		config.Caching.Disabled = *req.Caching.Disabled
+	}
+	if req.Caching.MaxAge > 0 {
+		config.Caching.MaxAge = req.Caching.MaxAge
+	}
+	if req.Caching.StaleWhileRevalidate > 0 {
+		config.Caching.StaleWhileRevalidate = req.Caching.StaleWhileRevalidate
+	}
+	return config, ""
+}
+
+// CreateHyperdriveConfig handles POST /accounts/{accountId}/hyperdrive/configs.
+func (h *Handlers) CreateHyperdriveConfig(w http.ResponseWriter, r *http.Request) {
+	config, msg := hyperdriveConfigFromRequest(r)
+	if config == nil {
+		BadRequest(w, msg)
+		return
+	}
+	if _, exists := h.store.GetHyperdriveConfigByName(config.Name); exists {
+		Conflict(w, "a Hyperdrive config with this name already exists")
+		return
+	}
+
+	config.ID = GenerateID()
+	config.CreatedOn = config.ModifiedOn
+	h.store.CreateHyperdriveConfig(config)
+	Success(w, config)
+}
+
+// ListHyperdriveConfigs handles GET /accounts/{accountId}/hyperdrive/configs.
+func (h *Handlers) ListHyperdriveConfigs(w http.ResponseWriter, _ *http.Request) {
+	Success(w, h.store.ListHyperdriveConfigs())
+}
+
+// GetHyperdriveConfig handles GET /accounts/{accountId}/hyperdrive/configs/{configId}.
+func (h *Handlers) GetHyperdriveConfig(w http.ResponseWriter, r *http.Request) {
+	config, ok := h.store.GetHyperdriveConfig(GetPathParam(r, "configId"))
+	if !ok {
+		NotFound(w, "hyperdrive config")
+		return
+	}
+	Success(w, config)
+}
+
+// UpdateHyperdriveConfig handles PUT /accounts/{accountId}/hyperdrive/configs/{configId}.
+// Like the Cloudflare API, the origin password is required on every update.
+func (h *Handlers) UpdateHyperdriveConfig(w http.ResponseWriter, r *http.Request) {
+	config, msg := hyperdriveConfigFromRequest(r)
+	if config == nil {
+		BadRequest(w, msg)
+		return
+	}
+	id := GetPathParam(r, "configId")
+	if existing, exists := h.store.GetHyperdriveConfigByName(config.Name); exists && existing.ID != id {
+		Conflict(w, "a Hyperdrive config with this name already exists")
+		return
+	}
+
+	updated, ok := h.store.UpdateHyperdriveConfig(id, config)
+	if !ok {
+		NotFound(w, "hyperdrive config")
+		return
+	}
+	Success(w, updated)
+}
+
+// DeleteHyperdriveConfig handles DELETE /accounts/{accountId}/hyperdrive/configs/{configId}.
+func (h *Handlers) DeleteHyperdriveConfig(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteHyperdriveConfig(GetPathParam(r, "configId")) {
+		NotFound(w, "hyperdrive config")
+		return
+	}
+	Success(w, struct{}{})
+}
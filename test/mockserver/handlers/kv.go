@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// KVNamespaceRequest represents a KV namespace creation or update request.
+type KVNamespaceRequest struct {
+	Title string `json:"title"`
+}
+
+// CreateKVNamespace handles POST /accounts/{accountId}/storage/kv/namespaces.
+// Like the Cloudflare API, a duplicate title is rejected with 400.
+func (h *Handlers) CreateKVNamespace(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[KVNamespaceRequest](r)
+	if err != nil || req.Title == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	if _, exists := h.store.GetKVNamespaceByTitle(req.Title); exists {
+		BadRequest(w, "a namespace with this account ID and title already exists")
+		return
+	}
+
+	ns := &models.KVNamespace{
+		ID:                  GenerateID(),
+		Title:               req.Title,
+		SupportsURLEncoding: true,
+	}
+	h.store.CreateKVNamespace(ns)
+	Success(w, ns)
+}
+
+// ListKVNamespaces handles GET /accounts/{accountId}/storage/kv/namespaces.
+func (h *Handlers) ListKVNamespaces(w http.ResponseWriter, _ *http.Request) {
+	namespaces := h.store.ListKVNamespaces()
+	ResponseWithResultInfo(w, http.StatusOK, namespaces, &models.ResultInfo{
+		Page:       1,
+		PerPage:    len(namespaces),
+		Count:      len(namespaces),
+		TotalCount: len(namespaces),
+	})
+}
+
+// GetKVNamespace handles GET /accounts/{accountId}/storage/kv/namespaces/{namespaceId}.
+func (h *Handlers) GetKVNamespace(w http.ResponseWriter, r *http.Request) {
+	ns, ok := h.store.GetKVNamespace(GetPathParam(r, "namespaceId"))
+	if !ok {
+		NotFound(w, "namespace")
+		return
+	}
+	Success(w, ns)
+}
+
+// UpdateKVNamespace handles PUT /accounts/{accountId}/storage/kv/namespaces/{namespaceId}.
+func (h *Handlers) UpdateKVNamespace(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[KVNamespaceRequest](r)
+	if err != nil || req.Title == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	id := GetPathParam(r, "namespaceId")
+	if existing, exists := h.store.GetKVNamespaceByTitle(req.Title); exists && existing.ID != id {
+		BadRequest(w, "a namespace with this account ID and title already exists")
+		return
+	}
+	if !h.store.RenameKVNamespace(id, req.Title) {
+		NotFound(w, "namespace")
+		return
+	}
+	Success(w, struct{}{})
+}
+
+// DeleteKVNamespace handles DELETE /accounts/{accountId}/storage/kv/namespaces/{namespaceId}.
+func (h *Handlers) DeleteKVNamespace(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteKVNamespace(GetPathParam(r, "namespaceId")) {
+		NotFound(w, "namespace")
+		return
+	}
+	Success(w, struct{}{})
+}
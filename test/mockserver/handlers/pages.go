@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// CreatePagesDeployment handles POST /accounts/{accountId}/pages/projects/{projectName}/deployments.
+func (h *Handlers) CreatePagesDeployment(w http.ResponseWriter, r *http.Request) {
+	projectName := GetPathParam(r, "projectName")
+	id := GenerateID()
+	shortID := id[:8]
+
+	deployment := &models.PagesDeployment{
+		ID:          id,
+		ShortID:     shortID,
+		ProjectName: projectName,
+		Environment: "production",
+		URL:         fmt.Sprintf("https://%s.%s.pages.dev", shortID, projectName),
+		Aliases:     []string{fmt.Sprintf("https://%s.%s.pages.dev", shortID, projectName)},
+		CreatedOn:   time.Now(),
+		LatestStage: models.PagesDeploymentStage{Name: "queued", Status: "active"},
+		Stages:      []models.PagesDeploymentStage{{Name: "queued", Status: "active"}},
+		Trigger:     models.PagesDeploymentTrigger{Type: "ad_hoc"},
+	}
+
+	// Like the Cloudflare API, a deployment of a branch is reachable at the
+	// branch alias <branch>.<project>.pages.dev.
+	if branch := pagesDeploymentBranch(r); branch != "" {
+		deployment.Environment = "preview"
+		deployment.Trigger.Metadata.Branch = branch
+		deployment.Aliases = append(deployment.Aliases,
+			fmt.Sprintf("https://%s.%s.pages.dev", pagesBranchAlias(branch), projectName))
+	}
+
+	h.store.CreatePagesDeployment(deployment)
+	Success(w, deployment)
+}
+
+// ListPagesDeployments handles GET /accounts/{accountId}/pages/projects/{projectName}/deployments.
+func (h *Handlers) ListPagesDeployments(w http.ResponseWriter, r *http.Request) {
+	projectName := GetPathParam(r, "projectName")
+	deployments := h.store.ListPagesDeployments(projectName)
+	ResponseWithResultInfo(w, http.StatusOK, deployments, &models.ResultInfo{
+		Page:       1,
+		PerPage:    len(deployments),
+		Count:      len(deployments),
+		TotalCount: len(deployments),
+	})
+}
+
+// GetPagesDeployment handles GET /accounts/{accountId}/pages/projects/{projectName}/deployments/{deploymentId}.
+// Each request is a poll that may advance the deployment's stage.
+func (h *Handlers) GetPagesDeployment(w http.ResponseWriter, r *http.Request) {
+	projectName := GetPathParam(r, "projectName")
+	deploymentID := GetPathParam(r, "deploymentId")
+	deployment, ok := h.store.PollPagesDeployment(projectName, deploymentID)
+	if !ok {
+		Error(w, http.StatusNotFound, 8000007, "deployment not found")
+		return
+	}
+	Success(w, deployment)
+}
+
+// GetPagesDeploymentLogs handles GET /accounts/{accountId}/pages/projects/{projectName}/deployments/{deploymentId}/history/logs.
+func (h *Handlers) GetPagesDeploymentLogs(w http.ResponseWriter, r *http.Request) {
+	projectName := GetPathParam(r, "projectName")
+	deploymentID := GetPathParam(r, "deploymentId")
+	if _, ok := h.store.GetPagesDeployment(projectName, deploymentID); !ok {
+		Error(w, http.StatusNotFound, 8000007, "deployment not found")
+		return
+	}
+
+	entries := h.store.GetPagesDeploymentLogs(deploymentID)
+	Success(w, models.PagesDeploymentLogs{
+		Total:                 len(entries),
+		IncludesContainerLogs: true,
+		Data:                  entries,
+	})
+}
+
+// pagesBranchAlias returns the subdomain of the branch alias of a Pages branch:
+// the lowercased branch with every character other than a letter or digit
+// replaced by a dash, truncated to 28 characters.
+func pagesBranchAlias(branch string) string {
+	alias := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(branch))
+	if len(alias) > 28 {
+		alias = alias[:28]
+	}
+	return strings.Trim(alias, "-")
+}
+
+// pagesDeploymentBranch returns the branch of a deployment creation request.
+// Git deployments send it in a JSON body, direct uploads as a form field.
+func pagesDeploymentBranch(r *http.Request) string {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		req, err := ReadJSON[struct {
+			Branch string `json:"branch"`
+		}](r)
+		if err != nil {
+			return ""
+		}
+		return req.Branch
+	}
+	return r.FormValue("branch")
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// PagesDomainRequest represents a request to add a custom domain to a Pages project.
+type PagesDomainRequest struct {
+	Name string `json:"name"`
+}
+
+// AddPagesDomain handles POST /accounts/{accountId}/pages/projects/{projectName}/domains.
+// Domains are added pending ownership verification and certificate validation
+// and keep that status until a test changes it with Store().SetPagesDomainStatus.
+func (h *Handlers) AddPagesDomain(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[PagesDomainRequest](r)
+	if err != nil || req.Name == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	projectName := GetPathParam(r, "projectName")
+	if _, exists := h.store.GetPagesDomain(projectName, req.Name); exists {
+		Conflict(w, "domain is already added to the project")
+		return
+	}
+
+	domain := &models.PagesDomain{
+		ID:                   GenerateID(),
+		Name:                 req.Name,
+		Status:               "pending",
+		CertificateAuthority: "google",
+		ValidationData: models.PagesDomainValidationData{
+			Status:   "pending",
+			Method:   "txt",
+			TXTName:  "_cf-custom-hostname." + req.Name,
+			TXTValue: GenerateID(),
+		},
+		VerificationData: models.PagesDomainVerificationData{Status: "pending"},
+		CreatedOn:        time.Now(),
+	}
+	for _, zone := range h.store.ListZones() {
+		if req.Name == zone.Name || strings.HasSuffix(req.Name, "."+zone.Name) {
+			domain.ZoneTag = zone.ID
+			break
+		}
+	}
+
+	h.store.CreatePagesDomain(projectName, domain)
+	Success(w, domain)
+}
+
+// ListPagesDomains handles GET /accounts/{accountId}/pages/projects/{projectName}/domains.
+func (h *Handlers) ListPagesDomains(w http.ResponseWriter, r *http.Request) {
+	Success(w, h.store.ListPagesDomains(GetPathParam(r, "projectName")))
+}
+
+// GetPagesDomain handles GET /accounts/{accountId}/pages/projects/{projectName}/domains/{domainName}.
+func (h *Handlers) GetPagesDomain(w http.ResponseWriter, r *http.Request) {
+	domain, ok := h.store.GetPagesDomain(GetPathParam(r, "projectName"), GetPathParam(r, "domainName"))
+	if !ok {
+		NotFound(w, "domain")
+		return
+	}
+	Success(w, domain)
+}
+
+// RetryPagesDomain handles PATCH /accounts/{accountId}/pages/projects/{projectName}/domains/{domainName},
+// which retries the validation of a domain. The mock returns the domain unchanged.
+func (h *Handlers) RetryPagesDomain(w http.ResponseWriter, r *http.Request) {
+	h.GetPagesDomain(w, r)
+}
+
+// DeletePagesDomain handles DELETE /accounts/{accountId}/pages/projects/{projectName}/domains/{domainName}.
+func (h *Handlers) DeletePagesDomain(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeletePagesDomain(GetPathParam(r, "projectName"), GetPathParam(r, "domainName")) {
+		NotFound(w, "domain")
+		return
+	}
+	Success(w, struct{}{})
+}
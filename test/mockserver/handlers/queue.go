@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// QueueRequest represents a queue create or update request.
+type QueueRequest struct {
+	Name     string               `json:"queue_name"`
+	Settings models.QueueSettings `json:"settings"`
+}
+
+// QueueConsumerRequest represents a queue consumer create or update request.
+type QueueConsumerRequest struct {
+	Type            string                       `json:"type"`
+	ScriptName      string                       `json:"script_name"`
+	DeadLetterQueue string                       `json:"dead_letter_queue"`
+	Settings        models.QueueConsumerSettings `json:"settings"`
+}
+
+// CreateQueue handles POST /accounts/{accountId}/queues.
+func (h *Handlers) CreateQueue(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[QueueRequest](r)
+	if err != nil || req.Name == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	if _, ok := h.store.GetQueueByName(req.Name); ok {
+		Conflict(w, "queue name already taken")
+		return
+	}
+
+	now := time.Now()
+	queue := &models.Queue{
+		ID:         GenerateID(),
+		Name:       req.Name,
+		CreatedOn:  now,
+		ModifiedOn: now,
+		Settings:   req.Settings,
+		Consumers:  []models.QueueConsumer{},
+	}
+	h.store.CreateQueue(queue)
+
+	created, _ := h.store.GetQueue(queue.ID)
+	Success(w, created)
+}
+
+// ListQueues handles GET /accounts/{accountId}/queues.
+func (h *Handlers) ListQueues(w http.ResponseWriter, _ *http.Request) {
+	Success(w, h.store.ListQueues())
+}
+
+// GetQueue handles GET /accounts/{accountId}/queues/{queueId}.
+func (h *Handlers) GetQueue(w http.ResponseWriter, r *http.Request) {
+	queue, ok := h.store.GetQueue(GetPathParam(r, "queueId"))
+	if !ok {
+		NotFound(w, "queue")
+		return
+	}
+	Success(w, queue)
+}
+
+// UpdateQueue handles PUT /accounts/{accountId}/queues/{queueId}.
+func (h *Handlers) UpdateQueue(w http.ResponseWriter, r *http.Request) {
+	queueID := GetPathParam(r, "queueId")
+	req, err := ReadJSON[QueueRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	if !h.store.UpdateQueue(queueID, func(queue *models.Queue) {
+		if req.Name != "" {
+			queue.Name = req.Name
+		}
+		queue.Settings = req.Settings
+	}) {
+		NotFound(w, "queue")
+		return
+	}
+
+	queue, _ := h.store.GetQueue(queueID)
+	Success(w, queue)
+}
+
+// DeleteQueue handles DELETE /accounts/{accountId}/queues/{queueId}.
+func (h *Handlers) DeleteQueue(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteQueue(GetPathParam(r, "queueId")) {
+		NotFound(w, "queue")
+		return
+	}
+	Success(w, struct{}{})
+}
+
+// ListQueueConsumers handles GET /accounts/{accountId}/queues/{queueId}/consumers.
+func (h *Handlers) ListQueueConsumers(w http.ResponseWriter, r *http.Request) {
+	queue, ok := h.store.GetQueue(GetPathParam(r, "queueId"))
+	if !ok {
+		NotFound(w, "queue")
+		return
+	}
+	Success(w, queue.Consumers)
+}
+
+// CreateQueueConsumer handles POST /accounts/{accountId}/queues/{queueId}/consumers.
+// A queue has at most one consumer per Worker script and one http_pull consumer.
+func (h *Handlers) CreateQueueConsumer(w http.ResponseWriter, r *http.Request) {
+	queueID := GetPathParam(r, "queueId")
+	queue, ok := h.store.GetQueue(queueID)
+	if !ok {
+		NotFound(w, "queue")
+		return
+	}
+
+	req, err := ReadJSON[QueueConsumerRequest](r)
+	if err != nil || !validQueueConsumer(req) {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	for _, existing := range queue.Consumers {
+		if existing.Type == req.Type && existing.Script == req.ScriptName {
+			Conflict(w, "queue consumer already exists")
+			return
+		}
+	}
+
+	consumer := models.QueueConsumer{
+		ID:              GenerateID(),
+		Type:            req.Type,
+		Script:          req.ScriptName,
+		DeadLetterQueue: req.DeadLetterQueue,
+		Settings:        req.Settings,
+		CreatedOn:       time.Now(),
+	}
+	h.store.AddQueueConsumer(queueID, consumer)
+	Success(w, consumer)
+}
+
+// UpdateQueueConsumer handles PUT /accounts/{accountId}/queues/{queueId}/consumers/{consumerId}.
+func (h *Handlers) UpdateQueueConsumer(w http.ResponseWriter, r *http.Request) {
+	queueID, consumerID := GetPathParam(r, "queueId"), GetPathParam(r, "consumerId")
+	req, err := ReadJSON[QueueConsumerRequest](r)
+	if err != nil || !validQueueConsumer(req) {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	var updated models.QueueConsumer
+	if !h.store.UpdateQueueConsumer(queueID, consumerID, func(consumer *models.QueueConsumer) {
+		consumer.Script = req.ScriptName
+		consumer.DeadLetterQueue = req.DeadLetterQueue
+		consumer.Settings = req.Settings
+		updated = *consumer
+	}) {
+		NotFound(w, "queue consumer")
+		return
+	}
+	Success(w, updated)
+}
+
+// DeleteQueueConsumer handles DELETE /accounts/{accountId}/queues/{queueId}/consumers/{consumerId}.
+func (h *Handlers) DeleteQueueConsumer(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteQueueConsumer(GetPathParam(r, "queueId"), GetPathParam(r, "consumerId")) {
+		NotFound(w, "queue consumer")
+		return
+	}
+	Success(w, struct{}{})
+}
+
+func validQueueConsumer(req *QueueConsumerRequest) bool {
+	switch req.Type {
+	case "worker":
+		return req.ScriptName != ""
+	case "http_pull":
+		return req.ScriptName == ""
+	}
+	return false
+}
@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
@@ -116,3 +117,152 @@ func (h *Handlers) UpdateR2BucketLifecycle(w http.ResponseWriter, r *http.Reques
 	h.store.UpdateR2BucketLifecycle(bucketName, req)
 	Success(w, req)
 }
+
+// R2CustomDomainRequest represents an R2 custom domain attach or update request.
+type R2CustomDomainRequest struct {
+	Domain  string `json:"domain"`
+	ZoneID  string `json:"zoneId,omitempty"`
+	MinTLS  string `json:"minTLS,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+// AttachR2CustomDomain handles POST /accounts/{accountId}/r2/buckets/{bucketName}/domains/custom.
+// New domains start with pending ownership and an initializing certificate; tests
+// advance them with Store.SetR2CustomDomainStatus.
+func (h *Handlers) AttachR2CustomDomain(w http.ResponseWriter, r *http.Request) {
+	bucketName := GetPathParam(r, "bucketName")
+	if _, ok := h.store.GetR2Bucket(bucketName); !ok {
+		NotFound(w, "bucket")
+		return
+	}
+
+	req, err := ReadJSON[R2CustomDomainRequest](r)
+	if err != nil || req.Domain == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	if _, ok := h.store.GetR2CustomDomain(bucketName, req.Domain); ok {
+		Conflict(w, "custom domain already exists")
+		return
+	}
+
+	domain := &models.R2CustomDomain{
+		Domain:  req.Domain,
+		Enabled: req.Enabled,
+		Status:  models.R2CustomDomainStatus{Ownership: "pending", SSL: "initializing"},
+		MinTLS:  req.MinTLS,
+		ZoneID:  req.ZoneID,
+	}
+	h.setR2CustomDomainZone(domain)
+
+	h.store.CreateR2CustomDomain(bucketName, domain)
+	Success(w, domain)
+}
+
+// GetR2CustomDomain handles GET /accounts/{accountId}/r2/buckets/{bucketName}/domains/custom/{domain}.
+func (h *Handlers) GetR2CustomDomain(w http.ResponseWriter, r *http.Request) {
+	domain, ok := h.store.GetR2CustomDomain(GetPathParam(r, "bucketName"), GetPathParam(r, "domain"))
+	if !ok {
+		NotFound(w, "custom domain")
+		return
+	}
+	Success(w, domain)
+}
+
+// UpdateR2CustomDomain handles PUT /accounts/{accountId}/r2/buckets/{bucketName}/domains/custom/{domain}.
+func (h *Handlers) UpdateR2CustomDomain(w http.ResponseWriter, r *http.Request) {
+	bucketName, domainName := GetPathParam(r, "bucketName"), GetPathParam(r, "domain")
+	req, err := ReadJSON[R2CustomDomainRequest](r)
+	if err != nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+
+	if !h.store.UpdateR2CustomDomain(bucketName, domainName, func(domain *models.R2CustomDomain) {
+		domain.Enabled = req.Enabled
+		if req.MinTLS != "" {
+			domain.MinTLS = req.MinTLS
+		}
+		if req.ZoneID != "" {
+			domain.ZoneID = req.ZoneID
+		}
+	}) {
+		NotFound(w, "custom domain")
+		return
+	}
+
+	domain, _ := h.store.GetR2CustomDomain(bucketName, domainName)
+	Success(w, domain)
+}
+
+// DeleteR2CustomDomain handles DELETE /accounts/{accountId}/r2/buckets/{bucketName}/domains/custom/{domain}.
+func (h *Handlers) DeleteR2CustomDomain(w http.ResponseWriter, r *http.Request) {
+	domain := GetPathParam(r, "domain")
+	if !h.store.DeleteR2CustomDomain(GetPathParam(r, "bucketName"), domain) {
+		NotFound(w, "custom domain")
+		return
+	}
+	Success(w, map[string]string{"domain": domain})
+}
+
+// R2NotificationRequest represents an R2 event notification configuration request.
+type R2NotificationRequest struct {
+	Rules []models.R2NotificationRule `json:"rules"`
+}
+
+// SetR2Notification handles PUT /accounts/{accountId}/event_notifications/r2/{bucketName}/configuration/queues/{queueId}.
+func (h *Handlers) SetR2Notification(w http.ResponseWriter, r *http.Request) {
+	bucketName, queueID := GetPathParam(r, "bucketName"), GetPathParam(r, "queueId")
+	if _, ok := h.store.GetR2Bucket(bucketName); !ok {
+		NotFound(w, "bucket")
+		return
+	}
+	if _, ok := h.store.GetQueue(queueID); !ok {
+		NotFound(w, "queue")
+		return
+	}
+
+	req, err := ReadJSON[R2NotificationRequest](r)
+	if err != nil || len(req.Rules) == 0 {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	for i := range req.Rules {
+		if len(req.Rules[i].EventTypes) == 0 {
+			BadRequest(w, "rule must have at least one event type")
+			return
+		}
+		if req.Rules[i].RuleID == "" {
+			req.Rules[i].RuleID = GenerateID()
+		}
+	}
+
+	h.store.SetR2Notification(bucketName, queueID, req.Rules)
+	Success(w, struct{}{})
+}
+
+// DeleteR2Notification handles DELETE /accounts/{accountId}/event_notifications/r2/{bucketName}/configuration/queues/{queueId}.
+func (h *Handlers) DeleteR2Notification(w http.ResponseWriter, r *http.Request) {
+	if !h.store.DeleteR2Notification(GetPathParam(r, "bucketName"), GetPathParam(r, "queueId")) {
+		NotFound(w, "notification configuration")
+		return
+	}
+	Success(w, struct{}{})
+}
+
+// setR2CustomDomainZone fills in the zone of a custom domain: the zone given by
+// its ID, or else the zone whose name the domain ends with.
+func (h *Handlers) setR2CustomDomainZone(domain *models.R2CustomDomain) {
+	if domain.ZoneID != "" {
+		if zone, ok := h.store.GetZone(domain.ZoneID); ok {
+			domain.ZoneName = zone.Name
+		}
+		return
+	}
+	for _, zone := range h.store.ListZones() {
+		if domain.Domain == zone.Name || strings.HasSuffix(domain.Domain, "."+zone.Name) {
+			domain.ZoneID, domain.ZoneName = zone.ID, zone.Name
+			return
+		}
+	}
+}
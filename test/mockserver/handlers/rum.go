@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// rumSiteDefaultPageSize is the page size of the site list when per_page is not given.
+const rumSiteDefaultPageSize = 10
+
+// RUMSiteRequest represents a Web Analytics site creation or update request.
+type RUMSiteRequest struct {
+	Host        string `json:"host,omitempty"`
+	AutoInstall *bool  `json:"auto_install,omitempty"`
+}
+
+// CreateRUMSite handles POST /accounts/{accountId}/rum/site_info.
+// Like the Cloudflare API, a hostname can only have one site, and auto_install
+// is rejected for *.pages.dev hostnames.
+func (h *Handlers) CreateRUMSite(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[RUMSiteRequest](r)
+	if err != nil || req.Host == "" {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	if _, exists := h.store.GetRUMSiteByHost(req.Host); exists {
+		BadRequest(w, "site already exists")
+		return
+	}
+	autoInstall := req.AutoInstall == nil || *req.AutoInstall
+	if autoInstall && strings.HasSuffix(req.Host, ".pages.dev") {
+		BadRequest(w, "auto_install is not supported for this host")
+		return
+	}
+
+	site := &models.RUMSite{
+		SiteTag:     GenerateID(),
+		SiteToken:   GenerateToken(16),
+		Host:        req.Host,
+		AutoInstall: autoInstall,
+		Created:     time.Now(),
+	}
+	h.store.CreateRUMSite(site)
+	Success(w, site)
+}
+
+// ListRUMSites handles GET /accounts/{accountId}/rum/site_info/list.
+// Like the Cloudflare API, the list is paginated by the page and per_page
+// query parameters.
+func (h *Handlers) ListRUMSites(w http.ResponseWriter, r *http.Request) {
+	sites := h.store.ListRUMSites()
+
+	page, err := strconv.Atoi(GetQueryParam(r, "page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(GetQueryParam(r, "per_page"))
+	if err != nil || perPage < 1 {
+		perPage = rumSiteDefaultPageSize
+	}
+
+	start := min((page-1)*perPage, len(sites))
+	end := min(start+perPage, len(sites))
+	ResponseWithResultInfo(w, http.StatusOK, sites[start:end], &models.ResultInfo{
+		Page:       page,
+		PerPage:    perPage,
+		Count:      end - start,
+		TotalCount: len(sites),
+	})
+}
+
+// GetRUMSite handles GET /accounts/{accountId}/rum/site_info/{siteTag}.
+func (h *Handlers) GetRUMSite(w http.ResponseWriter, r *http.Request) {
+	site, ok := h.store.GetRUMSite(GetPathParam(r, "siteTag"))
+	if !ok {
+		NotFound(w, "site")
+		return
+	}
+	Success(w, site)
+}
+
+// UpdateRUMSite handles PUT /accounts/{accountId}/rum/site_info/{siteTag}.
+func (h *Handlers) UpdateRUMSite(w http.ResponseWriter, r *http.Request) {
+	req, err := ReadJSON[RUMSiteRequest](r)
+	if err != nil || req.AutoInstall == nil {
+		BadRequest(w, "invalid request body")
+		return
+	}
+	siteTag := GetPathParam(r, "siteTag")
+	existing, ok := h.store.GetRUMSite(siteTag)
+	if !ok {
+		NotFound(w, "site")
+		return
+	}
+	if *req.AutoInstall && strings.HasSuffix(existing.Host, ".pages.dev") {
+		BadRequest(w, "auto_install is not supported for this host")
+		return
+	}
+
+	site, _ := h.store.UpdateRUMSite(siteTag, *req.AutoInstall)
+	Success(w, site)
+}
+
+// DeleteRUMSite handles DELETE /accounts/{accountId}/rum/site_info/{siteTag}.
+func (h *Handlers) DeleteRUMSite(w http.ResponseWriter, r *http.Request) {
+	siteTag := GetPathParam(r, "siteTag")
+	if !h.store.DeleteRUMSite(siteTag) {
+		NotFound(w, "site")
+		return
+	}
+	Success(w, map[string]string{"site_tag": siteTag})
+}
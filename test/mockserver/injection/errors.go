@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"regexp"
 	"sync"
+	"time"
 )
 
 // ErrorType defines the type of error to inject.
@@ -24,6 +25,10 @@ const (
 	ErrorTypeConflict ErrorType = "conflict"
 	// ErrorTypeNotFound simulates a 404 not found error.
 	ErrorTypeNotFound ErrorType = "not_found"
+	// ErrorTypeStatus responds with the StatusCode of the injection.
+	ErrorTypeStatus ErrorType = "status"
+	// ErrorTypeLatency only delays the request, which is then served normally.
+	ErrorTypeLatency ErrorType = "latency"
 )
 
 // TriggerMode defines when to trigger the error.
@@ -52,6 +57,12 @@ type ErrorInjection struct {
 	Probability int
 	// CountLimit is the number of times to trigger for TriggerModeCount.
 	CountLimit int
+	// StatusCode is the HTTP status code to respond with for ErrorTypeStatus.
+	StatusCode int
+	// Latency delays the response by the given duration.
+	Latency time.Duration
+	// RetryAfter sets the Retry-After header in seconds, if positive.
+	RetryAfter int
 
 	// Internal state
 	triggerCount int
@@ -61,8 +72,11 @@ type ErrorInjection struct {
 
 // InjectedError represents an injected error.
 type InjectedError struct {
-	Type    ErrorType
-	Message string
+	Type       ErrorType
+	Message    string
+	StatusCode int
+	Latency    time.Duration
+	RetryAfter int
 }
 
 // ErrorInjector manages error injection rules.
@@ -148,24 +162,15 @@ func (e *ErrorInjector) Check(path, method string) *InjectedError {
 
 		switch injection.TriggerMode {
 		case TriggerModeAlways:
-			return &InjectedError{
-				Type:    injection.ErrorType,
-				Message: "Injected error (always)",
-			}
+			return injection.injected("Injected error (always)")
 		case TriggerModeProbability:
 			if rand.Intn(100) < injection.Probability {
-				return &InjectedError{
-					Type:    injection.ErrorType,
-					Message: "Injected error (probability)",
-				}
+				return injection.injected("Injected error (probability)")
 			}
 		case TriggerModeCount:
 			if injection.triggerCount < injection.CountLimit {
 				injection.triggerCount++
-				return &InjectedError{
-					Type:    injection.ErrorType,
-					Message: "Injected error (count)",
-				}
+				return injection.injected("Injected error (count)")
 			}
 		}
 	}
@@ -173,6 +178,17 @@ func (e *ErrorInjector) Check(path, method string) *InjectedError {
 	return nil
 }
 
+// injected returns the error the injection responds with.
+func (i *ErrorInjection) injected(message string) *InjectedError {
+	return &InjectedError{
+		Type:       i.ErrorType,
+		Message:    message,
+		StatusCode: i.StatusCode,
+		Latency:    i.Latency,
+		RetryAfter: i.RetryAfter,
+	}
+}
+
 // Remove removes error injections matching the given path pattern.
 func (e *ErrorInjector) Remove(pathPattern string) {
 	e.mu.Lock()
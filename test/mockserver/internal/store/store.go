@@ -7,6 +7,10 @@ package store
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -32,14 +36,19 @@ type Store struct {
 
 	// Access resources
 	accessApplications      map[string]*models.AccessApplication       // appID -> AccessApplication
-	accessPolicies          map[string]map[string]*models.AccessPolicy // appID -> policyID -> AccessPolicy
+	accessPolicies          map[string]map[string]*models.AccessPolicy // appID -> policyID -> AccessPolicy; reusable policies use appID ""
 	accessGroups            map[string]*models.AccessGroup             // groupID -> AccessGroup
 	accessServiceTokens     map[string]*models.AccessServiceToken      // tokenID -> AccessServiceToken
 	accessIdentityProviders map[string]*models.AccessIdentityProvider  // idpID -> AccessIdentityProvider
+	accessSCIMUpdateLogs    []models.AccessSCIMUpdateLog
+	accessCertificates      map[string]*models.AccessMutualTLSCertificate // certificateID -> AccessMutualTLSCertificate
+	accessCustomPages       map[string]*models.AccessCustomPage           // pageUID -> AccessCustomPage
+	accessTags              map[string]*models.AccessTag                  // tagName -> AccessTag
 
 	// Gateway resources
-	gatewayRules         map[string]*models.GatewayRule // ruleID -> GatewayRule
-	gatewayLists         map[string]*models.GatewayList // listID -> GatewayList
+	gatewayRules         map[string]*models.GatewayRule     // ruleID -> GatewayRule
+	gatewayLists         map[string]*models.GatewayList     // listID -> GatewayList
+	gatewayLocations     map[string]*models.GatewayLocation // locationID -> GatewayLocation
 	gatewayConfiguration *models.GatewayConfiguration
 
 	// Device resources
@@ -47,8 +56,32 @@ type Store struct {
 	deviceSettingsPolicies map[string]*models.DeviceSettingsPolicy // policyID -> DeviceSettingsPolicy
 
 	// R2 resources
-	r2Buckets         map[string]*models.R2Bucket // bucketName -> R2Bucket
-	r2BucketLifecycle map[string]interface{}      // bucketName -> lifecycle rules
+	r2Buckets         map[string]*models.R2Bucket                       // bucketName -> R2Bucket
+	r2BucketLifecycle map[string]interface{}                            // bucketName -> lifecycle rules
+	r2CustomDomains   map[string]*models.R2CustomDomain                 // bucketName/domain -> R2CustomDomain
+	r2Notifications   map[string]map[string][]models.R2NotificationRule // bucketName -> queueID -> rules
+
+	// Queue resources
+	queues map[string]*models.Queue // queueID -> Queue
+
+	// D1 resources
+	d1Databases map[string]*models.D1Database // databaseID -> D1Database
+
+	// Workers KV resources
+	kvNamespaces map[string]*models.KVNamespace // namespaceID -> KVNamespace
+
+	// Hyperdrive resources
+	hyperdriveConfigs map[string]*models.HyperdriveConfig // configID -> HyperdriveConfig
+
+	// Web Analytics resources
+	rumSites map[string]*models.RUMSite // siteTag -> RUMSite
+
+	// Pages resources
+	pagesDeployments     map[string]*models.PagesDeployment          // deploymentID -> PagesDeployment
+	pagesDomains         map[string]*models.PagesDomain              // projectName/domain -> PagesDomain
+	pagesDeploymentLogs  map[string][]models.PagesDeploymentLogEntry // deploymentID -> log lines
+	pagesDeploymentPolls map[string]int                              // deploymentID -> GET count
+	pagesProgression     models.PagesDeploymentProgression
 
 	// Zone Rulesets
 	zoneRulesets map[string]*models.ZoneRuleset // rulesetID -> ZoneRuleset
@@ -80,12 +113,27 @@ func NewStore() *Store {
 		accessGroups:            make(map[string]*models.AccessGroup),
 		accessServiceTokens:     make(map[string]*models.AccessServiceToken),
 		accessIdentityProviders: make(map[string]*models.AccessIdentityProvider),
+		accessCertificates:      make(map[string]*models.AccessMutualTLSCertificate),
+		accessCustomPages:       make(map[string]*models.AccessCustomPage),
+		accessTags:              make(map[string]*models.AccessTag),
 		gatewayRules:            make(map[string]*models.GatewayRule),
 		gatewayLists:            make(map[string]*models.GatewayList),
+		gatewayLocations:        make(map[string]*models.GatewayLocation),
 		devicePostureRules:      make(map[string]*models.DevicePostureRule),
 		deviceSettingsPolicies:  make(map[string]*models.DeviceSettingsPolicy),
 		r2Buckets:               make(map[string]*models.R2Bucket),
 		r2BucketLifecycle:       make(map[string]interface{}),
+		r2CustomDomains:         make(map[string]*models.R2CustomDomain),
+		r2Notifications:         make(map[string]map[string][]models.R2NotificationRule),
+		queues:                  make(map[string]*models.Queue),
+		d1Databases:             make(map[string]*models.D1Database),
+		kvNamespaces:            make(map[string]*models.KVNamespace),
+		hyperdriveConfigs:       make(map[string]*models.HyperdriveConfig),
+		rumSites:                make(map[string]*models.RUMSite),
+		pagesDeployments:        make(map[string]*models.PagesDeployment),
+		pagesDomains:            make(map[string]*models.PagesDomain),
+		pagesDeploymentLogs:     make(map[string][]models.PagesDeploymentLogEntry),
+		pagesDeploymentPolls:    make(map[string]int),
 		zoneRulesets:            make(map[string]*models.ZoneRuleset),
 		warpConnectors:          make(map[string]*models.WARPConnector),
 		splitTunnelExclude:      []models.SplitTunnelEntry{},
@@ -142,12 +190,29 @@ func (s *Store) Reset() {
 	s.accessGroups = make(map[string]*models.AccessGroup)
 	s.accessServiceTokens = make(map[string]*models.AccessServiceToken)
 	s.accessIdentityProviders = make(map[string]*models.AccessIdentityProvider)
+	s.accessSCIMUpdateLogs = nil
+	s.accessCertificates = make(map[string]*models.AccessMutualTLSCertificate)
+	s.accessCustomPages = make(map[string]*models.AccessCustomPage)
+	s.accessTags = make(map[string]*models.AccessTag)
 	s.gatewayRules = make(map[string]*models.GatewayRule)
 	s.gatewayLists = make(map[string]*models.GatewayList)
+	s.gatewayLocations = make(map[string]*models.GatewayLocation)
 	s.devicePostureRules = make(map[string]*models.DevicePostureRule)
 	s.deviceSettingsPolicies = make(map[string]*models.DeviceSettingsPolicy)
 	s.r2Buckets = make(map[string]*models.R2Bucket)
 	s.r2BucketLifecycle = make(map[string]interface{})
+	s.r2CustomDomains = make(map[string]*models.R2CustomDomain)
+	s.r2Notifications = make(map[string]map[string][]models.R2NotificationRule)
+	s.queues = make(map[string]*models.Queue)
+	s.d1Databases = make(map[string]*models.D1Database)
+	s.kvNamespaces = make(map[string]*models.KVNamespace)
+	s.hyperdriveConfigs = make(map[string]*models.HyperdriveConfig)
+	s.rumSites = make(map[string]*models.RUMSite)
+	s.pagesDeployments = make(map[string]*models.PagesDeployment)
+	s.pagesDomains = make(map[string]*models.PagesDomain)
+	s.pagesDeploymentLogs = make(map[string][]models.PagesDeploymentLogEntry)
+	s.pagesDeploymentPolls = make(map[string]int)
+	s.pagesProgression = models.PagesDeploymentProgression{}
 	s.zoneRulesets = make(map[string]*models.ZoneRuleset)
 	s.warpConnectors = make(map[string]*models.WARPConnector)
 	s.splitTunnelExclude = []models.SplitTunnelEntry{}
@@ -271,6 +336,18 @@ func (s *Store) ListTunnels(accountID string) []*models.Tunnel {
 	return tunnels
 }
 
+// UpdateTunnel updates a tunnel.
+func (s *Store) UpdateTunnel(id string, update func(*models.Tunnel)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tunnel, ok := s.tunnels[id]
+	if !ok || tunnel.DeletedAt != nil {
+		return false
+	}
+	update(tunnel)
+	return true
+}
+
 // DeleteTunnel soft-deletes a tunnel.
 func (s *Store) DeleteTunnel(id string) bool {
 	s.mu.Lock()
@@ -308,9 +385,13 @@ func (s *Store) UpdateTunnelConfiguration(tunnelID string, config models.TunnelC
 // ---- Virtual Network Operations ----
 
 // CreateVirtualNetwork creates a new virtual network.
+// Like Cloudflare, the account keeps a single default virtual network.
 func (s *Store) CreateVirtualNetwork(vnet *models.VirtualNetwork) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if vnet.IsDefaultNetwork {
+		s.clearDefaultVirtualNetworkLocked(vnet.ID)
+	}
 	s.virtualNetworks[vnet.ID] = vnet
 }
 
@@ -348,6 +429,7 @@ func (s *Store) ListVirtualNetworks() []*models.VirtualNetwork {
 }
 
 // UpdateVirtualNetwork updates a virtual network.
+// Making a virtual network the default clears the flag on the previous default.
 func (s *Store) UpdateVirtualNetwork(id string, update func(*models.VirtualNetwork)) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -356,6 +438,9 @@ func (s *Store) UpdateVirtualNetwork(id string, update func(*models.VirtualNetwo
 		return false
 	}
 	update(vnet)
+	if vnet.IsDefaultNetwork {
+		s.clearDefaultVirtualNetworkLocked(id)
+	}
 	return true
 }
 
@@ -372,6 +457,16 @@ func (s *Store) DeleteVirtualNetwork(id string) bool {
 	return true
 }
 
+// clearDefaultVirtualNetworkLocked clears the default flag on all virtual networks except id.
+// The caller must hold the write lock.
+func (s *Store) clearDefaultVirtualNetworkLocked(id string) {
+	for otherID, other := range s.virtualNetworks {
+		if otherID != id {
+			other.IsDefaultNetwork = false
+		}
+	}
+}
+
 // ---- Tunnel Route Operations ----
 
 func routeKey(network, vnetID string) string {
@@ -395,12 +490,14 @@ func (s *Store) GetTunnelRoute(network, vnetID string) (*models.TunnelRoute, boo
 	return route, ok
 }
 
-// ListTunnelRoutes returns routes filtered by tunnel ID or virtual network ID.
+// ListTunnelRoutes returns routes filtered by tunnel ID or virtual network ID,
+// ordered by network and virtual network.
 func (s *Store) ListTunnelRoutes(tunnelID, vnetID string) []*models.TunnelRoute {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	routes := make([]*models.TunnelRoute, 0)
-	for _, route := range s.tunnelRoutes {
+	for _, key := range slices.Sorted(maps.Keys(s.tunnelRoutes)) {
+		route := s.tunnelRoutes[key]
 		if (tunnelID == "" || route.TunnelID == tunnelID) &&
 			(vnetID == "" || route.VirtualNetworkID == vnetID) {
 			routes = append(routes, route)
@@ -664,6 +761,7 @@ func (s *Store) ListAccessGroups() []*models.AccessGroup {
 	for _, group := range s.accessGroups {
 		groups = append(groups, group)
 	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
 	return groups
 }
 
@@ -720,6 +818,20 @@ func (s *Store) GetAccessServiceTokenByName(name string) (*models.AccessServiceT
 	return nil, false
 }
 
+// ListAccessServiceTokens returns all access service tokens sorted by name, without client secrets.
+func (s *Store) ListAccessServiceTokens() []*models.AccessServiceToken {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tokens := make([]*models.AccessServiceToken, 0, len(s.accessServiceTokens))
+	for _, token := range s.accessServiceTokens {
+		copied := *token
+		copied.ClientSecret = ""
+		tokens = append(tokens, &copied)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Name < tokens[j].Name })
+	return tokens
+}
+
 // UpdateAccessServiceToken updates an access service token.
 func (s *Store) UpdateAccessServiceToken(id string, update func(*models.AccessServiceToken)) bool {
 	s.mu.Lock()
@@ -773,6 +885,18 @@ func (s *Store) GetAccessIdentityProviderByName(name string) (*models.AccessIden
 	return nil, false
 }
 
+// ListAccessIdentityProviders returns all access identity providers sorted by name.
+func (s *Store) ListAccessIdentityProviders() []*models.AccessIdentityProvider {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	idps := make([]*models.AccessIdentityProvider, 0, len(s.accessIdentityProviders))
+	for _, idp := range s.accessIdentityProviders {
+		idps = append(idps, idp)
+	}
+	sort.Slice(idps, func(i, j int) bool { return idps[i].Name < idps[j].Name })
+	return idps
+}
+
 // UpdateAccessIdentityProvider updates an access identity provider.
 func (s *Store) UpdateAccessIdentityProvider(id string, update func(*models.AccessIdentityProvider)) bool {
 	s.mu.Lock()
@@ -797,6 +921,177 @@ func (s *Store) DeleteAccessIdentityProvider(id string) bool {
 	return true
 }
 
+// ---- Access mTLS Certificate Operations ----
+
+// CreateAccessCertificate creates a new Access mTLS certificate.
+func (s *Store) CreateAccessCertificate(cert *models.AccessMutualTLSCertificate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessCertificates[cert.ID] = cert
+}
+
+// GetAccessCertificate retrieves an Access mTLS certificate by ID.
+func (s *Store) GetAccessCertificate(id string) (*models.AccessMutualTLSCertificate, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cert, ok := s.accessCertificates[id]
+	return cert, ok
+}
+
+// ListAccessCertificates returns all Access mTLS certificates.
+func (s *Store) ListAccessCertificates() []*models.AccessMutualTLSCertificate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	certs := make([]*models.AccessMutualTLSCertificate, 0, len(s.accessCertificates))
+	for _, cert := range s.accessCertificates {
+		certs = append(certs, cert)
+	}
+	return certs
+}
+
+// UpdateAccessCertificate updates an Access mTLS certificate.
+func (s *Store) UpdateAccessCertificate(id string, update func(*models.AccessMutualTLSCertificate)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cert, ok := s.accessCertificates[id]
+	if !ok {
+		return false
+	}
+	update(cert)
+	cert.UpdatedAt = time.Now()
+	return true
+}
+
+// DeleteAccessCertificate deletes an Access mTLS certificate.
+func (s *Store) DeleteAccessCertificate(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.accessCertificates[id]; !ok {
+		return false
+	}
+	delete(s.accessCertificates, id)
+	return true
+}
+
+// ---- Access Custom Page Operations ----
+
+// CreateAccessCustomPage creates a new Access custom page.
+func (s *Store) CreateAccessCustomPage(page *models.AccessCustomPage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessCustomPages[page.UID] = page
+}
+
+// GetAccessCustomPage retrieves an Access custom page by UID.
+func (s *Store) GetAccessCustomPage(uid string) (*models.AccessCustomPage, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	page, ok := s.accessCustomPages[uid]
+	return page, ok
+}
+
+// ListAccessCustomPages returns all Access custom pages.
+func (s *Store) ListAccessCustomPages() []*models.AccessCustomPage {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pages := make([]*models.AccessCustomPage, 0, len(s.accessCustomPages))
+	for _, page := range s.accessCustomPages {
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// UpdateAccessCustomPage updates an Access custom page.
+func (s *Store) UpdateAccessCustomPage(uid string, update func(*models.AccessCustomPage)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	page, ok := s.accessCustomPages[uid]
+	if !ok {
+		return false
+	}
+	update(page)
+	page.UpdatedAt = time.Now()
+	return true
+}
+
+// DeleteAccessCustomPage deletes an Access custom page.
+func (s *Store) DeleteAccessCustomPage(uid string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.accessCustomPages[uid]; !ok {
+		return false
+	}
+	delete(s.accessCustomPages, uid)
+	return true
+}
+
+// ---- Access Tag Operations ----
+
+// CreateAccessTag creates a new Access tag.
+func (s *Store) CreateAccessTag(tag *models.AccessTag) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessTags[tag.Name] = tag
+}
+
+// GetAccessTag retrieves an Access tag by name.
+func (s *Store) GetAccessTag(name string) (*models.AccessTag, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tag, ok := s.accessTags[name]
+	return tag, ok
+}
+
+// ListAccessTags returns all Access tags.
+func (s *Store) ListAccessTags() []*models.AccessTag {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tags := make([]*models.AccessTag, 0, len(s.accessTags))
+	for _, tag := range s.accessTags {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+	return tags
+}
+
+// DeleteAccessTag deletes an Access tag.
+func (s *Store) DeleteAccessTag(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.accessTags[name]; !ok {
+		return false
+	}
+	delete(s.accessTags, name)
+	return true
+}
+
+// AddSCIMUpdateLog records an Access SCIM update log entry.
+func (s *Store) AddSCIMUpdateLog(entry models.AccessSCIMUpdateLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessSCIMUpdateLogs = append(s.accessSCIMUpdateLogs, entry)
+}
+
+// ListSCIMUpdateLogs returns the SCIM update logs of an identity provider, newest first.
+// An empty idpID returns the logs of all identity providers; limit <= 0 returns all entries.
+func (s *Store) ListSCIMUpdateLogs(idpID string, limit int) []models.AccessSCIMUpdateLog {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make([]models.AccessSCIMUpdateLog, 0, len(s.accessSCIMUpdateLogs))
+	for _, entry := range s.accessSCIMUpdateLogs {
+		if idpID == "" || entry.IdPID == idpID {
+			result = append(result, entry)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].LoggedAt.After(result[j].LoggedAt)
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
 // ---- Gateway Rule Operations ----
 
 // CreateGatewayRule creates a new gateway rule.
@@ -914,6 +1209,76 @@ func (s *Store) DeleteGatewayList(id string) bool {
 	return true
 }
 
+// ---- Gateway Location Operations ----
+
+// CreateGatewayLocation creates a new gateway location.
+// Like Cloudflare, the account keeps a single client default location.
+func (s *Store) CreateGatewayLocation(location *models.GatewayLocation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if location.ClientDefault {
+		s.clearDefaultGatewayLocationLocked(location.ID)
+	}
+	s.gatewayLocations[location.ID] = location
+}
+
+// GetGatewayLocation retrieves a gateway location by ID.
+func (s *Store) GetGatewayLocation(id string) (*models.GatewayLocation, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	location, ok := s.gatewayLocations[id]
+	return location, ok
+}
+
+// ListGatewayLocations lists all gateway locations.
+func (s *Store) ListGatewayLocations() []*models.GatewayLocation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	locations := make([]*models.GatewayLocation, 0, len(s.gatewayLocations))
+	for _, location := range s.gatewayLocations {
+		locations = append(locations, location)
+	}
+	return locations
+}
+
+// UpdateGatewayLocation updates a gateway location.
+// Making a location the client default clears the flag on the previous default.
+func (s *Store) UpdateGatewayLocation(id string, update func(*models.GatewayLocation)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	location, ok := s.gatewayLocations[id]
+	if !ok {
+		return false
+	}
+	update(location)
+	if location.ClientDefault {
+		s.clearDefaultGatewayLocationLocked(id)
+	}
+	location.UpdatedAt = time.Now()
+	return true
+}
+
+// DeleteGatewayLocation deletes a gateway location.
+func (s *Store) DeleteGatewayLocation(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.gatewayLocations[id]; !ok {
+		return false
+	}
+	delete(s.gatewayLocations, id)
+	return true
+}
+
+// clearDefaultGatewayLocationLocked clears the client default flag on all locations except id.
+// The caller must hold the write lock.
+func (s *Store) clearDefaultGatewayLocationLocked(id string) {
+	for otherID, other := range s.gatewayLocations {
+		if otherID != id {
+			other.ClientDefault = false
+		}
+	}
+}
+
 // ---- Gateway Configuration Operations ----
 
 // GetGatewayConfiguration retrieves the gateway configuration.
@@ -959,6 +1324,18 @@ func (s *Store) GetDevicePostureRuleByName(name string) (*models.DevicePostureRu
 	return nil, false
 }
 
+// ListDevicePostureRules returns all device posture rules sorted by name.
+func (s *Store) ListDevicePostureRules() []*models.DevicePostureRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rules := make([]*models.DevicePostureRule, 0, len(s.devicePostureRules))
+	for _, rule := range s.devicePostureRules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules
+}
+
 // UpdateDevicePostureRule updates a device posture rule.
 func (s *Store) UpdateDevicePostureRule(id string, update func(*models.DevicePostureRule)) bool {
 	s.mu.Lock()
@@ -1088,6 +1465,470 @@ func (s *Store) UpdateR2BucketLifecycle(bucketName string, lifecycle interface{}
 	s.r2BucketLifecycle[bucketName] = lifecycle
 }
 
+// ---- R2 Custom Domain Operations ----
+
+func r2CustomDomainKey(bucketName, domain string) string {
+	return bucketName + "/" + domain
+}
+
+// CreateR2CustomDomain attaches a custom domain to an R2 bucket.
+func (s *Store) CreateR2CustomDomain(bucketName string, domain *models.R2CustomDomain) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.r2CustomDomains[r2CustomDomainKey(bucketName, domain.Domain)] = domain
+}
+
+// GetR2CustomDomain retrieves a custom domain of an R2 bucket.
+func (s *Store) GetR2CustomDomain(bucketName, domain string) (*models.R2CustomDomain, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	customDomain, ok := s.r2CustomDomains[r2CustomDomainKey(bucketName, domain)]
+	if !ok {
+		return nil, false
+	}
+	copied := *customDomain
+	return &copied, true
+}
+
+// UpdateR2CustomDomain applies update to a custom domain of an R2 bucket.
+func (s *Store) UpdateR2CustomDomain(bucketName, domain string, update func(*models.R2CustomDomain)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	customDomain, ok := s.r2CustomDomains[r2CustomDomainKey(bucketName, domain)]
+	if !ok {
+		return false
+	}
+	update(customDomain)
+	return true
+}
+
+// SetR2CustomDomainStatus sets the ownership and SSL status of a custom domain,
+// as Cloudflare does while it verifies the domain.
+func (s *Store) SetR2CustomDomainStatus(bucketName, domain, ownership, ssl string) bool {
+	return s.UpdateR2CustomDomain(bucketName, domain, func(d *models.R2CustomDomain) {
+		d.Status = models.R2CustomDomainStatus{Ownership: ownership, SSL: ssl}
+	})
+}
+
+// DeleteR2CustomDomain removes a custom domain from an R2 bucket.
+func (s *Store) DeleteR2CustomDomain(bucketName, domain string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := r2CustomDomainKey(bucketName, domain)
+	if _, ok := s.r2CustomDomains[key]; !ok {
+		return false
+	}
+	delete(s.r2CustomDomains, key)
+	return true
+}
+
+// ---- R2 Event Notification Operations ----
+
+// SetR2Notification replaces the notification rules of an R2 bucket for a queue.
+func (s *Store) SetR2Notification(bucketName, queueID string, rules []models.R2NotificationRule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.r2Notifications[bucketName] == nil {
+		s.r2Notifications[bucketName] = make(map[string][]models.R2NotificationRule)
+	}
+	s.r2Notifications[bucketName][queueID] = slices.Clone(rules)
+}
+
+// GetR2Notifications returns the notification rules of an R2 bucket by queue ID.
+func (s *Store) GetR2Notifications(bucketName string) map[string][]models.R2NotificationRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result := make(map[string][]models.R2NotificationRule, len(s.r2Notifications[bucketName]))
+	for queueID, rules := range s.r2Notifications[bucketName] {
+		result[queueID] = slices.Clone(rules)
+	}
+	return result
+}
+
+// DeleteR2Notification removes the notification rules of an R2 bucket for a queue.
+func (s *Store) DeleteR2Notification(bucketName, queueID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.r2Notifications[bucketName][queueID]; !ok {
+		return false
+	}
+	delete(s.r2Notifications[bucketName], queueID)
+	return true
+}
+
+// ---- Queue Operations ----
+
+// CreateQueue creates a new queue.
+func (s *Store) CreateQueue(queue *models.Queue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queues[queue.ID] = queue
+}
+
+// GetQueue retrieves a copy of a queue by ID.
+func (s *Store) GetQueue(id string) (*models.Queue, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	queue, ok := s.queues[id]
+	if !ok {
+		return nil, false
+	}
+	return copyQueue(queue), true
+}
+
+// GetQueueByName retrieves a copy of a queue by name.
+func (s *Store) GetQueueByName(name string) (*models.Queue, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, queue := range s.queues {
+		if queue.Name == name {
+			return copyQueue(queue), true
+		}
+	}
+	return nil, false
+}
+
+// ListQueues returns copies of all queues.
+func (s *Store) ListQueues() []*models.Queue {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	queues := make([]*models.Queue, 0, len(s.queues))
+	for _, queue := range s.queues {
+		queues = append(queues, copyQueue(queue))
+	}
+	return queues
+}
+
+// UpdateQueue applies update to a queue.
+func (s *Store) UpdateQueue(id string, update func(*models.Queue)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queue, ok := s.queues[id]
+	if !ok {
+		return false
+	}
+	update(queue)
+	queue.ModifiedOn = time.Now()
+	return true
+}
+
+// DeleteQueue deletes a queue with its consumers.
+func (s *Store) DeleteQueue(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.queues[id]; !ok {
+		return false
+	}
+	delete(s.queues, id)
+	return true
+}
+
+// AddQueueConsumer adds a consumer to a queue.
+func (s *Store) AddQueueConsumer(queueID string, consumer models.QueueConsumer) bool {
+	return s.UpdateQueue(queueID, func(queue *models.Queue) {
+		queue.Consumers = append(queue.Consumers, consumer)
+		queue.ConsumersTotalCount = len(queue.Consumers)
+	})
+}
+
+// UpdateQueueConsumer applies update to a consumer of a queue.
+func (s *Store) UpdateQueueConsumer(queueID, consumerID string, update func(*models.QueueConsumer)) bool {
+	found := false
+	s.UpdateQueue(queueID, func(queue *models.Queue) {
+		for i := range queue.Consumers {
+			if queue.Consumers[i].ID == consumerID {
+				update(&queue.Consumers[i])
+				found = true
+			}
+		}
+	})
+	return found
+}
+
+// DeleteQueueConsumer removes a consumer from a queue.
+func (s *Store) DeleteQueueConsumer(queueID, consumerID string) bool {
+	found := false
+	s.UpdateQueue(queueID, func(queue *models.Queue) {
+		queue.Consumers = slices.DeleteFunc(queue.Consumers, func(c models.QueueConsumer) bool {
+			if c.ID == consumerID {
+				found = true
+				return true
+			}
+			return false
+		})
+		queue.ConsumersTotalCount = len(queue.Consumers)
+	})
+	return found
+}
+
+func copyQueue(queue *models.Queue) *models.Queue {
+	copied := *queue
+	copied.Consumers = slices.Clone(queue.Consumers)
+	return &copied
+}
+
+// ---- D1 Database Operations ----
+
+// CreateD1Database creates a new D1 database.
+func (s *Store) CreateD1Database(db *models.D1Database) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.d1Databases[db.UUID] = db
+}
+
+// GetD1Database retrieves a copy of a D1 database by ID.
+func (s *Store) GetD1Database(id string) (*models.D1Database, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	db, ok := s.d1Databases[id]
+	if !ok {
+		return nil, false
+	}
+	copied := *db
+	return &copied, true
+}
+
+// ListD1Databases returns copies of the D1 databases whose names contain name,
+// sorted by name. An empty name returns all databases.
+func (s *Store) ListD1Databases(name string) []*models.D1Database {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	databases := make([]*models.D1Database, 0, len(s.d1Databases))
+	for _, db := range s.d1Databases {
+		if strings.Contains(db.Name, name) {
+			copied := *db
+			databases = append(databases, &copied)
+		}
+	}
+	sort.Slice(databases, func(i, j int) bool { return databases[i].Name < databases[j].Name })
+	return databases
+}
+
+// DeleteD1Database deletes a D1 database.
+func (s *Store) DeleteD1Database(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.d1Databases[id]; !ok {
+		return false
+	}
+	delete(s.d1Databases, id)
+	return true
+}
+
+// ---- Workers KV Namespace Operations ----
+
+// CreateKVNamespace creates a new KV namespace.
+func (s *Store) CreateKVNamespace(ns *models.KVNamespace) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kvNamespaces[ns.ID] = ns
+}
+
+// GetKVNamespace retrieves a copy of a KV namespace by ID.
+func (s *Store) GetKVNamespace(id string) (*models.KVNamespace, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ns, ok := s.kvNamespaces[id]
+	if !ok {
+		return nil, false
+	}
+	copied := *ns
+	return &copied, true
+}
+
+// GetKVNamespaceByTitle retrieves a copy of a KV namespace by title.
+func (s *Store) GetKVNamespaceByTitle(title string) (*models.KVNamespace, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, ns := range s.kvNamespaces {
+		if ns.Title == title {
+			copied := *ns
+			return &copied, true
+		}
+	}
+	return nil, false
+}
+
+// ListKVNamespaces returns copies of all KV namespaces, sorted by title.
+func (s *Store) ListKVNamespaces() []*models.KVNamespace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	namespaces := make([]*models.KVNamespace, 0, len(s.kvNamespaces))
+	for _, ns := range s.kvNamespaces {
+		copied := *ns
+		namespaces = append(namespaces, &copied)
+	}
+	sort.Slice(namespaces, func(i, j int) bool { return namespaces[i].Title < namespaces[j].Title })
+	return namespaces
+}
+
+// RenameKVNamespace changes the title of a KV namespace.
+func (s *Store) RenameKVNamespace(id, title string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ns, ok := s.kvNamespaces[id]
+	if !ok {
+		return false
+	}
+	ns.Title = title
+	return true
+}
+
+// DeleteKVNamespace deletes a KV namespace.
+func (s *Store) DeleteKVNamespace(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.kvNamespaces[id]; !ok {
+		return false
+	}
+	delete(s.kvNamespaces, id)
+	return true
+}
+
+// ---- Hyperdrive Config Operations ----
+
+// CreateHyperdriveConfig creates a new Hyperdrive config.
+func (s *Store) CreateHyperdriveConfig(config *models.HyperdriveConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hyperdriveConfigs[config.ID] = config
+}
+
+// GetHyperdriveConfig retrieves a copy of a Hyperdrive config by ID.
+func (s *Store) GetHyperdriveConfig(id string) (*models.HyperdriveConfig, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	config, ok := s.hyperdriveConfigs[id]
+	if !ok {
+		return nil, false
+	}
+	copied := *config
+	return &copied, true
+}
+
+// GetHyperdriveConfigByName retrieves a copy of a Hyperdrive config by name.
+func (s *Store) GetHyperdriveConfigByName(name string) (*models.HyperdriveConfig, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, config := range s.hyperdriveConfigs {
+		if config.Name == name {
+			copied := *config
+			return &copied, true
+		}
+	}
+	return nil, false
+}
+
+// ListHyperdriveConfigs returns copies of all Hyperdrive configs, sorted by name.
+func (s *Store) ListHyperdriveConfigs() []*models.HyperdriveConfig {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	configs := make([]*models.HyperdriveConfig, 0, len(s.hyperdriveConfigs))
+	for _, config := range s.hyperdriveConfigs {
+		copied := *config
+		configs = append(configs, &copied)
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Name < configs[j].Name })
+	return configs
+}
+
+// UpdateHyperdriveConfig replaces a Hyperdrive config, keeping its ID and creation time.
+func (s *Store) UpdateHyperdriveConfig(id string, config *models.HyperdriveConfig) (*models.HyperdriveConfig, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.hyperdriveConfigs[id]
+	if !ok {
+		return nil, false
+	}
+	config.ID = id
+	config.CreatedOn = existing.CreatedOn
+	s.hyperdriveConfigs[id] = config
+	copied := *config
+	return &copied, true
+}
+
+// DeleteHyperdriveConfig deletes a Hyperdrive config.
+func (s *Store) DeleteHyperdriveConfig(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.hyperdriveConfigs[id]; !ok {
+		return false
+	}
+	delete(s.hyperdriveConfigs, id)
+	return true
+}
+
+// ---- Web Analytics Site Operations ----
+
+// CreateRUMSite creates a new Web Analytics site.
+func (s *Store) CreateRUMSite(site *models.RUMSite) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rumSites[site.SiteTag] = site
+}
+
+// GetRUMSite retrieves a copy of a Web Analytics site by site tag.
+func (s *Store) GetRUMSite(siteTag string) (*models.RUMSite, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	site, ok := s.rumSites[siteTag]
+	if !ok {
+		return nil, false
+	}
+	copied := *site
+	return &copied, true
+}
+
+// GetRUMSiteByHost retrieves a copy of a Web Analytics site by hostname.
+func (s *Store) GetRUMSiteByHost(host string) (*models.RUMSite, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, site := range s.rumSites {
+		if site.Host == host {
+			copied := *site
+			return &copied, true
+		}
+	}
+	return nil, false
+}
+
+// ListRUMSites returns copies of all Web Analytics sites, sorted by hostname.
+func (s *Store) ListRUMSites() []*models.RUMSite {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sites := make([]*models.RUMSite, 0, len(s.rumSites))
+	for _, site := range s.rumSites {
+		copied := *site
+		sites = append(sites, &copied)
+	}
+	sort.Slice(sites, func(i, j int) bool { return sites[i].Host < sites[j].Host })
+	return sites
+}
+
+// UpdateRUMSite sets whether the script of a Web Analytics site is injected automatically.
+func (s *Store) UpdateRUMSite(siteTag string, autoInstall bool) (*models.RUMSite, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	site, ok := s.rumSites[siteTag]
+	if !ok {
+		return nil, false
+	}
+	site.AutoInstall = autoInstall
+	copied := *site
+	return &copied, true
+}
+
+// DeleteRUMSite deletes a Web Analytics site.
+func (s *Store) DeleteRUMSite(siteTag string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rumSites[siteTag]; !ok {
+		return false
+	}
+	delete(s.rumSites, siteTag)
+	return true
+}
+
 // ---- Zone Ruleset Operations ----
 
 // CreateZoneRuleset creates a new zone ruleset.
@@ -1154,6 +1995,217 @@ func (s *Store) DeleteZoneRuleset(id string) bool {
 	return true
 }
 
+// ---- Pages Domain Operations ----
+
+func pagesDomainKey(projectName, domain string) string {
+	return projectName + "/" + domain
+}
+
+// CreatePagesDomain adds a custom domain to a Pages project.
+func (s *Store) CreatePagesDomain(projectName string, domain *models.PagesDomain) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pagesDomains[pagesDomainKey(projectName, domain.Name)] = domain
+}
+
+// GetPagesDomain retrieves a custom domain of a Pages project.
+func (s *Store) GetPagesDomain(projectName, domain string) (*models.PagesDomain, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pagesDomain, ok := s.pagesDomains[pagesDomainKey(projectName, domain)]
+	if !ok {
+		return nil, false
+	}
+	copied := *pagesDomain
+	return &copied, true
+}
+
+// ListPagesDomains returns the custom domains of a Pages project, sorted by name.
+func (s *Store) ListPagesDomains(projectName string) []*models.PagesDomain {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	domains := make([]*models.PagesDomain, 0)
+	for key, domain := range s.pagesDomains {
+		if strings.HasPrefix(key, projectName+"/") {
+			copied := *domain
+			domains = append(domains, &copied)
+		}
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Name < domains[j].Name })
+	return domains
+}
+
+// UpdatePagesDomain applies update to a custom domain of a Pages project.
+func (s *Store) UpdatePagesDomain(projectName, domain string, update func(*models.PagesDomain)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pagesDomain, ok := s.pagesDomains[pagesDomainKey(projectName, domain)]
+	if !ok {
+		return false
+	}
+	update(pagesDomain)
+	return true
+}
+
+// SetPagesDomainStatus sets the status of a custom domain and of its ownership
+// verification and certificate validation, as Cloudflare does while it
+// verifies the domain.
+func (s *Store) SetPagesDomainStatus(projectName, domain, status, verification, validation string) bool {
+	return s.UpdatePagesDomain(projectName, domain, func(d *models.PagesDomain) {
+		d.Status = status
+		d.VerificationData.Status = verification
+		d.ValidationData.Status = validation
+	})
+}
+
+// DeletePagesDomain removes a custom domain from a Pages project.
+func (s *Store) DeletePagesDomain(projectName, domain string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := pagesDomainKey(projectName, domain)
+	if _, ok := s.pagesDomains[key]; !ok {
+		return false
+	}
+	delete(s.pagesDomains, key)
+	return true
+}
+
+// ---- Pages Deployment Operations ----
+
+// CreatePagesDeployment creates a new Pages deployment.
+func (s *Store) CreatePagesDeployment(deployment *models.PagesDeployment) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pagesDeployments[deployment.ID] = deployment
+}
+
+// GetPagesDeployment retrieves a Pages deployment by project name and ID.
+func (s *Store) GetPagesDeployment(projectName, id string) (*models.PagesDeployment, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	deployment, ok := s.pagesDeployments[id]
+	if !ok || deployment.ProjectName != projectName {
+		return nil, false
+	}
+	return deployment, true
+}
+
+// PollPagesDeployment retrieves a Pages deployment like GetPagesDeployment and
+// counts the request as a poll, advancing the deployment's stage according to
+// the configured progression.
+func (s *Store) PollPagesDeployment(projectName, id string) (*models.PagesDeployment, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deployment, ok := s.pagesDeployments[id]
+	if !ok || deployment.ProjectName != projectName {
+		return nil, false
+	}
+	s.pagesDeploymentPolls[id]++
+	s.advancePagesDeployment(deployment, s.pagesDeploymentPolls[id])
+	return deployment, true
+}
+
+// SetPagesDeploymentProgression configures how in-progress Pages deployments advance.
+func (s *Store) SetPagesDeploymentProgression(progression models.PagesDeploymentProgression) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pagesProgression = progression
+}
+
+// advancePagesDeployment moves an in-progress deployment to the stage it has
+// reached after polls polls. Deployments never move back a stage, and finished
+// deployments are left unchanged. Must be called with the lock held.
+func (s *Store) advancePagesDeployment(deployment *models.PagesDeployment, polls int) {
+	progression := s.pagesProgression
+	if !progression.Enabled() || deployment.LatestStage.Status != "active" {
+		return
+	}
+	stages := progression.Stages
+	if len(stages) == 0 {
+		stages = models.DefaultPagesDeploymentStages
+	}
+	finalStatus := progression.FinalStatus
+	if finalStatus == "" {
+		finalStatus = "success"
+	}
+
+	reached := 0
+	if progression.AdvancePolls > 0 {
+		reached = polls / progression.AdvancePolls
+	}
+	if progression.AdvanceAfter > 0 {
+		reached = max(reached, int(time.Since(deployment.CreatedOn)/progression.AdvanceAfter))
+	}
+	if current := slices.Index(stages, deployment.LatestStage.Name); current > reached {
+		reached = current
+	}
+
+	deployment.Stages = make([]models.PagesDeploymentStage, len(stages))
+	for i, name := range stages {
+		status := "idle"
+		switch {
+		case i < reached:
+			status = "success"
+		case i == reached:
+			status = "active"
+		}
+		deployment.Stages[i] = models.PagesDeploymentStage{Name: name, Status: status}
+	}
+	if reached >= len(stages) {
+		deployment.Stages[len(stages)-1].Status = finalStatus
+	}
+	deployment.LatestStage = deployment.Stages[min(reached, len(stages)-1)]
+}
+
+// ListPagesDeployments returns all deployments of a Pages project, newest first.
+func (s *Store) ListPagesDeployments(projectName string) []*models.PagesDeployment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	deployments := make([]*models.PagesDeployment, 0)
+	for _, d := range s.pagesDeployments {
+		if d.ProjectName == projectName {
+			deployments = append(deployments, d)
+		}
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		return deployments[i].CreatedOn.After(deployments[j].CreatedOn)
+	})
+	return deployments
+}
+
+// UpdatePagesDeployment updates a Pages deployment.
+func (s *Store) UpdatePagesDeployment(id string, update func(*models.PagesDeployment)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deployment, ok := s.pagesDeployments[id]
+	if !ok {
+		return false
+	}
+	update(deployment)
+	return true
+}
+
+// SetPagesDeploymentLogs replaces the build log lines of a Pages deployment.
+func (s *Store) SetPagesDeploymentLogs(id string, lines []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := make([]models.PagesDeploymentLogEntry, 0, len(lines))
+	now := time.Now()
+	for _, line := range lines {
+		entries = append(entries, models.PagesDeploymentLogEntry{Timestamp: now, Line: line})
+	}
+	s.pagesDeploymentLogs[id] = entries
+}
+
+// GetPagesDeploymentLogs returns the build log lines of a Pages deployment.
+func (s *Store) GetPagesDeploymentLogs(id string) []models.PagesDeploymentLogEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make([]models.PagesDeploymentLogEntry, len(s.pagesDeploymentLogs[id]))
+	copy(entries, s.pagesDeploymentLogs[id])
+	return entries
+}
+
 // generateUUID generates a random UUID-like string.
 func generateUUID() string {
 	b := make([]byte, 16)
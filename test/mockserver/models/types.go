@@ -138,12 +138,13 @@ type VirtualNetwork struct {
 
 // TunnelRoute represents a Tunnel Route.
 type TunnelRoute struct {
-	Network          string    `json:"network"`
-	TunnelID         string    `json:"tunnel_id"`
-	TunnelName       string    `json:"tunnel_name"`
-	VirtualNetworkID string    `json:"virtual_network_id"`
-	Comment          string    `json:"comment"`
-	CreatedAt        time.Time `json:"created_at"`
+	Network          string     `json:"network"`
+	TunnelID         string     `json:"tunnel_id"`
+	TunnelName       string     `json:"tunnel_name"`
+	VirtualNetworkID string     `json:"virtual_network_id"`
+	Comment          string     `json:"comment"`
+	CreatedAt        time.Time  `json:"created_at"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
 }
 
 // AccessApplication represents an Access Application.
@@ -152,6 +153,7 @@ type AccessApplication struct {
 	Name                    string              `json:"name"`
 	Domain                  string              `json:"domain"`
 	Type                    string              `json:"type"`
+	AUD                     string              `json:"aud"`
 	SessionDuration         string              `json:"session_duration"`
 	AutoRedirectToIdentity  bool                `json:"auto_redirect_to_identity"`
 	EnableBindingCookie     bool                `json:"enable_binding_cookie"`
@@ -168,6 +170,8 @@ type AccessApplication struct {
 	Policies                []AccessPolicy      `json:"policies,omitempty"`
 	SelfHostedDomains       []string            `json:"self_hosted_domains,omitempty"`
 	Destinations            []AccessDestination `json:"destinations,omitempty"`
+	CustomPages             []string            `json:"custom_pages,omitempty"`
+	Tags                    []string            `json:"tags,omitempty"`
 }
 
 // AccessDestination represents an Access Application destination.
@@ -185,8 +189,10 @@ type AccessPolicy struct {
 	Include    []AccessRule `json:"include"`
 	Exclude    []AccessRule `json:"exclude,omitempty"`
 	Require    []AccessRule `json:"require,omitempty"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
+	// SessionDuration defaults to 24h like the Cloudflare API.
+	SessionDuration string    `json:"session_duration,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 // AccessRule represents an Access Policy rule.
@@ -258,12 +264,65 @@ type AccessServiceToken struct {
 
 // AccessIdentityProvider represents an Access Identity Provider.
 type AccessIdentityProvider struct {
-	ID        string                 `json:"id"`
-	Name      string                 `json:"name"`
-	Type      string                 `json:"type"`
-	Config    map[string]interface{} `json:"config"`
-	CreatedAt time.Time              `json:"created_at"`
-	UpdatedAt time.Time              `json:"updated_at"`
+	ID         string                            `json:"id"`
+	Name       string                            `json:"name"`
+	Type       string                            `json:"type"`
+	Config     map[string]interface{}            `json:"config"`
+	ScimConfig *AccessIdentityProviderScimConfig `json:"scim_config,omitempty"`
+	CreatedAt  time.Time                         `json:"created_at"`
+	UpdatedAt  time.Time                         `json:"updated_at"`
+}
+
+// AccessIdentityProviderScimConfig represents the SCIM configuration of an Identity Provider.
+type AccessIdentityProviderScimConfig struct {
+	Enabled                bool   `json:"enabled"`
+	Secret                 string `json:"secret,omitempty"`
+	UserDeprovision        bool   `json:"user_deprovision,omitempty"`
+	SeatDeprovision        bool   `json:"seat_deprovision,omitempty"`
+	GroupMemberDeprovision bool   `json:"group_member_deprovision,omitempty"`
+	IdentityUpdateBehavior string `json:"identity_update_behavior,omitempty"`
+	ScimBaseURL            string `json:"scim_base_url,omitempty"`
+}
+
+// AccessSCIMUpdateLog represents an entry of the Access SCIM update logs.
+type AccessSCIMUpdateLog struct {
+	IdPID            string    `json:"idp_id"`
+	ResourceType     string    `json:"resource_type"`
+	RequestMethod    string    `json:"request_method"`
+	Status           string    `json:"status"`
+	ErrorDescription string    `json:"error_description,omitempty"`
+	LoggedAt         time.Time `json:"logged_at"`
+}
+
+// AccessMutualTLSCertificate represents an Access mTLS certificate.
+type AccessMutualTLSCertificate struct {
+	ID                  string    `json:"id"`
+	Name                string    `json:"name"`
+	Certificate         string    `json:"certificate,omitempty"`
+	Fingerprint         string    `json:"fingerprint"`
+	AssociatedHostnames []string  `json:"associated_hostnames"`
+	ExpiresOn           time.Time `json:"expires_on"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// AccessCustomPage represents an Access custom page.
+type AccessCustomPage struct {
+	UID        string    `json:"uid"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	CustomHTML string    `json:"custom_html,omitempty"`
+	AppCount   int       `json:"app_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// AccessTag represents an Access application tag.
+type AccessTag struct {
+	Name      string    `json:"name"`
+	AppCount  int       `json:"app_count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 // GatewayRule represents a Gateway Rule.
@@ -301,6 +360,28 @@ type GatewayListItem struct {
 	CreatedAt   time.Time `json:"created_at"`
 }
 
+// GatewayLocation represents a Gateway DNS Location.
+type GatewayLocation struct {
+	ID                  string                   `json:"id"`
+	Name                string                   `json:"name"`
+	Networks            []GatewayLocationNetwork `json:"networks"`
+	IP                  string                   `json:"ip,omitempty"`
+	DoHSubdomain        string                   `json:"doh_subdomain"`
+	ClientDefault       bool                     `json:"client_default"`
+	ECSSupport          *bool                    `json:"ecs_support,omitempty"`
+	DNSDestinationIPsID *string                  `json:"dns_destination_ips_id,omitempty"`
+	PolicyIDs           []string                 `json:"policy_ids"`
+	Endpoints           map[string]interface{}   `json:"endpoints,omitempty"`
+	CreatedAt           time.Time                `json:"created_at"`
+	UpdatedAt           time.Time                `json:"updated_at"`
+}
+
+// GatewayLocationNetwork represents a source network of a Gateway Location.
+type GatewayLocationNetwork struct {
+	ID      string `json:"id,omitempty"`
+	Network string `json:"network"`
+}
+
 // GatewayConfiguration represents the Gateway Configuration.
 type GatewayConfiguration struct {
 	Settings GatewaySettings `json:"settings"`
@@ -410,6 +491,220 @@ type R2Bucket struct {
 	Location     string    `json:"location,omitempty"`
 }
 
+// R2CustomDomain represents a custom domain attached to an R2 bucket.
+type R2CustomDomain struct {
+	Domain   string               `json:"domain"`
+	Enabled  bool                 `json:"enabled"`
+	Status   R2CustomDomainStatus `json:"status"`
+	MinTLS   string               `json:"minTLS,omitempty"`
+	ZoneID   string               `json:"zoneId,omitempty"`
+	ZoneName string               `json:"zoneName,omitempty"`
+}
+
+// R2CustomDomainStatus is the ownership and SSL status of an R2 custom domain.
+type R2CustomDomainStatus struct {
+	Ownership string `json:"ownership"`
+	SSL       string `json:"ssl"`
+}
+
+// R2NotificationRule is an event notification rule of an R2 bucket.
+type R2NotificationRule struct {
+	RuleID      string   `json:"ruleId,omitempty"`
+	Prefix      string   `json:"prefix,omitempty"`
+	Suffix      string   `json:"suffix,omitempty"`
+	EventTypes  []string `json:"eventType"`
+	Description string   `json:"description,omitempty"`
+}
+
+// Queue represents a Cloudflare Queue.
+type Queue struct {
+	ID                  string          `json:"queue_id"`
+	Name                string          `json:"queue_name"`
+	CreatedOn           time.Time       `json:"created_on"`
+	ModifiedOn          time.Time       `json:"modified_on"`
+	Settings            QueueSettings   `json:"settings"`
+	ConsumersTotalCount int             `json:"consumers_total_count"`
+	Consumers           []QueueConsumer `json:"consumers"`
+}
+
+// QueueSettings contains the settings of a queue.
+type QueueSettings struct {
+	DeliveryDelay          *int `json:"delivery_delay,omitempty"`
+	MessageRetentionPeriod *int `json:"message_retention_period,omitempty"`
+}
+
+// QueueConsumer represents a consumer of a queue. Like the Cloudflare API, it
+// names the Worker of a consumer in Script.
+type QueueConsumer struct {
+	ID              string                `json:"consumer_id"`
+	Type            string                `json:"type"`
+	Script          string                `json:"script,omitempty"`
+	DeadLetterQueue string                `json:"dead_letter_queue,omitempty"`
+	Settings        QueueConsumerSettings `json:"settings"`
+	CreatedOn       time.Time             `json:"created_on"`
+}
+
+// QueueConsumerSettings contains the delivery settings of a queue consumer.
+type QueueConsumerSettings struct {
+	BatchSize           *int `json:"batch_size,omitempty"`
+	MaxRetries          *int `json:"max_retries,omitempty"`
+	MaxWaitTimeMs       *int `json:"max_wait_time_ms,omitempty"`
+	MaxConcurrency      *int `json:"max_concurrency,omitempty"`
+	VisibilityTimeoutMs *int `json:"visibility_timeout_ms,omitempty"`
+	RetryDelay          *int `json:"retry_delay,omitempty"`
+}
+
+// D1Database represents a D1 database.
+type D1Database struct {
+	UUID                string    `json:"uuid"`
+	Name                string    `json:"name"`
+	Version             string    `json:"version"`
+	NumTables           int       `json:"num_tables"`
+	FileSize            int64     `json:"file_size"`
+	CreatedAt           time.Time `json:"created_at"`
+	PrimaryLocationHint string    `json:"-"`
+}
+
+// KVNamespace represents a Workers KV namespace.
+type KVNamespace struct {
+	ID                  string `json:"id"`
+	Title               string `json:"title"`
+	SupportsURLEncoding bool   `json:"supports_url_encoding"`
+}
+
+// HyperdriveConfig represents a Hyperdrive configuration.
+type HyperdriveConfig struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Origin     HyperdriveOrigin  `json:"origin"`
+	Caching    HyperdriveCaching `json:"caching"`
+	CreatedOn  time.Time         `json:"created_on"`
+	ModifiedOn time.Time         `json:"modified_on"`
+	Password   string            `json:"-"`
+}
+
+// HyperdriveOrigin is the origin database of a Hyperdrive configuration.
+// Like the Cloudflare API, responses never contain the password.
+type HyperdriveOrigin struct {
+	Scheme   string `json:"scheme"`
+	Host     string `json:"host"`
+	Port     int    `json:"port,omitempty"`
+	Database string `json:"database"`
+	User     string `json:"user"`
+}
+
+// HyperdriveCaching contains the caching settings of a Hyperdrive configuration.
+type HyperdriveCaching struct {
+	Disabled             bool `json:"disabled"`
+	MaxAge               int  `json:"max_age,omitempty"`
+	StaleWhileRevalidate int  `json:"stale_while_revalidate,omitempty"`
+}
+
+// RUMSite represents a Web Analytics site.
+type RUMSite struct {
+	SiteTag     string    `json:"site_tag"`
+	SiteToken   string    `json:"site_token"`
+	Host        string    `json:"host"`
+	AutoInstall bool      `json:"auto_install"`
+	Created     time.Time `json:"created"`
+}
+
+// PagesDomain represents a custom domain of a Pages project.
+type PagesDomain struct {
+	ID                   string                      `json:"id"`
+	Name                 string                      `json:"name"`
+	Status               string                      `json:"status"`
+	CertificateAuthority string                      `json:"certificate_authority"`
+	ValidationData       PagesDomainValidationData   `json:"validation_data"`
+	VerificationData     PagesDomainVerificationData `json:"verification_data"`
+	ZoneTag              string                      `json:"zone_tag,omitempty"`
+	CreatedOn            time.Time                   `json:"created_on"`
+}
+
+// PagesDomainValidationData is the certificate validation of a Pages custom domain.
+type PagesDomainValidationData struct {
+	Status       string `json:"status"`
+	Method       string `json:"method"`
+	TXTName      string `json:"txt_name,omitempty"`
+	TXTValue     string `json:"txt_value,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// PagesDomainVerificationData is the ownership verification of a Pages custom domain.
+type PagesDomainVerificationData struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// PagesDeployment represents a Pages project deployment.
+type PagesDeployment struct {
+	ID          string                 `json:"id"`
+	ShortID     string                 `json:"short_id"`
+	ProjectName string                 `json:"project_name"`
+	Environment string                 `json:"environment"`
+	URL         string                 `json:"url"`
+	Aliases     []string               `json:"aliases,omitempty"`
+	CreatedOn   time.Time              `json:"created_on"`
+	LatestStage PagesDeploymentStage   `json:"latest_stage"`
+	Stages      []PagesDeploymentStage `json:"stages"`
+	Trigger     PagesDeploymentTrigger `json:"deployment_trigger"`
+}
+
+// PagesDeploymentTrigger describes what caused a Pages deployment.
+type PagesDeploymentTrigger struct {
+	Type     string                         `json:"type"`
+	Metadata PagesDeploymentTriggerMetadata `json:"metadata"`
+}
+
+// PagesDeploymentTriggerMetadata contains the branch and commit of a Pages deployment.
+type PagesDeploymentTriggerMetadata struct {
+	Branch        string `json:"branch"`
+	CommitHash    string `json:"commit_hash,omitempty"`
+	CommitMessage string `json:"commit_message,omitempty"`
+}
+
+// PagesDeploymentStage represents a stage of a Pages deployment.
+type PagesDeploymentStage struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// DefaultPagesDeploymentStages are the stages a Pages deployment goes through.
+var DefaultPagesDeploymentStages = []string{"queued", "initialize", "clone_repo", "build", "deploy"}
+
+// PagesDeploymentProgression configures how in-progress Pages deployments advance
+// through their stages. A deployment advances one stage every AdvancePolls GET
+// requests for it, or every AdvanceAfter since it was created, whichever is further.
+// The last stage then ends with FinalStatus. The zero value disables progression.
+type PagesDeploymentProgression struct {
+	// Stages are the stage names, defaulting to DefaultPagesDeploymentStages.
+	Stages []string
+	// AdvancePolls is the number of polls per stage; zero disables poll-based progression.
+	AdvancePolls int
+	// AdvanceAfter is the duration per stage; zero disables time-based progression.
+	AdvanceAfter time.Duration
+	// FinalStatus is the status of the last stage, "success" (the default) or "failure".
+	FinalStatus string
+}
+
+// Enabled reports whether deployments advance at all.
+func (p PagesDeploymentProgression) Enabled() bool {
+	return p.AdvancePolls > 0 || p.AdvanceAfter > 0
+}
+
+// PagesDeploymentLogs represents the build logs of a Pages deployment.
+type PagesDeploymentLogs struct {
+	Total                 int                       `json:"total"`
+	IncludesContainerLogs bool                      `json:"includes_container_logs"`
+	Data                  []PagesDeploymentLogEntry `json:"data"`
+}
+
+// PagesDeploymentLogEntry represents a single build log line.
+type PagesDeploymentLogEntry struct {
+	Timestamp time.Time `json:"ts"`
+	Line      string    `json:"line"`
+}
+
 // WARPConnector represents a WARP Connector.
 type WARPConnector struct {
 	ID        string    `json:"id"`
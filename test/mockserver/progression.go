@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package mockserver
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/handlers"
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+// PagesProgressionRequest is the payload of POST /admin/pages-progression. It
+// configures how in-progress Pages deployments advance through their stages
+// when polled:
+//
+//	{"advanceAfterPolls": 2, "finalStatus": "success"}
+//
+// An empty object disables progression, leaving deployments in their stage.
+type PagesProgressionRequest struct {
+	// Stages are the stage names, defaulting to queued, initialize, clone_repo, build and deploy.
+	Stages []string `json:"stages,omitempty"`
+	// AdvanceAfterPolls advances a deployment one stage every that many GET requests for it.
+	AdvanceAfterPolls int `json:"advanceAfterPolls,omitempty"`
+	// AdvanceAfter advances a deployment one stage every interval since its creation,
+	// as a Go duration such as "2s".
+	AdvanceAfter string `json:"advanceAfter,omitempty"`
+	// FinalStatus is the status the last stage ends with: "success" (default) or "failure".
+	FinalStatus string `json:"finalStatus,omitempty"`
+}
+
+// PagesProgressionResponse is the result of POST /admin/pages-progression.
+type PagesProgressionResponse struct {
+	// Enabled reports whether deployments advance.
+	Enabled bool `json:"enabled"`
+}
+
+// toProgression validates the request and converts it to a progression.
+func (p *PagesProgressionRequest) toProgression() (models.PagesDeploymentProgression, error) {
+	if p.AdvanceAfterPolls < 0 {
+		return models.PagesDeploymentProgression{}, fmt.Errorf("advanceAfterPolls %d must not be negative", p.AdvanceAfterPolls)
+	}
+	var advanceAfter time.Duration
+	if p.AdvanceAfter != "" {
+		var err error
+		if advanceAfter, err = time.ParseDuration(p.AdvanceAfter); err != nil || advanceAfter < 0 {
+			return models.PagesDeploymentProgression{}, fmt.Errorf("invalid advanceAfter %q", p.AdvanceAfter)
+		}
+	}
+	switch p.FinalStatus {
+	case "", "success", "failure":
+	default:
+		return models.PagesDeploymentProgression{}, fmt.Errorf("finalStatus %q must be success or failure", p.FinalStatus)
+	}
+	return models.PagesDeploymentProgression{
+		Stages:       p.Stages,
+		AdvancePolls: p.AdvanceAfterPolls,
+		AdvanceAfter: advanceAfter,
+		FinalStatus:  p.FinalStatus,
+	}, nil
+}
+
+// handleSetPagesProgression configures Pages deployment stage progression.
+func (s *Server) handleSetPagesProgression(w http.ResponseWriter, r *http.Request) {
+	req, err := handlers.ReadJSON[PagesProgressionRequest](r)
+	if err != nil {
+		handlers.BadRequest(w, "Invalid request body: "+err.Error())
+		return
+	}
+	progression, err := req.toProgression()
+	if err != nil {
+		handlers.BadRequest(w, err.Error())
+		return
+	}
+	s.store.SetPagesDeploymentProgression(progression)
+	writeSuccess(w, PagesProgressionResponse{Enabled: progression.Enabled()})
+}
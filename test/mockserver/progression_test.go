@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package mockserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/StringKe/cloudflare-operator/test/mockserver/models"
+)
+
+const deploymentsPath = apiPrefix + "/accounts/acc-1/pages/projects/my-site/deployments"
+
+func createDeployment(t *testing.T, mock *Server) string {
+	t.Helper()
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-1",
+		ProjectName: "my-site",
+		CreatedOn:   time.Now(),
+		LatestStage: models.PagesDeploymentStage{Name: "queued", Status: "active"},
+	})
+	return deploymentsPath + "/dep-1"
+}
+
+func latestStage(t *testing.T, resp *http.Response) models.PagesDeploymentStage {
+	t.Helper()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct {
+		Result models.PagesDeployment `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body.Result.LatestStage
+}
+
+func TestPagesProgression_AdvancesPerPoll(t *testing.T) {
+	mock, server := newMockAndServer(t)
+	resp := post(t, server, "/admin/pages-progression", `{"advanceAfterPolls": 1}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	path := createDeployment(t, mock)
+
+	var stages []models.PagesDeploymentStage
+	for range 6 {
+		stages = append(stages, latestStage(t, get(t, server, path)))
+	}
+
+	assert.Equal(t, []models.PagesDeploymentStage{
+		{Name: "initialize", Status: "active"},
+		{Name: "clone_repo", Status: "active"},
+		{Name: "build", Status: "active"},
+		{Name: "deploy", Status: "active"},
+		{Name: "deploy", Status: "success"},
+		{Name: "deploy", Status: "success"},
+	}, stages)
+}
+
+func TestPagesProgression_CustomStagesAndFailure(t *testing.T) {
+	mock, server := newMockAndServer(t)
+	post(t, server, "/admin/pages-progression",
+		`{"stages": ["queued", "build"], "advanceAfterPolls": 2, "finalStatus": "failure"}`)
+	path := createDeployment(t, mock)
+
+	assert.Equal(t, models.PagesDeploymentStage{Name: "queued", Status: "active"}, latestStage(t, get(t, server, path)))
+	assert.Equal(t, models.PagesDeploymentStage{Name: "build", Status: "active"}, latestStage(t, get(t, server, path)))
+	assert.Equal(t, models.PagesDeploymentStage{Name: "build", Status: "active"}, latestStage(t, get(t, server, path)))
+	get(t, server, path)
+
+	deployment, ok := mock.Store().GetPagesDeployment("my-site", "dep-1")
+	require.True(t, ok)
+	assert.Equal(t, models.PagesDeploymentStage{Name: "build", Status: "failure"}, deployment.LatestStage)
+	assert.Equal(t, []models.PagesDeploymentStage{
+		{Name: "queued", Status: "success"},
+		{Name: "build", Status: "failure"},
+	}, deployment.Stages)
+}
+
+func TestPagesProgression_AdvancesOverTime(t *testing.T) {
+	mock, server := newMockAndServer(t)
+	mock.Store().SetPagesDeploymentProgression(models.PagesDeploymentProgression{AdvanceAfter: time.Minute})
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-1",
+		ProjectName: "my-site",
+		CreatedOn:   time.Now().Add(-150 * time.Second),
+		LatestStage: models.PagesDeploymentStage{Name: "queued", Status: "active"},
+	})
+
+	assert.Equal(t, models.PagesDeploymentStage{Name: "clone_repo", Status: "active"},
+		latestStage(t, get(t, server, deploymentsPath+"/dep-1")))
+}
+
+func TestPagesProgression_DisabledByDefault(t *testing.T) {
+	mock, server := newMockAndServer(t)
+	path := createDeployment(t, mock)
+
+	for range 3 {
+		assert.Equal(t, models.PagesDeploymentStage{Name: "queued", Status: "active"}, latestStage(t, get(t, server, path)))
+	}
+}
+
+func TestPagesProgression_FinishedDeploymentsAreUnchanged(t *testing.T) {
+	mock, server := newMockAndServer(t)
+	mock.Store().SetPagesDeploymentProgression(models.PagesDeploymentProgression{AdvancePolls: 1})
+	mock.Store().CreatePagesDeployment(&models.PagesDeployment{
+		ID:          "dep-1",
+		ProjectName: "my-site",
+		LatestStage: models.PagesDeploymentStage{Name: "build", Status: "failure"},
+	})
+
+	assert.Equal(t, models.PagesDeploymentStage{Name: "build", Status: "failure"},
+		latestStage(t, get(t, server, deploymentsPath+"/dep-1")))
+}
+
+func TestPagesProgression_InvalidRequests(t *testing.T) {
+	_, server := newMockAndServer(t)
+
+	for _, body := range []string{
+		`not json`,
+		`{"advanceAfterPolls": -1}`,
+		`{"advanceAfter": "soon"}`,
+		`{"advanceAfterPolls": 1, "finalStatus": "done"}`,
+	} {
+		t.Run(body, func(t *testing.T) {
+			resp := post(t, server, "/admin/pages-progression", body)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
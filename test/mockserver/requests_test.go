@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2025-2026 The Cloudflare Operator Authors
+
+package mockserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tunnelsPath = apiPrefix + "/accounts/acc-1/cfd_tunnel"
+
+func TestRequestLog_RecordsMethodPathAndBody(t *testing.T) {
+	mock, server := newMockAndServer(t)
+
+	post(t, server, tunnelsPath, `{"name": "demo", "config_src": "cloudflare"}`)
+	get(t, server, zonesPath+"?name=example.com")
+
+	log := mock.GetRequestLog()
+	require.Len(t, log, 2)
+	assert.Equal(t, http.MethodPost, log[0].Method)
+	assert.Equal(t, tunnelsPath, log[0].Path)
+	var body struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, log[0].DecodeBody(&body))
+	assert.Equal(t, "demo", body.Name)
+	assert.Equal(t, http.MethodGet, log[1].Method)
+	assert.Equal(t, "name=example.com", log[1].Query)
+	assert.Empty(t, log[1].Body)
+}
+
+func TestRequestLog_HandlerStillReadsBody(t *testing.T) {
+	mock, server := newMockAndServer(t)
+
+	resp := post(t, server, tunnelsPath, `{"name": "demo", "config_src": "cloudflare"}`)
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	tunnels := mock.Store().ListTunnels("acc-1")
+	require.Len(t, tunnels, 1)
+	assert.Equal(t, "demo", tunnels[0].Name)
+}
+
+func TestRequestLog_FindAndCount(t *testing.T) {
+	mock, server := newMockAndServer(t)
+
+	post(t, server, tunnelsPath, `{"name": "demo"}`)
+	get(t, server, tunnelsPath)
+	get(t, server, zonesPath)
+
+	assert.Equal(t, 1, mock.CountRequests(http.MethodPost, "/cfd_tunnel$"))
+	assert.Equal(t, 2, mock.CountRequests("", "/cfd_tunnel$"))
+	assert.Zero(t, mock.CountRequests(http.MethodPut, ""))
+	assert.Len(t, mock.FindRequests(http.MethodGet, ""), 2)
+}
+
+func TestRequestLog_ExcludesAdminAndClearsOnReset(t *testing.T) {
+	mock, server := newMockAndServer(t)
+
+	get(t, server, zonesPath)
+	get(t, server, "/health")
+	get(t, server, "/admin/requests")
+	require.Len(t, mock.GetRequestLog(), 1)
+
+	post(t, server, "/admin/reset", "")
+
+	assert.Empty(t, mock.GetRequestLog())
+}
+
+func TestRequestLog_AdminEndpoint(t *testing.T) {
+	_, server := newMockAndServer(t)
+
+	post(t, server, tunnelsPath, `{"name": "demo"}`)
+	get(t, server, zonesPath)
+
+	resp := get(t, server, "/admin/requests?method=POST&path=cfd_tunnel")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result struct {
+		Result []RequestLogEntry `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.Len(t, result.Result, 1)
+	assert.Equal(t, tunnelsPath, result.Result[0].Path)
+	assert.JSONEq(t, `{"name": "demo"}`, result.Result[0].Body)
+
+	resp = get(t, server, "/admin/requests?path=(")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "Invalid path pattern")
+}
@@ -5,6 +5,7 @@ package mockserver
 
 import (
 	"net/http"
+	"regexp"
 
 	"github.com/StringKe/cloudflare-operator/test/mockserver/handlers"
 )
@@ -20,6 +21,9 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 	// Admin endpoints for testing
 	mux.HandleFunc("POST /admin/reset", s.handleReset)
 	mux.HandleFunc("GET /admin/requests", s.handleGetRequests)
+	mux.HandleFunc("POST /admin/fault", s.handleAddFault)
+	mux.HandleFunc("POST /admin/clear-faults", s.handleClearFaults)
+	mux.HandleFunc("POST /admin/pages-progression", s.handleSetPagesProgression)
 
 	// Create handlers
 	h := handlers.NewHandlers(s.store)
@@ -36,6 +40,7 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/cfd_tunnel", h.CreateTunnel)
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/cfd_tunnel", h.ListTunnels)
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/cfd_tunnel/{tunnelId}", h.GetTunnel)
+	mux.HandleFunc("PATCH "+apiPrefix+"/accounts/{accountId}/cfd_tunnel/{tunnelId}", h.UpdateTunnel)
 	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/cfd_tunnel/{tunnelId}", h.DeleteTunnel)
 	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/cfd_tunnel/{tunnelId}/connections", h.CleanupTunnelConnections)
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/cfd_tunnel/{tunnelId}/token", h.GetTunnelToken)
@@ -80,6 +85,13 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/access/apps/{appId}/policies/{policyId}", h.UpdateAccessPolicy)
 	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/access/apps/{appId}/policies/{policyId}", h.DeleteAccessPolicy)
 
+	// ---- Reusable Access Policy Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/access/policies", h.CreateAccessPolicy)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/policies", h.ListAccessPolicies)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/policies/{policyId}", h.GetAccessPolicy)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/access/policies/{policyId}", h.UpdateAccessPolicy)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/access/policies/{policyId}", h.DeleteAccessPolicy)
+
 	// ---- Access Group Routes ----
 	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/access/groups", h.CreateAccessGroup)
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/groups", h.ListAccessGroups)
@@ -101,6 +113,31 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/identity_providers/{idpId}", h.GetAccessIdentityProvider)
 	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/access/identity_providers/{idpId}", h.UpdateAccessIdentityProvider)
 	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/access/identity_providers/{idpId}", h.DeleteAccessIdentityProvider)
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/access/identity_providers/{idpId}/refresh_scim_secret",
+		h.RefreshAccessIdentityProviderScimSecret)
+
+	// ---- Access mTLS Certificate Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/access/certificates", h.CreateAccessCertificate)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/certificates", h.ListAccessCertificates)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/certificates/{certificateId}", h.GetAccessCertificate)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/access/certificates/{certificateId}", h.UpdateAccessCertificate)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/access/certificates/{certificateId}", h.DeleteAccessCertificate)
+
+	// ---- Access Custom Page Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/access/custom_pages", h.CreateAccessCustomPage)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/custom_pages", h.ListAccessCustomPages)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/custom_pages/{pageId}", h.GetAccessCustomPage)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/access/custom_pages/{pageId}", h.UpdateAccessCustomPage)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/access/custom_pages/{pageId}", h.DeleteAccessCustomPage)
+
+	// ---- Access Tag Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/access/tags", h.CreateAccessTag)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/tags", h.ListAccessTags)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/tags/{tagName}", h.GetAccessTag)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/access/tags/{tagName}", h.DeleteAccessTag)
+
+	// ---- Access SCIM Log Routes ----
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/access/logs/scim/updates", h.ListSCIMUpdateLogs)
 
 	// ---- Gateway Rule Routes ----
 	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/gateway/rules", h.CreateGatewayRule)
@@ -116,6 +153,13 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/gateway/lists/{listId}", h.UpdateGatewayList)
 	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/gateway/lists/{listId}", h.DeleteGatewayList)
 
+	// ---- Gateway Location Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/gateway/locations", h.CreateGatewayLocation)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/gateway/locations", h.ListGatewayLocations)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/gateway/locations/{locationId}", h.GetGatewayLocation)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/gateway/locations/{locationId}", h.UpdateGatewayLocation)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/gateway/locations/{locationId}", h.DeleteGatewayLocation)
+
 	// ---- Gateway Configuration Routes ----
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/gateway/configuration", h.GetGatewayConfiguration)
 	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/gateway/configuration", h.UpdateGatewayConfiguration)
@@ -145,6 +189,64 @@ func (s *Server) registerRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}", h.DeleteR2Bucket)
 	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}/lifecycle", h.GetR2BucketLifecycle)
 	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}/lifecycle", h.UpdateR2BucketLifecycle)
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}/domains/custom", h.AttachR2CustomDomain)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}/domains/custom/{domain}", h.GetR2CustomDomain)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}/domains/custom/{domain}", h.UpdateR2CustomDomain)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/r2/buckets/{bucketName}/domains/custom/{domain}", h.DeleteR2CustomDomain)
+
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/event_notifications/r2/{bucketName}/configuration/queues/{queueId}", h.SetR2Notification)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/event_notifications/r2/{bucketName}/configuration/queues/{queueId}", h.DeleteR2Notification)
+
+	// ---- Queue Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/queues", h.CreateQueue)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/queues", h.ListQueues)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/queues/{queueId}", h.GetQueue)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/queues/{queueId}", h.UpdateQueue)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/queues/{queueId}", h.DeleteQueue)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/queues/{queueId}/consumers", h.ListQueueConsumers)
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/queues/{queueId}/consumers", h.CreateQueueConsumer)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/queues/{queueId}/consumers/{consumerId}", h.UpdateQueueConsumer)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/queues/{queueId}/consumers/{consumerId}", h.DeleteQueueConsumer)
+
+	// ---- D1 Database Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/d1/database", h.CreateD1Database)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/d1/database", h.ListD1Databases)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/d1/database/{databaseId}", h.GetD1Database)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/d1/database/{databaseId}", h.DeleteD1Database)
+
+	// ---- Hyperdrive Config Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/hyperdrive/configs", h.CreateHyperdriveConfig)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/hyperdrive/configs", h.ListHyperdriveConfigs)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/hyperdrive/configs/{configId}", h.GetHyperdriveConfig)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/hyperdrive/configs/{configId}", h.UpdateHyperdriveConfig)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/hyperdrive/configs/{configId}", h.DeleteHyperdriveConfig)
+
+	// ---- Workers KV Namespace Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/storage/kv/namespaces", h.CreateKVNamespace)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/storage/kv/namespaces", h.ListKVNamespaces)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/storage/kv/namespaces/{namespaceId}", h.GetKVNamespace)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/storage/kv/namespaces/{namespaceId}", h.UpdateKVNamespace)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/storage/kv/namespaces/{namespaceId}", h.DeleteKVNamespace)
+
+	// ---- Web Analytics Site Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/rum/site_info", h.CreateRUMSite)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/rum/site_info/list", h.ListRUMSites)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/rum/site_info/{siteTag}", h.GetRUMSite)
+	mux.HandleFunc("PUT "+apiPrefix+"/accounts/{accountId}/rum/site_info/{siteTag}", h.UpdateRUMSite)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/rum/site_info/{siteTag}", h.DeleteRUMSite)
+
+	// ---- Pages Domain Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/domains", h.AddPagesDomain)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/domains", h.ListPagesDomains)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/domains/{domainName}", h.GetPagesDomain)
+	mux.HandleFunc("PATCH "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/domains/{domainName}", h.RetryPagesDomain)
+	mux.HandleFunc("DELETE "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/domains/{domainName}", h.DeletePagesDomain)
+
+	// ---- Pages Deployment Routes ----
+	mux.HandleFunc("POST "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/deployments", h.CreatePagesDeployment)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/deployments", h.ListPagesDeployments)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/deployments/{deploymentId}", h.GetPagesDeployment)
+	mux.HandleFunc("GET "+apiPrefix+"/accounts/{accountId}/pages/projects/{projectName}/deployments/{deploymentId}/history/logs", h.GetPagesDeploymentLogs)
 
 	// ---- Zone Ruleset Routes ----
 	mux.HandleFunc("POST "+apiPrefix+"/zones/{zoneId}/rulesets", h.CreateZoneRuleset)
@@ -169,8 +271,18 @@ func (s *Server) handleReset(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write([]byte(`{"success":true}`))
 }
 
-// handleGetRequests returns the request log.
-func (s *Server) handleGetRequests(w http.ResponseWriter, _ *http.Request) {
-	log := s.GetRequestLog()
+// handleGetRequests returns the request log. The optional method and path
+// query parameters filter it as for FindRequests.
+func (s *Server) handleGetRequests(w http.ResponseWriter, r *http.Request) {
+	method := r.URL.Query().Get("method")
+	pathPattern := r.URL.Query().Get("path")
+	if _, err := regexp.Compile(pathPattern); err != nil {
+		handlers.BadRequest(w, "Invalid path pattern: "+err.Error())
+		return
+	}
+	log := s.FindRequests(method, pathPattern)
+	if log == nil {
+		log = []RequestLogEntry{}
+	}
 	writeSuccess(w, log)
 }
@@ -5,13 +5,18 @@
 package mockserver
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,10 +36,16 @@ type Server struct {
 
 // RequestLogEntry records an API request.
 type RequestLogEntry struct {
-	Timestamp time.Time
-	Method    string
-	Path      string
-	Body      string
+	Timestamp time.Time `json:"timestamp"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Query     string    `json:"query,omitempty"`
+	Body      string    `json:"body,omitempty"`
+}
+
+// DecodeBody unmarshals the JSON body of the request into v.
+func (e RequestLogEntry) DecodeBody(v any) error {
+	return json.Unmarshal([]byte(e.Body), v)
 }
 
 // Option is a function that configures the server.
@@ -81,13 +92,23 @@ func NewServer(opts ...Option) *Server {
 // middleware adds common middleware to all requests.
 func (s *Server) middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Log request
-		s.logRequest(r)
+		// Log API requests; admin endpoints are not part of the log
+		if !isAdminPath(r.URL.Path) {
+			s.logRequest(r)
+		}
 
-		// Check for error injection
-		if err := s.errorInjector.Check(r.URL.Path, r.Method); err != nil {
-			s.handleInjectedError(w, err)
-			return
+		// Check for error injection; admin endpoints are never faulted so
+		// faults can always be cleared
+		if !isAdminPath(r.URL.Path) {
+			if err := s.errorInjector.Check(r.URL.Path, r.Method); err != nil {
+				if !delay(r.Context(), err.Latency) {
+					return
+				}
+				if err.Type != injection.ErrorTypeLatency {
+					s.handleInjectedError(w, err)
+					return
+				}
+			}
 		}
 
 		// Add CORS headers
@@ -105,15 +126,26 @@ func (s *Server) middleware(next http.Handler) http.Handler {
 	})
 }
 
-// logRequest logs an incoming request.
+// logRequest logs an incoming request, including its body. The body is
+// restored so handlers can still read it.
 func (s *Server) logRequest(r *http.Request) {
 	log.Printf("[%s] %s %s", r.Method, r.URL.Path, r.URL.RawQuery)
+
+	var body []byte
+	if r.Body != nil {
+		body, _ = io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
 	s.requestLogMu.Lock()
 	defer s.requestLogMu.Unlock()
 	s.requestLog = append(s.requestLog, RequestLogEntry{
 		Timestamp: time.Now(),
 		Method:    r.Method,
 		Path:      r.URL.Path,
+		Query:     r.URL.RawQuery,
+		Body:      string(body),
 	})
 }
 
@@ -121,7 +153,11 @@ func (s *Server) logRequest(r *http.Request) {
 func (s *Server) handleInjectedError(w http.ResponseWriter, err *injection.InjectedError) {
 	switch err.Type {
 	case injection.ErrorTypeRateLimit:
-		w.Header().Set("Retry-After", "60")
+		retryAfter := 60
+		if err.RetryAfter > 0 {
+			retryAfter = err.RetryAfter
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
 		w.WriteHeader(http.StatusTooManyRequests)
 		writeError(w, 10000, "Rate limit exceeded")
 	case injection.ErrorTypeServerError:
@@ -137,12 +173,38 @@ func (s *Server) handleInjectedError(w http.ResponseWriter, err *injection.Injec
 	case injection.ErrorTypeNotFound:
 		w.WriteHeader(http.StatusNotFound)
 		writeError(w, 10004, "Resource not found")
+	case injection.ErrorTypeStatus:
+		if err.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(err.RetryAfter))
+		}
+		w.WriteHeader(err.StatusCode)
+		writeError(w, 10005, fmt.Sprintf("Injected status %d: %s", err.StatusCode, http.StatusText(err.StatusCode)))
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		writeError(w, 10099, "Unknown error")
 	}
 }
 
+// isAdminPath reports whether path is a health check or admin endpoint.
+func isAdminPath(path string) bool {
+	return path == "/health" || strings.HasPrefix(path, "/admin/")
+}
+
+// delay waits for d and reports whether the request is still active.
+func delay(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return true
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // Start starts the server.
 func (s *Server) Start() error {
 	log.Printf("Starting mock Cloudflare API server on port %d", s.port)
@@ -184,6 +246,12 @@ func (s *Server) Stop(ctx context.Context) error {
 	return s.httpServer.Shutdown(ctx)
 }
 
+// Handler returns the server's HTTP handler.
+// This allows serving the mock API from an httptest.Server on a random port.
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
+}
+
 // Store returns the server's data store.
 func (s *Server) Store() *store.Store {
 	return s.store
@@ -198,9 +266,7 @@ func (s *Server) ErrorInjector() *injection.ErrorInjector {
 func (s *Server) Reset() {
 	s.store.Reset()
 	s.errorInjector.Clear()
-	s.requestLogMu.Lock()
-	s.requestLog = make([]RequestLogEntry, 0)
-	s.requestLogMu.Unlock()
+	s.ClearRequestLog()
 }
 
 // GetRequestLog returns a copy of the request log.
@@ -225,6 +291,37 @@ func (s *Server) GetRequestCount(pathPrefix string) int {
 	return count
 }
 
+// FindRequests returns the logged requests with the given method whose path
+// matches the regular expression pathPattern. An empty method matches all
+// methods. It panics if pathPattern is not a valid regular expression.
+func (s *Server) FindRequests(method, pathPattern string) []RequestLogEntry {
+	pathRegex := regexp.MustCompile(pathPattern)
+	s.requestLogMu.RLock()
+	defer s.requestLogMu.RUnlock()
+	var found []RequestLogEntry
+	for _, entry := range s.requestLog {
+		if (method == "" || entry.Method == method) && pathRegex.MatchString(entry.Path) {
+			found = append(found, entry)
+		}
+	}
+	return found
+}
+
+// CountRequests returns the number of logged requests matching method and pathPattern,
+// as for FindRequests. It allows asserting that a sync made no writes:
+//
+//	assert.Zero(t, mock.CountRequests(http.MethodPut, "/configurations$"))
+func (s *Server) CountRequests(method, pathPattern string) int {
+	return len(s.FindRequests(method, pathPattern))
+}
+
+// ClearRequestLog clears the request log, keeping data and faults.
+func (s *Server) ClearRequestLog() {
+	s.requestLogMu.Lock()
+	defer s.requestLogMu.Unlock()
+	s.requestLog = make([]RequestLogEntry, 0)
+}
+
 // URL returns the base URL of the server.
 func (s *Server) URL() string {
 	return fmt.Sprintf("http://localhost:%d", s.port)